@@ -4,7 +4,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
+
+	"github.com/boromil/timesheet/domain"
+	transport "github.com/boromil/timesheet/transport"
 )
 
 // ParsedArgs - container for parsed CLI args.
@@ -16,8 +21,59 @@ type ParsedArgs struct {
 	SdbDBName,
 	SdbAPIKey,
 	SdbAPIValue,
-	RefIDPrefix string
-	EchoMode bool
+	RefIDPrefix,
+	SdbProbePath,
+	PDFTemplate,
+	InvoiceTemplate,
+	InvoicePrefix,
+	CSP,
+	FrameAncestors,
+	ReferrerPolicy,
+	PermissionsPolicy,
+	LogFormat,
+	LogLevel,
+	TraceExporter,
+	TraceEndpoint string
+	TraceSampleRatio float64
+	ShutdownDelay,
+	ShutdownTimeout,
+	CORSMaxAge,
+	CacheTTL,
+	SdbTimeout,
+	SdbBreakerCooldown,
+	SdbProbeInterval,
+	ReadHeaderTimeout,
+	ReadTimeout,
+	IdleTimeout time.Duration
+	CacheMaxEntries,
+	CacheMaxBodySize,
+	CompressMinSize,
+	SdbRetries,
+	SdbBreakerThreshold,
+	MaxHeaderBytes int
+	MaxAuthBodySize,
+	MaxAPIBodySize int64
+	RateLimitAuth,
+	RateLimitAPI transport.RateLimit
+	AuthCookieSameSite http.SameSite
+	SdbUpstreams       []transport.Upstream
+	TimerRounding      domain.Rounding
+	LogRedactHeaders,
+	LogRedactValues,
+	CORSOrigins,
+	CORSMethods,
+	CORSHeaders,
+	CompressTypes,
+	TrustedProxies []string
+	EchoMode,
+	DevMode,
+	SdbProxy,
+	SdbReportQueries,
+	CSPReportOnly,
+	AuthCookies,
+	AuthCookieInsecure,
+	TraceInsecure,
+	CORSCredentials bool
 }
 
 // Parse - parses the command line arguments and populates the ParsedArgs object with the outcome
@@ -29,7 +85,153 @@ func Parse() *ParsedArgs {
 	flag.StringVar(&pa.SdbInstanceAddr, "sdb-address", "https://demo.slashdb.com", "SlashDB instance address")
 	flag.StringVar(&pa.SdbDBName, "sdb-dbname", "timesheet", "SlashDB DB name i.e. https://demo.slashdb.com/db/>>timesheet<<")
 	flag.StringVar(&pa.RefIDPrefix, "sdb-ref-id-prefix", "__href", "SlashDB's object ref URL prefix")
-	flag.BoolVar(&pa.EchoMode, "echo-mode", true, "printout SlashDB's connection info - usefull for debugging")
+	flag.BoolVar(
+		&pa.EchoMode, "echo-mode", true, "log every SlashDB call (method, URL, status, latency) - usefull for debugging",
+	)
+	flag.BoolVar(
+		&pa.DevMode, "dev", false, "serve the assets from the working directory, with no caching and a live reload",
+	)
+	flag.BoolVar(
+		&pa.SdbProxy, "sdb-proxy", true, "pass the raw SlashDB API through (the app uses the /api/v1 endpoints)",
+	)
+	flag.BoolVar(
+		&pa.SdbReportQueries,
+		"sdb-report-queries", false, "aggregate the reports with SlashDB custom queries, they are created if missing (MySQL dialect)",
+	)
+	flag.StringVar(&pa.LogFormat, "log-format", "logfmt", "log output format: json or logfmt")
+	flag.StringVar(&pa.LogLevel, "log-level", "info", "minimal log level: debug, info, warn or error")
+	flag.StringVar(&pa.TraceExporter, "trace-exporter", "none", "OpenTelemetry trace exporter: none, otlp or stdout")
+	flag.StringVar(
+		&pa.TraceEndpoint,
+		"trace-endpoint", "", "OTLP/HTTP collector host:port, if empty the OTEL_EXPORTER_OTLP_* env vars are used",
+	)
+	flag.BoolVar(&pa.TraceInsecure, "trace-insecure", false, "use plain HTTP when talking to the OTLP collector")
+	flag.Float64Var(&pa.TraceSampleRatio, "trace-sample-ratio", 1, "fraction of the traces to sample, from 0 to 1")
+	flag.DurationVar(
+		&pa.ShutdownDelay,
+		"shutdown-delay", time.Second*5, "how long to report not ready before draining connections on SIGTERM/SIGINT",
+	)
+	flag.DurationVar(
+		&pa.ShutdownTimeout, "shutdown-timeout", time.Second*20, "how long to wait for the in-flight requests to complete",
+	)
+	flag.BoolVar(&pa.CORSCredentials, "cors-credentials", false, "allow credentialed cross-origin requests")
+	flag.DurationVar(&pa.CORSMaxAge, "cors-max-age", time.Minute*10, "how long the browsers may cache the preflight response")
+
+	var corsOrigins, corsMethods, corsHeaders string
+	flag.StringVar(
+		&corsOrigins,
+		"cors-origins", "", "comma separated allowed cross-origin origins, \"*\" for any, empty for same-origin only",
+	)
+	flag.StringVar(&corsMethods, "cors-methods", "GET,POST,PUT,DELETE", "comma separated allowed cross-origin methods")
+	flag.StringVar(
+		&corsHeaders,
+		"cors-headers", "Accept,Authorization,Content-Type,X-CSRF-Token,X-Request-ID,X-Requested-With",
+		"comma separated allowed cross-origin request headers",
+	)
+
+	var rateLimitAuth, rateLimitAPI, trustedProxies string
+	flag.StringVar(
+		&rateLimitAuth, "rate-limit-auth", "10/m", "login/registration rate limit per client IP i.e. 10/m, 0 to disable",
+	)
+	flag.StringVar(
+		&rateLimitAPI, "rate-limit-api", "20/s", "authenticated routes rate limit per user i.e. 20/s, 0 to disable",
+	)
+	flag.StringVar(
+		&trustedProxies,
+		"trusted-proxies", "", "comma separated IPs/CIDRs of the proxies allowed to set X-Forwarded-For",
+	)
+
+	flag.DurationVar(&pa.CacheTTL, "cache-ttl", 0, "how long to cache the SlashDB reads per user, 0 disables the cache")
+	flag.IntVar(&pa.CacheMaxEntries, "cache-max-entries", 10000, "max number of the cached responses")
+	flag.IntVar(&pa.CacheMaxBodySize, "cache-max-body-size", 1<<20, "max size (in bytes) of a cached response body")
+
+	var sdbUpstreams string
+	flag.StringVar(
+		&sdbUpstreams,
+		"sdb-upstreams", "",
+		"comma separated SlashDB instances with roles i.e. https://db1=primary,https://db2=replica, overrides -sdb-address",
+	)
+	flag.StringVar(&pa.SdbProbePath, "sdb-probe-path", "/", "path requested on every SlashDB instance by the health probes")
+	flag.DurationVar(
+		&pa.SdbProbeInterval,
+		"sdb-probe-interval", time.Second*10, "how often to probe the SlashDB instances, 0 disables the probes",
+	)
+	flag.DurationVar(&pa.SdbTimeout, "sdb-timeout", time.Second*5, "how long a single SlashDB call may wait for a response")
+	flag.IntVar(&pa.SdbRetries, "sdb-retries", 2, "how many times to retry the failed idempotent SlashDB calls")
+	flag.IntVar(
+		&pa.SdbBreakerThreshold,
+		"sdb-breaker-threshold", 5, "consecutive SlashDB failures which open the circuit breaker, 0 disables it",
+	)
+	flag.DurationVar(
+		&pa.SdbBreakerCooldown,
+		"sdb-breaker-cooldown", time.Second*15, "how long the open circuit breaker fails fast before probing SlashDB",
+	)
+
+	flag.StringVar(
+		&pa.CSP, "csp", transport.DefaultCSP, "Content-Security-Policy, {nonce} is replaced with a per request nonce, empty disables it",
+	)
+	flag.BoolVar(&pa.CSPReportOnly, "csp-report-only", false, "only report (to /app/csp-report) the CSP violations, don't block")
+	flag.StringVar(&pa.FrameAncestors, "frame-ancestors", "'none'", "CSP frame-ancestors sources, who can embed the app")
+	flag.StringVar(&pa.ReferrerPolicy, "referrer-policy", "same-origin", "Referrer-Policy header value, empty disables it")
+	flag.StringVar(
+		&pa.PermissionsPolicy,
+		"permissions-policy", "camera=(), microphone=(), geolocation=(), payment=()",
+		"Permissions-Policy header value, empty disables it",
+	)
+
+	flag.BoolVar(
+		&pa.AuthCookies,
+		"auth-cookies", false, "keep the JWT in an HttpOnly cookie (with CSRF protection) instead of handing it to the app JS",
+	)
+	flag.BoolVar(
+		&pa.AuthCookieInsecure,
+		"auth-cookie-insecure", false, "don't mark the auth cookies Secure, for the plain HTTP development setups only",
+	)
+	var authCookieSameSite string
+	flag.StringVar(&authCookieSameSite, "auth-cookie-samesite", "lax", "SameSite attribute of the auth cookies: lax, strict or none")
+
+	flag.Int64Var(&pa.MaxAuthBodySize, "max-auth-body", 64<<10, "max size (in bytes) of the login/registration request bodies")
+	flag.Int64Var(&pa.MaxAPIBodySize, "max-api-body", 10<<20, "max size (in bytes) of the request bodies passed on to SlashDB")
+	flag.IntVar(&pa.MaxHeaderBytes, "max-header-bytes", 32<<10, "max size (in bytes) of the request headers")
+	flag.DurationVar(&pa.ReadHeaderTimeout, "read-header-timeout", time.Second*5, "how long a client may take to send the request headers")
+	flag.DurationVar(&pa.ReadTimeout, "read-timeout", time.Second*30, "how long a client may take to send the whole request")
+	flag.DurationVar(&pa.IdleTimeout, "idle-timeout", time.Second*120, "how long an idle keep-alive connection is kept open")
+
+	flag.StringVar(
+		&pa.PDFTemplate,
+		"pdf-template", "", "path of a custom timesheet PDF layout template, templates/timesheet-pdf.xml is the default one",
+	)
+	flag.StringVar(
+		&pa.InvoiceTemplate,
+		"invoice-template", "", "path of a custom invoice PDF layout template, templates/invoice-pdf.xml is the default one",
+	)
+	flag.StringVar(
+		&pa.InvoicePrefix,
+		"invoice-prefix", "INV-", "prefix of the invoice numbers, followed by the year and the number in it, i.e. INV-2026-0001",
+	)
+
+	var timerRounding string
+	flag.StringVar(
+		&timerRounding,
+		"timer-rounding", "none", "how the stopped timer durations are rounded: none or <nearest|up|down>:<step> i.e. nearest:6m",
+	)
+
+	var compressTypes string
+	flag.IntVar(&pa.CompressMinSize, "compress-min-size", 1024, "smallest response (in bytes) to be compressed, -1 disables compression")
+	flag.StringVar(
+		&compressTypes,
+		"compress-types", strings.Join(transport.DefaultCompressibleTypes, ","),
+		"comma separated compressible media types, entries ending with / match the whole type",
+	)
+
+	var logRedactHeaders, logRedactValues string
+	flag.StringVar(
+		&logRedactHeaders,
+		"log-redact-headers", "Authorization,Cookie,Set-Cookie", "comma separated header names never to be logged",
+	)
+	flag.StringVar(
+		&logRedactValues, "log-redact", "", "comma separated secret values to be masked in the logs (the SlashDB API key always is)",
+	)
 
 	var sdbAPIKey string
 	flag.StringVar(
@@ -46,5 +248,65 @@ func Parse() *ParsedArgs {
 		pa.SdbAPIKey, pa.SdbAPIValue = tmp[0], tmp[1]
 	}
 
+	var err error
+	if pa.RateLimitAuth, err = transport.ParseRateLimit(rateLimitAuth); err != nil {
+		log.Fatalln(fmt.Errorf("-rate-limit-auth: %w", err))
+	}
+	if pa.RateLimitAPI, err = transport.ParseRateLimit(rateLimitAPI); err != nil {
+		log.Fatalln(fmt.Errorf("-rate-limit-api: %w", err))
+	}
+	pa.TrustedProxies = splitList(trustedProxies)
+
+	if sdbUpstreams == "" {
+		sdbUpstreams = pa.SdbInstanceAddr
+	}
+	if pa.SdbUpstreams, err = transport.ParseUpstreams(sdbUpstreams); err != nil {
+		log.Fatalln(fmt.Errorf("-sdb-upstreams: %w", err))
+	}
+	for _, u := range pa.SdbUpstreams {
+		if u.Role == transport.RolePrimary {
+			// the proxy and the SlashDB client are set up with the first primary, the pool does the rest
+			pa.SdbInstanceAddr = u.URL.String()
+			break
+		}
+	}
+
+	if pa.TimerRounding, err = domain.ParseRounding(timerRounding); err != nil {
+		log.Fatalln(fmt.Errorf("-timer-rounding: %w", err))
+	}
+
+	pa.CompressTypes = splitList(compressTypes)
+
+	sameSites := map[string]http.SameSite{
+		"lax":    http.SameSiteLaxMode,
+		"strict": http.SameSiteStrictMode,
+		"none":   http.SameSiteNoneMode,
+	}
+	var ok bool
+	if pa.AuthCookieSameSite, ok = sameSites[strings.ToLower(authCookieSameSite)]; !ok {
+		log.Fatalln(fmt.Errorf("-auth-cookie-samesite: expected lax, strict or none, got: %s", authCookieSameSite))
+	}
+
+	pa.CORSOrigins = splitList(corsOrigins)
+	pa.CORSMethods = splitList(corsMethods)
+	pa.CORSHeaders = splitList(corsHeaders)
+	cors := transport.CORSConfig{AllowedOrigins: pa.CORSOrigins, AllowCredentials: pa.CORSCredentials}
+	if err := cors.Validate(); err != nil {
+		log.Fatalln(fmt.Errorf("-cors-origins: %w", err))
+	}
+	pa.LogRedactHeaders = append(splitList(logRedactHeaders), pa.SdbAPIKey)
+	pa.LogRedactValues = append(splitList(logRedactValues), pa.SdbAPIValue)
+
 	return pa
 }
+
+// splitList splits a comma separated flag value, skipping the empty items
+func splitList(s string) []string {
+	items := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,9 @@
+package main
+
+import "embed"
+
+// embeddedAssets holds the static assets (along with their precompressed variants, when build.sh made them)
+// and the page index template
+//
+//go:embed assets templates
+var embeddedAssets embed.FS
@@ -10,8 +10,8 @@
     return local.toJSON().slice(0, cutBy);
   };
 
-  var getURL = function (s) {
-    return window.timesheet.baseURL + s;
+  var apiURL = function (s) {
+    return "/api/v1" + s;
   };
 
   // some utility functions
@@ -34,6 +34,20 @@
     return ms * 2.777777777777778e-7;
   };
 
+  var formatElapsed = function (seconds) {
+    // formats the seconds as h:mm:ss
+    var pad = function (n) {
+      return n < 10 ? "0" + n : String(n);
+    };
+    return (
+      Math.floor(seconds / 3600) +
+      ":" +
+      pad(Math.floor((seconds % 3600) / 60)) +
+      ":" +
+      pad(seconds % 60)
+    );
+  };
+
   var resetFields = function (target, items) {
     var item;
     for (var i = 0, l = items.length; i < l; i++) {
@@ -80,6 +94,34 @@
     return formValid;
   };
 
+  var fieldErrors = function (jsonData) {
+    // extracts the per field errors from the error envelope
+    return (jsonData && jsonData.error && jsonData.error.fields) || {};
+  };
+
+  var getCookie = function (name) {
+    // returns the value of a (not HttpOnly) cookie, or "" if it's not set
+    var parts = document.cookie ? document.cookie.split("; ") : [];
+    for (var i = 0, l = parts.length; i < l; i++) {
+      if (parts[i].indexOf(name + "=") === 0) {
+        return decodeURIComponent(parts[i].slice(name.length + 1));
+      }
+    }
+    return "";
+  };
+
+  // with the cookie based auth, the state changing requests have to repeat
+  // the CSRF cookie value in a header (double submit)
+  Vue.http.interceptors.push(function (request) {
+    var csrfToken = getCookie("timesheet_csrf");
+    if (
+      csrfToken &&
+      ["GET", "HEAD", "OPTIONS"].indexOf(request.method) === -1
+    ) {
+      request.headers.set("X-CSRF-Token", csrfToken);
+    }
+  });
+
   var unauthorizedHandler = function (resp) {
     if (resp.status == 401) {
       this.$emit("bad-token");
@@ -120,6 +162,9 @@
                        placeholder="enter password">
                 <input-errors :errors="password.errors"/>
             </div>
+            <div class="form-group" :class="{'has-danger': form.errors.length > 0}">
+                <input-errors :errors="form.errors"/>
+            </div>
             <button type="submit" class="btn btn-primary">GO!</button>
         </form>
         `,
@@ -141,10 +186,14 @@
       };
     },
     methods: {
-      createAuthInfo: function (accessToken) {
-        var payload = JSON.parse(atob(accessToken.split(".")[1])),
+      createAuthInfo: function (jsonData) {
+        if (!jsonData.accessToken) {
+          // cookie based auth, the token is kept away from JS
+          return { payload: jsonData.user };
+        }
+        var payload = JSON.parse(atob(jsonData.accessToken.split(".")[1])),
           authInfo = {
-            accessToken: accessToken,
+            accessToken: jsonData.accessToken,
             payload: payload
           };
         return authInfo;
@@ -167,17 +216,18 @@
             resp.json().then(function (jsonData) {
               self.$emit(
                 "logged-in",
-                self.createAuthInfo(jsonData.accessToken)
+                self.createAuthInfo(jsonData)
               );
               resetFields(self, ks);
             });
           },
           function (resp) {
             resp.json().then(function (jsonData) {
-              var k;
+              var k,
+                fields = fieldErrors(jsonData);
               for (var i = 0, l = ks.length; i < l; i++) {
                 k = ks[i];
-                self[k].errors = jsonData[k] || [];
+                self[k].errors = fields[k] || [];
               }
             });
           }
@@ -297,10 +347,11 @@
             }
 
             resp.json().then(function (jsonData) {
-              var k;
+              var k,
+                fields = fieldErrors(jsonData);
               for (var i = 0, l = ks.length; i < l; i++) {
                 k = ks[i];
-                self[k].errors = jsonData[k] || [];
+                self[k].errors = fields[k] || [];
               }
             });
           }
@@ -388,11 +439,61 @@
                         <input-errors :errors="accomplishments.errors"/>
                     </div>
                     <button type="submit" class="btn btn-sm btn-primary">Create</button>
+                    <button v-if="!timer.running" type="button" class="btn btn-sm btn-outline-primary" @click="startTimer">
+                        Start timer
+                    </button>
+                    <button v-else-if="timer.timer.project_id === project.id" type="button" class="btn btn-sm btn-outline-danger" @click="stopTimer">
+                        Stop timer ({{ elapsed }})
+                    </button>
                 </form>
             </div>
         </div>
         `,
+    computed: {
+      elapsed: function () {
+        return formatElapsed(this.timer.timer.elapsed_seconds);
+      }
+    },
     methods: {
+      startTimer: function ($event) {
+        this.$http
+          .post(apiURL("/timer/start"), {
+            project_id: this.project.id,
+            accomplishments: this.accomplishments.value
+          })
+          .then(
+            function (resp) {
+              this.$emit("timer-changed");
+            },
+            function (resp) {
+              // i.e. a timer was started on another device
+              unauthorizedHandler.call(this, resp);
+              this.$emit("timer-changed");
+            }
+          );
+      },
+      stopTimer: function ($event) {
+        this.$http
+          .post(apiURL("/timer/stop"), {
+            accomplishments: this.accomplishments.value
+          })
+          .then(
+            function (resp) {
+              this.$emit("timesheet-created", this.project, resp.data);
+              this.$emit("timer-changed");
+              resetFields(this, ["accomplishments"]);
+            },
+            function (resp) {
+              unauthorizedHandler.call(this, resp);
+              var fields = fieldErrors(resp.data);
+              if (fields.accomplishments) {
+                this.accomplishments.errors = fields.accomplishments;
+                return;
+              }
+              this.$emit("timer-changed");
+            }
+          );
+      },
       getDateTimeNow: function (minOffset) {
         if (minOffset == null) {
           minOffset = 0;
@@ -448,21 +549,14 @@
         var data = {
           duration: this.duration.value,
           accomplishments: this.accomplishments.value,
-          project_id: this.project.id,
-          user_id: this.userId
+          project_id: this.project.id
         };
 
         this.$http
-          .post(getURL("/timesheet/user_id/" + this.userId + ".json"), data)
+          .post(apiURL("/entries"), data)
           .then(
             function (resp) {
-              var ld = extend(
-                {
-                  date: new Date().toDateTimeInputValue(19)
-                },
-                data
-              );
-              this.$emit("timesheet-created", this.project, ld);
+              this.$emit("timesheet-created", this.project, resp.data);
               resetFields(this, ["accomplishments"]);
             },
             function (resp) {
@@ -501,6 +595,10 @@
       project: {
         type: Object,
         required: true
+      },
+      timer: {
+        type: Object,
+        required: true
       }
     }
   });
@@ -561,41 +659,17 @@
         };
 
         this.$http
-          .post(
-            getURL("/timesheet/user_id/" + this.userId + "/project.json"),
-            data
-          )
-          .then(function (resp) {
-            var tdata = {
-              project_id: resp.data.split("/").pop(),
-              user_id: this.userId,
-              duration: 0,
-              accomplishments: ""
-            };
-
-            this.$http
-              .post(
-                getURL("/timesheet/user_id/" + this.userId + ".json"),
-                tdata
-              )
-              .then(
-                function (resp) {
-                  var ld = extend(
-                    {
-                      id: Number(tdata.project_id),
-                      timesheet: []
-                    },
-                    data
-                  );
-                  this.$emit("project-created", ld);
-                  resetFields(this, Object.keys(data));
-                },
-                function (resp) {
-                  unauthorizedHandler(resp);
-                  console.log(resp);
-                }
-              );
-          });
+          .post(apiURL("/projects"), data)
+          .then(
+            function (resp) {
+              this.$emit("project-created", extend({ entries: [] }, resp.data));
+              resetFields(this, Object.keys(data));
+            },
+            function (resp) {
+              unauthorizedHandler(resp);
+              console.log(resp);
+            }
+          );
       }
     },
     props: {
@@ -663,8 +737,9 @@
                                 </span>
                             </div>
                             <div class="card-block">
-                                <new-timesheet :userId="userId" :project="project" @timesheet-created="addTimesheet"/>
-                                <div class="card mt-2" v-for="(timesheet, tIdx) in project.timesheet" v-if="timesheet.duration > 0 && timesheet.accomplishments.length > 0">
+                                <new-timesheet :userId="userId" :project="project" :timer="timer"
+                                               @timesheet-created="addTimesheet" @timer-changed="loadTimer"/>
+                                <div class="card mt-2" v-for="(timesheet, tIdx) in project.entries">
                                     <div class="card-header">
                                         created: <strong>{{ timesheet.date | formatDateTime }}</strong>
                                         <remove-btn class="float-sm-right float-md-right float-lg-right" :on-confirm="removeTimesheet(project, timesheet, tIdx)"/>
@@ -690,33 +765,57 @@
         `,
     mounted: function () {
       if (this.userId !== -1) {
-        // get all projects and their timesheet-s
+        // get all projects and their entries, the newest first
         this.$http
-          .get(
-            getURL(
-              "/timesheet/user_id/" +
-              this.userId +
-              "/project.json?depth=1&sort=timestamp"
-            )
-          )
+          .get(apiURL("/projects?expand=entries&limit=1000"))
           .then(function (resp) {
-            var projects = resp.data.reverse();
-            for (var i = 0, l = projects.length, project; i < l; i++) {
-              project = projects[i];
-              project.timesheet = project.timesheet.reverse();
-            }
-            this.projects = projects;
+            this.projects = resp.data.items;
             this.loading = false;
           }, unauthorizedHandler);
+        this.loadTimer();
+        this.loadTotals();
+        // the timer state is kept on the server, it's only ticking here
+        this.ticker = setInterval(
+          function (self) {
+            if (self.timer.running) {
+              self.timer.timer.elapsed_seconds++;
+            }
+          },
+          1000,
+          this
+        );
       }
     },
+    beforeDestroy: function () {
+      clearInterval(this.ticker);
+    },
     data: function () {
       return {
         projects: [],
+        timer: { running: false },
+        // the project hours, by the project IDs
+        totals: {},
         loading: true
       };
     },
     methods: {
+      loadTotals: function () {
+        // the totals are aggregated by the server, the project entries don't have to be all loaded
+        this.$http
+          .get(apiURL("/reports?group_by=project"))
+          .then(function (resp) {
+            var totals = {};
+            for (var i = 0, l = resp.data.groups.length; i < l; i++) {
+              totals[resp.data.groups[i].key] = resp.data.groups[i].hours;
+            }
+            this.totals = totals;
+          }, unauthorizedHandler);
+      },
+      loadTimer: function () {
+        this.$http.get(apiURL("/timer")).then(function (resp) {
+          this.timer = resp.data;
+        }, unauthorizedHandler);
+      },
       addProject: function (project, $event) {
         this.projects.splice(0, 0, project);
       },
@@ -729,78 +828,38 @@
             return;
           }
 
-          // first remove all the children timesheet entires
+          // the project entries are removed along with it
           self.$http
-            .delete(
-              getURL(
-                "/timesheet/user_id/" +
-                self.userId +
-                "/project_id/" +
-                project.id
-              )
-            )
-            .then(function (resp) {
-              // then remove the project itself
-              self.$http
-                .delete(
-                  getURL(
-                    "/timesheet/user_id/" +
-                    self.userId +
-                    "/project/id/" +
-                    project.id
-                  )
-                )
-                .then(function (resp) { }, unauthorizedHandler);
-            }, unauthorizedHandler);
+            .delete(apiURL("/projects/" + project.id))
+            .then(function (resp) { }, unauthorizedHandler);
         };
       },
       updateProjectData: function (project) {
         this.$http
-          .get(
-            getURL(
-              "/timesheet/user_id/" +
-              this.userId +
-              "/project/id/" +
-              project.id +
-              "?depth=1"
-            )
-          )
+          .get(apiURL("/projects/" + project.id + "?expand=entries"))
           .then(function (resp) {
-            project.timesheet = resp.data.timesheet.reverse();
+            project.entries = resp.data.entries || [];
           }, unauthorizedHandler);
+        this.loadTotals();
       },
       addTimesheet: function (project, timesheet, $event) {
-        project.timesheet.splice(0, 0, timesheet);
+        project.entries.splice(0, 0, timesheet);
         // reload and sync-up project data
         this.updateProjectData(project);
       },
       removeTimesheet: function (project, timesheet, tIdx, $event) {
         var self = this;
         return function ($event) {
-          project.timesheet.splice(tIdx, 1);
+          project.entries.splice(tIdx, 1);
           self.$http
-            .delete(
-              getURL(
-                "/timesheet/user_id/" +
-                self.userId +
-                "/project_id/" +
-                project.id +
-                "/date/" +
-                timesheet.date
-              )
-            )
-            .then(function (resp) { }, unauthorizedHandler);
+            .delete(apiURL("/entries/" + timesheet.id))
+            .then(function (resp) {
+              self.loadTotals();
+            }, unauthorizedHandler);
         };
       },
       sumDuration: function (project) {
-        var tmp = project.timesheet
-          .map(function (el) {
-            return el.duration || 0;
-          })
-          .reduce(function (a, b) {
-            return a + b;
-          }, 0);
-        return Number(tmp.toFixed(2));
+        return this.totals[project.id] || 0;
       }
     },
     filters: {
@@ -830,9 +889,14 @@
   var app = new Vue({
     el: "#app",
     methods: {
+      setAuthHeader: function (authInfo) {
+        if (authInfo.accessToken) {
+          Vue.http.headers.common["Authorization"] =
+            "Bearer " + authInfo.accessToken;
+        }
+      },
       storeAuthInfo: function (authInfo) {
-        Vue.http.headers.common["Authorization"] =
-          "Bearer " + authInfo.accessToken;
+        this.setAuthHeader(authInfo);
         this.authInfo = authInfo;
         this.userId = authInfo.payload.id;
         this.userName = authInfo.payload.username;
@@ -847,8 +911,7 @@
         }
 
         this.authInfo = JSON.parse(authInfoStr);
-        Vue.http.headers.common["Authorization"] =
-          "Bearer " + this.authInfo.accessToken;
+        this.setAuthHeader(this.authInfo);
         this.userId = this.authInfo.payload.id;
         this.userName = this.authInfo.payload.username;
         this.setView("projects");
@@ -865,6 +928,10 @@
         this.view = viewName;
       },
       logOut: function ($event) {
+        if (!this.authInfo.accessToken) {
+          // expires the session cookies
+          this.$http.post("/app/logout");
+        }
         this.deleteAuthInfo();
         this.setView("login");
       }
@@ -1,432 +0,0 @@
-// Code generated for package main by go-bindata DO NOT EDIT. (@generated)
-// sources:
-// assets/css/bootstrap.css
-// assets/js/app.js
-// assets/js/vue-resource.js
-// assets/js/vue.js
-// templates/index.html
-package main
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"net/http"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-// Name return file name
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-
-// Size return file size
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-
-// Mode return file mode
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-
-// Mode return file modify time
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-
-// IsDir return file whether a directory
-func (fi bindataFileInfo) IsDir() bool {
-	return fi.mode&os.ModeDir != 0
-}
-
-// Sys return file is sys mode
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-
-type assetFile struct {
-	*bytes.Reader
-	name            string
-	childInfos      []os.FileInfo
-	childInfoOffset int
-}
-
-type assetOperator struct{}
-
-// Open implement http.FileSystem interface
-func (f *assetOperator) Open(name string) (http.File, error) {
-	var err error
-	if len(name) > 0 && name[0] == '/' {
-		name = name[1:]
-	}
-	content, err := Asset(name)
-	if err == nil {
-		return &assetFile{name: name, Reader: bytes.NewReader(content)}, nil
-	}
-	children, err := AssetDir(name)
-	if err == nil {
-		childInfos := make([]os.FileInfo, 0, len(children))
-		for _, child := range children {
-			childPath := filepath.Join(name, child)
-			info, errInfo := AssetInfo(filepath.Join(name, child))
-			if errInfo == nil {
-				childInfos = append(childInfos, info)
-			} else {
-				childInfos = append(childInfos, newDirFileInfo(childPath))
-			}
-		}
-		return &assetFile{name: name, childInfos: childInfos}, nil
-	} else {
-		// If the error is not found, return an error that will
-		// result in a 404 error. Otherwise the server returns
-		// a 500 error for files not found.
-		if strings.Contains(err.Error(), "not found") {
-			return nil, os.ErrNotExist
-		}
-		return nil, err
-	}
-}
-
-// Close no need do anything
-func (f *assetFile) Close() error {
-	return nil
-}
-
-// Readdir read dir's children file info
-func (f *assetFile) Readdir(count int) ([]os.FileInfo, error) {
-	if len(f.childInfos) == 0 {
-		return nil, os.ErrNotExist
-	}
-	if count <= 0 {
-		return f.childInfos, nil
-	}
-	if f.childInfoOffset+count > len(f.childInfos) {
-		count = len(f.childInfos) - f.childInfoOffset
-	}
-	offset := f.childInfoOffset
-	f.childInfoOffset += count
-	return f.childInfos[offset : offset+count], nil
-}
-
-// Stat read file info from asset item
-func (f *assetFile) Stat() (os.FileInfo, error) {
-	if len(f.childInfos) != 0 {
-		return newDirFileInfo(f.name), nil
-	}
-	return AssetInfo(f.name)
-}
-
-// newDirFileInfo return default dir file info
-func newDirFileInfo(name string) os.FileInfo {
-	return &bindataFileInfo{
-		name:    name,
-		size:    0,
-		mode:    os.FileMode(2147484068), // equal os.FileMode(0644)|os.ModeDir
-		modTime: time.Time{}}
-}
-
-// AssetFile return a http.FileSystem instance that data backend by asset
-func AssetFile() http.FileSystem {
-	return &assetOperator{}
-}
-
-var _assetsCssBootstrapCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x5b\x73\xdc\x38\x92\x28\xfc\xae\x5f\xc1\xd1\x84\xc3\x76\x77\xb1\xcc\xba\xab\xe4\x70\xc7\xee\x4c\xcc\x89\x33\x11\xd3\xfb\xb0\xb3\xfb\xbd\xf4\xe9\x07\x16\x89\xaa\xe2\x98\xb7\x43\xb2\xa4\x52\xf7\xf6\xf7\xdb\x4f\x90\x04\xc8\x04\x90\xb8\x95\x64\x5b\xf2\x65\x76\x5b\x12\x91\x99\x48\x24\x12\x89\xcc\xc4\xed\xdd\x0f\x7f\xba\xf2\x7e\xf0\xfe\x52\x14\x4d\xdd\x54\x61\xe9\xdd\x2d\xa7\xc1\x34\xf0\xc3\xb4\x3c\x86\xd3\xb5\xf7\xe6\xd8\x34\x65\x7d\xfb\xee\xdd\x81\x34\x3b\x06\x34\x8d\x8a\xec\x6d\x8b\xf6\xd7\xa2\x7c\xa8\x92\xc3\xb1\xf1\xe6\xc1\x6c\xe6\xcf\x83\xd9\xc6\xfb\xaf\x23\x01\xe4\xfe\xfd\xd4\x1c\x8b\xaa\x56\x02\xdf\x27\x4d\x43\xaa\x89\xf7\xf7\x3c\x9a\xb6\x40\xff\x48\x22\x92\xd7\x24\xf6\x4e\x79\x4c\x2a\xef\xe7\xbf\xff\x17\x60\x21\x69\x8e\xa7\x5d\x5b\xf9\xbb\xe6\x7e\x57\xbf\x1b\xf8\x79\xb7\x4b\x8b\xdd\xbb\x2c\xac\x1b\x52\xbd\xfb\xc7\xdf\xff\xfa\xb7\xff\xf8\xe7\xdf\x5a\xfe\xde\x5d\xbd\xfb\xe1\x4f\x5e\x5e\x54\x59\x98\x26\xbf\x91\x69\x54\xd7\xde\xdd\xaa\x6d\x9f\xf7\x3f\x1d\x69\x5a\x9b\xf7\x3f\x1e\xa0\x9d\x93\xa8\x48\xc3\xfa\x1d\x8f\xf7\xc3\xbb\xab\x63\x93\xa5\xde\xef\x57\x9e\xb7\x2f\xf2\xc6\xdf\x87\x59\x92\x3e\xdc\x7a\x75\x98\xd7\x7e\x4d\xaa\x64\xff\xfe\xca\xf3\xd2\x24\x27\xfe\x91\xb4\xcd\xbc\xf5\x66\xd3\xd9\xaa\xfd\xe8\x67\xb5\xdf\x90\x73\xe3\xd7\xc9\x6f\xc4\x0f\xe3\x7f\x9d\xea\xb6\x34\x08\x5e\x75\xa5\xf7\x64\xf7\x31\x69\x94\x10\x7f\x5c\x5d\xed\x8a\xf8\xa1\xab\x39\x0b\xab\x43\x92\xdf\x7a\x41\xf7\x39\xac\x9a\x24\x4a\xc9\xe4\x2a\xac\x93\x98\x4c\xae\xf6\x45\xd1\x0a\xf3\xea\x48\xc2\xb8\xfd\x99\x87\x77\x93\xab\x9a\x44\x4d\x52\xe4\x1d\x7a\x9c\xd4\x65\x1a\x3e\xdc\x7a\xbb\xb4\x88\x3e\x76\x34\x8e\xb3\xb1\x49\x6d\xe5\xb7\xde\x9c\x64\xef\x61\x55\xd3\xf5\x86\x64\xb4\xc6\x7d\x72\x88\xc2\xb2\xa5\x37\x69\x7f\x3f\x55\x64\x72\x95\x85\x89\x92\x7a\x0f\xc3\xb1\x3e\x23\x99\xb7\x0c\xca\x73\x5f\x7b\xd5\x95\x31\x11\xec\x8a\x73\xcb\x44\x92\x1f\x6e\xbd\xa8\xc8\x1b\x92\x77\xdf\x5a\x76\xd8\x3f\x0d\x08\x13\x7b\xd0\xfe\x51\xdc\x91\x6a\x9f\x16\xf7\xb7\xde\x5d\x52\x27\xbb\x94\x74\xf5\x95\x94\x19\xae\x07\xb3\x22\x2f\xea\x32\x8c\xc8\x64\xfc\xf5\x3d\x2f\x93\x59\x2b\x93\x56\xe2\x1d\xf6\x2e\x8c\x3e\x1e\xaa\xe2\x94\xc7\x7e\x54\xa4\x45\x75\xeb\x35\x55\x98\xd7\x65\x58\x91\xbc\x91\xba\x34\x26\x51\x51\x85\xad\xcc\xfc\xfa\x63\x52\xde\x7a\xc5\xee\x5f\x24\x6a\xea\x9e\xe0\x6d\x18\x35\xc9\x5d\xdb\x87\xb7\xc7\x96\xe7\xae\x82\xe2\xd4\x74\x8a\x74\x9f\xc4\xcd\x71\xe8\xed\xdd\xae\xfa\xa5\x49\x9a\x94\xfc\xda\x73\x51\x54\x31\xa9\xfc\x5d\xd1\x34\x45\x76\xeb\xe5\x45\xde\x71\x2d\xd4\x79\xdb\x8f\xa4\x96\x9c\xbe\xd4\x8b\x8b\xa6\x21\x71\xaf\x6f\x93\xab\xba\xa9\x8a\xfc\x30\x0a\xeb\x9e\x0a\x37\xc9\x8f\xa4\x4a\x1a\x13\xd8\xae\x48\x63\x52\x75\x50\x51\xd1\xea\xe6\xc7\x5d\x3c\xb9\xaa\xc3\xac\x7c\x94\xfc\xe3\x7d\x0e\xd4\xb5\x79\x48\xc9\xad\x97\x34\x61\x9a\x44\x5d\x71\x16\x56\x1f\x15\x3d\xf4\xe7\xfd\xbe\xd3\x0b\xf6\x67\x10\xf4\x52\xad\xb3\x30\x4d\xc5\x21\x70\x43\xc7\x5d\x7d\x6a\x9b\x78\x2a\xc5\xf2\xcd\xea\x95\x34\xd8\x3b\xea\x65\x51\x27\xbd\x5c\x2b\x92\x86\x6d\xc7\xb6\x5f\xef\x48\x3b\x50\xc3\xd4\x0f\xd3\xe4\x90\xdf\x7a\xbb\xb0\x26\x7d\x87\xf4\x75\xd0\xee\xec\xfb\xd1\x0f\xa6\xf3\x15\x6d\x2e\xab\xba\x29\xca\xae\x80\x7d\x0f\x4f\x71\x52\x4c\xae\xee\x92\x98\x14\xfc\xd0\x4b\xf2\x8e\xa9\x71\x04\x76\xa0\xb7\x79\xd1\xbc\xf9\xa5\x1d\x2e\x55\x91\xd6\xbf\xbe\xe5\x71\x98\xe6\x80\x96\xfc\x71\x75\x95\x64\x07\xa8\x66\x54\xd8\x3d\x6c\xcb\xda\xdd\xa1\xa3\x7a\x5b\x15\x45\xd3\x13\x1c\x87\xdc\x31\x89\x63\x92\xf7\x3a\x72\x6a\x9a\xd6\x5a\x24\x79\x79\x6a\x26\x57\x45\xd9\xb4\xfd\x52\xb6\x76\x29\x25\x51\x33\xb9\x6a\x35\x32\xac\x48\x68\xb2\xac\x50\x1b\xa8\xe5\x44\x8d\x2d\x6f\x22\xb9\xea\x05\x2e\xa1\x61\x60\x70\x3d\x57\xbd\xd0\xdb\xa1\xd2\x0d\xec\x7d\x51\x65\xa0\xe9\x0c\xb6\x9b\x0f\x7e\x69\x1e\x4a\xf2\xe1\xba\xff\x76\xfd\xeb\xe4\x8a\x7e\xa8\x48\x4d\x1a\xf0\x77\x7d\xda\x65\x49\x73\xfd\x2b\x67\xef\xc2\xb2\x24\x61\x15\xe6\x11\xb9\xf5\x7a\x0a\xa0\x82\xdb\x5b\x3f\x2b\x7e\xf3\xf7\x45\x74\xaa\xfd\x24\xcf\x5b\x8b\x2e\xd4\xa6\x01\xa1\xf5\x6b\x20\x18\x47\x32\x88\xb2\xdf\x3d\xaf\x0c\xe3\xb8\xb3\xbd\x50\xbc\x80\x40\x95\xe4\x07\x99\x4d\x45\x39\xe3\x51\x51\x3c\x30\xc8\x97\x43\x0b\x79\xeb\xcd\xca\x33\xb5\x5c\xde\x5f\xba\xea\xfe\x8b\x9c\x1b\x3a\xf7\x90\x34\xae\x49\x03\x5a\xd3\x83\xd7\x45\x9a\xc4\xde\x9f\xa3\xa0\xfd\x1f\xa7\x32\xde\xbc\x3c\xf3\xad\x9c\x2e\x56\xed\xe4\x37\x5d\xcf\xfb\x9f\x1b\x36\x04\x53\x72\x20\x79\xac\x9c\xbd\x06\xe3\xac\x9c\xbc\x78\x08\x6a\x96\x06\xeb\x0a\x86\x67\x13\x76\x4a\xda\xb2\x79\x66\x33\x02\x1b\x01\xb0\x3b\x3c\xef\xfe\x98\x34\xc4\xef\xec\xe7\x2d\x75\x7b\xe8\xac\x57\x1c\x2a\x52\xd7\x3a\x53\x61\xb0\x52\xdc\x28\x1d\x87\x50\x78\x6a\x8a\xae\x9c\x76\x59\x74\x24\xd1\xc7\x5d\x71\x86\x03\x21\x8c\x93\x42\xd0\xfb\xc7\x48\x4a\xd0\x40\x5a\x4b\x7e\xca\x76\xa4\xea\x94\x99\xd6\xd1\x69\xb2\x5f\x97\x49\xee\xb3\x01\xab\x84\x2d\x4e\x0d\x0f\xdb\x71\xcb\x2c\x8b\xd8\xc8\x9a\x84\x55\x74\xd4\x0c\xe5\x56\x58\x9d\xf6\xbd\x07\x73\x79\xb1\xdf\xd7\xa4\xb9\xf5\xfc\x39\xf5\x7d\x04\x6a\x23\x37\xfd\x17\x3f\x6a\x89\xa5\x22\xf3\x4a\xf0\x71\x52\x57\xf2\x35\x58\xb0\x11\x79\x9f\xa4\xc4\x3f\x95\x69\x11\xc6\xb0\xe9\x3a\x03\xd5\x9b\x62\xde\x13\x88\x49\x13\x26\x69\x3d\xb9\xca\x48\x7e\x52\x39\x83\xf5\x29\xcb\xc2\xea\x81\x2f\x4e\x93\xba\xf1\x93\x86\x8e\xaa\x28\xcc\xef\x42\xad\x9e\x76\xba\x98\x95\x69\xd8\x10\x6c\x16\x6b\xe5\xda\xcf\x3e\xbf\x2a\x8a\xff\x2d\x23\x71\x12\x7a\x65\x95\xe4\xbd\x69\xf8\x61\xd2\xfe\xe7\xf6\x76\x47\xf6\x45\xeb\xcf\x76\x7f\x84\xfb\xce\x93\xf6\xbc\xf2\xf6\x76\x9f\x54\x75\xe3\xa7\xa4\xa1\x9f\xe2\xe4\x4e\xfe\xd8\xb1\xf7\x7f\x4f\x45\x43\xe4\xb2\x34\x91\xbf\x8d\x74\x93\x9c\x88\x54\xe9\x27\x84\x26\x2d\x01\x14\x5b\xd7\xed\xf7\x6e\xe4\xf4\x01\xc4\x31\x8c\xdb\x91\xd9\xb6\xd7\xfb\x53\x92\x95\x45\xd5\x84\xbd\x57\x2a\x8c\x3f\x1d\x20\x37\x0c\xd5\x80\x7f\x5c\x79\x5e\xd8\xf2\x13\xde\xb6\x93\x69\x6b\x84\x01\x2b\x4a\x27\xb4\x43\x1b\xbd\x59\x2a\x6d\x8a\x4a\x9d\xfa\x5b\xef\xda\x7b\x73\xed\x85\x4d\x53\xbd\xe9\xc0\xde\x7a\xd7\x6f\xaf\x19\x36\xf3\xe3\x05\x9b\x57\x56\xc4\xbf\x6f\xc3\x4e\x99\xcd\xb2\x12\x44\x4a\xf1\x91\x89\x61\xbb\xdd\xf6\x52\x28\xc3\x03\xf1\x77\x15\x09\x3f\xfa\x49\xde\x86\x58\xb7\x5e\x78\x57\x24\x31\xa3\xd9\xb4\x81\x16\xa5\xc3\x9b\x6b\xbf\x0f\xc1\xfc\xce\xd7\x19\xc0\xbb\x8e\x67\x7e\x95\x99\x7c\xd9\x82\x1f\xe7\xdd\x7f\x17\x14\xa7\xa8\xca\x63\x98\xd7\xb7\xde\xa2\x67\xf1\x3e\x89\x8b\x7b\xf6\xe7\x1f\x32\x3c\xa8\xa3\x13\xb2\x50\xc5\x34\x0f\xef\x76\x61\x25\xb6\x81\xcd\xf6\x1d\xc8\x2e\x8c\x0f\x1a\x69\x75\x8e\x34\x05\xed\xda\xce\x81\xb6\xee\x77\x1a\x96\x35\x69\xa3\xb5\xfe\x37\xa4\x73\x28\x62\x13\x4f\xc0\x1f\x47\x46\x08\xf3\xe5\xf7\x4a\x2a\x7e\x5f\x31\x89\xbd\xe6\x38\xc1\xbe\xc6\xea\xb6\xc4\x71\x2c\xd1\x6d\xa3\x54\x16\xf8\x3f\x7e\xfe\xfa\xe3\xea\xea\x87\xc9\x15\xb0\x36\x3f\x40\xed\xc7\xe8\x03\x97\x00\x23\x0e\xad\xf0\xbf\xf9\x59\xed\xdf\x25\xe4\xbe\x6d\x40\x47\x90\xba\x0b\x31\xb9\x4b\x22\x1a\x4e\xbe\xe7\x5b\x94\xd5\x3e\x9b\xce\x99\xaf\x57\x47\x55\x91\xa6\xbb\xb0\xe2\x42\xd9\xb0\xf4\x8f\xc9\xe1\x98\xb6\x73\x22\x1a\xf6\xc2\x3c\x05\xe7\xc7\xb7\xb3\x48\x4a\xfc\xfa\xa1\x6e\x48\x36\xf1\xfa\x9f\xfe\x29\x99\x78\x7f\x49\x93\xfc\xe3\xcf\x61\xf4\xcf\xee\xd3\xff\x2a\xf2\x66\xe2\x5d\xff\x93\x1c\x0a\xe2\xfd\xf7\xdf\xaf\x27\xde\x7f\x16\xbb\xa2\x29\x26\xde\xf5\xff\x26\xe9\x1d\x69\xbd\x13\xef\x3f\xc8\x89\x5c\x4f\xbc\x7f\xaf\x92\x30\x9d\x68\x42\x84\xaa\xcf\x62\x70\x41\x29\x73\x89\xa4\xc8\x61\x05\x23\xc3\xf9\x76\xbe\x9b\x47\xef\x55\x51\xe4\x9e\x4e\xde\xe1\x2e\xc9\x63\x72\xfe\x70\xed\xcf\xae\x7f\xbd\xed\xbc\x53\xde\x33\x95\xec\x65\x97\x6d\x99\xb4\x23\xd4\x3b\x2e\x26\xde\x71\x39\xf1\x8e\xab\x89\x77\x5c\x83\xfc\x88\xdf\x85\x7a\xc0\x2b\x1d\xe2\xfb\xe9\xaa\xa2\x93\x64\x69\x05\x3f\x63\xe0\xc0\xd2\x4e\xfa\x3f\xe2\xb0\x09\xfd\xa2\x4a\x0e\x49\x1e\xa6\x3e\x48\x29\x44\xa7\xaa\x6e\x9b\x79\x24\x69\xd9\xe3\xc6\xf1\xe0\x38\xa2\xf4\xf9\x50\x5c\x21\x60\xa8\xa3\x45\x3a\xb9\x3a\xa5\x93\xab\x38\x75\x6b\x45\x91\x7a\x3d\xaa\xd7\x62\x17\xfd\x8f\x53\xfb\x11\x63\xae\xf7\x0d\xe3\x06\x4f\x4c\xf4\x85\x31\x86\x48\xa5\x3c\x7c\x4f\xc9\x7e\x88\x88\x85\xb9\x63\x8c\x1a\x02\x20\xec\x5e\x8e\x2c\xc9\x30\xdf\xac\xe2\x1b\x34\xef\x32\xf8\x23\x61\xaf\x3b\x13\x0f\x26\x80\x06\x02\xb3\x65\x74\x13\x99\xd2\x3a\x1d\x95\x2e\xc4\x3f\x56\x64\xff\xeb\xdb\xfe\x77\xa6\xa1\x34\xdc\x97\x23\x0c\x1d\x4f\x1a\x6a\x23\xbf\x3a\x20\xa9\x2d\x4f\x55\x31\x3f\xc8\x02\x2e\xad\x67\xa7\x4d\x68\x00\x83\x64\x2a\xb9\x8e\x65\xd3\xb6\x18\x25\x65\x49\x1c\xd3\x1c\xc2\x2f\x55\x91\x82\xa8\x97\x1b\x51\x65\x91\xe4\x0d\x4d\x88\x85\x93\xab\x36\x94\x9a\x0c\x89\x04\x01\x71\x48\x96\xa4\xe1\x8e\xa4\x63\xa6\x84\xfa\xcf\x42\xca\xa4\x4b\x2e\x17\xa7\xe8\xe8\x87\x51\x2f\xcd\x2c\xcc\x93\xf2\x94\x76\xb2\x65\xd3\x86\x0e\xa2\x75\xa9\x87\x89\x5b\x39\x6d\xe3\x06\x51\x9c\x01\x68\x76\xb8\x23\x45\x23\x35\xda\x1d\xd3\x0d\x1b\x5a\xec\xfb\x30\x58\xc7\x22\xa6\xf7\xeb\xc5\x3a\xda\xcc\x07\x5d\xa1\xb2\x6e\x07\x63\x07\xd5\x57\xe2\xf7\x3e\x53\x4f\xa6\x6f\xc6\x71\xcc\xdc\x70\x38\x6d\xc0\xde\x0a\x53\x1f\x01\x2b\x2d\x2e\x4d\x73\x20\xfa\x37\xa6\x1f\xde\xc3\xcf\xab\xf2\xdc\xa9\xd6\x30\x7d\xf6\xd9\x95\xaa\x6d\x76\xd7\xc6\x5e\xa7\xfa\x6e\xe6\x73\x4f\x42\xf7\x2a\x0d\x69\x87\xcc\xc7\xd8\xb7\x71\x52\xb7\x1d\x19\x4f\xb8\xd2\x31\x28\x1f\x00\x38\xdd\xcc\x8b\x56\x5a\x69\x71\x4f\xb3\xbf\x10\x37\x0e\x1b\x32\x68\x24\xfd\xd6\x24\x99\xf4\xad\x85\x6b\xbf\xfb\x69\x11\x85\xa9\x58\x9a\x15\x79\xa3\x0b\x98\xdb\x20\x90\xb9\x47\x5c\xeb\x2b\xd2\x4f\xe7\x6c\xd4\xc9\x89\x9d\x2c\xc9\x41\x9a\x5c\xcc\x88\x80\x64\xe0\xe8\xf0\x05\x62\x02\x47\x8c\x55\x07\xbf\x09\x4f\xb3\xa8\xa6\x0c\xe8\x7e\x0c\x1f\xad\xfa\xcf\x98\x51\x18\x0c\x64\x71\x6a\x58\x22\x53\x1d\x20\xab\x23\x60\x3b\x7f\x64\x72\x35\x6d\x0b\xa6\x6d\xc9\xb4\x2d\x9a\xb6\x65\xd3\xb6\x70\xca\x7b\x2b\xe3\x10\xe6\x84\xc0\x3c\x3f\x60\xf3\xb9\x49\x78\xd5\xc7\x0c\x62\xfe\x16\x4b\x84\x51\x26\xa7\xc8\x3a\xd5\x38\x3e\x7b\x46\xe7\x12\xc4\x50\xde\xb5\x61\x21\x96\xcf\x98\xe1\x69\x41\xba\x16\x2e\x65\x90\x11\xa2\x6b\xfe\x4a\x86\x98\x8f\x20\xeb\x51\x42\x92\x33\xfa\xc7\xd5\xd5\x34\x65\x01\x23\x46\x40\x10\xd2\x82\xae\x50\x4c\x69\x0f\xfa\x92\x04\xd6\x4a\x34\x4c\xb6\x90\x94\x24\xaa\xd5\x54\xcd\x83\x89\x98\x24\xd7\xe5\x23\x88\x49\x3d\xb0\x70\x27\x46\x57\x15\xa1\x37\x30\xe3\xdd\x3a\xd1\x21\x00\xa6\x61\x98\x02\x7b\xbc\x21\x38\xac\x0e\xbb\xf0\x4d\x30\xf1\xe8\xff\x4d\x67\x6f\xc7\xf5\xa3\xc9\xd5\x54\xbd\x8e\xa4\x08\x42\xe8\x72\xd5\xe4\x6a\x3a\xac\x5a\x81\xb4\x33\x5d\x84\xc5\x22\x90\x68\x7f\x43\x16\x54\x9d\x92\xba\xf1\x4f\x79\xe7\x7d\xc7\xdc\x9c\x3b\xf8\xad\x5e\x9f\x61\x13\x97\x6f\x7a\xd4\xde\x72\x5c\x8c\xd8\xe5\xed\x0c\xb6\x48\x82\xef\x57\x8d\xd2\xb0\x6e\xfc\xe8\x98\xa4\xf1\x5b\xd8\x57\x15\xb5\x0f\x34\x45\x3a\x4d\xf2\xa4\x49\xc2\x34\xa9\x33\x51\xb4\xdb\x5e\xb4\xe2\x22\xcd\xa9\x2c\x49\x15\x85\x35\x65\x56\x70\xd9\x81\x80\x5b\xa5\x32\x69\x05\x3e\x48\xa9\x7a\x50\x41\xf5\xdf\x59\x02\x81\x44\x84\x90\xbd\x58\xb7\xdf\x2f\xcc\x2b\xe6\x1a\x59\x5f\x44\x2f\x08\xa5\xc6\x52\x08\xd4\xc7\x66\x49\xb3\xff\x33\x0f\x66\x4b\xef\xff\x04\xc1\xbf\x07\xd7\x12\x66\x45\xee\x48\x55\xf3\x1d\x4e\x45\x3e\x13\x9c\x33\xa0\x07\xd0\x9d\xea\xa0\x81\x14\x28\xb6\x42\x0c\xa2\xb0\x94\x0c\x59\x37\x4f\xdd\x26\x8c\xc4\x98\x59\x01\x02\x6a\x45\xe3\x75\x62\xa2\xc4\x92\xec\xe0\xef\xd3\x53\xc2\x02\x42\x69\x95\x45\x5a\x08\xe8\x50\x9a\xe3\x29\xdb\xe5\x61\x92\x4a\x63\x77\xd0\x14\x45\xfe\x40\x95\x7b\x82\x72\x0d\xe3\xe4\x54\x73\xd4\x86\x4c\x4c\xab\xed\x74\x95\xb9\xb5\x39\xc1\x74\x5e\x7b\x24\xac\x89\x9f\xe4\x7e\x71\xea\x37\x20\x14\x56\x60\x36\x30\x56\xf2\x00\xd1\x93\xc6\x14\xf4\x50\x3e\x0b\xa5\x94\x3e\x04\x6f\xd4\x39\x5c\x18\x5f\xc8\xd6\x00\x19\x38\x60\xf7\x41\x97\x02\xc6\xb7\x20\xfc\x4c\xf2\xb4\x98\x78\x3f\x17\x79\x18\x15\x13\xef\xaf\x45\x5e\x17\x69\x58\x4f\xbc\xeb\x7f\x24\x3b\x42\x97\x55\x7e\x2e\xf2\xe2\x7a\xe2\x5d\xff\xb5\x38\x55\x09\xa9\xbc\xff\x20\xf7\xd7\xdc\x96\x05\x5a\x9d\xa4\x0f\x55\xb7\x74\xb8\x94\xad\x8a\xc0\xf6\x2e\x5e\xce\x96\x1b\x95\xe6\x6c\xf6\x9b\xfd\x56\xa7\x22\x5d\xfa\xe1\x27\x0f\x61\x01\x5f\x61\x94\x2b\x81\x5e\xd7\xc7\x5d\x7c\x71\x43\x98\x96\xcb\xad\x00\xc9\x35\xa9\x15\xac\x11\x6d\xcd\x72\xed\x8a\x3d\x00\x78\x72\xa7\x14\x95\x51\x8c\xf1\x8c\x29\x02\x75\xe3\x58\x13\x68\x35\x0a\x71\x03\x7c\x20\x72\x9b\x4e\x10\xf6\x12\x89\x72\xea\x87\x43\x59\x11\xbf\xcf\xd3\x0e\x91\x7b\x3b\x50\xd9\xa0\x59\xf4\x3b\xac\xc6\x4c\x87\xff\xc0\xf2\xba\x3d\x7e\x6b\x0f\xc3\x84\xad\xf5\xe3\xdb\x56\xb8\x14\x58\x3f\xd6\xc5\x89\x9a\x7d\x15\x27\x93\x15\xb7\x8e\x4e\x49\xcc\xd8\xb4\x4e\x97\xe0\xde\x80\xd8\x6d\xb5\x59\x97\xe7\xde\x15\x10\x78\x53\x12\xc7\xc9\xd3\x94\x3d\x52\xc5\x66\x7d\xf3\xa9\xab\xd8\x6e\xe7\x9f\xba\x8a\xd9\x3c\x08\x3e\x75\x1d\xba\xce\x60\x20\x4c\xc1\xd0\x09\xe2\x92\x2e\x60\x20\xf3\x4b\x08\xeb\x04\xcf\x40\xd6\x97\x10\xd6\x8a\x9b\xc1\xcc\xcc\xc2\x18\x71\x81\xab\xf1\x4c\x87\x1d\xe0\xf0\xd3\x0e\xbe\x4f\x51\x11\xaa\x09\x9f\xa2\x22\x5c\x33\x1e\x5d\xd3\xb4\x2a\xee\xf9\xa9\x0b\x2c\x0a\xbe\xc7\xbe\xef\x53\x22\x14\x64\x75\xf7\x51\x44\x60\x80\x10\xb1\x5b\x2e\xbf\xf5\xda\xff\xb2\x54\x31\x43\x47\x8a\xda\x7f\x48\x11\xaf\x9c\x3e\x6b\x0f\xa7\xc8\xbe\xad\x1a\xb2\xf6\x2b\xc9\xe2\x84\xed\x54\xef\xc9\x89\x43\x75\x7b\x72\xe2\x9c\x8a\x3d\x8e\xfa\x34\x2f\xfc\xc3\xa9\x69\x48\x55\x23\xf1\x76\x20\x75\x17\x75\x33\x00\xd6\x4f\xad\x96\xa7\x13\xe1\xdb\x2f\x51\x1a\xd6\xf5\x0f\x1f\xae\xa3\x22\xf5\x69\x02\x53\x50\xfb\x00\x0d\x2b\x7b\x9b\x98\xfa\xb3\x49\x47\xd7\x9f\xd3\x9f\x0b\xfa\x73\x49\x7f\xae\xe8\xcf\x35\xfd\xb9\xa1\x3f\x6f\xe8\xcf\x2d\xfd\x39\x0b\xd8\x2f\x8c\xe2\x8c\x92\xa4\x7f\xd6\xd9\x50\x57\x9d\x0d\xd5\xd5\xd9\x50\x63\x9d\x0d\x95\xd6\xd9\x50\x6f\x9d\x0d\x55\xd7\xd9\x50\x7b\x9d\x0d\x0c\xd4\xd9\xc0\x43\x5b\x45\x00\x7e\x07\xf5\xcd\xc6\x0a\xe9\x2f\x59\x3c\xf0\x93\xc5\x03\x3f\x59\x3c\xf0\x93\xc5\x03\x3f\x59\x3c\xf0\x93\xc5\x03\x3f\x59\x3c\xf0\x93\xc5\x03\x3f\x59\x3c\xf0\xd3\x56\x11\x80\xdf\x41\x7d\xb3\xb1\x42\xfa\x4b\x7a\x18\xf8\x49\x0f\x03\x3f\xe9\x61\xe0\x27\x3d\x0c\xfc\xa4\x87\x81\x9f\xf4\x30\xf0\x93\x1e\x06\x7e\xd2\xc3\xc0\x4f\x7a\x18\xf8\x69\xab\x08\xc0\xef\xa0\xbe\xd9\x58\x21\xfd\xe5\x3c\xea\xc6\x79\x54\x8f\xf3\xa8\x21\xe7\x51\x49\xce\xa3\x9e\x9c\x47\x55\x39\x8f\xda\x72\x1e\x15\xe6\x3c\xea\xcc\x19\xa8\xcd\x19\x68\xce\x79\x54\x1e\xff\x9c\x6a\xa6\x6a\x61\xa6\x6f\x87\xee\x10\xb6\xf2\x53\xf1\x13\xcd\xd0\xdf\xc7\xcb\xf7\xf1\xf2\x22\xc6\xcb\x27\x72\x1c\xbf\xeb\xff\x77\xfd\xff\xb6\xf4\x9f\x8f\x67\xbe\xeb\xff\x77\xfd\xff\xb6\xf4\x5f\x08\xb3\xbf\x0f\x80\xef\x03\xe0\x2b\x1e\x00\x2d\x05\x6e\x13\x50\x97\x6d\xd9\x85\x75\x52\xd3\x40\x9a\xcb\xd0\x94\x15\xd9\x93\xaa\x22\x31\x5d\x69\x08\xa4\x44\x0d\x44\x85\xbb\xcb\xdb\xc2\x6e\x6d\x4d\xa8\xea\x50\x15\xf7\xf4\x3b\x5f\x53\x17\xff\xdc\x11\x50\x36\xd4\x01\x70\xa4\xf4\x27\x0b\xf3\xbb\xcd\x70\xe2\x16\xf7\x9e\x89\x40\x64\xa2\xdf\x79\xc9\x72\x9c\x90\x0d\xb9\x84\x31\xc1\x97\x50\x16\xc6\xf5\xc9\x6e\x78\xbb\xd5\x7f\x33\x5d\x74\xff\x5e\xe1\x4c\x48\xc5\x3c\x27\x5c\x31\x90\x0a\xf8\xce\xf8\x9a\xbb\xf1\x35\x5b\x4f\xd7\xed\xbf\x8d\x82\x31\xb9\x9c\xe7\x8c\x2f\x87\x1d\x06\x0a\x18\x6f\x0b\x37\xde\xe6\x2b\x05\x53\xa0\x80\xe7\x86\x16\x00\x36\xba\x2f\xac\xfe\xa5\x5b\xfd\x8b\x85\xbe\xd3\xe4\x72\x9e\x1b\xbe\x1c\x30\x05\x0b\x18\x6f\x2b\x37\xde\x96\x33\x7d\xbf\xc9\xe5\x3c\x6f\x7c\x39\xe0\x0d\x16\x30\xde\xd6\x6e\xbc\xad\x02\x05\x53\xa0\x80\xe7\x86\x16\x00\x36\x56\x70\xb8\x6f\x1c\xeb\x37\x0c\x36\xb9\x5c\xe0\x46\x35\xdc\x56\xc8\x78\xbb\x71\xe3\x6d\x6d\x18\x6f\x72\x39\xcf\xdb\x5a\x35\xde\xd6\xc8\x78\xdb\xba\xf1\xb6\x51\x8d\xb7\x8d\x6a\xbc\x6d\xa4\xf1\xb6\x81\xe3\x6d\x16\x38\x1a\x49\xc3\x80\x93\xcb\x05\x33\xa9\x1a\x70\x37\xc8\x80\x9b\x39\x5a\xf0\xad\x61\xc4\xc9\xe5\x3c\x73\x5b\xd5\x88\xdb\x22\x23\x6e\xe6\x6a\xc6\x03\xd5\x98\x83\x25\x82\xe9\x0e\xa4\x51\x37\xce\xb2\xe5\x29\x4d\xfd\xbe\xfb\x2a\x71\x7b\x4e\x57\x36\x83\x65\xc2\xb8\xe8\x00\xe6\x10\x40\x9c\x0d\x3a\x88\x05\x84\x18\x0c\x75\x57\xb4\x84\x45\xa2\xb9\xec\x20\x56\x10\x42\x34\x5a\x1d\xc4\x1a\x42\xac\xb8\x86\x6d\xb8\x22\x8c\xfb\x1b\x08\x21\x8e\xad\x0e\x62\x0b\x21\x36\x1c\xf7\x33\x4e\x70\xa2\xf2\xf5\x20\x9c\xfc\x44\x15\xe8\x41\x78\x09\x82\xae\xa9\x8f\xb4\x6b\xe0\xaa\xee\x50\x34\x03\x45\x52\xd3\xea\x23\xed\x18\xea\x2e\x4a\x2d\xab\x8f\xb4\x5f\x7a\x00\xd0\x2d\xf5\x91\x76\x4b\x5f\x22\xf7\x4a\x7d\xa4\xbd\xd2\x03\xc8\x9d\x52\x1f\x69\xa7\xf4\x00\x2b\xae\x45\x1b\x58\x82\xf1\x7d\x03\x00\xe4\x1e\xa9\x8f\xb4\x47\x7a\x80\x0d\xc7\xf7\x0c\x8a\x4b\xee\x8f\x16\x02\x4a\x4d\xee\x8e\x16\x82\x93\xdb\xd0\x1b\xfd\x41\x7c\x2a\x74\x6e\xd1\x4b\x68\x03\x05\x9c\xcb\x80\x62\x27\x50\xc8\x85\x0c\x39\xf4\x06\x05\x59\xca\x20\x62\xb7\x50\xc8\x95\x0c\x29\xf6\x0f\x85\x5c\xcb\x90\x2b\xa1\xb1\x1b\x04\x04\x6f\xed\x8d\x0c\x29\x76\x1d\x85\xdc\xca\x90\x1b\xa1\xb5\xb4\x17\x79\x21\xe3\xcd\x9d\x21\xfd\x21\xf4\xaa\xc5\xea\x08\xdd\x27\x6c\x88\x9f\x6c\x23\x28\x24\x86\x52\x46\x51\xfa\x38\xca\x1c\x49\xc9\xb1\x94\x62\x33\xc9\xd0\xd2\x31\xa6\x52\x4e\x3b\xa6\xb8\xca\x14\x59\x61\xb1\x95\x10\x5d\xf1\x2c\xcd\xdc\xf9\x41\x5c\x3b\x8b\x48\x4b\x19\x6b\xa9\xa2\x2d\x9e\xcf\xb9\x3b\x9f\x58\x5c\x65\x13\x79\xa9\x63\x2f\x65\xf4\xc5\xf3\xba\x70\xe7\x95\x0b\xb7\xb4\x91\x18\x12\x8b\xc9\xd1\x18\xcf\xcf\xd2\x9d\x1f\x2c\xee\xb2\x89\xcc\xd4\xb1\x99\x32\x3a\xe3\x79\x5d\xb9\xf3\x8a\xc5\x61\x36\x91\x9a\x3a\x56\x53\x46\x6b\x3c\xaf\x6b\x77\x5e\xb9\xf0\x4c\x1b\xb9\x21\xb1\x9b\x1c\xbd\xf1\xfc\x6c\x2e\xe0\xc7\x38\x98\xf1\x48\x4e\x1d\xcb\x29\xa3\x39\x9e\xd7\x1b\x77\x5e\xb1\xb8\xcd\x26\xb2\x53\xc7\x76\xca\xe8\x8e\xe7\x75\xeb\xce\xeb\x46\x3d\x9e\x37\xea\xf1\xbc\x41\xc6\xf3\x46\x1e\xcf\x74\x92\x76\x33\xda\xc6\x01\x8d\x47\x7e\xea\xd8\x4f\x19\xfd\x09\xcc\x5e\x30\xc3\x60\x71\x9e\x4d\x24\xa8\x8e\x05\x95\xd1\xa0\xc0\xec\x25\xd3\x4c\xa0\x1e\xd3\x62\x64\x88\xc5\x86\x6a\xaf\xa1\x0b\x51\xea\xcc\x67\xdd\x2d\xec\x25\xe5\x60\x66\x3c\x8c\x3c\xee\x18\xe0\x9c\x07\x44\x66\x31\x06\xb9\xe0\x21\xe1\xc4\xc2\x40\x96\x3c\x08\x62\xd6\x19\xe4\x8a\x87\x44\x8c\x2a\x83\x5c\xf3\x90\x2b\x44\x20\x1b\x01\x44\xdd\xda\x1b\x1e\x12\x19\xe3\x0c\x72\xcb\x43\x6e\x90\xd6\xce\x84\x8e\x40\x94\x7e\x00\x15\xfa\x03\x51\xb9\x01\x54\xec\x11\x5e\x05\xea\x23\x54\x01\x7e\x8f\x31\x07\x32\xe3\x40\x30\x91\xf4\x70\x73\x0e\x0e\xed\xff\x1e\x70\xc1\x01\xf2\xdd\xdf\x43\x2c\x39\x08\xb4\xf7\x7b\xc0\x15\x07\x88\x76\x7e\x0f\xb8\xe6\x00\x57\x88\x24\x36\x3c\x84\xba\x9d\x37\x1c\x20\xda\xf3\x3d\xe0\x96\x03\xdc\x20\xed\x9c\xf1\xe2\x47\xfb\x9d\x42\xf2\xbd\x80\x76\x3b\x85\x14\xfa\x01\xf6\x3a\x8d\xb0\x40\xbf\xf3\x5b\x3d\x31\xc8\x19\x06\x29\x4b\x67\x44\x98\x63\x08\x88\x3a\x8c\x18\x0b\x0c\x03\xea\xc5\x08\xba\xc4\x40\x11\x05\x19\x31\x56\x18\x06\xa2\x29\x23\xc6\x1a\xc3\x58\xa1\xc2\xd9\xa0\xa0\x3a\xe9\xdc\x60\x18\x88\x12\x8d\x18\x5b\x0c\x63\x83\x4a\x67\x86\x76\x2b\xa2\x56\x00\x05\xed\x5f\x51\xbf\xec\x76\x64\x65\xf1\xb7\x12\x73\x67\xf1\xb3\x8b\xb9\xb3\xf8\x65\xc4\xdc\x59\xfc\x72\x62\xee\x2c\x7e\x5e\x31\x77\x16\xbf\x9c\x98\x3b\x8b\x5f\x4e\xcc\x9d\xc5\xcf\x2b\xe6\xce\xe2\x97\x13\x73\x67\xf1\xcb\x89\xb9\xb3\xf8\x79\xc5\xdc\xdd\x2e\xab\x97\x12\x73\x77\xdb\xc0\x5e\x4a\xcc\xdd\xed\x53\x7b\x76\x31\x77\x16\x9b\x63\x6e\x30\x8f\xeb\x63\x6e\x30\x91\x1a\x62\x6e\x30\x8d\xa9\x62\x6e\x30\xb3\x18\x62\x6e\x60\xd7\x0d\x31\x37\xb0\xaa\xaa\x98\x1b\x18\x3a\x43\xcc\x0d\xcc\x8c\x21\xe6\x06\x83\x5c\x15\x73\xc3\x71\x67\x88\xb9\xa1\xd6\x1b\x62\x6e\xa8\x73\xca\x98\x1b\xa8\x80\x2a\xe6\x06\x1a\xa0\x8d\xb9\x81\x02\xe8\x63\x6e\xd0\xff\x8a\x98\x1b\x74\xbf\x3e\xe6\x06\xbd\xaf\x8f\xb9\x41\xe7\x2b\x62\x6e\xd0\xf7\xfa\x98\x1b\x74\xbd\x3e\xe6\x06\x3d\xaf\x88\xb9\x61\xc7\xeb\x63\x6e\xd8\xef\xfa\x98\x1b\x76\xbb\x3a\xe6\x06\xfd\x6e\x88\xb9\x41\xf7\xdb\xc5\xdc\x40\x0f\x2c\x63\x6e\xa0\x10\xa6\x98\x1b\x68\x86\x65\xcc\x0d\x54\xc4\x32\xe6\x06\xba\x62\x8a\xb9\x81\xd2\x58\xc6\xdc\x40\x7b\x2c\x63\x6e\xa0\x46\xa6\x98\x1b\xea\x93\x65\xcc\x0d\x15\xeb\x82\x98\x5b\x3c\x05\x92\x1e\xbe\x95\x98\x3b\x3d\x3c\xbb\x98\x3b\x3d\xbc\x8c\x98\x3b\x3d\xbc\x9c\x98\x3b\x3d\x3c\xaf\x98\x3b\x3d\xbc\x9c\x98\x3b\x3d\xbc\x9c\x98\x3b\x3d\x3c\xaf\x98\x3b\x3d\xbc\x9c\x98\x3b\x3d\xbc\x9c\x98\x3b\x3d\x3c\xaf\x98\xbb\x3b\x49\xf4\x52\x62\xee\xee\xa8\xd3\x4b\x89\xb9\xbb\xb3\x58\xcf\x2e\xe6\x4e\x0f\xe6\x98\x1b\xcc\xe3\xfa\x98\x1b\x4c\xa4\x86\x98\x1b\x4c\x63\xaa\x98\x1b\xcc\x2c\x86\x98\x1b\xd8\x75\x43\xcc\x0d\xac\xaa\x2a\xe6\x06\x86\xce\x10\x73\x03\x33\x63\x88\xb9\xc1\x20\x57\xc5\xdc\x70\xdc\x19\x62\x6e\xa8\xf5\x86\x98\x1b\xea\x9c\x32\xe6\x06\x2a\xa0\x8a\xb9\x81\x06\x68\x63\x6e\xa0\x00\xfa\x98\x1b\xf4\xbf\x22\xe6\x06\xdd\xaf\x8f\xb9\x41\xef\xeb\x63\x6e\xd0\xf9\x8a\x98\x1b\xf4\xbd\x3e\xe6\x06\x5d\xaf\x8f\xb9\x41\xcf\x2b\x62\x6e\xd8\xf1\xfa\x98\x1b\xf6\xbb\x3e\xe6\x86\xdd\xae\x8e\xb9\x41\xbf\x1b\x62\x6e\xd0\xfd\x76\x31\x37\xd0\x03\xcb\x98\x1b\x28\x84\x29\xe6\x06\x9a\x61\x19\x73\x03\x15\xb1\x8c\xb9\x81\xae\x98\x62\x6e\xa0\x34\x96\x31\x37\xd0\x1e\xcb\x98\x1b\xa8\x91\x29\xe6\x86\xfa\x64\x19\x73\x43\xc5\xba\x20\xe6\x96\x4e\x9e\x0f\x27\x7b\xbf\xfa\xa0\xfb\x9c\x3e\xbb\xa0\xfb\x9c\xbe\x8c\xa0\xfb\x9c\xbe\x9c\xa0\xfb\x9c\x3e\xaf\xa0\xfb\x9c\xbe\x9c\xa0\xfb\x9c\xbe\x9c\xa0\xfb\x9c\x3e\xaf\xa0\xfb\x9c\xbe\x9c\xa0\xfb\x9c\xbe\x9c\xa0\xfb\x9c\x3e\xaf\xa0\xfb\x9c\xbe\xa0\xa0\xfb\x9c\xbe\xa0\xa0\xfb\x9c\x3e\xc7\xa0\xfb\x9c\x9a\x83\x6e\x30\x8f\xeb\x83\x6e\x30\x91\x1a\x82\x6e\x30\x8d\xa9\x82\x6e\x30\xb3\x18\x82\x6e\x60\xd7\x0d\x41\x37\xb0\xaa\xaa\xa0\x1b\x18\x3a\x43\xd0\x0d\xcc\x8c\x21\xe8\x06\x83\x5c\x15\x74\xc3\x71\x67\x08\xba\xa1\xd6\x1b\x82\x6e\xa8\x73\xca\xa0\x1b\xa8\x80\x2a\xe8\x06\x1a\xa0\x0d\xba\x81\x02\xe8\x83\x6e\xd0\xff\x8a\xa0\x1b\x74\xbf\x3e\xe8\x06\xbd\xaf\x0f\xba\x41\xe7\x2b\x82\x6e\xd0\xf7\xfa\xa0\x1b\x74\xbd\x3e\xe8\x06\x3d\xaf\x08\xba\x61\xc7\xeb\x83\x6e\xd8\xef\xfa\xa0\x1b\x76\xbb\x3a\xe8\x06\xfd\x6e\x08\xba\x41\xf7\xdb\x05\xdd\x40\x0f\x2c\x83\x6e\xa0\x10\xa6\xa0\x1b\x68\x86\x65\xd0\x0d\x54\xc4\x32\xe8\x06\xba\x62\x0a\xba\x81\xd2\x58\x06\xdd\x40\x7b\x2c\x83\x6e\xa0\x46\xa6\xa0\x1b\xea\x93\x65\xd0\x0d\x15\xcb\x14\x74\x83\xf7\xa2\xc5\x9b\x74\xe5\x69\x46\xf5\x30\xec\xf0\x5c\xf4\x64\xf8\x55\x7a\xb8\x64\x78\x5f\x52\x7c\xbd\xb3\x29\xba\x0b\xcc\xf1\x17\xbf\xb8\xd7\x9c\x58\x2d\x24\x8c\xd9\xd3\xd4\x22\x31\xf6\x10\xa5\x37\xbe\xf8\xdc\xf3\x3a\xd7\x92\xec\x1e\x4d\xfe\x91\xfe\xfc\x5d\xe0\x46\x8b\x0a\xe4\xa7\x79\xaa\x98\x3e\x80\x5d\x67\x40\x46\xdd\x5f\x92\x98\x16\xbc\x4c\xc7\x37\xb3\x7f\xc7\x9f\x2c\x92\x38\xe2\xdf\xde\x56\xbc\xbc\xed\x4a\xa8\x97\x38\x42\xae\x2f\x81\x44\xa9\xc4\x87\xf8\x92\x3d\x23\x46\xdb\xdc\x54\x49\xd9\x22\x76\x92\x6e\xaa\xdb\xbc\x39\xfa\xc5\xde\x6f\x1e\x4a\xf2\xa6\x88\xe9\x4b\x64\xb2\x24\xc5\x97\xdf\x82\xd5\x5b\x48\xb6\x7f\xe9\x76\x20\x3a\x3e\x7c\x6b\x41\x69\xc3\x93\x0a\xa3\x26\xb9\x23\x13\xfe\x4f\xef\x27\xd8\xfc\xf1\x5b\x7c\x61\x25\x3d\x83\x1c\xb9\x47\x31\xad\xa6\xf7\x53\xf7\xa2\xbb\x0d\xd8\xf1\xc2\xba\xeb\x53\x14\x91\xba\x9e\x08\x7f\xf3\x22\x03\x1f\x55\x32\xfb\x73\xbc\xdf\x07\xf1\x8d\xb2\x59\x94\x84\x56\x4e\x7f\x8e\x03\xb2\x8d\xd6\x96\x34\xd4\xb2\x91\xe0\x54\xc2\x41\x2a\x4c\xf2\x7d\x31\x81\x7f\xf0\x92\x60\x5f\xd4\x62\xd8\x92\x78\xbf\x51\x36\xa1\xc5\xd7\xcb\x20\x5a\x92\xc5\x7e\x61\x43\x40\x2d\x00\x1e\x48\xd9\x7a\xb9\xaa\xfb\xb0\xca\x93\xfc\x30\x11\xfe\xe6\x65\x00\x3e\x2a\xc5\x00\xdf\x58\x44\x18\xa4\x24\xf4\x92\xd8\x87\xfb\x79\x14\x59\xd2\x50\x0b\x43\x82\x53\xca\x43\xae\x30\x0e\xf3\x03\xa9\x26\xfc\x9f\xbc\x34\xc6\x6f\x6a\x61\xcc\x63\x12\x13\x65\x43\x7a\x0a\x7a\x59\x90\x5d\x14\x69\x64\xc1\x91\x50\x8b\x42\x04\x53\x4a\x82\xab\xae\x9d\x25\xfc\x24\xef\x1f\x09\xa4\x38\xf6\xef\x94\x8d\x24\x62\xb2\x0f\x4f\x69\x23\x92\x58\xae\x97\xe1\x32\x52\x50\x91\xe7\x35\xc6\xc8\x05\x5c\x70\xf8\xdc\xa0\xa6\x9f\x62\xf9\x13\x74\x57\xc6\x97\xbd\x31\xf7\x80\xa2\xe8\x66\xfe\x00\xc2\x57\xa4\x2e\x8b\xbc\x6e\xe7\xa2\xdf\x6d\x9e\x50\x1e\x1e\x22\x3b\x8f\x31\xa1\x9f\xd5\xfe\xf0\x9d\x3e\x34\xda\x7e\x6b\xcb\x8f\x49\x77\x47\x6a\xff\x66\xd9\x2e\xac\xf0\xba\x2d\xd8\xdd\x17\x55\xe6\x47\x45\xde\x54\x45\x6a\xc7\xaa\xf8\x4a\x28\xf0\x1f\xa5\x47\x7d\xa5\x17\x68\xe7\xab\xf7\xb6\xda\x21\xf7\x7b\x92\x85\x87\xe1\xb1\x55\x90\xd9\x01\xb8\x69\x52\xde\x82\x17\xd4\xcf\x30\x65\x63\x82\x93\x3d\x2f\xe9\x51\xdb\x76\x7a\x75\x7d\x84\x12\x2a\x16\x7c\x3d\xb2\xa3\x57\x4f\xb8\x04\x55\x7d\x0c\xe3\xe2\x5e\x86\x7a\x2f\xbc\x46\xf9\x54\x34\x85\x87\x30\x4d\x64\x9f\x92\xc5\xcf\x45\xcb\x56\x18\xe2\x60\xb8\xbd\x6d\xc7\x1a\x39\x97\x61\xae\xb2\xfd\xe8\x53\x84\xe8\xc8\x02\x6f\xe3\x3b\xe9\x3e\x6f\x93\x56\xd1\x6e\xb1\xe7\x5f\xd2\x1f\x1f\x1e\x16\x78\xa7\x6d\xee\x9e\x51\xf7\xcb\x34\x8c\xc8\xb1\x48\xe3\xe1\xb9\x57\xfe\x21\x50\xcf\x2b\xca\x30\x4a\x9a\x87\xf1\x3d\x51\x41\x12\xc5\x6f\x8f\x25\xd2\x4a\xf3\x69\xb8\x79\x24\x3e\x7b\xe4\x7f\xe2\x71\xdf\x7f\xa9\x48\x18\x17\x79\xfa\xf0\xab\x72\xde\x1c\x9e\xed\xb5\xa2\xdf\xf3\x76\xaa\xea\x16\x39\x2f\x1a\x3f\x4c\xd3\xe2\x9e\xf4\x2f\x70\xd6\x24\x25\x51\xc3\x23\xe6\x45\xf3\xe6\x97\xd6\x7a\xfe\xfa\xb6\xff\x3d\x3b\xa5\x4d\x52\xa6\xe4\xd7\x3e\xf4\x62\x56\x34\x0a\xd3\xe8\xcd\x9c\x3e\x29\xfc\x63\x1b\xc6\xbd\x55\xd2\xec\xf4\xae\x57\xe5\xbb\x30\x3d\x11\x47\x15\x14\x1b\xe7\xef\x93\xb4\x0d\xc0\xb8\x6f\x55\xeb\x78\xa0\x73\x07\xbb\xfd\xb3\x03\x4f\xc3\x1d\xe1\x1e\x03\xee\x43\xf8\xae\x35\x74\x2a\xf1\x3b\xdb\xfb\x83\x37\x7f\x0b\x26\x9a\x21\x4f\xa0\x86\x14\x9f\xca\xc5\x6a\x66\x87\x02\xb0\xca\x37\xf6\xb5\x63\xa0\x8a\xe7\xee\x45\x06\xe8\xed\x7b\x18\x03\x73\x7b\x06\x30\x50\xc0\x40\x30\xbd\xd9\x60\x1c\x90\x03\xc9\x63\xb9\xfe\xf1\x55\x61\xb1\xba\xb1\x44\x96\xae\xe2\xf9\x7e\x4e\x2b\xea\x26\x6c\x92\xe8\xe9\x6a\x44\x1d\x09\x66\x72\xfb\xe9\x1a\x7f\x1a\x96\xb9\x2f\xe5\x19\xb3\xcc\x94\x4f\xe1\x5b\x36\xf1\xa6\xbd\xb1\x6a\x47\x45\xd9\x76\xdd\x4f\x9e\x11\x6f\x72\x65\x89\x04\xa1\xc2\x38\x2e\x72\x14\x13\x7e\xd9\x35\xb9\x8a\xd8\xae\xc9\x27\x66\xde\xfa\x87\x00\x20\xc9\xf4\x70\x41\x9b\xd4\x48\xa6\x36\xf5\x98\xf6\x6d\xe2\x34\xc7\xf8\x56\x9e\x6b\xf7\x19\x05\xee\xd4\x2d\x02\xbb\xe3\x83\xef\xa3\x4a\xa3\x43\x54\xf7\xcc\x33\x62\xcd\xfd\x3a\xd3\x4d\x12\x58\xa3\x1d\xe7\x19\xac\xc1\x94\x84\x24\x1c\x57\x3a\x88\xe0\x28\xe9\x5d\xa3\x25\xc6\x4d\x7e\xb3\xe9\xcd\x0c\x98\x58\x57\x15\x37\x2a\xa5\x93\xea\x22\xfd\xde\xcf\x0f\x33\xac\xdf\x67\xe0\xe9\x7b\xb1\xdb\x17\xba\x6e\x4f\x0f\x0e\xdd\xde\x31\xfb\xb8\x6e\x87\x24\x1e\xd3\xed\x2a\xb9\x5d\xd0\xed\x8b\xe9\xac\x5b\x1d\xe1\x3b\xbe\x23\xda\xc1\x29\x97\x3f\x3a\xb8\x86\x9c\x1b\x45\x70\xcb\x3d\x78\x3e\x95\x34\xeb\x48\xa2\x8f\x7d\x17\xa3\x2f\x1c\x2a\xe9\x89\x73\x1a\x4f\x71\x3a\xf8\x88\xe0\x23\x70\x90\x64\x8f\x56\xe5\x47\xe2\xf8\xc2\x13\x2a\xa3\xde\x61\x13\x2b\x23\x5d\x16\x49\xde\x90\x4a\x22\xdb\xf5\x9f\x20\x83\x70\x57\x17\xe9\xa9\x21\x4a\x09\x7a\xe2\x6b\xab\xb8\x68\x7b\xe2\xb7\xad\xdb\xed\x47\xc7\x24\x15\x1f\x7e\xee\xa7\x03\x04\xab\xf5\x06\xf8\x1e\xed\xbf\xf9\xc0\xf3\x94\x11\x70\x69\x89\x0b\x55\x59\x12\xc7\x29\x51\xd1\xf8\xd1\x53\x70\xc2\x2f\xec\x4e\x37\x0a\x23\xb5\x27\x24\x6e\xdd\x6d\x88\xa3\xd2\x3e\x66\xf6\x87\x34\x3e\xf7\x79\xcc\xe7\x72\x9f\x69\xce\x12\x99\x3e\xe7\xc0\x00\x8d\x0e\x7f\x45\x4a\x12\x36\xad\x6a\xd1\x5f\x85\xf2\xb1\x37\x22\xd2\x2a\x48\xbf\xbb\xa1\xd5\xec\xb5\x94\xf7\x00\x68\xcc\xe6\xf5\xd6\x7a\xf8\x45\x91\x90\x51\x42\xb7\xc2\x38\x86\xf5\xb0\x4a\x81\x0b\x73\xa2\x03\xea\x7a\x5a\x84\xe0\x7d\x73\x1c\x7f\xd4\x13\x09\xfb\x54\x37\x05\x9f\x37\x03\x41\xfa\xcd\x2a\x32\x30\x8e\x65\x1c\x95\x88\x92\x09\x46\xeb\x53\xd2\xc3\x63\xd9\x70\xbf\x26\xa1\x49\xbc\xec\xeb\xef\x68\x1e\xee\x54\xa5\x6f\xae\xe3\xb0\x09\x6f\xbb\x0f\xef\xea\xbb\xc3\x8f\xe7\x2c\x7d\x1f\x1d\xc3\xaa\x26\xcd\x87\x53\xb3\xbf\x99\xbc\x5a\xfc\xb5\xbe\x3b\x78\xe7\x2c\xcd\xeb\x0f\xaf\x8f\x4d\x53\xde\xbe\x7b\x77\x7f\x7f\x3f\xbd\x5f\x4c\x8b\xea\xf0\x6e\x1e\x04\x41\x8b\xf9\xda\xbb\x4b\xc8\xfd\x5f\x8a\xf3\x87\xd7\xdd\x06\x35\xef\xe6\xf5\xab\xc5\xdf\x5e\x2d\xfe\x5a\x86\xcd\xd1\xdb\x27\x69\xfa\xe1\xf5\xab\xf9\xa2\x6f\xd4\x6b\x2f\xfe\xf0\xfa\xe7\xf9\x74\xe1\xad\xa7\x9b\xc5\x3f\xa6\x6b\x6f\x39\x5d\x2d\x22\x7f\xba\xf4\x67\xd3\x60\x39\x5d\xae\xfd\xd9\x74\xd9\x6a\x91\x3f\xbd\x49\x67\xd3\x99\xd7\xfe\xb9\x98\x2e\xfd\xc5\xf4\x26\x9a\xae\xfd\xe9\x7a\xe1\xcd\xda\x9f\xf3\x4d\x6b\x1e\xa7\x9b\xd4\x5f\x7a\xcb\xe9\xba\x25\xb1\x98\xae\xfc\xe9\x4d\x47\x6a\x36\x9d\xfd\xf6\xfa\x5d\xcf\x47\xcb\xe4\xab\xc5\xdf\xae\xdf\x8e\x42\x63\x6b\x25\x5a\x9d\xc4\x81\xa0\x56\x0d\x10\xa8\x4e\x0a\xf8\x92\x4e\x8e\xd8\x6a\x9d\xdc\x07\x61\xbc\x24\x06\xc6\xd1\x2c\xb8\x0a\x51\xaf\x93\x0c\x4d\x49\x0f\xd3\xc9\xfb\x63\xd2\x98\x78\x1c\xbe\x3e\x1f\x8d\xec\x9b\xd4\x6b\xe4\x72\xba\xf4\x56\xd3\xc5\x7c\x79\xf4\xa7\x37\x77\xfe\x7c\xba\x5c\x1f\xa7\x37\xbf\x65\x41\xab\x7f\xf3\xf6\xe3\xff\xb7\x9a\xde\x6c\xdb\x6f\x3f\x2f\xa6\x9b\xf5\x74\xbd\xf8\xc7\x34\x58\x7a\x9b\x69\xb0\x59\x45\xfe\x74\x36\x5b\x4d\xe7\xd3\x60\xb6\x9e\x2e\xe7\xab\xe9\xbc\xfd\xb1\x3e\x6e\xa6\x8b\xed\x26\x9a\xce\x97\x73\x2f\xf0\xa6\x8b\xcd\xdc\x9f\xce\xe7\xeb\xe9\x7c\x75\xe3\xb7\xe5\x7f\x5d\x4f\x37\xf3\x76\x00\x6c\xe7\x6d\xe5\xcb\x8d\x37\x9f\x6e\xb6\xde\x72\x3a\x5f\x2d\xa6\xeb\x45\x47\x75\xe1\x4f\x67\x9b\xa5\x3f\x5d\x6c\xe9\x2f\xcb\xed\xd2\x0b\x74\xaa\x4d\x27\x11\xad\x66\xa3\x30\x50\x35\x19\x00\xaa\xd7\x3c\xb6\xa4\xd6\x03\xae\x5a\xab\xe3\xed\x6a\xb1\xdc\xeb\x79\xc6\x94\x5a\x85\xa7\xd7\x69\x86\xa5\x24\x87\x67\xd1\xe2\xfd\x86\xad\x18\x2b\x65\x06\x66\xec\x4f\xae\xd3\x83\xde\xf6\x6c\x03\x25\xf7\xe7\x9e\x3f\xf7\x36\xde\x06\xaa\x79\xdd\x54\xc5\x47\xc2\x21\xb4\x8a\x1e\x78\x41\xba\xf0\x16\x59\xe0\x2f\xfe\x11\x78\x0b\xa6\x48\x51\x52\x45\x29\xf1\xaa\x0f\xaf\xa7\x2b\xe1\x5b\x74\xfe\xf0\x7a\xf1\x1a\x2f\x7a\x50\x17\xf5\x58\x18\x84\xa0\xb4\x9d\x40\x31\xbf\x10\x64\xff\xdf\x63\xdf\xf7\x29\x11\x0a\xe8\xbe\x5f\x11\x81\x01\x72\x67\x8d\xf6\x69\x71\x7f\xeb\x55\xc5\xbd\x77\x5f\x85\x25\x73\x6e\x86\x03\x47\x78\x31\xdb\x3d\x8c\x14\xc3\xb5\x0a\xea\x8e\xf6\x5e\x17\x2c\xec\x0a\xfc\xa4\x21\x59\x0d\x8b\xb9\x9a\x25\x64\xf6\x0f\x45\x16\x25\x28\x46\x3f\xe2\x73\x4a\x86\xb7\x46\x20\xa9\xd1\xcf\x56\x77\x8a\xa6\x5b\xd4\x1d\x83\x74\x8d\x41\x7e\x56\x12\xb4\x91\xa1\x42\x8a\x22\x03\x65\x18\x7d\xc4\x4b\xff\x75\xaa\x9b\x64\xff\xd0\x59\x00\x92\x37\x1a\x1e\x64\x1a\xec\x9f\x96\x06\x16\xe8\xfd\x21\x76\x8d\x18\x44\x7f\xee\x0e\xfa\xe4\xa7\xe5\x2c\x86\xaa\xd5\x60\x55\x0e\xd7\x67\xa0\x70\x0e\x3d\x0d\xe7\x44\x65\xe4\xec\xc9\xa7\x0c\xd5\x21\xb2\xa9\x2a\xb8\x0a\xa0\xa9\x51\x26\x02\xe6\x62\x8a\x8c\x9d\x7c\x54\xd7\x0b\xad\x0e\x26\xa1\x4b\x9a\x34\x58\xc3\xef\x86\xec\xf3\x19\x32\x49\x15\xb9\x94\x89\xfb\x10\x10\x72\x3f\xc8\x72\x82\x01\x77\x4c\x87\xa9\x92\x82\x3a\x1e\xd9\x42\xc6\x14\x24\x43\xf8\xc4\x11\xce\x00\xe2\x06\x7f\xd7\xc2\xcf\xa7\x85\x56\x4a\xc2\xf7\x91\x9f\xe4\x71\x12\x85\x4d\x51\x49\xca\xc2\xb2\x9a\x06\x2b\xac\x51\x19\x69\xa3\x3e\x39\x37\xfe\xb8\xc1\x5e\xe6\xad\x8d\x3d\x86\xcc\xa3\x26\x21\xe9\x79\xa3\xd2\xf6\x87\x10\xd8\x1a\x87\x92\xd1\x6e\x85\xe3\x9e\xe6\xe9\xf3\xa2\xca\xc2\x54\xb9\x5e\xdb\xf1\x29\xe9\x41\x17\xf9\xfb\x75\x19\x46\xdd\x8e\x16\x36\xb9\xaa\x2d\x34\xeb\xf7\x53\x4d\x2a\xbf\x5f\x4d\x18\xb7\x85\x75\xdd\x5d\xfc\xa6\x2c\xec\x94\x41\x59\xd8\xfe\x43\x0b\xe5\xdd\x61\xc2\x72\xb3\xb8\x2f\x6e\xa6\xde\x14\xe7\xb6\x81\x2c\x4c\xd3\x16\xa4\x86\xbb\x96\xde\xcb\x9b\xb7\x54\x60\x66\x18\xda\xcd\xfd\x66\x91\x49\xb7\xac\x05\x36\xac\x76\x7d\x16\x93\xa8\xa8\xc2\x9e\xc8\xb8\xe7\x48\x40\x9a\x8e\xbb\x9c\x86\x0d\x4a\xd2\xd3\xea\xfd\x0e\xac\xde\x53\x0b\xba\x03\x1c\xdd\x2e\xbb\xf9\xc4\x9b\xcd\x36\x13\x6f\x3e\x5b\x4f\x3a\x81\xbc\xe5\x32\xc5\x6e\x88\x94\xb7\x29\xd8\xea\xd3\xb2\x6a\xde\x99\x03\xb6\xf6\x4c\xd7\xab\xb1\x91\xf4\xe4\x41\xdf\x4a\x7a\xc6\x00\x0f\xd7\x07\xe1\x84\x3c\x07\x57\xfb\x84\xa4\x71\x4d\x9a\x5f\xd8\xa7\x5f\xbd\x70\x5c\x40\xec\xd7\x5e\x7c\x72\x47\xf2\xa6\x16\x44\xec\x97\x55\x92\x85\xd5\x83\xe5\xae\xd8\x60\xbe\x59\xc5\x37\x48\xa2\x82\x15\x08\x64\x41\x57\xdb\x10\x5f\x85\xe1\x0a\x23\x3e\x5b\x2d\xb7\x3b\x99\x38\x50\x0f\xf6\x0d\xa8\x89\xbb\x62\x5c\xaa\x17\x40\x2d\x06\x3e\x78\xf5\x18\x58\xe6\xd4\xe4\x29\x04\x0c\xb5\x67\xa8\x7c\x38\xcc\x52\x1f\x8b\x7b\xba\x98\x3c\x72\x56\x15\x65\x5c\xdc\xb7\x2e\xc4\xe1\x90\xda\xee\x87\x06\x9d\xa3\xdc\x34\x6b\xe8\xb6\x9a\x44\x45\x1e\x8b\xca\xc6\x36\x5a\x3b\xec\x55\x1c\x76\x98\x73\x54\x11\x5d\xd3\xd2\x26\xeb\xf6\x7f\x08\xf9\x30\x6e\xff\x87\xd5\x00\x15\x6e\xf8\xea\xa8\x72\xc1\x72\xe2\x8d\xff\x71\x51\x3a\x0c\x53\xe2\x51\x54\xbc\x91\x79\x93\xea\x39\xc9\x9a\x53\xbb\xb1\x72\x54\xf1\x00\x6f\x1a\xd5\xb3\xed\x2b\x5b\xed\x13\x7b\xb1\x3b\x80\x63\xa7\xeb\xab\x5d\x14\xc4\x18\x4d\x56\x00\x69\x3a\x99\xb8\xc5\x6c\x17\xc4\x98\x89\x9b\x87\xe1\x2e\x9e\x0b\x94\xa1\xba\xb5\x1f\xdc\x34\x6d\x3b\x9b\x78\xb3\xed\x7c\xe2\xcd\xe7\x73\x27\x45\xc3\x10\x21\x5f\xa2\x8a\x75\xbc\x9a\xb4\xcb\x4d\xa6\x9c\x7a\x75\x75\xa2\x9a\xd5\x73\x73\x91\x3d\x03\x3d\x61\xab\x51\x62\x1f\xc1\x15\x4c\x0b\xa5\x32\xad\xa5\x0a\x64\x9d\xf4\x6a\xb9\xdc\xc6\xcb\x25\x42\x7c\x39\xdb\xae\x97\x33\x99\x38\x67\xc9\xfa\x6f\x8e\xda\xd5\xce\x81\x37\xcb\x89\xb7\x75\x54\x2e\x19\x4f\xe0\x4d\xb2\x60\x94\x65\xa3\x86\xb9\x0a\x98\xb7\x61\xb4\x72\xdc\x82\x31\xce\x2e\x52\x35\xd0\x39\xb6\xaa\x26\x76\x1b\x5c\x9a\x34\x57\x68\x5c\x22\x15\xc8\x3a\xa9\x1a\x89\xb6\x9b\x19\x36\x53\x90\xdd\x76\x31\x5b\xcb\xc4\xa1\xaa\xd1\x6f\x8e\x53\xe6\x32\x98\x78\xb3\xcd\x62\xe2\x6d\x6e\xdc\x66\x4c\x04\x51\xe0\x4e\x54\x36\xc6\xb4\x71\xb2\x74\x15\x31\xa7\x6c\xac\x72\x54\xd9\x06\xce\x2e\x52\x36\xd0\x3d\xb6\xca\x26\x76\x1c\x58\x32\x34\xd7\x67\x5c\xba\xe4\xa9\x3a\xa9\x5a\xb4\x5d\x04\x73\x6c\x44\x47\xb3\x39\x99\x87\x12\x6d\xa8\x69\xfd\x27\x47\x45\x6b\x7d\xfa\x9b\x56\x5d\xb6\x6e\x7a\x26\xe3\xf1\x9c\x89\x5a\x46\xf9\x35\x29\x99\xab\x6c\x39\x1d\xa3\x35\xa3\x2a\xc6\xb8\xba\x48\xc3\x40\xaf\xd8\x6a\x98\xd8\x5f\x34\x82\x47\x83\x4f\x10\x01\xa9\xc9\xdb\x1d\x97\x52\x06\x4f\x42\xfd\x8e\x51\xaa\x65\x84\x26\x56\x02\xb5\x53\x28\xfb\xe2\x51\xab\xc8\x8f\xa0\xaf\x62\x53\xf8\x64\x87\xae\xe3\xb0\xee\x51\x09\x88\x53\x5f\x91\x23\x54\x8f\x25\xb6\x2f\x0c\x6d\x1d\x7b\x14\x0f\x64\xbb\x48\xe9\x29\xb5\x96\x0b\xbd\xa4\xba\xdd\x2c\x69\x84\x9a\x51\x7d\x05\x98\xc2\x3e\xaf\xa8\x57\xe6\x4a\xa1\xb8\x8a\x28\x58\xdd\x75\x36\x7a\xab\x88\x86\x65\xa6\xb4\xba\x6b\x17\x1d\x3f\x49\xd7\x4a\x21\x30\x08\xca\x9e\x4c\x6b\xc5\x78\x0e\x56\xee\xa4\xb3\xd6\x11\x23\x57\x03\xa6\xb4\xcf\x24\x76\xe6\xd8\x51\x28\xaa\x1c\x4b\x6b\xfb\xca\x46\x4d\xe5\x80\x9a\x63\x44\xab\x9c\x8f\x08\xb0\x9d\xbb\x0f\x0b\xa7\xd1\x7d\xc6\x8f\x55\x50\x21\x1c\x14\xea\x77\xd3\x51\xdb\x98\x53\xac\x04\xb5\xad\xcf\x24\x0e\x17\xf9\x51\xd9\x54\x2c\x2e\xb7\xd9\x20\xae\x37\xaa\x58\x70\x2e\x72\xa4\x37\xa8\x8f\x0a\xd6\x9d\x7b\x14\x0d\xcd\xb1\xad\xc8\x8f\x54\x5b\x31\xb0\x14\xea\x77\x52\x5b\xeb\xe8\x55\xac\x04\x53\xdb\xe7\x13\xd3\x8b\x1c\x29\x14\x17\x8d\xf1\x6d\x76\x91\x6b\x15\x17\x0d\xf4\x45\x8e\xb4\x8a\xfb\xb8\xc0\xdf\xb9\x4f\x91\x30\x1f\xdd\x6e\xfc\x48\xbd\x15\x63\x55\xbe\x7a\x27\xb5\xb5\x8e\x87\x85\x3a\x30\xad\x7d\x26\xf9\x01\x81\x1d\x85\xc6\x62\xf9\x02\x6d\x9f\xd9\x28\x2c\x96\x34\x10\xd8\xd1\xaa\xeb\xa3\x92\x08\xd6\x5d\x99\x26\x79\xbf\x7b\x44\xb1\x15\x44\x19\xc4\x0d\x1b\x20\x78\x5a\xb4\xa1\xed\xaf\x7c\xd3\xdb\x2f\x53\xe9\x8b\x29\x47\x83\x4a\x58\xac\x07\xea\x1f\xa8\x18\x39\x3f\xa0\x24\x07\x2f\xf9\xb2\xc4\x90\x6a\x95\x87\x5a\x30\x5b\x46\x37\xd1\x7b\x6c\x23\xc6\x29\x8f\x49\xd5\xf6\xb3\x83\x66\xc9\x02\x63\x15\xb1\xc3\xa6\x28\xa4\xcc\xe9\x50\x62\xbb\x53\xa4\x3f\x9e\xdd\xfe\x02\x4f\x07\x7f\x82\x23\xd4\x5d\x1d\xdd\x1d\x00\x63\x65\xfd\x09\xf4\x4f\x71\x4e\xbf\xab\xa4\xdb\x0c\xa5\x38\x61\x2c\xee\x9d\x07\x08\x3f\x7a\x02\x36\x7f\x1e\x74\x38\x01\xd9\xed\x39\xfc\xa5\x79\x28\xc9\x87\xeb\xfa\xb4\xcb\x92\xe6\xfa\xd7\x11\x73\xc2\x95\x57\xa4\x26\x9a\xe2\xdd\xa9\x69\x8a\x1c\x96\xa3\x1b\xfc\xa7\xfb\x30\xee\x95\x7f\xd8\x10\x13\xa8\xf6\x27\x51\x88\xfe\x22\xa5\x6e\xdf\x57\x58\x21\xfb\x93\x54\x60\x66\x18\xc6\x4f\x6f\xe2\x7e\x47\x2e\xe0\x89\x8a\x34\x0d\xcb\x5a\x38\xfa\x31\xaa\x1f\x2b\x1f\x29\x20\x57\xd5\x34\x95\x0e\x8e\xde\xad\x56\xdc\xf7\xb0\xbb\x22\x7e\xb0\x02\xef\x15\x90\x63\x83\xb9\xa2\xf8\xf6\x51\xb6\x67\xae\x93\x37\xbb\xff\xed\xd6\x3b\x26\x71\x4c\x72\x55\x1f\xf4\x48\xed\x28\x58\xf5\x9b\xbb\x90\x0e\x40\x61\x0c\x00\x2d\xd7\xed\xec\x71\x2a\x27\x57\xc3\x34\xa2\x64\x9e\x41\x83\xb9\xe6\xf6\x36\xdc\x37\xd4\x3e\x28\xb7\x10\x52\xed\x0b\xa4\xe6\x0b\x67\xaa\x17\xf8\x35\xc5\xe3\xc6\xc0\x61\xf3\xe6\xf5\x35\x18\xb5\x74\x38\x2d\x48\xd6\xef\xdc\x83\x03\x9a\x6d\xae\x1c\x0a\x15\xae\x12\x60\x01\x83\xc3\x5a\x8e\xef\x86\x1b\x25\xea\xe9\x64\xc5\xb1\x0e\xf8\x18\x2f\x16\x00\xcd\xe1\x6a\xcf\x48\x7e\xd2\x1c\xd7\xef\x6f\x71\xa6\x77\xf9\x81\xfd\xac\xbf\xf9\x49\x1e\x77\x4f\x2a\x05\x41\xf7\x41\x18\x46\x9e\xb7\x4f\x8b\xb0\xb9\xed\x70\xba\xbe\x01\x6f\x41\x05\xfc\x95\x3e\xe3\xdd\x80\x63\x27\xb6\xdf\xe8\xe9\xee\x40\x71\x87\x10\xb6\xd1\x04\xee\x18\x65\x35\xa7\x49\xdd\xb0\x0b\x11\xd5\x2e\x2f\xf3\x6c\x9e\xdd\x35\x81\x5c\x6f\xc5\xc9\x5d\xc2\x2e\x32\x1b\x76\xcb\x96\x67\x5e\x6e\xa3\x2c\x31\x7b\x60\xb8\x59\x73\xa8\x2a\x69\x48\x66\x33\x47\x81\x6e\x5c\x94\x67\x30\x11\x47\x29\x09\xab\xee\x22\xef\xe3\x7b\xb3\xb7\x87\xf7\x61\x92\x1f\x49\x95\x74\xdd\xa8\xd8\xf6\x3b\x36\x47\xde\x7c\x1b\x20\x4d\x1a\x5c\x13\xfe\xab\xec\x49\xcd\xe2\x19\xe9\x97\x5d\x15\x8e\x0a\xae\x45\x9b\xfd\x66\xbf\x45\xea\x1d\x1d\x51\xbe\x62\xe0\x38\x0a\x9a\xe8\x52\x2d\x5c\xd7\xe0\xab\x05\xf1\x07\x5f\xb1\xd6\xab\x53\x6e\x78\xb5\xf0\x1b\x59\x64\x21\x9b\x18\xec\xc2\x37\x0a\x1d\x2a\x2d\xdf\x40\xa2\xb7\xbe\x1d\x1c\xbc\xe0\x09\xbe\x8c\x21\xe3\xb4\xa5\x10\x85\x9d\x0c\xe1\xae\x83\x1a\x50\x8e\x24\x8c\xc5\xd9\x67\xd0\x7a\x69\xc7\xb6\xed\x95\x67\xd0\x33\x94\x05\x8d\xea\x35\xc7\x55\x2b\xf3\xf6\x0f\xc1\x4c\xef\x93\x73\xdf\x27\xa3\xd5\x87\x72\xe1\xaf\x43\x43\x2c\xf7\x76\xab\x9a\x5f\x86\x0e\xeb\x28\x33\x91\x0d\xb7\xe5\xe0\x6f\x08\x04\xdc\x25\x1c\x83\x2f\x3d\x01\xbf\xfb\x6c\x2a\xd6\xf8\x33\xd2\x59\x14\x36\xfb\x2b\x0e\xe4\xd2\x62\xf4\x5c\x2e\x28\x13\xd1\x05\x34\xdd\xa5\x2e\x03\xfb\x34\x26\xc0\x5b\x04\xe3\x05\xb4\x61\x8a\xa3\x8c\xe2\x41\xc6\x19\x77\x58\x11\x1e\x63\x9c\x49\xc7\x18\xc5\x12\x8c\xdb\xde\xb2\xe9\x78\x06\xb6\x6f\xd0\x8d\xb9\x82\x18\x8c\xeb\xb8\x02\x2e\xcc\x86\x25\x63\xc6\x41\xcd\x40\x4f\x55\x07\x61\x26\x02\xf7\xd9\xab\x9b\xd1\xf5\xd1\x8f\x52\x37\xc2\xef\xb2\xda\xc2\x46\x29\x70\xb9\x52\x9d\xe2\x2b\x18\x40\x01\x4c\x74\x94\x2c\x69\xc0\xe4\x4b\xb0\xd8\x95\x4b\xec\x79\x87\x16\xa8\x29\x8a\x74\x17\x56\x9f\xe9\x68\xfc\x78\x5a\xab\x6e\xc2\xaa\x81\x45\xd2\x31\xab\xee\x58\xd6\x00\xe6\xc9\x87\xb5\xb8\x32\x0f\x3b\xa9\x05\x49\x88\x0d\x96\x4e\x92\x72\x07\x0a\xd1\x21\x91\x17\xcd\x9b\xdb\x7d\x52\xd5\x4d\x7f\x27\x55\x7f\x33\xd9\x6d\x1a\xf2\x1f\x44\xef\xfd\x2d\xf4\xdb\x91\xcc\x16\x3f\xec\x46\xf2\x72\xd7\x59\x20\x5d\xc0\x12\x7d\xfc\xa3\x9b\x51\x20\x7f\x30\xd6\x90\x4b\x51\x46\xc6\x7a\x65\x61\x4d\x04\x78\x31\xc6\x91\x10\x10\x1e\x5b\x31\x28\x59\x14\x0b\x31\x0e\x41\x7f\x73\x51\x8b\x1a\xd6\xa2\xd7\xe1\x8c\x60\xd7\xcd\x94\xb4\xae\xdf\x64\x91\x8a\x02\xb4\xa7\x83\xa5\x79\x9f\xbc\xf3\x29\x2f\x3a\x15\x50\x29\xf9\x93\x75\xb2\xa4\x54\xf2\x6c\x32\x2d\x4a\x92\xe3\x22\x11\x7d\x52\x6a\x9f\x05\x50\xbf\x2e\xd3\xa4\x81\xe9\xc2\x31\x59\xb0\x11\xaf\xb0\x45\x2e\x7d\xd3\x51\x85\x81\xbe\x6a\xe4\xd7\x99\x12\x5d\x91\xd9\x74\x6b\xc3\x42\xd9\x88\x05\xdf\x0a\x3f\x3d\xd8\x71\x32\x26\x74\x5d\x38\x81\x17\xc3\x89\xf7\x16\xa2\xfe\x27\xef\x73\x7e\x19\xef\x12\x4e\x74\x45\x95\x74\xb3\x10\x63\x4b\x2c\x8f\x93\x8a\x44\x2c\xde\x63\x41\x32\x77\x33\x05\x80\x88\x8a\xf4\x94\xe5\xd2\x4c\xa8\x84\x60\x4e\x23\x0e\x81\x1c\xdf\x96\x26\x64\xee\xe8\xb5\x66\x32\x16\xf1\xd9\x3f\x0d\xbe\xee\xf0\xb6\xe5\xd1\x6d\xe3\xc1\x6d\x25\xbe\x42\x67\xac\x3c\x2b\x34\x23\xae\x0e\x11\x74\xfe\xda\x4f\xb6\xfe\xdf\x4f\xb6\x1e\x20\x02\x28\xfb\x80\x5d\x9c\xe7\x73\x89\x24\x85\x73\x21\x79\xe6\x16\x13\xa1\xc5\x0c\x28\x87\x04\xba\xc9\xcb\x65\xa6\x52\x4c\x1f\xfa\xda\xb5\xd3\xd5\xef\x86\x69\xd0\x7e\x66\xc2\x7b\xe9\x69\x7d\x0b\x45\x1d\x97\x3b\x19\x17\x12\x7c\x8c\xb7\x71\x49\x1f\x3e\xde\xf1\xb8\xa8\x73\x7f\x89\xc3\x26\xa4\x6d\x64\xcb\x67\xf5\xf5\xaf\xac\xc3\xb8\xa5\xb7\x30\x4e\x8a\xeb\x5f\x27\x2e\x38\xdd\xa5\x22\xbb\xe2\x6c\x46\xe3\xbc\xb0\x4b\xea\x55\x13\x18\x99\xd0\x2c\x1c\xf4\xc9\xf0\x76\x96\x01\xb9\xee\xfe\xa5\x02\xe5\xe9\x75\x31\xf8\xb2\xcc\x10\x3d\x59\x40\x2a\x19\x71\xc8\x90\x7c\x29\x12\xce\x1e\x4c\x3e\x60\x59\x9f\x99\x9c\xf5\x99\x29\xb3\x3e\x62\xc9\x98\xf5\x81\x25\x8c\x6f\x34\x2d\x67\x68\xc9\x90\xd2\x51\x43\x0c\xb9\x1d\x35\x08\x92\x42\x5a\x48\x15\xa3\xb7\xa5\xf7\x13\x97\x5e\xd0\x9f\x27\x05\xf1\x42\x3c\xb3\x2f\xe2\x1a\xe1\xf7\xba\xeb\x27\x29\xb9\xa3\x9d\x91\x3c\xf9\x4e\xfa\x8b\x7d\x0d\x0b\x45\xec\x3d\x39\xb7\xeb\x65\x50\xd2\xe2\xb6\x11\xf1\xe5\x33\xab\x37\x52\xdc\xee\xca\x19\xce\x99\x0e\xaf\xf4\xe0\xb7\xe7\x68\x5f\x2a\x7a\x92\xc5\x4a\x49\x1a\xd2\x73\x1b\x4f\xfa\x9a\x86\x5c\xdd\x27\xd9\xb7\x63\x68\x55\x7a\x78\xda\xb7\x22\xac\x5a\xf5\xe8\xad\x4f\xb2\xe2\xa2\x8e\x82\x01\x4e\xf0\x07\x84\xeb\xcc\xf4\xb3\x8f\xc1\x68\x00\x5b\x63\x61\x59\x64\x1f\xd6\x1e\x52\x5c\xe2\xb1\x41\x13\x7c\x5a\x1b\x83\xc7\x85\x4d\xc6\x84\xac\x03\x45\x18\x3b\xe8\x23\x85\xcb\x93\x7b\xe6\x9e\xe1\xec\xef\xb0\x18\x69\xa1\x02\x42\x4e\xd8\x62\xbe\x71\x90\x8d\xb3\x18\x1d\xf1\x2c\xf5\x00\x09\xb1\xac\x5b\xa5\x52\x58\x65\x43\x9e\x22\x85\xca\x39\x62\x3f\x22\x46\x49\x1f\x1d\x73\x19\x04\x6c\xaa\xc5\x1d\x68\x68\x9b\xb5\x0b\xe4\xba\x27\x76\x2c\x97\x60\x15\xce\x78\xbf\xc0\x2d\xbb\xe2\xe3\x77\xde\x11\x47\xe2\x05\xc8\xd1\x8f\x23\x63\x8a\x45\x37\x1c\x11\xf3\xcd\x61\x31\xe6\x98\xc3\x72\x3b\xaf\xfc\x29\xcd\x27\x68\x22\x1d\xfe\xca\x36\x3e\xe1\x68\x15\xd7\x7c\x6d\xc5\xac\x49\x09\xe0\x82\x57\x23\xa8\xba\x42\x8d\xc1\xd6\xe5\x1d\x0d\x3c\x5b\x2b\x77\x44\x1b\x96\x3c\x1c\xf1\xd4\x2a\x84\xdc\x31\xfa\x9c\x76\x74\x64\x49\x0e\x7c\x64\xd5\x92\x81\xe0\x90\xb3\x45\x06\xb6\x59\x07\x7b\xeb\x48\xba\xb7\x53\xff\xde\xd1\x20\x36\xbf\xb3\x35\x70\x7f\xb4\x8a\xda\x6d\xe7\x50\x91\xd8\xfb\xff\x0d\xb7\x84\xda\x1f\x84\x57\x56\xd5\xef\x3a\x35\x55\xa4\x3e\x49\xd3\x46\x0a\x6d\xfd\x13\xfa\xf7\xa2\xfd\x7b\x3c\xb9\xa1\x3e\x4a\xa3\x45\x54\xb2\x4b\x37\x79\x3c\x5e\x30\x37\xfb\x28\xdc\x13\x4d\x4d\xc3\xf6\x38\x63\x5d\xb6\x1b\xe5\xb8\x6d\x96\xae\x75\xc6\xa4\x8e\xaa\xa4\x6c\xf5\xcb\x65\xc3\x1e\x5a\x15\xe4\x5e\xb3\xeb\x17\xdc\x33\x0a\xb6\x8f\xa9\x77\x83\x52\xd8\x61\xd0\xb1\x21\x87\xa6\xfb\xbe\xd0\xad\xac\xc8\xb1\xa5\x58\xec\x2e\xe7\x17\xae\xe4\x5c\x8c\xf4\x54\xd5\x2a\x78\xd5\xfe\xff\x7b\xdd\x73\x56\x03\x0c\xec\x34\x1a\x5a\x19\x74\x50\x17\x8e\x9b\x29\xb9\x98\x9c\x67\xf2\x8a\xce\x9e\xbe\x2c\xb2\x9e\xae\xd6\xcb\xe9\x66\x95\xfa\x8b\xe9\x6a\xeb\x2d\xa6\xeb\xd9\xdc\x9f\x4d\x57\x8b\x9b\xf6\xbf\xab\x7f\x04\xde\x72\x3a\x5f\x7b\xf3\xe9\x76\xb3\xf4\x36\xd3\xf9\xca\xbb\xf1\xe6\xd3\xd9\x76\xa1\x7a\xcd\xc6\x4e\x5a\xad\x49\x6f\x48\x95\x25\x79\xd8\x98\xad\x91\xfe\x82\x92\xcf\x2a\xd0\xa5\xb7\x54\xbc\xd7\x32\x88\x34\xf0\xe6\xc7\xa5\x5e\x3a\x5d\x62\xc0\x4d\x25\x45\xc5\x56\x91\x78\x9e\xba\xe8\x2f\x3d\x7f\x09\xb4\x71\x7c\xb9\x66\xf1\x9a\xd7\x4a\xbd\x56\xf5\xcd\xa8\xfd\xba\x09\xbb\x16\x7e\x4f\x6e\x6b\xc5\x83\xf9\x98\xd2\xae\x63\xc4\xd4\x99\xe8\xfc\xa8\xa3\xcc\x45\xad\x14\xac\x9f\x47\xf4\xa7\x92\xb2\xf0\xec\x0b\x07\x23\xb4\x4f\xb0\x73\x99\xbc\x05\x4b\xe5\x6d\x68\xa2\x72\xb1\x11\x73\xc6\x96\x59\x5f\xf5\x69\x27\x78\x52\xa2\xf5\x8c\x3e\xb1\xa1\x59\xa1\x96\x7b\xb1\x58\xd0\xe7\xf8\xbc\xe0\x1f\x9d\xa9\xf9\x2d\x0b\xbc\xd6\x50\x2f\x8e\x4b\xd9\x28\x8f\x93\xf0\xf0\x8e\x64\x2f\x18\x8b\x39\xf7\xa6\x3c\x7b\xb3\xa0\x54\x9d\xd6\x91\x81\x1e\x9d\xfd\xa6\x4e\x4b\x58\x96\x24\xac\xc2\x3c\x22\xb2\xb7\x23\x97\x49\x6a\x06\x4e\x82\xc9\x17\x56\x2c\xf6\xfd\xbd\xe3\x6c\x53\x9a\x96\xc6\xe3\x5e\xf2\xe7\x09\x3e\xd5\x71\x11\xcc\x0b\xa6\x55\x74\xdc\x92\x73\x19\xd2\xa7\xe7\x15\xb1\x52\x0f\x8d\xbe\x8f\x8f\xed\x53\x03\x47\xe0\x36\x48\xa2\x7c\xb3\xe2\x27\xa6\x7d\x42\x37\x27\x18\x82\x59\x87\xe1\x3f\xd7\x1e\x14\xd1\x05\x99\x2d\x33\x20\xc2\x84\x67\xe8\x96\x03\x49\xeb\x7a\xd9\x42\x53\x92\x36\xad\x9a\x87\x69\x79\x0c\xdf\x50\x21\x7f\xe8\xd7\xe6\x15\x22\xef\xf8\xc0\x23\x7d\x39\x6c\x78\x8f\x1e\xd0\xe1\xcf\x9e\xac\x70\x3e\x55\x8f\x22\x08\xb6\x4f\x61\xfa\x9e\x79\xa4\xc1\xdd\xc6\xfd\xc8\x35\x36\xa4\x5f\x6e\xd3\x30\x3f\xbc\x21\xf9\x5b\xb8\x5d\x74\x3c\xe1\xfa\xd7\x63\x51\xd4\xa4\xed\x7c\x32\x9d\x4e\xaf\xd5\x64\x6e\x77\x64\x5f\x54\xe2\x18\x90\xba\x99\xed\x16\xe3\xd2\x7c\xe3\x21\x21\xf6\xf7\xd0\xe3\x6b\x3c\x78\x7c\x6a\x1d\xf8\x34\xcb\x9b\x60\x05\x71\xce\x4e\x57\x9a\xbb\x01\x08\x72\xec\x87\xbf\x54\xc5\x7d\x4d\xa8\xfc\xf3\xf0\xee\x13\xfb\x80\xc8\x53\x34\x98\x11\x92\xcf\xc8\x52\xf6\xc6\xdb\x3a\xf4\x07\xe2\xda\x8e\x62\xca\xc9\xd0\x86\x2c\xea\xf0\xc1\xea\xf2\x07\x06\x3d\xbd\x64\xca\x61\x04\x9a\x70\x57\xcb\x4b\x20\xb0\xeb\xfb\xc8\x9f\x83\xef\x7e\x1b\xce\xbd\x0a\x72\x1a\xb3\xc8\x3c\xfc\x20\x20\xe3\x93\x2f\xea\xe5\x35\x69\xb1\x56\x5e\x91\x81\xa3\x5f\x66\x80\x13\xb5\x50\xa4\xba\x67\x84\x0e\x8c\xf1\xa7\x42\x20\x36\x7d\xe1\x78\x85\x90\x74\x66\x14\xa9\x71\xc8\x58\xcb\xdd\xd3\x1f\x1a\xe5\xa5\x6f\xef\xdb\xc8\x17\xd4\xc4\x31\xfd\xcf\xe0\xfb\x8c\x75\xca\x87\x22\xb1\x3d\xb3\x8f\xda\x0f\xda\x56\x56\x26\x69\x8a\x2b\x94\x5a\x05\x04\x1c\x5e\x62\xa0\xd0\x24\x32\x2a\x15\x36\x9e\x62\xb2\x0f\x4f\xa9\x98\xf5\xc2\x32\xca\x2d\xb5\xd6\xb3\x17\x06\xce\x67\xd9\xf3\x86\xed\x6e\x61\x3c\xf5\x5b\x99\x92\x36\xf2\xbb\x8c\x31\xe6\x49\xc9\x8c\xc1\x12\x9e\x31\x56\xa2\x62\xac\x09\x77\x6c\x6f\x95\xf7\x93\xd7\xfd\x59\x86\xe2\xf3\xb3\xa3\x15\x14\xc0\xc1\xe9\x46\xec\x14\x75\x1e\xde\xb1\xf3\x7a\x9f\x7b\xd3\xe4\x8b\xc8\x32\xa8\xdc\x8a\x51\x76\xfe\xae\x62\x71\x87\xd2\xc7\xe7\x62\x8d\xa9\x74\x0e\x85\xcd\x14\x63\x09\xbe\xac\x84\x6f\xd3\xe1\xfc\x1b\xd3\xbd\x07\x22\xdf\xd0\xfe\x8f\x1f\x6d\xa7\xdb\x16\xe3\xd3\xbb\x22\x2f\x4b\x51\x2e\xf6\x98\x98\x30\x79\x5b\x2b\x1e\xa8\x42\x6b\x02\x14\xda\x0e\x73\x51\xc6\xa5\x5a\x1b\x97\xfc\xb4\xd1\x51\xef\xf6\xa9\xf0\x6b\x7b\xfd\xc9\x9c\x9a\xa4\x7b\xed\xc1\x9e\xd6\x98\x1a\x4e\xf7\x60\x34\x90\xbd\x78\x58\x34\xae\x1a\x0f\x33\x31\x81\x85\xba\x17\x66\xdf\x4b\x35\x97\x02\xa1\x88\x43\x89\x7d\x76\x19\x4c\x14\xc7\x4f\xa2\xc2\xf0\x1c\x22\x0b\xdd\x5b\x0f\x1a\xc6\x43\xc3\x07\xfb\xdb\x8b\xb8\x8b\x50\x58\xd6\xcc\x7e\x89\xaa\x9d\xbf\xa4\xe9\x4d\x07\x85\xb4\x77\xb8\x6d\x03\x0f\x1c\xe9\xaa\xbb\x42\xf0\xe0\x7e\x0f\x1c\x9d\x33\xa3\xf0\x59\xf1\x31\x05\xb2\xda\xac\x86\x67\xc5\x39\xea\xad\xf3\xea\x71\xc3\x53\x76\xec\x54\x8f\x6e\xd2\x33\xc1\x2c\xb4\xff\x03\x27\xfe\x93\x37\x6d\x7b\x24\x4c\x72\x32\xbc\xe1\x89\x0c\x7a\xc5\xeb\xa0\xe6\x57\xd2\xe5\x1a\x7f\x97\xde\x3c\x65\x66\xf5\x58\x54\xc9\x6f\x2d\x33\xe9\x7b\x09\x06\x35\xad\x6a\xe3\xda\x5d\x61\xc6\xd4\x01\xb3\xad\x1c\x00\x66\x5a\x07\x00\xae\x8a\x76\x1e\x83\xfb\xab\x85\x0a\xf0\xe2\x81\xbc\x5c\xfc\x85\xdf\x9e\x55\x28\x85\x38\xbb\xbe\x9c\xee\xb2\x68\x10\x3f\xc3\x49\xea\x3e\x1d\x1f\xa0\xe5\x55\x7e\x28\xb0\x1f\x49\x9f\xef\xed\xe2\x6f\x50\x39\xb9\xeb\x10\x71\x59\x7b\x7f\x4a\xb2\xb2\xa8\x9a\x30\x6f\x34\x62\xd7\x40\x8d\x3d\xa0\x06\x42\x49\x08\xb9\x6d\x43\x53\xa0\x63\x83\xdc\x49\xf7\x87\x62\xda\xd8\xac\x37\x6a\x3b\xeb\xd7\xd9\x27\x9d\x39\xe8\xd1\x86\x27\x9f\x3c\x36\xeb\x1b\x7d\xa3\x5e\x96\x41\xfa\x16\x87\xa8\xa8\x7a\x2f\xab\xc7\xec\xda\xf4\x89\x66\x11\xc5\xa8\xfa\x3e\x91\x7c\x5a\x2d\x7d\xf9\x73\x09\x6c\xcd\x85\xd3\xc9\x76\x3b\xd3\x58\xde\x2c\xfe\xb4\xd3\x49\x16\x7f\x92\xe9\x64\xbb\x9d\xeb\x1b\xf5\xb2\x8c\xd3\x37\x39\x50\x05\xd5\x7b\x59\x3d\x66\xd7\xa6\x4f\x35\x9d\xe0\xa3\xea\xfb\x74\xf2\x69\xb5\xf4\x2b\x98\x4e\x40\x6b\x2e\x9c\x4e\x66\xb3\xed\x56\x63\x7a\xd3\xc3\xa7\x9d\x4f\xfa\x33\xca\x4f\x3e\x9f\xcc\xe6\x41\xa0\x6f\xd5\xcb\x32\x4f\xdf\xe4\x50\x15\x74\xef\x65\xf5\x98\x5d\x9b\x3e\xd5\x84\x82\x0f\xab\xef\x13\xca\xa7\xd5\xd2\xaf\x60\x42\x01\xad\xb1\x99\x50\x10\x0a\xe7\x54\xda\x0e\x81\x8f\xd3\x8b\x97\x64\xc1\x10\xb4\x18\xa1\x8a\x62\xa3\xa6\x1a\xf5\x14\x2d\xd4\xea\xa8\x85\x86\x9a\xf5\x13\x45\x56\x76\x85\x61\xf2\xc6\xa6\x6e\x6e\xe2\x56\x12\x96\xcc\x8b\xf3\x22\xb4\x92\xcf\xe7\xaf\x3e\x76\xe2\xd6\xad\xd1\x2b\x0e\x0d\x4f\x15\x0b\xc7\x4a\xa9\x7f\xfa\x1d\x15\xdf\xc8\x00\xc1\x9f\x6d\x52\x5b\x6e\x1b\xbb\x6d\x61\xb5\x75\x36\x5b\xba\x31\x4e\xdb\x00\x68\xae\x91\x2d\x5f\x14\x2a\xed\x96\xc1\xb9\x9d\x3c\x13\x45\x21\x24\x48\x37\xe8\x89\x3b\x9a\xb7\x6f\x4d\xc4\xc5\x0d\x0f\x18\x08\xbb\xac\x40\xc7\xc5\x70\x35\x81\x16\x48\x7a\x68\xc5\x89\x61\x79\xd0\x2a\xa8\xac\xac\xa9\xe8\x9b\xcf\x83\x1a\x99\xdf\x58\x57\x8b\xee\xa8\x15\xc9\x2d\x8c\xe4\xba\x7b\xab\x7f\x1a\xc9\xaa\xc4\xdf\x01\xd3\x4d\x84\xb6\xe0\x03\xab\x6d\x25\x76\x90\xf2\x6b\x36\x4e\xdd\x0b\xf5\x99\xdf\xa4\x2b\x6d\xd4\xb7\x20\x33\x6e\xbf\x79\xdc\x49\x51\xee\x60\xdb\x62\xee\x2d\xe6\xaf\x2d\xce\xc3\x21\xc7\x6c\x65\x25\x7d\x4d\xcb\xfa\xa0\xf8\xc3\xeb\xf9\xf0\x21\x4d\x72\x12\x85\xe5\x87\xd7\x1d\xdb\xc3\xe7\x2c\x69\x48\x95\x26\x59\xd2\x7c\x78\x3d\x0b\xfa\xa3\x74\x4b\xef\xe6\x38\x5f\xfe\xbc\xf4\x66\xeb\xfe\xe7\x7c\x79\x9c\xab\x8e\xf0\xe2\x02\x63\xbb\xcd\xec\x46\x53\x92\xdf\x91\xaa\x26\x2a\x1b\x25\x16\x23\x56\xaa\xdb\xd4\x68\xa6\x29\x8e\x4d\x1c\x48\x30\x4e\x8a\xea\x45\xf3\xa4\x02\x93\xc6\xb8\x99\x57\xbd\x55\x9a\xaf\x56\x13\x6f\xfc\x8f\x51\x9a\x5a\xeb\xa4\x07\xc6\xed\x93\xc4\xc0\xc6\x85\x03\xb5\xa1\x92\xe8\xce\x6d\xe8\xaa\x2d\x16\x0a\xae\xb3\x59\x7a\xbe\x79\xab\xa5\x87\x95\xed\x96\xb9\xd7\x0d\xc6\x4a\x92\xce\x4c\x2f\x9c\x67\x6d\xb4\x10\x1d\xfe\x62\xa6\x4b\x12\x1c\x66\xbc\x94\x83\x2e\x0a\xab\xf8\xfb\x3e\x79\x35\xc4\xa3\x0e\x2e\xce\x2d\x4e\x2e\x86\x55\x0c\x5e\x92\xfd\x2c\x67\x45\x86\xcd\xc7\x33\x89\x93\x26\x69\xe8\x4e\x4a\xe9\x62\x81\x8d\x00\x5a\x9f\x76\x12\x74\x7f\x08\x08\x1e\x2d\xc6\xaf\x5f\xee\xeb\x22\xe7\x06\x5c\x9d\x86\xd6\x0a\xc0\xed\x4f\xcc\x0d\xe0\xdd\xcd\x06\xc3\x1f\xd2\xa5\x06\x52\xf3\x5b\xb3\xda\x6d\x64\x97\xee\x70\x87\xdf\xe9\xbb\x89\xd6\xb7\xa4\x3b\x9e\x66\x43\x18\x01\x42\x92\xf8\x10\x04\xa8\xbd\xd4\x52\xe2\x04\xbd\x09\x51\x52\x0a\xf0\x0c\x20\x72\xdb\xaa\x78\xba\x83\x3b\x16\xa0\x79\x12\x52\x73\x16\x11\x1f\x48\x02\x3f\xaa\x1e\x60\xed\xe8\x6e\x99\x60\xdb\xeb\x7d\xaf\xdb\x82\x80\x7d\x0b\x38\x2d\xdb\x17\x45\x63\xd1\x56\x9b\x76\xf5\x6f\xc5\xda\x36\xaa\xaf\x58\xd1\x9d\xe0\xe4\xad\x6d\xbb\x24\x79\x8d\x67\x40\x85\x1b\x09\x83\xe9\x5a\xd5\x87\xbe\x7c\x49\x33\xbd\x43\x10\x22\x89\x8f\xeb\xca\x55\xf7\xa7\xef\xec\xea\x96\x2a\x18\x88\x95\x55\x92\x85\xd5\x83\xc5\x25\x42\xfc\x81\x46\xee\x1a\x35\x48\x08\xf2\x38\x41\xcb\x80\x3a\x58\x3c\xf5\x49\xed\x62\x14\x91\xba\x56\x71\xb9\x8a\x76\x37\xab\x08\xe1\x92\x15\x48\x84\x30\x2e\xf9\xb2\x0b\xb8\x4c\xf2\x7d\xa1\x64\x71\x17\x05\x31\xc1\x58\xa4\x05\x3c\x15\x8c\x3f\x50\x70\x01\x73\xf7\x61\x95\xb3\x67\xbe\xb1\x91\x16\x84\xf1\x12\xe3\x8f\x15\x48\x84\x30\x16\xf9\xb2\x0b\xb8\x8c\xc3\xfc\xa0\x44\xf9\x73\xbc\x5d\x2d\x96\xe8\xf1\x5a\x5a\x20\xd2\xc1\x78\xe4\x8a\x2e\x60\x91\xde\x8b\x62\x18\x39\xda\xb3\xc9\xf2\xe8\x61\x44\x6b\x12\x15\x79\x7c\x29\xd9\x28\x8a\x10\x9a\x1a\xb5\xd4\x93\x93\x54\x73\xe0\x52\x3b\x1c\x0d\x44\xc5\x21\xc9\x88\xea\x15\x54\x4f\x54\x52\x52\x46\x54\xab\x4f\x7a\x9a\x92\x4e\xb1\xa0\xc0\x18\x07\xac\xb9\x49\x68\x88\x25\xd0\x21\x0d\xcb\xec\x95\xd1\x2e\x1a\x9c\x3f\x86\x0d\xbc\xac\xf3\x4e\xf1\xa2\xce\xe1\xfe\xbf\xa7\xa2\x41\x5e\x9a\x56\x70\x41\x43\x6d\xac\x1e\x72\x6e\xf0\x12\xe6\x22\x1b\x99\x98\x8e\xbf\x43\xc9\x5e\xdc\x73\x5c\xaa\x44\x55\x2e\xbf\xf1\xcd\xb7\x5f\x10\xd2\xe8\x0e\x29\x9d\x3d\xe4\x9e\xeb\xbe\xf2\xec\x60\xe9\xa1\xf1\x48\x7e\xcb\x61\x1a\x3e\x68\x0e\xc1\xb9\x3d\xf6\xac\x89\x7c\xda\xea\x1a\xfa\xa8\xb4\xd2\x97\x47\x39\x56\xfb\xf5\xe6\x06\xf6\x8c\x1a\x3d\x77\x7d\xbd\x98\x17\xaf\xaa\xda\x70\xaa\xae\x9f\x75\x48\xf4\xf1\xcb\xec\xbf\xe8\x1f\xe7\xaf\x8a\x7b\x4f\xb1\x03\x43\x05\x30\x04\xf1\x32\xc0\x1f\x7c\xbb\xc6\x8c\xc7\xe7\x6c\x9d\x10\xcf\xcb\x11\x7d\xc0\x5d\xa6\x0e\x23\xfa\x40\xb8\x66\x7d\x8c\xe8\xc7\x12\x53\x32\xe5\x51\x1b\x8c\xc4\x74\x89\x5d\x4a\x45\x97\x54\xc1\xba\x44\x71\x77\xbe\x18\xb2\xaf\xfa\x0b\x46\xd4\x14\xc4\xd7\x17\xa4\x5b\x07\x06\x0a\x96\xa3\x61\xbc\x53\xfd\x2b\x1b\x0e\xf4\xf1\x09\x30\x1e\x3e\x8b\xb6\xe2\x0c\xfc\xc8\x31\x22\xde\x3d\xe9\x79\xf2\xec\xa2\xa0\x24\xa5\x05\x2c\x1e\xfa\x30\x5d\x58\x63\xae\x48\x9e\x43\x9e\x92\x26\x98\x25\x2c\x5a\x83\x53\x16\x12\x0b\xe6\xc7\x30\xb4\xf7\xf4\x58\xd4\x62\x10\xc9\xa3\x28\x6a\x04\x62\x4b\xd7\xf2\xf1\x2c\xec\xf9\xac\x47\x90\xe4\x84\x32\x79\x3c\x15\x4c\x10\x02\xa7\x96\x66\xae\xb7\xce\xb5\x60\x09\xfa\xaf\x7e\x54\x9c\xda\x29\x65\x31\x8c\xec\xee\x8e\x40\x55\x61\xf7\x0f\x2d\x14\xc8\x1e\xc2\x92\xbb\xe3\x42\x22\x8d\x02\x40\xf2\x22\xc0\x1f\x52\x7b\xd0\xf9\x5e\xbc\x76\x42\xda\x6b\xa8\xce\x7f\xb3\x6d\x84\xbb\x8a\x84\x71\x54\x9d\xb2\x9d\x22\x59\x88\x67\xd4\xc6\xeb\xfb\xc4\xfb\x52\xac\x2e\xeb\xc3\xb3\xb4\x23\x2f\xf0\xb2\x43\xf9\x86\x3a\xe1\x8a\x8c\x28\x25\x61\x75\xdb\x3a\xcb\x47\x91\xce\x78\x49\x94\xfc\x02\xbc\x00\xf4\xa3\x27\x7e\xe2\xae\xfa\x33\x5e\xd8\x32\xbc\xa9\xad\x7a\x51\x9b\x7d\x47\xae\xbc\x1b\xda\xf3\xee\xda\x9a\xb9\x2e\xec\xe1\x58\x94\x96\x12\x4e\x79\x4c\xaa\x96\xdb\xa7\x24\x3a\xae\x4f\x08\xc8\xc8\x6a\xeb\xd0\xc6\x16\xbc\x0c\x0f\x49\x1e\x0e\x4f\x1d\x7c\xd6\xcb\x11\x51\x35\xd5\x68\x62\x19\x1e\x88\xbc\x3e\xd2\x7f\xc6\x17\x61\x8c\x0f\xdb\x3a\x2e\x9f\x8c\x1c\xc0\x99\x83\x67\xc0\x6d\x91\x44\xb3\xa4\xc3\x55\xc8\xfa\x51\xa8\x8c\x7f\x89\xc7\xfe\x8d\x12\x6d\x22\x6e\xac\x74\xd8\x8e\x20\x54\x2b\x8f\x16\xd5\x7d\xb0\xf6\x77\x15\x2b\xef\x0b\x04\x4c\x8d\xdb\x47\xf5\x0b\xca\xe8\xb5\x99\xf8\xbb\x90\xf0\xd9\x22\xc3\xc5\xdf\xe8\x43\x02\xc6\x85\x5b\xa4\x09\x43\xf6\x64\xfc\x22\xe7\x4b\x82\xd9\x32\xba\x19\x1f\x97\x94\x47\xbb\x9d\x4d\xc7\x04\x49\x47\x7c\xb7\xbb\x5d\x90\xab\xdb\x83\x87\x2a\x7a\xa6\x41\xaa\x1d\x92\x0b\xe3\x88\x5c\x98\x2b\xbf\x74\x7c\x22\x95\xe3\x20\x42\xed\x75\xa6\x11\xa5\xd5\x8b\x98\x2a\x82\x8f\x92\xe5\xdc\x6c\xdd\xcc\x95\x5f\x6c\xeb\x2c\x4c\xdd\xe8\x66\x84\xf1\xc1\x6e\x52\xef\x25\xda\x09\x74\x89\x5f\x31\x2e\xbc\xe6\xba\x2b\xd2\x58\x1e\xdc\x88\xc1\xc4\x5f\x71\xb5\x7c\xa4\x76\x17\xd6\xa4\x9f\xd6\x0d\xfe\x54\xdb\xd0\x5b\x92\x95\xcd\x83\x6a\x73\xf2\xae\xc9\x3d\x20\x10\xdc\xd8\x81\x3b\x51\xff\xb8\xba\x0a\x29\x5d\x6a\x59\xd8\x9f\x8a\x34\xac\xc6\xa6\xa0\x57\xa4\x77\xc4\xba\x85\x56\xf4\x6c\xc3\x74\x8d\x3b\x57\x6b\xf4\x51\xd4\x59\xc0\xcb\xc2\xa7\x97\xa0\xaa\x16\xbb\xa0\xbb\xc2\x21\xfc\x72\xac\xc8\xfe\xd7\xc1\x98\x62\x65\xe0\x3e\x5e\x99\xf0\x72\xb7\x9a\xaf\x36\x5c\x0b\xed\x16\x81\x25\x04\x94\x13\xbe\x4c\xcb\x49\x30\x5f\x85\xe1\x0a\x12\xb6\x5c\xe8\x95\x10\x50\x4e\xf8\x32\xbd\x4c\x96\xdb\x78\xb9\x84\x84\x6d\x16\x73\x79\x68\x94\x07\x50\xa0\x65\x60\x31\xdb\x05\x31\x27\x0a\xcb\x05\x5b\x09\x01\x65\x83\x2f\xd3\x72\x42\xa2\xed\x66\xb6\xe7\xf4\xce\x6a\x51\x56\x84\xc7\xd5\x14\x16\x69\xd9\x88\xb6\x8b\x60\x4e\xbb\xfa\x5f\xa7\x6c\x57\x34\x95\xf8\xe8\xf5\x5c\x13\x13\xce\xd5\x9b\x4a\x34\xf1\xdf\x42\xba\xf7\x0f\x8f\xee\x79\x86\x00\x4b\xcb\x96\xa5\x39\x17\xd4\x0e\xb0\xfe\xb1\x12\x57\x42\x06\x19\x06\xf1\x30\xc2\x46\xf8\x7d\x7a\x4a\x62\xfb\x53\x55\xaa\x57\xc9\xc3\x94\x54\x8d\xeb\x4e\xa3\x19\x37\x89\x5d\x78\xeb\x65\x57\x73\xb7\xd6\xc8\x14\x99\x36\x78\xb8\x7b\x76\x84\x1a\x26\x57\x64\x0e\x1b\xa1\xe2\xa4\xce\x92\xba\x4e\xba\x0b\xb9\xa2\xb4\xa8\xcd\x53\x05\xf0\x7d\x87\x87\x04\xa4\x8b\x75\x51\x91\xa8\x99\x35\x98\xa9\x78\xbf\x0f\xd0\x70\x23\x0e\xc8\x36\x5a\xc3\x29\x78\x11\x6d\xd6\x8b\x18\xa3\xad\xd6\x96\x68\x46\xe6\xbb\x05\x86\x23\xca\x92\x61\xcc\x77\xab\x25\x1b\x4c\x3d\x88\xce\xbc\xc5\x5b\x12\xef\x37\x08\xfb\xbb\x28\xde\xef\x39\x0f\x62\x31\xdb\x04\x37\x7b\x89\xb0\x9a\xf7\x70\x1d\xaf\x88\xcc\x89\x92\xf1\xe5\x6a\xbe\xde\x42\x70\x93\x59\x8c\xf6\x37\x64\x81\xf0\xbe\x0f\xf7\xf3\x28\x82\xbc\xdf\x84\xeb\x78\xb1\xc3\x68\xab\xd9\xdf\x6f\x48\xb4\x5b\x61\x38\xaa\x16\xac\xd7\xab\x19\x2f\x7a\xbd\x39\xdd\xcf\x63\x82\x6e\x14\x22\xbb\x28\xe2\x1b\x10\x6e\x97\xcb\xe5\x1c\x21\xad\xe6\x9f\x2c\x77\xdb\xdd\x16\x41\x51\xb1\x7f\xb3\x5c\xac\x16\xfd\xc4\xf8\x6f\x2c\x09\xf2\x91\x3c\xec\xab\x30\x23\xb5\x57\x56\xc5\xa1\x22\x75\xed\xef\xc2\xca\xaf\x9b\x2a\x29\x49\x3f\x28\xf6\xd5\x98\x41\xc5\x9e\x35\x9c\xd1\x17\x2e\xfa\xec\x62\x53\xe8\x60\x03\x98\x75\xf5\x8b\x2f\x5a\xfd\x17\xac\x7b\xca\x6a\xfc\xc4\x19\xab\x76\x52\xee\x97\xb8\x8e\x49\x1c\x93\x5c\x8a\xdc\x14\xcf\x85\xd1\x8f\x78\x30\x71\x71\x1a\x16\x8a\xb9\x6b\xb8\x58\x9f\x7d\xf2\x47\x24\x47\x7b\x2d\x16\x47\x22\x3d\x09\xc1\x04\xd8\xb6\x32\xac\xfc\x43\xcb\x1e\xc9\x9b\x37\xcb\x55\x4c\x0e\x13\xd5\x01\x8c\xd5\x5b\x6f\xbe\x7a\x35\x81\xb3\xa4\xfc\x61\x15\xbc\xd2\x11\x30\x14\x6f\x44\x72\xe2\x87\xb7\xef\xf1\x06\x15\x5f\x4f\x5b\x5e\x68\x43\xd4\x37\x63\x43\x57\x96\xfd\xd3\x41\x49\xca\x1c\xe6\x49\x16\x36\x54\x9b\x87\x13\xd4\xdd\xc7\xce\x94\xa0\xf6\x6a\x56\x53\x49\x7a\x49\xbe\x4f\xf2\xa4\x19\x5f\x9d\xf2\x8b\xc7\x60\x7b\x9e\x77\x09\x76\xdb\xaa\xde\xf3\xfe\xb4\x36\x0e\x39\x7e\x3e\x5c\x23\x8f\x9e\x3e\xdf\xab\x6f\xab\xd7\x5f\x54\x8f\xe0\x0f\x8d\xf4\x77\x45\xfc\xc0\xf5\x97\xf1\x88\x8a\xea\xcd\x10\xd5\x8b\x21\xec\xcc\xf8\x78\xc2\xe1\x33\x4a\xf6\x19\x1f\x41\xb2\x7a\x81\x81\x17\x1c\x7d\x96\x20\x1a\x16\x8d\x84\xe5\x4d\xf9\x75\x20\x38\x15\x72\x41\x84\x82\xa8\xf4\x1d\x89\x9c\xfe\x3c\xdf\xce\x77\xcc\x9f\xc4\xe9\x0c\x21\xb7\xa2\x58\x4e\x8e\x09\x2c\x5b\xe6\xdc\xd9\xe1\x0c\x0d\x27\xc8\x2a\x1c\xe3\xdf\xf8\x70\xa3\x40\xd2\x66\xfd\xe3\xd3\xde\x7e\x81\x6f\x0c\xb2\xd8\x37\xa4\x28\xfe\x42\x77\x60\x38\xa5\x01\x86\xc7\xa7\x1e\x7d\x64\x0f\xe9\xd2\x4f\x77\xde\xcb\x70\xa0\x4b\xb3\xd1\xf6\xa9\x0e\x79\x49\x4d\xc5\xc7\xa4\xdd\xe1\x3b\x01\x69\x58\x9f\x44\xe8\x3d\xd9\x1b\xaa\xc3\xbe\x65\x55\xe5\x4a\x73\xa5\xe3\xca\xc6\xc4\xe9\x4c\xa5\xa6\xf6\x6e\xcb\xb8\x4b\xd5\xe2\xa1\x5e\x2e\xe3\x2e\xd6\x0b\x2c\xd9\x27\x59\x7c\x56\xd4\xa7\x94\xb1\x33\x86\xf7\x93\x57\x67\x61\x9a\x5e\x84\x39\xed\x50\xad\x3b\x49\x45\x50\x92\x78\x1c\x12\x82\xe8\xd9\x3e\x3d\xd5\x47\x09\x9d\xdb\xee\xce\x6d\x4c\x17\x37\x31\xe2\x79\x50\xb1\x82\x4b\xcf\xa2\xaa\x09\x5e\x7a\xa6\x54\xe9\x6d\xc0\x3c\xa3\x98\x31\xd4\x66\x1e\xbb\xa5\x31\x05\xb5\xc9\xd5\xee\xd4\x34\x45\xee\x54\x9b\x8e\xa0\x46\x4b\x0d\x35\xb9\x9a\x03\x25\x0b\xe3\x02\xa0\x0a\x80\xde\xe0\xa1\x67\x88\x5d\xe0\x61\x80\x92\x3d\x28\x7d\xa7\xd0\xac\xaf\xae\x01\xc3\xcb\x8a\xfa\xaa\xb1\x7d\x4d\x6a\xcb\x03\xb8\xe2\x2d\x0f\xcc\x3a\x8b\x35\x0d\x99\x61\x31\xc7\xab\xcd\x15\xa3\x6d\x6b\x49\x29\x5b\xa4\xac\x47\x49\xca\x5d\xcf\xb4\x58\xf6\x4a\xd6\x92\x51\x6b\x58\x57\xaa\x57\x2f\x40\x40\x0b\x82\x28\x96\x4e\xf8\xd1\x92\x2c\xf6\x0b\x0d\xd3\x26\xad\x02\x30\xb6\x2a\x35\xf2\x23\xa8\x14\x58\x09\x10\xab\x81\x39\x7b\x31\xfb\xae\xcd\xe2\xa3\x0d\xa3\xd4\x94\x8d\xd2\xd5\xa6\x23\xe8\xae\x5e\x26\x44\x7b\x0d\xa3\x94\xd4\x4a\xc6\x00\xf4\x7a\xc6\x93\x31\x41\xc9\xda\xa6\xef\x14\xba\x82\xa2\x6b\x80\x49\xe1\x78\x30\x4b\x9d\x03\x5c\xf1\x3a\x07\x57\x70\xc4\x9a\xc0\x2a\x8b\xb8\x5e\xa2\x5d\x77\x41\x5b\xd7\x13\x53\xb6\x4a\x53\x97\x86\x9c\xbb\xbe\x19\xf0\xec\xd5\xad\x27\xa4\xd6\x36\x5a\xae\x57\x36\x8e\x88\x01\x48\x56\x35\x6d\x67\xb0\xb5\x2e\x0d\xf3\x26\x4d\xe3\xa0\x2c\x15\x0d\xf0\xc4\x2b\x1a\x5c\x69\x23\xd9\x8e\xc4\x7e\x45\xea\xb2\xc8\x6b\x46\xdb\x76\x63\xa6\x90\x33\xe2\xcf\x7f\xca\x2b\x2f\x58\x7d\xf8\x4e\x70\x7c\x3f\x3a\xca\xaf\xf4\xa5\x13\xd8\x04\x81\x4c\xba\xb5\x2e\xac\xa4\xfb\x80\x15\x14\xbb\x7f\x91\xa8\xc1\x4a\xee\x92\x98\x14\x82\xb0\xf0\x53\xa7\xaa\x93\xa6\x82\xf0\x86\x95\x20\xfa\x37\xcb\x44\x04\x78\xbb\xfd\xf9\x6c\xf7\xb0\xe5\xa4\xc7\xbd\x6f\xba\x9c\x4f\x6f\x56\x9b\xd9\x72\xf1\x4a\x81\x3f\x5b\x6b\xf1\x57\xeb\xe9\x7c\xa5\xc2\x5d\xee\x1e\x16\x6a\xd4\x8d\x12\x6f\xb6\x7b\x98\xa9\xf1\xc6\x3b\x41\xc7\xad\x10\xf4\x6c\x41\x17\x20\xbd\x17\xf7\xaf\x72\x3b\x31\xed\xf7\x09\x06\x41\x30\x24\x07\xeb\x63\x18\xb7\x2a\x1a\x74\x19\x9f\x60\x18\x4e\x45\x19\x46\x49\xf3\x70\xeb\x4d\x57\x80\xa5\xf1\xc0\x74\xf7\x17\xb2\xdd\x17\x90\xb6\xda\x97\x07\x6b\xda\xf4\x55\x51\x0b\x00\xb6\x83\x70\xc3\x0a\x21\x61\xf1\xcc\x6b\xc0\x65\xe1\xca\x92\x84\x55\x98\x47\xdc\x4b\xbc\x59\x11\x87\xa9\xdf\x5d\x36\xf7\xbb\x72\xf8\x76\x40\x82\xda\xef\x93\x73\x9f\x77\x71\x3b\x69\x3d\x24\x1e\x66\xc1\x2a\x78\x2f\xef\xa1\xc4\xd7\x6e\xe9\x55\x08\xc3\xb8\xe8\x18\x9a\xee\xc3\x98\x78\xb4\x05\x71\x12\xa6\xc5\x81\x5b\x88\xe8\x84\x42\xb9\xe5\xbe\xed\x8b\x2a\xf3\x82\xe9\xa2\xf6\x48\x58\x13\xbf\x38\x75\x42\x73\x04\xf7\x0b\xbe\x82\xc2\x96\xf6\xe3\xc0\x26\x26\xde\x26\x06\x56\x24\x74\x5a\x55\x1a\x36\xe4\x4d\x30\xf1\xfc\xf9\xea\xd5\x5b\xb8\x82\x66\x07\xe8\x79\x9e\x01\x70\xec\xb7\xfe\x8d\x7d\x7d\xbf\x21\x74\x02\x2b\xb6\x02\x2b\x9e\x82\xb7\x92\xfa\x03\x2d\x67\x2a\xe8\x9f\x39\x25\x64\x5f\x1f\x6e\xe9\x95\x69\x23\x05\xd0\x0c\x7c\x1a\xa5\x76\x9f\x5d\xb5\xd6\x67\x6f\xdb\x51\x40\x37\x01\x53\x3a\xec\x29\xfb\x2f\xb9\x50\xf0\xcc\x97\xbd\xd4\x69\x7c\x64\x65\x3a\x4a\x93\xf2\x16\xbc\xf5\x7d\x56\xac\x4d\xa3\x70\xe6\x65\x81\x39\x7e\x8f\x1f\x3b\x89\x80\x9a\xad\x8e\xbd\xb8\xa2\x67\x5f\x9f\xd2\xa0\x2e\x55\x57\xab\x75\x13\x94\xcc\x40\x6f\x40\x7f\x87\xd3\x11\x0e\xd7\x0d\x58\x1e\x8e\xcd\x90\x3d\x24\xb8\x09\xee\xb3\x2e\x78\x7f\x9e\xb5\x26\x58\x71\x19\x46\x1f\x6f\xbd\x7f\x9d\xea\x26\xd9\x3f\xc0\x42\xfa\xc9\x1f\x7c\xd7\xee\xd4\x81\xbf\x23\xcd\x3d\x21\xb2\xf2\x4b\x74\xd8\x3f\x33\x9d\xf1\xb2\x12\x7a\x65\x81\xf2\xbe\x3c\x6e\x2d\xb2\xef\x29\xf5\x45\x8e\xb2\xfb\xc4\xf7\xf1\xb0\xd0\x8f\xdb\xa5\xcf\x7c\x43\xe5\x8a\xb7\x9b\xe0\x72\x9c\x6f\x42\x01\x49\x1e\x6b\x95\xaf\xab\x86\x02\x21\x7a\x07\x4a\x50\x9d\x83\xe8\x2a\x75\x13\xae\x31\x44\x74\x8d\x76\xca\x4f\x9e\xe2\x0e\x0f\xee\x18\x1f\x5c\x78\x44\xd1\xc5\x13\xf8\xfc\xf5\x1d\x32\x7a\x1d\x55\x45\x9a\xee\xc2\xca\xcf\x48\x58\x9f\x2a\x31\xc8\x95\xe2\x36\x7f\xbb\xdd\x6e\xfb\x26\xb2\xed\xf2\x41\xff\x27\x1b\x11\xec\xef\xd1\x45\xed\x2b\xb1\xd9\x6e\x2f\xf9\x09\x6d\x0b\xc6\x07\xf9\x03\x4a\x7a\x74\x0d\x16\x41\x79\x1e\x14\xff\x8f\x91\xc4\xf0\x22\x31\x40\x5f\x30\x74\x9b\x57\x29\x7b\x32\x29\xc2\xc5\x0d\x47\x66\xda\x14\x45\xda\x24\xe2\x3c\x05\xe5\x06\x26\x9f\x4d\x80\x67\x0b\xba\x30\x6d\x1f\x66\x49\xda\x8e\xb0\xb0\x2c\x53\xe2\xd7\x0f\x75\x1b\xad\x7b\xfd\x4f\xff\x94\x4c\xbc\xbf\xa4\x49\xfe\xf1\xe7\x30\xfa\x67\xf7\xe9\x7f\x15\x79\x33\xf1\xae\xff\x49\x0e\x05\xf1\xfe\xfb\xef\xd7\x13\xef\x3f\x8b\x5d\xd1\x14\x13\xef\xfa\x7f\x93\xf4\x8e\xb4\x3e\x89\xf7\x1f\xe4\x44\xae\x27\xde\xbf\x57\x49\x98\x4e\xbc\x3a\xcc\x6b\xbf\x26\x55\xb2\x1f\x23\x46\x76\x3e\x99\x39\x28\x5c\xc8\x38\x7e\x4e\x49\xd3\x90\xaa\x3b\x26\xd6\x29\x3a\x28\xe9\x0e\xb1\x55\x24\xfc\x38\xba\x6e\xb2\x8d\xe4\x77\xc9\xf4\x27\xe2\xf9\x6f\xc3\xc6\x2a\x65\x8c\xc8\xc5\xa5\xdc\x47\xe0\xc2\xb2\xef\xc2\xb1\x36\xc6\xed\x7d\x51\xc5\x8c\x5b\xe1\x2b\xd2\x36\xf4\x24\x23\x05\xef\x9f\x3a\xe9\x48\xf9\xed\x87\xf7\x88\x8f\x40\xb5\x03\x75\x0e\xb6\x3c\x08\xfd\xd9\xdd\x6d\xe1\x0d\x5f\x77\xb5\xdf\x90\xe6\x48\x2a\x9f\xa4\x24\x23\x79\xe3\x87\x4d\x13\x46\x47\x12\xc3\xeb\x2b\x06\xf3\xb3\x6a\x43\xf5\xf7\xe2\x9d\xc2\x0b\x36\x0f\x20\x75\x0d\x55\xf9\x49\x9e\x8f\xc7\xf0\x9d\x58\xc0\x49\xd0\xe5\x4f\xc9\x23\x5b\xf5\x59\x1c\xfe\x7c\x32\x35\x9b\xc2\x25\x0b\xd4\x8a\xb2\xf1\x5f\x9e\xc7\x06\x22\x7b\xde\x07\x0f\x4e\x6c\x66\x67\xfe\xec\x5a\xd4\xb2\x23\xa4\x16\x3c\xca\x1b\xc7\xb0\x52\xa4\x55\xff\x18\xc2\x23\x84\xda\xb1\xa0\x11\x69\x9f\x84\xea\x85\x28\x6f\x6b\xeb\x7b\xdc\x45\x9c\xa2\x48\xfb\x2d\x31\x46\xa1\xf6\x3d\x6b\xd7\x24\x76\x89\x8d\x5e\x4f\x95\x32\xd5\xaa\x99\x3d\x07\x26\x99\x3e\x85\x8e\x06\x4c\xa4\x92\xcf\x69\x96\x68\x5b\x8d\x5d\x6b\x7a\x25\xb3\x50\x53\xf5\xd0\xd7\x68\x99\x0b\x0f\x96\x7a\x0a\x83\xb4\x8b\x14\x15\x93\x6b\xb7\x29\x4b\x25\xd5\x9e\x21\xea\x04\x0d\x93\xf7\x9c\x4d\xde\x83\xdc\x16\xe5\xd9\xbb\x61\x4c\x58\x9c\x96\x56\x45\x8e\xfa\xd3\x0d\x6a\x29\xe1\x3e\x03\xd3\x26\xe8\x58\xc1\x4a\xb4\x77\x85\xd2\x29\xbd\x73\x39\xe9\x06\xf2\xa2\x1c\x12\xb2\xa6\xa4\x3c\x1e\x36\xaf\x15\x9e\x0b\x94\x6d\xeb\xc8\xf1\xce\x70\xff\xf7\x77\xef\xe6\x2b\xf1\x6e\x5e\x4c\x56\x09\xe8\x3c\xfb\x49\xfd\x2a\xf6\xd5\xce\xaf\xe2\x4c\xd5\x98\x86\x44\x48\x03\xdb\x89\x96\x76\x37\x4b\xb9\xd4\x8f\x10\xb4\xc0\x19\x2f\xb0\xe2\xe6\x31\x7e\x22\x1a\x8d\x8b\x7d\x63\xac\xf9\xe5\xbc\x3e\x7f\x36\xc3\x26\x25\xf6\x55\xf6\xe1\xa4\x5e\x66\x3b\x8a\x9f\x4a\xa8\xa3\x80\x46\x1e\x03\x94\xc7\x40\xc5\xe3\xb8\x63\x56\x64\x8a\xf9\x99\x16\xfc\x0c\x7e\x26\x7f\x43\xa7\x52\xc5\xfa\x09\x54\xad\x64\xb4\xdc\x41\x22\x5d\x8d\x4e\x1d\xdd\x63\x8c\x12\x84\x13\x3c\x9c\x92\xf5\xfa\xa5\x6a\x89\x25\xab\x40\xb9\x45\xed\xa2\xc3\x94\x57\x2e\xce\x9b\xb5\x56\xaf\x4b\xc5\x29\x8e\x3f\x51\xb9\xa0\x25\x51\x38\xdc\x4a\xed\x1a\x1c\x6e\x0b\x6e\x98\xc3\x0d\xab\x55\x2b\x97\xd2\xe2\x88\x00\x0e\xf2\x70\x36\x23\xe3\x88\x56\xda\xaf\x76\x10\xea\x95\xeb\x12\xdb\x09\x38\x1d\xd5\xda\xce\x72\xf1\x9e\xbd\xb5\x76\x5d\x2a\x4e\x61\xe8\xd9\x59\x2e\x21\xfa\xe8\x0e\xf3\x6c\x74\x7c\x79\xa3\x91\x4d\x9a\x94\x38\x0b\x53\x81\xef\xe0\x7c\xd2\x5e\x57\xee\xaa\x99\x6b\xdb\x8d\xc7\x12\x48\xe2\x5f\x27\x0a\x1a\x8b\x59\xb4\x78\x8c\xc5\x30\x76\x54\xb4\x35\x83\x0d\x58\x12\x07\x0e\xdc\xba\x09\xda\x37\x95\x2d\xef\xcd\x92\x7e\xbc\xb9\x4f\x22\x1c\xb3\x5d\xd5\xc3\x65\x1d\x16\xd6\x1c\x86\x7c\xd6\xc3\xed\x52\x81\x8e\xd2\x19\x58\xb4\xb0\xe6\x5c\x54\x2a\x1a\x73\xb0\xb4\x34\x44\x49\x37\xe5\xd9\x9b\x2d\x39\xc2\x5c\xd6\x0c\x6e\xec\x31\xbc\xa0\xb4\xd1\x2b\x3c\xd9\xb5\xff\x7b\xaf\x3b\x80\x45\xaf\xbe\x5f\x38\xdd\xd1\xbf\x10\xaf\xe8\xe7\x0d\x80\xf6\x2e\x30\x06\xca\x2d\xea\x33\xd1\x6c\x07\xd1\x00\xd0\x41\xdf\xc0\x97\xb1\xab\x70\x0b\xa3\x34\x25\x4f\x1c\x61\x73\x8a\xad\x4d\x6a\xcc\x66\x52\xb3\xc6\x46\xe8\x31\x07\xc3\x12\x85\x55\x71\xaa\x89\xb8\xeb\x68\x5c\x71\x84\x40\x20\x21\xa2\xdd\x7d\xc1\x76\xd9\xe1\xbb\x9c\x46\x6a\x96\x47\x35\x87\x80\x55\x78\xdd\x9a\xad\xbf\x48\x1b\x5a\xfc\x45\x3c\x5e\xad\x2c\xd4\x65\xbf\x6f\x69\x4d\x37\xf4\x24\x39\xdb\xd3\x63\xde\xbc\x84\xe1\xe8\x77\x30\x99\x6a\x79\x1a\x58\x7c\x3b\x93\x04\x63\x66\x0d\x46\xe9\xfb\x30\x22\xfe\x5d\x52\x27\xbb\x24\xed\x16\x24\xc6\x3d\x3c\x7c\x98\xae\x05\x64\x14\x4b\x52\xd5\x25\xe9\xb6\xe0\x76\x7d\x3c\x2c\xd4\xb1\x7f\x0a\x80\x7e\x29\xae\x3e\x95\x65\x51\x35\xb5\xf7\x46\x56\x07\xb0\x27\x69\x11\x33\x73\xff\xf6\xad\x57\x54\xde\x1b\x13\xd0\x77\x3d\xfa\xa6\xf4\x88\xef\xe8\x61\xdf\x38\xff\xd9\xcf\x87\x17\x87\xc0\xc7\xb2\x22\x77\x9f\x74\x63\x84\xc4\x9e\x81\x0f\xbd\xa3\x7c\x81\xfd\xec\xea\x13\x3e\x75\x4e\xae\x0c\xd9\x32\x21\x7c\x1a\x9d\x5c\xfd\x16\x44\x30\xfa\xf8\x7e\xb3\x00\xfe\x03\xe7\xb9\x63\xb0\xef\xcb\xcb\x99\x6a\xa7\x1d\x17\xbe\x04\x78\x84\xb5\x56\x48\x6a\xd6\x86\xf4\x8e\x91\x33\xdf\x95\x35\x11\xe1\xf3\x9b\xd0\xef\xaa\xf4\x35\xab\xd2\x58\x7d\xeb\x85\x56\x45\x4a\xf9\x93\xbf\xe7\xec\x94\xb7\xd3\x39\x8f\x6f\x62\xeb\x99\x5c\x26\x6d\x1f\x53\xd4\x2d\xe2\xb3\x7f\x1a\x7c\xe6\x5a\xaf\x5e\x59\xaf\x62\xca\x7b\x54\xd1\x5e\x87\xcf\xe0\x21\xa5\xf4\xd8\x18\xae\x19\xec\xbc\x98\xa2\xd4\xfd\xe6\x66\xb8\x51\x18\x1e\x0e\xd9\x6a\x1a\x00\x52\x89\x81\x02\x6c\xd0\xe2\x71\x8d\x5a\x49\xae\x7b\x50\x5f\xd5\xa4\xf1\xb5\x7d\xe5\x0d\xdf\x42\xfe\x8a\xc5\x9d\xec\x6f\xc5\x69\x15\x2f\x2f\xfc\x8a\x94\x24\x6c\x68\x0f\x62\xaa\x27\x5d\x21\x16\x04\xaf\xc0\x8b\x2c\xa3\x1f\xa6\x82\xd2\x37\xba\x5f\x32\x91\xae\x67\x3b\x55\xe9\x9b\xeb\x38\x6c\xc2\xdb\xee\xc3\xbb\xfa\xee\xf0\xe3\x39\x4b\xdf\x47\xc7\xb0\xaa\x49\xf3\xe1\xd4\xec\x6f\x26\xaf\x16\x7f\xad\xef\x0e\xde\x39\x4b\xf3\xfa\xc3\xeb\x63\xd3\x94\xb7\xef\xde\xdd\xdf\xdf\x4f\xef\x17\xd3\xa2\x3a\xbc\x9b\x07\x41\xd0\x62\xbe\xf6\xf6\x49\x9a\x7e\x78\xfd\x6a\xbe\xd8\xef\xf7\xaf\xbd\xbb\x84\xdc\xff\xa5\x38\x7f\x78\x1d\x78\x81\x77\xe3\xdd\xbc\xee\x5f\xf2\x2f\xc3\xe6\x48\x1f\xfc\x0f\x52\x7f\xe9\xf5\xff\x9b\x4d\x57\x7e\xfb\xff\xf3\xfe\xff\x3d\xfa\xd3\xa7\xdf\x7f\x7b\xfd\xae\xc7\x6e\x2b\x7a\xb5\xf8\xdb\xf5\x5b\x8d\x3a\x3c\xdb\x16\xcf\xa6\xab\xb6\xcd\xed\x8f\x59\xdf\x46\x0f\xb4\xd7\x63\xdf\x97\x7e\xf7\x3f\x63\x9b\x93\x3c\x4e\xa2\xb0\x29\xaa\x5a\x63\xc6\xb1\xfd\xf5\x2c\xe5\x85\xee\x15\x58\x7d\x3e\x2b\xff\x05\x8c\xad\xfa\x42\xb1\xe1\x8d\x3c\x79\xeb\x10\xfd\x26\x3f\x08\xa3\xea\x8e\x34\x79\xfc\x2e\xf2\x40\xb9\x8b\x3c\x50\xee\x22\x0f\xc0\xe1\x1b\xb0\x71\x96\x37\x57\x0b\x2e\x57\x59\x61\x1f\xc1\x26\x39\x6a\xd1\x5b\xed\x68\x25\xe9\x0f\xfb\x87\xb5\xc7\xf5\xf4\x0f\xde\xae\x34\x9a\x9c\x26\xb6\x2b\x0e\x3e\xa2\xc6\x26\xd3\x2d\x9f\x49\x45\x16\x1d\x74\xac\x99\x32\x95\xd2\xb2\xf4\xe7\x62\x0e\x9e\xa0\xd6\x5d\x13\x35\x5a\xcc\xb0\x1c\xae\xc8\xd3\x9a\x0e\xaa\xfd\xc3\x8d\xed\x5c\xc3\x68\x21\xd8\x6a\xf4\x5e\x3c\xfd\x3a\xe7\xf7\x6c\xf9\x22\x21\x0b\x8f\xa7\xbf\x06\xb9\x75\xde\xd8\x33\x16\x1d\xdb\xaa\x37\x2e\xbc\x3f\x25\x59\x1b\x4e\x85\x79\x03\x71\xd9\xaa\xaa\x88\xd6\x7e\xc7\x31\xb2\x24\x8e\x53\xbc\x2e\x5a\x84\xe3\x81\x3d\x28\x12\x8f\x7d\x91\x82\xc3\xb6\xed\x1a\x64\x58\xae\xa1\xa0\x6c\x28\x2b\x14\x71\x77\x07\x7f\x1f\xc6\xf2\x15\xbb\xd8\xaa\xe3\xee\x60\xf7\x0e\x85\x58\x49\x08\x30\xc1\x3b\x20\xe3\x37\x8b\x27\x28\x30\xc6\xad\x9e\xa2\x40\x99\x91\xee\x24\x02\xdf\x2c\x5e\xa1\xc0\x98\x31\xbf\x46\x81\x72\xc2\x5f\x5c\xc3\x3e\x58\x3c\x44\x81\xf1\x60\xf5\x20\x05\xca\x86\x74\xbb\x09\xf8\x66\xf1\x16\x05\xc6\x8c\xcd\x9b\x14\x28\x2f\xe2\xd5\x17\xe3\x27\x8b\xe7\x28\xf0\xae\x19\x9f\x85\x47\x10\xfb\xfb\x2e\x15\xbd\xd3\x61\x0a\x1d\xd4\x7f\xd3\x32\x33\x0b\x66\xb3\xd9\x5c\x66\xa6\x5f\x0f\x0a\xe8\x3e\x26\x7a\x8e\x5d\x05\xd6\x14\x25\x07\x4a\xaf\x36\x53\x81\xf7\xeb\x81\x1c\x02\x73\x01\x55\x28\x74\x8b\x01\x87\x33\xc4\xfc\x2a\xa4\x6e\x21\x91\x43\xa1\x33\x9d\x84\xd0\xc9\x84\x70\xd7\xa9\xa1\x9b\x6c\x29\x9c\xf9\x25\x72\xd7\x5b\x26\x19\xe1\x31\x4b\xf4\xa4\x8f\xe0\x31\xf2\x96\xef\x99\x5f\x70\x49\x25\xab\x61\xc8\x25\x3d\xe1\x9b\x81\x8c\x76\x94\x54\x51\x4a\xb0\x4e\x5a\xb1\xf0\x92\x81\x06\x68\x57\xb2\xdb\x24\x48\x58\xed\x93\xf3\xe3\x1e\xe3\x8c\xfd\xd6\xbf\x96\xd7\x9c\x25\xdd\x8a\xfd\xde\x97\xc2\xe2\x76\x25\x70\xef\x78\xa9\x43\x7d\x04\x11\xc1\x50\x81\x36\xe1\x2e\x15\xf8\xe9\x3f\x29\x40\xfd\x88\xd0\x2b\x1b\x79\xf8\xfe\xbb\x8c\xd4\xfa\xfa\xca\xb5\x0e\x0e\x5e\x11\xae\x29\x61\xc6\xc8\x4d\x05\x22\xa1\x73\x62\x55\x73\xc6\xa4\x6b\x66\x10\x92\xd2\xf0\x09\xc0\x34\x44\x55\xc4\xcc\x27\x19\x63\xbf\xce\x46\x1d\xd4\x69\x21\x4d\x09\x77\x08\x40\x17\xf5\xda\x28\x23\x01\x0d\xb3\xd1\x4a\x48\x00\xc5\xd4\xa3\x8c\x3a\xaa\xd5\x52\x09\x65\xd4\x55\xb3\xb6\x42\xe4\x41\x33\xac\xb4\xd6\x4e\x6f\xad\x34\x57\xa7\xbb\x48\x27\xe8\xf8\x54\xea\xb0\x93\x16\xbb\xe8\xb1\x85\x26\xab\x4f\xc3\x6e\xd6\x37\x40\x9b\xb3\xd8\x51\x9b\xb3\xf8\x02\x6d\xce\x10\x0b\xeb\xa4\xcd\x59\xec\xac\xcd\x59\xec\xac\xcd\x99\x64\x79\x1d\xb4\x39\x8b\x9f\xb9\x36\x67\xb2\x3d\x7e\xf9\xda\x0c\xcf\x76\xc7\x7e\x7a\x70\xd4\xe6\xf4\x70\x81\x36\x0f\x48\x97\x6a\x73\x7a\x70\xd6\xe6\xf4\xe0\xac\xcd\x0c\xe5\x22\x6d\x4e\x0f\xcf\x5c\x9b\xc7\x4e\xf8\x8a\xb4\x79\xd6\x9d\x63\x1c\xd4\xf9\x9c\x3a\xaa\xf3\x39\xbd\x40\x9d\x07\xa4\x4b\xd5\xf9\x9c\x3a\xab\xf3\x39\x75\x56\x67\x86\x72\x91\x3a\x9f\xd3\x67\xae\xce\x63\x27\xbc\x54\x75\x9e\xf6\xaf\x64\x24\x55\xdd\x87\xa2\xfc\x8d\x5a\x71\x92\x87\x69\x7f\xbd\xa7\x70\x35\x1f\x4d\x73\xf8\x33\x69\x15\x47\x2a\x69\xff\x81\x8f\x43\xa5\x69\x68\xae\x73\x8e\xd4\xa9\xac\x12\xab\x11\x56\x78\xca\xbb\x8f\xc2\x63\x4d\x48\xad\x33\xa4\xd6\x40\x55\x6b\x80\xd4\x1a\x80\x5a\x2b\x7a\xd7\x04\x76\x57\xd9\xb1\xa8\x92\xdf\x8a\xbc\x09\xc5\x01\xa0\xbd\xb8\x4c\x01\x2b\xde\x3f\xd6\x56\x2c\xaa\x05\x7e\x97\x19\x0e\xe9\x21\x77\x9a\x89\x90\x43\x2b\xfb\xcb\xce\x94\x0d\x65\xa9\xeb\x4f\xd0\x4c\x5a\xb3\x5d\x4b\x95\xc0\x58\x63\x11\x60\xd8\xab\x7e\x45\xc6\x14\xe4\x53\xf4\x2e\xa3\x67\xdd\xbd\x3e\x8e\xa1\xe9\x66\x0d\x86\xa2\xbb\x51\x0c\xa1\xdb\x8d\x92\x70\xeb\x7e\x37\x39\x08\x2c\xb8\xe8\x81\xa3\x34\x34\x48\x83\x40\xee\xab\xb0\xe4\xc4\x30\x7c\xbd\xed\x5e\x20\xd2\xf1\xa7\x85\x1a\x18\x52\x40\x0d\x1c\xf4\x6f\xc6\xab\x78\xa0\xa5\x46\x2e\x94\x70\x02\x1f\x08\x1c\x27\x0b\x54\x35\x84\x76\xd8\xf4\x9d\x15\x34\x22\x23\x65\x6f\x09\x9b\x0a\xfa\x57\xe2\x24\x05\xee\xb7\x24\xf4\x65\xb8\x3a\xe2\x17\x91\x61\x18\x9e\xbc\xd7\x41\x05\xe6\x29\x6f\x38\x93\x31\xb0\xc6\x90\x5c\x9e\xe3\x86\x6b\xd4\x9c\x1a\x22\xc3\x23\xcd\xc0\x81\xd4\x8d\x10\xe1\xb1\x26\xd0\xbd\x55\x78\x2b\x68\xa1\x65\x43\x50\x68\xa4\x19\x4a\x38\xb4\x25\x08\x34\xd6\x0e\x7a\x0b\xa2\xa2\x21\x14\xda\xb6\x25\xdc\xc5\x8a\xe6\x06\xe1\xd4\x95\x2d\x52\x53\xc7\x1a\x16\x76\xeb\x07\x5c\xbb\x14\x14\x29\xa4\x91\xdd\x38\xa9\x9b\x2a\xd9\x9d\x1a\xd5\xd8\xb6\xa7\x3f\xae\xa0\x77\xdb\x43\x15\xa3\x1b\x5e\x06\xa2\xe8\x02\xc5\x73\x92\xba\xc6\x68\xa8\xb2\x7f\x36\x54\xc5\x26\x60\x63\x9a\x56\xa5\x1e\xd4\x72\x45\x86\x01\xad\xa4\xa8\x66\x1d\x1b\xcd\x90\x71\xc5\x48\xe6\xf6\x84\xd8\xb0\x6f\x1e\xc6\x3a\x92\x28\xff\x8a\x31\x0c\xb9\xe7\x76\xa8\x20\xfc\xa3\x9b\x54\x14\x2d\x50\xc0\x62\x6d\xd0\x80\x4a\xad\x30\xec\x93\x61\x23\xa0\x22\x4d\x74\x54\x8f\x81\xbe\xd8\xa2\x15\x38\x28\x3e\x04\x54\x90\x52\x1b\x30\xd0\xb1\x09\xea\x49\x9a\x2b\xb7\x1d\xa1\x5d\x44\x6c\x9e\x81\xed\x68\xcb\x5c\x8a\x23\x15\xa3\x63\x18\x86\x80\x43\xd3\x50\x34\x4f\xad\x3c\x77\xc8\x70\x14\x08\x99\x07\x1a\x60\xcf\x62\xb0\x99\xa6\x4c\x9e\x3f\x6c\xc2\x14\xe8\x58\x4f\x83\x80\x4f\xfd\x5c\x68\x5d\x81\xcc\x2f\x32\x0f\xa2\xd4\xcc\xb3\x20\xe0\xd6\x38\x15\xda\x56\x81\x8d\x21\xd9\x10\x88\xd4\xcc\x23\x9c\x1b\x42\xe6\x51\xae\x25\x3d\xf2\x58\x93\x74\xef\x87\x27\xfa\x3a\x3f\xcf\x5d\x5b\xd6\x5f\xfa\xa5\xe3\xab\x7f\x06\xb7\x37\x3f\x0a\x58\xf6\xaf\xc5\x39\x54\x49\xdc\xc5\xf2\x66\x14\x2d\x17\x42\x13\x54\x76\xaa\xc7\xb6\x33\x52\xb0\x25\x66\x2b\xa5\xa5\x2c\x70\x87\xdb\x27\x40\xc1\x60\x9c\x20\x67\x26\xeb\xa4\xa1\x2a\x70\xa5\xb4\x4b\x3d\x09\xb3\x51\x82\x6c\x69\xad\x92\xaa\xf3\x2d\x4c\x99\x92\x17\xa1\x31\xa8\xd7\x00\x49\xd8\xb8\x02\xb0\x41\x56\xfe\x80\x86\xb4\xa4\xa0\x2a\x23\xd0\xd3\xb0\xb0\x00\xbc\x7e\xea\x4c\x80\x4a\xde\x36\x86\x43\xcd\x8e\x79\xd7\x47\x3f\x1a\x32\x90\xdd\x36\xe7\xb7\x35\x19\x6e\x53\x8e\x9b\xcb\x72\xd3\x25\x02\xc6\xc2\x90\xeb\x36\x67\xbb\xd5\xf9\x6e\x43\xc6\x1b\xe6\xbc\x85\xea\xf9\xcc\xb7\x39\xf7\xad\xce\x7e\x1b\xf2\xdf\x30\x03\x2e\xf0\xc0\xf2\xe0\x8e\xb9\x52\xb7\x24\xb1\x6b\x36\xdc\x35\x1f\x6e\xce\x88\x0b\xad\x06\x79\x71\xa7\xd4\xe8\x53\x35\x5b\x93\xf0\x76\xce\x8f\x5b\x65\xc8\xe5\x5e\xe7\x52\x80\x4f\xd5\xfb\xaa\x24\xe0\x65\xd9\xf2\x4b\xf3\xe5\xf6\x19\x73\x54\x2d\x2c\x24\xe3\xaa\x1e\xae\x72\xb1\x48\x84\x5f\x9c\x3f\x77\xca\xa0\x0b\x02\x1a\x72\xd8\x2e\x99\x74\x97\x5c\xba\x3e\x9b\x2e\x70\x03\x72\xea\x6e\x59\x75\xb7\xbc\xba\x29\xb3\x8e\xc8\x48\xa1\x42\x2e\x39\x73\xf7\x1c\xbb\x5d\x96\x9d\x72\x2b\x25\xda\x33\xe0\x1e\xdb\x66\xdb\x2f\xcd\xb7\x3b\x65\xdc\xed\x73\xee\xea\xa6\x31\xdf\xda\x2e\xf7\x7e\x59\xf6\xdd\x21\xff\x6e\x9b\x81\x57\x37\x08\xb8\xe5\xd6\x99\xf8\x4b\x72\xf1\x6e\xd9\x78\xbb\x7c\xbc\xba\x55\x30\xc9\x60\x9f\x97\x7f\x44\x66\xde\x31\x37\xef\x94\x9d\x57\x37\x13\xe4\x26\x2e\xcf\xd2\xbb\xe7\xe9\x5d\x32\xf5\x94\x79\x2e\x55\xa9\xb6\x10\xda\x78\xd8\x3d\x67\xef\x96\xb5\xb7\xcb\xdb\xe3\x0d\xc2\xed\x82\x26\x88\x76\xcd\xe0\xbb\xe4\xf0\x6d\xb2\xf8\x78\x33\x94\xd6\x40\x1f\x42\xbb\xe5\xf3\x1d\x33\xfa\xe6\x9c\x3e\xde\x16\x2e\x46\x77\xc9\xed\xbb\x67\xf7\x9d\xf3\xfb\x36\x19\x7e\xd5\xc8\x19\x23\x7b\x87\x4c\xbf\x73\xae\xdf\x35\xdb\x6f\x91\xef\xe7\x1a\x64\x70\x17\x5c\xf2\xfe\xee\x99\x7f\xdb\xdc\xbf\x8a\x63\x79\xb4\xdb\xaf\x01\xb8\xae\x02\xd8\xad\x03\xa8\x38\x45\x07\xb4\xed\x7a\xc0\x05\x2b\x02\x36\x6b\x02\x2a\x5e\xf1\x49\xdb\x71\x6d\xe0\x92\xd5\x01\x87\xf5\x01\x15\xef\xe8\x4c\xec\xb6\x4e\x70\xe1\x4a\x81\xfd\x5a\x81\x7a\xfc\x61\x26\xc5\x7a\xcd\xe0\x92\x55\x03\xab\x75\x03\x8e\xdf\x3e\xad\x99\x8d\xab\x07\x4e\xeb\x07\x97\xad\x20\x38\xaf\x21\x18\x56\x11\xd0\x06\xa9\xad\x9f\xc5\x6a\xc2\x05\xeb\x09\x56\x2b\x0a\x28\xa7\x2a\xab\x67\x5c\x59\x70\x5e\x5b\xb0\x58\x5d\x40\x39\xd4\x58\x3b\xc3\x2a\xc3\xa5\xeb\x0c\x17\xac\x34\x18\xd7\x1a\xd0\xa6\x29\xbc\x19\xcb\x35\x87\xcb\x56\x1d\x2c\xd6\x1d\x14\x2a\xad\x36\x28\xa6\xf5\x87\x8b\x57\x20\x2e\x59\x83\x30\xaf\x42\xd8\x9d\xd8\xeb\xd8\xcd\xe2\x2f\xbe\x12\x91\xc5\x5f\x74\x25\x22\x8b\xbf\xfc\x4a\x44\x16\x7f\x8b\x2b\x11\x59\xfc\x6d\xaf\x44\xf4\xbd\xfe\x7d\x25\x42\xa5\x16\xdf\x57\x22\x94\x02\x7a\x46\x2b\x11\xdd\xb1\xef\x67\xb7\x12\x41\x65\xf4\xf2\x56\x22\xb2\xf8\xab\x5d\x89\xc8\xe2\xaf\x6c\x25\xa2\xb5\x54\x5f\xdf\x4a\x44\x16\x7f\x13\x2b\x11\x59\xfc\x42\x57\x22\x34\x16\xe2\x65\xae\x44\x28\xed\xc2\xcb\x5a\x89\xd0\x59\x83\x97\xb6\x12\xd1\xda\x80\xaf\x6f\x25\xa2\x1b\x39\x5f\xd7\x4a\x84\xc2\x18\x3c\xe3\x95\x08\x74\xb4\x3f\xcb\x95\x08\xd5\x80\x7e\x8e\x2b\x11\xca\x49\xfb\x05\xac\x44\xa8\x66\xe2\x97\xb0\x12\xa1\x34\x29\xcf\x71\x25\xa2\x15\xf4\x57\xb5\x12\xa1\xb5\x7e\xcf\x6a\x25\x42\x63\xf5\x9e\xc9\x4a\x84\xde\xda\xbd\xe8\x95\x08\xb5\x37\xf3\xfc\x56\x22\x0c\x06\xe5\xab\x59\x89\x80\xb7\xad\xf5\xc6\xef\xf0\xc5\x57\x22\xd2\xc3\x17\x5d\x89\x48\x0f\x5f\x7e\x25\x22\x3d\x7c\x8b\x2b\x11\xe9\xe1\xdb\x5e\x89\xe8\x7b\xfd\xfb\x4a\x84\x4a\x2d\xbe\xaf\x44\x28\x05\xf4\x8c\x56\x22\xba\x2b\x3b\x9f\xdd\x4a\x04\x95\xd1\xcb\x5b\x89\x48\x0f\x5f\xed\x4a\x44\x7a\xf8\xca\x56\x22\x5a\x4b\xf5\xf5\xad\x44\xa4\x87\x6f\x62\x25\x22\x3d\xbc\xd0\x95\x08\x8d\x85\x78\x99\x2b\x11\x4a\xbb\xf0\xb2\x56\x22\x74\xd6\xe0\xa5\xad\x44\xb4\x36\xe0\xeb\x5b\x89\xe8\x46\xce\xd7\xb5\x12\xa1\x30\x06\xcf\x78\x25\x02\x1d\xed\xcf\x72\x25\x42\x35\xa0\x9f\xe3\x4a\x84\x72\xd2\x7e\x01\x2b\x11\xaa\x99\xf8\x25\xac\x44\x28\x4d\xca\x73\x5c\x89\x68\x05\xfd\x55\xad\x44\x68\xad\xdf\xb3\x5a\x89\xd0\x58\xbd\x67\xb2\x12\xa1\xb7\x76\x2f\x7a\x25\x42\xed\xcd\x3c\xbf\x95\x08\x83\x41\xf9\x6a\x56\x22\xb8\x97\x32\x3a\x7e\xcf\xe9\x17\x5f\x8a\x38\xa7\x5f\x74\x29\xe2\x9c\x7e\xf9\xa5\x88\x73\xfa\x2d\x2e\x45\x9c\xd3\x6f\x7b\x29\xa2\xef\xf5\xef\x4b\x11\x2a\xb5\xf8\xbe\x14\xa1\x14\xd0\x33\x5a\x8a\xe8\x9e\x5b\x7a\x76\x4b\x11\x54\x46\x2f\x6f\x29\xe2\x9c\x7e\xb5\x4b\x11\xe7\xf4\x2b\x5b\x8a\x68\x2d\xd5\xd7\xb7\x14\x71\x4e\xbf\x89\xa5\x88\x73\xfa\x42\x97\x22\x34\x16\xe2\x65\x2e\x45\x28\xed\xc2\xcb\x5a\x8a\xd0\x59\x83\x97\xb6\x14\xd1\xda\x80\xaf\x6f\x29\xa2\x1b\x39\x5f\xd7\x52\x84\xc2\x18\x3c\xe3\xa5\x08\x74\xb4\x3f\xcb\xa5\x08\xd5\x80\x7e\x8e\x4b\x11\xca\x49\xfb\x05\x2c\x45\xa8\x66\xe2\x97\xb0\x14\xa1\x34\x29\xcf\x71\x29\xa2\x15\xf4\x57\xb5\x14\xa1\xb5\x7e\xcf\x6a\x29\x42\x63\xf5\x9e\xc9\x52\x84\xde\xda\xbd\xe8\xa5\x08\xb5\x37\xf3\xfc\x96\x22\x0c\x06\xe5\x65\x2e\x45\x4c\xf7\x69\x11\x36\x7e\x4a\xf6\xfd\x50\xed\xfe\xbc\xf5\xba\xbf\x21\xf8\x08\x5a\x25\x87\x23\x07\xdb\x7f\xc0\x81\x87\xe7\xbe\x29\xac\xf4\xd4\xb7\xcd\x5b\x15\x2d\xa1\x3a\x1b\x79\x54\x73\x39\xe4\x99\x28\xca\xc8\xab\x86\x5b\x11\x09\x3c\x51\xae\xe2\xda\xf6\x6e\xab\x96\x62\x16\x3b\x73\x9e\xc5\x17\x70\xde\x5d\x81\xf2\x08\xce\xf9\xb3\x30\x9d\x52\x1c\x9c\x39\x4f\x0f\x17\x70\xde\x6d\x99\x7e\x04\xe7\xc2\xda\x59\x4b\xf2\x9c\x3a\xb3\x7e\x4e\x2f\x60\x9d\x7f\xd1\xde\xc0\xfa\x74\x9f\x9c\x49\xec\x37\x45\x9f\x90\x2d\x8b\x3a\xe9\xb3\xcb\xdd\xf7\x16\xac\x29\xd8\x72\x5e\x57\x2f\xfd\xbd\xe5\x9b\xfe\xfa\x9b\x9f\xe4\x31\x39\xdf\x7a\xb3\x60\xc1\x9e\xd2\xee\x88\xee\x8a\xa6\x29\x32\x15\x5d\x48\xad\x87\xb4\x22\x5d\x37\x49\xf4\xf1\x01\x61\x98\x59\xbe\x1e\xe0\x3d\x57\x36\x7e\x1b\x5b\x83\xd1\xae\xfc\x22\x4f\x1f\x04\xc2\xe1\xae\x2e\xd2\x53\x43\x5a\x24\xd6\xbb\xe5\xb9\xfd\xeb\x48\xfa\x36\xd0\x3f\xcb\x30\x8e\x93\xfc\x40\xe9\x67\x61\x75\x48\x5a\xbe\x68\x69\x71\x47\xaa\x7d\x5a\xdc\xdf\x7a\xc7\x24\x8e\x49\xde\x7e\x8b\xd2\xa4\xbc\xf5\x2a\x12\x35\x6f\x82\x89\x47\xff\xef\x6d\x2f\x11\xee\x6d\x72\xca\x99\xbf\x2f\xa2\x53\x1d\xee\x52\x72\x1b\x46\x4d\x72\x47\x26\x1e\x52\xd4\xfd\x26\x34\xa2\x6e\xc2\x26\x89\x40\x13\x5a\x7f\x09\xb6\x81\xfd\xcd\xd8\x0e\x78\x9e\xef\x92\x3a\xd9\xa5\x64\x64\xba\x87\x6f\x79\xbb\xf7\xe7\xab\xae\x36\x4a\x79\xbe\x7a\x25\x59\xde\x7b\x7f\x15\x40\x98\x55\x80\xc1\x6c\x38\x3a\x1b\x94\xce\x2c\xe0\x08\xcd\x02\x84\xd2\x91\x71\xc4\x1a\x87\xb1\x74\x64\x2c\x31\x20\x8c\xa7\x23\xe3\x89\x01\x61\x4c\x1d\x07\xa6\x06\x85\xc0\xb8\xca\x46\xe6\xb3\xf0\xec\xeb\x1a\x90\x1d\x39\x50\x3d\x59\x9f\x01\xd2\x8e\xf3\x02\x19\xa6\xe1\x80\xfc\x7e\x14\xc8\x60\x15\x0f\xc6\x86\xa8\x0c\xb8\xe3\x01\x87\xf1\x2b\x43\xa6\x3c\x24\x1d\xdc\x32\xdc\xd9\xa2\x6a\x33\x95\x07\x63\x3b\x6d\x78\xf6\x67\xbc\x44\xa7\xf3\x55\x45\xb2\xe1\x27\x22\xdd\x99\x5c\xab\x0a\xb8\xe2\x81\x59\x43\x55\xe0\x3b\x1e\x7c\xe0\x5a\x05\x9f\xf2\xf0\x54\x52\x2a\xe8\xb3\x35\x33\xb6\x14\x1f\x2c\x65\x61\xdf\x22\x7f\x2e\xf4\x06\xed\x0c\x55\x5f\xcc\x91\xfa\x55\x5d\x31\x47\x5b\xaf\xea\x89\x39\xde\x13\xaa\x8e\x98\x63\x1d\xa1\xea\x07\x4b\x4e\x2c\xe9\x3d\xd8\x49\xc1\xba\x31\xfe\x82\xeb\x83\x59\x0b\x34\x53\xc8\x7f\x21\xd5\x8c\x43\x56\x3c\x24\x6d\x33\x0e\xbb\xe3\x61\x19\xbb\x38\x70\xca\x03\xf7\x92\xc2\x41\xcf\x76\x3c\x58\xd1\x7a\xb0\x69\xb9\x65\x13\xfc\x25\x2f\xef\xbe\x5b\x66\x4a\x9d\x5f\xca\x35\x2b\x75\x7e\x89\xb5\x58\xa9\xf3\x4b\x54\xee\x4a\x9d\x5f\x22\x92\x57\xea\xbc\x25\x27\x96\xf4\x1e\xec\xa4\x60\xdd\x18\x7f\xc5\xf5\xc1\xa2\x05\x5a\x28\xe4\xbf\x92\x6a\xc6\x21\x2b\x1e\x92\xb6\x19\x87\xdd\xf1\xb0\x8c\x5d\x1c\x38\xe5\x81\x7b\x49\xe1\xa0\x67\x3b\x1e\xac\x68\x3d\xd8\xb4\xdc\xae\x09\x25\x9d\xba\x47\x2f\x1a\x99\x9a\xcb\x86\x87\x52\x79\x32\x65\x25\xc0\x29\x5d\x99\x72\x27\x40\xaa\xfd\x82\x32\x15\x40\x55\x6e\x48\x79\xb6\xa9\xdd\x82\xce\x83\xb9\xb5\x56\x8c\xd3\x39\x79\x14\xad\xc1\xa3\x29\x1b\x1e\x43\xef\xd2\x94\x95\x00\x6d\xf0\x69\xca\x9d\x00\x6f\x72\x01\xca\x54\x40\xd0\xfb\x20\xe5\xd9\x9e\x1f\x6b\x9a\x0f\xb6\x12\x71\x68\x16\x9d\xa4\x41\xb7\xe8\x5c\x9b\xb2\xe1\xe1\xb5\xbe\x4d\x59\x09\xc0\x7a\xe7\xa6\xdc\x09\xe0\x06\x87\xa0\x4c\x05\x78\xad\x3b\x52\x9e\xad\x99\xb1\xa5\xf8\x60\x29\x0b\xfb\x16\xd1\xd9\x7b\xe8\x0c\xb5\x8f\x53\x36\x3c\xac\xc6\xc9\x29\x2b\x01\x54\xe7\xe5\x94\x3b\x01\x58\xeb\x23\x94\xa9\x00\xad\xf1\x4d\xca\xb3\x25\x1b\x76\xd4\x1e\xac\xda\x6f\xdb\x0e\x3a\x6d\x8f\x82\xd7\x3a\x3b\x65\xc3\xc3\x6b\xbd\x9d\xb2\x12\x80\xf5\xee\x4e\xb9\x13\xc0\x0d\x2e\x42\x99\x0a\xf0\x5a\x07\xa5\x3c\x5b\x33\x63\x4b\xf1\xc1\x52\x16\xf6\x2d\xa2\xf3\xf9\xd0\x19\x6a\xaf\xa7\x6c\x78\x58\x8d\xdb\x53\x56\x02\xa8\xce\xef\x29\x77\x02\xb0\xde\x6b\x48\x05\x68\x8d\xb7\x52\x9e\x2d\xd9\xb0\xa3\xf6\x60\xd5\x7e\xcb\x76\x80\xa7\x70\x98\xc3\x89\x3e\x5b\x9f\x35\x22\x60\x5f\x2f\x0e\x5c\x49\xc0\xd5\x98\xed\xc3\x3c\x4e\x11\x9c\xf1\x8c\xc3\xa7\x12\x7c\x2f\x2f\x1c\xfa\x6c\xcd\x8c\x15\xb9\x07\x4b\x41\x58\xb5\xc5\xb0\xde\x93\xf9\x35\x4b\xb3\xa9\x13\x6d\x34\x09\x9f\x35\x32\x30\xee\xb8\xf5\xe0\x15\x02\xae\xf0\x17\x7b\x84\x1d\x82\x80\xfb\x7e\x0c\x23\x45\x30\x30\x9f\x93\xc1\x9f\xed\x59\xb2\xa2\xf7\x60\x2b\x11\xcb\xf6\xb4\xe4\x66\x62\x6f\x68\x5c\x5a\xae\x67\x66\x18\x1f\x1a\xa4\x0a\x41\xd2\x3a\x94\xa0\x97\x66\x8a\x5e\xd2\xe0\xa5\x08\x9e\xda\x35\xe5\x7a\xcc\x85\x49\x07\xda\x0f\x6e\x52\x73\x6a\x6d\x4b\x7a\x2e\xf5\xa4\xca\x0b\xe6\xfa\x71\x8e\x72\xa4\xef\xc6\xb9\x42\x42\xfa\x5e\x9c\xab\x7a\x51\xdf\x89\x73\xbc\x13\xf5\x7d\xe8\xc0\xa1\x3d\xe5\x07\x27\x79\xb9\x34\xb4\x25\xbc\x10\xfa\x0f\x77\x9c\xb9\xbe\x5b\x20\xbc\x28\x31\x2a\x04\x43\xed\xbf\x82\x7e\x5b\xe0\xfd\xa6\x44\x4a\x11\x24\x85\x1b\xcc\xf5\x99\x35\x6f\xb6\x54\x1f\x1c\x64\x64\xdf\xbc\x96\xe8\x52\xec\x2b\xa5\xaf\xcd\xf5\xd7\x12\xe3\xc5\x30\xd6\x96\xb8\x54\x0c\x63\x6d\xa9\xe8\x33\xc3\x58\x5b\xa2\xbd\x66\x18\x6b\x0e\x1c\xda\x53\x7e\x70\x92\x97\x4b\x43\x5b\xc2\x2b\xa1\xff\x70\xf7\x9c\xeb\xbb\x15\xc2\x8b\x12\xa3\x42\x30\xd4\x5e\x32\xe8\xb7\x15\xde\x6f\x4a\xa4\x14\x41\x52\x38\xdb\x5c\x9f\x59\xf3\x66\x4b\xf5\xc1\x41\x46\xd6\xcd\x2b\xa1\xc3\xa3\x4c\x68\x32\xe0\x06\x81\xd6\x78\x8c\x65\x85\xc1\xeb\x5c\xc6\x72\x87\x61\x68\x7d\xac\x32\xc5\x50\x34\x4e\x5e\x79\x76\xe0\xca\x8e\xe2\x83\xb5\x5c\x6c\x1b\x05\x3d\x19\xab\x64\x28\xd7\x45\x33\x94\x15\x0d\x56\x85\x61\x99\x7d\xc7\x72\x87\xe1\x59\xb8\x53\x65\x8a\x21\x1a\x3d\xbc\xf2\xec\xcc\xa7\x0b\xf5\x07\x47\xd9\xb9\x35\x19\xba\x36\x16\x89\x54\xae\x43\xe7\x38\x53\xfa\xfe\x14\x91\x8c\x4e\x64\xb9\xc3\xd0\xcc\xce\x55\x99\x62\x78\x26\x67\xaf\x3c\xbb\x32\xe9\x40\xfb\xc1\x4d\x6a\x4e\xad\x85\x3e\x8f\x21\x09\xcb\x75\xe2\x02\x63\x47\x89\x52\x61\x28\x06\x6f\xb2\xdc\x61\x48\x26\x7f\xab\x4c\x31\x2c\xbd\xe7\x57\x9e\xdd\xd8\xb3\xa6\xfb\xe0\x22\x29\x87\x36\x42\x67\xc7\x22\x81\xcb\x75\xdc\x12\x65\xc7\x30\xfa\x44\x24\xa3\x5b\x59\xee\x30\x34\xb3\xbb\x55\xa6\x18\x9e\xc9\xfd\x2b\xcf\xae\x4c\x3a\xd0\x7e\x70\x93\x9a\x53\x6b\xa1\x17\x64\x48\xfe\x72\x9d\xb8\xc2\xd8\x51\xa2\x54\x18\x8a\xc1\xbf\x2c\x77\x18\x92\xd1\x03\x4b\x31\x2c\xbd\x2f\x58\x9e\xdd\xd8\xb3\xa6\xfb\xe0\x22\x29\xfb\x36\x66\xc2\x0b\xea\xca\xc4\x31\x17\x0f\x48\x08\xca\xbc\x29\x08\x09\x64\x24\x75\xee\x16\x44\x05\x32\x9a\x32\x09\xcb\x05\x06\x32\x9e\x22\x13\xcc\xc5\x06\x2e\x4c\xda\x12\x7e\x70\x13\x99\x5d\x3b\xcd\xdb\xf4\x33\x3f\x8b\x1d\x12\xce\x12\xb0\x3e\xe1\x2c\x83\x1b\x12\xce\x32\x82\x29\xe1\x2c\x63\xe8\x13\xce\xd6\x2c\x59\x26\x9c\x2d\x25\x62\x9b\x70\xce\xe2\x0b\x13\xce\x12\xa2\x4d\xc2\x59\x46\xb2\x4a\x38\xcb\x68\x76\x09\x67\x19\xcf\x26\xe1\xec\xc8\xa4\x53\xc2\xd9\x49\x6a\x6e\x09\xe7\x2c\xbe\x28\xe1\x2c\xa1\x59\x24\x9c\x65\x1c\x9b\x84\xb3\x8c\x65\x95\x70\x96\xd1\x2c\x12\xce\x6e\x1c\xba\x24\x9c\x5d\xe4\xe5\x94\x70\xce\x62\xe7\x84\xb3\x84\x62\x4c\x38\xcb\x18\xe6\x84\xb3\x8c\x63\x91\x70\x96\x91\x8c\x09\x67\x17\xde\xec\x13\xce\xf6\x32\x72\x48\x38\x67\xf1\x45\x09\x67\x09\xcd\x22\xe1\x2c\xe3\xd8\x24\x9c\x65\x2c\xab\x84\xb3\x8c\x66\x91\x70\x76\xe3\xd0\x25\xe1\xec\x22\x2f\xa7\x84\x73\x16\x3b\x27\x9c\x25\x14\x63\xc2\x59\xc6\x30\x27\x9c\x65\x1c\x8b\x84\xb3\x8c\x64\x4c\x38\xbb\xf0\x66\x9f\x70\xb6\x97\x91\x43\xc2\x19\x38\x3c\x16\x09\x67\x19\x5a\x9f\x70\x46\xe0\x0d\x09\x67\x04\xc3\x94\x70\x46\x50\xf4\x09\x67\x7b\xae\x6c\x13\xce\xb6\x72\xb1\x4e\x38\x03\x4f\xc6\x31\xe1\x2c\x63\xda\x24\x9c\x11\x2c\xab\x84\x33\x82\x67\x97\x70\x46\x10\x6d\x12\xce\xae\x7c\xba\x25\x9c\xdd\x64\xe7\x98\x70\x06\xae\x8d\x53\xc2\x59\xc6\xb3\x48\x38\x23\x48\x36\x09\x67\x04\xcd\x2a\xe1\x8c\xe0\x59\x24\x9c\x1d\x99\x74\x4a\x38\x3b\x49\xcd\x2d\xe1\x0c\x7c\x1e\xeb\x84\xb3\x8c\x63\x4c\x38\x23\x28\xe6\x84\x33\x82\x64\x91\x70\x46\xb0\x8c\x09\x67\x27\xf6\x1c\x12\xce\x0e\x92\x72\x49\x38\x03\x67\xc7\x29\xe1\x2c\xe3\x59\x24\x9c\x11\x24\x9b\x84\x33\x82\x66\x95\x70\x46\xf0\x2c\x12\xce\x8e\x4c\x3a\x25\x9c\x9d\xa4\xe6\x96\x70\x06\x5e\x90\x75\xc2\x59\xc6\x31\x26\x9c\x11\x14\x73\xc2\x19\x41\xb2\x48\x38\x23\x58\xc6\x84\xb3\x13\x7b\x0e\x09\x67\x07\x49\xb9\x24\x9c\xf9\x87\xb2\x2d\x12\xce\x18\x82\x31\xe1\x8c\x22\x99\x13\xce\x28\x9a\x45\xc2\x19\xc5\x33\x26\x9c\x1d\x99\xb4\x4f\x38\x3b\x89\xec\x31\x09\x67\x78\xbb\x4a\xe6\xa7\x07\x87\x84\xb3\x04\xac\x4f\x38\xcb\xe0\x86\x84\xb3\x8c\x60\x4a\x38\xcb\x18\xfa\x84\xb3\x35\x4b\x96\x09\x67\x4b\x89\xd8\x26\x9c\xd3\xc3\x85\x09\x67\x09\xd1\x26\xe1\x2c\x23\x59\x25\x9c\x65\x34\xbb\x84\xb3\x8c\x67\x93\x70\x76\x64\xd2\x29\xe1\xec\x24\x35\xb7\x84\x73\x7a\xb8\x28\xe1\x2c\xa1\x59\x24\x9c\x65\x1c\x9b\x84\xb3\x8c\x65\x95\x70\x96\xd1\x2c\x12\xce\x6e\x1c\xba\x24\x9c\x5d\xe4\xe5\x94\x70\x4e\x0f\xce\x09\x67\x09\xc5\x98\x70\x96\x31\xcc\x09\x67\x19\xc7\x22\xe1\x2c\x23\x19\x13\xce\x2e\xbc\xd9\x27\x9c\xed\x65\xe4\x90\x70\x4e\x0f\x17\x25\x9c\x25\x34\x8b\x84\xb3\x8c\x63\x93\x70\x96\xb1\xac\x12\xce\x32\x9a\x45\xc2\xd9\x8d\x43\x97\x84\xb3\x8b\xbc\x9c\x12\xce\xe9\xc1\x39\xe1\x2c\xa1\x18\x13\xce\x32\x86\x39\xe1\x2c\xe3\x58\x24\x9c\x65\x24\x63\xc2\xd9\x85\x37\xfb\x84\xb3\xbd\x8c\x1c\x12\xce\xc0\xe1\xb1\x48\x38\xcb\xd0\xfa\x84\x33\x02\x6f\x48\x38\x23\x18\xa6\x84\x33\x82\xa2\x4f\x38\xdb\x73\x65\x9b\x70\xb6\x95\x8b\x75\xc2\x19\x78\x32\x8e\x09\x67\x19\xd3\x26\xe1\x8c\x60\x59\x25\x9c\x11\x3c\xbb\x84\x33\x82\x68\x93\x70\x76\xe5\xd3\x2d\xe1\xec\x26\x3b\xc7\x84\x33\x70\x6d\x9c\x12\xce\x32\x9e\x45\xc2\x19\x41\xb2\x49\x38\x23\x68\x56\x09\x67\x04\xcf\x22\xe1\xec\xc8\xa4\x53\xc2\xd9\x49\x6a\x6e\x09\x67\xe0\xf3\x58\x27\x9c\x65\x1c\x63\xc2\x19\x41\x31\x27\x9c\x11\x24\x8b\x84\x33\x82\x65\x4c\x38\x3b\xb1\xe7\x90\x70\x76\x90\x94\x4b\xc2\x19\x38\x3b\x4e\x09\x67\x19\xcf\x22\xe1\x8c\x20\xd9\x24\x9c\x11\x34\xab\x84\x33\x82\x67\x91\x70\x76\x64\xd2\x29\xe1\xec\x24\x35\xb7\x84\x33\xf0\x82\xac\x13\xce\x32\x8e\x31\xe1\x8c\xa0\x98\x13\xce\x08\x92\x45\xc2\x19\xc1\x32\x26\x9c\x9d\xd8\x73\x48\x38\x3b\x48\xca\x25\xe1\xcc\xbf\x87\x6c\x91\x70\xc6\x10\x8c\x09\x67\x14\xc9\x9c\x70\x46\xd1\x2c\x12\xce\x28\x9e\x31\xe1\xec\xc8\xa4\x7d\xc2\xd9\x49\x64\x8f\x49\x38\x73\x97\x62\x67\xfe\x39\x75\xc8\x38\x4b\xc0\xfa\x8c\xb3\x0c\x6e\xc8\x38\xcb\x08\xa6\x8c\xb3\x8c\xa1\xcf\x38\x5b\xb3\x64\x99\x71\xb6\x94\x88\x6d\xc6\xf9\x9c\x5e\x98\x71\x96\x10\x6d\x32\xce\x32\x92\x55\xc6\x59\x46\xb3\xcb\x38\xcb\x78\x36\x19\x67\x47\x26\x9d\x32\xce\x4e\x52\x73\xcb\x38\x9f\xd3\x8b\x32\xce\x12\x9a\x45\xc6\x59\xc6\xb1\xc9\x38\xcb\x58\x56\x19\x67\x19\xcd\x22\xe3\xec\xc6\xa1\x4b\xc6\xd9\x45\x5e\x4e\x19\xe7\x73\xea\x9c\x71\x96\x50\x8c\x19\x67\x19\xc3\x9c\x71\x96\x71\x2c\x32\xce\x32\x92\x31\xe3\xec\xc2\x9b\x7d\xc6\xd9\x5e\x46\x0e\x19\xe7\x73\x7a\x51\xc6\x59\x42\xb3\xc8\x38\xcb\x38\x36\x19\x67\x19\xcb\x2a\xe3\x2c\xa3\x59\x64\x9c\xdd\x38\x74\xc9\x38\xbb\xc8\xcb\x29\xe3\x7c\x4e\x9d\x33\xce\x12\x8a\x31\xe3\x2c\x63\x98\x33\xce\x32\x8e\x45\xc6\x59\x46\x32\x66\x9c\x5d\x78\xb3\xcf\x38\xdb\xcb\xc8\x21\xe3\x0c\x1c\x1e\x8b\x8c\xb3\x0c\xad\xcf\x38\x23\xf0\x86\x8c\x33\x82\x61\xca\x38\x23\x28\xfa\x8c\xb3\x3d\x57\xb6\x19\x67\x5b\xb9\x58\x67\x9c\x81\x27\xe3\x98\x71\x96\x31\x6d\x32\xce\x08\x96\x55\xc6\x19\xc1\xb3\xcb\x38\x23\x88\x36\x19\x67\x57\x3e\xdd\x32\xce\x6e\xb2\x73\xcc\x38\x03\xd7\xc6\x29\xe3\x2c\xe3\x59\x64\x9c\x11\x24\x9b\x8c\x33\x82\x66\x95\x71\x46\xf0\x2c\x32\xce\x8e\x4c\x3a\x65\x9c\x9d\xa4\xe6\x96\x71\x06\x3e\x8f\x75\xc6\x59\xc6\x31\x66\x9c\x11\x14\x73\xc6\x19\x41\xb2\xc8\x38\x23\x58\xc6\x8c\xb3\x13\x7b\x0e\x19\x67\x07\x49\xb9\x64\x9c\x81\xb3\xe3\x94\x71\x96\xf1\x2c\x32\xce\x08\x92\x4d\xc6\x19\x41\xb3\xca\x38\x23\x78\x16\x19\x67\x47\x26\x9d\x32\xce\x4e\x52\x73\xcb\x38\x03\x2f\xc8\x3a\xe3\x2c\xe3\x18\x33\xce\x08\x8a\x39\xe3\x8c\x20\x59\x64\x9c\x11\x2c\x63\xc6\xd9\x89\x3d\x87\x8c\xb3\x83\xa4\x5c\x32\xce\xfc\xb3\xb7\x16\x19\x67\x0c\xc1\x98\x71\x46\x91\xcc\x19\x67\x14\xcd\x22\xe3\x8c\xe2\x19\x33\xce\x8e\x4c\xda\x67\x9c\x9d\x44\x66\x9d\x71\x9e\x36\xe4\xdc\xf8\xec\xf5\xe9\x96\x76\xf7\x81\x3e\x4e\x8a\xbd\x4a\x3d\x20\xe5\xc5\x7d\x15\xf6\x2f\x0a\xde\x1f\x93\x86\xf8\xdd\xbb\xce\xb7\x1e\xfd\x8e\xe2\x34\xd5\x29\x8f\xc2\xa6\x7f\x68\x11\x7b\xdd\xaf\x83\x1a\x0b\x48\x9a\x26\x65\x9d\xd4\xef\xf1\x4a\x00\xe5\x94\x3d\x13\x09\xf9\x4f\xb1\xe7\x4f\x3b\x80\xae\x47\x24\xf8\xfe\x2b\x8a\x00\x5e\xee\x85\x18\xc8\xeb\xb8\xe6\xab\xb1\x3b\x02\xfc\x4b\xa8\x5a\xae\xa9\x12\x30\xb4\x91\x77\x13\xf7\x02\x22\xf7\xfa\xb0\xa1\x15\xea\x15\x09\x78\xe7\x4a\x47\x84\x7f\x19\xd5\xba\x25\xc2\xeb\xa8\xf6\x2d\xc9\xe2\x27\x6a\x09\xdc\xcc\xdf\x2b\xd1\xe1\xa2\x96\x08\xaf\xa5\xda\xb7\x24\x3d\x3c\x51\x4b\xb8\x55\xa2\x8e\x0a\xff\x72\xaa\x75\x53\x84\xd7\x53\xed\x9b\x22\x3e\x6e\x6d\xd7\x14\x2a\x86\xe2\x9e\x54\x51\x58\x93\x71\x78\x35\x55\x98\xd7\xfb\xa2\xca\x6e\xbd\xb1\x14\x1d\x98\xa7\xb2\xd4\x20\x8f\xa5\xf8\xa8\x0e\xcb\xa4\x09\xd3\xe4\x37\x1c\x1b\x14\x8b\xe8\xfb\x22\x6f\xfc\xfb\xee\x91\x49\x3f\x2f\xaa\x2c\x4c\x3b\x0a\xe0\x73\x6b\xa4\xda\xef\x32\xfc\xae\x48\x63\x19\xba\xfd\x0a\x60\xbb\x8a\xa3\x11\xac\x6e\x1e\x52\x72\xeb\xf5\x9f\x41\x13\x3a\xb3\xd8\x81\x45\x45\x5a\x54\xb7\xde\x9f\xf7\xfb\x3d\xde\xda\xec\xd4\x90\x98\x03\x5d\x2f\xd6\xd1\x66\x2e\x42\x87\x00\xbc\x7f\x19\x75\xe2\x71\xdf\x8e\xad\x85\xe6\x08\x2d\x77\xab\xf9\x6a\x83\x57\x5b\x56\x49\x16\x56\x0f\x1c\x7c\x30\xdf\xac\xe2\x1b\x45\xc5\x14\x41\xa8\x9a\x7d\x95\x2b\x0f\xe6\xab\x30\x5c\xe1\x95\xd7\xa7\x28\x22\x75\xcd\xc1\xaf\xa2\xdd\xcd\x2a\x52\x54\x4e\x11\x84\xca\xd9\x57\xa4\xe5\xcb\x6d\xbc\x5c\xe2\x95\x27\xf9\xbe\xe0\x6b\xde\x45\x41\x2c\x29\x53\x38\x42\x0b\xd5\x76\x9f\xe4\x3a\x17\xb3\x5d\x10\x2b\x1a\x7c\x1f\x56\x79\x92\x1f\x78\x8d\x08\xc2\x78\xa9\xaa\x96\x22\x08\x35\xb3\xaf\x72\xe5\x24\xda\x6e\x66\x0a\x0d\x8b\xc3\xfc\x20\x80\xc7\xdb\xd5\x62\x29\x81\x87\x10\x5e\xa8\x9a\x7e\x94\x6b\x8e\xb6\x8b\x60\x2e\xf5\x5b\x8f\x74\xa8\xc2\x07\x3f\x0e\xab\x8f\x1c\xc6\x7c\x3b\xdf\xc9\x18\xa1\x80\x22\xd4\x3f\x7e\x97\x59\x98\x05\xb3\xd9\x4c\x1a\x30\x3d\xde\x31\x89\xc9\x30\x5e\x6f\xbd\xe0\x5d\xe0\x85\xef\x47\xdc\xce\xae\x94\x61\x45\x7a\xfb\xd7\xab\xd5\x31\x8c\x5b\x3f\x27\x2f\xf2\xee\x9d\xe0\x5d\x18\x7d\x3c\x54\xc5\x29\x8f\x7d\x1c\x4b\x78\xe3\x38\xc9\xe9\x23\xc3\x5d\xbd\xdd\xef\x49\x9a\x34\x0f\xcc\xa5\x92\x18\xed\x3f\xfb\xe7\xda\x3f\xf5\xae\x5b\x9c\xd4\x65\x1a\x3e\x18\x9e\x74\x1f\xdf\xde\x5d\x6d\x56\xc3\x44\x33\x12\x8b\x8b\xfb\x9c\x1a\x7e\x35\x41\xf5\xf4\x05\x9d\x23\x4a\xb4\xce\x18\x87\x2e\x24\x47\x3e\x37\xeb\x0d\x42\xf2\x91\x7c\x42\xd7\x87\x12\xcd\xe2\xc7\xf1\xb9\xdd\xce\x10\x92\x8f\xe4\x13\x3a\x36\x94\x68\x7a\x78\x1c\x9f\xb3\xd9\x76\x8b\xd0\x7c\x24\xa3\x9c\xdf\xc2\xd4\x29\x75\xe0\x14\x20\x0d\xac\x68\x55\x7a\x4a\x07\x4c\x3b\x9d\xe4\x8d\xbf\x4b\x8b\xe8\xa3\xf5\x40\xe8\x70\x7a\x66\x55\x64\x00\xa1\xfe\x23\xc6\x34\x8f\x9c\xe4\x69\x42\xdf\x9e\x7f\x14\x13\x80\x0e\xa0\x44\xbf\xda\xb2\xf1\x54\x12\x91\xa8\x49\x2c\xf9\x4a\xf9\xc8\xb4\x69\x2f\x8f\x5f\xcc\xaa\xf1\xee\x87\x3f\x7b\x75\x71\xaa\x22\xf2\x73\x58\x96\x49\x7e\xf8\xef\xff\xfc\xc7\x87\x5d\x51\x34\x75\x53\x85\xe5\x34\xaa\xeb\x69\x16\x96\xde\x0f\xef\xfe\x5f\x00\x00\x00\xff\xff\xea\xef\x3d\xd3\xfa\xec\x02\x00")
-
-func assetsCssBootstrapCssBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsCssBootstrapCss,
-		"assets/css/bootstrap.css",
-	)
-}
-
-func assetsCssBootstrapCss() (*asset, error) {
-	bytes, err := assetsCssBootstrapCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/css/bootstrap.css", size: 191738, mode: os.FileMode(420), modTime: time.Unix(1535146440, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsJsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x73\xe3\x36\x92\xff\xfb\xf9\x14\x3d\xfc\x6f\x65\xa4\xbf\x45\xc9\xf6\xe6\xb2\x77\xb2\xe5\x3c\xee\x5c\x7c\x97\xcc\x6c\x25\x93\x54\x6d\xb9\x5c\x1b\x48\x6c\x49\x8c\x49\x82\x47\x80\xf6\x68\x27\xfe\xee\x57\x78\x22\x01\x12\xa4\x28\xdb\x33\xd9\xb9\x8a\x5e\x78\x24\x12\x68\x34\x7e\x68\x34\xba\x1b\x0d\xcc\x68\x5d\x66\x2b\x1e\xd3\x0c\x46\x63\x78\xf7\x0c\x20\x28\x19\x02\xe3\x45\xbc\xe2\xc1\xd9\xb3\x67\x00\xdf\x10\x8e\xd3\xbc\xa0\x9c\xf2\x5d\x8e\x53\x4e\xc5\x83\x37\x71\x8a\x97\x59\x5e\xf2\x9f\x49\x52\x22\x2c\xa0\x26\xb3\x2a\xf9\x57\x3b\x45\x0b\x20\x5e\xeb\x07\xb0\x58\x40\x56\x26\x89\x79\x01\xa0\x1f\xc3\xc9\x67\x67\xf2\xc9\xbd\xfc\x7b\x4b\x0a\x48\xe8\x8a\x24\xb0\x80\x0c\xef\x64\xeb\x23\xbe\x8d\xd9\x58\x95\x92\xef\xa6\x0c\xf9\xf7\x71\x56\x72\x64\xf2\xdd\x74\x53\xff\x1e\x43\x08\xe6\x99\xe0\xf2\x9f\x34\xc3\xd7\xeb\x35\x43\x3e\x1a\x6b\x1a\x05\xf2\xb2\xc8\x34\x29\x4e\xff\xeb\xc7\xd7\xaf\x46\xe3\x29\x4b\xe2\x15\x8e\x8e\x27\x8a\x31\x59\xf4\x5e\x02\x20\x58\xda\x20\xff\xe9\x87\xef\x9c\x7e\x32\xd3\x15\x4d\xee\x2e\xce\x22\x7a\x37\xe5\x71\x8a\x6c\x8b\xc8\xa7\x4b\xc2\x50\x54\x3a\x02\x56\x13\x9b\xcd\x80\xd1\x14\xa1\xe4\x71\x12\xf3\x5d\x45\x8f\x99\x96\xf0\x2d\xc7\x2c\x72\x5a\xa2\xcb\x5f\x4f\x26\x40\x97\xbf\x9e\x9a\x26\x67\x33\x5d\x8e\x89\xc7\xb8\xe2\x70\x17\xf3\x2d\xf0\x2d\xc2\x8a\x66\x1c\x33\x0e\x74\x0d\x24\xa3\x7c\x8b\x85\xac\xb1\xa6\x05\x8c\x34\xf2\xa2\x99\x38\x7a\x0b\x0b\x38\x9e\xc0\x0d\xee\x18\x2c\xe0\xb5\x24\x33\x15\xbf\x46\xb2\xa5\x09\x24\x98\xc1\x42\xbe\x9f\x26\x98\x6d\xf8\x56\x16\x3e\xd3\x44\x04\x81\x73\x51\xc6\x7a\x70\x74\x24\xbf\xd7\x63\x7c\x83\x3b\x4d\xe2\x2a\x8e\xde\x5e\x9b\xa2\xa2\x43\x57\x37\xb8\xbb\x86\x85\xec\x96\xfc\x6e\x4b\x81\x86\x54\x94\x73\xc7\x21\x65\x6f\xe8\xb7\x0e\x38\x29\xb3\x40\x59\xd1\xec\x16\x0b\xce\x20\x8d\x93\x24\x66\xb8\xa2\x02\x22\x4e\x61\x4b\xcb\x82\xd9\xa4\x53\x06\xff\x1f\x4e\xa7\x7f\x71\x3e\xff\x8e\xe1\x5f\xdc\xf6\x0a\x64\xc8\x5f\xc6\x98\x44\xcc\x69\x95\x93\x62\x83\x7c\x02\x31\xc7\x9a\x01\x89\x2b\xc7\xf4\xac\x46\x5c\x3e\x52\x40\x0b\x89\x96\xc5\x35\x9a\x67\x10\x0b\x00\xcf\x20\x3e\x3a\xaa\x11\x13\x25\x60\x01\x8a\xfe\x95\x2c\x7f\x15\x5f\x57\xc8\x89\x07\x53\x2c\x0a\x5a\x08\x7e\xae\xdc\xe7\xb7\x7a\x2e\x06\x41\x8d\x65\xdd\x95\xd5\x16\x57\x37\x97\xeb\x9f\x49\x12\xbb\xe2\x95\x17\x18\xc5\x2b\xc2\x71\x02\x8a\xb2\xfc\xf7\x7b\xb6\xb1\xfb\xa5\x9e\x5c\x4a\xa9\x51\xa5\xa6\x71\x16\xe1\xdb\xd7\xeb\x91\x2e\x3c\xd1\xac\x6c\x09\xfb\xab\x28\xf0\x3d\xdb\xa8\xb2\xba\xde\x05\x84\x27\x92\x19\xa5\x15\xaa\x56\xad\xbe\xaf\x61\xf4\xdc\xaa\x5e\xbf\x01\xd3\x66\x5e\xb2\xad\x69\xd0\xf4\xfd\x5e\xff\xab\x47\x76\x4d\x12\x86\x06\x80\xaa\x39\x2f\x59\x4d\x94\xe5\x72\xea\x57\xac\x4e\xe0\x64\xec\x10\xd0\x94\x79\x51\xa2\x2b\x1e\x31\x7b\x49\x8b\xb4\x0d\x29\x5d\xfe\x6a\x83\xb7\xb6\x0a\x09\x22\x93\x7a\x7a\x78\xe6\x5e\x85\x64\x81\xff\xa3\x40\x0c\x84\x42\x83\xb5\x10\x43\x88\x99\x78\x5e\xc6\x05\x46\x81\x29\x28\xdf\x68\x6c\x45\x7b\x15\xb6\x2f\x33\x87\xad\x75\xdd\x75\xdd\xa5\xd1\x7a\x6a\xa8\xc1\x6f\xbf\x29\xec\xc6\xf0\xc9\x27\xf0\xfc\x2b\x4a\x13\x24\xd9\x68\xad\xc4\xca\x00\xa2\x5b\xf1\x09\xb7\xa5\x29\xfc\xb2\xad\x3a\x20\xa7\xfc\x95\xd2\x09\x96\x60\x8b\xb1\xb7\x45\x74\x64\x75\x62\x24\x6b\x8e\x27\x8a\xc2\xd4\x48\xa9\xc2\x67\x6c\x8b\x89\x8d\xb4\x25\x08\x46\x48\x1c\xed\x52\x95\x75\xc7\xb4\xcc\x48\xc9\xb7\xb4\x88\xff\x89\xd1\xb7\x24\x8b\x12\x2c\x1c\x10\x0b\x64\xb9\xbd\xbc\x89\xdf\x53\xc6\x09\x2f\x99\x58\xe4\x3e\x3d\x3e\xa9\x19\x92\x0b\xd1\x9f\x30\x8d\xf9\x28\x58\x92\x28\xe4\xf4\x06\xb3\x60\xdc\x9c\x9e\x3f\x97\x38\x5d\xd1\x34\xa7\x19\x66\x7c\x14\xc8\x85\x55\x4a\x2b\x0b\x26\x9a\x16\xc7\x34\x4f\x08\xc7\xb9\xa6\xfc\xe2\x3c\x8a\x6f\x2f\xc4\x1f\xb8\x0d\xd7\xb4\x58\x04\x08\x71\xa6\x25\x3a\x80\x55\x42\x18\x5b\x04\xa2\x8b\xa1\x58\x11\x0a\x9a\x84\x6b\xc4\x68\x49\x56\x37\xc1\xc5\xbb\x77\x80\x70\x7f\x7f\x3e\x93\x34\xe4\xdf\x17\x4a\x96\xf2\x82\xe6\x6c\xde\x98\x20\x73\x0b\x60\x61\x03\xcc\xe1\xcb\xa2\x20\xbb\x49\xf5\x30\xc2\x35\x29\x13\x3e\x87\xa6\x31\x61\x3e\x1a\xf1\x5a\x5f\xd5\xb3\xb6\x1e\x98\xfb\xb1\x0f\x8c\xef\xe8\x26\xce\xc4\x2c\x6b\x43\x01\xbf\x54\xd4\xce\x45\x4f\xe1\x0b\x56\x2e\xd3\x98\x4f\xf3\x02\x6f\x31\xe3\x8b\x20\x11\x95\x83\x0b\x8b\x13\x00\x89\x99\x8d\xcf\xa6\xa0\x65\x1e\xc0\x5c\x3f\x7b\xf7\x62\x4b\x58\x18\x91\x6c\x83\xc5\x8b\x39\x94\x0c\x8b\x8c\xa4\xa8\xa5\x4e\x0b\x38\x5c\xc0\xf1\x7d\x83\xb0\x24\x9e\x90\x25\x26\x20\x07\xc4\xd4\x0c\x2e\x7e\x62\x58\x80\xf8\x7a\x3e\x93\xef\x3d\xf5\x62\x31\xe6\x12\xdd\x45\xc0\xf1\x2d\xf7\x0e\x61\xd0\xaa\xa7\x3f\x35\xef\xce\x88\x0f\xea\x44\x17\xcd\x38\xd2\xf8\x85\x55\x47\xba\x8a\xde\x86\x29\x8d\x30\x99\xf2\x22\x4e\xeb\x7e\x2b\xc5\xd1\x59\x29\x4f\xc8\x0a\xb7\x34\x89\x50\x08\x6f\xc6\xb1\x90\x6c\x82\x82\xac\x03\xa1\x50\xaf\x7d\x73\xf5\xaf\xd5\x98\x96\xfc\x59\x63\xb0\xa5\x70\x3f\x66\xfc\x73\xc2\xd8\x1d\x2d\xa2\xc3\xc7\xdf\xd4\x0c\x2e\xfe\xa6\xbf\x0d\x1b\xfe\xaa\xde\xbf\x90\x08\x54\x3c\x0d\x13\x81\x0a\xb4\x83\x45\xa0\x06\x6d\xa8\x04\x34\x06\x68\x88\x04\x2c\x4b\xce\x69\xa6\xe1\x56\x3a\xa3\x02\x7b\xc9\x33\x58\xf2\x2c\xcc\x8b\x38\x25\xc5\x2e\xb8\xf8\xcf\xd7\xcf\xcf\x67\xaa\x46\x4d\xe6\x7c\x26\x50\xae\x7f\xff\xa2\x94\x61\x44\x38\xf1\x6b\x41\xad\x01\x6b\xa5\x68\xc6\x63\xee\x28\x4a\x89\xd7\x1c\x82\x60\x62\x3d\x34\x5a\xf8\xea\x7a\xe2\xe8\x54\xb5\x6e\xcf\xa5\x49\x51\xeb\xd5\xba\x8c\x41\xe6\x3d\x36\x21\x50\x70\xc9\xd7\x94\xda\xaa\x5e\xaf\x7b\xaa\x7a\x8a\x7c\x4b\x23\x6b\x71\x59\x15\x48\x38\x7e\x59\xf2\xed\x65\xb6\xa6\x36\x8c\x64\xb5\x42\xc6\xde\x88\xc5\xd3\x5e\x57\xa4\xa9\x43\x76\x09\x25\x62\xb9\x17\x6e\xdc\x34\x27\x05\xc3\x11\xe1\x74\x69\x57\x92\x16\x1e\x1f\x05\xd3\x60\x7c\x75\x72\x3d\x1e\xdb\x7d\x24\xba\x3d\x58\x38\xdd\x00\xb0\xea\xcf\xed\x1f\x13\xa7\x94\x6e\x7f\x6e\xbe\x58\x2f\xef\xeb\xc5\x4e\x8f\xbe\x69\xab\x32\x4a\x0c\x2d\x39\xc9\xec\x1e\xff\x49\x2e\x60\xcd\xce\x32\x4c\xd6\xc2\x84\xdc\xc6\x4c\xdb\x61\x60\x4c\x27\xcb\x12\x1d\x89\x62\xd3\x7f\x08\x59\x1c\xfb\x96\x61\x7b\x0d\x76\xc8\xdf\x34\x8d\x51\x8b\x90\xdd\x6b\xf1\xa0\x05\x57\x2d\xcf\xb2\x96\xbb\x08\x34\x31\x33\x72\x29\x8b\xba\xca\xc2\x05\xb0\xb6\x3b\xa4\x25\xb5\xe5\x3c\x9f\xe6\x94\xf1\x51\x30\x23\x79\x3e\x53\xeb\xfb\x44\x72\x34\x81\x77\x80\x69\x29\x2c\x03\x21\x0a\x4a\x66\xe1\x7e\x3c\xe5\x5b\xcc\x46\x16\x59\xbf\x29\x57\x63\xc4\xf2\xe9\xaf\x8c\x66\x23\x5d\xb3\x2e\x2e\x9e\x7e\x23\xc0\x68\x54\x01\xd5\x0f\x65\xe7\xb5\xd4\x96\xd0\xa0\x1b\x8c\x42\xc1\x68\xeb\xa5\xac\xe8\x0a\x7e\xd5\xcc\xd4\x16\xfb\x46\xcd\xf1\x59\xe3\x81\xe5\xa8\xca\x61\x9b\xc0\x0d\x6b\x14\xba\x77\x7e\xdf\x4f\xde\x2f\x24\x52\xa0\x9a\x5c\x7a\xdd\x86\x7e\xa7\xa1\xfe\xdc\xc8\xc2\x57\xf1\x75\x93\xaa\x82\xf1\xea\xe6\xba\xf6\x8c\x0d\x5f\x57\x37\xd7\xc2\xaf\xb9\x6a\xd5\xb9\xef\x83\xe6\x59\x0b\xe6\x7d\x56\xea\x0f\xb8\x89\x19\xc7\xe2\xa1\x86\x6a\xa1\xeb\xff\x61\xab\x3e\xd8\x50\x29\x70\xf3\xe1\x2c\xd5\x1d\x2d\x0b\x19\x9f\xfc\x57\x34\x59\x31\x25\x71\x72\xb8\x0c\xc8\x6a\xc1\xc5\x5f\xc5\x3f\xc3\x06\x5f\xd5\x78\xe8\x40\x77\xb2\xd9\x45\xf0\x10\x29\x33\x12\xd1\xcf\xa2\x2b\x0e\x8a\xa1\x87\xc9\x82\xac\x0b\x24\x8a\x0a\x64\x6c\xb8\x2c\xd8\x18\xfc\xe1\xbb\x74\x49\x4a\x5f\x3f\xba\x68\x7e\x71\x83\xbb\x32\x5f\x04\x31\xfb\x91\xa4\x28\x7a\xb1\x57\x56\x3e\x98\x93\x23\x95\xc6\x07\xf1\x74\x1e\x26\x2f\xa7\x03\x05\xe6\xc3\x8d\xbc\x97\xa3\xf7\x30\xf4\xa7\x07\x8e\xfd\xe9\x21\x83\x5f\x60\xf8\x24\xe3\x7f\xda\x21\x00\xb2\x2a\x4b\x49\x92\x38\x63\x20\x96\x68\x10\x7f\xc2\xb4\xe4\x18\x05\x17\x7f\xa7\x25\x64\x88\x11\x70\x0a\x15\x4f\x7c\x8b\x15\x4b\x40\x96\xf4\x16\xa7\xe7\x33\x49\xec\x0f\x7f\xda\xd4\x13\xca\xfa\xe3\xf6\xd7\x2b\x11\xfa\x48\x62\x02\xf5\x14\xee\xf7\x8e\x85\x0f\x2c\x5d\x44\x57\x29\xc3\x62\xa1\xdc\xe5\x69\x63\xc2\xba\xfe\x45\xa3\x84\xd9\x6b\xa2\xf9\xc8\xf1\x0b\xda\xee\x73\x8d\x5f\x01\x51\xbc\x5e\xcb\xc5\x32\xaa\x36\x6d\xac\x19\x15\x33\x59\x00\x0b\xcc\xb8\x98\x77\xe2\x25\xcd\x50\xcd\xb4\xe0\xac\xbb\x23\x15\x3b\x66\xbb\xcd\x69\x67\x0c\xe7\x70\x3c\xa8\x33\x25\xdb\x36\xaa\x7a\x62\xf1\xf5\xce\x93\x72\x47\xfe\xaf\x84\x24\x24\x26\x7d\x21\x09\x3d\xb5\x65\x39\xcb\x0c\xec\x8a\x5b\x78\x44\xcd\x5f\xf4\x74\xee\x15\xbf\x83\x82\x1c\x05\x6e\x9e\x3c\xc4\xb1\x2f\x56\x60\x05\x34\x2a\xdf\x14\xa3\xe0\xa1\x11\x84\x6a\x9f\x8c\xde\xb4\x3d\xfb\x01\xec\x0c\x63\x08\x3c\xf2\x04\xae\x4c\xc1\x1f\xe1\x0c\x18\x10\xce\xf8\xb2\xe4\xdb\xaf\x49\x11\xf5\x87\x32\x2c\xe3\xb2\xa0\x77\x40\x92\x78\x93\x85\x32\x65\x21\x5c\x49\xa3\xa2\x27\x9a\xb1\xa2\x49\xf8\x29\x88\xbf\x69\x14\xfe\x59\x7e\x61\x69\x78\x1a\x5c\xec\xb1\x62\x57\x34\xf1\x19\x4c\x76\x09\x52\x44\xca\xdc\x51\x4c\x40\xca\xc3\x3f\x7b\xea\xf8\xea\x85\x5b\x24\x51\x8b\x6f\xa7\x46\x59\x19\x57\x19\xb9\x85\x8c\xdc\x86\x9c\x2c\x19\xfc\x5a\x32\x1e\xaf\x77\xa1\x4e\xbf\x31\x6d\x5b\x44\x65\xb9\x1e\xca\x92\x7a\x12\x5b\xd4\x25\x96\x01\x7c\xb1\x4a\xe2\xd5\xcd\x22\x50\xd2\xff\x82\x21\x0f\x6f\x63\xbc\x7b\x31\x81\x17\x32\xfe\xf9\x62\xbc\x87\xaa\xa4\x4c\x6c\xc2\x49\x9c\xdd\x58\x5e\x00\x59\xf1\xf8\x16\xe7\x20\xc8\xc2\x62\x61\xe8\xde\x07\x17\x72\xf7\xf5\x7c\x46\xf6\xb0\x3d\x4b\xe2\xa7\xed\x98\x99\xe2\xef\xa1\x6f\x15\xe9\xfb\x00\x2e\x4c\xdc\xee\x91\x5d\x3c\x9f\x95\x1e\xcf\x18\xfc\xe6\x72\xf5\x4a\x6d\xde\xb3\x2d\xbd\x5b\x04\x9a\xbd\x0a\xfb\xc0\x11\xcb\x65\x42\x57\x37\x7d\x52\x99\x3b\xc5\x65\x50\x6e\xdf\x80\xc8\x7d\x3d\x15\x8d\xac\x22\xd4\x8b\x80\x66\xdf\xc9\x1f\x97\x99\xcf\xbb\xa8\x7b\x95\x3f\x55\x77\xab\xe1\x78\xef\x3d\x36\x2d\xe9\x4e\xd7\xab\x48\xef\xd4\x7a\x42\x14\x7c\xaa\x6d\xaf\xb6\x1b\xa4\x25\x1b\x3f\x7f\xe9\xb0\xa6\xeb\xc1\x6d\xef\xae\x5d\x66\x6b\x3a\x81\xb6\x91\x67\xa7\xb0\x30\x4e\x0b\x0c\x49\xc9\xb7\x61\x9c\xad\x69\x30\x81\xba\xae\xb5\xe4\x38\x55\x34\xa4\xc1\x04\x82\xbc\xa0\xc2\x90\x63\x41\x73\x1d\xf2\xe6\x9e\x88\x5a\xed\xcc\x93\x1f\x79\x11\x67\x9b\xda\xf2\xf0\xba\x23\xfb\x96\xb7\x57\x78\xf7\xc6\xa4\x69\x0e\x5e\xe2\x9a\xeb\x4a\xdf\xe2\xd6\x27\xc1\x1d\x3b\x00\x6a\x13\x68\xc0\x3a\x35\x20\x8e\x13\x95\x05\x11\x63\xfb\xb2\xa0\xe9\xb0\x50\x4e\xd5\x90\x15\x03\x34\x54\xc2\x75\x41\xd3\xe0\xe2\x1b\xfd\x13\xc4\xcf\xce\x68\x60\x45\xc8\x8e\x0d\x45\x84\x23\x8f\x53\x0c\x65\xca\x6d\x67\xb0\xc4\x7c\xdc\x50\x8b\xd3\x99\xfe\x68\x8b\xf9\x78\x22\x50\xe0\xc4\x97\x58\x1a\xc8\xd0\x8f\xdb\xc5\x7d\x64\xf7\x05\xab\xf6\xc1\xbe\x8f\x3e\x29\x62\x12\x46\xc8\x56\x45\xbc\xc4\x68\xb9\x73\xfb\xfe\x2d\x26\x79\xdf\xc8\xf9\x63\x46\x1e\x9e\xba\x54\xda\x3e\xdd\x7d\xb8\xfc\xbd\xa1\x4f\x26\x7d\x9c\xfe\x0e\x22\xf7\x86\x7e\x1c\x02\xe7\xc7\xf9\xe1\xe2\xf6\x86\x3e\x4e\xd8\x2a\x7e\xde\xbf\xa8\x91\x95\xd0\xeb\x49\xcc\xb6\x29\x66\x9c\x3d\x5c\xde\x1a\x84\x82\x8b\x2f\xdd\x07\xfb\xc5\x4f\xac\x0d\xa4\x40\xd2\x8b\xfb\x10\x49\xe9\x25\xb0\x4f\x26\x06\x00\xd2\x4b\xdf\x9d\x09\x4d\x6a\x03\xa6\x83\x10\xf3\x26\x98\x50\xd0\x3b\xb6\x08\xba\xdc\x30\x09\xdf\xcc\xe0\x77\xb0\xd8\xf9\xbb\xfc\x10\xd9\x1b\x12\xc8\x66\xa9\x1b\xcf\xfe\x5a\x2e\xdc\xed\x90\x76\xdd\x9e\x1b\xda\xf6\xf0\x30\xd0\x7c\xdb\x20\x37\x67\x6f\x5e\xd1\x3b\xdb\x84\x4b\xe3\x4c\x9d\x71\x69\xc6\x44\xab\x17\xed\xf3\x37\xb2\x89\xfa\x35\x1c\x77\x05\x36\x33\x7a\x67\x1f\xc6\xb1\x0c\xbd\x8c\xde\xd9\x47\x71\xc4\x4f\xe7\x24\xce\x51\xdd\xc0\xb8\x95\x0c\x25\x4a\xfb\x0e\x13\xd5\x0d\x58\xe9\x51\x2b\x92\x5c\xd6\xe1\xc4\xae\x44\xe3\x56\xd8\x51\x5a\xa2\xdd\x61\x47\x37\x45\xbc\x15\x7c\x14\x3a\x1a\x16\xfa\x00\x94\x4c\x2c\x93\xf4\xda\x06\x89\x13\x8a\xe4\xb4\xa7\x8e\x59\x51\x9a\xc1\x4b\x8d\xc1\xad\xe0\x5a\x87\x8f\x55\x00\x81\x80\x7c\x26\xcb\x00\xc9\x22\x10\x8b\x5a\xd0\x08\xb5\x5a\xb4\xdc\x1c\x7a\x37\x08\xc7\x5e\x91\x57\x23\xd1\xa9\xb1\x1b\xac\x6c\xf7\x4a\x67\xd8\x3b\xa5\xda\x5c\x5a\xaf\xeb\xfc\xa4\x03\x71\xee\x65\x5f\x71\xcc\xe9\x78\x02\x4d\x14\xcd\x19\x80\x36\x57\xef\x87\x15\x17\x30\x0a\xe7\x52\x3a\x1e\x82\x63\x20\xc5\x2a\x2d\x19\x87\x25\xc2\x12\xd7\xb4\x40\xe0\x34\x78\x0a\x34\x67\x33\x48\x90\x33\x10\x33\xda\x30\xf2\xcc\xcb\x5e\x75\x58\xe8\x55\x99\x2e\xb1\x18\xc9\x83\x55\x23\x4e\x21\x94\x1d\x1b\x4f\x39\x7d\x19\xbf\xc5\x68\x74\x3a\x6e\x4f\x5d\x73\x10\x06\xec\x49\xaa\x1c\x98\xfd\xdb\x34\xcf\x25\x1f\xcd\x29\x3d\x3a\x6c\x5f\xc0\x13\xef\x37\x3d\x9b\xfb\x3a\xea\x64\x7c\xba\xcb\x85\x2e\xef\x5d\xe9\xec\x6a\xda\x7b\xfd\x47\x5c\xc5\xff\xd5\x83\x69\x1c\xd9\xc5\x4a\x86\x45\x5d\x46\xfc\xba\xac\x93\x42\xfd\xc1\x7e\xab\xb6\x8a\xfb\xab\xd3\x86\xa3\x60\x56\x1d\x26\x9c\x69\xb2\xb3\x00\x8e\x6c\xca\x70\x04\x81\x8c\x65\x07\x63\xb5\x45\x60\x27\x0a\xb6\xb6\x05\xf6\x85\xe9\xf5\xf1\xcb\x08\x16\xfa\x78\x61\x3b\x9b\xb1\x1d\xc7\x56\x9a\x61\x6e\x2d\x10\x7e\xb5\x7e\xf2\x1f\xcd\x24\xc6\xc6\x16\x42\x45\xac\x69\x41\xb5\x82\xfc\x76\x80\xa1\x82\x28\x54\x02\x18\x05\x13\x67\x78\x26\x90\x44\xbd\xd9\x92\xa2\xf0\x04\xae\x5a\x36\xcb\x75\x33\x7d\x72\x72\x10\x92\x9e\x63\x44\xaa\x64\x93\x97\x15\xcd\x18\x4d\x70\x9a\xd0\x8d\xaf\x80\x1d\xd9\xf7\xc7\x4d\x0e\xd8\x24\xb7\x95\x93\x77\x07\xd8\x9c\x9c\xb5\xec\x8c\xd1\xd8\xbf\x29\xfc\xcc\x03\x4c\xad\xa0\x07\x53\x3f\xf9\xb7\x07\xd0\xf7\x52\x3f\x9e\x9e\x0c\xa7\xd4\x52\x02\x4f\xbc\x1d\x6e\x06\xca\xd9\xde\x6e\x44\xb8\xd4\x14\x6e\xc7\xb8\x94\x4a\xf6\x1c\xaf\x0a\x4f\x9a\x4a\x57\x0b\x79\x9b\x86\xda\x33\x7d\x7c\x9c\xec\x6f\xaa\x81\x0f\x1f\x25\xdb\x7e\xea\x06\x78\x63\x9e\x60\x70\xf1\x0a\xef\x4c\x9f\xcf\x67\xdb\x4f\x7f\x97\xe8\xda\xf0\x0c\xdb\xaa\x01\xcb\xcf\x54\xb9\xa2\xaf\xfa\x12\x6c\xab\x6a\x76\x40\xa3\x37\xad\xd5\x7c\x06\xc7\x21\x06\x51\xdb\xe7\x6a\x1e\x9a\xa5\x6b\x3e\xae\x8b\x39\x20\x01\xd7\x7c\xda\x11\x0b\x51\x59\xc6\x2a\x7c\x69\x76\xc4\x88\x4a\x57\x82\xae\xf9\x74\xf8\x95\x3d\x49\xba\x55\xcd\xa7\x8b\x99\xc9\x6e\xe5\xd2\x64\x79\x78\xd0\xac\x26\x12\x5c\x7c\x53\xff\xf8\x58\x82\x17\x7b\x40\xe8\xa5\xdd\x08\xe1\x59\x94\x06\x06\x2d\x6c\xf0\xde\x6f\xc0\xa2\xdd\xcd\x8f\x3e\x58\x71\x80\x11\xf2\x9e\xb3\xf4\x2c\x70\x3f\xcc\xaa\xde\x71\x90\x6d\x80\x27\x74\x40\xa8\xe2\x10\x4f\xc8\xca\x79\xf2\xe7\x3b\x39\x10\x29\x6f\xa9\x39\x5d\x0e\x75\x59\x1c\x89\x39\xcc\x7f\x99\x19\x47\x4a\xfb\x31\x0e\xa9\x86\x3b\xd0\x76\x6f\xfa\xed\x70\x81\x10\xf7\x26\x87\xb9\x0e\x9d\xcc\x09\x12\xe5\xcc\x51\xc1\x59\x30\x56\x09\x80\x4d\x0f\xc5\xe7\xdf\x35\xcb\xd4\x66\xea\x71\xf3\x55\xcb\xee\x0c\x5c\xc5\x64\xc3\xdd\x09\x79\x07\xec\x87\x43\xef\x85\x5c\x36\xeb\x73\xc3\x9a\x1c\xb4\xdd\x4b\x18\xe0\x18\xc1\x10\x37\x13\x3a\x5c\x4d\x90\xba\xda\x98\xc8\x23\xc9\xe6\xb4\x1e\x48\x4f\x4f\x74\x7f\x0c\x1a\x8e\x3f\x60\x7f\x3c\xae\x28\xf8\xdd\x51\xf0\xb9\xa4\xd0\x70\x4b\x35\x4f\x96\x53\xea\x71\x43\xc1\xeb\x8a\xda\xe9\x8e\x4a\x1f\xb4\xeb\x79\xb8\x1d\x82\xfb\x60\xa7\x14\xf6\x3b\xa6\xd0\x4a\x3b\x6b\xc0\x72\x3f\x68\x9b\xff\x11\x4e\xd0\x1e\xef\xe5\x07\x4c\xe9\x2d\x7e\xc5\xb3\x7e\xe7\xc5\x59\x46\xd5\x0f\xcf\x32\x5a\xdb\x6d\x4b\x9e\x7d\x2d\xbe\xd5\xf9\x4b\x98\x91\x65\x82\x5f\xd3\x6c\x1d\x17\x29\x51\xc6\x97\xbb\x82\xb2\x9c\x64\x55\xee\xcb\xf3\x95\x53\xf2\x13\x29\x9b\x67\xe7\x33\x51\xa8\xaf\xde\xaa\xbb\x81\xba\x70\xcd\xa4\x4e\x84\x52\x4c\x4e\x19\xa7\xf9\x94\x61\xb2\x5e\x04\x77\x05\xc9\x73\x8c\x5e\x67\x9a\xe3\xe0\x62\x87\xcc\xd7\xfc\x41\x54\xa3\x98\xb5\x51\xc8\xa8\xb7\x5b\x8d\x67\x6d\x73\x44\x1b\x15\x62\x30\x4b\x8e\x96\x74\x18\xf0\xbb\x76\x00\x4c\x80\x52\xcc\x44\x1b\x2f\xf8\x1c\x24\xf7\xea\x4a\x28\x61\x0a\xc1\x5c\x3d\xa1\x25\x4f\xe2\x0c\xb5\x0d\x1a\x3c\x36\xc2\x62\x37\x3a\x57\x91\xda\x61\x46\x43\x73\x54\xfa\xcd\x07\xd9\x41\x6a\xca\x9a\x02\x8d\x14\x1c\x07\x80\xe6\x7d\x38\x66\x66\xb5\x85\x77\x40\xcb\x0d\xca\xde\x78\xb0\x47\x20\x0e\xa7\xec\xbb\xc3\xc7\xab\x46\x68\x8d\x5b\x53\x93\xbc\xd4\x6d\x3e\x32\x18\xa2\x23\x21\xdf\xc5\x6c\x78\x34\xe4\x41\x69\xb1\x7f\x36\x09\x5f\xa7\x26\xe1\xeb\xe4\x09\xd2\x62\xfb\xb2\x60\x33\xbc\x0b\x8d\xab\x3c\x57\x0a\x59\x1d\x53\xbd\x8c\x02\xf8\xa2\xb1\x94\x2d\x02\x12\x45\x26\x2c\xd4\xe5\xb6\xa8\x5c\xbf\x78\xbd\xa8\x92\xbe\x2c\x47\x4e\xde\x2f\x95\x50\x12\xc5\xd9\xa6\xcf\xc1\x6a\x86\x95\x64\x17\xcc\x75\x47\xa3\x2a\xbe\x9b\x5f\x46\x6f\xc7\x10\x67\x50\xe5\x97\xed\x49\x04\x3c\x38\xe9\xb7\xaa\x29\xf5\xd6\x39\xe3\x05\xcd\x36\x17\xef\xde\x99\x16\xa5\x99\x2d\x2f\x54\xd2\xaf\xba\xb4\xa9\x97\x60\xe5\x56\x27\x94\x70\x31\xdc\x45\xbc\xd9\x72\x50\x3f\xd3\xc8\xf9\x99\x6c\xd4\xcf\x01\xcc\x82\xdc\xa8\xe2\x24\xb1\xcc\x51\x8b\x75\x56\xa6\x26\xa3\xcb\x40\x39\xb6\xbb\x60\x5d\x99\xb7\xb7\x17\x85\x5c\x69\x43\xb1\x5a\x1a\x69\x4b\xc2\xd3\x00\xe6\x34\x0b\xf5\x84\x5e\x04\xaa\x90\x16\x9c\x91\x1c\xb4\xbe\xe4\xca\x8a\xf8\x00\x28\x7b\xbc\xe4\xaa\xc8\xd0\xc0\x63\xab\xa2\x98\x1c\x95\xf9\xd8\x9e\x1e\x73\x0d\x5e\x25\xe9\x01\x7c\xd1\xda\x93\x90\x73\xa6\x4e\x39\x1c\xd2\x6d\x8f\xf0\x9f\xd6\xc2\x5f\xb5\x30\x01\xde\x10\xff\xfa\x06\xca\x40\x4f\xc1\xfa\x4a\x4a\x23\x09\x66\x16\xd6\x6f\x9a\xdb\x5f\xf5\x6c\x1d\x28\x6a\x0f\x9f\x55\xe6\xa3\xb1\x72\xa4\xd4\x62\x9d\x70\x84\xdf\x64\xb8\x89\x54\x1b\x08\xb6\xc0\x0e\x6e\xc7\x23\xae\x07\x4d\x3d\x9f\x58\x57\x63\x5b\xab\xa5\xe6\x10\x0d\x19\x75\x18\x26\xcc\x55\xd1\x87\x0a\x75\x45\x60\xfb\x99\x53\x9f\x95\x4b\x19\x68\x87\x74\x19\x9e\x3a\x67\x46\xbd\x3a\xc4\x23\x58\x2d\x0d\x72\x3e\xdb\x7e\x76\x00\x43\x9e\xb4\x6e\x60\x7c\x97\xe0\x22\xb8\xa3\x45\x14\x0a\x33\x69\xbe\x2c\x90\xdc\x84\xe2\xf7\x59\x70\x51\x4b\x6e\xa7\xec\x34\x84\xdb\xd5\xd4\x1d\x39\xdc\x2d\xce\x86\x0d\xcb\x10\x55\xd4\x5f\xa4\x2f\xe4\xb7\x37\xbb\x1e\x13\x86\x0f\x39\x08\x03\x0f\x95\xa6\xf3\xed\xa7\x5a\xcb\x98\x05\xdd\xb7\x6d\xf3\x9d\x7a\x37\x9d\x4e\xfd\xbb\x36\x6d\x8a\xcd\x8e\x18\x4a\x7f\xa7\x25\x6c\xc9\x2d\x42\x46\xab\xe8\xfe\x0e\x39\x84\x40\xa2\xa8\x3e\x51\x09\xf3\x71\x7f\x4b\x87\xa3\xfc\xb0\xec\xfd\x21\xc6\x5c\x47\xfa\x17\x2d\x33\xa9\x0e\x7d\x7e\x47\x75\x4c\x54\xc7\x72\x9e\x2f\x16\x10\x9e\xd8\xd6\xf4\x6c\x06\x1b\xe4\x40\x92\xa4\xb2\x8b\x54\xfe\xd0\x16\xe3\xa2\x9e\x1b\x21\xdb\x13\xdd\xdb\xa0\x3f\xb8\xd7\xc0\xa7\x23\xe0\xd4\x28\xe5\x84\x9f\x5a\x14\xec\x38\xe0\xe7\x11\xe6\x7c\xbb\x38\xf9\x84\xd1\x82\x2f\x24\x6d\x4e\xd2\xdc\x0d\x96\x8d\x1f\x19\x19\xac\x80\x59\x58\x01\xc0\x02\x6f\xb1\x60\x38\x6a\x84\x3b\x7c\x67\xfd\x1a\xb6\xed\xc4\x3c\xe8\x3f\xfa\x67\xc4\xb6\xae\xef\x39\x04\xd8\x5a\xcc\xeb\xe2\xd6\x15\xd3\x1d\xbc\xde\xb7\x43\x88\x56\x57\xcd\xd7\xb3\x76\x29\x3d\x87\x9b\x8e\x97\xa4\x39\xf1\x45\x8f\x1e\x9d\x91\x60\x98\x71\x23\xf0\x9a\x0f\xd7\x3f\xeb\xf7\x9f\x6b\xa7\x64\xee\xdc\x6d\xac\x57\xe3\x0e\x77\xb3\x1a\x41\x7d\x07\xf0\xf1\x44\x8c\xad\xb1\x87\x5b\x3e\xad\x63\xc3\x3a\xed\xc8\x6b\x83\x07\x1c\x91\x36\x2f\x4c\x2a\x57\x8f\x3f\xec\xc8\x28\x2c\xf4\x65\x69\x0d\x86\x73\x7d\x5f\xf1\xd5\xf1\xb5\x13\x39\x56\x57\x2c\xeb\xaa\xbe\xd4\x4f\xdf\x51\x59\xe7\xa0\xec\x6c\x06\xeb\xb8\x60\x5c\x77\x5a\x6a\x12\x79\xc1\xf8\x36\x4e\xa2\x02\xb3\x5a\x87\x00\x66\x3c\x2e\xd0\x76\x16\xd4\x71\xdd\x56\xd8\x7a\x1a\x61\x82\x1c\x9b\xca\xc3\xaf\x52\x86\x2a\x15\xa8\x6f\x9c\xf3\xa8\x15\x4b\xb1\x74\x55\xaf\x13\xb9\x1a\xaf\xc6\x9d\xda\x66\x98\x8e\x91\x20\x8a\x72\x06\x43\x79\x25\x80\x1e\x95\x98\x0b\xb6\x9f\x79\x7a\xe2\x0b\xf7\x77\x62\x07\x3d\xf8\xc1\x01\x18\xc2\x5e\x1c\xc1\xc6\x72\xd6\x43\xa6\x13\x4f\xf0\xec\x22\xf8\x9e\x74\x60\xbb\x4f\x07\xa9\xcf\xde\x42\xf7\xad\x69\x5d\xe6\xc2\xbb\xd0\xd3\xfa\x9b\x86\xf2\xaa\x9c\xe3\xe6\x99\xb8\xdf\x7f\xa5\xf4\x0f\x42\x0d\x7f\xbb\xa6\x59\x56\x9f\x6e\x1d\xf5\x2d\x54\xf5\x62\xba\x67\xa9\xda\xb7\xa8\x4c\x6a\xe5\xfe\xa6\xde\xc1\xf1\xa8\x77\xcb\xd9\x6a\x2b\xd2\xf6\xba\xe9\x68\xfb\xea\xb1\xc5\xda\x6c\x06\x05\xca\x8b\x45\x85\xcd\xc4\x76\xd9\x2a\x2c\xf3\x6a\xea\x3a\x9b\x42\x6a\xa4\x9a\x12\x34\xda\xb3\x86\x0c\xee\x0e\x7f\x2f\x2b\x4b\x27\x24\xdc\xb9\xff\x5e\x7d\x3e\x52\x65\xee\xad\x2d\x86\xc9\x5b\xcf\x8d\x34\x3c\x7e\x21\x78\x88\x1e\xb2\x62\x72\x7b\x34\x90\xdc\xd7\x4e\x73\x9f\x51\x68\xcf\xdf\x94\xe4\x16\x67\xd8\x61\x02\x00\x26\xb5\x03\xff\xdb\x6f\xf6\x49\x10\x80\x7b\x47\x21\x14\x18\x95\x2b\xb4\x68\x92\x09\x2c\x3b\xa8\x12\x38\x82\x65\x63\xb6\x1f\xb7\x93\xc9\xcd\x56\x6e\x9a\xfb\x72\xce\x1d\xbb\x72\x1d\x27\x1c\xed\xfb\xe8\xdd\x68\x90\x8d\x59\xeb\x96\x1f\x99\x2b\x61\x9f\x63\x51\x25\x3a\x8e\xa0\x98\x34\x80\x37\x41\x9b\xe1\xe8\xea\xf8\x1a\x8e\x20\x98\x40\x00\x47\x10\x5d\x9d\x5c\xff\xbe\xfb\x9c\x3f\x31\x2c\x3c\x3b\x12\x81\x8a\x56\xef\x68\x09\xa4\x40\x27\x32\xd2\x11\xae\x0e\xbc\x8c\x37\xd3\x75\xfc\xe7\xb0\x2b\xb6\xab\x7c\x86\x16\xdb\x62\x04\x48\x9e\xeb\x31\xf8\xb9\xc4\x91\x22\x8a\xc9\x1c\x82\xff\x47\xf2\x3c\xe8\x30\xed\xe5\xb1\x73\xef\xbd\xd0\xfa\x99\x3d\xce\x02\x1c\x79\x83\x8e\x8a\x3f\x32\x81\x54\x4a\xb3\x2b\x79\xa7\x89\x98\x8a\x6a\x6b\xf2\x1a\x16\x96\x64\x06\x5f\x21\x29\xb0\x90\x03\x6a\x88\xda\xd7\xef\x36\x36\xd6\xac\x3b\xa3\x9b\x57\x3a\xbb\x2b\x77\xfd\x7e\xaa\xef\x87\x9e\xaa\xff\x6e\xa2\x51\xf4\x95\x18\x10\x4f\x61\x73\x65\xd1\x99\xe5\x19\xad\x48\xf2\x23\xa7\x05\xd9\xe0\x94\x21\xbf\xe4\x98\xaa\x48\x40\xc2\x0c\x44\xff\x8d\xbb\x89\xba\x0e\x9b\xc9\x51\x8a\xd7\xbb\x1a\x2a\xdf\x82\xd4\x09\xf0\x0d\xee\x6c\x6c\xab\xff\xce\xc7\xb8\x14\xf0\x39\xb4\xdb\x86\xb9\xfd\xff\x04\xe9\x71\xd7\xaf\x7f\xe4\x05\x2c\xdc\x4e\x6c\x74\x27\x44\x5b\x8d\x73\x4d\x4e\x2d\x9f\x13\xd3\x93\x1b\xd5\x1c\x28\xfb\x7a\xf0\x9a\xac\x35\xbb\x1f\x2d\x38\x4e\x8b\x3d\xd2\x53\xc9\x86\x5b\x61\x88\x80\xf8\x6b\xb4\xa5\x44\x96\x63\xc8\x7f\x8e\xf1\x6e\xe4\xbb\x77\xa1\xda\xa4\x95\x4b\xf8\x80\xa1\x57\x05\xed\x1b\xaa\x5c\x98\xa6\x0e\x4a\xdd\xf3\xe5\xdd\x7d\x17\x1a\x41\xd0\xdd\x6f\xfb\xdd\xc3\x84\xd0\x11\x39\x65\x82\x59\x52\xd7\x40\x45\x23\xe7\xac\x27\x31\xde\x09\x66\x5a\x6e\x80\xba\xc9\x04\xcc\x7b\xdf\xd5\xee\xaf\x4b\x3e\x60\xe7\xdb\x1d\x8a\x51\x73\x33\xbf\x1a\x4d\x75\xe7\xb9\x7f\x81\xec\x8d\x19\x4a\x32\x8d\xc9\xee\x51\x1d\x63\x27\xce\xa2\x42\x39\xee\x9d\x1c\x9a\x85\xca\x38\xaf\xa4\xe7\x5d\xed\x51\xe9\xd5\xae\x4e\xb6\x34\xa3\x69\x3f\x73\xda\x9d\x43\xbd\x51\x65\x1e\x57\x25\x33\x72\xfb\x35\x4d\x12\x92\x33\x67\xd7\xde\xac\x2e\xf7\x63\x81\xd7\xff\x06\x00\x00\xff\xff\xeb\x73\x8f\x6d\xcc\x6e\x00\x00")
-
-func assetsJsAppJsBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsJsAppJs,
-		"assets/js/app.js",
-	)
-}
-
-func assetsJsAppJs() (*asset, error) {
-	bytes, err := assetsJsAppJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/js/app.js", size: 28364, mode: os.FileMode(420), modTime: time.Unix(1583010604, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsJsVueResourceJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x7d\x6b\x77\xe3\x36\xb2\xe0\xf7\xfe\x15\xe8\x8c\xaf\x49\xb5\x69\xc9\xee\xdc\x99\xdd\x91\x47\xeb\xed\x74\x9c\x9b\xcc\x26\x6d\xaf\xdb\x9d\x9d\x3d\x6a\xa7\x97\x96\x20\x89\x6d\x8a\xe4\x90\x90\x1f\xd7\xf6\xfe\xf6\x3d\xa8\x02\x40\x3c\x29\xf6\x23\x39\x73\xd6\x1f\x2c\x92\x00\x0a\x85\x42\xa1\x50\x55\x28\x00\xa3\x17\xcf\x9f\x91\x17\xe4\x66\x43\xf7\x6b\xda\x94\x9b\x7a\x46\xc9\xcd\xe1\xf0\xcf\xc3\x43\xfe\x79\xc5\x58\xd5\x8c\x47\xa3\x65\xc6\x56\x9b\xab\xe1\xac\x5c\x8f\xaa\x74\x49\xaf\x33\x36\xd2\x4b\xf0\xac\xe7\x34\xa7\x69\x43\xe7\x64\x53\xcc\x69\x4d\xd8\x8a\x92\x5f\x7e\xba\x20\x3f\x67\x33\x5a\x34\x74\xf8\x8c\xbc\x18\x3d\x7b\x16\x2f\x36\xc5\x8c\x65\x65\x41\xe2\x65\x5e\x5e\xa5\x79\x42\x16\xe9\x8c\x95\xf5\xfd\x80\x3c\x3c\x23\x84\x10\x76\x5f\xd1\x72\x41\xe8\x5d\x55\xd6\xac\x21\x93\xc9\x84\x44\xe5\xd5\x47\x3a\x63\x11\xd9\xdd\x95\xc9\xeb\x72\xbe\xc9\x29\x79\xce\x53\x79\x7d\x8b\xac\xa0\xf3\x88\x1c\x8b\x84\xa1\x2a\x2e\xc1\xc7\x03\x32\xd6\xe1\x63\x11\x04\x2f\x71\x82\x0a\x30\x61\x98\xae\xe7\xe4\x58\xbc\xc4\x0a\x45\x04\x21\x50\x1f\xfe\xba\xa1\xe7\x92\x64\x5a\x45\x83\xa3\x67\x4f\x31\x5b\x65\x4d\x42\xb4\xe6\x0e\xc8\x03\x89\x36\x0d\x25\x0d\xab\xb3\x19\x8b\x8e\x9e\x01\xac\xd1\x8b\x17\xf0\x4b\x5e\x90\xb3\xba\x5c\x67\x0d\x6d\x46\xaf\xf6\x48\x55\xe6\xf7\x8b\x2c\xcf\x79\x57\x1c\x0e\xff\x9d\xc4\x9e\x8e\xb8\xaa\xd3\x75\xc3\x68\x56\x8c\x2a\x28\x39\x10\x80\x46\x08\xf9\x26\xad\xc9\xf9\xc9\xdb\xd3\x9f\x7f\x3d\xf9\x9e\x4c\xc8\xc1\x91\xf6\xf5\xef\x27\xaf\x2f\xe0\xeb\x61\xfb\xf5\xec\xe4\xcd\xf7\x3f\xbd\xf9\x0f\x32\x21\x2f\x05\x6e\x0a\x79\x81\xd9\xce\x61\x4c\xef\xe8\x6c\xc3\xca\x9a\xf7\x16\x56\xc7\x49\xba\xca\x9a\x61\xc3\x52\xc6\xc9\x20\xc0\x1c\x99\xa9\x37\x69\xbe\xe1\xa9\xaa\xaf\xac\xf4\x39\x5d\xd0\xba\xa6\x73\x32\x21\xd3\xcb\xa3\x16\x34\x47\x0c\x5b\xc7\x4b\xf3\xac\x5a\x22\xab\xef\x05\xd3\xc8\x3f\x89\x9e\x46\xf7\xbb\x81\x95\x87\xff\x09\x90\x43\xce\xbf\xf9\x0d\x8d\xef\x06\x47\x46\x9e\xa7\xa4\x6d\x7c\x5c\x77\x43\xe0\xac\x19\xd7\x36\x00\xed\xfd\x89\xcc\x52\x36\x5b\x91\x98\xda\x80\x2c\x20\x54\x2f\xf4\x0c\xff\xc3\x8f\xa2\xbf\xc8\xc9\xb9\xcd\x8f\x5e\x4d\xd9\xa6\x2e\x48\x41\x6f\xb5\x4e\xd3\xf2\x62\x7b\x13\x82\x70\x6c\x7c\x3c\x8d\x91\x0d\x79\x3a\x72\x51\x01\x58\x06\x2e\x77\x5f\x13\x17\xb7\x6b\xc2\xc8\xa4\x79\xae\x23\x92\xe6\x79\x9c\x31\x5a\xa7\x57\x39\xfd\x7a\x28\x71\x66\x9c\x95\x9b\x82\xd3\xff\x80\x67\x6a\x36\x39\xb3\x39\x96\xff\x65\x0b\xa2\xaa\x1f\xe6\xb4\x58\xb2\x15\x48\x9a\x03\x1f\x27\xc9\x86\x22\x38\x9b\x8f\x4c\xc0\x0a\x55\x51\xa8\x8e\x33\x3f\x48\x68\xe6\x96\x41\x20\x2a\xdf\xe4\x6c\x9a\x5d\x92\x09\xb9\x3b\xf2\x66\xc1\x16\xef\x81\xac\xf0\x66\xe0\xad\x15\x64\x99\x4c\x88\xd5\xee\x50\xc5\x5b\x5b\xde\x52\xc0\xf9\xd2\x4d\xa2\xb2\x26\x31\xef\xa9\x0c\x84\x1e\xc9\xc8\xdf\x6c\x9c\xf8\x47\xde\x1e\x1f\x6e\x0e\x7f\xab\x8e\x9c\x66\x97\x83\x21\x5b\xd1\x22\xd6\x88\xaf\x58\xc5\xc6\x49\x3d\x85\xc7\x4f\x3a\x33\x06\x0f\x7f\xff\x1d\x98\xf6\x8f\xa3\xc7\x27\xd3\x02\x84\x3b\x9f\x32\x54\x1d\x55\x5d\xb2\x92\xcf\xd0\x22\x47\xe5\x93\x32\xad\x64\xd0\xf0\xed\x9c\x28\x38\x87\x4a\x59\x2b\xe6\xa9\x89\x9a\xa9\xec\x56\xf3\xcc\x77\x90\x41\x14\xf1\x91\x85\xad\xea\xf2\x16\xba\xe4\xe2\xbe\xa2\x27\x75\x5d\xd6\x71\x24\xda\x41\x1a\xca\x58\x4e\xe7\xe4\x36\x63\x2b\x92\xb1\x86\xe6\x8b\x61\xd4\x3d\xb0\x41\xb4\xa4\x79\x0e\x33\xe0\x22\xcd\x1b\x6a\x0d\x36\x77\xae\x93\xc5\x78\x17\xf0\xd1\xcb\x95\x97\xbb\x69\xc4\x5f\x23\x5b\x20\xb5\xed\xe2\x1a\x53\xb1\xc9\x73\x4d\x97\xba\x0b\x29\x59\x08\xd9\xd0\x90\x42\xc3\x99\x67\x1d\xf2\x06\xc4\x77\x49\x1f\xb9\x23\x11\x7a\x8e\x8d\xee\xca\x46\x7a\xcc\xd7\x26\x65\x43\x29\x8a\xc0\xac\xde\xd8\xf4\x55\xa5\xb7\xce\xfc\x5f\x84\xbe\x57\x59\xf8\x2c\xec\xbd\x85\x03\x80\x51\x82\xb8\x69\x66\x65\x61\x3d\x85\xf4\x6b\x6e\x87\x3a\x13\x6e\x9e\x0f\x37\x6b\x70\x58\x43\x57\x69\xb5\x47\xde\x5c\x52\xd5\xbc\xf3\x27\x17\x25\xcb\x16\xf7\xb1\xab\x6a\x69\x22\xc7\xd6\xb1\x64\xbf\xd1\xb4\x29\x8b\xdf\x4f\xea\x20\xfc\x3f\x5a\xf4\xb8\xd4\x45\xeb\xa0\x9b\xba\x88\xeb\xe7\x92\x18\x73\xe8\x24\x96\x65\xfa\x12\xb7\xa0\x77\xec\x22\x9b\x5d\x9b\x16\x96\x43\x52\xb3\x71\xcf\xc3\xf4\xe7\x7f\xb7\xab\x2c\xa7\x6d\x19\x69\x93\x6c\xd1\x64\x38\x9a\x9a\xf9\xe2\x94\x6e\x56\xd9\x82\xc5\x83\x24\x38\xb0\xcb\xe2\x1c\xc5\x1a\x2f\x2e\x8b\x4d\x0f\x2e\xbb\x4b\x70\x8e\x34\x4b\x1c\x76\x94\x68\xa7\x51\x95\xfd\x65\x67\x76\x31\x04\x54\xee\x6f\x7d\x53\x0a\x09\x4e\x4c\xf2\xcf\x3f\x06\xe4\x00\xde\x26\x37\x79\x69\x31\x17\xe9\x44\xea\x35\x23\x79\x5a\x1f\xb7\x40\x70\xae\x52\xd6\x68\x62\xf2\xf7\xa0\x43\x40\x13\x90\x94\x34\x6f\xe8\x27\x54\x6c\x42\xdf\x02\x3c\x3c\x37\x60\xb5\x21\x9a\xe2\xb0\xfd\x44\x9a\x4a\x36\xfa\x22\x9a\x22\x90\x3f\x8c\xa6\x20\x8c\xbf\x12\x49\xfd\xf3\x68\xe7\x4c\x68\x21\x12\xaa\xdc\x63\xb8\xf4\xd4\x8b\xab\xa1\xd0\xe9\x94\x6c\x03\x35\xbb\xe5\xde\x44\xeb\xba\xde\x12\xf3\xcb\xcc\x08\x47\xac\x55\x9b\x66\x15\x4f\xfd\x38\x25\xc4\x82\x76\x39\xe8\x3d\x53\x38\xb4\xc0\xae\xd0\xbd\x0c\xfe\xc6\x8b\xf6\x81\x3b\x09\xe8\xa5\x71\xa2\x56\xc2\x04\xef\xba\xdf\x48\x3a\x4f\x2b\x46\xeb\xa1\xf8\x2e\xbc\x69\xda\xb0\x91\x19\x27\xa6\xf3\x51\xc7\xe5\x36\x2b\xe6\xe5\xed\x50\xe5\x6c\x29\x2e\xaa\xf7\x3b\xd8\x4e\xaf\x3e\x2a\x0f\x5b\x42\x66\x65\xc1\xe8\x1d\x33\x5c\x6d\x1c\x0d\x99\x83\x64\x45\xc3\xd2\x62\xa6\xe1\x64\xf7\x1a\x10\xa3\xe5\x08\x59\x52\xf7\x4d\x79\x46\x9d\x55\x4a\xe3\x18\x55\xf7\xf0\x2a\x2b\xe6\xb1\xc4\xd0\x98\xe9\x9f\x19\x60\x44\x16\x32\x91\xcd\x31\x08\xd0\xb6\xdb\xf6\xe0\x28\x33\xd3\xa0\x43\x98\x97\x39\xe9\xc4\xe3\xd0\xf0\xfe\xb4\xe5\xbd\x86\x38\xaf\xda\xe7\xc9\x02\xb1\xf2\x19\x75\x4b\xb1\x88\x62\xa9\x57\xe5\xae\x47\x0f\x74\xab\xcf\xaa\x5c\xd7\x53\xfb\x54\x6e\xf9\x20\xbe\x84\xea\xa6\xff\x22\x54\x39\x88\xa8\x9d\xc3\x76\x48\x70\x34\x1c\xb3\x7f\xe7\x10\x18\xcc\x40\xcd\x83\x50\x17\x87\x59\x22\xc1\x92\x2a\x3b\x87\x8e\x8c\x8d\x17\x9b\x7c\x91\x71\xfb\x46\x4a\x2d\x94\x2f\xc6\xd8\x53\x79\xb8\x99\xac\x5e\x10\x59\x6e\x38\x6b\x08\x39\x8e\x18\x55\x74\x62\x95\x8c\x8d\x62\xde\xb1\x84\xe6\x81\x98\xac\x77\x77\x15\x82\xbd\x6a\xae\x5b\x65\xd1\x28\xd7\xa3\x5e\x7f\x97\xeb\x02\x02\x65\xad\x8f\x76\x09\xf1\xc0\xd7\x7b\xc0\x95\xec\x41\xba\xff\x8b\xb6\x1d\x5a\x10\xf7\x6f\xf1\x22\x2b\xd2\x3c\xbf\x37\xf9\x3a\xcd\xf3\xab\x74\x76\x6d\xb4\xd9\x99\xcc\x2c\xc9\x64\x37\x53\x02\x41\x1d\x8c\x17\xb3\x66\x5c\x01\x10\x0a\x6b\xad\x4d\x1c\xb1\xf3\xb9\x90\xfd\x32\xc8\x36\x01\xf9\x5f\x78\x0a\x7e\xc7\xb2\x3c\x63\xf7\x0a\xa7\xc6\x9a\x84\xb9\xf0\xa8\xe9\x82\x4c\xc8\xc3\x53\xbb\x70\xb5\x4a\x9b\xd3\xdb\xe2\xac\x2e\x2b\x5a\xb3\x7b\xe8\xe8\xc5\xd0\xfc\x78\xa4\x17\x07\xe9\x33\xbd\x6c\xbf\x35\x79\x36\x43\x9b\x76\xb1\x73\x38\x84\xb7\x36\x71\x4e\xaf\x36\x4b\xe9\x94\x4b\x48\xc1\xb2\xd9\xb5\x26\xcb\xb2\xe2\xbb\xba\xbc\x6d\x68\xcd\x15\x2e\xd4\x0f\x70\xf2\xb7\xd7\x26\xed\x35\x35\xde\x56\x4e\xf3\x85\xad\xc0\xcd\xca\x62\x91\x2d\x45\x33\xf0\xe5\xc8\xc8\x20\x4d\x5f\x91\x45\xbe\xea\xc6\x31\xc3\xe4\x36\x49\xa6\xc8\xd6\x20\xdc\x21\xbe\x3e\x3e\x92\xe7\xe2\x43\x93\xe5\xb4\x60\x7e\x25\xe5\x36\xad\x8b\x78\xdd\x2c\x75\x84\x35\xb5\x68\x56\x16\x4d\xe9\x59\x93\x85\x35\xd5\xab\xcd\xd2\x61\x2c\xcc\x3f\x04\xb0\xd1\x54\x5f\x4c\xe5\x9f\x2e\xc7\x24\x22\x7b\x84\xd7\x17\x58\x15\x53\x88\x51\xf0\x81\x7c\x32\x66\x21\x84\x5a\x70\xdb\x2a\x56\x4e\x88\xd9\x55\x42\x66\xcc\xbb\xfa\xc5\xf4\x74\x3f\x61\x59\x9d\xad\xe3\x86\xf9\x16\xf2\x1a\x56\x93\x63\xfe\x7f\x58\xd3\x2a\xe7\x33\xec\xe8\xb7\xf7\xcd\x8b\xc7\xf7\xcd\x8b\x9d\xd1\x32\x21\x51\x34\x20\x63\x12\x45\x61\xc0\x27\xc5\x9c\xc3\x4e\xc8\x6c\x95\xd6\x8d\x23\x58\x79\x05\xbb\xbb\x98\x08\x1a\xad\xa2\x8f\x2b\x49\x25\x46\x2d\x2e\xef\x9b\xbd\x9d\x11\x60\x11\x9c\xb5\x9e\xf3\x1a\x80\xc3\x64\xfd\x7e\xa0\x5d\x22\x58\xaf\x93\x8b\xe3\x73\xba\x3c\xb9\xab\xe2\xf8\x9b\xe9\x37\x64\x4f\xe0\xbe\x47\xbe\xb9\xdc\xdb\xf9\x66\x30\xd0\xd0\x71\xe8\x51\xfe\x5c\xde\xd2\x7a\x2b\xad\x45\xbe\xd7\x69\x43\xe3\x4e\xfa\x96\xef\xaa\xaa\x17\x3c\xc8\x17\x82\x07\x72\xa4\x79\x55\xd7\x29\x97\x5e\xf0\x3b\x14\xef\xb6\xd4\xc8\x9a\xb7\xac\xce\x8a\x25\x9f\x05\x7c\xc6\x0f\x32\xfc\x4d\x9a\xa3\x75\xd2\x40\xe6\x00\xf6\x59\xf3\x83\x78\xec\x09\x4d\xf9\x07\x42\xf0\x4e\x61\x09\x21\x2e\xaf\x3e\x7a\xa0\x95\x57\x1f\x7d\xab\x0f\xfc\xb3\xbe\xfe\x10\x82\x7d\x96\xa7\x59\xd1\x59\x81\x59\xff\xee\x2e\xc1\xd7\xe1\x92\xb2\x33\xa9\x60\x9e\x2e\x30\x75\x32\x91\xa9\x9a\xee\xe9\xaf\xf8\xbb\xbc\xbc\x0a\xd4\x28\x9a\xc0\x73\xf8\xc4\x1e\x6f\x9a\x66\xa3\xf1\x6c\xc1\x9e\x28\xeb\xf5\xf7\x29\x4b\xbb\x2b\x92\xb9\x7a\x54\x26\xb3\x06\x44\x39\x57\x29\x84\x89\xb3\x4d\xed\x35\x9d\x0a\xae\xd9\x14\x4b\x0f\x8c\x31\xec\xd3\x7a\xb9\x59\xd3\x82\x35\x72\xfd\xfb\x6f\xe4\x65\x60\xf0\x0b\xe0\x5d\x02\x60\xab\xae\xe9\x6f\x66\x59\x81\x16\x11\x2f\x8a\x84\xd3\x27\xe1\x1f\x4c\x11\xc8\x3f\x90\x09\xfe\x3c\x3e\x82\x5a\x61\x34\x43\x1b\x23\x50\xd6\x6e\x82\x56\x1e\x15\x24\xde\x7f\x5d\x2d\x59\xd3\x7a\x49\xe3\x45\x81\x4a\xe8\xc3\xce\xcd\x7a\x8c\xa8\xed\x08\x64\xc7\x00\xec\x69\x90\x10\x8e\xf5\x83\xfd\xd9\xdf\x50\x9a\xce\x56\x31\x80\x01\x03\xcc\x0e\xd0\x01\x01\x93\x90\x6b\x7a\xef\x34\x0f\xa4\x0c\x60\xed\x5d\x38\xd6\x16\x8d\xcb\xab\x8f\xed\x7a\xf1\xde\x9e\x77\x8d\x48\x54\xae\x48\x31\xcd\x2e\x81\xf2\xf0\x9b\x85\x17\x86\x5b\xc7\xa6\x31\x88\xbd\x28\x5d\xd3\x7b\x92\x81\x38\x09\x2d\x53\x99\xba\x9f\x42\x06\x08\xe0\xc0\x0c\xe3\x7e\x4d\xef\x05\xf6\xf8\xc4\x4b\x6f\x5d\x43\xeb\xe8\xfa\xf2\xea\xa3\x23\xf6\xd3\xa6\xc9\x96\xdc\x0c\x15\xd2\x48\xbc\x3f\x3e\x92\x0f\xf8\x68\x4f\x00\xc8\x3f\x2c\xad\x97\x94\x39\x7d\x9c\xd6\x4b\xce\x8d\xa0\xc1\x62\x3b\xd4\x30\x4c\xc8\xa1\x3e\x44\x79\xce\xe1\xa2\xac\x4f\x38\xe3\xb4\x26\x00\xea\x5f\x36\x89\x3e\xe8\x95\x26\x04\x33\x25\xb0\x0e\x69\x79\xee\x1c\xb9\x05\x45\xfc\x3c\x3b\xa7\x8b\x74\x93\xb3\xe6\x0f\x68\x8c\xcb\x44\xbc\x02\xc1\x48\xfe\x36\x4b\x5e\x42\xe4\x80\x03\xba\xd5\x23\xf9\x67\x14\x10\xc0\xe1\x6d\x3b\xeb\x7c\x36\x29\x05\xaf\xfc\x2b\xb0\xc5\x17\x30\x44\x80\xcd\xe6\x94\x56\x7a\xd5\xfd\xfa\x8f\xf7\x1d\x2f\xc9\xa7\xc6\xd8\xd4\x1e\xb4\x3e\x19\xf0\x91\x26\x85\xa0\xfe\xdd\x2b\x26\x50\x3e\x05\x41\xed\xee\x92\xe7\x66\xb2\xc6\x0b\x41\xb9\x63\xf2\xcb\xc3\x53\x9f\x35\x73\x5d\x74\x7b\x50\xc0\x84\x4f\xaf\x7c\xba\x95\x47\x9d\x6e\x17\xa2\x51\x43\x42\x74\x98\x25\xea\x5b\x01\xaf\x65\x05\x35\xa6\x73\x40\xf5\x1c\x4c\x4f\x7e\x3b\x4d\xf3\x2c\x9c\x97\x25\x23\x67\x35\x5d\x64\x77\xe4\xa2\x4e\x8b\x66\x51\xd6\x6b\xcb\xbb\xd0\x2e\xf7\xf3\xcc\xb1\x98\x78\x77\x76\x0e\x13\x30\xf4\x9c\x61\xb5\xa9\x73\x61\x63\x6b\x79\x6d\x3d\x48\x29\xec\x6d\x96\x21\x87\x8f\x7d\x35\xfa\x0d\x03\x7b\x8f\xc7\x83\xe3\xf7\xa3\xd1\x90\xd1\x86\xc5\x9b\x3a\x77\x3a\x0c\xeb\x92\xf6\x9c\x05\x2b\x21\xd1\x28\x1a\x90\x3d\xfe\x43\xf6\x78\xde\x2e\x0d\x44\x25\xbb\x54\xfa\x9f\x1b\x5a\xdf\x93\xb3\xb4\x4e\xd7\x94\xd1\x7a\x3b\xa5\xfe\x09\x05\x7a\x91\x0a\xa0\x36\xed\x4c\x77\x4d\xef\x9b\xf8\x5d\x9d\x0f\x45\xe1\x61\x05\x19\x06\x89\x00\xca\xc7\x42\xb2\x9d\xc6\xa8\xf9\xb4\xf4\x40\x28\x89\xeb\xc9\xe7\xb3\xb7\x47\x46\x28\xcc\x86\x59\x31\xa7\x77\xa7\x8b\x18\x32\x72\x51\xbf\xef\x0d\x88\x03\xec\x24\x47\x5a\xee\x34\xe2\x2c\xeb\x3d\x33\x8a\x91\x09\xe1\x2d\x46\x1c\x63\xf8\x64\xf3\x0b\x7e\xf4\xf4\xfe\xde\x04\x90\x55\x68\x46\xc7\x11\x98\x31\xfb\x87\xe4\x98\x44\xc7\x11\x37\x2b\x77\x81\x0b\x00\xc4\x67\x72\xc0\xbb\xf3\x9f\xc9\x05\x5d\x57\x79\xca\x28\xb9\x79\x39\x3c\x18\xfe\x65\x4b\xec\xf9\xa6\xce\xf7\x99\x28\x31\x08\xb0\x09\xbd\xab\xd2\x62\xce\xd1\x4f\x88\xec\xa0\x9b\xb4\xce\xd2\xab\x9c\x36\x0e\xb3\xb0\x75\xc5\x3b\xbd\x4a\xeb\x86\xc2\x60\x48\x04\x00\x8c\x7e\x5a\x57\xf9\x50\x00\x14\x2c\x63\x91\xd0\x80\xac\x93\x51\x25\xc0\xe2\xe5\x30\xad\xaa\xfc\xbe\xcd\x9d\x20\xe8\x9b\xb4\x6e\x3a\x35\x78\x89\x8b\x7f\x12\x43\xac\x15\x41\xec\xb6\x71\x95\x94\x6b\x99\x7c\x20\x4c\xa3\xbd\x28\x21\xd1\x9f\xf8\xbf\x61\x04\x83\x38\x21\xd1\x11\xff\x77\xcc\xff\xed\x46\x97\x1a\x99\xec\x38\x63\x81\x8d\xd3\xc2\x66\xdc\x96\x31\x03\x3c\x10\xf1\xb1\xd3\x2d\x01\xcf\xb9\x56\x89\x6c\x8e\xe6\xf8\x79\x88\xa7\xbf\x3d\x3c\x5d\xee\x0d\xde\x3f\x3d\xca\xc7\xd1\x52\x1f\x77\x1f\xa0\xdb\x6a\xda\x34\x59\x59\x24\x24\x07\x05\x3b\x0f\xaa\xdf\xb0\xb0\x29\xb3\x3b\x4a\x9b\xd5\x48\x45\x47\x82\xfe\x84\x04\x47\xa2\x43\x24\x5f\x2d\xaa\x0b\xd4\x50\x6a\xeb\x1d\xce\x56\x69\xfd\x8a\xc5\x07\x83\x01\x4c\x4e\x7e\x11\xa0\xff\x69\x88\xf8\xc0\x74\xc7\x25\xb4\x25\xcc\xe2\xcd\xe6\xaa\x61\x75\x7c\xd8\x19\x27\x18\x4c\xd2\x01\x55\x79\xc6\xe2\x51\x32\x5a\x0e\x3c\x6a\x9d\xe4\x93\x6d\x6d\x14\x83\x92\x4c\xc8\x28\x9e\xfe\x36\x7e\x71\xf9\x62\x10\x1f\x8f\xc7\xf1\xfb\xf9\xde\xe0\x31\x7e\xff\x62\x30\x38\x1e\x0d\xe9\x1d\x9d\xb5\x10\xbb\xdb\x8d\x9d\x65\x8e\x42\xfe\x25\x21\x4b\xca\x7e\x85\x47\xc9\x95\x89\xa2\x30\x8c\xcf\xe9\xe1\x25\xfe\xbe\xbc\xe4\xaa\x1b\x7f\xfa\xf6\x72\x5b\x68\x89\x39\xee\x63\x04\xd3\x45\xdb\x41\x4f\x16\x02\xbf\x8b\x7c\x06\x9f\xcc\x5e\xd4\xc9\xba\x92\x9a\x0d\xe5\xa2\x0b\xd9\x26\x4a\xa2\x8e\xea\x9c\x2a\xc1\x57\x76\xdc\x2b\x44\xc7\xa8\x65\x37\xea\x15\x7f\x63\xd4\x05\x4d\xfa\xd3\x27\xd7\x25\xcb\x6f\x0b\xca\xe9\x4c\x16\xa2\x47\x70\x86\xf4\x22\x71\x8c\x0e\xc8\x71\x4b\xf5\x31\xb8\xc0\xf7\x24\x4b\x7d\x2c\xb3\x22\x56\xb8\x74\xf5\x71\x9f\x70\x23\x7d\xd1\x4c\xc0\x8e\x12\x3b\x9c\xb2\x47\x9b\xb6\x56\x26\xa7\x96\x62\x56\xce\xe9\x39\x6d\x68\x7d\x43\xe7\xb1\x14\x99\xbd\xe3\x8b\xc2\x0e\x16\xff\x64\xd5\x39\xda\xae\xe9\x7d\x42\xd6\xe5\x3c\x5b\x64\xd4\x75\x27\xc9\x38\x50\x51\x50\xa8\xfe\xfe\x2d\x31\xa8\x07\x7f\x8f\x6a\xbe\x5c\xbf\xdc\xdd\x15\x30\x80\xc9\x1c\x1e\xd3\x96\x6f\x44\x55\x9a\x3f\x1b\x86\xbe\x93\x58\x6c\xd6\x57\xb4\x0e\x24\x5e\x95\x65\x4e\x53\x7f\x68\x9b\x6c\x0b\xfc\x0e\x59\x29\x34\x76\x9f\x14\xe0\x68\x49\x9a\xf0\x26\xa8\x67\x68\xc5\x8b\xe0\x50\x31\xab\x40\xf9\xce\xeb\x38\x48\x50\x63\xf8\xa9\x60\x0a\x6e\x42\x0e\x0f\x7c\x22\xcd\xc3\x5c\x48\x6f\x14\x6b\xc8\x3c\xd0\x9f\x71\xdb\x8b\x42\xfb\xcd\x9a\xff\x41\xef\x4f\xc5\x57\x95\x3c\x20\xc7\x60\x45\x8f\x61\x12\xb5\xeb\x0c\x72\xad\x41\x84\x49\x77\xc3\x79\x5e\x63\x45\x43\xf4\x7f\x97\x4c\x41\x22\x2d\xb2\x9c\xd1\xba\x65\x1c\xff\x0c\xe6\x59\x0b\xff\x43\x89\x64\x10\x2c\x34\x52\xb7\x0d\x7f\xdd\x24\xc2\x26\x79\xda\x7a\xdd\x27\xb2\xd3\x1a\x66\xd3\xeb\xb0\xed\xff\x19\x24\x9a\x5e\x5f\x26\xe4\x7a\x6b\x24\xe7\xa7\x13\xc8\xe5\xf5\x2e\x92\xb5\xfa\x48\x50\xd9\xfb\x17\x60\x3b\xb6\xae\xb6\x12\xf4\xff\x4f\x6e\xb2\x5a\xfe\xee\xfc\xa7\xd7\xe5\xba\x2a\x0b\x5a\xb0\x78\x2b\xf7\x78\x00\x84\x78\x51\x13\xd5\xbf\x07\x4f\x06\x19\x2b\x20\x27\xb6\x6c\x75\xb4\x06\x98\x49\x15\x7a\x0f\xfe\x9b\x49\x44\xf6\xa0\xf5\x4a\xd7\xe8\x66\x02\x98\x25\xd7\x95\xa1\x1d\xf5\x45\xa3\x57\x35\x5b\xfc\x81\xce\x12\x8e\x3b\x89\x9b\x8a\xeb\x91\x77\xa2\xe8\x41\x9d\xb0\x43\x51\x9b\xe4\x61\x21\xd4\xaa\x71\x17\x14\x02\x47\x7d\xfe\x4c\x34\xb0\x93\xfa\x21\xb3\xad\x8a\xc8\xdd\x98\xd3\x3e\xd9\x76\x3e\x16\x0c\x2d\x1f\x5b\xba\x95\xb3\x7e\xdc\x6a\x5a\xca\xdd\x6a\x2a\x60\x7c\x6a\x0b\x01\xf7\x4f\x8a\xee\xfa\xbe\xd5\xd1\x2e\xd9\xfd\x5d\x11\x58\xd1\xec\x9a\xa8\x85\x2f\x4f\x53\x48\x51\xbb\xb2\xba\x7e\xcf\x53\x1f\xb7\x66\x8e\x6d\x45\x5b\x90\x6d\x4c\x3c\xbd\xee\x5d\xdd\xf6\x38\x13\x0d\x15\x3e\x3c\xb8\xed\x00\x3c\xdf\xb0\x09\xc4\xea\x75\xd0\x49\xb5\x24\x18\x89\x22\x5d\x01\xf1\xbf\x4d\x0f\xf6\xff\xfa\x6a\xff\x87\x74\x7f\x71\xf9\xf0\xf2\x69\x30\x5a\x0e\x86\xeb\xb4\xd2\xa6\x86\x2a\xad\x1d\x67\x10\x44\xf2\x8c\xf4\xb2\xc2\x59\x0d\x99\x7d\x9c\xce\x13\xc8\xa4\xa5\x07\xe6\x0c\x31\xbc\x86\x2c\xcf\xa7\x2f\x22\x09\x21\x65\xc5\xf4\x84\x7c\x96\xf1\xf9\x0f\xaf\xc9\x5f\xfe\xfc\x5f\x0e\x06\xdb\xbd\xd7\x4c\x15\x12\x0e\x64\x8f\x95\xa3\x7b\xdf\xa4\x3b\x5a\xf8\xcd\xa4\xe3\x1a\xdc\x9a\xa6\x17\x5b\x77\x6f\x9a\xa7\x59\x08\x47\x84\x67\x42\x76\x59\x6a\x4e\x73\xca\xa8\x05\xda\x5a\xfe\xf0\x2d\xb2\x75\xf8\x77\xdf\xd2\xfa\x26\x9b\x51\x58\x44\xe3\x74\x13\x24\xc8\x8a\x65\x88\x48\xef\xea\x5c\x77\xdc\x3a\x24\x6a\x68\xbe\x10\x9b\x4d\x30\x82\x42\xd1\x62\x07\x02\x2d\xa1\x2c\x93\x5d\x11\x5a\x1d\xf1\xad\x79\x18\x60\x1e\x36\x75\x3e\x26\x1a\x22\x63\xf1\xfb\xe4\xf5\xd5\x1a\x65\x71\xc1\x8a\x63\xa6\xad\x37\x24\x80\xf9\x70\x47\xbd\xfa\xd7\x17\x78\x09\x85\xbd\xaf\xdf\x56\x69\x31\xcf\x2d\x03\xd9\x69\x9e\xcc\xe4\x1b\x28\x22\x4d\xac\x0d\xa8\xba\xa6\xe2\xbb\xb3\xd4\xe5\xa9\x45\xc5\xa8\x74\xd5\xa3\x20\x6b\x67\xd5\x88\xfc\x5a\x07\x49\xb2\xdc\xac\xc3\xbb\x46\xbd\x0b\xbb\x12\x80\xb9\x4e\xe3\xe7\xc2\x77\x75\xae\xb8\xda\x64\x3b\xad\x83\x78\xa7\xab\x5a\xa0\xf7\xa3\xa8\x75\x68\xd7\x65\xc9\xd0\x22\x6b\xbf\x49\xc6\x78\x30\xb7\x9b\x5a\x15\x6b\xbd\xe9\xd6\xad\x13\xe9\x41\xca\x87\xb1\x92\x14\x62\x71\x6a\x8c\x3f\x89\xc0\x82\xff\x17\x6c\x68\xb2\x0b\x38\xf9\x65\xd9\x28\x21\x11\x14\xe3\x0f\xbc\x88\xda\xa5\xaf\x21\x78\x02\xe2\xb2\x21\x29\xa0\x5a\xa9\x95\x38\x74\x17\x48\x84\x65\xee\xff\x0e\x19\xc8\x03\x6a\xfc\x4f\xa4\xbc\xfa\xe8\x36\xa9\x92\x0b\x6f\xda\x06\x2a\x8c\x9d\x31\xc6\xb1\xca\xc6\xe5\x1c\x6d\x66\x69\x45\x75\xf1\xad\xa6\x33\xad\xeb\xb1\xc8\x30\x9d\x9b\x1b\x35\xc0\x71\xa4\x34\x91\x2e\x76\x0d\xdb\x64\x86\xbf\x24\xee\xde\xc0\x6c\xea\x5d\x60\xa4\x77\x40\x8c\xa2\x4e\x60\x18\xde\x0f\x1a\x20\xd0\xc0\x9c\xbd\xc5\x37\xc7\x76\xd3\xc3\xc6\x1a\x5a\x67\x69\x9e\xfd\x27\x8d\xe5\x5c\x80\x31\x61\xf6\x88\x11\xd4\xc3\xd9\x6d\x37\x1a\xb4\x8b\x2b\xff\xf6\xf2\x00\x62\x7b\xf7\xa2\x8e\xdd\x6a\x69\xdd\x50\xce\x26\xe7\x3f\x93\xb4\x98\xab\x18\x48\xd6\x90\x99\xec\xaa\x26\xc4\x2f\x28\x95\x9e\xf8\xa8\xf2\xf2\x4b\x63\x6e\x0a\xe2\xc2\xd9\x66\x17\xca\x27\xc2\x79\x39\x83\x30\x92\xe1\xac\xa6\x29\xa3\x27\x39\xe5\x6f\x71\x94\x46\xb6\xc2\xa4\x72\xca\x87\x5f\xca\xb9\x63\x41\xd3\x7c\xb8\xc2\xf0\x7e\x63\x09\x9b\xa8\xf5\x6f\x91\xc1\x2b\xf1\xad\xc2\x36\xb5\xcd\x9a\x78\xce\xb1\x2c\x62\xae\x92\x41\x3c\xe8\xac\xcc\x21\x59\xbe\x70\x95\xb1\x7d\x6b\x7b\x6a\x2c\xa2\x9f\x89\x29\x9c\x00\x4e\x59\x33\x84\x51\xd6\xcc\x4c\x5a\x95\x0d\x26\xf1\x07\x37\xa9\x48\xd7\x54\x25\xf3\x17\x0b\x70\xca\x56\x2a\x8b\x7c\x69\x57\x9c\x50\xcd\x1d\x45\x02\x65\x91\xce\x11\x84\xe0\x00\xed\x9b\x09\xb6\xa1\x69\x3d\x5b\x01\x50\x7c\x44\x00\xf8\xac\x47\x9f\x1f\x07\x9b\xbc\x4a\x1b\x04\xc0\x1f\xb0\x38\x7f\xd2\x0a\xff\x49\x2b\xab\x0f\x1e\xa2\x31\xb9\xe2\x3b\x67\x92\x2a\xc0\x43\x7d\xb3\xf6\x68\xb8\x9a\x6c\x6b\xa7\x1f\xbf\x5a\x2d\xc0\x61\x20\xd4\xcd\x5a\x9f\xf8\x45\xf4\xc2\x91\x83\x9a\x85\x99\x77\x88\x27\xa4\x99\x95\x95\xbb\xe4\x9b\x8a\xb8\x6e\x3d\xd6\x32\x21\x55\x9e\x66\x05\x7c\xb5\x03\x9b\x13\xa0\xa3\x13\xe2\xc0\x2b\x08\x05\x35\x38\xbd\x00\x80\x05\x4c\x21\x89\xb5\x40\x27\xc7\xa4\x21\x72\x33\x80\x6c\x00\xb1\xfe\xae\x29\x6f\x01\x24\x73\x59\x38\xe5\x9c\x14\x63\x13\x1e\x1f\x89\xe8\x6e\x74\x92\xe2\x72\x4c\x74\xd9\x2d\x66\x71\x67\x00\xc0\xdb\xdd\x45\x12\x05\x8c\x08\x31\xc3\x20\xd2\x43\xe0\x5b\xe1\xa9\xf3\xed\xfc\xd6\x4c\x71\x8e\x95\x0f\xa6\xdb\x79\x1a\x31\x7b\x7a\xc0\x35\xbc\xb4\xd9\xae\x2b\x10\x84\x78\x15\xa1\x7f\x7c\x5f\xae\x39\x11\x67\x79\x46\x0b\x46\xe2\x9f\x0a\x46\xeb\x82\x32\x72\x72\x57\xe5\x65\x4d\xeb\x41\x48\x2d\xbf\x9b\xd7\xaf\x45\xa1\x9a\xfe\x73\x43\x9b\x1e\x3b\x35\x9d\xcd\xde\x0e\xef\x70\x7e\xbd\x9b\xd7\x62\xd3\xaf\xc0\xee\x1c\xe1\xc7\xc0\x99\x52\x57\xd5\x61\x2d\xc2\xab\x1e\x35\x2c\xc5\x88\x9d\x4a\x62\x87\x67\x30\x6b\xc3\x52\xb6\x69\xf0\xac\x42\x6f\x26\xb9\x28\x84\x32\x2e\x2f\x53\x77\x13\x8f\xfe\xa7\xe0\xbd\x3c\x38\xd8\xee\x46\x53\x70\x61\xff\x4f\x3f\xc0\x7f\x0e\x02\xf6\x37\xa0\x3d\xf1\x0c\x48\x3a\xac\x69\x53\x95\xc5\xfc\x7f\x65\x6c\x15\xdf\xcd\x6b\xf1\xde\xd0\x0b\x90\x74\x0f\x58\xcf\x58\xd4\xf7\xe4\x5d\x14\xb2\x48\x25\x21\xa7\x57\x65\x6d\xee\x2a\x1e\x90\x07\xc9\x17\xbc\x2a\xc8\x10\x0f\x8e\x1c\x08\x3c\xb1\xac\xe0\x58\x2f\x04\xb5\xa6\x6c\x55\x42\xb0\x3d\xbe\x2f\x29\xe3\x46\xe1\xc0\x27\x41\x64\x1e\x96\xad\x69\xb9\xf1\xc6\x94\x70\xf8\x22\x19\xf8\xc2\x28\xd0\x29\x34\x00\xb3\x82\x77\x3b\x99\x48\x56\x3c\xf2\xe4\x90\x6d\xef\xc8\x02\x7d\xdc\x9d\xa5\xc5\xb1\x23\x53\x55\x97\xcb\x9a\x36\x8d\x4d\xe9\x27\x37\x73\x43\x8b\x79\xac\xd1\xf0\xbb\x72\x8e\x87\x85\xaa\xd6\x06\xc5\xc4\xeb\xd3\xf3\xb7\x04\x64\xc3\x8c\x56\xac\xb4\xcf\x26\xe0\x63\xe7\xed\xbb\xb3\xb3\xd3\xf3\x8b\xb7\x1f\x20\xef\x44\xdb\x97\xb8\xbb\x4b\xa2\xdb\x8c\xad\x5e\xd7\x74\x4e\x0b\x96\xa5\x79\x13\x91\x0c\x85\xc3\x3f\x7e\xf9\xf9\x47\xc6\x2a\x35\xc2\xed\x29\x78\x56\xd6\x8d\x21\x60\x4c\xeb\x5d\xd6\xe1\x95\x23\x65\xbd\x7c\x07\x5a\x9b\x52\x2a\xe3\xbc\x9c\xa5\x1c\x2e\xa8\x5d\x16\x33\xe3\xe6\xcc\x7f\xda\x45\x7a\x32\x1d\x14\x90\xca\xda\xf3\xc9\x44\xd4\xde\x7e\x7b\x7c\x14\xd0\x41\xab\xd2\xb3\xf0\x77\x6f\xd0\x86\xac\x7a\x56\x97\x4d\x73\x5a\x67\x4b\x98\xb0\xfd\xa7\x6f\xc9\xbc\x74\xbd\xe1\xb6\xde\x8f\x17\x17\x67\x81\x83\xdc\x24\xd2\xcf\x8d\x1e\x0b\x49\x1b\x85\x03\xca\xfa\x49\x2b\xf7\x3f\x79\x27\x82\xcb\x56\x3f\x70\x3b\x77\x9e\xb2\xb4\x83\xb7\x5a\x6d\x8c\x67\x0e\xb3\x42\xbb\x83\x49\xa2\x7c\x55\xce\xdd\xdd\x16\x32\x71\x45\xd3\x39\xad\x9b\x21\xba\xb8\xe2\xe8\x75\x59\x30\x5a\xb0\xfd\x8b\xfb\x8a\x1a\x9b\x09\x3d\x3b\x43\x8c\x0a\x70\x43\xb8\x41\xfc\xbf\xbf\x3d\x7d\x13\xaa\x97\x97\x31\xa3\x30\x0d\x68\xf6\xce\x66\x13\xd9\x86\x32\x0b\xd3\x84\x44\x69\x55\xe5\x19\xb2\xf5\xe8\x6e\xff\xf6\xf6\x76\x9f\x53\x6a\x7f\x53\xe7\x68\x3b\xcf\x3d\x7b\x23\xdd\xae\xe0\x38\xf7\xe9\x85\x8f\x0d\x4e\xb9\x6e\x2f\x18\x73\xad\x89\xf7\xd2\xc1\x1b\xd4\xc1\x28\x72\xbc\x71\x21\x0a\x73\xc0\x6d\xe4\xa9\xde\x64\x8e\x50\x34\xf0\x9f\x6e\x6a\x11\x9d\xb7\x71\x88\x8e\x8c\x6c\x71\x1f\x22\xbc\xbb\xf4\x61\xa8\x2d\x30\x3d\x3a\xc3\xb5\x5d\x24\x81\x74\x80\xc9\xe7\x50\x72\x8c\x5b\xdd\x54\x02\xa3\x77\xa0\xc8\xb4\x30\x9d\x23\x56\xbc\x04\x15\xc5\x3f\x85\xa2\x3a\x65\xfb\xd1\x0f\x75\xf4\xbf\x37\x65\x81\x58\x05\x43\xc9\xba\xcf\xdc\x32\xa8\x20\xe9\x2e\xe3\x52\xef\x82\xe7\xac\xf6\x3c\xf3\xc8\x84\xdd\xae\x1b\x39\xf0\x5c\xd4\x3b\x57\xae\x6d\xc8\xe6\x49\x1e\x1d\x50\xad\xbe\xb7\x7a\xe0\x89\x1b\x9b\x9e\xb4\x80\x65\x27\xc8\x2f\x96\x51\x8c\xc1\xd5\x30\x5c\xd1\x68\x58\x3d\x5c\xc3\xb9\x0f\xb0\x15\x3b\x7e\x3f\x7d\x7c\xff\x30\x18\x0d\xcc\x4d\xfa\x14\x4e\x2f\x79\x88\xa6\xd1\x98\x8c\x2e\x61\xbb\x76\x42\xa2\x07\xfe\xf6\x04\x6f\x4f\xae\x8f\x02\x6b\xd8\xdd\xe5\x85\xa7\xf0\x32\x3d\xbc\xbc\xc4\x75\x16\x8e\x51\x48\x3d\xe0\x1d\x7c\xa6\x6c\x08\x39\x27\x77\x89\x90\xea\x77\xb2\x1d\xc0\xd5\xd0\x0a\x20\xa8\x0a\x06\x86\x3c\x45\x22\x4a\xd4\x81\x12\x76\xbe\xd7\xf2\x3b\xcf\xff\x01\x3e\x71\x33\xf3\x97\x94\xad\x86\x75\x5a\xcc\xcb\x75\x3c\x68\x57\xfc\xbf\xfd\xcb\x40\x86\xc9\xbe\x1c\x24\x44\x63\x47\x65\xa5\x70\x83\xbc\xce\x2a\x76\x64\xdb\xc7\xbd\x6d\x98\x9e\xf6\xcb\x56\xdb\xc5\x63\xb7\xf0\x6e\x06\xa4\x9f\x77\xf8\x2c\xc9\x56\x23\xe6\x33\x0c\x98\x6e\xe3\xc5\x33\xc0\xf0\x98\x00\x28\xb4\xbb\x2b\x4e\xb7\x98\xca\x5e\xbc\x0c\x55\x23\x16\xaf\xec\xec\x7e\x61\xa1\xdc\x83\x9c\x24\xc3\x9a\xae\xcb\x1b\xfa\x7a\x95\xe5\xf3\x18\xbb\xb0\x77\x34\x5c\xd0\xba\xe2\x80\xb7\x5b\x54\xb6\x2d\x64\x63\x6f\x31\x0d\x1c\x5b\xed\x69\x7f\x68\xc2\xf3\x1d\xf0\xfd\x29\xf6\x9b\x53\x91\xe0\xe5\xf8\x81\x77\xfe\x98\x44\x50\x2a\x72\xe2\x40\x03\x75\x88\x05\x45\x3e\x68\x79\xcb\x64\x2b\x3f\xcf\xac\x6b\x28\xbb\xc0\xd4\xd8\x68\x43\x62\x9b\x78\xdd\xce\x7c\xec\xef\x0e\xdf\x32\x66\xb0\x43\x27\xf0\xeb\xb0\xa9\x67\x9a\x58\x91\x36\x83\x37\xab\x18\xd7\x11\x9f\x67\x46\x1f\xd3\x9b\x54\x00\xf6\x66\x4e\x9b\xfb\x62\xe6\x55\xfe\x45\x86\x6e\xb3\x54\x65\x72\xcc\x4e\x23\x9b\x39\x0a\xd2\xaa\xa2\xc5\x3c\x30\x0a\xc2\xb6\x22\x4e\x06\x3d\x55\xc9\x2a\xac\xd1\x9b\xd6\x3f\xe1\x72\x05\x40\x3b\x72\xc5\x31\x51\xb4\x09\xa6\x87\xe6\xfb\x1d\x5d\x94\x35\xed\x83\xf0\x15\xe6\xec\xb2\x41\xe4\xc2\x92\xd2\x2d\xa1\x48\xd0\x0a\xc1\xe4\xf6\x64\x23\xc5\xab\x7d\x54\x76\xb0\xf1\x04\x75\xca\x1b\x5a\xd7\xd9\xbc\x57\x33\x44\x91\xad\x84\xd7\x4d\xc9\xdd\x5d\x32\xfa\x2d\x3e\x7b\x77\xf1\x78\xf6\xea\xe2\xf5\x8f\x8f\xdf\x9f\xfc\x7c\x72\x71\x32\xd8\x19\x65\xa8\x1d\x98\x9d\xb5\xd5\xea\x02\x43\xe6\x1f\xfb\x1c\xf4\xfe\x2f\x50\x64\xff\x54\xb4\x20\x6a\xc7\xab\x00\xe6\xb7\x89\x24\x5b\x90\xe8\xec\xf4\xed\x45\xd4\x87\x5e\x50\x79\x1f\x0a\x21\x9a\x61\x33\x47\x34\x83\x4c\xc4\xf6\x79\x58\xda\xff\x91\xb1\x4a\x35\x70\x56\xae\xd7\x65\x61\xae\x4e\x3c\xf7\xd9\xf3\xc7\x56\xb9\x4d\xc3\xca\x35\x19\x93\x87\x27\xb3\xb0\x9e\x6b\x2a\x8f\x91\xb1\x08\x75\xa9\x4a\x38\x1b\x14\x45\x49\x8f\x07\x9f\x0b\x5f\x6f\xb0\x8d\xdd\x67\xab\xb4\x89\x21\xb3\x3f\xac\xcc\xed\x60\xcd\x55\xde\xe9\x99\x0e\x74\x97\x70\x0d\xf5\x56\x2b\xef\x56\xbf\x9b\x43\x7a\xa5\x1c\xd2\x96\xbb\xca\xef\x90\xa6\x37\xb4\xf0\x9b\x74\x44\xf9\x99\xd0\x1e\xd0\xe6\x0a\x5d\x57\xf0\x96\x8b\x64\x21\x70\x9e\x71\x9c\x8e\xf9\x7f\xe5\xb2\x25\x63\xe3\x55\x78\x70\x83\x86\x94\x54\x44\x78\x19\xa9\x91\x4d\x26\xe4\xf0\xe5\xcb\x6f\xc9\x31\x79\x79\xf0\xef\x44\x4f\x4b\xc8\x68\x44\x7e\x3a\xf9\xab\x54\xde\xae\x36\xcb\x2d\x90\x79\xfd\x21\xe8\xd1\x9b\x92\x08\xe3\x35\x22\x63\x3c\x7e\xaa\xcd\xc9\x4b\x0e\xfc\x26\x5d\x68\x5f\x14\xf0\xb8\x02\xb3\xa4\xec\x55\x9e\x9f\x0b\x42\xe0\xb0\x6f\xe2\xc1\x40\x84\xa0\x45\xef\x8b\xc8\xb0\xc0\xeb\xf2\xb6\x97\xc9\x29\x99\x1b\x27\x46\x5e\x0c\xcf\x6a\x8b\x0f\x12\xc2\x5f\x94\x7d\x3d\x8e\x06\x03\xfc\x84\xe9\x76\x22\xd9\x23\x87\xc1\x88\xd7\x50\x1b\xb5\xcb\x4a\xd0\x07\xf1\xb5\x7c\xf1\xab\x2e\x5f\xfc\xaa\xa7\x2f\x5e\x9d\xc6\xf1\x39\xaa\x1b\xaf\xe5\xf3\x3c\xf2\x7a\x05\x8a\xf3\xef\x71\x51\x2f\xd2\x3f\xc8\x41\x13\xaa\xde\x28\x6c\x8f\x4a\xfc\xdc\x1b\x11\xcb\xd9\x2d\x5c\xbf\x42\xf4\xab\xcf\x21\x4c\xec\xd2\x3e\xa5\xcf\xb7\x98\xe9\x99\x5d\xfa\x4a\xea\xe8\x1f\xfb\x42\xa4\xd1\xf9\x3e\x97\x40\x51\x42\x22\x53\xd6\x6d\x39\xc3\x7f\x34\x22\x73\x5a\xd5\x74\x96\x32\x3a\x27\x9b\x86\x92\x79\x79\x0b\x2a\xe9\x99\x58\xa0\x70\x10\xf6\x68\x4b\x72\x31\xc3\x70\xaa\x2a\xfd\x6f\x42\xa2\xff\x38\xb9\xf0\x9a\x95\xc0\xc3\xf3\xf9\x09\x17\xbc\x3f\x67\x0d\xa3\x05\xad\xe3\x48\x82\xd3\x54\x0a\x55\xc3\xa7\x04\xb5\xc9\xc2\x76\x93\xbc\x33\x61\x6f\x54\x1c\x68\x9f\x48\xe0\x4d\xf5\x45\xe4\xe5\xea\xdc\xe9\xdb\x8b\xc7\xb3\x77\x17\xbd\xd5\x38\xd9\x3e\xac\xfa\x0f\xa0\xb8\xd9\x46\xc0\xbb\xad\xff\x6b\x60\x67\x55\xd0\x89\xa3\x3d\x72\x02\xdb\x72\xfc\x2a\x95\x44\xae\xa1\x4c\x8c\x28\x9c\x97\xba\xb4\x24\x5b\x9a\x82\x30\xee\x5e\x7e\x5c\x6d\x5f\x7e\x5c\x6d\x5f\x7e\x5c\xf5\x59\x7e\x5c\x7d\xd1\x8a\xa2\xa1\xda\xbd\x29\xe7\x34\xa8\xd7\x15\xe5\x9c\x7a\x14\x3b\x43\x21\x57\xa6\x1f\xcf\x90\xd5\x34\x8e\x96\x25\x8b\x3c\x31\x6f\x9f\xa5\xff\x61\xec\x57\xb7\x51\xcf\xf3\x09\xaf\x8b\x43\x10\x37\xa7\x32\x5e\xcc\x01\xe7\x66\x6c\x2d\x0d\x6e\x62\xf8\xad\xf6\x2f\xe6\x4b\x69\x56\x48\x3f\x8c\xef\x50\x11\x9b\x17\x91\xe2\x18\x98\xfd\xc0\x1b\x3e\x26\xe8\xe1\xc2\x96\x8c\x89\x54\x14\x04\xf0\xb1\x7c\x78\x12\x77\x76\xf9\xbd\xa0\x4d\x15\x5c\x0a\xd9\xa2\x33\xf3\xe7\xa1\x70\xb2\x79\xf5\x27\xa9\xf0\x42\x46\x7c\x79\x5d\xce\xa9\xff\x0e\x12\x5d\x87\x05\xbd\x52\x2b\xf5\x0b\x6d\x9a\x74\x49\x5d\x15\xd5\xab\xba\x81\x6a\x0a\xa5\x3f\xcd\x06\x6b\x7b\xd7\xd2\x3e\xbb\x4d\xab\x20\x1e\x1e\xf5\xd1\xec\x61\x1d\x2f\x10\x0f\x18\xc1\x66\x45\xad\xa9\xa4\x61\x0b\xc8\xbc\x6c\x32\x38\xe4\xbf\x4b\x1b\x2a\xf8\x26\x34\xd6\xe5\x38\xf7\xdd\x3c\x20\xd6\xe7\x7f\x44\x3c\x20\x50\x98\xcb\x1f\x21\x4d\x71\xef\xb7\x9e\x68\x6f\x00\x7f\xae\x56\x17\xd5\xad\x01\xee\xf9\xbb\xe2\x20\x77\x73\x55\x49\x9b\x07\x2c\x4c\x7d\x96\x26\x69\x2f\xf2\xd1\xd0\xd5\xee\xf0\xf1\x72\x77\x3b\x1c\xf4\x42\x55\x59\x05\x77\x81\x07\xa2\xee\xfb\xd8\x9c\xf1\x4d\x99\xcd\xc9\xc1\x00\xc3\x0d\xb5\x0f\x61\xd3\x43\x16\x35\x82\x17\xd5\xa6\x7d\x41\x06\x8b\xb5\x35\xee\x11\xf5\xdc\xa4\x39\xe7\x16\xae\x11\x17\xb0\xbc\xe6\xad\xce\x5a\x0a\x16\x6c\xd6\x6d\xa2\xf5\x92\xee\xfa\xdd\x22\x41\x58\xa2\xc1\xaa\x0f\x3a\x77\x5c\x74\x01\xb1\x28\x67\xac\x03\x27\x81\x45\xe5\x6d\xf0\x48\x20\x8c\x54\xeb\x09\x09\x0c\xac\x0e\xb9\xec\xb8\x0d\xe6\x53\xe0\xee\x44\x27\x5f\xd7\xc9\x24\x04\x38\xdf\x68\xa5\x7b\x33\x63\xb0\xf4\x93\x7d\x63\x83\x93\xa1\x8b\x5b\x34\xd5\xb1\x17\xbf\xb4\xfd\xbb\x29\xf0\x82\xac\x0e\xbb\x3a\x50\x7b\xe7\x72\x32\x9c\x2a\x1e\x7f\xf3\x53\x71\x93\xe6\xd9\x9c\x64\xad\xd7\x91\x94\x0b\x5c\xd4\xfb\x86\xec\xa9\x33\x26\x14\x4f\xed\x91\x6f\x12\xb2\xde\x34\x8c\x5c\x51\x92\x2a\x36\xf9\xc6\x7f\x20\x83\xff\x4d\xc3\x15\xe5\xd4\x70\x63\x45\xd4\x07\x58\xd8\x90\x3d\x9b\x66\xa5\xf2\xf9\x77\x1a\x08\x4e\xd4\x5d\xed\x6e\xa4\xb2\x12\xd1\xfd\xb4\x37\xcd\x93\xff\xf8\xa8\x85\x78\xa1\xb7\x4b\x4c\x10\x63\x4d\x2b\xf4\x68\x79\x33\x53\x38\x07\x75\xd0\x8b\x8b\x33\xe1\x15\xf6\x1d\xf4\xff\xa3\xd2\xbd\x54\x6b\xa4\x2f\x49\xcc\xc5\x3a\xf9\xf0\x26\xcc\xac\x81\x0d\x5f\xe2\xe6\x23\x95\x08\x9b\x3a\xd6\x69\x25\x0e\x58\x54\xdf\xfb\xf9\x66\xf5\xcb\x18\x76\x0e\xa5\xfb\xc9\xd0\x01\x9c\x6b\x8b\x64\xb3\xd4\xe9\xd2\xc3\x55\x6a\x34\x85\xbf\xc6\xb6\xe2\x21\x2a\x5a\x52\xf6\x26\x5d\xd3\x58\xa2\x2d\x31\xb1\x36\xea\x06\xab\x5a\x52\xc3\xdb\xc4\x5f\x55\x55\x06\xc1\xf2\xac\x61\x82\x5c\xbc\x9e\x69\xa0\x62\xcf\xb1\x63\x50\xf2\x18\x7e\x70\x07\xcb\x40\x9c\x8f\xd1\x03\xb7\x57\xe6\x4d\x3f\xe2\x4b\x88\x18\x5b\x91\xe3\x7c\x2a\x4f\xae\x0c\xd7\xdb\x98\x34\x69\x24\x4d\xb4\x9d\x4a\x36\xb7\x4c\x8b\xb2\x5e\x43\xa4\x38\xd4\x1b\xea\x15\x3e\x99\x22\x22\x13\x32\x05\x65\x15\x21\x6e\x45\x09\x19\xc9\xb8\xb6\x1a\xbf\xd8\x88\x7d\x69\x9f\x71\x11\xcd\x8b\xd9\xe2\x06\x7a\x0f\xcf\x02\x68\xd1\x76\x83\xef\xcc\x42\x78\xd9\x7b\x50\x09\x7e\xda\xd2\x68\x11\x16\x30\xd1\xcf\x01\xe6\x5f\x76\x0e\x5d\x0e\x10\x79\x7b\x34\xb5\x4f\x9d\x16\xdb\xb5\x1f\x63\x5f\xe7\x6b\x67\xb1\x86\x01\x0b\xdd\x44\x07\x2b\x3f\xa9\x6b\x5d\xf0\x4e\x98\x57\xb5\x73\xff\x85\x4f\x62\x19\x82\xa9\x6d\x66\x2b\x99\x78\x97\xf9\x0d\x16\x28\x82\xc9\xee\x41\x25\x4a\x32\x3b\x97\xb9\xbc\xaa\x97\x6a\x03\x04\xf6\x28\xe2\x14\x32\x2b\xec\x08\x61\xd9\x27\xda\x80\x70\x86\xb0\x7e\x20\xc9\x3a\xad\x06\xc3\x9a\xce\x37\x33\xaa\x6f\x39\xaf\xe9\x4d\x42\x66\x9b\xda\x33\x25\xa2\x14\x10\x4b\x6e\x58\xc3\x64\x32\x51\x5f\xb0\xd0\x31\x14\x26\x63\xc2\x21\x69\x68\x27\x18\x5b\xe3\x9f\x1c\xcd\xf1\xed\x48\x48\x3e\x6c\x46\xd3\xdf\xd2\xfd\xff\x3c\xd8\xff\xeb\xfb\xfd\x3f\xed\xfc\xdb\x6e\xf4\x62\x6f\xf8\xdb\x87\xff\xf3\xf8\x7f\x2f\xa5\xcb\xce\xbb\x24\xe7\xbd\xa2\x55\xaa\x22\xb3\x55\x5a\xa7\x33\x46\x6b\x92\xa9\xa5\xce\x45\x46\xf3\x39\x90\x2f\x70\x93\x86\xda\x65\x9b\xad\xb1\xca\xce\x49\x55\xae\xbd\x78\x66\xd5\xf3\x56\x23\x56\x74\x90\xdf\x44\x90\x8c\xe7\x4e\x1a\xe9\x82\x59\x0c\x8d\x9d\x79\xa6\x8b\x04\xae\xdd\xc1\x37\x33\x8b\x8a\x38\xe2\x39\xf0\xc5\x97\xe1\x02\x8d\x94\x36\xd3\x05\xda\x28\xe6\xd8\x44\x54\x0c\x34\xe0\x7b\x79\x0d\xb1\x79\x50\xd3\x7f\x83\x68\x29\xb2\xbb\x2b\x3f\xfc\x8d\x7c\xab\x07\x3b\xa1\x0c\x93\x58\x89\x87\xc7\x47\xe2\xcf\x22\xf0\xd2\x5e\x44\xa8\xa7\x91\xb7\xa5\x03\xef\x77\x5b\x53\xb1\x32\x0b\x57\x15\xff\x09\xed\x88\x57\xe1\xd3\xae\xf4\x55\xd1\xad\x0e\x04\x23\x5c\x1a\x2e\xae\xd8\x02\x06\xae\xae\x70\xc0\xb4\xc8\xf0\x74\x5e\x55\xec\x8d\xe6\xf6\x62\x64\x94\x08\x2e\x3c\x1b\xd2\x44\xf1\x6b\x2b\x5a\xaf\x10\xb1\x36\xf8\x83\xbf\xc7\x1e\xe1\x02\x16\x8f\xd1\x1e\x4b\x54\x79\x80\x0b\x37\x83\x76\x10\xc4\x1d\xeb\x01\xfc\xc2\xbd\xd4\xcb\x03\x1c\x42\xb4\x27\x76\xc8\x76\x27\xf0\x60\x54\xb2\xcc\xea\x84\xf0\x3a\x02\x59\xc8\x58\x3c\xdc\x45\x5e\x7d\x10\xbb\xe8\x25\x24\x9a\xa7\x2c\x8d\x12\x9d\x25\x96\x94\x8d\x0d\x91\xee\x04\x9b\xe9\xca\x18\x32\x8b\xea\xc9\xa4\x85\xd3\x18\x70\xb8\x92\x80\x11\xe4\x1e\x0d\xc2\x18\x01\x2d\x57\x60\x9b\x9c\x7d\x28\x26\x53\x7d\xa5\xb8\x82\x1a\x65\x2f\x0e\xd8\x1f\xb2\x9c\x9e\xe3\x92\x80\x6d\x25\x63\xbe\x21\xff\x79\xd5\x84\x58\x5b\x64\x52\xeb\x03\xdd\x71\x7b\xad\x37\x50\x80\xde\x12\x19\x18\xba\xf7\xc3\x1e\xa0\x2e\x65\x20\x94\xcc\x0c\x3f\xe7\x1c\xa4\x87\x9c\x7b\xb2\x88\xa8\x74\x3c\x02\x77\xcb\x54\x83\x36\xa3\x6f\xa6\x81\x14\x73\xa2\x41\x4b\xd4\xdc\xa3\xeb\x15\x8d\xa6\x0b\x10\x77\xe5\xcb\x13\x0a\x0c\x1b\x4e\x5e\x7d\x00\xe1\x5b\xfa\x56\x5e\x93\xea\xd2\x2f\x2e\xaf\x6c\xd2\x8e\x0c\x17\xab\x01\x5c\xa8\xc3\x02\xa7\x41\x75\x43\x38\x3f\x8f\x0d\x51\xaf\x5d\xb7\x23\xe4\xbd\x47\x13\x08\x4e\x0d\x7a\x52\x50\x81\x3e\x6f\x97\xf1\x5a\x03\x0a\xf7\x48\xe9\xe3\x95\x7f\xf1\x89\x98\x77\xb5\x71\x65\x5f\x27\xd4\xef\x90\xf0\x3a\x58\xf8\xe4\x83\x6b\xc9\x81\x30\x5c\x6d\x89\xc0\xbc\x50\xbf\xfd\x2c\xb4\x0e\xff\xbe\x6d\x6d\x7c\x5b\x5a\x8a\xe8\x77\xed\xa0\x17\x71\xfa\x0c\x9e\x14\x03\x18\xca\xb5\x22\x15\xe7\xeb\x39\x48\x41\x3f\x11\xa7\xa1\xc5\x3c\x2b\x96\xa4\xa0\xec\xb6\xac\xaf\xa5\x43\xc4\xe7\x9d\x78\x7d\xfa\xcb\x2f\xa7\x6f\x3e\xfc\x78\xf2\xea\xfb\x13\xd8\x70\xf7\x10\xbd\x9a\xcd\x68\xc5\xa2\x31\x71\x36\x78\x24\x30\xc3\x8c\x60\xc3\x74\x42\x5e\x8c\x5e\x44\xda\xf5\x85\x5c\xb6\x7f\x78\x7d\xfa\xe6\xe2\xe4\xcd\xc5\x87\x8b\xff\x7d\x76\x02\xc0\x8c\xcd\x25\x1e\x90\x47\x70\xeb\x19\x65\x93\x0d\x5b\xec\xff\xd7\xc8\xd1\xcb\x7f\x64\xac\x0a\x8d\xb3\xc0\xb1\x3d\xca\x0f\x24\x9c\xeb\xda\x41\x30\x9a\x49\x26\x2e\x6d\x71\xe9\x6e\x1d\xa7\x03\x11\x73\xf2\x5c\x25\x0d\x02\x7c\xd7\xdc\x71\xbf\xf3\x99\x3a\x76\x75\x5f\xfd\x58\x9d\x99\xf2\xf0\x79\xbc\x75\x16\x68\xdf\xc1\x39\xc2\x59\x86\x1c\xee\x88\xc7\x81\x7d\xfb\x67\xef\x2d\x50\xe5\x35\x39\x26\x5a\x70\x9a\x73\xe5\xb0\x77\x29\xea\x29\xe4\x19\x77\xc8\xa3\x40\x6b\x52\x10\x2c\x1d\x1f\x91\xf0\x4a\xc5\x80\x8b\xd7\x59\xe7\xd7\xef\x13\xf5\xe3\xab\x13\x54\x03\xa1\xf3\x9c\x3e\x4f\xe8\xd1\x9b\xc6\xb1\x42\xd5\x86\x8d\xdd\x01\xa8\x9d\x28\x04\x27\x75\x74\x65\x48\xd9\x6c\xd5\x99\x03\xfd\x0c\x9d\x59\x30\x5a\x75\x6c\xc9\x14\x2d\x1d\xa2\x52\x9d\x73\x8d\x6c\xd6\xe6\x2d\xc3\x88\xe6\xb1\x88\x95\x76\x97\x83\x21\x36\x7b\x8c\x3f\xf8\x86\x2f\x09\xec\xef\x1c\x13\x3c\x02\x0a\x49\x25\xd7\x8c\x13\xd8\x06\x3c\x86\xff\x42\x6e\x39\x83\x18\x4e\x3a\xc2\x4a\xa3\x84\x44\x58\x1d\x7f\xc2\x7d\x3c\xe2\x81\xff\xf2\x1a\xf8\x2f\xc2\xe6\x4f\x1c\xae\x3a\x0b\x69\x1a\x2d\x29\xe3\x5f\x91\x6e\x32\x67\x0b\xea\xd2\x23\x2f\xb0\x3a\x5b\xcc\x71\x24\xa7\x2a\xe9\xd2\x3e\xd2\x26\x34\xe5\x10\x73\x9a\x8b\xb7\x4c\x34\x00\xc9\x24\xf3\xce\xce\xe1\x93\xef\x74\x20\x35\xfc\xa7\x11\x67\x2c\xde\xa6\x6a\x83\x3f\x9c\x8d\xbe\x66\xd3\xba\xe6\xd4\xaf\xd2\x40\xac\x02\xa3\x0e\xba\x1b\x1b\x98\x6b\x81\x7b\xd2\x19\xe3\xf3\xed\x2d\xd7\x03\xce\x4f\xde\x5e\x2c\x36\x39\x69\x30\x93\x3d\xe9\xea\xde\x0a\xb8\x07\xc8\xbc\x4e\x24\x9d\xb9\xc7\xb8\xf5\x98\xf3\x6a\x79\xe5\xac\xad\x55\xce\xa4\x10\x69\x43\xcc\x0d\x02\x4a\x2c\x86\xb2\x62\x23\x55\x7c\x54\xdf\x9c\x80\x70\x85\x6e\xdb\x6f\xf8\xa9\x75\xa2\x79\x00\xb6\x67\xd9\xb9\xa7\xe1\x69\xa1\xf0\xe2\xa4\xbe\x27\x53\x1f\x46\x10\xae\x71\x23\x6e\x55\x92\xd1\x27\xdb\x0c\x17\x3c\x95\x1f\x27\xfb\x15\x63\xb0\xbd\x8f\xb3\xe3\x00\x2e\x48\x54\xad\x50\x37\x8c\x79\xb6\x58\xa9\x47\xcf\x74\x28\xf1\xf1\xdb\x3b\x76\x15\xee\x71\x84\xc6\xc1\x7f\x1a\x4d\x44\xeb\x13\xa2\x59\x11\x89\xed\xf7\x68\x6e\x33\xd8\x02\x0b\xd7\xa0\x05\x22\x06\x66\x69\x43\xc9\xcb\xb1\xbb\x1c\xa9\x00\xf3\xd2\xd3\x03\xcf\x4e\x37\x61\xda\x40\xfa\xa1\xef\xe4\xea\xab\x9a\xa6\xf6\xf6\x2b\xa8\xef\xd0\x53\x1f\xb8\x27\xdb\x28\x42\xdc\x19\xd2\xc6\x12\x3a\x06\x4e\x70\x45\x56\x47\xcb\x87\x76\xe7\x3a\xeb\xf6\x56\x7b\x56\x6e\x83\xed\x3c\xf0\xb4\xd3\x97\x59\xa8\xa1\x9e\xdc\xe8\x7a\x8d\x4e\xee\x2a\xbc\xf6\x7d\x53\x11\x56\x92\x97\x2d\x3f\x92\xa9\x14\x19\xbc\xdd\x97\x09\x59\x96\x0c\x6e\x9a\xd6\x3a\x9d\xec\x91\xa8\x2d\xe1\x6e\x6e\x21\xc6\x09\x93\x7e\x5f\x86\x73\x27\x95\xc9\x8f\x9e\x2b\xab\xdc\xdb\x8c\xd4\xc9\xba\x32\xaf\x31\x2a\x6c\x11\x04\x9a\x8d\xe9\xd3\x79\x90\x72\x1b\x2c\x5b\x4d\x84\x35\xe9\x0d\xd5\x93\x61\x13\x8f\x96\x2e\x8e\x39\x0c\x96\xdf\x54\x73\x38\x18\x51\x83\xf0\xce\xc8\x80\x7b\x38\xf5\x0c\xb8\x67\x49\xcf\x23\x55\x23\x37\xcf\xb3\x90\xb5\xf6\x13\x57\x36\xf3\x9c\x54\xf9\x66\x99\x15\xa1\x29\x02\x53\xe3\x5f\x37\xae\x43\x5f\x14\xcc\x10\x4e\xe8\x96\x6b\x6f\x8f\xbf\x63\x59\x0e\x30\xb5\x1e\xfa\x75\x43\x85\x47\xfa\x9d\xee\x91\xe6\x9f\x41\x38\xa2\x01\x62\x26\x68\x73\xce\xb9\x21\xed\x64\x86\x33\xcf\x6d\xbb\x5a\x9d\x3e\x57\x5f\x46\x1b\x8e\x9a\xee\xe7\xb3\xe4\xd6\x0e\x4c\x19\xee\x20\xde\xee\xfb\xf3\x73\x63\x2c\x9a\x8e\x0b\x46\xf8\x5f\x99\x7f\x3c\x61\x7b\xc0\x44\x62\x61\xc8\x29\xf6\xd5\x51\xe4\x40\xfd\x38\xf2\x94\x4f\x47\x52\xf6\xde\x57\x41\x54\x67\x07\xbc\x11\x58\xf3\xd6\x7c\x02\x52\xe2\x7e\xe4\x2f\xc3\xa9\x6b\x41\xd2\x83\xbb\x16\xae\x78\x47\x67\x1b\x3c\x14\x5c\x77\xd8\x68\xac\xac\xb2\xe8\xcb\x8b\xdb\x03\x6a\x42\x76\x9e\x76\x39\x0c\xee\xd0\xf6\x5d\x88\x8d\x29\xc3\x5f\xcd\xc5\xfd\xf6\x2b\x98\xec\x28\x0e\x4c\xe8\xf2\x7c\x4e\x48\x3a\x7a\xf6\xec\x69\x30\x18\x1c\xfd\xbf\x00\x00\x00\xff\xff\xe4\x09\xf2\xfa\x27\x9f\x00\x00")
-
-func assetsJsVueResourceJsBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsJsVueResourceJs,
-		"assets/js/vue-resource.js",
-	)
-}
-
-func assetsJsVueResourceJs() (*asset, error) {
-	bytes, err := assetsJsVueResourceJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/js/vue-resource.js", size: 40743, mode: os.FileMode(420), modTime: time.Unix(1535148524, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsJsVueJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\xbd\x6d\x7b\x1b\x37\xb2\x20\xfa\x79\xf5\x2b\x60\x8d\xaf\xd9\x8c\x29\xd2\xce\x9e\x99\x73\x8e\x14\xc5\x8f\xc7\x2f\x27\x9e\x89\x63\xaf\xe5\x38\xe7\xac\xa2\xd1\x82\xdd\x20\x89\xa8\xd9\xcd\x69\x74\x8b\xe6\x44\xba\xbf\xfd\x3e\xa8\x2a\x00\x05\x74\x53\x72\xb2\x73\xf7\xd9\x7c\x88\x45\x34\xde\x51\x28\xd4\x7b\xcd\xbe\x7a\x70\x20\xbe\x12\x9f\x3a\x35\xfd\xc5\x88\xeb\xaf\xa7\x7f\x9c\x3e\xfd\x57\x5b\x92\xe5\x63\xf1\xf5\x93\xa7\xff\x72\xf4\xf5\x93\xa7\xff\x26\x5e\x5d\xcb\x4a\xfc\x57\xdd\xd9\x2f\x1f\x54\xa9\xa4\x51\x85\xe8\xaa\x42\x35\xa2\x5d\x29\xf1\xf6\xcd\x47\xf1\xbd\xce\x55\x65\xd4\xf4\x40\x7c\x35\x3b\xc8\x16\x5d\x95\xb7\xba\xae\x44\xb6\x2c\xeb\xb9\x2c\x27\x62\x21\xf3\xb6\x6e\x76\x63\xf1\xeb\xc1\x7f\x6b\x77\x1b\x55\x2f\x84\xfa\xbc\xa9\x9b\xd6\x88\xd3\xd3\x53\x31\xaa\xe7\xbf\xa8\xbc\x1d\x89\x47\x8f\x04\x7d\x5e\xd7\x45\x57\x2a\xf1\xc0\x7e\xb5\x63\x2d\x74\xa5\x8a\x91\x78\x46\x1f\xa6\xbe\xb9\xeb\x3b\x1b\x8b\x63\xdf\x39\xd6\xc7\xbe\xdd\x6c\xa0\x77\xfc\x30\x95\xeb\x42\x3c\xa3\x1f\x99\x9f\xdc\xf1\xc1\x7f\xa3\x19\x4f\x3f\x75\x8a\x77\x3d\x3e\x39\xb8\xcd\xda\x95\x36\x13\xc1\x56\x37\x16\xbf\x8a\x51\x67\x94\x30\x6d\xa3\xf3\x76\x74\x72\x70\x30\xfb\x4a\xd8\x2d\x38\xb8\x96\x8d\x50\xeb\x4d\xbb\x7b\x07\x2b\x13\xa7\x02\xff\x98\x2e\x1a\xa5\xfe\xa1\xb2\x5f\x6f\xc7\xb6\xf6\xcc\xee\xa0\x51\x62\xa5\xca\x8d\x6a\x8c\xd8\x34\x75\xd1\xe5\xca\x88\xb9\x6a\x5b\xd5\x88\xeb\xb5\xc8\xeb\x42\x09\x5d\x89\xbf\x9c\x09\x55\x2d\x75\xa5\x8c\x28\x3a\x25\xda\xda\x36\xd5\x8d\xed\x43\x7d\xde\x94\x3a\xd7\x6d\xa5\x8c\x11\xb2\x2a\x84\x9f\xa2\xae\x4a\x5d\xe9\x6a\x79\x10\x4a\xcc\x8f\x76\x37\x45\x76\x6d\x0f\x43\x88\x46\xb5\x5d\x53\x89\x6b\xd8\x2b\xbf\xd1\xe2\xe6\x86\x8a\xaa\xae\x2c\x0f\x6e\x0f\x78\x07\x2f\x87\x9a\x3f\x88\x9a\x3f\x7a\x44\x45\x03\xcd\x3f\x36\x9d\x1a\x1e\xbe\x6d\x3a\x95\x54\x7e\x2d\x4b\xb3\xa7\xf6\xc2\x7e\xb2\xd5\x67\x5f\x7d\x65\x41\xf3\xc5\x4a\xe5\x57\x42\x2f\xc4\xb5\x2c\x3b\x25\xb4\xdd\x4d\xbd\xd6\xad\xbe\x56\x00\x96\xac\xd7\xf7\xee\x83\xc8\xa0\x72\xd4\x7b\x76\x20\x84\x70\x60\x88\x7d\x01\x1c\xd9\x43\xae\x96\x23\x71\x73\xb3\xa7\x42\xd5\xad\xe7\xaa\xf1\x15\x66\x33\xf1\x70\x51\xd6\xdb\xa3\x42\x1b\x39\x2f\xd5\x51\xa9\x2b\xb5\xaf\xef\xdd\x7a\x5e\x97\x77\xf4\x3d\xaf\xeb\x52\xc9\x6a\x74\x20\xc4\x98\xad\xf9\x7f\x74\x3a\xbf\x12\x78\x7b\x44\x0e\x1b\x70\x24\x2c\x9c\xba\xe5\xcb\x46\x97\x3b\xd1\xd9\x1b\x6b\x21\x46\x95\xa5\x6d\x86\xb0\x68\xc4\xa2\xa9\xd7\x61\x97\x70\x3c\x23\xb6\x2b\x55\x89\xad\x12\x57\x55\xbd\x85\x1b\x0e\xe5\xb6\x9d\x36\x42\x8a\xbf\x9c\xbd\xfb\xe1\x28\xaf\xd7\x9b\x52\xcb\xaa\x85\xb9\x4e\xd3\x0d\x26\xa8\xcf\xea\xf9\x2f\xd1\xde\xd6\xf3\x5f\x3c\x58\xb0\xcb\x6e\x8b\x39\x1e\x60\x2b\xfc\x0f\xd5\xc2\x1c\x1a\xb9\x85\xda\x02\x8f\x41\xd4\x0b\x21\x69\x83\xd4\x74\x39\x15\xe7\xb4\x09\x38\xf2\x05\x4c\xc8\x5e\xc2\xcb\xb6\x3e\xc3\x16\xfe\x0a\x6e\x9a\xba\xad\x61\xda\xee\xdb\x09\x83\xb9\xb6\xfe\x20\xb7\x1f\xed\x48\x03\xb0\xe1\x7b\x9b\xe6\xb2\x2c\xa9\xc2\xd4\x94\x3a\x57\xd9\xbf\x4d\xc4\xd1\x53\x7e\x38\x67\x80\x15\xdc\xe9\xc0\xe4\xe1\x88\xa6\xe2\x5d\x55\xee\xa8\x4b\x83\x50\x2f\xbe\x12\x8b\xba\x11\x9b\x52\xda\xab\x2e\xaf\xe5\x59\xde\xe8\x8d\x6b\x6c\x7a\x1b\xfc\xde\x56\xdc\xb7\xcb\xc9\x2c\xe1\x33\x6c\x6f\xb2\x49\xa3\xe4\xb2\x7d\x50\xcb\x57\x9f\x37\xe9\x6d\x4b\xd7\x9c\xf4\x85\x8d\x2e\x46\x7b\x6e\x22\xc2\xcc\xb5\x2c\x75\x21\x64\xd3\xc8\x9d\xd0\x55\xa1\x3e\xf7\x16\xf4\xc9\xd6\x78\x6e\x2b\xbc\xb1\xdf\x61\xf3\x71\x1a\xf6\x14\x2b\x71\x2a\x36\xb2\x31\xea\x75\x59\xcb\x36\xc3\xf9\x40\x95\xf1\x49\x98\x69\x25\xbe\x3d\x15\x4f\x2c\x5c\xbd\x95\xed\x6a\xba\x28\xeb\xba\xc9\x2a\x9c\x6f\x65\x8b\xb5\x79\xad\x2b\xdd\x2a\x68\xc9\x27\x5c\x57\xd7\xaa\x69\x3d\x44\xb5\xb5\x90\x0e\xd0\xda\x95\x6c\x61\x11\x79\xdb\xc9\x12\xce\xcd\x3e\x7d\xaa\x48\x96\xe0\xe1\x2c\xcc\xdc\xa1\x2b\x59\x0a\x87\x0a\xed\xed\x7e\x26\x46\x23\xf8\xe3\x98\x5d\xf4\x18\xfe\x85\xc0\x8a\xf6\xae\x4d\x71\x22\x7a\xb1\xb3\x3d\x4f\xa0\x9f\x89\xf8\x7a\x4c\x95\x8e\x05\xdb\x8d\xc1\x35\xe9\x6a\xd3\xb5\x7c\x65\x88\xa8\x10\xe0\x54\x63\xb4\x69\x55\x95\xc3\x25\x16\x6f\x16\x70\xdb\x72\x68\x6c\xec\xba\x16\x52\x97\x66\xe2\x2f\x70\xa3\x97\xba\x92\x25\xed\x4e\x6f\x0f\x7e\xc0\xae\xef\x3c\x3d\xfb\x8d\x9d\x9a\x36\x3f\xc8\x1f\xec\x31\x3d\x83\x7d\x38\x16\x15\x5b\xc5\x5b\x79\xa5\x84\x14\x6b\xb9\x81\x87\x8d\x9a\xc8\xf0\xc2\xd9\x45\xc0\xad\xb2\x5b\xaf\x2d\x52\xb8\x52\x3b\xc2\x55\xba\xc2\xd3\x5b\xcb\x4d\x32\xd1\xb5\xbc\x52\x6f\xe5\x06\x70\xbd\x69\x9b\xc9\x81\xb0\x8f\xa8\xbd\x69\xdf\xd7\x5b\xd5\xbc\x90\x46\x1d\x84\xf9\xdb\xd1\x3d\xf6\xc8\x1b\x25\x5b\x95\xd9\x53\x80\x55\xd8\x0a\xa5\x36\xf6\x89\x37\x6d\x33\x35\x9b\x52\xb7\xd9\x68\x32\x82\x8f\x76\x76\x99\xad\xa1\xc5\xa9\x78\x72\x22\xb4\xf8\x06\x2a\x4f\x4b\x55\x2d\xdb\xd5\x89\xd0\x8f\x1f\xe3\x38\xc2\x8e\x72\x6e\xbf\x9d\xeb\x8b\x0b\x81\x8f\xa1\xed\xe2\x36\xec\x54\x6f\x8a\x08\x24\x81\x20\xc1\x5d\x77\xd5\x6d\x87\xd7\xb2\x9c\xb6\xb5\x6f\x91\x8d\x2f\x4e\xa0\x47\x0b\x37\xf7\xb4\x83\x9a\x03\x97\x5a\x8a\x56\x2e\xf1\x5a\xcf\x3b\x5d\xb6\x47\x76\x9b\x25\x81\x02\xac\xd5\xfc\xd9\x96\xbf\xa9\x3e\x4a\x8b\x75\x69\xaf\xb3\x91\x29\xeb\x76\x62\x1f\x8e\xba\x52\x55\x3b\x9a\xc0\x12\x81\x0c\xea\x8d\x20\xdb\xb6\xd1\xf3\xae\x55\x38\x4e\xa3\x8c\x6a\xae\x55\x11\xca\xf9\x68\x1f\xe8\xeb\x73\xdf\x88\x0d\x7a\xa5\x76\x93\x46\x2d\x26\x30\xb8\xfd\xdf\x91\xc9\xeb\x8d\x9a\x68\x33\x62\x43\x7f\x50\xeb\xfa\x5a\x09\x59\x09\xdd\xaa\x35\xbe\x8b\xb2\x42\x84\x15\x43\x4e\x83\x35\x33\xd9\x34\x13\xa8\x8c\xc7\xa7\x17\x50\x44\xe7\xea\x8e\x14\xe6\x07\xe8\xec\xd4\xf6\x35\x85\xbf\xdf\x2d\x32\x68\x77\x02\x55\x6c\x43\xac\xf2\xad\x7d\x3e\xa8\xa1\x3f\x72\xdb\xca\x82\x54\xae\xb0\xd6\x44\x3c\xc5\x8b\x7f\x0b\xa0\x91\x1e\xcf\x76\xa5\xda\x15\xd1\xe5\x84\x9f\x57\xd2\xc0\xcf\x4d\x53\x6f\x54\xd3\xee\xc2\xce\xad\xa4\x79\xb7\xad\xde\x53\xf9\xd0\xfb\x18\xd7\x38\x09\xbb\x80\x1f\xe0\xe5\x99\xd8\x0b\x17\xe1\xbb\xb8\x95\x7f\x83\xb0\x22\x9f\x32\xdc\x24\x21\x45\x2e\xf3\x95\x2a\x84\xc3\x38\xf0\xb4\x6f\xba\x46\x79\x08\x4d\x6e\x2f\xd5\xcf\x16\x55\xb8\xa3\x50\xb6\xff\x96\x3a\xda\x2e\xe9\xe3\x75\x25\x32\xd3\x36\xfc\xbc\x56\xda\x5e\x65\xf8\x7c\x6e\xda\xe6\x02\x8f\xc9\x2d\x4d\xb7\x96\x34\xce\xc2\x67\xcb\x25\x54\xd0\x87\x3d\x97\xdb\x68\x7d\x72\xad\x4a\xfd\x0f\xbb\xc2\xd5\x6e\xb3\x52\xd5\x51\xa1\x4a\x4b\x6b\xa9\x22\x42\x9f\x38\x7d\xac\xfb\xe1\x95\x38\x15\xb3\xa3\xec\xe7\xed\x78\xb6\x3c\x89\x3e\xb9\x59\x15\x8c\x03\xf1\x73\xa7\xf9\x59\x0c\xd4\xa8\x4d\x29\x73\x95\x85\x2e\x27\xec\xaa\x5f\x4e\x44\xce\xee\x7a\x2e\x9e\x89\x7c\xda\xd6\x3f\x6e\x36\x0e\x43\x88\x63\x31\x1a\x9d\xc0\x52\xf8\xe5\x94\x1b\xdd\x4a\x5a\x4e\x7f\xf6\xfe\xe3\x17\x4e\x32\x5f\xc9\xe6\x79\x9b\x3d\x19\x27\x63\x3f\x46\x24\x0a\x00\xff\x34\x9e\xc1\x77\xb0\x87\x0e\x62\xd6\xaa\xb4\x4d\x7a\x53\x59\xb9\x5a\xb8\x93\x3f\xff\x39\x3b\x7f\x7e\xf4\x3f\x2f\xfc\x6e\xfa\xef\xbf\x75\x3b\x59\xc7\x13\x31\x3a\x7a\xf8\x74\x34\x8e\x31\x6b\x34\xd9\x33\xbd\xde\x94\x4a\xcc\x75\x55\x88\x4d\x5d\xee\x16\xba\x2c\xe1\x35\x50\xd5\xb5\x6e\xea\x6a\xad\xaa\xd6\xe0\xeb\x54\xd4\xa2\xaa\x5b\x61\xba\x8d\xe5\x68\x85\x6e\xa7\xd3\x29\xd0\xb4\xb6\x9f\xf7\x2b\x59\xb5\xf5\xfa\x2f\x67\xe2\xe9\xf4\xf3\x54\x7c\x54\xf9\xaa\xd2\x39\xd0\x21\x5b\x25\x8a\xba\x1a\xb5\xa2\x52\x96\xba\xb7\x44\xbf\xac\x76\xeb\xba\x51\xc2\xe8\x2a\x57\xa2\x92\x40\xd5\xc3\x1c\xb4\xb1\xbd\x59\x82\x1e\x2a\x6c\x54\xb3\xa8\x9b\xb5\xa5\xdf\x75\x25\xd6\xb5\x69\xc5\xbc\xa9\xb7\x46\x35\x66\x22\xe6\x5d\x8b\x68\x0e\x9e\xd4\x56\x6c\xeb\xae\x2c\xc4\x5c\x89\x79\xa3\x24\xbc\xb3\x8b\xba\xb1\xdd\x01\x6b\x0a\x6b\xd8\x4a\x23\x2c\x8f\x63\xe9\x8b\xa6\xb3\x7c\x67\x3c\xf3\x89\x30\x35\x4e\x71\xdd\xd9\xb1\x94\xb8\x52\x9b\xd6\xf5\x33\x97\xf9\xd5\x56\x36\x85\x11\xf6\x75\x90\xad\x9e\xeb\x52\x3b\x4c\x65\x59\x6a\x6d\x5a\x59\xcd\xbb\x52\xe8\x65\x65\xa7\x5f\xa9\xcf\x6d\x84\x11\xdc\x16\xff\xd9\xae\x35\x5b\x54\x13\x91\xb7\x9f\xf1\x28\x7d\x9d\x79\xdd\x55\x70\xe1\x25\xbf\xee\x25\xa0\xe6\x65\x07\x07\xe2\x9e\x65\x7e\xe5\x4b\x4f\x8c\x95\xe2\x5b\xf1\x94\x7e\xc1\xbb\x5b\x4d\xe5\x66\x53\xee\xb2\xbc\xfd\x3c\x09\x9d\x8c\x7d\x95\x63\x5b\x05\xd0\x1f\xd6\x08\x24\x1b\x2b\x07\xb4\x71\x70\x20\xdc\xfc\xa6\x97\x38\x09\x40\x2c\x6c\x42\x34\x1d\xaa\x15\x51\xee\x78\xd0\x03\x6b\xa7\x36\x8b\x6a\x6a\x81\x00\x47\xbb\x45\xe1\x04\x40\xc5\xa9\x78\xed\x30\x6c\x40\xfa\xf6\xcb\x81\x5d\x5f\xe8\xf6\xc0\xce\x99\xef\xf1\x49\x9f\xcc\xac\x04\x50\xef\x47\xa5\xbe\xf2\x6f\x0f\x50\x9b\x8d\x92\x25\x7e\xeb\x51\x8b\x50\x2a\x32\x4b\xf5\x4c\x84\x69\x65\xd3\xe2\xbc\xe1\x4f\x20\xa8\xec\xbf\x37\x37\xe2\x89\xa3\xb4\x2c\x1d\xc5\x08\x28\x71\x84\x75\xdc\xe7\x46\xd9\x66\x95\xda\xe2\x80\x99\x06\xe4\xbf\x5d\xe9\x52\x89\x4c\x1f\x1d\xb9\x93\x6f\x94\x25\xb3\xa8\xaf\x73\x0d\x68\x47\x36\xed\x45\x42\x70\x35\xaa\xe5\xb4\xa8\xfe\xec\x5e\x51\xad\x2c\x75\x69\x39\x6a\xd9\x2c\x95\x63\xd3\x92\xf5\xa9\xcf\xad\xb2\x47\xd2\xd6\x13\x71\x69\x49\x0a\x02\x48\x47\x13\x5e\x29\xcb\x0c\xf1\x4f\x42\xb4\xf5\xf9\x95\xda\xd9\x99\x41\x31\xfc\x48\x26\xd5\xd6\x7c\x4e\xaa\x59\x2a\xbf\xf9\xf6\xe9\x74\xbc\x3d\x4c\x4f\x5a\x54\xb0\x2c\x95\x7b\x16\xd3\x03\x70\x1c\xa4\x6c\x9a\xf0\x94\x36\xca\x88\x53\xf1\xeb\xed\x3e\x02\x36\xd0\x39\x11\xfd\x4a\x24\xd0\xb9\xbe\x08\x54\x0c\x6e\x41\xd6\x28\x63\x2f\x08\x7c\x3b\x61\xe4\x0b\xdb\x68\xc3\x16\xf5\x1e\xb1\x93\xa8\x6a\x61\x77\x5b\x3a\x12\x40\x9c\xb5\xdd\x7c\x6e\x11\x90\x6c\x96\xc6\x42\x97\x25\xf3\xc4\xeb\xb2\xde\x8a\x95\xdc\x6c\x76\x62\xab\xdb\x55\xdd\xb5\xa2\x54\x12\xb0\x57\x67\x54\xa9\x8c\xe5\xb1\x65\x65\x36\xba\x54\x05\x20\x2d\xdb\x97\xad\x2a\xa6\xd3\x69\xa3\x4c\x2b\xb2\x55\xdb\x6e\xcc\xf1\x6c\xb6\x28\xeb\xed\xb4\x6e\x96\xb3\x79\x59\x2f\x67\x5f\x3f\x79\xfa\xaf\xb3\x27\x7f\x9c\x3d\xf9\xd7\x19\x32\xf3\x47\xee\xbe\x1c\xbd\x90\x65\x79\xf4\xbc\xd1\xed\x6e\x36\x8e\x77\xb5\xaa\xeb\x8d\xc8\xe4\x44\xcc\xf1\xa9\x0d\xeb\x7a\x5e\x6e\xe5\xce\xf8\x5b\x29\x4b\xc3\xe8\xd8\xaa\xb6\x37\xde\x3f\x45\xa1\x7d\x54\xff\x44\xdc\x72\xaa\x15\x5f\x29\xb9\x0e\x32\x1a\x22\x8a\x0b\x55\xb5\x1a\x88\x3a\xf6\xf4\xb3\xce\x2e\xa3\x8e\xfe\x43\x55\x76\x9b\xf1\x69\x97\xad\xce\x2d\x6c\x1a\xc7\x01\x03\x35\x6c\x31\xb3\x2e\x55\x43\xf2\xd7\x54\x22\xb1\x54\xd5\x19\xb4\xfc\xab\x6d\x98\x51\xa5\x08\x0b\xb9\x86\x8d\x2a\xba\x5c\xb1\x57\xd7\x8e\x35\x11\x6b\x76\x37\x6d\x75\x5b\x3a\xcd\xeb\x2a\x97\x6d\xb6\x9e\x9a\xd0\xf9\xcd\x8d\x38\xbf\x00\xac\x39\xb1\x7f\x4c\x7f\xa9\x75\x05\x0c\xd7\x10\xb3\xd2\x6e\x6b\x27\xd6\x92\x8d\x12\x65\x5d\x1b\x55\xee\x84\xfa\x7b\x27\x4b\x10\x95\x01\x73\x3f\x01\x86\x11\x58\xdf\x1d\xd4\x43\x39\x0c\x09\x5f\x26\xf6\x89\x86\x4f\x2b\x79\xad\x80\x98\x86\x2d\x37\x2b\xb9\x51\xcf\xe2\x7d\x80\x01\x5e\x41\xef\x70\x84\x8c\x3b\x00\x0e\x7f\xce\xce\xc0\xb2\x3f\x70\x09\x90\x8d\xc1\xbb\xf8\x5c\x9c\xfa\xbf\x33\xe9\x39\x4c\x57\xf4\x67\xfe\x79\x0e\x9f\x81\x83\xf0\xad\x41\xca\x41\x75\x3d\x52\x69\x76\xfe\x46\x62\x67\x80\x2d\xec\x50\x88\x97\xa9\x80\xc6\x4b\xaa\xfd\xb9\x57\x6d\xee\xab\xe1\xd8\xd4\x1b\x0c\x8d\x4d\x02\x06\x08\x9c\x8c\x43\xd9\xb0\x0d\xee\xc7\xa3\x47\x42\x4e\xd5\xb5\x6a\x76\x0c\x20\xd4\x44\x68\xde\x43\x78\x8b\xfd\xee\xda\x3a\x73\x8b\x4e\x7c\xa5\x5b\xf7\xe7\xad\x50\xa5\x51\x30\xb5\x07\x7c\x6e\x0f\x86\x26\x47\x78\xd8\x3c\x0f\xac\x83\xfd\xc9\x76\x22\xd4\xf9\x73\x52\x67\xce\xea\x30\xa0\x7d\xce\x17\x0a\xed\xd8\x62\xb1\x42\xba\x60\xcf\x41\xed\x5f\xaf\x84\xa7\xc0\x2e\xda\xfe\xbb\x7f\xd9\xa1\x97\x3b\xe8\xa6\x64\x18\x94\xa4\x53\x37\x88\x9e\x45\x2e\xdb\x7c\x25\x32\x15\xe6\x75\x6f\x7f\xbd\xde\x00\xc9\x47\xa7\xc1\xa0\xf4\x41\x0f\x4c\xa9\x3d\x89\xb3\x24\xca\xed\xe8\xd7\x7c\x7c\x90\x2c\x31\x19\xed\x36\xa2\x88\x60\xe3\xde\x20\xb7\x4d\xbc\xba\x97\x48\xfd\xc6\x27\x8d\x9f\x01\xbc\x60\xd8\x15\xbb\xc7\x3a\x7d\xcd\x8e\x9e\x32\x54\xf4\xaa\x32\x96\x95\x65\x22\x2b\x6d\x84\x25\xfe\x54\x21\xea\xaa\xdc\x89\x9a\x64\x6f\x0c\x8b\xd8\xa2\x94\xbd\x85\x06\xa4\xf5\x60\xf4\x60\xa4\x8d\xf2\x93\x7e\x80\xf5\xc3\xe1\xf9\xf6\x4e\xac\x64\xff\xf3\x04\x2c\x6a\xb6\x02\x05\x7b\x12\x8b\x18\xec\x04\xce\xce\x3e\x5c\x3e\xff\xf8\xf1\x83\x38\x15\xa3\x42\xb6\xf2\x08\x64\x2e\xcd\x91\x93\x86\x8e\x4e\xb0\xde\xf3\xb3\xb3\x57\x1f\x2f\x3f\xfe\xd7\xfb\x57\x67\xe2\x54\x9c\x1f\x08\x31\x62\x22\x1f\xfb\xb3\xd0\x8d\xca\x2d\x65\x89\x3f\x17\xba\x6c\x55\x33\x3a\xb8\xa0\x0e\xbe\x7f\xf3\xfa\xd5\x8b\xff\x7a\xf1\xfd\xab\xcb\xef\xde\xbd\xfb\xab\xef\x64\xae\x16\x75\xa3\x50\x64\x80\x0d\x91\xc5\x2f\xf0\x07\x7e\x7e\x5b\x77\x6e\x94\xb5\xfd\x33\xfe\xfa\xe3\xa6\xf0\x8d\x3b\xf8\x3b\xfa\xfc\x52\x99\xb6\xa9\x77\x34\x49\xfc\xe1\x6a\x48\x3b\xe1\xd0\xa0\x50\x49\x81\x6a\x9a\xba\x79\x21\x37\x6d\x67\xb7\x02\xd6\xc2\x35\x81\x79\x5d\x2d\xf4\x52\x9c\x8a\xcc\x9e\x07\x00\x86\x10\x5f\x89\x77\x1b\x14\x46\x02\x0d\x67\x5a\xfb\x02\x2f\x2d\x65\x99\x81\xbe\x46\x57\x22\xaf\x1b\x35\xeb\x5a\x5d\xce\x6a\xa8\x8a\xbc\x05\x5c\xb8\x7d\xda\x25\xac\x08\x64\xe1\x99\xef\xf1\x78\x48\x2c\x32\x39\xe0\x73\xf9\xc9\x49\x8e\x6a\xe0\x44\x1b\x4b\x35\x6d\x65\x53\xe9\x6a\x69\xdf\x7a\x1a\xd5\xe8\x52\x55\xed\x31\x42\x61\xdc\xc1\xd9\xaa\xde\x92\xfe\x12\x57\x05\xcc\xa1\xde\x88\xb5\x32\x46\x2e\x95\x00\x16\xac\x6e\x9f\xf9\xce\x42\xe5\x8f\x7a\x73\x2c\x0e\x0b\x75\xad\xca\x7a\x63\x41\xf0\x10\x15\xbf\xa1\xc6\x68\xef\x6c\x55\x05\x8c\x67\xa1\xae\xdb\xba\x2e\x8d\xef\xdd\x15\xfc\xee\x8e\x1b\x95\xd7\x4d\x01\xac\x72\x98\xb2\xe3\x9b\x73\x35\xb8\x09\xaf\x2c\x1c\x88\x95\xac\x8a\x92\x04\xec\x5b\x8b\x47\x55\x23\x00\x42\xc2\xec\xe0\xe7\x77\x58\xef\x18\x45\xfa\xf1\x3c\x24\x08\xd2\xfa\xfd\xd8\x33\x09\xdd\xd8\x5f\x77\xf4\xf2\x06\xd1\x74\xae\x9a\xd6\xd2\x33\x79\x67\xda\x7a\x2d\x54\xa9\xe0\x9a\xfb\x5e\x10\x9b\x17\xaf\xa8\xfc\x58\x9c\x5f\xc4\xfd\xbc\xc0\x86\x9d\x51\xc8\xb5\xc8\x52\x4b\xa3\x0c\x4c\xec\xfa\xa8\xae\xee\x85\xca\x2b\xb5\x7b\x51\x17\x5f\x04\x88\x7d\x39\xb3\x97\xfe\x82\x0c\xc1\x12\x6c\xad\xc8\x65\x55\xd5\x20\x48\x68\xd4\x52\x9b\xd6\xa2\x20\x21\x8d\x90\xd8\x89\x47\x39\x53\xf1\xd1\x69\x43\x4b\xd9\xda\xb3\x3b\x2a\xd4\xc6\xe2\xac\xaa\x05\x8d\xc2\x5a\xee\x6c\x2f\xf5\xb5\x6a\xb6\x8d\x6e\x5b\x55\x05\x68\x0f\xa2\xe5\x8f\x72\x79\x2c\xaa\x7a\xdf\x4c\xab\x58\x60\x7d\xf7\x84\xe1\x72\xdb\xa9\x86\x59\x62\x7f\x96\xb7\xfc\x27\xcd\xf7\x79\xdb\x36\x77\x4d\xd8\x8b\xf0\x2b\xd1\x55\x57\x55\xbd\xad\x1c\x54\x60\x6f\xe2\x7d\x6f\x74\x3e\xcc\x8f\xd8\x86\x00\xa6\x3f\x90\x53\xda\x56\x72\xad\xcc\x46\xe6\x0a\x64\xba\x7e\x0c\xdf\xd3\x52\xb5\x1f\xe5\xf2\x07\x57\xeb\x18\x78\xa7\xb8\xab\xf7\xb2\x31\x48\x73\x83\x1c\xc1\xce\xdb\xf6\x0a\xa0\x07\x94\xf8\x46\xe5\x7a\xa1\x73\xbf\x5f\x61\x9e\xa0\x72\x72\x0b\xa1\x71\x8e\x3d\x6b\xf4\x25\x67\xe9\x84\x55\x20\x74\x11\x9d\xe5\xa5\xbd\x1c\x7d\x82\x7a\x67\xe2\xbb\xee\xdb\x33\xdb\xd3\x8f\x46\xbd\x6f\xea\x4d\x7f\xbb\x5e\x7d\xde\xd4\x16\x28\xec\x9a\x4a\xb5\x94\xf9\xce\x2e\xd6\xd4\xec\xa2\x5f\x96\x7a\xa1\xf2\x5d\x5e\xaa\xef\xea\xfa\xca\x1c\xa7\xcf\xe4\xc1\xed\x38\x3c\x39\x7d\x7d\x0a\x71\x72\x20\xe4\x30\xc8\xfa\x3e\x14\x75\x23\x2e\x53\x35\xac\x03\x20\x26\x0b\x85\x07\xcc\xbe\x5d\xa6\x6d\xc4\x63\x31\x1a\x8d\x41\x7a\x6b\xef\x33\x48\x70\x19\x31\x92\x03\xc9\xf6\xe4\xf3\xd7\xff\x62\xb9\x34\xf7\xeb\x8f\xaf\x19\x39\xf4\x12\x2d\x73\x64\xa2\x8f\xf0\x53\x00\xdb\x14\xa7\x2d\x00\x52\x6b\x22\x54\xd5\xad\x55\x63\xf1\x09\xce\x88\x70\x08\x1a\x9b\x38\x3d\x03\x6b\xe4\xa4\x7b\x65\xa7\x8e\xa1\x07\xf8\x1d\x7a\x39\x16\x0f\x1e\x84\x5f\xf8\xd5\x5e\x26\xfc\x66\x29\x24\x2c\xc3\x67\xbb\x6b\x42\x39\x88\xf8\x4f\xb8\xa8\x02\xc0\xd3\xa0\xb4\x77\x23\xdb\x55\x60\xe8\xe7\x52\x97\x28\x84\x3e\xff\xdb\xcf\xdb\xe9\xc3\x8b\x19\x53\xa0\x20\x6c\xca\x76\x25\x32\xdb\x2a\xb0\x89\xd8\x6a\xda\x2a\xd3\xe2\x97\x98\x3a\x26\x42\xd3\xf6\x6f\xd4\x12\xa5\xc8\xa7\x38\x30\x69\x1e\xa7\xa3\xf1\x20\x79\xe8\x8d\x04\x86\x29\x60\xd7\xdb\x10\x19\x4c\x34\x25\xf6\xe0\x7a\xbe\xa5\x4f\x60\xbb\x61\xff\x7f\xee\xba\x38\xd7\x17\x17\x81\x82\xe4\xb6\x1f\x41\x67\x45\x80\x3a\xb3\x78\x51\x6c\x01\x29\x8a\xcb\x4b\x10\x42\x5e\x5e\x3e\x73\xea\xa9\xf7\xf6\xb7\xa5\x38\xfd\xa7\x91\x25\x8b\x7e\xbd\x45\xdb\xa9\x3f\xa3\xc8\x9a\xcb\xd4\x85\xa9\xf4\x62\xa1\xab\x25\x8a\x41\x2a\x57\xe5\xd4\xa9\xda\xb7\xba\x2a\xea\x6d\x6a\x57\x76\x42\xd5\x7f\x52\xa0\xab\xa3\xba\x3f\xfd\xe7\x2b\xd6\x73\x6a\x8a\x66\x79\x98\x07\x51\x95\xa9\xc3\x41\xd8\xdd\x56\xa9\xcf\x0e\x27\x58\xb6\x1d\x7b\x7f\xf4\x48\x0c\x37\x8a\xb5\x09\xd8\xc5\x8f\x20\x0e\xf0\xab\x78\xf4\x88\xe6\x3f\xad\xe4\xb5\x5e\xca\xb6\x6e\xa6\xf6\x51\x7e\xbe\xb4\x1d\x0d\xb4\xd7\xe6\x8d\x85\xc0\x1f\x81\xe3\x9a\xad\x8d\x56\x37\x6d\x03\x18\x70\x86\x20\xf6\xe3\x73\x56\xf3\xdf\x7d\xd5\x1f\x9f\x7b\x5d\xe5\xc8\xb6\x12\xff\x3e\x7d\x32\x1a\x8b\x6f\xc5\x13\x57\xfb\x55\xb1\x54\x83\xd5\x55\xb1\x54\xb3\xb8\xee\xf3\xaa\x68\x6a\x6d\xb9\x8f\xac\x5f\x5f\xe2\x47\x6c\x31\x06\xed\x5a\xbc\x71\x76\xdb\x7d\x25\x3f\xd9\x77\x67\xa1\xbb\x99\xde\xac\xea\x4a\xdd\xe8\x8d\x2c\x6e\xf4\xa6\x2e\x6e\x74\x6d\xc2\x02\xf7\x75\xaa\x6b\x13\x3a\x7c\xb1\x6a\xea\x75\x58\xd1\x2c\x87\xdf\x3f\xcf\x7e\x2e\x1e\x87\x9e\x88\x6f\xb5\x6b\x47\x08\x7c\xad\x1b\xb5\xa8\x3f\x83\x92\x55\x8a\x43\x20\xd2\x0e\x05\xe3\xe3\xfa\x3a\xd5\xe9\x14\xc5\x7d\x20\x5c\xff\x09\xb8\x6c\xcb\x17\xdc\x8e\xa7\xd0\x9a\xb8\x20\xd2\x06\x99\xf7\xd2\x18\x7d\xad\x02\xe3\x87\xfa\x62\x02\x08\xbc\xa0\x41\xbc\x63\x5b\xd6\x9b\x36\x88\x6f\xf7\xe2\xca\x4d\x6b\x26\x62\xb4\xc1\xde\x47\x13\x64\x4c\xec\x7f\x4b\xd5\x32\xcb\x80\xa5\x6a\x03\x63\xf9\x85\x42\x85\xfe\xd4\x39\xcf\x49\xf2\x85\xf1\xf8\xc4\x12\x8b\x4e\xec\xba\xd4\xed\xaa\x9b\x4f\xf3\x7a\x3d\x5b\xc8\x5c\xcd\xeb\xfa\x0a\x44\xb1\x33\x6d\x4c\xa7\xcc\xec\xeb\x7f\xfb\x23\x62\x69\x84\x7e\x59\x14\xaf\xae\x55\xd5\x7e\x0f\xa6\x2b\xaa\xc9\x46\xf6\x84\x8e\xc2\x72\xd0\x46\xc6\xae\x12\x90\x61\x24\xce\x40\x0c\x34\x43\x75\x54\xa5\x54\x01\x92\xe4\xb9\x12\xa5\xfc\xc7\xee\x48\x5d\x4b\xcb\x28\xcf\x55\x2e\x2d\x4e\xba\xee\x94\x23\xb9\x1a\xf5\xf7\x4e\x37\xf0\xcd\xb2\x8b\xb6\x8b\xeb\x4e\x25\x7c\x70\x03\x08\xcd\xa8\x56\x7c\xfa\xf1\xd5\xe5\xab\x1f\x3e\xa1\x11\x9a\x36\x67\x50\xcd\x41\x1b\xfe\xfa\x00\x6d\xd0\x36\x2d\xe5\xe3\xed\x39\xfb\x66\xb1\x71\xa6\x3b\x8f\x81\xb3\xd0\x0b\x77\x12\x28\x70\xe1\x98\xe3\x41\x40\x40\x84\xdf\xd0\xd6\x35\x45\x6c\x4c\xe2\x33\x13\x85\x6a\x55\xde\x0a\xcb\x0f\xaa\x0a\xe9\xb8\xa1\x45\x5b\xca\x54\x5e\xd7\xba\x08\x2d\x7f\x52\xf3\x8d\xcc\xaf\x84\x59\xe9\xf5\x1a\x0d\xa9\xc0\x08\x21\x57\xc6\x50\x2d\xb6\x3e\x9a\xcb\xf9\x88\x6a\x8c\x2e\xa6\xaa\xba\x9e\xd2\x26\x92\x91\x24\xd4\x1d\xd1\xd3\x12\x8b\xbb\x78\x57\x5e\x44\xd2\x53\x33\xf8\x5a\x07\xf4\x82\xd0\xf2\x3c\xf3\x68\x4f\xc7\xfd\xd8\x83\x79\x2f\x2f\xed\xa4\x5e\xbe\xfa\xf4\xf1\xdd\xbb\xef\xcf\x2e\xff\xe3\xfb\x77\x7f\x7e\xfe\x3d\x10\x61\x97\x97\x27\x5f\xa6\xad\xd4\xe6\x07\x54\xcd\x66\x2f\xda\x3a\xd6\x38\xd3\xc9\xd8\xf2\x01\xeb\xe5\x19\xa9\x74\xf3\xba\x50\x84\x95\x6c\x4d\x6f\xc7\x98\x8d\xbd\x7e\x6f\x25\xcd\x19\x18\x95\x8a\xd3\x03\x6f\x50\x4a\x25\x03\x2f\x99\x9b\x52\x86\x55\x2c\xa6\x0b\xcd\x3e\xa8\x45\x69\xb7\xe9\xae\x76\x54\x67\x5a\x6f\xab\xbf\xaa\x9d\xbd\x76\x08\xf9\x67\xaa\x3d\x19\xda\x14\x80\xd4\x3d\xfc\xa2\x85\x5d\x37\x63\x75\xf7\xb0\x67\xaa\x25\x0a\x69\x36\x03\x22\x82\x76\xc8\xb6\x03\x3b\x56\x79\x2d\x75\x69\xbb\xb6\xb4\xb8\x9d\x8d\x38\x15\x30\x27\x06\x40\xb3\x99\x90\xa2\xaa\xab\x23\x3b\xc7\x42\x36\x05\x34\xf7\x6a\x7b\x60\xe1\x40\x54\xb7\xad\x9b\x2b\x22\x9f\x83\xd5\x2c\x28\x2a\x42\xe7\x59\x5f\x22\xe7\x4b\xce\x62\x74\x6a\x31\xd0\xd4\xa8\x76\xbf\xd9\x8a\xc3\x97\x67\x2a\x31\xcb\xe1\x18\xc3\xfe\x8c\x45\xc8\x0e\x98\xa8\x7f\x52\x29\x3a\x0b\x6b\xe8\xf6\x64\xa0\x5f\x59\x14\xbc\x5f\xfb\x33\xee\x37\xe9\x90\x21\xf6\xc1\xfe\xf2\x52\xc9\x86\xf7\x88\x05\xbf\x75\x07\x4e\x0e\x22\xf1\xb0\x02\x6d\xef\x2d\x1a\xe5\xc7\x72\xb6\xad\x6c\x2a\x71\x0a\x5c\x24\x62\xda\x56\x6f\xa2\xdf\x4b\x52\x74\xbd\x70\x9c\xf7\xc7\xc6\x72\xa7\xa7\x22\xb3\x75\xf0\x4d\xb2\x87\x2c\x64\x03\x2c\x9f\x85\x4d\x34\x2f\x84\xe6\x20\xfb\x69\x7d\x63\xcb\x50\x86\xb6\x07\x07\x8e\x5b\x5a\x49\xf3\xa2\xae\x4c\x5d\xaa\x40\x4b\xe6\x54\xd0\x23\x3c\x89\xc1\x2a\xed\xdb\xb5\xd8\x21\xc3\x90\x3d\x3b\xfe\xdb\xcd\xf9\xd1\xe5\xc5\xd8\x19\x02\xc5\xb5\xa2\xf7\x02\x19\x35\x66\xb2\x02\xbb\x15\xcc\x80\x7c\xcf\xdc\x0c\x28\xb2\x01\x8a\x6d\x70\x4e\x9c\x4e\xc1\x77\x31\xb3\x53\x99\x2d\x27\x96\xf7\x3b\xa1\xf3\xa0\xad\x0e\x3d\xae\xcd\x72\x22\xae\xd7\xdc\xb6\xa2\xa5\xcd\xbd\x5e\x8b\x67\x7b\xb6\x3e\xb3\x2d\xc0\xe0\xe8\xc0\x3f\x5b\xc8\x76\x4d\x99\x6c\x8b\xc9\xb1\x7b\xdf\xd0\x90\x02\x1f\x7c\x9a\xc3\x04\x47\x1e\x47\x2f\x85\xed\x99\x1d\xcc\xa3\x47\x22\x7b\x40\xbd\xa1\x54\x73\x1c\x8d\x62\x2b\x4d\x41\x4a\x97\x65\x87\xe7\x9f\x3a\x05\x2b\xbe\x38\x16\x87\xe2\xb1\x1d\x47\x3c\xa6\x41\xb8\xb0\x1c\x16\x81\x30\xb7\x77\x63\x7e\xcf\x3c\xec\xd0\x34\x8b\x56\x6f\xa2\x49\x64\x41\x4d\xf5\x05\xbb\x4c\x95\xfb\x93\x1e\x86\x6c\x66\x21\xba\x9e\x08\x5d\xe5\x65\x57\xa8\xd7\xba\x54\x7c\x31\xd7\xeb\xe9\xc3\xa6\xae\x5b\x40\x30\x61\x9d\xfe\xc2\x8e\xbe\xf9\x50\xd7\xed\xb7\x23\x86\xce\x88\x4e\xd5\x75\x65\xc2\x0d\xb9\x5e\x0f\xbc\x78\xd7\xeb\x69\xae\x0b\xf1\x80\x99\x4f\x0b\xf1\xcc\x16\x53\x7b\x6f\x52\x73\xbd\x9e\x5e\x6a\xf3\xa9\x53\x7e\x43\xa0\xda\x43\x37\xce\xcd\x0d\x74\x56\x57\xa6\x6d\xba\xdc\xbe\x9a\x71\x0f\xd8\x87\xad\xe6\x48\x67\x24\xd3\x61\x23\xa8\xea\x14\x7e\xde\xdc\xf8\xdf\x97\x5e\x84\xf7\x51\x2e\x43\xab\x85\x2e\x79\xab\xcb\x4b\x5b\x10\xec\x3d\x1f\x40\x37\x8f\x1e\x41\xbd\x71\xa4\x9a\x5d\x13\x43\x60\xbf\x4c\xe1\x47\x36\xcb\xce\xff\x36\xfb\xf9\xe7\x8b\xc7\xe3\x9f\xa7\xd7\x9d\x7a\x38\xf3\x6a\x47\x9a\x1c\xb6\x79\xf4\x08\xff\x38\x7f\xea\x99\x6f\x8e\x36\x1d\x9c\x64\xd0\xe8\x99\xc8\x0e\xbf\xb1\x40\xe4\xd1\x03\x94\x8f\xc7\xe2\xb1\x38\xfc\xf6\xd0\x02\xcb\xe1\x37\xcf\xab\xba\xda\xad\xeb\xce\xd8\x82\xc7\xae\x3d\xac\xcd\x3e\xc1\x01\x1a\x00\xa9\x01\xd5\x05\x1d\x0b\xd9\x02\x80\xe2\xea\x2c\xd8\x21\x3e\x19\x7b\x68\x43\x2b\x93\x8d\x92\x6d\x8a\xc9\x26\xa2\xe2\x18\x04\x4d\x51\x46\x44\xf3\x91\x1d\x4f\x15\xcb\x25\x2a\xf1\xff\x88\xaf\x01\x74\x9e\x22\x52\x33\xe2\x31\xd8\x62\x9f\x78\x19\x05\x56\xfb\x16\x2b\x80\x18\x2b\xa9\x50\x89\x6f\xbf\x3d\x15\x4f\x07\xa4\x16\x8d\x32\x7e\xda\x7b\x5f\x0f\x7e\x4f\x92\xab\x81\x30\x49\xa0\xfc\x70\x23\x1b\x7b\xc3\xa3\x23\x6f\x1b\x65\xfb\x38\xbf\xe0\xba\xf7\xbc\x6b\x6c\xcd\x0f\x2a\xef\x1a\xcb\xd3\x9c\xa9\xbf\x77\x40\x80\x9f\xa2\x2d\x14\xdb\x0e\x7e\xe5\x70\x58\xdb\xa3\xd3\x39\x03\x53\xcd\x55\xcb\x60\xf4\x26\xc1\x5e\xdd\xd6\x3b\xe7\x95\x8f\xc4\xd3\x8b\x13\x56\x17\xf4\x9f\xd2\xb4\xfc\xda\xd0\x35\xe7\x45\xf1\x00\x62\xef\xe4\x1f\x3f\x3e\x89\xea\xd9\x1b\xcf\xb6\x25\xfe\x98\xd7\x55\xab\x2b\x76\x9d\x23\x4c\xbe\x77\x7f\x7a\xeb\x15\xc3\xcb\xb4\x3b\x6e\x97\x36\xd9\x3b\xdb\x8b\x93\x2f\x5a\x14\x3f\x91\x00\x3d\xf1\x5f\x30\xf8\xa6\x33\x2b\x7b\x58\x27\x07\xf7\xac\xff\x36\xc1\x9f\x3f\x57\x3f\x57\x0b\x20\x47\x74\x65\xff\x1e\xc1\xdb\xa3\xc2\xce\x4c\xd7\x72\x93\x25\xb8\x9a\xbd\xef\xd9\x21\xdc\x74\x8d\xe2\x58\xf1\x4c\x8c\x8e\x8e\x8e\xbe\x15\x23\x71\x4c\x97\x30\x1b\x89\xd1\x44\xfc\x51\x3c\x16\x5a\x7c\x25\xbe\x06\x2c\x90\xc5\x26\x1f\xd7\xeb\x71\xb4\x1b\xcf\x44\x96\x0d\xbc\x19\xd9\xf5\xfa\xfc\xc9\x05\xa2\x91\xe9\x74\x6a\x91\xc1\x63\x3b\xa1\xf3\xa7\x17\x50\x26\x1a\xb7\x7d\xa0\x91\x36\xe3\xc3\xb8\xdb\xe3\xa1\x87\xc8\x0e\x3e\x0e\x64\x09\xac\x18\x8d\x7e\x7e\xae\x08\xb5\x24\xdc\xa0\x5f\xb8\xdd\xae\xcc\xed\x1d\x20\xa5\x3d\xb3\xc6\x29\xbb\xe9\xd0\xbb\xc8\xa8\x4b\x20\xff\x3a\x90\x61\x3d\x09\x76\x53\xcf\x45\xa1\x36\xa4\x47\xa9\xe7\x96\x3f\x45\x23\x58\xcb\x30\xe4\xb2\x42\x7b\xa1\x75\x57\xb6\x7a\x53\x82\xd1\x99\xd7\x7d\x1b\x61\xba\xb9\xc9\x1b\x0d\xe6\x6c\x6d\x2d\x74\x1b\x24\xc7\x2f\x55\x44\x41\xd8\x9f\x44\x33\x03\xbd\x0c\xb3\xe8\x74\x81\xd7\x09\x49\xe8\x6e\x6e\x08\x87\x58\x54\xf5\x52\x6d\x62\xb2\xfe\xac\x9b\x27\x94\xbd\x2d\xc9\x4c\x37\x67\xfd\xda\x4e\x10\x4a\x6d\xf9\x50\x4f\xe8\xfc\x90\x74\x16\x0a\x43\x7f\x58\x96\xf9\x6e\x27\x62\x5f\x97\xa8\x2b\xe1\xfd\x51\x09\x93\x83\xd8\x16\x68\x68\xe9\xae\x76\x28\xb1\xab\x7b\xa9\x36\x30\x14\xca\x7a\x06\x06\xa9\xea\x36\xa1\x9a\xa9\x24\xf3\xbc\xa3\x69\xe5\x5c\x83\x05\x3b\x68\x95\xe8\x70\x14\xf9\xf6\x2c\x74\x63\x5a\x27\x77\xc7\xbd\x0e\x5b\x86\xd6\xea\x03\xfe\x3e\x13\xb0\x2e\x86\x3a\x5e\xaa\x2e\xbe\x11\x65\x24\x5c\xb7\x9f\xcf\xf5\xc5\x14\x2d\x12\x32\xb6\x06\xf4\x0e\x76\x88\xc7\x99\x9a\x3a\x45\xf0\x5c\x59\xc8\x51\xd7\xb2\xec\x64\xab\x8a\xa9\x17\x69\x69\x43\xc2\x94\x72\x27\xba\x4a\xff\xbd\x53\x5e\x9a\xd5\xae\x54\xa3\x44\xee\xec\xb9\xc9\xfc\x04\x44\x59\x7b\xba\xb5\x6f\xb9\xac\x76\xa2\xd5\x6b\x35\x3d\x08\xdb\x2e\x90\x20\x23\x2e\x0b\x8a\xce\x5a\x99\x5f\x11\x10\x32\x45\x47\x67\x56\x1f\xb1\x49\x76\xc9\x0f\xb1\x77\xb0\xbc\x1b\x04\x43\xf6\x19\x9f\xea\x68\x7c\xea\xed\x24\x32\xfc\xd9\xd4\x1b\x37\x1a\x0e\x13\x35\x89\x46\xa8\x37\x59\x9f\x83\xfc\xf4\x43\x5d\x44\x2f\x3a\x16\x58\xea\xa9\x95\xcb\xc9\x81\x10\x85\x6c\xa5\xfd\x37\x5f\xe9\xb2\x68\x54\x65\xff\x6e\xd5\xe7\x16\x7c\xc8\xca\x35\x7c\xaa\x2b\x57\xe2\xe9\x43\xb4\xf5\x30\xb6\x4c\x9a\x5d\x95\xbf\x46\xd7\xf2\x03\x76\xff\x5a\x70\x97\x6a\x91\x90\x84\x12\x3b\x96\x38\x85\x21\x7d\x99\x1b\x57\x9c\xfa\x29\xf8\x6f\x76\x54\xdb\x85\xfa\xdc\xfa\x32\x55\xda\x57\x46\x95\x6b\x5f\x02\x44\xb7\x67\x41\x43\xc7\x38\x6b\xdb\x2f\xfe\xe5\xbf\x2c\xaa\x17\xfe\x5b\xbf\xdd\xa2\x7a\xe7\x49\xf9\xa1\xaf\x67\x79\xbd\x51\x6f\x8a\xc1\xaf\x57\x6a\x47\xeb\x03\xe7\x7c\xd9\x4a\x5b\xc4\xa6\x14\xef\x1e\xcc\x2d\x2e\xea\xd7\x7d\x53\x99\x56\xe2\xc3\xdc\x1f\x10\x5f\xda\xc1\x4f\x8d\xdc\x72\xe1\x21\xa2\x5a\x83\x56\xad\x03\x1f\x2c\x53\xf3\xa6\x32\xaa\x69\x99\x20\x84\xbe\xbd\xa8\xd7\x6b\x1c\x26\x6d\xf5\xa2\xac\xab\xd8\x8e\x8b\x3e\xbc\xc3\x19\xc7\xc5\x1c\x52\xc4\x69\x04\x38\x71\x9d\xb7\x0a\x00\xa5\xbf\x28\x6d\x9e\xdb\xef\xef\x2d\x1f\xbf\xaa\xcb\x82\x0b\x48\x6f\x49\x46\xe7\xf1\xe4\xf3\x3c\x57\xc6\xd4\x0d\xe8\x0f\x10\xba\x8e\xed\x1f\x3d\x35\xa8\xb8\x15\x84\xa0\x5e\xbe\x7a\xff\xe1\xd5\x8b\xe7\x1f\x5f\xbd\x3c\x46\xab\x10\x74\xc8\xec\x9d\x85\x2d\x4d\xdd\x3c\xa6\x77\x09\x4b\xfb\x93\x42\xc8\x9f\xe2\x4d\x4e\x85\x6c\x5c\xe2\xd5\x1b\x1d\x56\x3a\xa4\x08\xd1\xca\x64\x78\xc3\xc3\x63\x31\x19\xda\x0f\x27\xce\x44\xe9\xd4\xab\xf5\xa6\xdd\xf5\x30\x45\x66\x6f\x48\xc0\x6d\x02\x2f\x8c\x25\x96\x6b\x5d\x88\x27\x62\x4c\x25\x4e\xd8\x81\x46\xdf\xd0\x47\xa5\xb6\x38\x0d\x44\xff\xb6\x34\xbd\xcb\x50\xc6\x21\xcb\x81\x9c\xf3\x7d\xae\x0b\x5c\x67\x10\xb6\xc1\x5c\x3f\xaa\xcf\x2d\xe1\xb0\xd4\x2f\x39\x8c\xea\xe1\x66\x22\x86\xff\xe4\x0e\xd7\xa4\x3e\xb1\xcc\xed\x5a\xff\x43\x15\xc2\xac\x64\x09\x72\xb2\x92\xde\x92\xce\x19\x33\x90\x31\xb9\x9d\x1b\x06\xa6\x30\x65\xdd\xd2\x4f\xf6\x26\xed\x82\x8a\x05\x8d\x63\xf2\xa6\x36\xc6\xf6\xe4\x68\x27\x72\xba\x36\x13\x18\x84\xb4\x09\x6b\x54\x39\x18\xb2\xad\x42\x79\xef\xcb\x77\x6f\xc5\x5a\x56\x7a\xd3\x95\x12\x71\x46\xa3\xca\x1d\x4c\xb8\xc2\x88\x19\x16\x17\x8a\x46\x2d\x54\x83\x5e\xcf\x4c\x3a\x59\x57\xca\xed\x95\x9d\x24\xb3\x7f\x70\xd7\x36\xec\x19\xf2\xa8\x78\x54\xf8\x32\xb8\x9f\xee\x85\x70\xbf\xf9\x4b\xe1\x9b\xd0\xfb\xe0\x7e\xd3\xcb\xe1\x9b\x84\x17\x24\x14\xf5\x5f\x12\xf7\x2d\x7a\x51\x84\x00\x20\xc2\x29\x23\xb2\xc7\x5a\x88\x2c\xa9\x9c\x61\xb6\x6b\x82\x2d\x2c\x60\x75\x10\x3b\xe3\x67\x42\xcb\xbe\x75\x00\xc4\xeb\x18\x34\x59\x2d\xfe\x6e\x60\x2d\x5f\x12\xd5\x0a\xd8\xdd\xd5\x62\xc8\xdd\xd7\x0a\xef\x88\xab\x45\x25\xf1\xbc\xdc\x49\x25\xf7\x03\x2b\xe0\x73\x8f\xae\x70\x3c\x24\x03\x42\x94\x36\x28\xb9\x71\xa0\x09\xd2\xdf\xa2\x56\xa6\x1a\xb5\x62\x53\xca\x9d\xd8\xaa\xb2\x04\xf9\x3f\x10\xf3\xbb\x4a\xae\xc9\x19\x4f\x02\xaa\xb0\xdd\xac\x55\xbb\xaa\x0b\x63\xc1\x0d\x7d\x72\x3c\x3a\x39\xf0\x54\x06\xf8\x15\x3b\xe3\x06\x64\xb4\x7c\xad\x93\x50\xe3\x2d\x75\x95\x0a\xc7\x43\x73\x87\x97\x68\xd0\x8f\xf5\x7b\x92\x1f\x81\xcd\xac\x25\xa2\xd0\x3e\x75\x53\x6f\xf0\x0f\xb3\xd2\x0b\xb2\x90\xed\x2a\xf6\x03\x7d\x8c\xe9\xef\xba\xa1\xd2\x46\x5d\xab\xc6\x28\x67\xd0\x0a\x1b\xf7\xa6\x6a\x55\x93\xab\x4d\x2b\xd6\x9d\x05\x18\xb6\x64\x7b\xc3\xd5\x5a\xb7\x42\x5d\xa3\x7d\xe1\x57\xb3\x83\x78\x66\xd3\x45\xdd\xbc\x92\xf9\x8a\x71\xab\x58\xc1\xd3\xe1\xe8\xc7\xeb\xc3\x0c\xe0\x57\xba\x85\xbe\xf4\x94\xed\xe0\x39\x56\x01\x6e\xbd\x50\x8b\x8c\xef\xdc\x84\xda\x33\xc9\x37\x4c\xba\x66\x4a\x08\xdc\xed\x25\xb2\x4f\x13\x51\x02\x65\x35\xec\x11\x48\x02\x17\x5b\xe7\xe8\x48\x8c\xa1\xd9\x39\xb4\xb8\xe0\x6d\xa8\x88\x24\xda\x24\xc6\xea\x4a\x7b\x0b\xdc\x0a\x52\xbb\x6b\x67\x72\x0d\xab\x9c\x3b\xee\xe2\xf2\xb2\x9e\x5f\x5e\x86\x2f\x1a\xc8\x0d\x7c\xdd\x85\x30\x5b\x0d\x3a\x6d\xbe\x81\x20\xa8\x90\x46\xd1\xd9\x1f\x47\x45\xee\xc0\x8f\x83\xb0\x88\x3a\xc4\xd9\x9b\x20\x99\x00\x4f\xcf\xa8\x31\x01\xc8\xde\xb6\xc4\x05\x7d\x3d\x1e\xea\xe4\xd6\x4b\xc4\x5c\x2b\x4b\xef\xd7\xf3\x29\x32\xcd\x8a\x9c\xf5\xdc\x37\x27\x97\x9b\xcd\x1c\x9f\x96\xaf\x64\xb5\x44\x81\x47\x3d\xb7\x3c\x23\xb1\x74\xd9\xf8\x24\x11\xd6\x75\x65\xeb\x8c\xb4\xc6\x89\x15\x36\x40\x06\xb8\x10\xf9\x0b\xb5\x54\x2d\x73\x55\x07\x43\xc4\x08\x80\xc6\x1c\xee\x85\xa9\xd7\x0a\xb6\xc3\x88\x2d\x9a\x05\x6c\x21\x02\x4e\x2d\x48\x7d\xe9\x84\x00\x14\x7b\xc9\xe8\x42\x71\x33\xcf\x11\x38\xe1\x22\xa3\x07\xa5\x70\x81\x9c\x7b\x3b\xf0\x96\x2b\xcb\x9b\xbd\xc3\x5d\xf1\x48\x8c\x7b\xec\x2d\x97\xa5\xc2\xef\x2e\xd6\x88\x0b\x51\x93\xb6\x85\x4f\xdc\x56\x8d\x3e\x91\x1a\x29\x04\x36\x69\x5b\xf4\xa7\x6f\x6b\xa1\x64\xbe\xa2\x45\xa8\xc2\xb6\x21\xc7\x46\x01\xe4\xa9\xab\x39\xa1\xf8\x02\xae\x3b\x74\x01\xc5\x30\x03\xc8\x62\x85\xa6\x23\xe3\x2d\xfd\xd0\xa9\x0c\xfc\x12\x97\x10\xde\x6a\x66\xe0\x1f\x9c\x0a\x3a\x14\x97\x25\xea\xe3\xd1\x80\x32\xd7\x44\x37\x14\xda\x6c\x00\xb3\xe1\xe6\x99\xb0\x63\x7e\x51\x8c\x12\xf3\x65\x7c\x7b\xe0\x46\x51\x44\x25\xb7\x37\x8e\xcd\x02\x79\x8b\x7d\xda\x5f\xaa\x0d\x82\x14\xd6\x5e\xbf\xa8\x3b\x78\xe3\x9e\x38\xec\x02\x0d\x27\x62\x84\x57\x73\x34\x11\x5e\xee\x60\xa1\x3b\x91\x99\xc1\xe0\x11\x9e\xe9\x96\xf4\x68\x3a\xe3\x36\xaf\xd1\x00\xfc\xff\x1c\xbf\x7b\x9d\x46\x5e\x6f\x76\x54\x86\x70\x4e\x1d\xb8\x69\xc4\xb8\xce\xc3\x37\x5d\x0a\x58\x43\x74\xc3\x70\x46\x27\xa9\x2b\x0d\x54\xdc\xca\xf2\x8a\x57\x38\x60\xfe\x81\x3f\xc9\xf2\x4a\xb4\xab\xa6\xee\x96\x2b\x84\x11\x7f\xa6\xf6\x74\x08\x00\x90\x26\xb3\xe7\x6b\xdb\xc4\x47\x4c\x76\xcd\x88\xab\x08\x54\x51\xfe\x30\x57\xce\x39\xc5\xd2\x6f\xb6\x25\x45\xaa\xb1\x8f\xb3\x36\x91\xe7\xaa\x3b\x58\x26\xda\xb1\xd3\xe6\x67\x0f\xbf\x83\xb1\xa3\xf3\xdd\x4a\x5c\xb7\xec\xf7\x7d\xee\xad\xa0\xcf\x1f\xb0\x82\x44\x62\xf8\x03\xba\x7e\x28\x6f\x6e\x6a\x9c\x53\x6b\xb4\x63\xee\x1a\x4a\x94\x1f\xd5\x0b\x22\x06\x74\xab\xd6\x66\xef\x62\xf8\x61\xf1\x45\x45\xe5\x10\x3d\xc4\x0c\xf9\x32\xa1\xbc\x09\x87\xd8\x2f\x70\xa2\xce\xb0\x9b\x64\xf2\x33\x17\x89\x2e\x88\x38\x09\x64\x65\xe5\x04\x50\xe4\x33\x5c\x37\xb4\xa4\xf9\xce\x9e\x39\xd2\x04\xba\x5a\xda\x46\x64\xea\x83\xab\xb2\xc8\x5b\x57\x64\x3d\xed\x0d\x39\x63\xab\x5f\x0e\xfd\x22\xc3\x81\x26\xc2\x34\x39\x6e\x31\x51\x07\x5f\x09\x65\x4a\x5d\xb5\xce\x5a\x44\x54\xf5\x11\xb4\x44\xa3\x27\x12\x08\xfa\x21\xc4\xa9\xed\xe1\x24\x6a\x49\xde\x22\xbc\xe1\xed\x6f\x5b\x2b\x80\x01\xad\x73\xa5\x8b\x42\x55\xcc\x29\x1c\x4f\xf6\x4b\x6c\x80\xd8\xd5\xde\xbb\xe0\xa1\xd3\x8d\xa1\xb3\x77\xb8\xce\xaf\xfc\xd4\x81\xe6\x89\x83\x5d\x3f\x08\x98\x48\x9b\x26\x47\x47\xc2\x93\x24\x8e\xcc\xf3\xb6\x55\xeb\x0d\x3c\x6a\x39\xc5\x67\xa9\x02\xaa\xd7\x9c\x9f\xa7\x68\x59\xe0\x3c\xeb\x83\x99\xad\x14\x20\xd2\xd0\x62\x21\x4c\x07\xc4\xf1\xa2\xb3\x84\xb2\x7b\x5c\xa0\x15\xd9\xf0\xab\xcf\xda\x00\x21\xc9\x5b\xf9\xc0\x73\x42\x96\x8d\x92\xc5\x0e\xec\x5c\xea\xaa\xe7\x2b\x47\x37\xcd\xe3\x44\x90\xcc\xbc\x94\xad\x0c\xbc\xb8\xf7\x35\x74\x0f\xc2\xcd\x8d\x8b\x12\x48\x0b\xaa\x17\xc8\xd6\xed\xb5\xb6\xae\xe7\x0e\xc9\x63\x78\x9b\xf4\x25\x00\xdb\x50\x28\x23\xb2\x8d\x77\xed\xae\x7a\xb8\x82\xee\x0d\x62\x34\x1e\xd3\x6e\x21\x7d\x17\xbd\xe7\x60\x8f\x25\xc4\x83\x9e\xed\x60\x36\x76\xdf\x06\x9f\x1f\xbb\xd4\x28\x5c\x9c\x7f\x96\xa8\x15\xa1\x45\x6d\x5e\x7d\x6e\x55\x65\xf4\xbc\x54\xae\xa9\x1b\x93\x66\xea\xb4\xeb\xd1\x2a\xec\x61\xbb\xe5\x45\xcf\x07\x79\x3d\xfb\xd3\xb0\xfb\x53\xcf\x43\x5b\xf7\xba\xa2\x16\xe3\x96\xc7\x08\x1c\x72\x17\x68\x08\xef\x86\x97\xa7\xae\x2c\x68\x0e\x06\x36\x88\x51\x35\xec\xa7\x45\xd7\xe8\x88\x34\x81\x13\x05\xa7\x00\x74\x88\x3a\x83\x27\x6a\x02\x24\x14\x48\x32\x58\xe8\xaf\x1e\x69\x40\xe5\x9b\x5e\xd4\xa4\x98\x94\x7c\xa9\x0c\x04\xf0\xab\x9b\x10\xfa\xc8\x01\x90\x6f\xfb\xe8\x51\xf0\x82\xe0\x42\xb6\x10\xde\x72\x00\x1c\x1d\x9b\xd4\xba\x08\x6e\x8d\x3a\x72\x61\x37\xe3\x37\x97\xa6\x8a\x85\xe2\x54\x0c\x8e\xbb\x44\x73\x2a\xb8\x25\x54\xf3\xd1\xa3\x1e\xff\x03\x33\xfa\x3a\x20\x99\x92\x4c\xfe\x79\x78\x0a\xf4\x49\xb8\x6b\x2c\x63\xc7\x72\x12\x95\x95\xb6\xc0\x2d\x4e\xc5\x03\x27\x3f\x02\x00\xc1\xb7\xc9\x85\x88\xfb\x32\x4f\x0f\xee\xd9\x71\x97\xf7\x06\x96\xc7\x16\xce\x0e\xae\xfe\x03\x67\x9e\xc5\xba\x79\x47\x2f\xd2\xce\x3c\xa3\x3f\x58\x5c\x45\x70\x30\xe1\xee\xf0\x7d\x65\x15\x22\xe0\x0d\xa9\xba\x32\xc6\x21\x81\x26\x1b\x37\x22\x56\x5a\x53\xe1\x74\xb8\xd9\xfd\xc4\x26\x1b\x56\x55\x45\x4a\xfc\xb9\xff\xfa\xaa\xea\x44\xf5\xec\x9d\x9b\xc4\x2d\xee\x9d\x19\xdc\xbb\x33\xda\xbb\x4a\x6d\x3f\x39\x19\xe3\xef\xdc\xc1\xc1\x37\xde\xa8\x72\x01\x11\x47\x65\xa3\x82\xa5\x39\x18\x75\xc0\x88\x28\x65\x85\x81\x6e\x6e\x7c\x21\xc4\x19\xc5\x3f\x1d\x6a\x86\xb2\xfe\x4e\xf9\xdb\xc5\xb7\x60\x88\x66\xd8\x3f\x91\xbb\x7d\x4c\xed\x04\x38\xae\xe1\x83\xf3\xf2\x70\xc8\xdc\x72\xc5\xf4\xda\x98\x78\x0f\x27\xb4\xce\xd0\x3a\x8d\x05\x80\x37\x16\x6b\xa5\x43\xdc\x7d\x13\x93\x9e\xfb\xac\xf7\x6d\xcf\x27\xea\x4c\xb5\xcc\xbf\x8b\xf0\xb4\x63\x24\x9f\x17\x45\xa0\x12\x38\x17\x01\x74\x63\xa3\x97\x4b\xcb\x10\x22\xbf\x8f\xdc\xbf\xce\x89\x9f\x5e\x44\x71\xec\x9c\x60\xce\xb6\x73\x14\x02\x10\x12\xc9\x5b\x60\x14\x23\xb0\x9c\x4f\x59\x20\x0d\xee\x3d\x38\x7c\x5a\x29\x6c\x72\xe6\x6e\x36\xbe\xaa\xce\x06\xd8\x15\x8f\xd9\xeb\x08\x36\x7b\xd9\xe1\x0b\xf4\xfb\xb4\xb3\x18\x7c\xc2\x98\x90\x9d\x9c\x16\x9a\x34\x22\x2f\x9a\xfb\x65\x7e\x90\x71\xf4\xc2\xc6\x68\xc0\xcd\x0f\x6c\xa4\x93\x98\xaa\x60\xcb\xeb\x63\x82\x20\xb1\xec\x63\x4d\x41\xc4\xd4\xb5\xfc\x9c\x45\x1f\xc2\xc3\xe5\x5b\x50\x34\x42\xd8\xc9\xa7\xb8\x99\x91\x0c\xe6\x5a\x96\x6c\x76\x14\xe3\x88\xc8\xe9\x47\x8f\xc4\x03\x57\x94\x3a\xc6\x24\x33\x73\x26\xc6\x1e\x37\xf4\xba\xf7\xb2\x32\xb7\x68\x46\x4b\x81\x15\x14\xb1\x03\x68\x80\x65\x11\x43\x3d\x47\xc8\x76\xa4\x87\x1f\xf2\xfe\xdb\x0b\xa7\x49\x77\x60\xf4\x1c\x34\x3a\xb2\x28\x2c\xcd\x9a\x9e\xaa\x56\x06\xe3\x5e\x7d\x62\xe4\xa5\x3d\x55\xdd\x1a\x01\x76\x92\x0f\x41\xdf\x39\xf2\x26\x75\x23\xd9\x8a\xa6\xab\x5a\xbd\x56\xe2\x48\x14\x2a\x2f\x2d\x7a\xd1\xad\xe8\x36\x8b\xa6\xc6\x10\x6d\x16\xf2\xa1\x19\xda\x14\x4e\xd1\x9a\xf2\xae\x9d\x7f\x10\x88\xad\x2f\xdd\xd1\x1e\x87\x3b\x25\x22\xd7\x5f\x9b\x93\x83\x41\x01\x1c\xeb\x89\x93\x6e\xa5\x6a\xb9\xa7\x27\xc8\x0a\xe8\x8a\xbb\x1b\xae\x17\xa2\x52\x96\x3b\x90\x4d\xdf\x13\xb4\x8c\x2e\xee\xff\xf1\x3b\x5b\xe0\x02\xfe\x6f\xbc\xb6\xd1\x25\x8c\xa0\xe0\xff\x92\xcb\x01\x7b\xc7\xdc\xa5\xed\xad\xb0\x0f\xc1\x6f\xb8\x15\x47\xe2\x97\xce\x20\xe6\xd4\xc0\x8f\xda\x8d\x1e\x02\x7c\x0e\xf4\xc4\x9c\x71\xb0\x19\x64\xe9\xe8\x6c\xd9\xcd\x38\xe9\xdf\x9b\xa8\x45\x0f\xec\x79\xa8\xe5\x01\xb1\x65\x4d\xe1\x63\x7d\xb8\x05\xd4\x4f\xda\x8b\x4c\x71\xb0\x2d\x9e\xe8\x50\x9e\x0a\x01\x1b\x21\x2a\x99\x72\x91\x02\xbc\x4c\x25\xee\x86\x34\x4d\x02\xc2\xec\x79\x88\x5c\x92\x94\x2d\xbd\x41\x9e\xfa\x8b\x44\xa1\x5e\xae\x00\xde\x0b\xa8\x9e\x1e\x4f\xb8\x94\x01\x39\xbe\xfd\x62\x06\x2f\x43\xf5\x12\x06\x30\x71\xf5\xbc\x2f\xfb\x7b\x80\x86\xed\xc3\x3f\xa7\xc7\x38\xcd\xaa\xd2\x50\x34\x89\x8b\x3b\x45\x50\x71\xd1\x10\xd0\xc7\xdd\xc7\x51\x91\x2c\x84\x2c\xc9\xbb\x31\xa4\x06\xc8\x6e\xea\x2d\x44\x90\xc3\x00\x7a\x82\x0c\x43\x10\xbd\x3a\xc1\x43\x55\x08\x89\xf4\x11\x7d\x08\xe2\x49\x8c\xfe\xb7\x52\x62\x01\x1a\x29\xdc\xb0\x20\xc7\xb7\x93\x40\x73\x15\xb0\xec\x1f\x8c\xca\x72\x92\x2c\x83\x3c\x8b\x1a\x85\xe9\x24\xd0\x36\xfd\xab\xd9\x01\x46\x44\xb4\x1d\x4e\x55\x89\x61\xa6\xed\xdf\xf6\xf0\xcd\x4b\xb4\x10\x0a\xea\x34\x5c\xc7\x04\x67\x8d\xce\x10\xcc\x85\x07\x00\x9c\x1b\x0c\xf3\xab\x2b\xc4\x21\x2d\xff\x67\xb0\x18\xb5\x0f\xf5\x63\x71\xf8\xf3\x21\xd8\x32\x3a\x61\x2d\x68\xe8\x8b\x0e\xdc\x16\xfd\x45\x3e\xf4\xd7\xd6\x05\xe6\x01\x59\xac\x5d\x8e\xdd\xa3\xff\x55\xa9\xed\xff\xb2\xfd\x6d\xeb\xa6\x98\x7a\x2f\x84\x01\x73\xeb\x42\x2d\x64\x57\xb6\xb0\x49\xf1\x5a\xc6\xcc\x26\x13\xe3\xc1\x82\xa4\x12\x8f\xd5\x9b\x95\x96\x3b\x3c\x51\x03\x91\xdf\xf0\xc5\xa4\x80\x88\x6d\xbd\x84\xd8\x2e\x69\x28\x70\x5b\x1d\xb6\x11\x02\x35\x86\x60\x8c\xb0\x57\xf4\x33\xc4\x5e\xf4\xd8\x15\x70\x6f\x5b\x7f\x82\xf4\x26\x4d\xbd\x26\xca\x7a\x8f\xc0\x19\xfa\xf9\xad\x12\xe7\x01\x41\x1e\x0c\x28\x4e\x5d\x9c\x48\x2c\xa4\xe1\x2d\x1c\xf0\x98\x91\x09\x4a\xac\x63\x74\x08\x5c\x84\x2b\xf5\x4b\xe8\xfb\xcc\xc4\xc2\x23\x18\x9f\xde\x29\x5e\xee\x9a\x87\xde\xfd\xbe\x66\xf1\x26\x0d\x07\x80\x8c\x82\x5a\xda\x56\x7b\x0e\xe9\x5d\xf3\x1a\x7d\x19\x10\x36\x3e\x91\x1c\xc7\x02\x08\xfd\x7d\xbd\x3e\x60\xe7\x17\x60\x7d\x36\xb3\x74\x14\x3c\x40\x53\x8a\xcc\x09\xbd\x4e\xc4\xbc\x6e\x57\x4e\x25\x31\x67\x18\x23\x6c\xa1\xeb\xbf\xe7\xdb\xe2\x67\x91\xa8\x39\x1f\xf8\x0f\xbd\x26\xae\x2f\xd6\x62\x36\xc3\xb7\xc1\x37\x12\x8f\x7c\x35\x40\x62\x30\x43\xf4\xc1\x25\x9b\x10\xdb\x44\x51\xe4\xdf\x7a\x20\x2c\x3e\xdd\x0a\x2f\x94\x75\x8d\x60\xc9\x85\x53\x48\xd7\x0b\xec\xda\x2f\x79\x3a\x9d\x8a\xaa\x76\xfd\xba\x46\xb9\x0b\x24\x12\x26\x08\x61\xd9\x83\x07\xa2\x6a\xbc\xd5\x84\xdf\x6c\x0a\x3d\x0e\xae\xd5\x7c\x66\xb5\xd8\x48\x63\xec\x72\xae\x75\xdd\x19\xba\xaf\x53\x8e\x05\xe2\x73\x2f\xec\xc1\xbf\xae\xb8\x6c\xc6\x85\x94\xf4\x30\xe6\xb1\x8f\x73\xb7\x73\xdb\x97\xb8\x14\x3d\xf3\x5f\x90\x7b\x46\x0d\x3c\x28\xf1\xc4\x71\x04\x47\x51\x77\x61\xe1\xbd\xfe\xfc\xa7\xa1\x0e\x63\xf8\x40\x17\x98\x28\x2e\xde\xaf\x77\x2c\xdb\x99\xaf\xf5\x97\x0f\xb0\x4c\xa8\x17\xea\x32\x81\x8b\x66\xad\x98\xfb\xe1\xf0\x7e\xf8\x75\xa6\xfb\x62\xdf\x0d\x6e\xf3\x1f\xf6\x86\x7b\xa9\x10\xb6\x8e\x87\xda\xb7\x57\x6c\xac\x64\xcf\xfa\x83\xf9\x0a\x5c\xb2\xc6\x57\x36\x10\x60\x32\x00\x03\xaf\x38\xe1\x93\xdc\x1b\x2b\x31\x7e\x7d\x10\xff\x88\x70\x41\x3d\xf9\x41\x4f\x6f\x91\xbe\xba\xbf\x07\x21\x79\xe1\x1f\x49\xa8\xd2\x93\x7a\x10\xed\x5e\x58\xf0\x6f\xa3\xc7\x85\x18\x7d\x5c\x29\x71\x68\xa7\x7c\xe8\xc8\x9b\x80\xec\xd8\xc5\x1c\xf1\x37\x3c\xc2\x1f\x52\x6c\x54\x73\xe4\x21\xce\x11\x40\xc1\xb0\x21\x6e\x8b\x1a\x32\xc4\x27\xa3\x70\x95\xae\xd7\xe1\xdd\xbf\x1f\x8b\xa6\x87\x6a\x11\x7f\x16\xf6\xd8\x6f\x93\x0f\x9f\xfd\xa5\x0d\x10\xd4\x98\xaa\x16\x02\x2a\x01\xad\x07\x14\x15\x20\x5c\x42\x93\xd2\x20\xf1\x9d\x12\xd6\xf0\xd9\xb6\xdb\x7f\xf2\x07\x91\xa9\x65\x84\xf4\x9f\xf5\xd0\x42\x74\x23\x30\xe4\x2d\x5f\x1f\xde\x89\x98\x62\x4e\xbf\xf3\x2b\xcc\x2e\xd2\xb9\x2b\xbb\x38\x48\x6e\x96\x05\xe7\x24\x88\xd4\x80\x75\xd6\xaa\xae\xaf\xc8\xbc\x04\x40\xff\xdc\x16\x5c\x88\xd3\xb0\x05\x27\x51\xdc\xfb\xe7\xc6\x28\xb0\xfc\x3a\xc0\x60\x7a\xf6\x51\xba\x5e\x63\x36\x2a\x78\xbe\xc2\x35\x16\x8e\x4e\x1c\x4f\xf8\x5b\x56\x80\xf5\x80\x14\xed\xaa\x51\xea\x68\x2b\x89\xa0\x13\x73\xd5\x6e\x95\xaa\x04\x77\x45\x23\x07\xcb\x89\x47\x7a\xa0\x54\x24\x62\x1a\x4e\x94\x53\xf5\x83\xa7\x88\x13\xbe\xef\x06\x93\xea\xe8\x20\x0d\x92\x1d\xdb\xe5\x05\xcc\x77\x73\x23\xbc\x07\x3b\xbf\xe7\x5f\xce\x53\x4b\x63\x54\xd3\x62\xff\x1f\x77\x1b\xe2\xf5\x23\x20\x8e\xa4\x38\x3c\xd2\x76\x74\x37\x06\xde\x19\xf2\x69\xb4\x10\xc0\xc2\x75\x0e\x9c\x3e\xc8\xe5\xf8\xe9\x83\x3e\xff\xb1\x18\x99\x91\x87\x01\xdc\xc0\x18\x0a\x7e\x42\x67\x12\xd8\x70\xfe\xdb\x52\x04\x2b\x65\x1c\xfe\xb1\x6c\xae\x63\xdd\x14\x38\xa2\xd8\x93\xaf\x6a\x4b\xa5\x43\xc6\x80\x2d\xdd\x44\xb2\xf6\x8d\x6f\x23\xe1\xe2\xad\xf3\x98\xfd\x42\x64\x1c\x1f\x65\xe2\xef\x4f\xf1\x7c\x46\xa6\x1f\xb7\x07\xc6\x99\x4e\xa7\x4e\x89\x17\xbd\x72\x2c\x9a\x8f\xe5\x16\xd8\x47\x66\x93\xef\xa5\x14\xd1\x63\x9c\x34\x0d\xdf\xd2\x96\x77\x84\x7b\x49\x89\x54\x77\xd0\xf7\x81\x27\xf4\x8b\xa0\xf1\x85\xf0\xe6\x05\x2d\x9c\xc0\x4d\xb1\x9c\xe7\x91\x30\x1c\x3f\xfa\x53\x7b\x00\x6d\x27\x61\x7f\x62\x96\xe8\x4a\xed\x1e\x3e\x85\x87\x25\xb9\x2e\xa0\x71\x75\x9e\x1b\x0d\x0a\x6c\x1e\x3a\x57\x55\xaf\x4d\x74\x1e\x31\x9f\x64\x19\x55\x08\xc7\x05\x02\xe8\x18\x8f\x92\x47\x6e\x78\x5e\xfd\x38\xe7\xf8\x57\x1a\xca\x8c\xba\xc6\x94\x56\xc1\x58\xcb\xa1\xf0\x08\x7f\xdf\x89\xbc\xc7\x0e\x63\x7b\x34\x3d\x98\x7e\x80\x49\x09\x20\x32\x69\x48\xeb\xb3\x52\xf1\x4d\xc0\xe7\xeb\xd4\xfd\x74\x56\xb9\xbe\x40\x57\x98\x8a\xd2\xfd\x46\x0b\x44\x55\xfc\xee\xcb\x93\x52\x30\x77\x63\xb5\xf1\xff\xff\x90\xb6\x27\x86\xc8\x7e\xd0\x4b\x70\x73\x54\xd3\x97\x9f\x0c\x9c\x49\xd8\xf2\x6b\x0d\x2e\x1c\x11\xdd\x71\xc2\xed\x17\x2c\x45\xe9\xa4\x52\x2c\xfb\x12\x97\x74\x0c\x48\x70\x62\x22\x67\x88\x96\x88\x83\x42\x0d\x52\x16\x81\x6a\xe7\x70\x04\xf2\x65\x67\x82\x8a\xf4\x1b\x04\xea\x4c\x92\x2c\x59\xa6\xcf\xbb\xc9\x1a\x91\xb9\x10\xc4\xb1\x08\x91\xb4\x39\xee\x85\xf5\x5d\x1a\x66\x2c\x00\xa3\xc5\x1e\xb7\x4e\xad\x14\x87\xed\x1e\xac\x8b\x21\x0b\x18\x09\x3d\xfb\xdb\xb9\x3c\xfa\xc7\xf3\xa3\xff\x79\x71\xfe\xf3\xf6\xe8\xe2\xab\x87\x14\x0a\x89\x22\x18\x30\x51\xbe\x13\x25\xbf\xa9\x30\x01\x61\xbc\xe0\x63\x71\x38\x12\x8f\x31\x8a\xc2\x63\x31\x3a\x9c\x8a\x17\xf1\x86\x70\x69\xb4\x17\x81\xe5\x75\x05\xc1\x74\x65\xb9\x59\x49\x30\x3c\xd0\xb9\xc8\x57\xb2\x91\x39\xd8\xb2\x82\x92\x63\xa5\x28\xd1\xd0\x24\xd2\xf3\x54\x05\x86\x14\xc5\xbc\x26\x20\x1e\x93\xa2\x44\x65\x2e\x13\x6c\xdf\xfa\xa8\xfe\x21\x91\x1a\x6d\xc2\xcd\x8d\x13\x29\x46\xc1\x69\xef\x58\xfb\x4b\x4c\x2f\xd4\x19\x15\xd2\xb5\xd5\x4d\x88\x51\xfb\xdd\xc7\xb7\xdf\x07\x19\xb5\x34\x83\x54\xfd\x48\x17\xc7\xc2\x6d\x16\x9f\x68\x3f\xce\x79\x59\x12\x1d\xed\x78\x8d\x5d\xd5\xca\xcf\x40\x55\x57\x75\xb3\x86\x2c\x51\x85\x17\x9f\x86\x6c\xa4\x47\x73\x49\x6e\x43\x6b\x99\xea\x70\x7d\xc3\xf7\xd0\x73\xe6\xe9\x3e\xc7\x4d\x39\x93\x1c\xc3\xa2\x6e\xc0\x6f\x2f\xd5\x87\x5f\x49\xf8\xca\x34\xd9\x09\xb0\xcf\x14\x73\xd4\x2e\x74\xd8\xac\x17\x7b\xf2\x5c\x1c\x19\xbb\x0c\x7a\x0a\xb0\x8c\x33\x4e\x07\x0f\x75\xbd\x38\x8f\x94\x31\x49\x16\x48\xca\x34\xcb\x79\x5c\x0a\xf5\xe1\x72\x7a\x65\xd7\x4c\x27\x6f\x31\x83\x39\xb7\x35\x2e\xc0\x71\xcf\x76\x87\xd1\xa0\x5d\x58\xa6\x01\x8e\x17\x60\x64\x84\x7b\xe6\xc2\xdc\xe2\xb8\xa4\xa8\x40\x7b\x4d\xd4\x3d\xe0\x19\x52\x64\xd1\x84\x2d\xde\x27\x29\x8c\xb7\x29\xc5\x17\xee\x38\x06\xf6\x26\x88\x2f\xfb\xcb\x0e\xda\xe8\x78\xd1\x3d\x0b\x37\xce\x16\x5d\x47\x1c\x91\xdb\x1f\x3b\xe4\xed\xc9\xb0\x3c\x86\x5f\xa0\x43\x87\x3c\x90\xed\x5d\x78\xb6\x43\xfc\x7c\x08\x13\xfe\xf9\xf0\x98\x32\x33\x5a\x8e\xd1\x67\xe2\x69\x82\x75\xda\x84\xc9\xc7\x0f\xe7\x5d\x2b\x96\x35\x46\x48\xc9\x7c\xb2\x59\xbf\x5d\x8f\xc5\xe1\xf4\xd0\x31\xcd\xc4\x32\x7b\xa4\x10\x81\x36\x90\x42\x86\x4b\xc4\x7f\x70\xd7\x04\x6e\x21\x3e\xf7\xc0\x0a\xc1\x6d\x1b\xb8\x66\x7b\x6e\xd9\x9b\x8a\xf2\xca\x0e\x5d\x33\x47\x45\xf8\xc9\x60\x81\xbf\x68\xf8\x73\xe0\xa6\xb1\xdb\x9f\x36\xf6\x37\xb0\x7f\xdb\xa8\xbb\xbb\x42\xd7\x62\x95\xe1\xc0\xb5\x61\xd0\x73\xac\x46\xd9\x35\x7f\x05\x71\xf4\xb1\xf0\x85\x03\xb0\xd0\x87\xe9\x7d\x93\x21\xa0\xf6\x4b\x67\x22\x32\x84\x6c\x1a\x26\x02\xed\x30\x33\x52\xcc\xdf\x09\xc4\x44\x99\xb8\x79\xdb\x11\x6f\x51\x27\x1f\xc1\x36\x7e\xfd\x67\xc0\x36\x4e\xf9\x9f\x03\xdc\x7e\xdf\xee\x80\xee\x21\x30\x6e\xe4\x96\xc5\x85\x08\xb1\x33\x00\x9e\x89\x10\xbe\xfb\xc1\x78\x19\x1a\xc5\x04\x0c\x90\x60\xba\xe1\x0f\x46\x18\xc0\x81\xa2\xad\xb0\xef\xb0\xf9\x37\x2f\x0d\x15\xa7\x50\x1e\x1d\x34\xc3\xf0\x50\x63\x8f\x58\x4f\x84\x96\x00\x9f\x73\x5d\x15\xc7\xb6\xc5\x84\x9c\x5e\xe0\x07\xc3\xe8\xb1\x64\x32\xc4\xf6\x48\xc8\x6b\x24\x9f\x26\x64\xab\xed\xaf\x32\x99\x45\x0f\x19\x06\xff\x36\x38\x39\x0c\x24\xd4\x61\x0a\x2d\x5f\x0e\x21\x6e\xec\x2f\x02\x10\x4c\x7a\xd6\x6e\x6b\x37\x8b\x3a\xce\x79\x06\x06\xe8\x68\x28\x2e\x5e\x58\x3e\xb9\x6b\x21\xa1\xa0\x70\x79\x35\x40\xe9\x81\x32\xa3\x56\xa3\xae\x52\x42\x88\x98\x95\x6a\x34\x08\x92\x86\x44\x45\x4e\x4b\x1b\x78\x24\xcf\x20\x45\x72\x5e\xdc\xbf\x84\x7e\x26\x9e\xef\xc4\x89\x2b\x19\x54\x10\xf3\x3a\x08\x17\x11\x63\xeb\x22\x96\xf9\x4e\x62\xba\x28\xf3\x3a\x5f\xf2\x22\x8f\xd0\xf9\xbe\xaf\xe1\x86\xb0\x29\x82\x71\x00\x60\x1c\xf3\xba\xa9\xd7\x7e\x02\x54\xe6\xee\x07\xab\xe2\x26\xec\xd9\x67\xbe\x65\x5e\x93\xcb\x1a\xf4\x18\x3d\x1c\x61\xad\x3f\xeb\xaa\x7f\xe9\x98\x6d\x02\xaf\x77\x87\x89\xc2\x7d\x53\xe1\xdd\x60\x56\x9f\x75\xa2\x9a\x8c\xa3\xd4\x05\x12\x91\x5c\xa1\x61\x6e\x8e\x9c\x89\xe2\x7a\xc1\x78\xaf\xb5\x2a\x0b\xc6\xe7\xc4\x0d\x48\x02\xc0\xb4\xf2\xa4\xa6\x75\xd3\x8b\x55\xb5\x03\x3d\x86\x79\xc6\x50\x0a\xb5\xa2\xb8\x9d\xde\x20\xc1\xdb\x0e\x20\x96\xb9\xb9\x89\x98\x50\xec\x94\x16\xec\xf0\x90\x61\x8a\x78\xca\x40\x05\x53\xa7\xbf\x9d\x79\x41\x6a\xc2\x4f\x91\xf5\x6e\x59\xaa\x3a\x53\x97\x98\x61\xd9\x22\x28\xc0\xd7\xe8\x12\xc6\xd3\x22\xc1\xfd\x74\xde\xd7\x08\xfa\x4e\xb2\x8b\xa1\x9a\xc9\xfa\x05\x6c\x45\x6b\xec\xc9\x08\x67\xfd\x6e\x5f\x60\xcb\xc2\xd8\xda\x2d\xa4\x05\x97\x3a\x4d\x1d\xdc\xe0\x3c\x40\x68\x89\x7e\x01\xc1\x9b\x1e\xa2\x3f\x58\x60\x84\xeb\x6c\xf1\xdf\x5b\x0d\x3e\xdd\x07\xde\xff\xe8\x2e\x01\x1c\xdd\x66\x88\x56\xd8\x27\xe2\x7b\xd6\x59\x34\x79\xff\xfc\x80\x6c\x15\x1e\x0d\xaf\x71\x2d\xeb\x5c\x96\x94\xe0\x04\xb3\x20\xda\x96\x9a\xe2\x1a\xb8\x98\x44\xcc\x27\x05\xfb\x9c\x08\x5d\xf0\xfc\x54\x58\x7a\xae\x8b\x0b\x3f\xb8\x23\xa8\x0b\xf0\xa7\xf7\xe4\xb5\x2e\xc6\x27\xc3\x3d\xb2\x06\x03\x5d\xb3\xaf\x61\x8c\xf7\xd2\xe4\x12\x92\xf4\xd2\x20\x2e\x47\x70\xc6\x3b\xdb\x33\x1e\x6f\x3c\x30\x20\xff\x8c\x23\xce\x66\x62\x21\xcb\x72\x2e\xf3\x2b\x50\x31\xc7\xde\x67\x11\xb3\xc7\xf6\xe3\xe6\x66\x70\x09\xa1\x38\x1a\xe8\xe4\x4b\xed\x0f\x39\xf0\x80\xcc\xb1\x51\x66\x90\x39\x7f\x2d\x21\x27\x25\xa8\xf0\xf1\x82\x40\x70\xb7\xdd\x46\x91\x3f\xf5\x93\x09\x64\x05\x7f\x2c\x46\xc8\x7f\x23\x6c\xb2\x8b\x1a\x33\x08\x69\x3a\x4d\x34\x96\xea\xc9\x57\xec\x73\x01\xd0\x4f\xee\x30\x96\x9f\x60\x61\x25\xbc\x65\x51\xf4\xac\x39\x1e\x9b\x18\xb5\x77\x0c\x51\x38\xcc\x28\xe7\x06\x31\xae\xc7\x66\xbe\x27\x8f\x26\xb8\x53\x80\xff\xec\x7b\x99\xcd\xc4\xbc\xae\x4b\x25\x2b\x91\x4b\x83\xfe\x84\xd0\x84\x4a\xdf\x50\x36\xf5\xa5\x02\xe2\x06\x0d\x24\xff\x8c\xdf\x26\x98\x41\x07\x94\x16\xee\xa8\xa2\x66\x3c\xc3\x3a\x38\x27\xe1\x74\xed\x01\xb1\xf9\x4e\x40\x83\x69\xd1\xe2\x68\x1c\x71\xa8\x9d\x4a\x82\x8b\x07\x36\x81\xbe\x5a\x48\x18\x05\xe7\x32\xfb\xdb\xa7\x8d\xce\x62\x8c\x0e\x01\x49\xca\x1d\xac\x52\xa8\xf5\xa6\xdd\xb9\x24\x2d\x33\xcc\x2e\x09\x34\x55\x5b\xfb\xed\xd0\x8b\xd0\xd2\x95\xad\xa4\x11\x2b\xbd\x5c\x29\x30\x3e\xad\x1b\xdd\xee\x18\x3d\x8a\xfd\x0d\xee\x18\xc6\x73\x49\x37\xcc\xed\x0b\x6f\xf8\x8d\x78\x62\x17\x14\xed\xe3\x37\xbc\xeb\x71\xec\x66\xd0\xed\x09\xf8\x4f\x00\xea\x71\x1b\x6d\xb1\x77\x31\x89\x77\xb1\x17\xeb\x9e\xb9\x91\x58\xe0\x25\xb1\xea\x27\x5b\x0a\xe6\x03\x78\x70\xe1\x6d\x9c\xcd\x28\x09\x36\x18\x4e\xf3\xb1\xc8\x74\xa5\x51\x66\x05\x4e\x98\xde\x9e\x06\x72\xc9\x82\x0c\x0b\x98\x0b\x74\xbb\xd3\x98\xc3\x87\x80\x5f\x5d\x9f\x25\x4e\xf6\x91\x93\x9e\x33\xd1\x8a\x7c\xf4\x33\xbb\x19\x34\x2b\xe6\xde\xe4\xcb\xd2\xea\xbd\x51\xfc\xe5\xe6\xe2\x73\xbb\x09\x99\x5f\x34\xa3\xee\x27\x74\x09\x53\x94\x80\xfb\x7c\xcb\x52\xbe\xb6\x03\x7b\x83\xa9\xf7\x2d\x50\xa4\xe9\x5d\x7b\xdb\x2e\x92\x7d\x77\xea\xb4\xaa\x76\x7d\x4e\xdc\xd8\x5c\x48\x1d\xd1\x3a\x7b\x6e\xdb\x40\xb3\xdb\x03\xce\x63\xc1\x0c\xa9\x19\x61\x0d\x88\x47\x2d\x97\xd2\xd2\x0b\x10\xcb\x7d\x41\x61\xb3\xa8\x1a\x06\xa6\x22\x7f\xde\x47\xc8\xc7\x7e\x31\x3a\xf7\xee\xa3\x85\x5a\xdc\x2d\x61\x8e\xb7\x13\xfd\xf3\xea\x0d\x0a\x9a\xd1\x9e\x72\x74\x78\xcc\x05\xab\x28\xd1\x44\x4b\x49\xfb\x5c\xe1\x40\x33\xe4\xb3\x41\x30\x67\x69\x21\x29\xdc\x72\x06\xcc\x35\x46\xc1\x02\xac\x77\xa2\xc1\x00\x23\x95\x25\x51\x74\x41\xcb\x62\xc3\x14\x71\xc6\x90\xc8\xbd\x34\x75\xd8\x7c\x4c\xff\xeb\xad\xb5\x30\x10\xd3\x04\x3b\x70\xf6\x1b\xee\x63\xbc\x7a\x4b\xa1\x81\x71\x71\x57\x79\x1b\x7f\x1f\x67\x90\xfc\x00\xdc\xcd\x5f\xbb\x08\xb9\x91\x88\x8b\xfc\x46\xf1\x06\x0e\x7d\x0c\x91\xed\xc3\x7c\x43\xfd\xcb\x20\x50\x84\x63\xe5\x00\x95\xc0\x5a\x5c\x9b\x21\x2b\x59\x96\xfd\xbd\xb7\xe7\x6a\x61\xec\xb5\x37\x6a\xdb\x6d\x40\xa7\x0e\x99\x04\x3c\x9a\xf1\x9f\xf5\x02\x28\xd3\x40\x94\x70\xba\x57\x5d\xab\x8a\x42\x5e\x89\x42\x2f\x20\x2e\x55\x2b\xd4\x67\x95\x77\xe4\x29\x0e\x11\x93\x7a\x39\x22\xfa\xc2\x04\xbb\x87\x84\xe6\xb3\x80\xd9\x11\xa2\x5f\x47\x66\x58\xcf\x6c\x6b\x67\x7c\x35\x26\x5d\x51\xa1\x16\xdc\x11\x1c\xf0\x8c\xd8\x52\x42\x44\x44\x0b\x76\xe2\x00\xe8\x09\x7e\x08\x48\x09\xb9\x63\x7b\xb1\x0f\x50\x7c\x4b\xee\xb6\xe8\x7d\x89\x1a\x44\xc4\x50\x4c\x89\x08\x93\xf5\xf9\x55\x1e\x3d\x72\x38\x6c\xe8\xa6\x39\xb2\xca\x57\xdf\x40\x4e\xb5\x58\x95\x33\x08\xf5\x03\xfe\x02\xee\xc5\x41\x69\xb9\x25\x04\xa2\xa9\xec\x25\xde\xe1\x10\x4f\xc3\xf3\xc9\x08\x1b\x88\xce\xfa\x00\x2a\xdc\xdc\x50\xc5\xd3\xf0\x24\x22\x67\x8d\x22\x12\x7b\x50\xc6\x87\x7e\x76\x3c\x44\xc4\x15\x26\x6e\x22\xdc\x4f\x4a\xb8\x59\x04\xce\xc1\xbd\xb6\x43\x52\x52\x20\x2a\x43\x22\xe2\x07\x30\xd5\x84\x63\x76\x7c\x49\x43\x93\x23\x62\xb9\x69\x83\x8c\x66\x02\x1d\x85\x44\xee\x22\x5e\x0d\x06\xee\xe4\x7d\x4c\xf9\x77\xbb\x25\xa3\x11\xcb\x32\x8d\xdb\x15\xd5\xc7\x89\x45\x54\x03\x5a\xce\xd9\xf2\x3b\xa5\x53\x08\x09\x21\xaa\x97\x58\x20\x79\xed\xd1\x70\x2a\xad\x9a\x32\xc1\x94\x78\xe5\x04\x57\x20\x9a\x8a\x57\xb5\x96\x9b\x2c\x30\x30\x3e\xe7\xb8\xb0\x6f\x96\xef\x61\xf2\xbb\x04\x5b\x03\xd0\x45\x64\x7a\xed\x1c\xa9\xa7\xbe\xe0\x24\xc0\x2d\x16\xc4\x86\xfd\xae\xb4\xe7\x0d\x9c\x58\x04\xc6\x1b\x46\x69\x41\xc3\xa8\xc3\x9b\x97\x28\x4b\xf7\x58\xf6\x89\x34\x71\x30\xe6\xc6\x83\x5c\x84\x98\xdc\xe2\x6f\x44\x7d\xde\xfc\xd0\xad\xe7\xaa\xb9\x21\xea\xfd\xc6\x61\xa8\x1b\x4a\x41\xf3\x70\x76\xd2\x13\x6b\xa2\x40\x93\x01\x62\x60\x4d\x3c\xd8\xa4\x37\x2c\xd0\xbe\x59\xc4\x1a\x44\x13\x43\x0d\x32\x6f\x16\x05\xee\x69\x59\x9a\x02\x17\x3a\x28\x80\x2f\x86\x79\xe4\x8d\xd3\x5c\x6c\x44\x62\x2e\x22\x2f\xb1\x6d\x23\x37\x1b\x6f\xd1\x61\x92\x73\x04\x93\x50\x44\xf0\x14\x49\x29\x62\x46\x60\xe0\x5e\x0c\x0b\x87\x8b\x7a\x7a\x8c\x78\x43\x6c\xa7\xef\xe2\x4e\x5d\x97\x43\x22\xe1\x93\xfb\xfa\x02\xec\xd4\xeb\x6a\xc8\x41\xfd\x24\x55\x47\xdc\xbb\x14\x46\xc3\xb2\x16\xc7\xf8\x0f\x65\x76\x64\x13\x3a\x8e\x7e\x25\x82\xe3\x1f\x4d\x30\xef\x77\xec\x96\xe3\xb3\x10\xe8\xbd\x8a\x1c\x5e\x74\x08\x52\xe2\xe4\x51\xd2\xa5\x79\x54\x7f\xef\x24\x88\x93\xb1\xc9\x56\x03\x89\xa0\x4b\xd4\x9b\x36\x5d\xe5\xc2\xc3\xf4\x1e\xf5\xeb\xb5\x11\x33\xa1\x17\x8d\x5c\xab\xd4\x1a\x91\x60\x34\x4e\xfb\xed\xd3\x41\x54\x90\x2e\xa2\x62\x99\x9e\x5c\x76\x88\xbf\xfd\x6c\xbe\x0a\xc6\x23\x3f\x6f\x1f\x8f\x67\xdc\x27\x13\x7b\x78\xe6\x73\x43\x60\x1a\x10\xae\x30\xd0\xe6\x4c\xae\x15\x8e\x2d\x27\x62\x1e\x19\x9b\xb8\x9b\x43\xd9\xd8\xdd\xcf\xf9\x38\xea\x82\xf3\x96\xf8\x88\x4d\xe2\x67\x81\xe9\x1c\x12\xc7\xaf\xa8\x56\x42\x94\x85\x99\xc5\xf5\xdc\xd5\x7f\x26\x9e\x88\x63\x71\xf4\x94\xcb\x57\xb9\xb0\x18\x42\xf9\xc5\x88\x3c\x92\x17\xab\xaa\x97\xed\x7d\xdf\x90\x20\x21\x4e\x9e\x5f\x37\xcb\xe8\xa9\x4a\x52\xc0\x27\x02\x18\x74\x3f\xc3\x2c\xd1\x99\x82\xb4\xf4\x90\x58\x65\x51\x87\x1d\x8a\x73\xe8\xe4\x5d\x03\x49\x02\x22\xbb\x82\x0c\x4b\xf3\xae\xf1\x29\x25\xe2\x47\x7c\x05\x36\xcd\x54\xc3\x51\xcd\x51\x92\x72\xce\xef\x43\x6d\xce\xc7\x0f\xd1\x0f\x50\x69\x58\xc9\x0a\xb4\x88\x4f\x42\xe8\x5f\x05\x90\x33\xc2\x68\xe2\x14\x9b\x9f\xeb\x0b\x24\x3a\xf3\xae\x99\x88\x64\xfd\x3e\xe8\xc9\x49\xd4\x0d\xa8\x06\xb0\x9b\x81\xec\xa3\xb0\xf5\x3c\xbb\x5f\xd4\x16\xe3\xa0\x7f\x17\x36\x3d\x53\x90\xef\x61\x92\x64\x6c\x87\xd9\x8d\xbe\x28\x36\x86\x3b\xe7\x81\xae\xa3\xe5\xc4\x61\xc9\x7b\xb5\xf7\x9e\x3e\x59\xf7\xf0\xfc\xe0\xde\xf7\x97\x6d\xb1\xb3\x05\xeb\xd7\xe6\xf9\x90\xe2\x31\x0e\xf6\x6d\x56\x59\x2f\xfd\xee\x60\xc3\xd1\x40\xc7\xa3\x44\xed\x10\x5a\xdd\xb1\x70\x57\x69\x70\xbd\x91\xd3\x35\x56\xa3\x2c\x0d\xb6\x8e\x25\x99\x8e\x89\x64\xb3\xad\x79\x96\x3b\x24\xdf\x0e\xc7\x91\x12\x69\x40\x3e\x0f\x0f\x8d\x97\xd0\x87\xc4\x99\xe0\x0b\x0e\xe9\x10\xc7\xdc\xf5\x62\x38\x47\x97\xd7\xc5\x45\x59\xa1\x54\xd3\x0c\x45\xd2\x6b\xea\xad\xdd\xf6\xc4\x5f\x75\xf6\x15\x81\x80\x11\x6f\x31\x1d\xfe\x8b\x95\xac\x2a\x55\xfa\xe0\x94\x39\x09\xaf\x1d\x3f\x00\x02\x27\x55\x15\x94\x1d\x12\xef\x45\xd8\xd7\x45\xd9\x99\xd5\x0b\xdf\x86\x7c\x83\x7a\x0d\xe8\x16\xd6\x1b\x0d\x8c\x86\x1f\xc4\x49\x97\x31\x52\xb0\x2f\xf5\xd1\x20\x9e\xec\x73\x55\xc4\xae\x86\xd0\x00\x7e\x39\xd7\x17\x19\xd3\xd7\xce\xc4\x77\xaa\x51\x62\xab\x30\x15\x06\xc4\x29\xb6\x7c\xa6\x6a\xe0\xed\x25\x0a\xc8\x90\xb1\x11\xa8\x65\xd7\x3a\x6f\xea\x56\x1a\xf2\xc9\xc8\xd6\xf6\x15\x1d\x0b\x28\x81\x24\x07\x6f\x2a\xf1\x8d\xf8\x7a\xfa\x2f\x94\x36\xb8\xe0\x2d\xd4\xb5\x6a\x76\xdb\x95\x6a\xd4\x44\xcc\xbb\x96\x12\x1e\xc8\x46\x61\xec\x4e\x93\xab\x4a\x36\xba\x06\x13\x27\x4c\xe1\xc9\x1a\xc3\x14\xdb\xba\x06\x91\x2a\x30\xbc\x28\x52\x85\x79\x2c\x74\x03\x3e\x2f\xce\x0d\x01\xb2\x9c\x1a\x55\x60\x6c\x69\x03\xc9\x60\x5a\x2d\x4b\x8a\x7d\x2b\x32\xc8\x0e\xfe\x87\x7f\xf9\xe3\xd7\x4f\x27\xe2\x0f\x7f\xfa\xd3\xbf\x3f\x19\x8b\xba\x41\x66\xdf\xf5\x31\xef\xe6\xf3\x12\xe2\x9e\x61\x68\x13\x23\xd7\x30\x27\xe8\x42\x64\x7f\xf8\xd3\x1f\xff\xf4\xa7\xf1\x54\x7c\x57\x6f\xed\xba\x26\xb4\x4b\xd1\x8e\xb0\x15\xa3\xdc\x66\x25\x21\xb5\x48\x5b\x82\x77\xf8\xbc\x54\x6b\x08\xa4\x0d\xa4\x89\x69\x25\x26\xa8\xc7\xe0\x0b\x85\x68\xf4\x72\xd5\x52\x3e\x53\xd1\xa8\x8d\xd4\x76\x5c\x9c\xf9\x9f\xfe\xed\xe9\x7f\x9f\x88\xba\x43\x6a\xa8\x91\x95\x41\x97\x9e\xf1\x94\x1f\xab\x25\x8c\xfc\x16\x52\x78\x3c\x94\xfd\xd9\xed\x57\x90\xd8\x5c\x48\xb1\x95\x3b\x4b\x63\x2d\xea\x26\x57\xd1\x02\x30\xe8\xe3\x6c\x06\xda\x3d\x55\xd0\xe0\xba\xa2\x3d\xc0\xf7\xb2\x61\x41\x4a\xe7\x3b\x71\x7d\x54\x57\x63\xcc\xa2\x0b\x43\x7f\xd4\x6b\xd5\x58\xee\x01\x6f\x0d\xf4\x9e\x94\x75\x46\xbd\x85\x62\x3b\x64\xb8\x4e\xb3\x99\x78\xa9\x5a\xd5\xac\x75\x95\x4c\x0b\x60\x54\x00\xbd\xb7\x56\x95\x0b\xd3\x3a\x9b\x89\x8f\x2a\x5f\x55\x14\xf4\xd9\xa8\xf6\xcd\x7a\xad\x0a\x6d\xb7\x35\xf8\x54\xd9\xa3\xd4\x85\x92\xa5\xc8\x57\xb5\xce\x09\x14\x75\x3b\x32\x28\xf2\xf7\x29\x29\x0f\xd0\x27\xf5\xcd\xab\xa9\xf8\xb8\xa2\x74\x1c\x71\xd6\x49\x8b\x72\x20\xc7\x6d\x5b\xee\xc4\xdf\x3b\xd5\x29\x0c\x9e\xe3\xae\xac\x90\x8b\x56\x35\x60\xee\xf5\xf2\xdd\x5b\x0f\x3b\xc6\x89\xd7\x50\x2b\xea\x60\x4b\x94\x35\x8a\x70\xe6\x3b\x82\xa5\x18\x19\x0d\x06\xe4\x47\x15\x27\x53\x70\x46\xab\xbe\x2b\x37\x27\xaf\x48\xf4\x49\x7c\x36\x03\x71\xfc\x45\xd4\x7d\x16\xa3\x39\x44\x2c\x3e\x75\x27\x9b\x53\x82\x54\x07\x66\x65\xb9\x5f\x3f\xb3\xb8\xfa\x58\xdc\xdc\xa0\xec\xee\xfd\x4a\x56\x6d\xbd\xfe\xcb\xd9\x81\x48\xb7\x26\x3c\x3e\xc8\xf2\x9c\x93\xf9\x51\x5c\xed\x45\x70\x4e\xba\x18\x1d\x8c\x03\x21\x9f\xd3\xcc\x30\x96\x5c\xdc\x28\xf3\x7a\xb1\x4d\x0d\xd9\x24\xa8\xf2\xd4\xfe\xfc\x1a\x30\x34\x2b\x78\x3a\xad\xab\x35\xb6\xb7\xfb\x17\x6d\xd0\xc9\x97\x6d\xb1\xed\x66\xba\xa9\x4d\x4b\xf3\xc8\x9e\x26\x3b\xbb\x47\xdb\x1d\x32\x3d\x7f\xd9\x39\xda\x0a\x75\xd7\x26\xa7\x38\x11\x4f\xfc\x70\xc9\x15\x0c\x98\x64\xcf\xfd\x1b\x9e\xd3\x64\x20\x89\x6c\x02\xb4\xef\x9b\x7a\xad\xcd\xdd\xf0\x4a\x75\xd8\xa9\x6d\xc4\xa9\x6b\x39\x25\x05\x2d\x1e\x56\x8c\x77\x86\x37\x79\xda\xae\x54\x35\x04\xc1\xce\x17\x9d\xf0\x33\x44\xe1\xff\xf1\xcd\x4f\x6a\xfe\x49\xab\xad\x99\xf8\x11\x6d\x7b\x1f\x7e\x3e\xaf\xed\x5e\xb4\xaa\xdc\x61\x5c\x6d\x40\x2a\xbe\x33\x4c\x37\xb5\x54\xad\x30\x6d\x97\x5f\xa1\xa7\xfb\x56\xe9\xa6\x20\xa4\x8f\x2f\x44\x20\x32\xec\x93\xb8\xe9\xcc\x2a\x32\xc5\x26\x3d\x98\x3f\x04\x40\x38\xee\x1d\xa5\x5f\x1a\x66\x83\x59\x82\x60\x6d\x98\x29\xa2\xd5\x25\x54\x9a\x23\x7d\xe5\xfa\xf2\xe9\xb5\x8b\x1a\x5f\x60\xf0\xed\x02\x97\xab\x89\x00\x54\x8f\x18\x5e\x48\xc8\x30\xd4\x00\x22\x6c\xf0\x3d\xc2\x50\x28\xae\xa7\x43\x78\x3b\x0e\x61\x90\x74\x86\xe8\x63\x4e\xb3\xb1\xc8\x8d\x42\x33\xc9\x8a\x34\xab\xd8\x37\x63\xf2\xde\xbc\x3b\x83\x54\x80\x01\x46\x29\xff\xeb\x6d\xff\x1e\xc4\x86\x05\x00\xf9\x43\x10\x90\x3e\x3b\x41\xea\xf0\x53\x23\x37\xdc\xd1\xd6\xd4\x14\x7b\x7b\x01\xa9\x95\xf2\xba\x50\x2e\x58\xb8\x0f\x80\x86\xa7\x86\xef\xf4\xc4\x85\xd4\xc5\x9f\x78\x78\xb0\xf2\xc2\x59\x69\xc7\x8f\x97\xae\x4c\xab\x64\x81\xda\x3c\xbf\x59\x89\xbc\x61\xab\xdb\x55\x78\x13\xbd\xd4\xc1\xb9\xa2\x57\xd3\x4b\x5b\x03\x3e\xde\xdc\xd8\xef\xac\x60\x08\xde\x93\x27\x36\xa8\x80\x83\xe1\xc5\xa2\xa2\xb8\xf7\xc8\x64\xf8\x20\x93\x74\x2b\x86\x1f\x69\xd1\xf3\x5b\x8c\x65\x0f\x16\x01\x7c\xd4\xf9\x95\xc8\xf2\xf9\x44\xe4\xed\xe7\x70\x7d\x2f\xe9\xce\xc6\x44\x2e\x48\xa8\xfb\xf3\x07\xe6\x8b\x85\x61\x8c\x19\xda\x7c\x4e\x8c\x6b\xfb\x99\x45\xd9\x1b\xe2\x3b\x57\x09\xb7\xd9\x7e\x9e\x88\x91\x9b\x64\xcf\x82\x3e\xbc\x64\x6e\xb2\xa1\x2f\x57\xc2\x06\x75\xc1\xf6\x9c\x53\x03\xd2\xfb\x1e\xe5\x78\xf2\x3f\xec\xbe\xad\xc7\x37\x96\xd9\x94\x45\x00\x9b\xc5\x91\x3f\x7c\x25\x3d\x58\x29\x28\xc8\x06\xd2\x77\x3b\x7f\xc4\x39\x63\xad\xf6\xe0\xdf\x44\xe0\x63\xdf\x46\xaa\xc9\x4e\x68\xef\xc6\xa0\x05\xbc\x3b\x61\x81\x09\x02\x6f\xd3\x94\x5e\x6b\xd9\x5c\x11\x65\xa8\xa4\xe9\x1a\xc5\x32\x35\x6f\x54\xb3\xd8\x93\xe7\xdd\x7e\xaa\x9b\xb5\xac\x72\xe8\xfd\x1e\xe3\x2f\xda\xff\x66\xe1\xd4\x8f\xf6\xef\xa9\x1d\x3a\x2e\xc0\x19\x44\x65\x90\x14\xfb\xad\x6c\xae\xcc\x40\x31\xd6\x37\x4c\x61\x09\x7d\x46\x69\x87\xe4\x92\x09\x46\xfc\xb8\x50\x7e\xe2\xec\x85\xdd\xc0\xbc\x21\xda\x05\x83\x2f\xd2\x47\xb9\x9c\x08\x55\x15\x1f\xe5\x92\xd9\x4f\xb2\x19\xef\xab\x7c\xc2\xeb\x86\x95\x64\xae\xe2\xde\x0a\xfb\xdb\xd3\x92\xd1\xa5\x89\x0e\x96\x59\x00\xff\xef\x66\x6e\xb1\x00\xf6\x79\xe7\xa1\x43\x57\xba\x85\x12\x06\x15\x10\x04\x53\x15\xff\x41\x1c\xfb\x29\x98\x86\xbc\x95\x1b\x3c\xe5\xd1\x9b\x0a\xe2\x1b\xec\x26\x21\x08\xdc\x0f\xf2\x87\x89\x36\xaf\x6d\xb1\x9a\x58\x82\xe2\x87\x89\xd3\xcf\x8f\x36\xb2\x31\xea\x75\x59\xcb\x76\x02\x7f\xbe\xa9\xda\x49\xa1\x2c\xd6\xff\xf1\xc3\x9b\xf0\x97\x37\x15\x9e\xa8\xca\x7d\xf4\x7f\x85\x8f\xbe\xdb\xb7\xb2\x5d\x4d\x50\x85\x32\x79\x29\x5b\x35\x01\x81\xea\x84\x4c\xae\x9d\x49\x14\x19\xfa\x7c\x50\xcb\x57\x9f\x37\x93\xb7\x72\x33\x39\x53\xed\xe4\x2f\x67\xef\x7e\x98\xbc\xa9\xda\x32\x74\x47\x2a\xcf\x91\x53\x53\xfc\xa4\xe6\x1b\x99\x5f\xcd\xe8\x62\xe8\x05\x25\x16\xa2\x2d\xda\xca\xa6\xfa\xa1\xae\xde\x93\x53\x7f\x0c\x8f\x49\xc8\xbe\x44\x5b\xf7\xde\x87\xd1\x73\x79\x6b\x7a\xf1\xa6\xb4\x11\x18\x80\x0f\x35\xfa\x98\xb9\x29\x84\x3c\xb1\x14\x49\xd0\xdc\x8d\x7c\x2e\x27\x1f\x97\x0a\x8d\x14\xa6\xe2\x6d\x30\xe9\xb1\x0f\x2e\xc0\x89\x8f\x99\xa6\x8d\x0f\xed\x17\x79\xe9\x29\x0d\x34\x4a\x3f\xe8\x22\x04\xf9\x83\xf4\x66\xa5\x34\x86\x1c\x69\x82\xc3\xe3\xc4\x52\x1e\xdc\x65\xae\xd2\xad\x96\xa5\xfe\x07\xa5\xab\x0a\x71\x90\x79\xad\x33\xa5\x8e\xc5\xaa\x6d\x37\xe6\x78\x36\xbb\xee\xd4\x2f\x66\x5a\x37\xcb\xd9\xf5\xd7\xb3\x65\xa7\x0b\x35\xa3\x29\xea\x76\x37\x5d\xb5\xeb\xf2\x0f\x2f\x21\x3c\xa4\xae\x96\x47\x2e\x4e\xe3\x51\x48\x66\x16\x94\x72\x2e\x0d\x87\xb7\xf8\xf0\x27\x87\x99\x27\x3e\xef\xc4\x29\x4a\xac\x3c\x7e\xfe\xbc\xbb\x8f\x3a\xfe\xbc\x43\x00\x20\x7b\x4d\x2c\x61\xb2\x6a\xef\xaf\xf8\xb6\x2e\xf4\x42\x43\x20\x68\x77\x75\x46\xa6\xad\x37\x93\x4d\x03\xfc\xe9\xc4\xa8\x72\x31\xc9\xdb\xa6\x9c\x40\x4a\x9a\x89\x2c\xdb\xc9\x5a\xb5\x72\xa2\x3e\xcb\xbc\x75\xaf\x24\x09\x21\xaf\xd4\xee\x05\xa4\x0c\x3b\x75\x0f\xc4\xe7\x5d\x96\x7c\x9b\x78\xac\x15\x87\x28\x1e\x8c\xfe\xda\x45\x0f\x76\xe4\x69\xe9\x66\x9e\x58\xeb\x31\x39\x25\xc6\x37\xe4\x11\xe7\xbc\xce\x68\xed\x96\xad\xab\x74\xee\xc7\x62\x4a\x20\x3e\x8e\xc4\xcc\x8e\xdc\xb2\xb4\x4e\x90\x38\xa7\xce\x7a\x03\x21\x3c\x3b\x35\xd0\x8d\x7d\xf6\xf7\xc9\xad\x83\xeb\x01\x41\x01\x89\x75\xc5\x29\x0d\xb4\x92\x86\x6d\xdc\x4a\x9a\xc1\xab\xec\x9b\x63\x64\xb2\x10\xdc\xf5\x84\x7d\xd6\xe6\x39\xa2\x50\x71\x9a\x20\x53\xb4\x4b\xbf\xb9\xb1\x6d\xa7\xf9\x4a\x36\xcf\xdb\xec\x09\xb1\xd4\x97\x23\xae\x94\x78\x60\xc7\x78\xf4\x48\x3c\xf0\x9d\x8d\x13\xf7\xc5\x80\x7f\xa2\x89\xc6\x14\x96\xdf\x1b\xdb\xdd\xcd\x0d\xeb\x2e\x50\x32\xfe\x6e\x2c\x55\x9b\xee\x4a\x1c\x2d\x7c\x99\x80\x53\x98\x12\xe3\x35\x21\x6c\x1b\x33\x01\x8f\xc2\xde\xba\x68\xa0\xff\x5b\x6b\x61\xd0\x90\xac\xc2\x3f\x67\x1c\x25\x87\xc2\x24\x3f\x79\x7a\x89\x81\xa2\x2b\x3c\x3b\xbe\x5d\x69\x4c\xf5\xf2\x79\xe7\x24\x71\x96\x05\xea\x0c\x8f\x37\x15\x3c\x24\x98\x85\xd6\x49\x04\x2b\x24\xc3\x0b\x9e\x56\x88\x9f\x21\xde\x68\x54\x82\x2c\x86\x7d\xb3\x36\x1b\x3a\x20\x01\xd6\x4a\xe1\x60\x7c\xe1\x31\x83\x61\x3f\xdc\x7a\x7a\x89\x3d\xb9\x3d\x08\x08\xe3\x7a\x3d\xf1\x53\x19\xa6\x75\xfb\xad\x9d\xda\xad\x9f\x0f\x1a\x83\xdd\x2b\xd2\x93\x3b\xd4\x74\x79\xa6\xda\x8c\xc5\x41\xf9\xc0\xc2\x12\xb6\x8d\x84\x64\x6a\x21\x18\x36\x24\x5c\xba\xae\xaf\xd0\x5d\x93\x92\xe8\x60\xda\x25\x8a\xe7\x39\xf1\xbc\x22\x88\x79\x45\xa5\x4c\x4b\x16\x4f\xf8\x84\x11\xd7\x08\xd9\x98\x6c\x97\xb6\xad\x36\x2e\x8f\x12\x45\x30\x12\x87\x85\x52\x9b\xc3\x10\x9e\x34\x8d\xb4\xeb\xa7\x16\x52\x44\x5e\xba\xb2\x0c\xbc\xa1\xd9\x52\x61\xef\xd8\x6f\xa4\xda\xb2\x44\xef\x73\x19\xf5\x89\x1d\x30\x1f\x52\xcc\x72\xe2\x7d\xae\xcd\xf3\x21\x73\x01\xcf\xe7\x64\xf6\xd2\x12\x2e\x60\xde\x91\x80\x47\x7c\xfa\x8a\xd7\x4d\xfd\x0f\x55\xe1\x0a\xd0\x02\xfb\xcb\x92\x7b\x90\x39\x0d\x45\x2b\xe5\xcf\x59\xa1\x36\x6f\xc8\x34\x81\xc7\x32\x75\xc6\x49\x18\x9a\x5d\x55\xd3\x95\x34\x19\xd4\xed\xe9\x86\x0f\xc2\xdd\x85\x9a\xb2\x28\xa8\xe6\x49\xe4\xe6\xff\x9c\x7b\x93\xdb\xe1\xf6\xfa\x92\xc7\x07\x03\x5a\x69\xd8\xda\x93\xbe\xa3\xe7\x40\x14\xca\xe0\x35\xae\x93\x54\x32\xf7\x8e\x44\x51\x28\x93\xf1\xd2\x2b\xe1\xbd\xca\x5e\x5d\x23\x59\x08\xe9\xfc\x8a\x2c\x66\x3a\x98\x80\x4d\x1a\x48\x06\x7f\x0a\x66\x18\xe9\x83\xf0\x08\x1e\x04\xf2\x01\x77\x55\x9f\x61\x55\x54\x60\x3d\x1d\x8b\x63\xef\xaa\x0f\xd8\xa8\xca\xd5\xc3\x87\x4f\xf7\x74\xf8\xff\x8e\x4e\x40\xcc\xdb\xa8\x85\xfe\xac\x0a\x51\x4a\x03\x9e\x60\x2a\xbf\x52\x85\x77\xa8\xa1\x01\x5d\x57\x77\x0f\x18\x34\xdc\x43\x03\x3e\xe0\x2b\x70\x55\xf7\x77\x18\xd9\xb9\x60\x88\x0a\x67\x42\x29\x60\x3e\xc7\x6e\x56\x94\xe2\x02\x7b\x3c\x76\x7f\x60\x29\xed\xd4\xb1\xfb\x03\x0f\x6a\xdc\xcf\x0d\xfb\xba\x7a\x53\x59\xfc\xd3\x88\x6c\xe1\x03\x7b\x84\x47\x83\x3e\xf1\x2b\xe1\x45\x36\xb0\xc5\xfe\x17\x4b\x75\xb8\x80\xa7\x80\x1a\x4f\x17\xee\x29\xe8\x3b\x76\xdb\x11\x63\xa2\xc2\x27\x59\x5d\x54\x51\x52\x75\xb1\xc7\x22\x81\x72\x7e\xee\x31\x49\xc0\xaf\xe7\xfa\x62\x58\xe6\xf4\xf0\xe9\xb0\x28\x86\x3d\x84\x9e\x70\xc0\x97\x8f\x1b\xd2\x63\x76\x5b\x5d\x2d\x4b\xe5\x1f\x95\xf8\x89\xb6\x6b\x18\x16\x76\x45\x12\x14\xb6\x51\xb8\x70\x06\x06\xf4\x2d\xc2\xab\xe8\x0a\xfc\x3d\xe8\x84\xec\x9b\x0a\x2e\x6c\x90\x59\xb6\x2e\x8b\x77\xf0\x87\x2c\xc0\x2c\x0a\x53\xf0\x13\xa8\x44\x4e\x3c\xc8\xca\x83\x67\x31\xd8\x42\xd4\x65\x31\x41\xbd\x91\xd7\xfa\x02\xc4\xea\x4a\xd4\x95\xdb\x52\x34\xbc\x47\xbb\x93\xba\xc2\xc0\x0b\xe4\xd4\x00\x0e\xaa\x30\x3c\x2f\x56\x84\x01\x62\x94\xc0\xb9\xfa\x3b\x44\x2a\x0e\x31\x62\x00\xf7\xbc\x6b\x18\xa8\xfc\xd6\xf8\x87\xde\x3c\xd4\x1d\xe4\x82\xf4\xb0\xad\x37\x2e\xb0\x3f\xa6\x88\x99\xc8\x8b\xd9\x59\x72\x92\xd2\xc7\xce\xe0\x0e\x7b\x47\x1e\x2f\x00\xa7\x5c\x97\xfc\x35\x48\x16\x33\x8d\x61\x5f\x24\xdb\x6a\xf7\x39\xbe\x9f\x30\x7e\x4a\x0b\xda\xc7\x24\xcc\x9c\xce\x12\x0b\x2c\x92\x70\x7f\x3b\xd4\x40\x3f\x09\x27\x84\x9f\x8d\x5c\x9b\xfe\x3a\xec\x8c\xec\xd6\xd6\x25\x23\xbb\xeb\xb2\x20\x40\xcd\x3b\x4f\x73\xb1\x59\xd7\x65\x62\xb7\x1b\xc3\x92\x85\x90\xd8\xd6\x8a\xf6\x8a\x7a\x60\x5b\x72\x3f\xf0\x30\x00\x8f\x76\x81\xc1\x61\xb2\x03\xfb\x42\x89\xc7\xee\xb3\x40\x28\x60\x68\x47\xb8\x21\xab\xba\xbe\xfa\xab\xe5\x1b\x43\x24\x44\x88\x0c\xa0\x16\x7b\xc9\x0b\x8a\x03\xa0\x16\x10\x27\x74\x6a\x1b\x82\x08\x3d\x2e\x39\x15\xbf\xde\x7a\x32\x00\x25\x51\x70\xd6\xfe\x25\x2b\x8b\xef\xb0\x5e\xa1\x16\xe7\x34\x0d\x4c\x2a\x1b\x64\xf8\x60\x3f\x81\xf5\x3c\x9e\xb6\x55\xd3\xfc\xb2\x91\x9c\x7d\x36\x13\x7a\xbd\xa9\x9b\x56\x56\xed\x31\x6d\xa3\x8b\x78\x09\x53\xb3\x74\x29\x46\xf3\x01\xa5\x35\xa5\x67\x04\xf5\x74\x0d\x41\x16\xb1\x13\x0c\x99\x89\x47\xb5\x56\xeb\xba\xd9\x89\xd2\x65\x7c\xc5\x5e\x33\x86\xde\x26\x7c\xb6\xb1\x1f\x3c\xbb\x34\xf0\xd1\xad\x04\xbd\x89\x7c\x76\x38\x3b\x37\x04\x1e\x7a\x9a\xfa\xf7\xe4\x9c\x8d\x71\xd1\x37\xcf\xf9\x02\x8c\xf3\x32\xcc\x03\xae\x29\xca\x40\x3e\x36\x9d\xf2\xc5\xb8\x55\xb1\x2f\x9f\xcb\x40\x23\xdd\x46\x3a\xe4\x4d\x08\xc0\xcf\x98\x3a\x39\x89\x3f\xd8\xa1\x50\x31\x91\x6e\xd2\xd0\xc3\xe4\x77\x64\x53\x4a\x5d\x85\x7d\xb9\x73\x67\x68\xe0\xe8\x18\x2e\xa2\x3b\xc1\x9e\x23\x5a\x44\x50\x24\x70\x18\x0c\xef\xfb\xc9\xe0\x3d\x52\x9f\xdb\x46\xe6\xe0\x0d\x02\x9e\xfe\x70\x39\x30\xc2\xba\xed\x89\xfc\x4a\x5f\xb4\x35\xb8\x11\xb5\x72\xc9\x62\x31\xa2\xb1\x0e\x80\x1a\x75\x03\xf2\x3c\xb9\xc5\x77\xd7\x40\xa8\xe9\x29\x56\x26\x83\x75\x17\xbd\x21\x72\x3e\x42\x25\x19\x62\x7c\x6f\x03\x01\x1e\xe5\xe4\xd7\xe3\x83\x5c\xe9\xd6\xa8\x72\x31\x65\x0e\xae\x21\x1d\xb9\x9d\xe3\x74\x30\x9e\x94\x03\x59\x56\x7f\x38\xd1\xc4\x50\x8c\x29\xd9\xb6\xc0\x0e\x03\x32\x80\x1f\x27\x49\x0c\x2b\xf8\x92\x0c\x68\x21\x13\x2a\x53\x26\x93\x97\x34\xfc\xdd\x41\x96\xde\xf1\xa0\x27\x9e\x8c\x2b\xdb\xbf\x42\x6c\xf9\xd8\x3b\xd5\x01\x25\x77\xe8\x84\xce\xde\x54\xde\x9a\x1d\xf9\x86\x21\xfb\x76\x77\x87\x98\x58\xca\xce\xc3\xbe\x22\x49\x17\xa4\xe6\xa0\x67\x0c\x36\xe3\xd1\x23\xe1\x3c\xc0\xed\xef\x49\xd2\x24\x04\xb9\x49\xac\x4e\xf5\x86\x8f\x47\xd2\xe5\x20\x4f\xe6\xa3\x7a\xd1\xb2\x7d\x02\xd1\xe9\x3a\x40\x01\x4f\x60\x60\xff\xcb\x30\xac\x4c\x1c\xb4\xae\x95\x4b\xbb\xf5\x16\x2a\xd0\x04\xd1\x1b\x99\xb9\xac\x3d\xc8\x9b\x23\xc1\xaf\x4d\xda\xe9\x61\x2a\xe8\x9e\xf6\xc6\x3d\xfc\xa1\x6e\x49\x5a\x0d\x81\xd9\xec\x6e\xe8\x79\xd7\x12\x40\xe7\xd2\xa8\x23\x5d\x19\x05\xd6\x58\xd7\x98\xa2\x02\x5c\xd7\x5f\x80\x34\xba\xd7\x1d\x02\x94\x8b\xad\xdb\x19\x10\x16\x68\x7b\xa8\x73\x39\x3f\x82\xd4\xde\xea\xef\x9d\xbe\x96\x65\x48\x51\x82\x3a\x65\x5d\x1d\xbd\x7c\xf7\xb6\xdf\x63\xab\xd6\x9b\x12\x72\x30\x8b\xff\xaa\x3b\x67\xf6\xb4\x69\xea\xb9\x9c\x97\x10\x62\x85\x56\x49\x40\x46\xdb\x1e\x54\xd2\xe9\x1e\x1c\xb2\xfe\x19\x28\xa5\x29\xe9\x80\x4b\x03\xb7\x57\x88\x71\x0b\x0b\x23\x91\x2e\x8e\x34\x01\x64\x45\xc6\x3c\x03\x2d\x02\x60\x85\x16\x98\x64\x31\x26\x5a\x62\x1f\xfa\x38\x1e\xa2\xf7\x70\x6b\xc0\x39\x00\xa2\x70\xb2\xb4\x92\xd4\x2d\x78\xc0\x61\x7c\x3d\xe6\xe4\x86\x37\xd6\xb6\x18\x27\x32\x38\x0b\xf6\xd0\x53\x12\xf5\xa3\x51\x3e\x0e\x87\xfd\xdc\x8b\x2a\xf4\xc0\x8d\x12\x45\x11\xc2\xcc\x36\xbd\x06\xa9\x08\xd1\x89\x8a\x19\xd1\x17\x4d\x05\xd7\x72\xc7\x6c\xb0\xc2\x6f\x9b\x50\xd2\x66\xef\x9c\xe2\xa3\x40\xe9\x38\xcf\xfe\x32\x03\x83\x39\x07\x8a\x70\x8f\xb5\x25\xed\x25\x66\xaf\x05\x93\x93\xb5\xae\xf4\x5a\xff\x43\x51\xb6\x39\x72\x65\x72\x14\x25\xbe\x1b\x73\xb4\xde\x6c\x65\x4b\x26\x7d\xb2\x92\xe5\xce\x6b\x6c\xfc\x00\xb2\x75\x63\x80\x2d\xc9\xf4\x60\x36\xb3\x0d\x5f\xd7\x0d\xbd\xc1\x70\x59\xd7\xb2\xb9\xea\x36\x93\x64\x8c\x5c\x56\x90\xe4\x3a\x18\xf0\x98\x2b\x90\x6f\x7a\x6d\x65\xbb\x02\x53\xc0\xa5\x65\xe9\x64\x0b\xe9\x19\x40\x3e\xca\x43\xa3\x2c\x3b\xd9\xc8\xaa\x8d\x13\x3e\x00\x27\xfd\x0d\xbc\xaf\xdf\x92\xe9\x8c\x45\x13\xb6\xb3\x76\x5b\xbb\x54\xf5\x50\x0c\xaf\x69\x32\x33\x6d\xc8\xec\xf2\x18\xb6\xf4\xe9\x14\x23\x76\xfb\xa7\xb2\xc1\x70\xdb\xad\xd4\x15\x0b\x0b\x69\xc4\x11\xf3\x89\x71\x73\x94\x65\xa8\x01\x76\xb5\x72\xe7\x63\x76\xb8\xb0\x61\x91\x5d\x0a\x71\xcf\x4d\x5d\xb7\x53\xf1\xa6\x42\xe5\x9c\x9d\xf1\x04\xf3\x3e\x39\x77\x1b\x30\x0e\x1d\x9e\xb6\x38\xf2\x36\x34\x18\x2b\xc6\xf8\xb1\x20\xa4\xf8\xa2\xb4\xf0\x80\x0b\xda\xae\x6a\xec\xab\x5d\x81\xe5\xb1\x6e\x57\x24\x71\x0c\x21\x9e\x31\x74\xef\x54\xbc\x69\xfb\x3b\x3e\x27\xaa\xe4\xe9\x51\x69\xd9\x4f\x51\x28\xb5\xb1\xdd\x79\x8d\xf3\xc0\x3e\x18\x4f\x16\x7a\x3e\x86\xd0\x6f\xbd\xad\xfc\x16\x4f\x59\xae\x42\x58\xb1\x8f\x7f\xf6\xc2\x1d\x42\xe6\xea\x92\x8c\x66\x48\x18\xe2\x7a\x1b\x10\x87\xf4\x85\x2f\xae\xf6\xb9\xbe\xe8\x3b\xb4\xec\xd9\x16\x64\x29\xce\x5d\x04\x20\x3b\x9b\xa1\xcb\xea\x3e\x92\x71\xdf\xd7\xf7\x00\x15\xd9\x4b\x52\x7a\x26\x59\x6e\xe5\xce\xb0\x9b\x40\x12\x6f\x98\x92\x99\x80\x81\xeb\x74\x39\x15\xdf\xb8\x9b\xf9\xed\x44\x7c\x63\xca\xba\xfd\x76\x22\xae\x8f\x16\x75\x03\x3a\xda\x6d\x6f\x40\xd4\xfb\x5e\xeb\x02\xed\xc3\x3a\xa3\x1a\xb0\x8a\xb6\x50\x60\x89\xc4\xa3\x6d\xa3\x01\x54\x92\x9b\x67\xc4\x4c\xfc\xe5\xec\x3f\x01\x40\x4d\x97\xaf\xe8\x4a\x59\xb0\x2f\xcb\x18\x2c\xc1\x98\xd7\x43\xa6\x25\x2e\x20\x45\x6f\x5b\x63\x48\xd4\xda\x40\x6a\x65\xf4\x2b\x13\x2e\xea\x97\x9d\x1b\xd2\xab\xd3\x81\xe0\x75\xfb\xce\xdf\xbb\x47\x85\x64\x76\xf1\x91\x3c\x13\xe7\x48\xff\x7f\x54\x9f\x5b\x40\x0f\xa1\x82\x4b\x04\x30\x0c\x10\x8e\xd0\x7a\x16\x66\x01\x15\xdc\x54\x7a\x15\x8f\x99\x57\x7d\xec\x55\x66\xc7\xb6\x43\x8b\xac\xf2\xcc\xb1\x9f\xb9\x7d\x0a\xb1\x18\xf8\x2b\xf7\x73\xda\xaa\xcf\x2e\x0d\xde\x6b\x8b\xf5\xb1\x54\x9b\x17\xf5\xda\xb2\xb0\x89\xe9\xd7\xe0\x14\xc3\x6e\x4d\x08\x7c\x58\x9c\x92\x40\x8e\x9f\xfb\xa8\x35\x7a\x22\xf2\x09\xc8\x82\xa1\x22\xfe\xe9\x45\x61\x5f\x78\xc7\x72\x17\x99\x0c\x6f\xd5\x49\x5f\x88\x35\x76\xfe\xe0\xf6\xf0\x51\x3c\x4c\x51\x55\x46\x10\xf8\xbd\xae\x5a\x5d\x75\x8a\x1e\x45\x3f\x1d\x34\x72\x72\xae\x19\x47\xe2\xe9\x89\xff\x8e\x9f\xce\x7d\xd5\x0b\xcf\xdf\xd3\xc2\xf7\xdd\xfe\x44\x34\x95\xbb\xde\xbf\x15\x4f\x22\xb1\x14\x97\xc0\x24\x60\x30\x11\x59\xc6\x76\x97\x1c\xbb\x2d\x49\x77\x09\x0e\x3c\x5c\xcd\xed\xf2\x0e\x09\x59\xfc\x22\x73\x4b\x6e\xdb\x63\x16\xf6\x68\x4d\xc4\x3a\x04\xa0\xc9\xf2\xf3\x27\x17\x8e\xf9\x76\x65\x76\xa1\x89\x52\x3e\x5e\xbf\xe7\x7b\x03\xdc\xdb\x8f\x00\x55\xe2\xb1\xc0\x4e\x11\xc6\xb8\xf6\x3c\x9f\x82\x15\x42\x36\x40\x7d\xc2\x08\xc0\x99\x13\xfe\xc3\x04\x0b\xfb\xcd\xf8\xa2\x4b\xd9\x13\x01\xee\x5f\xca\x17\xed\x11\x3e\x5f\x06\xf1\x8c\x8b\xa8\x61\xa1\xf4\xec\xec\x83\x58\xed\x0a\x0a\x12\xe6\xe9\x0a\xdf\x03\x50\x05\xac\x17\x65\x0c\xba\xab\xb8\x7c\x69\x05\x39\xaf\x02\xfa\x43\xfc\x05\x24\x0d\x85\x04\x3e\xf8\x5d\xfb\x9d\xa7\x09\x89\x01\xd8\x50\x64\x3b\x4a\x16\x4f\xea\x4f\xe6\x1e\xdd\xd6\xe2\xda\x4e\xbe\x77\x12\x59\x0f\xb7\x8d\xef\x96\xe5\xa7\xa0\x75\x2f\x5c\x7d\xd1\x61\xfc\xb6\xad\x88\xa1\xae\x67\xe1\x01\x7a\x77\x94\x5f\x58\xce\x08\x28\x55\x7c\xfa\x30\x02\xb3\x7f\x2d\xb2\x52\x5f\x59\x1a\x32\x3c\x91\xe0\x11\xb3\xa8\x9b\x71\x7a\x95\x9a\x2e\xa0\x7d\xc8\xf4\xf9\xbd\x36\xed\x38\xe6\xbe\x11\xf5\xe6\x53\x30\x22\x4c\xbe\x10\xde\x9a\x82\x9d\xc3\x40\x2b\x8e\x5c\xe3\x5b\x09\x6d\xc4\xa9\x38\xbc\xbc\xbc\x2e\xb5\x69\x21\xea\x02\x43\x16\x1e\x4b\xc0\x5f\x97\x87\x77\xdd\xbb\xac\x77\xd9\xbe\x2c\xd6\x19\x4a\x30\x2d\xaf\x2e\x32\x4b\x91\x4d\xc4\x5c\x1a\x16\x46\x1e\xb1\x76\xbd\xde\x4c\x2f\x2f\x95\x79\x5b\x17\x5d\xa9\x1c\xef\x68\x59\x20\x8c\x42\x00\x99\xbb\xeb\xf5\xe6\x1c\x7f\x7a\x37\x90\x8f\x72\x89\x71\x67\x46\xd8\x72\xc4\xd3\xc8\xda\x06\x90\xfb\x71\x1d\x45\x29\xba\xe5\x0f\x20\xe9\xb1\x6d\x1d\xf7\x66\xdb\xf9\x51\x84\x4d\x56\x7e\x0c\xfd\xc4\xac\x28\x00\xda\x73\xb3\xab\xf2\xf7\xa5\xcc\xd5\xaa\x2e\x2d\xd1\x62\x97\x44\xa1\x6a\x26\x4c\xd0\x46\xf1\x63\x7c\xac\x52\xfb\x30\x46\x72\x37\x54\xdc\x42\x16\x03\xec\xf9\xd5\x7a\xd3\xee\x10\x86\x29\x56\x68\xa1\xa6\xe0\xbb\xf7\x9a\x22\xe1\x9c\xba\x81\xe2\xcf\x6f\x15\xe4\xc5\xfa\x15\xc6\x3e\xc6\x19\xb8\xf1\x8f\xfd\x44\xfc\x34\x8e\xc3\x84\xec\x74\x8e\xed\xff\xd0\xd6\xd3\xd9\x03\xdb\xeb\xcf\x57\xee\xc3\x37\xee\xaa\x3c\x04\xeb\x4f\x16\x6e\xf7\xd1\x49\x17\x5d\xf0\x1c\xce\x86\xc3\xc5\xa0\xfa\xe8\xcc\xc9\xa8\x90\xa8\xdc\x0e\x91\x3a\xa5\xf7\x2a\xc4\x92\x6c\xde\x07\xcd\xb6\xd8\xd7\x85\xfb\x1e\xf7\x10\x4d\xaf\xac\x25\x5a\x78\xf7\x26\x48\x5f\xee\x9a\x22\xab\xb2\x7f\x92\xb4\x43\x86\x4b\xdd\x1d\xdb\x42\x06\xe5\x28\x5c\x4c\xea\xd3\xe5\xc4\x5f\x43\x71\x1d\x1a\xb7\xf9\x26\x80\xcb\x94\x15\x9d\xd3\xdf\x2c\x8d\x0b\xb8\x87\x3a\xa1\xb3\x2f\x05\xcf\x8f\x0f\x48\x98\x0f\x79\x1e\xec\x09\x13\xeb\xe6\x79\x57\x88\x58\x0f\x20\xe0\x9a\xfe\x10\x46\xfa\x11\x54\xac\x69\x2a\xfe\x5b\x36\xa1\x60\x7e\x5e\x57\x79\x62\xa9\x1e\x69\x05\xc0\xfa\x41\xb0\x63\xe6\x1b\xe9\x8a\xc5\x29\xc3\x55\x48\x5f\x38\x08\xf6\x73\x00\x87\x21\x30\x15\x0a\x2e\x3c\xc0\x8e\x42\x52\x7c\xa2\x08\x6a\x60\x9a\x77\x55\xbe\x6a\xea\x0a\x63\x75\xc1\x08\xa1\x8f\x0c\x1d\x70\xa9\x1c\x45\x8a\x66\xa5\xd7\x6b\xb4\x14\xe2\x6d\xc9\x92\xf6\xec\xec\xc3\x98\x0b\x78\x6c\x95\x24\x52\x80\x3b\x9b\xde\x8e\x8d\xa3\x2d\x73\x51\xe3\xd3\x1d\x93\x26\x28\x99\x7f\x87\x72\xb7\x1f\x41\x13\xd7\xe8\x99\x70\x4a\xc3\x4d\x8a\x5c\xda\x7c\x16\xb6\x47\xb8\x49\x88\x67\x22\x3b\xfc\xb9\xfa\x00\x3f\xb0\x95\x9b\xdd\xb1\xa5\x57\xa8\x7e\x14\x29\xf1\x3e\x9c\xc1\xcf\x1b\xbe\x26\xc1\x11\x7f\xcb\x06\xb2\x6b\xe4\x5c\x22\x26\xb4\xaf\xae\x22\x4e\x89\x9e\x96\x46\x99\xf1\xa0\x7d\xa2\x7d\x59\xc1\xb7\x6c\x7f\x28\x72\x0b\x2b\x63\x71\xfa\xad\xf3\xc6\x48\x48\x0b\xa4\x0c\xf6\xa2\xb9\xf0\x80\x83\x0b\x5c\x7f\xb2\xe9\x6b\x1f\xd1\xcf\x76\x47\x6d\x5b\x7f\x82\xdc\x45\x3f\xfa\x70\xdf\x2a\xfa\x95\xf7\x6d\x5c\x7c\x9e\xb6\x1d\xbe\x0b\xf1\x8a\x7a\xe7\xdc\xbb\xba\xf8\x69\xe0\x02\x13\xfa\x1d\x1a\xc9\xa1\xf8\xe1\xb1\x18\x0e\xef\x8f\x46\x1f\x07\xc7\xc3\x61\x6c\xad\x42\x95\x12\x2d\x52\x9f\xa4\x01\x32\x92\x51\x7a\xd0\x39\x68\x8b\x1c\xfb\x76\x0e\xa0\xe3\x2f\x02\x16\x7c\xd1\xe2\x8f\x43\x9b\xfe\xa5\x13\xa5\x7a\x43\xd7\x29\x05\x37\xfa\x35\x11\x61\x77\x6e\x6e\xc4\xd7\x4f\x9e\xec\x8d\x00\xb2\xe7\xe8\x5a\xdc\x84\x64\xc6\x5f\xb4\x3b\xbf\xe1\x22\x09\x00\x65\xb8\xd3\xbd\xf5\x1e\xd2\x14\x44\x06\x58\x8e\x4f\xca\xd2\xd6\x6b\x33\x3e\x4c\xda\x24\xbb\x12\x05\x52\xc1\x1d\x71\x1d\xec\xd3\xc5\xd0\x76\xd0\x63\xcd\xdc\xf4\x82\xcd\x94\x86\xf0\xbd\xe1\xe9\xe3\x4f\x4b\xb9\xbb\x83\x56\xf1\xe2\xa7\x61\x1a\x46\x00\x41\x3c\x48\x3f\x0d\xf1\x00\xda\xf4\x89\xe4\x9e\x30\x2a\x96\x30\x59\xb0\xec\xd1\xbb\x83\x9d\x2f\x55\xfb\x5a\x37\x26\xe8\x08\x41\x38\x92\xca\xea\xf6\xcb\x5e\xef\xcc\x69\x72\x97\xa8\x89\x88\xab\x21\x81\x13\x07\x50\x64\x73\xdd\x8f\x80\x08\xbd\x46\x18\xb4\xc8\xe9\x06\x59\x66\x3a\xc6\xa1\x28\xd5\x1d\x60\xc1\xa2\x74\xfc\xe9\xce\x57\xba\x7d\x45\x91\x30\x7c\xf6\x98\xf5\xf4\x92\xc2\x13\xec\x4d\xd5\x66\xeb\xac\xa4\xf9\xae\xae\xaf\x9c\x09\xa9\x07\x30\x20\x82\x74\xeb\xe2\xfa\xfb\x90\x10\xd8\x27\x31\x32\xa5\x37\x8d\x8b\x0c\xd2\xa7\x97\xd8\xca\x5b\xce\x39\xbd\xba\xaf\xef\xfd\x58\x37\x51\x06\x32\xdf\x00\x0c\xc8\x43\xed\x13\x1e\xe3\xce\x79\x40\xb0\x70\x75\x45\x41\x76\x65\x13\xb1\xa8\x26\x40\xf6\x04\x80\x08\xbf\x9c\x67\xc7\xf4\x21\x10\x46\xbe\xc5\x40\x68\x19\x5f\x2f\xad\x95\x70\x48\x60\x15\xf5\x94\x8d\x8e\x23\xf9\xf6\x8b\x45\xd4\x41\xdf\xba\xb0\xbf\x76\xe0\xb0\x30\x76\xa7\xdf\x01\xb2\x39\xf4\x75\x0e\xf8\x30\xde\x76\x3e\xb1\x57\x0c\xdb\x3d\x89\x1a\x5b\x58\xfc\xf5\x76\x02\xb6\x8b\x7e\x09\x3e\xb8\x8f\xef\x33\x64\xc4\x8c\xa6\x8d\x00\xf0\x56\x7f\xd6\x95\xc8\x3e\x75\xcc\xbe\x78\x55\xd7\x2e\xea\xa0\xfd\xf3\x78\x66\xbb\xfb\xd4\x29\xa6\xdd\x78\x58\x57\x11\x57\x91\x6c\x1b\xc5\xff\x5b\x69\x34\x79\xb5\x7f\x30\xaa\xec\x7a\xed\xcb\xc4\xa0\xa8\x15\x7a\x1b\xdf\xcd\xa9\xa0\x95\xda\xdd\x6c\x0a\x4e\x20\x9c\x3e\x58\x7e\x13\x00\x88\x7d\x72\xaf\x2c\xdc\xb8\x73\xf8\x07\x22\xdb\x0f\x94\x9e\x8a\xf3\x8b\xf1\x98\x3c\xa3\x2b\xce\x75\xd8\xeb\x03\x4a\x54\xd8\x3e\xb4\xf5\xca\x6b\xd3\x86\x10\x22\xd2\x47\x23\x5f\x94\x72\x09\x3a\x50\xcb\x4d\xb4\x51\xde\x02\xce\xc6\x30\x1d\xe0\x4a\x9a\x95\x28\xeb\xfa\xaa\xdb\x30\xf4\x85\x87\x46\x11\x19\x93\xfd\x1b\xc4\x10\xc3\xe1\xc6\x59\x6c\x5f\xef\x1e\xd3\x3b\xfa\x5c\xdd\x7f\xf8\xc9\x21\xfb\xda\xf1\xb3\x0e\xc8\x26\xdc\xad\x3a\x6c\xa3\x77\x7b\x87\xe8\xdc\x89\x31\x1e\xce\xb4\xae\xa6\x0b\x00\xc3\x8a\x98\xe1\x35\xbf\xe8\xbe\xaf\xfb\x17\xb4\x58\xfc\xd3\x80\x19\xe4\x00\xa5\x87\xeb\x07\x7e\xe6\x04\xaa\xd1\xd2\xef\x45\xec\xe9\xf4\xdd\xca\xed\x28\x98\x53\x6a\x11\x10\xf9\xff\x91\x9b\xe4\xce\x6a\xf8\x2a\xed\x9d\xad\xd9\xa8\x5c\x2f\x74\x8e\x83\x05\x59\xc7\x9c\x5e\x9c\xf8\x6a\x05\xbc\xf0\x20\x9f\x9b\x9e\xaa\x35\xea\x1c\x6a\x2d\xaa\xa1\x7d\x0d\x17\xd5\x6e\xe8\x5d\xfb\x69\x3b\xe1\x7d\xf0\x19\xaf\x22\x17\x2a\x9c\x75\xe4\xb6\x07\x50\x91\xcf\x63\xaf\x90\xe0\x17\xf2\xf0\x29\xcf\x67\x28\x44\x3e\xc7\xea\xe7\xda\xe7\xda\x15\x3e\x92\x02\xc6\xf3\xab\x20\x63\xe5\x1c\xa0\x1b\x7e\x27\x82\xe2\xb9\x99\x9a\x0d\x98\xfa\x6b\x8b\xf0\x9f\x46\xd4\x29\x04\x3a\xd9\x4b\x84\x7e\xd1\x85\x50\x6b\xdd\xf6\x6f\xc4\x1d\xb7\x9b\x93\x58\xa5\xb3\x1d\x73\x88\x06\xe1\x6b\xd0\xf6\x0d\x68\x89\xb8\xfe\x83\x53\x6a\x41\xb1\xcd\xdd\x59\xc6\xd5\x2e\x22\xe0\x8c\xed\xd9\x0e\x5f\x31\x33\xf5\xa4\x77\x6f\xce\x66\x97\xd8\xa2\x91\xe3\x3e\x7b\xb6\x41\x6b\xb6\xeb\x35\x1a\xb1\x79\x1b\x36\x67\x1f\x0f\x8e\xd1\x16\x77\x83\x26\xc8\xde\x33\x9c\x80\x0a\xe3\xa6\x86\x6b\xbf\xc7\x6c\x6d\x57\x77\x22\x97\x95\x4b\x4e\x9a\x74\x78\x7d\x54\x57\xa2\xad\x89\xde\x40\x7d\x3a\x99\xb9\xb9\x18\x57\x7d\x63\x35\x66\x9b\x96\x74\x77\xb7\xa9\x5a\x16\xec\x20\x1d\x9a\xd9\x63\xb6\xc6\xf7\x20\x70\x56\x03\x1a\x8a\x2f\xc4\x0a\x11\x52\xc0\xda\xe1\xfe\x89\x6f\xc5\x53\xf1\x4c\xb4\x35\x31\x0e\xb6\xee\xb1\xfd\xce\x2f\xad\x6c\x96\xb6\x95\xab\xe4\xb1\x34\xbf\x4c\x83\xf2\xd1\xf9\x7d\xa2\xd1\x34\xa4\x27\xdc\xf4\x8b\xf8\x2d\x33\x9c\x4b\xdc\x13\x87\x33\x89\x88\x62\x5b\x66\x87\x51\x7c\xb7\x61\x20\x3b\x1c\xdf\xc1\x82\x0e\xdc\x7e\xc6\x8f\x04\x0f\xcc\xae\xb2\xec\xac\x58\xa9\x72\x43\xe3\x20\xd7\xe8\xcc\x8a\xbd\x62\x0d\xf4\x1d\x3e\x85\x9c\x29\xeb\x96\x7c\x2a\x87\x33\x48\x9d\x95\x75\x8b\x74\x31\x57\xaa\xc4\xea\x06\x10\x69\x43\x3d\x9e\xdf\xf2\x41\xcc\x20\xfa\x65\x40\xcd\x7d\xa1\x6c\x19\xb3\x77\xc7\xa9\x5d\xa7\xa9\xd0\x39\x6f\x08\x2e\x8d\x12\x14\x34\x98\xfb\xcc\xfe\x60\x8c\x3b\xb8\x01\xc0\xba\xfd\xed\x45\xf1\xb2\x42\xed\x90\x36\x81\x9d\x07\xb7\xd2\x4f\x1d\xd5\xf7\x5a\x5a\xf0\x8c\xc0\x9c\x0d\xcc\xb8\x39\xfe\x35\xb5\x4d\x02\x80\x90\x75\x60\xf2\xfd\x24\x7e\x73\x2b\xb9\x56\x05\x6d\x25\xdd\x62\x90\x7e\xcf\x41\xc2\x40\x61\xdb\x69\xae\xa0\x33\x86\x3c\x16\x5e\x99\x8d\xe6\xdf\xfe\x01\x97\x6b\xe5\x0e\x2a\xc4\x84\xa2\xf4\x73\x54\x0e\x8f\x95\xfb\xdb\xbe\x60\xf0\x71\x51\xbd\xe8\x7f\x66\xaa\xd1\x68\xe9\xb0\x33\x0f\xf0\xc1\x86\xef\x31\xfb\x4e\x3e\x81\xbe\x2a\xbf\xd2\xd0\xf4\x54\x64\xb0\x60\xf2\xae\xb1\x84\x3b\xff\x8d\x24\x3b\x7f\x7d\x70\x8e\xad\x5c\xa2\x44\xd4\xe1\xc2\x48\x22\x6a\x7b\xe0\xd6\x0c\xf6\xb7\x4b\x85\xe7\x6f\xc2\xcd\x8d\xed\x7b\xaf\xaa\xda\xf7\xc1\xf2\x05\x8a\xbd\x2c\x08\xcc\xd9\x69\x41\xc3\x2a\x7c\x09\x67\x3d\x78\x7f\x2c\xc4\x10\x79\x6a\xe0\xf1\xbb\xb0\x88\x68\x3f\x06\x50\xb0\x5d\xe9\x56\x99\x8d\x04\xcf\x14\x7f\x73\xec\x36\x3d\x7c\x6a\xcf\x1e\x1a\x72\x5b\xb8\xb0\x8f\x0f\x9f\x5e\x4c\xc1\xb5\x3a\xd3\xe6\x27\xdf\xcd\xb8\x07\x9e\x51\x8b\x41\x73\x61\xbc\xbd\xb1\x05\x54\xe8\x71\x40\xec\x94\xf5\xe5\x4e\x0f\x7a\x82\x27\x10\xd2\x78\xc3\x28\x3c\x58\x31\x1a\x52\x89\x9e\xe5\xf5\x46\x15\x01\x2b\x81\xc7\x0d\x44\x26\xc5\x68\x38\x33\x67\x4b\xd1\x28\xc7\xaa\xa3\x4a\xc1\xfe\x1f\xb8\xef\x93\x3d\x96\x85\x8b\x6a\x30\xde\xeb\xa0\x47\x67\x6a\x4f\x98\x4e\x8d\xea\x80\x9c\x71\xd8\xbd\xa5\x51\xe6\x1c\x2b\x4d\xc9\xd8\x98\x7e\x39\xd6\xe8\x6e\xf5\x3f\xbc\x88\x10\x16\xe5\x8d\x8b\x16\xe3\x68\x66\xf4\x34\x07\xed\x9e\xae\x96\x20\xac\x0b\x2a\xe4\x81\x10\xbb\xba\xd2\xed\xf7\x7a\xa1\xf2\x5d\x5e\x2a\x26\xc5\xda\x1f\xf3\x00\xc1\xb5\xac\x73\xd9\x2a\xf4\x26\x86\x2c\x31\x72\x6e\xc0\x93\x85\x04\x57\xde\xf5\x99\xb2\x53\x7a\xff\x12\x28\x70\xaf\x04\x7d\xb6\x50\xe1\x2a\xb8\x7e\xdc\x06\x13\x59\x8e\x35\x83\xa4\xcb\x0f\xf7\xe8\x91\x70\xdf\xe2\xfc\x94\x2c\x35\x66\x5c\x81\xa3\x5d\xf7\xc5\x3f\x3b\x70\x45\x7d\xb8\x65\x94\xd5\x3d\x4c\x3a\x22\x11\xde\xc3\xa6\xae\x43\xa1\x78\xe6\x3b\x83\xf2\x63\x90\x0d\x51\x4d\x8f\x75\xbc\x45\x9e\x6d\xae\x16\xee\xcd\x24\x99\xa0\x4b\x1b\x14\x18\x20\x5b\xaa\x2b\x3c\xeb\xa4\x18\x13\xf9\xbe\x09\x1f\x43\x34\x64\xdb\xc8\xbc\xad\xbb\xaa\x45\x4f\xe3\xf8\xc3\x4b\x65\xda\xa6\xde\x0d\x7d\xfa\xb3\xd2\xd5\x72\xe0\x7b\x14\xec\xda\x41\x4b\x2a\x8a\x8a\x99\x92\x4b\x14\x8a\x45\x6c\x09\xdc\xce\x89\xb3\xe1\x0a\x21\xdd\xf6\x08\x99\xa2\x75\x30\x02\x52\x96\xe5\x77\x75\x7d\x05\xd4\xd9\x08\xe3\xfa\xa2\x3a\x7b\x14\x89\x1b\x5c\x36\xb3\x57\x25\x81\xb0\x2a\x4f\xa2\x0f\x9f\xc8\x1e\xc4\x8e\x03\x53\x8b\x3f\x3f\x4f\x6f\x58\x7c\xe5\xb0\x72\xef\x1a\xba\x68\x1a\xbe\x53\x5b\x16\x3a\x9f\xcd\xd2\x7d\xba\xdc\xd8\x53\xbf\xbc\xb4\xa4\x07\xa6\x90\xc6\xc7\x5c\x55\x96\x34\xdd\xd4\xda\x49\x0b\x66\x33\x81\xd1\x98\x28\x52\x14\xbe\xfc\x10\x9b\x47\xe6\x57\xaa\x2a\x20\x50\x74\x78\xec\x1f\xf8\x35\x46\x8c\x32\x45\x6c\xa2\xe6\x4c\xae\xa3\x68\x9f\xfc\x8c\x32\x2e\x8e\x7a\xa8\xca\x89\x48\x0f\x90\x7c\x4b\xc4\xec\x2b\x22\xad\xde\xd9\xa7\xea\xab\xd9\x24\x6a\x99\x48\xa7\x5f\x95\xeb\x3d\xdf\x1b\xb5\x78\x55\x26\x6e\xc7\xce\x41\xd2\xfb\x36\xe0\xd2\x17\xce\xe4\x0f\x42\x03\xbb\x35\xc1\xc4\x43\x33\x0a\x85\x45\x9c\xd4\x95\x52\x1b\x94\xe5\x15\x8a\x84\xea\xc0\x4e\x35\x0a\x3c\x77\xc9\xb9\x33\xfb\xc3\x1f\xff\xed\x8f\x4f\xc7\x07\x77\x4d\x3f\x95\xba\xe3\xbc\x23\xb9\x45\xdf\x9f\x11\xef\x83\xb9\x73\xc3\xfd\x89\xd1\x56\x47\xf0\xdc\x03\xb5\x3e\x90\x7a\x18\xa3\xcb\x77\x79\x79\xdd\xa9\xcb\x4b\xe1\x83\x87\x79\xe0\xc0\x8b\xc1\x70\x25\xfc\x9e\xba\x06\xd1\x52\xf8\x85\x7c\xa8\xca\x58\x1a\xa8\x78\x23\x1e\x4a\x06\x81\x6d\xe1\xd0\x23\xba\x2d\x7c\xf7\xee\x85\xcb\xf4\x0d\xc9\xc2\xec\x1e\x48\x03\x71\xeb\xa2\x51\xf0\xe6\x50\xb6\xb4\xf0\x4a\x84\x4f\x96\x48\x08\xdf\xe8\xaa\xc5\x33\x73\xe8\x58\xa5\xf7\xff\x36\xde\x25\xf2\x07\xd6\xde\x07\x78\xbe\xc3\x60\xd2\x16\x44\x3a\x0a\x07\x44\xae\xc2\x48\x98\x39\x6c\xee\xec\x4d\x59\x57\x10\x93\x16\x47\x1e\x99\x68\x80\xe9\x3e\xe9\x0d\xb3\xc7\x19\xb4\xfc\xb9\x03\x3b\xd2\x83\x11\x0b\xd3\xa8\x70\xda\x45\x26\x3e\xb7\xfb\x86\x2f\x10\xdd\xff\xd6\xa1\xd3\xc7\x22\x95\xfa\xb1\x9d\x1e\x42\xd8\xd4\xce\x61\xec\x3d\xcf\x4f\x90\x79\x33\xe6\x4d\x95\x0b\x4a\xd7\xe7\x28\x8d\x84\xd6\x08\x87\x1f\xa6\xcc\x28\x0d\x07\x32\xbd\xf1\x20\x61\xd8\xba\x4f\x63\xf0\xa5\x81\xc3\x77\x4a\x35\x24\x69\xb5\x11\xf7\x28\xd9\x14\xf5\xb6\x72\xb9\x00\xcd\x97\x1f\x9c\x6b\x9a\xf5\x9e\x33\x4d\x08\xc3\xf5\xb9\x3f\xb0\x4d\xbf\x5b\xa0\x2f\x87\xbb\x0e\x9b\xeb\x11\x05\xee\x30\xc6\x05\x9c\xbb\x4a\x0b\x88\x3e\xe4\x02\x3b\xad\xe5\x0e\xcc\xb1\x20\xa3\x00\x25\xfd\x64\xf1\x87\x81\x46\xb1\x8c\x5f\x1c\x73\x88\x88\x97\xf0\x61\x7a\xbd\x7e\x61\x9f\xf8\xa3\xa3\x64\x4a\x90\x95\xd0\x5e\x44\xf0\x02\x80\x2b\x34\x9d\x32\x68\xd9\x0b\x28\x64\x44\xe6\x00\x1b\xd3\xc4\xd4\x95\xc8\xbb\x06\x80\x20\xd8\x7f\x37\x4a\xf9\x0e\x3d\x12\xf6\x2f\x37\xe6\x09\x61\x89\xad\x74\xe3\x7b\xa5\x4b\x3d\x00\xdf\xbe\xc2\x28\xb4\xc4\x7c\xe1\x8b\x05\x78\xc9\xf8\x08\x8f\x5e\x4d\xe8\x57\x05\xf2\xfa\x71\x0a\xf1\xfb\xb1\xf8\xbd\x08\x39\xc5\xe2\xd0\x69\xa9\xa4\x51\x22\xd7\x4d\xde\x95\x60\x74\xe5\xce\x3c\xfb\xc3\x9f\xfe\xf5\x8f\xff\x3e\xee\x63\xe2\x78\x04\x28\x9a\xfa\xeb\x16\x8f\x71\x1b\x53\x8b\x6b\x7b\xb4\xb1\x11\x2b\xaa\x58\x55\x09\xae\xa5\x8e\x92\x38\xf0\xda\x73\xc4\xd7\x88\xab\x31\x51\x18\xbb\x92\x78\x74\x1e\x3d\xf5\xbe\x0c\x58\xf7\xc6\x12\xef\x3b\x83\x23\x90\x90\x84\x77\xeb\x9d\x23\x93\xcc\x9d\xae\x9c\xc5\x42\x02\x3b\xbe\x3f\x8c\x98\x6f\x6e\x3c\x43\x55\x5a\x16\x94\x1f\x57\x9a\xb2\x4e\x88\xd1\x7f\xd5\x1d\x08\x93\x51\xe2\x0b\xb4\x0e\x0a\xf7\x8e\x50\x16\xd4\xe9\x12\x04\xb5\x9f\x3a\x17\xee\x3c\x72\xe1\x1c\x45\x02\xe1\x91\x77\x19\x75\x96\x93\x2e\x29\xc3\x54\xbc\xc2\x00\xa0\x9b\x46\x1d\x79\xa7\x4f\xd6\x13\xc9\x08\x93\xfe\x52\xc7\x31\x70\x44\x23\xe7\x4e\xef\x9f\x7a\xa4\xab\xbc\xec\xc0\x09\xcd\xce\x96\xe7\xd0\x63\x51\x65\xb8\x3c\x39\x15\xbe\xf4\x76\x25\xd8\x3f\x02\x3c\x71\xbb\x47\xbf\x74\x10\x7a\xc6\x1e\xa5\x2c\xbc\xea\x17\xcc\x22\x62\xbc\x87\xde\x2c\xe0\x44\x46\x21\x3c\x6c\x62\x48\x70\x72\x7f\x00\xe5\xfb\x0d\x3f\x29\xa2\x26\x8b\xcb\x6c\x4b\xd7\xb2\xb9\xda\x63\xba\xb1\xc7\x58\x98\x49\xdf\x2c\x3e\x73\xb1\xb7\xfc\x43\x52\xd0\x87\x4e\x17\x91\x50\x8e\x22\x1a\x8b\x53\x71\x78\xdd\xa9\x23\x3b\x8f\x23\x28\x3c\x06\x57\x86\xa8\x32\x46\x37\x8e\xaa\xaa\xaa\xf0\x15\xa9\x26\x84\x69\xee\x45\x4a\x06\xca\x82\xf1\x5f\x4d\x62\xcd\x06\xad\x92\xf0\xc9\x2e\x4a\x73\x66\xc7\x13\x3c\xc9\x25\x9d\xfc\xe1\x78\x30\x78\xf3\xdd\x13\x59\x3b\x56\xb5\xcf\x9e\xfe\xce\xc9\xc0\x43\xb2\x67\x2e\xc2\x07\x7a\xe6\x40\xff\x85\x67\xca\xa6\xca\xb6\xac\x3f\xe3\x5b\x2f\xbd\xc0\x68\x23\x46\x51\x58\xe0\xb6\x8e\xe4\x0c\x2c\xb2\x22\x15\x8d\x98\xb7\x6a\xdd\x1c\x24\x29\xc6\x43\xa5\x88\xe1\x02\x4a\x00\x5e\xeb\x88\x96\x75\x59\x6e\x60\x8c\xa1\xa8\x24\x23\x83\xd7\x99\xde\xd2\xf1\x84\x62\x71\x36\xaa\xd4\x0a\x5e\x6c\x3e\x59\xcc\xc6\xe0\x8c\xf6\x43\x8e\xe3\x4a\x6d\xc5\x4f\x58\x07\x2e\x6b\xb2\x97\x13\x51\xd5\xf5\xc6\xa5\xfc\xb2\x74\x02\x5e\x52\x34\x9f\xa4\x86\xe2\x2b\x4c\x27\xe8\x37\x92\x89\xca\x28\x6d\x7a\xd5\xa1\x1f\x19\x4d\xd8\x3d\xe2\x13\x5c\xb8\x2b\x86\x60\xbc\xe5\x82\x1a\xb9\xba\x9e\xb3\x58\x78\x24\x75\x84\xcf\x54\x41\x9a\x0c\xe6\x4d\xad\x2b\xe0\x8c\x74\x85\xd9\x62\x1d\x99\x11\x73\x46\x94\xcc\x97\x3d\x84\x91\xc4\x27\x90\x43\x31\x1e\xa3\x19\x8d\x7c\x48\xa6\xa0\x5c\x1a\xb0\x90\x8a\x85\x87\xe1\xe9\xf6\x89\xa9\x7b\xa6\x52\x48\x13\x20\x07\x0b\xbd\xdb\xa5\x3a\x77\x4a\x7a\xe2\x49\xb0\xba\x5f\x46\xe9\x26\xef\x00\x98\x87\x6a\xc5\x34\xcd\x41\x03\x0c\x89\xa0\xca\x3a\xf0\x64\x1e\xe6\x5d\x34\x90\xa2\x46\xd0\xa7\xfc\x4f\x3c\xa4\x31\x67\xe0\xa3\x89\xfa\xf0\xbf\x2f\x82\xdc\xee\xc1\x03\x7c\x91\xe2\xaa\xf6\x45\x8f\xff\x9b\xcd\x60\x52\x16\x2a\x31\xe2\x82\x57\x79\xf5\x84\x1d\xbd\x8e\xa8\x6d\x5d\x16\xfd\xb6\x6c\x6b\x31\xbe\x96\x61\xb2\xf0\x9b\x9b\x68\x5c\xf8\x92\x8c\xcb\xab\x83\xce\xde\x12\x48\x94\x29\x9e\x8f\x1b\xb7\x1d\x7b\x11\x66\x22\x05\x71\xb2\x33\xf6\xcb\xc9\x35\xaf\x07\x3e\x31\x99\xc4\xf5\x7a\x64\xc4\x86\x19\xab\xa2\x2a\x4b\xb7\xab\xba\xb3\xb4\xf9\x11\x09\xa6\x02\xcc\x7b\xe6\x9e\xf5\x82\xd7\xc6\x12\xf0\xb6\x37\xc6\x08\xba\xea\xd3\x44\x68\xeb\xa7\x78\x9b\x2e\x28\x39\x88\xd3\xe4\x88\x1d\x0a\xa0\x81\x1f\xa2\xb6\x4f\x56\x85\x78\x18\xcc\x30\x57\xd2\xac\x7c\xba\x79\x83\xf1\x9d\x20\x53\x99\x0b\xbe\x8e\x31\x6e\xd5\x0e\x70\x25\x25\x40\xa1\x45\x38\x71\xc8\x22\x0d\xdf\x04\x79\xdf\xda\x95\x5a\xc7\x11\xdf\x69\xfe\x2e\xbe\x52\x0f\x30\xf0\x83\x25\x35\xc3\x11\xbb\xb3\xe1\x86\xa3\x25\xb7\x4b\x8c\xea\x46\x0b\x86\xdb\xce\x52\x98\xbb\x7c\xf5\xfd\x5c\xf5\xde\xd8\xb3\x5e\x2e\x4b\xf5\x0e\x38\x42\x74\x47\x09\xf1\x67\x78\xfc\x27\x9f\x8e\x3e\xfe\xf4\x57\x0c\xe9\x1a\x03\x9d\x2b\x07\xb5\x1d\x99\xa8\x0d\xa8\x71\x5c\xbd\xfd\x16\xc5\xe8\xb3\xe9\xea\x31\x9b\xe2\x7e\x60\xac\xde\x02\x01\x8c\xb7\xed\x02\x74\x4d\xcf\xbc\xe4\xcc\xa7\xdf\x3f\x75\xd1\xba\x14\x04\xe4\x81\x20\x3c\xac\xcb\x09\xc3\x9c\xa9\xc4\x20\xdd\x33\x88\xf2\xe3\x19\xc1\x2b\xa5\x36\x42\x8a\xbc\xde\x80\x09\x59\x23\xb7\xa1\xab\x1e\x66\x09\x87\x74\x1a\x6a\x71\x84\x4a\x07\xeb\xcf\x9f\x63\xf1\x3b\xe1\xc2\xc7\xec\xfb\xfe\x37\xd8\x1f\xdf\xf5\x9d\x8f\x16\x0c\x69\xef\x33\x4b\x8e\xad\x6a\xc7\x0e\x5e\x9d\x6b\x14\xaa\x50\x1f\x53\x1e\xbe\x70\xbb\x2c\x8a\x63\xcf\x04\xc5\x02\x7a\x91\xd8\x29\x00\xae\x24\x73\x06\x6e\x05\x91\xc5\x58\x61\x12\xdd\x3b\xee\x20\x88\x5d\xf4\x9c\xec\x60\xf3\xef\x7d\xfb\xbc\x3e\xe6\x36\xd1\xb2\xbe\xa9\x9c\xa6\xe7\x63\xa3\x98\xa2\x8e\x84\x3e\xd7\x6b\x30\x83\xbf\x5e\x47\xe2\xaf\x28\x04\x13\x57\x25\xb1\x54\x2a\x40\x11\xed\x0b\x45\x14\x2c\xbd\x6d\xb3\x01\x9a\xc0\x9e\x0b\x2a\xa2\x82\xdd\x37\xff\x7d\x9f\xaa\xca\xa7\xaa\x8a\x96\x87\x56\x5b\x7b\xe4\x89\xcc\x8d\xaa\xdf\x77\x6c\x6d\xc2\x83\x5f\x73\x45\xda\xcd\x4d\xa2\x58\x1b\x22\xa7\x06\x67\x3b\x84\x73\xb8\x82\x6f\x18\xef\x0c\xef\x5e\xc6\x1b\x9e\xeb\x8b\x08\x1f\xc4\x74\x9b\xeb\xc0\x53\x6e\xfc\x6c\x0a\xf5\x4f\x3e\x9d\x38\xaf\xd2\x6f\x3b\x1c\x26\xb4\xe1\xd0\x36\xb4\xad\x61\x98\xdf\xb7\xab\xfb\xd6\xfd\x1b\xf6\x35\x74\x31\xb8\xb3\xae\x32\xee\x64\x88\x8d\x3a\x9b\x89\x3f\xfc\xeb\x1f\xff\xf5\xbf\x0b\xca\x08\x25\x0a\xb5\x71\xe1\xe8\x21\x62\xe9\x4a\x51\x60\x63\xfb\x6a\x7b\x85\x29\x4a\x22\x2d\x99\xdc\x99\xd5\x47\x30\xf7\x0f\x59\x11\x59\x02\x01\x86\x31\x21\x1c\xe5\xc5\x89\x47\x57\x14\xd9\x7f\xd0\xa7\x66\x22\x7e\x81\xa8\x65\x58\x27\x32\xa0\xfa\x25\xd9\xba\xd8\xe8\xcd\x35\xf1\x89\xab\xfd\xdb\xf4\x85\xe9\xbf\xc0\xd8\x0d\x94\x28\x96\xfd\xb5\x7f\x1c\xf6\x82\x55\x24\x77\x91\x1b\xb7\x73\xd4\xab\xd6\xba\xcd\x46\x60\x87\x3f\x12\x8f\x71\xcf\x1d\xd5\xb6\xa9\x37\x61\xd7\xb8\x1d\x9c\xdd\x82\xb7\xcf\xff\xf3\xf2\xc7\xf7\x2f\x9f\x7f\x7c\x75\xf9\xe2\xdd\x8f\x3f\x7c\x14\xa7\xe2\xe9\x93\x27\x27\xf8\x11\x33\xe8\x85\x9c\xc3\xfe\xd4\x5f\xc4\x8a\xf9\x90\xf5\xe3\xd7\x5b\xfc\xe5\xa5\xa4\xa1\x68\x2b\x75\xcc\x22\x82\xfb\x76\xd9\x99\x55\xaf\x54\x53\x6c\x9b\x27\x3c\x5b\x02\x72\xe3\x4c\xbf\x34\xa2\x4c\x78\x7d\x1b\x3c\xd5\x9e\xb9\x4a\x67\x90\x2b\x8f\xa4\x01\xae\x5f\x58\x57\x48\x68\xdc\x5b\x56\x92\xeb\x38\x2c\xcd\x3d\x45\xc9\xf2\x70\x9f\xd9\x02\x7b\xab\x0a\x09\xff\x5e\xdb\x6f\x98\xcd\x98\x72\xb6\x5a\x9a\xb8\xe9\x2a\x2e\x2a\x48\xf3\xff\x43\x87\x7e\x4d\xff\x03\x8e\x85\xd6\xc4\xc6\x72\x98\x01\x77\x1b\x3a\x9a\x38\x91\xd2\x6c\x26\xce\xea\xa6\x75\x69\x1b\x91\xab\x83\xb6\x14\x30\xf5\xa3\xe5\xf6\x50\x5d\x87\x86\x54\xc7\x58\xfe\x74\x2a\x5e\xb0\x98\x65\x8d\xf2\xda\x39\xa6\x4c\x02\x13\x5c\x30\x14\x13\x99\x0b\x30\xc3\x14\x98\x10\xb8\x0b\xbb\xb3\x9b\x47\x2c\x3c\x4d\xc2\x53\xef\x63\xac\xf1\xf5\x54\x3c\x8f\x84\x1d\x9d\x51\x7e\x3d\x38\x03\xbb\x5b\xd4\xda\xf2\xfc\x24\xbf\x74\x12\x0f\x37\x03\x3f\x60\xbf\x83\x81\x29\xc4\x9d\xd0\x5c\xfe\xfb\x54\xbc\x59\x00\x05\xe9\x23\xc2\x1a\xa6\xca\x20\xfe\xc2\x69\x2e\xa3\x59\xbb\xd9\x34\x1d\x18\x7f\xe2\x4c\xec\x6c\xfd\x44\x28\xf6\x1f\x05\xfc\xb3\xc7\x80\x70\x69\xea\x86\x3b\x87\xca\x89\x98\x33\xc2\x43\x4e\x75\x21\x8e\xc4\x7c\xaa\x8b\x13\xe7\x05\x6e\xb9\xfd\x1a\xc4\xb6\x18\x54\x80\xc0\xd7\x9d\xc4\x1a\x32\x66\xba\x61\xd7\x94\xd7\x99\x52\x7c\x62\x73\x50\x26\xc3\xbe\xfa\xf8\xc1\x4c\x01\x87\xb1\xae\xfc\xa5\xa4\x7b\xf4\x4d\x74\x8f\xa8\x34\xa0\xcb\x60\x95\x03\xd5\xce\x35\x8b\x42\x05\x72\x54\xa7\xb7\x73\xd2\xd1\x95\x34\xe7\xba\x88\xfd\x18\x5c\x9d\xa6\xab\xb2\x28\x3f\x6a\xa1\xae\x51\x4e\x4e\x29\x1a\xe0\x16\x99\xb6\xde\x84\xbb\x49\x96\x03\x41\xb5\x76\xbf\x18\xd9\x4d\xe1\x41\x4c\xd8\x84\x0b\x4f\x13\xcc\xa2\xdf\x37\x37\xe2\xc9\x58\x3c\x76\xd1\xb7\xc8\xe2\x92\xd7\xf8\xb6\x87\x67\xef\xd5\x66\x58\x86\x17\xf3\xa2\xdb\x37\x11\x92\xca\x79\xaa\x1c\x52\x35\x5b\x3c\x1f\xfb\xfc\x7a\x0d\xb6\x61\xa9\x70\xf0\xbf\x67\x22\x3b\xd4\x5e\xa9\x8a\xb1\xed\xd4\xe7\x4d\xa3\x8c\xb1\x40\x46\x96\xee\xae\x83\xf0\xc5\x27\xd3\x4f\xfa\x3b\x16\x87\xa0\xb2\x0f\x17\x23\x51\x22\xc4\x91\x5b\xb9\x12\xc1\x0d\x32\xa4\x4c\x88\xdd\x3a\xf8\x13\x78\x10\x18\x3a\xca\x58\x5f\x2f\xc4\xa6\x36\xad\xc3\xa2\x3e\x51\xb9\x51\x2d\xc0\x2f\xbc\x0d\x2e\xa8\xb2\xc3\xf0\xff\x83\x5e\xb4\x3e\xca\x67\x39\x25\x82\x92\xc2\xd7\xa7\x9b\xe9\xea\xa0\x81\x64\xfa\xcc\x64\xfe\x36\xe6\x98\xb4\xc7\xed\x8d\xc3\x98\x16\x46\xfd\xc8\x9e\xac\xb1\x95\x9f\xbb\x52\xfb\xba\x9b\x2c\x9e\x2f\x4c\xca\xd6\x42\xc6\x88\xea\xf0\x19\x06\x34\xa0\xae\xdb\xba\x2e\x9d\xf4\xf3\x1e\xbd\x0a\xd5\x36\x4c\x83\xe2\x8a\x1c\x7c\xba\xdf\x53\xa4\x30\xe0\xc5\xd8\x47\xf3\xf1\xe9\x89\x0c\xb6\x2c\x98\x45\xea\xe4\xdd\x3d\x39\x18\x52\xbd\xb3\xa7\x2b\x20\x0e\x66\xa3\x0e\x9c\x5a\x80\xa0\x41\xb3\x0e\xe0\x4b\xdc\xdf\xe4\x5d\x73\xaf\x3d\x1c\xed\xe6\xa8\x9f\x08\x00\x5e\x6d\x84\x03\x29\xae\xd4\xa6\x3d\x92\xa5\xa5\xc4\xc3\xf1\x82\x75\xcb\x56\x1a\x76\xb6\xf4\x32\x80\x9c\xdf\xbe\xe5\x10\x92\x16\xdf\xde\xad\x2e\x4b\x40\xbf\x4d\x9d\x2b\x08\x36\x8d\xc6\x58\xf6\x11\x52\x55\xab\xed\x7b\x64\x79\x55\x4b\x75\xcc\x95\xaa\xb0\x13\x78\x20\x38\x49\x00\x9d\x79\xb0\x89\xb8\x17\x4f\x6d\xbb\xab\x10\xd8\x87\xb6\x26\xd3\x33\xd0\x81\xba\x04\x52\xb2\xa7\x02\xc4\xe4\xca\xa8\xf6\xde\x09\x17\xe1\x18\x9e\x05\x12\x26\x43\xf4\x7f\x5d\x21\x7a\x72\xbd\x03\x8f\x0d\xfa\x8b\xa6\xee\x5a\xd5\x1c\x5d\x6b\xb5\x1d\xf7\x0d\x32\x3d\x6b\xd8\xbf\x87\xc1\x92\x34\x05\xaf\xf8\x8e\x44\x00\x36\xc4\x09\x25\x8f\x53\x78\x98\x1c\x54\xed\xe1\xaa\xf6\x70\x47\xae\x55\x50\x86\xc0\xbf\xa4\x01\xe1\xb0\xf2\xde\x12\x78\x52\x04\x6d\x11\xa6\xd0\xf6\x05\x38\x31\x5b\xf3\x2f\xf5\xdc\x20\x2e\x2e\xba\x4d\xa9\xc1\x34\xf8\xcd\x4b\xe3\x41\xc4\xc5\x02\xee\xaa\x52\x19\x03\x5b\x6e\x9b\x51\x6e\x6e\x1f\xc9\xd4\x25\xdf\x8e\x33\x6f\x0f\xc1\x8b\xd3\xdb\x64\x91\x21\x8d\xd7\x6c\xc6\x2f\x32\x49\x7a\xf0\xc9\x8b\x1f\xc4\xf0\x54\xc7\x4c\xef\x03\x47\x8d\x86\x6b\x86\xab\xc5\xf4\x05\x34\xe8\x3e\x53\x3f\xbd\xf0\x7a\x2a\xd7\xcf\x44\xa0\x07\x60\xb4\x81\xde\x9c\x13\x14\x3e\xc5\x60\x07\x1b\x69\x5a\xfa\x3e\x11\xba\xf5\x5b\x6a\x49\x1c\x48\x55\x0f\x81\x8d\xb4\x6c\x55\xb9\x9b\x72\x0d\x6f\xca\x1e\x1c\x85\x67\xdd\xa1\x2b\xf1\x2d\x51\x40\x8f\x1e\x05\x60\xd2\x85\xf8\x96\x6d\x20\x7f\xdf\xb5\xb3\xd1\x09\x9e\x4a\xf4\x9e\x90\x77\xa3\xa5\x1d\x26\x96\x13\x4d\x76\xc8\xd1\x3b\x94\xc9\x7c\x45\x14\x7b\xd8\x6e\x62\x39\xc2\x60\x81\x07\xe1\x7e\xcf\x2e\xc3\x74\x36\xc0\x47\xec\xcb\x7c\x02\x8f\xa0\x2e\xc0\xeb\x93\xf1\x61\xcf\xfd\x29\x40\xbe\x5a\xb0\x4e\x0c\xe4\xc2\xc4\xb1\xf3\x26\x24\x94\xd3\x10\x0d\xfd\x2b\x78\xfc\x16\xda\x72\x17\x2e\x4c\x55\x80\x60\x46\x8a\x60\x0a\x25\xca\x66\x4e\xa8\x13\x63\x58\x81\x5c\xdf\xde\x74\x60\x9e\x6c\xb3\x87\x30\x97\x6c\x2c\xe4\x46\x63\x9a\x07\x90\xc9\xe8\x6b\x45\x0c\x94\x5d\xc4\x4f\xfe\x39\xf1\x77\xc1\x5f\x83\x60\x56\xf3\x79\xf3\xae\x79\x8d\x6e\x5a\x73\x88\x5e\x40\xb2\x67\x7b\x0f\x62\xa5\xa7\x8b\x65\xb0\xd2\x66\x4a\x82\xc3\x13\x1f\x3c\x2d\xaa\xc9\x05\x38\xe1\x51\x73\x36\x81\xb7\xb1\xf5\x3a\xc5\x4c\xb3\x5f\xe1\x48\xc8\xbf\xbe\xae\x9c\x12\xa1\x8e\x93\x35\xc0\xf8\x85\xa5\x87\x4e\xc5\x03\xef\x06\x60\x0b\x4e\xc2\x77\xe0\x7c\xf8\x77\x5b\xc0\xbe\x97\xf2\x1f\xbb\xe8\xbb\x2d\x60\xdf\x29\x8c\x4b\xf8\x6e\x0b\x7a\x6a\x78\x3e\x15\x3e\x2c\x1f\x82\x77\xc7\xa4\xb5\xd4\x98\x9c\x85\x4f\xdc\x6f\xc0\x46\x8f\x1f\x03\xfc\xa1\x12\x4c\xd3\xd1\xdb\xcd\xc2\x50\x30\x50\xb1\x87\xbf\x71\x2e\xba\x69\x77\x7c\x02\x27\x2e\x73\x31\xcc\x86\x71\x36\x34\xf5\x4d\x08\xfa\x0b\x25\x95\xda\xbe\xec\x15\x42\x72\xbe\x34\x9b\x63\xd4\x60\xef\x67\x06\xdf\xa7\x0e\xd6\x7c\xb8\xc8\xcc\x9d\x37\xdc\x28\x7e\x17\xec\x8c\x31\xdb\xe3\x41\x14\xa4\x8b\x7a\xd8\x13\xdd\x0a\x46\xc4\x66\x61\xb4\xe1\x43\xf3\xb5\x60\xe8\xf7\xb2\x5d\x65\x54\x7f\xcc\x90\x3a\xab\xca\x64\x61\x51\xfb\xc8\xee\xe2\xd6\xe1\x9d\xdf\x1b\xb0\x6d\x4b\xa7\x6c\x49\x9f\xd5\xb1\x73\xfe\xa4\x45\xa3\xf3\x2d\x77\xe1\x1d\xb9\xfb\x0c\xb6\x5e\x32\xcf\xd5\xa6\x35\x14\x2f\x5d\x14\x75\x7b\x54\xa8\x52\xaf\x35\x64\xc7\x94\xed\xca\x4c\x23\xfb\xac\xd1\xeb\xba\xc1\x90\xdd\x79\x5d\xb5\x4d\x5d\x4e\x44\x1c\xbd\xde\x39\xfa\x72\x5b\xa8\x5e\x96\x2f\x0e\xcd\x88\xc2\x18\xf8\x1d\x20\x0b\x16\x82\x61\x23\x0b\xe5\xb6\x10\xe4\x72\x01\xc5\xbe\xb2\xed\x65\x8b\x08\x1f\x77\x78\x82\x42\x22\x75\x44\xe8\x35\xc2\xae\x88\xea\x68\x0f\x98\x89\x32\x86\x45\x89\xd3\xd3\xe2\xf9\x31\x41\xaa\xc7\x37\x40\x5d\xbb\xd4\xc1\x91\x01\x33\xd1\xd9\x41\xf8\x19\xad\x0f\x27\xe8\x04\xa0\x5e\x41\xd7\x17\x80\x02\xfc\x3a\xec\x10\xe0\x68\xd0\x01\x98\xe0\xca\xc2\xbf\x43\x9c\xc4\xa2\x26\xd7\x69\x9c\x78\x03\x27\x44\x45\xbb\x6a\xea\xad\x60\x79\x23\xc4\x42\x57\x60\x90\xf2\xab\x7b\x5b\x0f\xdb\xba\xcb\x57\x87\x94\x44\xd5\x67\x4f\x75\x7a\x67\xd4\x48\x97\xa2\x6d\x24\xe4\x81\x94\xde\xa9\x84\x1f\x01\xcc\x14\x30\xe0\x36\x20\x28\xb6\x64\xfb\x89\x8b\x91\x43\xee\xcc\x2e\x76\x56\x88\x64\xb5\xfe\x9a\xe5\xa5\x92\x55\xb7\xb1\x28\x29\xeb\xd9\xa0\xd8\x3e\x38\xf8\x3c\x2f\x0a\xf0\xd5\x70\xf9\x5c\x45\x4b\xa6\x1c\xfe\x71\xdc\x07\x30\xb2\x28\x5e\x02\x12\xe7\x91\x8a\x6c\x49\x56\xb8\xe9\x7b\x52\x31\x24\x3b\x0d\x28\xc2\x23\x41\xc8\x7a\xaa\x43\xa8\xb2\xe4\xab\x2c\x0a\xfb\xf5\x24\xfd\x46\x4f\xa0\x1d\x2c\xc5\x3e\xc5\x60\xbf\x02\xa6\x21\x8b\xe2\xac\x9b\x07\x48\xf6\xc4\x4c\xd8\x93\x17\x10\x83\xa6\xdb\xd0\x39\xf9\xad\x09\xca\x87\x7d\x7b\xc2\xb6\x9e\x6f\x0c\x2f\xce\xee\x8e\x9f\xe2\xa8\x4a\xff\xd4\xdc\xcb\x00\x17\xfe\x25\x7d\xf8\x14\x5a\x44\x31\xe3\x1f\xd0\x87\x78\xb7\xf1\x3c\x92\x9d\x41\x43\x68\xb7\x39\x3e\xab\xe5\x2d\x4b\x3f\xd5\xae\x37\x6c\x6e\x6f\x0a\xd3\x7f\xee\xe2\xd3\x1b\x7c\xf2\xda\xf5\xa6\x5f\x1e\x92\xff\x8a\x64\x98\x68\x90\x64\x88\x74\x80\xe1\xee\x4d\x24\xa2\x67\x27\x7d\xd6\xcd\x4d\xde\xe8\x39\x32\x5e\xaa\x59\xc8\x1c\x79\xad\x9f\x88\x01\x20\x93\x32\x20\x3d\xa3\x6b\xc2\x48\xce\x21\x40\xe8\xfb\xe5\x51\x09\x1d\xff\x80\xa8\x05\x50\x91\x17\xb6\xf8\xd7\x20\xa6\xe0\x1c\xa9\xe2\xac\xb7\x82\x9a\xd6\x93\x4c\x51\x03\x2f\x09\x8d\x50\x1d\xe7\xee\xc2\x4d\x88\xee\x80\x27\xfb\xc5\x2f\xf5\xfc\xee\xcd\x49\x3d\x79\xf6\xed\x89\x65\xa6\xd8\x86\xd8\x9f\x59\x50\x99\x32\x02\x2d\x72\x8c\x49\x5e\x8f\x8c\x5d\x76\xcf\x88\xd9\x2a\x96\x5e\x60\xef\xa9\x37\x0f\x9f\xcd\xc4\x4b\x8f\x6a\x15\x19\x15\xf9\x1f\x75\x45\x51\x86\x66\x98\x17\xc4\xc5\x04\x00\x2f\x04\x75\xad\x58\xb8\x29\xcf\x7e\xe0\x00\xda\xe0\xaa\x21\x55\x24\x4b\xbf\x43\x9f\xd7\x72\x17\x9a\x82\x64\x76\xdd\xb5\xb2\x75\xae\x83\x42\xf0\xfc\xd3\x51\xae\x29\xff\x04\xc0\xef\x38\xfc\x8e\x6a\x81\x5e\x44\x2c\x1e\xd8\xd0\xba\x2c\x3e\xf1\x7d\x8a\x92\xfa\x47\x44\x46\xf4\x65\xcf\x1b\xdb\x0f\xd7\x41\x54\x37\x3e\xdb\xf4\xc8\x4f\xb0\xaf\x89\x1f\xfc\xb7\x87\xef\xf0\x3d\x65\x87\x9e\xc9\xfb\xed\x8f\xb8\xe8\x47\x7f\x65\x2b\xf9\x4d\x33\xbf\x1d\x7e\x0f\x22\x12\x0b\x77\xb2\x5e\x70\x19\x43\x60\x3c\x81\xa6\x5c\xaa\x36\x32\x46\x8d\xd8\x88\x7d\xb7\x43\xb9\x41\xd8\x15\xf1\x65\x19\xe7\x22\x07\x6f\x44\x84\x1e\x9c\x1a\x31\x2c\xe1\x25\x60\x2e\x0b\xee\x96\x3c\x01\x3c\x1a\x32\xbb\xc3\x05\xd6\x86\xaf\x66\x68\x86\x88\xfd\xf8\xfc\xa8\xe4\x9f\xfa\xa4\xc5\x0f\x19\x0d\x9a\xf5\x11\xd4\x87\xd4\x51\x8d\x16\xe6\xa9\xac\x91\x30\x01\xbb\x97\xda\xb4\xfb\xd6\xe5\x9d\xc8\xd8\xca\x7c\xd9\x3d\x6b\xdb\x83\xb9\x86\x1c\xe9\xc0\x24\xd3\x81\xb6\x9d\x8f\xab\xe9\xa2\x6e\x4b\x61\xea\xb5\xda\x42\x8e\xfe\xcf\x1b\x55\x41\x8e\x72\x4b\x60\x62\x2a\x0e\x53\x83\x95\xf9\x95\xde\x08\xed\xdb\xba\x48\x25\xeb\x20\xd0\xf3\x0a\xc7\x69\x42\x15\xdd\xe7\x50\x08\x5e\x77\xbe\xa6\x83\x57\xbc\xa6\x83\x4e\x72\x43\x07\x3a\xec\x21\x97\x1c\xea\x1d\x94\x46\xca\xa9\x73\x5b\xad\x93\x58\xde\x64\x56\xb2\x51\xc5\x7b\xa2\xc1\x5f\x2a\xd0\x7a\x21\xd3\x6c\xc7\x55\x55\xb7\x56\x8d\x9c\x97\xea\x18\xde\x4b\x0a\xaa\xb3\xd0\xcb\x2e\x29\x5d\xaa\xf6\x18\x0d\xd8\x0f\x20\xe4\x2e\xfe\x80\xf1\x3c\x40\x6c\x9a\xfa\xf3\x4e\x64\x18\x3f\x72\x22\x4c\xdd\x35\xb9\x82\xc4\x7f\x90\xd3\xc2\x0e\xb8\x6f\x3e\x29\x6b\x05\x5d\xfd\x07\xf2\x2c\x59\x12\xb9\xc7\x2e\xfe\xdc\x77\x7e\x01\x16\x8f\x07\xe4\x6b\xb0\xb7\x7f\x33\xd0\xff\x19\xf5\x7f\x2d\x4b\x7e\xb3\xd2\xbe\xf1\x49\x38\x71\x43\x50\xc4\x3d\x64\x3c\xdd\x48\x7e\xd1\x60\x6a\xb9\x6f\x16\x89\x08\xde\x96\x92\x35\x05\x0f\xda\xea\x1f\x5e\x9e\x35\xa9\xde\xb4\xbd\x00\x18\x5e\x8a\x15\xcc\x5e\xa1\x4f\x48\x05\x0b\xac\x23\xfb\x76\xe2\xad\x5e\xa0\x70\xad\xda\x55\x5d\xf8\x26\x6f\xf1\x67\x68\xe4\xbe\x27\xcd\x0a\xd9\x4a\xcf\x7e\x56\xba\x7d\x29\x5b\xe9\xa3\x54\xf0\x87\x85\xdc\x30\xbd\xf3\x25\xd8\x75\x04\x49\xbf\x34\x1f\xea\x1a\x5a\x07\x79\x3f\x1b\x26\xaf\xd7\x9b\x0e\x75\x4b\x30\xcc\x0b\xfa\x1d\xe6\xe7\x6b\x24\x13\x84\xcd\x13\x8f\x1e\x09\xf6\xcb\x92\x3d\x95\xb4\x97\x05\x10\x1b\x9f\x3f\x14\x84\x5e\xa1\xfe\x80\x2e\xce\xef\x2a\x1a\x60\xc1\x8e\x46\xa9\x5e\xbd\x35\x31\x99\xbb\x0e\x9b\xc1\xfa\xc0\x2f\x83\xc6\xc7\xde\xf4\xc5\xa7\x32\x80\xec\xa6\x57\xca\x92\x5c\xa4\x57\x5a\x74\x6d\xd7\x90\x29\xb4\x53\xc7\x83\xd9\x83\x6e\x21\x3f\x0d\xb9\xeb\x01\xa1\x76\x90\x46\x00\x2d\x76\x95\x5c\xeb\xdc\xb9\xcd\x5e\xa9\x9d\x43\x00\xc4\xaf\x39\xbb\xe4\xfd\x56\xcf\x51\x22\x2f\x38\x43\x71\x8a\xbe\xca\xc1\xcf\xd9\x22\x76\xfb\xc1\xbb\x97\xe2\x6c\x89\x6a\x84\xdc\x8c\x90\x7c\x08\xa4\x36\x80\x7a\xb1\xa7\xfb\xad\xb6\x1d\x8b\x05\xda\x7a\x2e\x22\x83\xb5\xb8\xf6\x76\x01\xc8\xf7\x86\x2c\xbb\x9c\x4c\x1e\x36\x8d\x36\x77\xda\x46\xdf\xc9\x8b\xc4\x61\x0c\x7d\x70\xbb\xe2\xae\xa4\xbf\x4e\xb8\x0d\xb7\xa4\x78\xee\x42\x80\x65\x51\xeb\xc8\x83\xd3\x61\xe6\x69\xdc\x2c\x69\x71\x97\x15\x44\x76\x88\xd4\x62\x3c\x43\x1f\xd9\x50\x0a\x4a\x27\x5a\xb0\x90\x64\x98\xf1\x16\xc2\x06\xce\x15\x2a\x0c\x24\xcb\x4e\x09\x9b\x35\x3d\x1c\x7f\x99\x4f\xa3\x20\x6f\xa9\x0f\xe4\x8b\x90\xf1\xb4\xb2\x44\x70\x0e\xc7\x70\x77\x4e\x47\xcc\x7b\x7e\x9f\xf1\x72\x4c\x4e\xa7\x7b\x20\xc4\xe1\xf3\xeb\x5a\x17\xc8\x6a\x90\xad\x91\xbd\x66\x28\xcb\x29\x77\xcc\xcb\x0c\x01\xc6\xe9\xb9\x7a\xa9\x79\x9d\x07\x4f\xab\xd0\xd0\x52\x5d\x93\xce\x39\xb5\x5d\x0a\xbe\x24\xbd\x48\x89\x42\x1c\xbe\xc1\x0b\xea\x64\xa4\xe8\xe9\x8e\xae\x45\x5d\x4b\x29\x8e\x41\x80\x16\xc5\x5c\xb1\xa5\x23\xd3\xef\x0d\xe6\x3c\x15\x90\x39\x17\x69\x1d\xe2\xa9\x8e\xd3\x4c\xc0\x87\x93\xa8\x25\x3b\xb5\xe1\xc4\xc0\xa9\x0a\x8d\x1c\x83\xf0\x72\xa3\x50\x8f\x14\x85\x4d\xfd\x59\x87\x99\x72\x13\x2e\x4f\x50\x7a\x91\x1f\x6a\xf5\x3f\x75\x3e\x15\xd3\x78\x2a\x7e\xa2\xe4\x9f\xce\x73\x0a\xe9\x0a\x1c\x88\xe4\xbd\x42\x06\xf2\x0e\xd0\x4c\xab\x91\x02\x74\x09\xcb\x09\xb3\x64\x94\x9b\x3b\x32\x15\x86\xfe\x00\x91\x1f\x22\xe6\x3d\x44\x0a\x85\xc9\x72\x30\xf1\xff\x40\x82\xef\x80\xf6\x2d\x12\x22\xc4\x03\xcc\xc5\xb0\xdf\x44\xfa\x8a\x60\x62\x76\x1e\xdd\xaa\xe8\xbf\x18\x2e\x54\x60\xf8\xe4\xde\x50\x52\x57\xe0\xaf\x48\x57\x41\x12\xf1\xa5\xc2\x37\xb9\x70\xf8\x8b\x24\xe3\x45\xf4\xfe\x10\xd6\x7d\x5f\x4a\x5d\x11\x8b\x0d\x2f\xbb\xb7\x51\x71\x2f\x36\x6e\xc9\x6f\xd3\x3a\x8c\xa0\x75\xc8\xe9\x49\x88\x3f\xa4\xa7\xc5\xf7\xe7\xf8\xe7\x2a\x28\x0d\x46\xab\xb6\xdd\x98\xe3\xd9\xec\xba\x53\xbf\x98\x69\xdd\x2c\x67\xd7\x5f\xcf\x96\x9d\x2e\xd4\x2c\xb8\x24\x4e\x57\xed\xba\xfc\x83\xed\xfe\xe8\x6d\x67\xda\xa3\x3f\xab\x23\x79\xf4\xda\xed\x80\x83\x67\x82\x64\xff\x62\xcc\x66\x04\x41\x78\xb5\x2a\xff\x30\xc5\x0f\x1e\xd1\x75\xf6\x17\x6e\xc6\xc0\x43\xfd\xb0\x97\x9d\xde\x56\x20\x5a\x29\xa9\x42\xa5\x27\x8c\xbb\xbb\x62\x0e\x41\x7b\x65\x95\xe8\x17\x74\xc5\x7d\x82\x7e\x65\x8f\x86\x1b\x2c\xa4\x70\xa7\x92\x34\x9d\xf5\x10\xfe\x47\x32\x2f\xc5\x04\x60\x18\xe3\x6e\x2f\x18\xc8\xf8\x5b\x66\x1c\x46\x72\x58\xe8\x8b\x71\x7d\x08\xd6\x83\xfb\x17\xe6\x1b\x90\x3e\x9b\xed\x6f\x56\x6c\x7d\x5c\xa9\x78\x66\xbd\x55\x69\xc3\x9c\x74\x29\x5f\x3c\x2c\x08\xde\xac\x08\x75\x1e\xfe\x68\x88\xda\x72\x81\x12\x49\x80\x45\x7a\xaa\xc3\x3b\xf4\x54\x41\xbc\xf8\x20\xbc\xcc\x59\xbc\x3a\x8e\x71\xec\xa4\xfb\x08\x87\xf4\xd3\x48\x35\xc3\xc2\x0e\x02\x11\x8d\x97\x79\x1f\xed\x7c\x1b\x67\xc9\x40\x0c\xe3\xef\xff\xef\xf0\x13\x80\x6d\x45\x85\xd1\x90\x93\x40\x90\x7c\xd1\x95\x06\x67\x3f\xae\xae\x1a\xd4\x56\x0d\xa8\xa5\x0e\x6d\xcb\x6c\x7c\x18\xc7\x5b\xff\x75\x48\xaf\x94\xa8\x71\x7c\x2e\x08\xf7\x4c\x92\xc8\x22\xf8\xcc\xfd\x0a\x22\xa5\x63\x72\x2b\x4a\x23\x0d\x3a\x86\x02\xe9\x79\xc6\x6e\xe0\x66\x3d\x5c\x94\xf5\xf6\x88\x36\xeb\xa8\xd4\x95\x8a\x8d\xc0\x1d\xdd\x9e\x8c\x7e\x47\x40\x76\x72\x37\xe7\x2f\xba\xa6\x70\xcd\x90\x7f\x9b\xb6\x9b\x25\x02\xf3\x54\xf6\xd9\xd9\x07\x71\x6a\xff\x85\xb8\x36\x1f\x5c\xb0\x35\xb2\x8d\x4c\x1f\xa9\x78\x2d\x64\x7a\x69\x54\xf3\x52\x2d\x28\x49\xa2\xfd\xca\x72\xd5\xdb\x0a\x5e\xeb\x4c\xef\x8b\x6f\x10\x3d\x31\xe2\x99\xff\x70\xec\xfe\x9a\x42\x26\x0e\xf1\xc5\xf6\xc0\x6e\xa4\xd4\x1c\x38\xbe\xdd\xd9\x21\xf1\xfe\xda\x88\xb5\x36\xc0\xd3\x2c\x06\x89\xa2\xe4\xd2\x47\xe8\xa9\xaf\x52\x0e\x84\x01\x6c\x6b\x24\x38\xc6\x13\x43\x31\x7e\x25\x4b\x2f\x86\x72\xa1\xde\x7a\x63\x4f\xfd\xd4\x29\xac\x11\x09\x0b\x78\x1c\x00\x36\x97\x30\x2f\xda\x03\x88\x35\x8a\x02\x15\xfc\x2f\xfe\x35\x0c\xd9\x83\x2b\xe2\xb1\x0c\x8e\x1c\xee\xde\x07\x6e\x49\xe0\x02\x22\xd3\x7a\xfd\x04\x5a\xad\x4f\x90\x61\xd3\xc1\x11\xb8\x3e\x7e\x36\x13\xb2\xfd\x1d\xf4\x19\xf6\x11\xf1\xfc\xc0\x21\x10\xc8\x0d\x2b\xa6\x6d\x77\xbe\xb3\xe9\x43\x2e\xa8\xf0\xf0\x95\xc1\xab\x71\x2f\x10\xc5\x2f\x07\xee\x92\x26\x34\x77\x38\x66\x5c\x61\xea\x03\x18\x93\x07\x16\xdc\xd9\x8c\x06\x3d\x94\xff\x19\x53\x0b\x6f\x5a\x3a\x39\x2e\xaa\x8f\xfc\xf4\xf8\xfe\x82\x8e\x88\xc4\x57\x07\xc0\x3d\xdb\x7f\x68\xaf\x79\x68\x6d\x20\xe3\x5e\x80\x60\xe2\xd4\xb2\x5f\x03\xf8\x28\xb2\xa9\x19\x46\x22\x6e\xe5\xf7\x48\x02\xd9\x68\xb4\x9a\x67\x74\x43\xdd\xbc\x11\x43\xc0\x43\x4b\x35\x3c\x52\x3a\xb9\x7b\x04\x94\x05\xda\xdb\xd6\x13\x5b\xdd\x33\x2b\x86\xf4\xfc\xac\xf8\xbe\x3c\x7a\xe4\xab\xa0\x04\xc7\xa2\x40\x74\x62\x75\xa7\x7d\xff\x32\x44\x8c\x5d\xfd\xe2\xdc\x84\xef\x5d\x99\x6b\x6c\xd8\x2c\xfb\x65\xa1\xc3\xdb\x2f\x0d\x0a\x44\x2d\xef\x1e\xfe\x14\xb7\xf6\xde\x73\x4e\x24\xb2\xd9\xfe\xb7\xe0\xc5\xbd\xb7\x02\x4c\xc5\x8d\xd1\xcb\x0a\x11\xd4\xbc\x6b\x85\xc6\xa0\x1c\x55\x0d\x46\xdb\xaa\x89\x9e\x86\x76\xa5\x53\x54\xea\x76\xe2\x9f\x27\xdf\x1d\x3a\x68\x40\x52\xb8\x54\xe7\xe3\xec\xab\x27\x15\x87\x2d\xf8\x41\xf4\xdf\x27\x3c\x1e\x3d\xda\xf3\x85\xbd\xf4\xf6\x8c\x7b\xf1\xfe\x58\x21\xea\xc6\x62\xd4\x44\xee\x2b\xa4\x64\x4b\x73\x66\x62\xf3\x97\x6a\x33\xc5\xfd\x19\x6a\xcb\xb5\x53\xa2\x9f\xf0\xc5\xbb\x1e\x78\x7d\x6d\x1f\x61\x31\x09\x35\x12\x6c\x41\x7a\xfd\x25\x3c\x5a\x4a\x22\x45\xad\x07\xb9\x2b\x7a\xca\x53\x22\xa5\xcf\x52\xdd\xc9\x51\x55\xc1\x3e\xcd\xf3\x13\xb1\x48\x04\x51\x31\xde\x87\x34\x87\xc6\x4b\x8d\x49\x3b\x42\x54\x3c\x30\x57\x0e\xd0\xd2\xa0\xc0\xea\xd9\xe1\x97\x0a\xdf\xbe\x98\x19\xfb\xa7\xb1\x8e\xbf\x51\x3a\x08\x41\xef\x02\x45\x80\x39\x2b\xf7\xb0\x54\x5f\x7e\x16\x79\x5d\x2d\x4a\x9d\xb7\xe4\x18\x20\x99\x47\xde\x27\xe2\xf1\x40\x4c\x8d\x67\xdf\x3b\x07\x14\x17\xe2\x41\x55\x4b\x76\x76\x8e\x13\x07\xc1\x3c\x44\xd8\xc2\x01\x2e\x45\xdd\x88\x87\xf7\xe6\x2b\x59\x3b\x7a\x71\x08\xe6\xc4\x33\x40\xa2\xe2\x58\xcc\x75\x55\x44\x60\x19\x0c\x02\x7b\x77\xe4\x27\x64\xb9\xec\x0d\xd9\x06\x4d\x47\x0a\xfe\x5b\xae\x04\x61\x1e\xdf\xce\x5d\x21\xc1\x18\x71\xd4\x79\xaa\xbb\x37\x33\x14\x7a\x8a\x50\xad\x61\x77\x79\xe7\xaf\xca\x63\x65\x01\x5a\xa5\x56\xc1\x53\x7e\x4f\x96\x83\xbb\x9b\xa7\xe6\xf7\x09\x3a\xbe\xd3\x52\x9d\xba\x60\xe6\xea\x51\x2a\x73\x2e\x2f\x4b\x37\x22\x04\xa9\xa7\x2f\x27\x8c\xeb\x6d\x42\xf1\x94\x7d\xbe\x8d\x09\x26\x5f\xd7\xbe\xbc\x06\x2c\x99\x47\x31\xf7\xdc\x00\x8a\x3b\xa7\x1f\x17\xa9\xe1\xe2\x7a\x4a\xf6\xfb\x6e\x49\x7e\x41\x6e\x7a\xe3\x68\x47\xc0\xa1\x2f\x8d\x9e\x3e\x9b\x41\x54\x19\x50\xb4\xaf\xea\x2d\xdc\xf0\x4d\x53\xcf\x4b\xb5\x76\xae\x35\x4e\x54\xee\xc5\x2a\x01\x8d\x91\x70\xef\x20\x98\x03\xa1\x46\x6a\xf0\x55\x9d\x90\xc2\x1e\x6c\x7f\x50\xd0\x9b\xab\xa2\x6b\x80\xef\xc7\x08\x93\x90\xaa\x0e\xa3\x19\x39\xbd\x95\x63\x21\x9c\xf0\x14\x39\x5b\x94\x55\xd2\xef\x54\x9d\x9c\xf1\x38\x23\xf0\x4c\x82\x8c\x23\xd6\xc5\xf1\x7e\x5c\xc1\xfd\x1d\x21\x42\x65\x9e\xec\x6e\x0a\x29\x7d\x53\xa9\xed\xcb\x88\x29\x89\xf1\xd7\x08\xf1\x4c\xa3\x36\xa5\xcc\x31\xc9\x12\xe1\x25\x50\xa6\x01\x43\x93\x98\x59\xff\x68\x94\xa8\x94\x01\xef\x38\x26\x09\xd3\x10\x33\xb3\x67\x60\xbd\x87\xe4\x61\x6b\xbd\x87\x22\x3b\x7c\x88\x6b\x85\xcc\x34\xb2\xb0\x1c\xe1\xf4\x30\x36\x7d\xb8\x9b\x86\x8a\xe2\x3d\x4f\xc4\x08\xd6\x34\x9a\xb8\x1d\x1b\xef\xd7\xae\xf7\x5a\xc2\x4c\x46\x13\x3f\xf7\xf1\x50\x40\x69\x5c\x8f\x41\x01\x45\x2f\xd8\x34\x64\x1a\x39\x15\x85\x2a\x87\xda\xa2\xe6\x98\xef\x06\xac\x90\xe1\x0a\xe7\xd7\x22\x98\x57\x49\x64\x95\xd7\x0f\x57\x1d\x63\x90\x7c\xde\x0b\x5f\x32\x80\xda\xfc\x55\xce\xe7\xec\x16\x07\xdc\x18\xf0\x8e\xfb\xcb\x4b\xf9\xfd\x47\xef\x3e\xe2\x3d\x98\x62\x9a\x32\x8d\x5c\x38\x38\x66\x58\x86\xeb\xd5\x3b\x7c\x31\x17\xd0\x79\x10\x04\x46\x94\x5d\xa4\x3d\x4a\x29\xdf\xae\x82\xba\xaf\x13\x98\xdb\x1f\x84\x3a\x4e\x3f\x95\xaa\xe9\xaf\x75\x91\x64\x10\xd9\x50\x61\x8f\x40\xb4\xa5\x27\x21\xa6\x98\xfd\xc9\x7d\x8c\xa8\xa8\x08\x92\x31\xdf\x53\x5f\xf9\x62\xd9\x2c\xfa\xec\xa3\x97\x78\x5e\x8b\x8d\xd5\x7f\xb7\xdf\x40\xb6\x05\x38\xbc\x68\xda\x8d\x32\x98\xb4\x87\xe2\x3f\x61\xbd\x48\xd2\x80\x89\x1a\x3c\x4d\x40\x49\xc8\xbb\xf2\x0b\xb4\xe9\x22\x52\x79\x50\xab\xe9\xa2\x6e\x5e\xc9\x7c\xf5\xff\xb1\xf7\xee\xdd\x6d\x1c\x57\xbe\xe8\xdf\xe1\xa7\x28\x31\x5c\x06\x10\x35\x01\xd1\x8e\xed\x18\x34\xad\x91\x25\x7a\xa2\x13\xeb\x31\x92\xe2\x99\xb9\x34\x17\xa7\x80\x2e\x00\x6d\x36\xba\xe1\xee\x06\x1f\x91\x74\x3f\xfb\x5d\xb5\x1f\x55\xbb\xaa\xbb\x41\x4a\xc9\x39\xf7\xfe\x71\xbd\x56\x22\xa2\xeb\xfd\xde\xcf\xdf\x16\x70\x09\x8e\x61\x51\x77\x2b\xc2\x95\x78\xe5\x23\x5d\xaf\x7b\xa7\xb1\x25\xa2\x65\xfa\x42\x76\xdf\x43\x6f\x5b\xf8\x28\x15\x48\x5d\x77\xa8\x70\xc9\x04\xf9\x73\x94\xb8\xbc\xf4\xf7\x54\xe3\x66\xbc\x86\xff\x22\xb5\x6b\x4c\x02\xb1\x71\x7f\x1f\x3c\x5b\x1c\x97\x58\x6c\x18\x35\x14\xbb\xc4\x93\x33\xf0\x4d\x58\xe3\xe1\x07\x7b\xbd\x4f\x75\x71\xeb\xec\x75\x81\x18\x20\xd8\xe6\x7a\x6d\xa9\x5c\x53\x94\xdb\xe5\x0a\x3c\x9f\xb3\xe5\xb6\x32\xaa\xdc\x12\x4e\x46\xea\x2e\x17\xb7\x75\x7b\x03\x94\x0a\xd5\xdb\x4a\xd7\x6f\x6f\xd7\xb3\x32\x77\xe7\xe8\x8d\x59\xe4\xb6\x58\x79\x5d\xfc\xcd\xee\x4e\xea\xec\x78\x91\xe5\x8d\xa9\xfa\x36\x67\xe7\xf6\x04\xdf\x54\xb7\x3d\xdd\xe5\x43\xfd\x5a\x9a\xe6\xd5\x75\xe1\x59\xf9\x7a\x5e\x65\x9b\xa6\xac\xdc\x94\xd9\x06\xc6\xde\x4e\x8e\x17\xc5\x9f\x6c\x79\x88\xa8\x9b\x84\xf4\xdb\x45\x19\x5f\xde\x03\x13\xf0\xb2\x13\x0f\xd0\xee\x46\xb4\x28\xc1\x56\xe0\x00\x8d\x17\x55\xb9\x96\x19\xd1\x76\x4d\x44\xc0\x70\x6a\x46\x4c\x89\x8d\x2a\xf0\xab\xb8\xe9\x3c\x6b\x18\x27\x25\xa2\x17\x51\xcc\x7c\x71\xa4\xed\x8b\x1b\x15\x3c\xf3\xe5\x44\xdc\xd2\xbe\x80\xa3\xca\x8f\x81\x2a\x0a\x62\x28\x84\xdc\xe2\x83\xee\x51\x0d\x48\x8d\x37\x00\x10\x5b\x3f\x5d\x61\xaf\xc5\xc4\x3e\x73\xf1\xd1\xe4\xe4\x52\x2d\xc7\xbd\x23\x0d\x1f\x05\x57\x49\xd7\xdb\xc0\x3b\x3b\xcc\x1b\x3f\x14\xbc\xa9\xc2\x5c\xd2\x6a\x3c\x32\xde\x76\xe2\x63\xf7\x86\xb4\xf8\x5f\x7b\x70\x17\xe5\xb6\x48\x23\xf1\xf0\xce\x28\x8f\x38\xce\x76\xe0\xf9\xfe\x30\x8f\x1c\x03\xe8\xea\x10\xec\xb9\xb3\xba\x89\x31\x97\x30\xcf\xcf\x59\x4d\x18\xbe\x3a\xf7\xd8\xbc\x7b\xf2\xd5\x6b\x12\x95\x25\x2a\x87\xc3\x87\xc2\x89\xe3\xce\xd8\xfe\x60\xb8\xf9\xe1\x03\x2f\xc3\x95\xce\x3b\x79\xa7\x0a\xe5\xbd\xe6\x5a\xb9\x62\x1c\x54\xf9\xd8\x1f\x54\x11\xfb\xd1\x67\xe8\x0e\xd6\x59\x99\xe6\x2c\x3b\x77\x00\xad\xb6\x42\xc0\x31\xc8\x24\x89\x22\xdc\x4e\xa2\xee\x15\xdb\xf5\xcc\x54\xbb\xba\xd7\xea\x17\x41\xd1\xe9\xbb\x7a\x42\x8e\xef\x3d\x1d\x91\xfe\x14\x23\x69\x2e\x17\x99\x3f\xf8\x1e\xc4\x7d\x13\xd7\x57\xcf\xdc\x85\x17\x5c\xc7\xe4\x75\xde\x71\x5d\x33\x8a\x04\x02\x90\x0c\x59\xa4\x2a\xc7\xc1\x3c\x33\x8b\x61\x65\x3c\xb8\x24\xfc\x18\x5f\x64\xf5\x2f\xb0\xc7\x24\x80\xb3\xd8\xd7\xcd\xa7\x6e\xe8\xef\xeb\xbc\x6c\x7e\xe8\xda\xca\x6f\xf3\x12\xb7\x72\xa1\xd7\x60\x25\xbd\x20\x17\x0d\x07\x4e\x6d\xff\x98\x65\x45\x8a\xb3\x2b\xb5\x29\x0e\x05\xf9\xa7\x82\xa5\xba\x12\x1a\x19\xe3\x4e\x32\x7f\x0a\x21\xa6\xf8\x0c\xc8\xa2\x84\x45\x2c\xc0\x92\x3d\x4b\xc7\xa8\xab\x92\x21\xb0\xe5\x7d\x7f\x42\x29\xf0\xdd\xca\xdb\x07\x6e\x03\x89\x3a\x76\xe2\x2e\x01\x24\xf6\xd5\xa1\xcd\xee\x90\x95\x39\x66\x2e\x58\xf0\xcf\x1b\x10\x94\x62\x5d\x41\x68\x04\xc1\xaf\x76\x88\x0a\x79\x84\x64\x35\x46\xff\xbc\xff\x98\x88\xe9\x1e\x11\x7f\x18\xf0\x1e\x18\xad\xeb\x24\x58\x80\x21\xe9\xe4\x3e\x7c\x70\x2b\xd8\xd2\x0c\x71\xa4\xd0\x97\x54\x9e\x56\x2c\x5c\x2b\x94\x7a\xe8\xaa\x10\xf0\x23\x30\x05\xdb\x5a\x2f\x7d\xb4\x14\x57\xcf\xa7\x2e\x80\x2b\xc8\x60\xd1\x26\xdd\x29\x11\x7d\xe6\x7a\xb1\xa9\x4c\x0d\x42\xe4\x72\x81\x3d\xc2\x57\x82\x43\x05\xfc\xba\x8f\x4f\x04\x4b\xa8\x21\x6a\x2b\x41\xd8\x00\x06\x4d\x24\x14\x3d\x24\x6f\x18\x4b\x56\xe7\xd9\xa5\xc9\x6f\x15\x52\x8b\x54\xc6\x54\x55\x59\xd5\xe3\xfd\xfb\x2f\x68\xc7\xd0\x02\x8e\x35\x5a\x3d\xb7\x12\xf1\x9a\xb1\x37\x0d\x2a\x24\xdc\x19\xf8\xe2\x0b\xfc\xc3\x45\x7f\x85\x8b\x39\x50\x5a\x48\xb1\xce\x01\x05\x6f\xc9\x8d\x5d\x8c\xa1\x0f\xef\x9a\xa8\xf7\xd0\xf8\x94\x1b\xf8\x98\x60\xa7\x46\xf1\x86\xa1\xea\x20\xf1\x53\xde\x51\x0e\x97\x8c\xa4\x6e\xdd\x19\x0e\xf9\x27\x48\x53\x43\x46\x45\xf1\x8f\xb6\x4d\x7d\x52\xd7\xe4\x87\xee\xf8\xc4\x44\x0d\xa8\xbe\x41\x02\x00\x2e\xc0\x37\xd8\xc9\xcb\x52\x53\x34\x59\x73\xdb\xcd\x50\xd7\x7f\x33\xb7\x2f\xcb\xe6\x05\xd9\xf2\xc0\x81\x4d\x94\x9e\x37\x5b\xf6\x99\x68\xbf\xca\x98\x6b\x14\x4d\x2b\x7e\x1d\x03\xcc\xcb\xab\xc5\x90\xab\xb0\x0f\xe2\xe1\x51\xcf\xdc\x61\x19\x38\x09\x98\x3f\xc2\x05\xea\x98\x3f\x87\xaa\x74\x69\x6e\x9f\xc2\x06\x01\xc7\x22\x32\x9d\xb6\x85\xcc\xcd\xa6\x24\x33\xe6\x28\x00\xe2\x25\x40\x01\xe9\x9a\x62\x9c\x63\x70\x6c\x3b\x01\xf6\x30\xe8\x1a\xc3\x3f\xb9\xdb\xab\x36\x1b\x5d\x01\xda\x0d\xe1\x68\xcc\x2f\xaf\x75\x95\xa2\x71\xb4\x6e\xc2\x75\x83\x6e\xfd\x8d\xbb\x64\xcf\x28\x57\xfe\x94\x42\x14\x90\xde\x7d\xb6\xcd\xf2\xe6\x79\x21\x12\x64\x2f\xc2\x1c\xf6\x8b\x78\x52\xd6\x7a\xb3\x01\x93\xee\xa7\x08\x7b\x4f\x63\xe6\x89\x40\x9a\xf5\xc3\x87\xa8\x09\x3e\x0b\x61\xb5\xf6\xbc\x04\xc3\xb7\xb7\x7f\x57\x85\xd1\x2a\x07\x1b\x26\xaa\x33\x09\x6a\x1c\x85\xa4\x49\xd0\xf9\x9d\x95\x06\x39\x93\x60\x1e\xa3\x3a\x83\xe6\xc2\x2a\xa3\x08\xf4\x2e\x93\xdd\x6a\x97\xe6\xf6\xfe\xe7\x75\x6d\xaa\x25\x52\xbd\xf6\xdd\x39\xd9\x47\x19\xf5\x3e\x07\x36\x87\xc0\x4f\x83\x1a\x6d\x47\xc2\x2d\xe1\xdf\x29\xf2\x2c\x21\xf1\x75\x02\xe6\x18\xcb\x64\x8f\xbc\x76\xed\x1f\xba\xb6\x79\x10\x3a\xe7\xed\x6d\x31\x17\x5a\x09\x72\x8d\x7d\xef\x1e\x97\x07\x91\xd7\xec\xe7\xdb\x58\x0e\xee\xfd\x70\xab\xb2\x42\x02\x11\xbb\xbc\x5b\xea\xdc\xb6\xdb\x69\x51\xf5\x41\xb7\x05\x3c\x46\x19\x8c\x2c\x7e\x42\x08\x34\x77\x25\xf9\xe1\xdd\xae\x22\x2a\xf4\x92\x56\x4c\x9e\xda\xa9\x99\xe7\xba\xae\x07\xa1\x07\x86\x4b\xac\x9b\xdb\xdc\x44\x89\x5d\xee\x1c\x81\x59\x47\xec\xe8\x5b\xaf\x28\x24\xf9\x4e\xfe\x0e\x1e\xb3\xdb\x8d\x0b\x5f\xde\x15\xe4\xdd\xa6\x1f\xb7\xeb\xc6\x6d\x03\x11\xd5\xf1\xe8\xae\xb7\x75\xf3\xf7\x1a\x3d\x5f\xec\x1e\x53\x28\x4f\x0f\x7a\xa9\x80\x4d\x85\xca\xd3\x72\xfd\x9a\x29\xc8\x61\xf8\xe5\x44\xbd\xff\x18\x33\xad\x61\xd0\x87\xa1\xf8\x09\xb9\xdb\x7c\x67\x64\x04\x66\x7b\x3d\x6a\x4f\x92\x70\xc2\xdb\x1a\x52\x4e\xca\x69\x07\x5e\xe0\xad\xf0\xea\x97\x33\x07\xae\x8e\xd0\x93\xb2\xf0\xbd\x42\x07\x48\xd9\x25\xfb\x5f\x59\x9c\x0d\xf7\xd1\xa9\x6a\x4a\x2c\xf4\xe8\x3c\xd8\x3c\x07\xe6\xaa\xe5\x61\xa2\x78\x83\x72\x3f\x31\x53\x58\xf5\x47\xf9\xf3\x63\x9b\x01\x77\x23\x8a\x95\xb5\x74\xbc\x23\x67\x03\x15\x03\xe5\x83\xc1\xf0\x27\xf2\x35\xe4\xc0\x61\xc9\xbb\x6e\x4e\xfd\x2d\x66\x18\x32\xce\x33\xde\x3f\xcf\x8b\x9f\x4a\xc9\xae\xa3\xe0\xcf\x59\xa6\x60\xad\xef\x6c\xa5\x30\xdf\xed\xaf\x27\x1c\xef\x1e\x76\xb6\x25\x2e\x4f\xa8\x12\x81\x68\x8b\x7e\xbb\xc2\xba\xe0\xd0\x51\x85\xa2\xdf\xe0\x9e\x54\xa0\xaf\x59\x96\x1a\x94\x3d\x24\x2e\xf4\xce\x5c\xdb\xa1\x30\xe8\x00\xd0\xb4\xb6\xd4\x98\xc9\x3f\x5b\x03\xb2\x35\x30\xa8\x98\x10\xc4\xa0\x8c\x6c\x9a\xdd\xac\x4c\x75\x9d\xd5\x26\x61\x1a\x57\xab\x45\x65\xea\x95\xab\xb2\x6b\x28\x8e\x51\x70\x70\x5f\xd0\x79\x34\x9a\xfb\xa9\xa8\x29\x1b\x8a\x81\x50\xc0\x0b\xf3\x85\x4d\xbc\xae\xca\x9b\x5b\xbc\x4b\x21\x6a\x94\xcb\xc0\xe0\x5b\x0c\x32\x59\xd4\x6a\x69\x0a\xf0\x00\x44\x77\x7a\x5e\x49\x2d\x41\x53\x5d\x48\xbd\x3d\xba\x8c\xd7\xba\xba\xc4\x45\x86\xb9\x48\xd4\x70\xff\x82\x96\xea\xe2\x02\xa2\xa7\xd9\xde\x8d\x28\xb8\x32\x14\x91\x73\xb3\x93\x10\xbb\x3a\x2c\x0b\xc2\xc2\x38\x5d\x2c\x10\x26\x27\xbf\x55\x59\xa3\xd6\x46\x17\x35\xb4\xcd\x91\x05\x21\xf0\x8e\xae\x79\x65\xd1\x4c\x43\x6d\x8b\xec\xf7\xad\xb1\x9b\x3e\xda\x9d\xb6\xe8\x2b\x08\x13\x49\xb3\x9e\xc8\x1d\x6a\x9f\x6f\x5c\xc9\xce\xe1\xd9\x5e\x89\xc1\xa9\x87\x68\x36\xfa\xd8\x26\xf2\xa1\x57\x53\xb5\xbf\x3f\x1a\x11\x95\xdc\x31\xec\xa0\x2b\xe2\x98\x70\x67\x88\x98\xcb\x6a\xdb\xcd\xbd\x3e\x42\xd9\xe6\x76\xf7\x5d\x97\xb0\x18\x76\x56\xa7\xb0\x98\xb7\xef\x59\x76\x0e\xa6\x5f\x28\x61\xe2\x2f\x0f\x3a\x84\x60\x4a\xc9\x09\xb1\x64\x09\x57\x90\xe0\x0c\x3c\x54\x38\x01\xd9\x28\xa1\x8e\x77\x19\x92\xca\xf7\xa9\xa3\x3a\x96\xd7\xf8\xf2\x1f\x7b\xe6\xcb\x16\x51\x43\x0c\x74\x27\xcb\x40\xdd\x10\xcb\x24\xab\x69\x66\x3d\x1b\x08\xdf\x9d\x00\xe9\xd8\xe7\x7c\x85\x81\xa8\xf1\x8f\x6e\xe5\xa0\xa7\xb7\x7c\xd4\x17\x76\x93\xf0\x34\x54\x1f\x49\x25\x15\xb7\xff\x02\xba\xaa\x2c\xee\x43\x55\x7d\x32\x31\xd5\x7a\xf1\xfc\x5f\x8f\x95\x90\x91\xd0\x47\xbb\xd1\x3d\xb6\x5c\xcf\xd3\x23\xc9\xb0\xca\x9b\x4e\x9d\xd8\xe7\xf2\x32\x90\xe8\x43\xeb\x5b\x74\x8e\x10\x6f\xb5\x78\x5e\xe9\x85\x74\x95\x3c\x56\x67\xe7\xe3\x79\x59\xcc\x75\x33\xe4\x8f\x09\xd4\x61\xbb\x66\xff\xed\xb2\x65\xee\x7d\xf5\x02\x7c\x39\x9d\xe7\x78\xcd\xfe\x15\x2e\xa5\x3a\x64\xf7\xf1\xef\xf1\x45\xa9\x4e\xdc\x7d\x72\x2c\xbe\x83\x50\xae\x7c\x09\x92\x5a\xf9\x1d\x44\x3f\x04\x33\x28\xbf\xe7\x4e\x76\x69\x77\x97\x4c\x69\x5c\xca\x5b\x12\x3d\x70\xca\xef\xea\xc4\x3e\xec\xb5\x39\xfd\x7d\x8b\x00\x03\x9c\x92\x71\xca\x73\x64\x97\x65\xda\xda\xd7\x07\xc7\x43\xa6\x2d\xbc\xae\x18\x45\x04\x32\xf1\xd2\x3e\x4e\x92\x0f\x95\x89\x33\x75\x12\x73\x24\x32\xf9\xca\xc5\xb3\x7d\x67\x6e\x1a\x17\xce\x96\x93\x4d\x4f\xb8\x5b\x4e\xdf\x8a\x18\x46\x5e\xc6\x29\x73\x2c\x83\xf6\x45\xdc\xa6\xae\xe5\xfd\xc9\xbd\x6e\xb8\xc2\x4f\x31\xea\x51\xc0\x42\x39\xf1\xab\x8b\x8d\xed\xc2\x02\xda\xbf\x9e\x36\x01\x11\xe3\xad\x28\x6c\xc2\x58\x40\x2c\x4c\x26\x3e\xe4\x3a\x87\x39\x20\xb1\x90\xc4\x33\xec\x7c\x71\x29\x42\x03\x60\xdd\xb8\xf7\x8c\x42\x34\xa9\x43\x07\x6a\x52\x98\xb9\xa9\x6b\x5d\xdd\x92\x8f\x0b\x18\x76\x82\x68\x2e\xe5\x98\x82\x66\x7e\xc9\xe4\x16\x16\xff\xc5\x41\xa4\xb2\x21\x27\x0e\x4d\x0d\x2e\xb6\x59\x3a\x70\xb7\x93\xcb\xde\x52\xc5\x52\x64\x26\x27\xb6\xec\xf4\x79\x12\x15\x8c\x2f\xca\x2a\x5b\x66\x76\x80\x1c\xeb\xad\x25\x24\x25\x43\x2d\x1e\xe2\xd5\x1a\xc4\x29\xe8\x3e\x01\x1e\xe6\xc1\x2c\x61\x26\x8a\xba\x3f\xe6\x0a\x32\x14\xc1\xa9\xb9\xae\x8d\x25\xe2\xae\x35\x46\xc3\x58\xeb\x4b\xa3\x60\x21\xae\x29\xac\xdd\x2c\x07\xab\xb1\xa5\x69\x94\x56\xab\x32\x4f\x11\x98\xcf\xf9\xb2\x54\x46\x34\xc3\xe6\x54\xe3\xd6\xb4\x48\xed\x62\xff\x3c\x44\x51\xfc\xfc\x6c\x1c\x0b\x60\x82\xac\x7e\x8a\xb1\x87\x53\x78\x8c\xde\x55\x5b\xe3\xac\x65\x2e\x28\x2c\x71\xea\x68\xdf\xc2\x98\xf4\x65\x59\xad\x75\x9e\xfd\x43\x13\x2a\xcb\x03\x5f\x05\xf0\x04\x88\x1f\x83\xee\xb8\xcc\x2a\xc2\xb7\x40\xc4\xef\xbe\xce\x7d\x18\x99\xb9\x0b\x1b\xc8\x25\xc2\x11\xb8\xef\x32\xe4\x9a\xe0\x94\xa2\x80\x6b\x88\x22\xeb\x0d\x55\x62\xa3\x94\xd8\x20\x45\x6c\x2f\x84\x5d\xa3\x50\x66\x9d\xa6\x74\x41\x7c\xb3\x79\x14\xd9\x6c\x74\x4c\xfc\xd1\x64\xa2\xea\xed\x66\x53\x56\x8d\x73\x06\x83\xa9\x16\x9b\x8a\x49\x5c\xa7\x23\xe2\xc9\x14\x76\x0e\x20\x00\x94\x61\x37\x05\x29\x4d\xe4\xe2\xc8\x93\xe2\x07\x2d\x03\x2b\xb7\x53\x36\x60\x10\x22\x63\xbd\x89\x8a\xf2\xb2\x09\xaa\xe1\xf1\xfb\xc9\x08\x00\x29\x83\xb0\x98\xb4\x0e\x51\x60\xcd\x68\x41\x3e\xee\x85\x30\xc6\xe3\x0b\xc8\xff\x3c\x95\x58\x36\xe3\x8b\x79\x30\xe7\x3a\x51\xb3\x44\xcd\x13\x95\x86\x86\x07\x1c\x2a\x33\x14\x7b\xbb\x53\x92\x28\x5f\x30\x69\xef\xdb\x00\xd5\x02\xab\xb2\x6c\x55\x24\xd6\x81\xd8\x99\x01\x3d\x01\xc4\xdb\xa2\x78\x8b\xfd\xf6\x33\xec\x86\x72\xdc\xca\xcb\x57\x7d\x78\x6c\x5b\x5e\x05\x90\x7d\x4f\x75\xc6\x38\xbe\x73\x5e\x42\x63\xbc\x4f\x9f\x0c\xb6\x83\xfc\xb8\xb7\xd7\x45\x88\x0c\x7b\x9e\x2f\x2f\x8a\x1e\x1d\xb7\xcc\x96\x7d\x99\x30\x10\xae\x7d\xae\x5a\xa1\x70\xf9\x0d\xf4\x5d\x16\xef\xe0\xbd\x5e\x3d\xe9\x53\x11\x42\xd6\xbc\x8a\x8f\x83\xf7\xb3\xf0\x5a\x59\x91\xb1\xcd\xe0\x08\x34\x85\x57\x21\x02\xf7\x67\xc6\xb0\x8c\x0e\x47\x5b\xf7\xed\x29\x79\xec\x9e\x97\x4c\xd9\xde\x81\x0c\xd7\x20\x68\x12\xb9\x43\x88\x0c\xc7\xc2\x93\x5d\x94\x46\x8d\xe1\x8e\xd2\x12\x75\xc9\x29\xa5\xaa\x80\x5c\x41\xc5\x7a\xcf\x6e\x40\x76\x81\x57\x52\xd0\x33\x21\x45\x13\x2d\x32\x11\x36\x2e\x5e\xae\x38\xda\xbc\x5c\xd8\x07\x94\x38\x60\x2c\xea\xa0\x57\xe3\x8b\x79\xf4\x65\xe4\x50\xdc\xb0\x2a\x7e\x49\xcb\x05\x8a\xb7\x23\xb1\xc9\x3c\x2f\x0b\xf3\xa4\x48\x5f\xe8\xea\x52\x8e\xad\xde\xe6\x0d\x47\x36\x47\xb6\x2b\x6c\x97\x09\x18\x61\xe3\x2a\x64\xf9\xbb\xee\x12\x37\x4a\xc0\x0a\xa7\xb3\x68\x38\xfe\x05\x65\x96\x01\x5b\xc9\x40\x2d\xb4\xba\x80\x0a\x3a\x6c\x1b\xe2\x70\x80\x32\x5f\xcb\x1a\xa3\x46\x2b\x86\xbb\xe7\xa0\x06\xc6\xfb\x3e\xf3\xd0\x61\x38\x5b\x79\x8d\xa2\xc0\xcf\xed\x6f\x52\x85\xf3\x2e\x6e\xb2\x00\x02\x1f\x50\x02\xfe\x72\xf4\x2d\xd6\x85\x52\x19\x0a\x02\xc4\x71\x4f\xdc\x05\x9c\x78\x81\x18\x03\xec\xe1\xe6\xa8\x51\xe2\x46\x51\xb0\x28\xf4\x3a\x06\x73\x41\x08\x42\x16\x18\xe2\x42\xc1\xc3\x39\x42\xd7\x26\x77\xb9\x83\x2a\xb9\x16\x14\x70\xcd\x4e\x00\xba\x61\x38\x11\x42\x24\x5a\x63\x28\x17\x24\x10\x31\x34\x0c\x92\xc9\x30\x04\x5a\x09\xd8\xa6\xb4\x0f\xec\x74\xc2\xc7\xe0\x31\x09\xa8\x6a\x4e\x6e\x5d\x75\x7c\xc9\xe1\xeb\x9c\x7b\xc8\xaa\x21\x16\x61\x90\x15\xf9\x13\xe4\xcc\x23\xc8\xed\xde\x75\xe2\x03\x05\x27\x0b\x05\x42\x49\x89\xbb\x56\xd4\xb0\x29\x13\x98\xbc\x6e\x7f\x1e\x9f\xa2\x54\x53\x9e\xcd\xf5\xda\xd8\xfd\x3f\x74\xe2\xeb\xaa\x5c\x3b\x56\x3c\x50\x6b\xed\xed\xed\x4d\x26\xea\x8d\x59\x5a\x0a\xb0\x8a\x5c\xd3\x20\x8c\x62\x53\xaa\x6b\x63\x6e\x08\x3e\xcd\x69\xf7\x8b\x65\x56\x98\xf1\x1e\x04\xb9\xc3\x68\x92\xce\xc2\x96\x42\x4a\x23\x64\x26\x16\x4b\x60\xb1\x7e\xd3\x57\x1a\xad\x2b\xc7\xd8\xee\xdf\x09\xbe\x0c\x64\xb3\x00\x83\x47\x88\xa1\x42\x62\xb9\xb3\x79\x2f\xed\x9e\x28\xc6\x8f\x59\x66\xcd\x6a\x3b\x1b\xcf\xcb\xf5\xe4\xaf\xba\xb8\xac\x8f\x1e\x1d\x3d\x7a\x34\xb1\x95\x1c\x62\x25\x87\x0e\xb4\x7b\xd2\x54\xc6\x4c\xd6\xda\x8e\xdd\x83\xcc\x40\x6d\x48\x12\xdb\x0d\x5f\x16\xdc\x38\xc3\xaf\x4a\x21\xbb\x6b\x3e\x2b\x2c\xa3\x80\x01\xa8\xc0\x01\xd5\x20\x9c\x06\xfa\xd7\xfb\x01\xfd\x82\x46\x0b\x32\x98\x91\x83\xb1\x80\x1c\x90\x01\xc3\xf1\x20\x44\x63\x56\x64\xcd\x54\x05\xe6\xe2\xa4\xaf\xba\xe2\xe8\xf6\x4a\xad\x6e\xd3\x0a\xac\xa2\x13\xc1\xab\x9c\xe6\xf4\x24\x54\x66\x71\x9a\xaf\x85\x7b\x82\x04\x06\xa6\xb0\xc1\xd4\xfc\x73\x76\x3a\x71\xce\xbe\x0f\x7a\x72\x8c\x2f\xec\x6b\xc8\x71\xfb\x5c\xf6\x2b\x1f\xfe\xfb\xd2\x98\xcd\x93\x3c\xbb\x42\x3a\x2c\xc0\x5a\xe8\x0a\xfa\x54\x27\xaa\xb1\xa4\x0e\x79\x0e\xc2\xd4\x78\x02\x75\x8d\xc1\xa6\x5e\xe2\x5b\x76\xe5\x62\xb9\x5f\x97\xd5\xa5\xd2\x15\xd8\xae\x58\xc6\x8d\x8a\x74\xcc\xe7\x78\x53\x99\x0d\x6a\x90\x7d\x5d\x89\xac\xb8\x5f\xa6\x86\xf1\xd1\x4f\x7a\x67\xeb\x44\xf8\xfa\x06\x29\x3f\x95\x15\x04\x66\x1e\x86\x84\xac\x17\xe9\xa1\x71\x3c\x67\xf7\xdf\xa3\x25\x0c\x96\x51\x49\xfb\x19\x0c\x12\x79\x00\xc3\x18\xba\x7d\xa0\x1e\x53\x5f\x4d\xbe\x56\x53\xef\x87\x9a\xf8\xad\x22\xc9\xa4\x64\x0f\x48\x48\x9c\x9f\xa9\x84\xc9\xc4\x4f\x6a\x58\xe6\xe9\x2f\xf8\x86\x70\x0c\x7c\xf7\x92\x7a\x2a\x32\x9a\x9e\x57\x92\x5f\xba\xdf\x34\x72\x33\xed\x44\xac\x85\xe2\x72\x87\x81\xa4\xe4\x4c\xf0\x74\xb5\xc0\x17\x13\x1f\x70\x3c\x75\xa1\xe4\x65\x4e\x11\xd5\x5b\xe4\x94\xb1\xc9\xfd\xf2\xd9\x1c\x96\x74\x20\x96\xda\x73\x1b\xbe\x3a\xc7\x89\x53\x56\x11\xf0\x9b\xb1\xa9\x60\xd6\xb3\xa2\x36\x55\x78\xc2\xed\x07\x35\x6c\xcd\xf3\xdc\xbd\x56\x57\x32\xd6\xb7\x98\xde\x8e\x09\xed\x99\x6a\x6f\x0d\xf8\xa0\xf3\x64\xb7\x63\xbb\xed\xc8\x15\xc5\x46\x72\x61\xe0\x5a\x65\x12\x35\xa0\xe3\x36\x08\xc8\x1a\x47\x5b\x46\x17\x47\xa8\xef\x98\x33\x81\xda\xd1\x3d\xb8\x55\xae\xb6\xe6\x10\xa3\xa4\xfd\xf1\xe8\xcb\xa3\x2f\x65\xd2\x33\xbc\x70\x09\x31\x33\xe9\x89\x3b\x37\xa0\xb8\xec\xe2\x52\x12\x20\xe5\x88\x8f\x02\x0e\x25\xe0\xb8\xe8\xdc\x4d\xae\x75\xee\x54\x59\x18\x68\xce\x54\x80\x6e\x8e\x51\x0b\xdb\xf1\x09\x5d\x6d\xb0\xdc\x2c\xf0\x73\x41\xa6\x15\x41\x02\xaa\x6b\x0c\x29\x6a\x2b\x5e\xb3\x45\x09\x85\xbc\x5b\x65\xf3\x15\xbc\xb9\xb2\xba\x9e\x18\x78\xd7\xab\x32\x37\x78\xa3\x72\xba\xb2\x2f\xa9\xc3\x59\x57\x7d\xb1\xef\xda\x4b\x18\x23\x9f\xbc\x0f\x6f\xb2\xf6\xe1\xec\xd8\x04\x0c\x64\x85\x13\xc8\x00\xb0\x2a\x94\xf2\xc3\xe1\x20\x9c\xe6\xa9\x84\xd3\x86\x2f\x9d\xc7\xe3\x5f\xb6\xfb\x3b\xa0\x9f\x31\x96\xf8\xce\x3d\xda\x75\x48\x0e\xa8\xbf\xc3\xfe\x79\xeb\x8d\x1e\xfe\xb9\x33\x07\xa0\xcc\x60\x0b\x63\xb7\xdb\xbb\xf2\x85\xa5\x24\x23\x0b\xef\x8e\xf7\xb1\x43\xe8\xd0\x2d\x6a\x88\xa4\x0b\xb1\x8c\xbd\xd1\xcb\xc0\x69\xfa\xef\xf6\xed\x19\xda\xc2\x91\x49\xa0\x64\x8c\x67\xba\x36\x36\x8b\x27\xc5\x05\xfa\xac\x4d\x64\x01\xe0\x26\xd7\x59\xe1\x9e\x1c\x42\x12\x54\x68\x26\xd6\xf0\x19\x99\x97\x45\xdd\x54\xdb\x39\xb2\xc2\x81\xe5\x7b\xd0\x0f\x6a\x91\x1b\x67\x08\x4a\xc8\x73\x2c\x82\xa4\x66\x0b\xa5\x1b\x94\x4a\xd7\x8d\x5e\x1a\x0c\xd5\x68\x89\xda\xa0\x2d\x55\x56\x4a\x17\x4a\x43\x50\x2e\x4f\xf3\x2d\xb4\x4d\xbc\x4d\x38\xfc\x23\xac\x42\xe8\xf9\x0d\x1d\x79\xd0\x89\x93\x13\xb8\xe0\x0e\xf7\x9f\x17\x20\x15\xf1\x11\xa4\x85\xef\xf5\x54\x41\x2c\x00\x8a\x81\x85\x23\x1d\x39\x76\xaf\x83\x91\x14\x43\x8c\x3a\xcd\x51\x5e\xed\xd7\x9f\xb0\xff\xc7\x1d\x2b\x3a\x9e\x8b\x08\x29\x32\x33\x89\x94\x8e\xe5\x34\x3b\xeb\xd4\xdb\x62\xee\x77\xb9\x2c\x95\xb8\xa5\x88\x7b\x6d\x1b\xc6\x6a\x4e\x4e\x3c\x35\x13\xd0\x94\x8c\x2f\x69\xb7\xc8\xdc\xac\xca\xdc\xb2\x0a\xc0\x93\x5a\x86\x29\x1a\x5f\x42\x37\x68\xc6\xb1\xae\x8d\x08\x7a\x08\x04\xe8\xbc\x5c\x83\x8e\x07\xb9\xe0\x6d\x83\x16\xd4\xd5\x95\xa9\x31\x7a\xd1\xca\xa8\x4a\x5f\xab\xac\x58\x58\x3e\xb6\xe1\xe0\x62\xcc\x08\x8f\x7d\x75\xf6\x93\xcc\xc6\x9c\x92\x8b\xbe\x87\x7d\x83\xca\xab\x43\x6f\x9f\xa3\x8b\x94\xa9\x35\x44\x67\x56\xb1\x9f\x30\x4c\xe5\x6b\x3f\x5c\x4f\x65\x06\xb3\xea\xaf\x1a\x27\x4d\x52\x2a\x38\xbf\xf4\x21\x90\x2b\xd9\xff\xec\x59\xc6\xbf\x46\xe2\x7e\xf1\xc8\xa4\x12\x55\x94\xb7\x37\xca\xc3\x83\x63\x41\xa7\x35\x2b\x50\xa9\xb3\xcc\xcb\x99\xce\xd5\x3a\xbb\xc9\x0a\xc2\x24\xdb\x6c\xf2\x8c\xdf\xac\xbd\x08\x88\x4c\x56\x05\xe3\xce\xca\x02\xc3\x05\xdb\x96\x9e\xfa\x54\xa2\x37\xf9\xfc\x92\xcc\xa0\xd2\x45\x6d\x27\x5f\xd4\x78\x75\xb8\x2e\x53\x93\x63\xf7\xe1\xce\x20\x23\x71\xb4\x26\xad\xf7\x5a\xe2\x3e\xc8\xef\xa3\x2f\x71\x9d\x2f\xec\xe7\xa1\x14\x9e\xa2\x8c\x25\xb8\x3d\xcc\x4d\x53\xe9\x79\xe3\x88\xce\x18\x2b\x9c\xd2\x81\xd7\xff\xa9\x2a\xd7\x70\x25\x0b\x10\x57\x3c\x0f\x8d\x5e\xba\x31\x75\xe9\x1a\x5d\x9f\x41\xe9\x24\xbb\x34\xf6\xd9\x63\xa3\xec\x5e\xd9\xf2\x10\x1b\x15\xd4\x73\x20\x3a\x4a\xdc\x5e\x19\x75\x0c\x54\x50\xd2\xce\x13\x17\xa0\x0a\x4c\xca\xcc\x71\x43\xe1\xe2\x35\x49\x76\x22\xb2\xcb\x57\x21\xf1\xcb\x9f\xbd\x7a\x11\xd0\xe3\x60\xdf\xd9\x56\x5e\x1d\xf3\x4e\x84\x8b\x00\xad\x89\x7c\x36\xf8\x39\x26\x9e\x7e\x5d\xa6\xd9\x22\xe3\x2d\x57\x97\xf6\x0d\x01\x6d\xad\x27\xa3\x1c\xab\x1e\x61\x3a\x73\x20\xe2\xd8\xd4\x02\x6b\x7e\x55\x38\xa1\x69\xd7\x8a\xe8\x59\x0d\x53\x35\x12\x6a\x29\xfe\x26\x89\x4f\x8a\x41\x0f\x41\xba\x75\x71\xdb\xb8\x38\x68\x6c\x90\xa6\x9a\x95\x2e\xdc\xee\xf5\xa3\x44\x57\x11\x87\x0e\xd8\xc9\x25\xb3\x47\x4b\x2c\x96\x6a\xc1\xff\xb2\xcb\x8a\x40\xe8\xe3\xfc\xe4\x8a\x71\x1c\xde\x0e\x8c\xca\x1c\x84\xec\x5e\xeb\x42\x2f\x51\x6f\x8e\x62\x92\x92\xc3\xf8\xc6\x17\x36\x72\x45\xb6\xb8\xdb\x8f\x18\xa0\x9b\x8e\xd6\x5e\xff\x7d\xcf\x9c\x18\xa8\x57\xf5\xda\x44\xaa\x8d\x31\x7c\xfb\xf0\xc1\x9e\xa6\xe3\x48\x3e\x6e\x99\x34\x14\x14\xa2\x48\x6f\xdf\xb2\x13\x1e\x56\x11\x9e\x56\xf7\xe6\xd9\x1f\x50\xd7\x63\x35\xdc\x3f\x64\x4f\x9a\x91\x9a\xaa\xc1\x60\x44\x78\x47\x78\x62\x04\xeb\xdd\xfd\x67\x70\x0d\xbf\x87\xee\x4e\x55\x74\xfc\xa6\xf2\x24\xba\x55\x9e\xca\x93\xd6\xe8\xe5\x14\xec\xc6\xdd\xd1\x9c\xba\xbf\x2c\x35\x1d\xbf\x0a\x4e\x47\x30\x99\xa8\xff\x34\xe6\x46\xd5\x1b\x33\xcf\x16\xd9\x7c\x4a\x92\x2b\x55\x99\xf9\xed\x1c\x54\xe0\x75\x03\x77\xf8\x3a\xfb\x87\x49\xd5\xbf\xc5\x91\xad\x17\x65\x15\x9c\x7f\x40\x49\x32\x79\x7e\x08\x1b\x84\x35\xb2\x63\xcc\xf3\xaf\x93\xd3\xed\x8c\xc5\x1e\xa8\x01\xbb\x31\xd9\x3a\xe5\x34\xe8\x98\xea\x78\xfc\x6b\xa3\x2e\x8b\xf2\x1a\xe9\x3d\x62\x3a\x9f\x06\xa4\x33\x90\x06\xe0\x22\x9f\x96\xa6\x2e\x06\x8d\xb7\x32\x81\x43\x1d\x88\x77\xec\x0b\x98\x67\x0b\x9c\x57\x17\x4e\x3f\x10\xf5\x90\x90\xa7\x4b\x47\x87\x87\xef\x02\x75\xda\xd0\x03\x1f\x9e\x85\x6b\x99\x0a\x13\x17\xa5\x2e\xf0\x07\x8c\x6c\x2a\x25\x4f\x17\xae\xcd\xa9\x6f\x1e\x00\x49\x9d\x99\xe9\x05\xf6\x64\x4a\x3d\xe2\x44\x8e\x82\x02\x77\xb6\x99\x5f\x92\xe4\xf3\x90\x97\x39\x8e\x7b\xce\xf7\x34\x66\x7b\xc7\xb9\x4e\xa4\xa0\x30\x4c\x0b\x35\x89\x61\x5a\x8c\xb6\x44\xca\x2c\x70\xdb\x96\xf9\xe8\xfb\x71\x90\x37\x32\xbd\x6d\x17\x8a\x32\x44\x22\x7a\x08\x2a\xd6\x29\xe0\x1a\xdb\xd3\x3a\xec\x44\x58\xea\xbc\xc7\x50\x81\xe0\x97\x78\x45\xe2\x5e\x98\x0c\x10\xa6\x3b\x43\x75\xf8\xe5\x4c\xd5\x3b\xa1\xb6\x04\x93\xd7\xa5\x95\xf2\xb0\x02\x32\xa7\x73\xbd\x85\x8f\xac\x70\xed\xe0\x0a\x23\xc5\xc1\x6e\x7a\xca\x52\xb9\x64\x3e\x49\x81\x23\x50\x64\x3e\x02\x22\x6b\x0f\x81\xd8\x37\x90\x04\x84\x96\x43\xb5\xaa\x8c\xbd\x7d\xd0\x44\x78\xec\x67\x2f\xa4\xb3\x9c\xa5\x03\xd1\x58\x12\xdd\x65\xa3\x4e\xbc\x21\x04\x76\x06\x63\xc0\xf8\x0f\x20\x0a\x04\x35\xe0\x00\xed\x2a\xf9\x01\xc0\xae\xdc\x5d\x9e\x6c\xff\x6d\x05\x59\xb1\xd9\x36\x83\x63\xa1\x0a\xf6\x4b\xe6\xd5\xe6\xa3\xd1\x99\xfd\x71\xce\x4b\x8b\xd5\x04\x21\x61\xef\x76\x4f\xf0\x47\xa1\x2c\xce\xa0\x0b\xe7\xfe\x14\xf0\x17\x75\xa2\xce\x44\x13\x3c\xef\xce\xb6\xd2\x17\x6d\x59\x43\xc8\x3a\x3a\xaa\x68\xe9\x8c\x6c\xaf\xdf\x3e\x7f\xf1\xfa\xe7\xd3\x8b\x97\xaf\xde\xbc\x78\xf2\xf3\xf3\xff\xeb\x54\x9d\xa8\xa3\x63\x48\x79\xf2\xf3\x7f\x3e\xf9\xef\xb7\x41\xca\x97\xc7\xb0\x6f\xae\x2b\xbd\xd9\x44\xcf\x06\xc1\x1c\x60\x14\x8e\xb5\xbd\xbf\x17\xb9\xb9\xc9\x66\xb9\xf1\xd1\x14\xa1\x2c\x99\xca\x2e\x49\x0b\x79\x6b\x20\x6e\x9c\x6e\xd4\xec\x16\xe9\x99\xe8\x7e\x67\x03\xbd\x61\x28\xa6\x20\xb7\x2a\x27\xc0\x10\xfc\x4e\x21\x4d\x38\xde\xdd\x6e\xd0\xed\x2a\xbf\xd6\xb7\x35\x5b\x77\xf4\x1b\x72\xe3\x76\xfc\xf0\x41\x65\xf5\xeb\x2a\x5b\x67\x80\x77\x13\x04\x54\x68\x55\x1f\x19\x68\xf9\xce\x04\x2e\x41\x44\x88\x39\x6a\xe1\x38\xf0\x7f\x07\xba\x32\xea\xe3\xce\x16\xe3\xd5\x89\x6e\xb8\x8b\x4e\x83\x97\x04\xa9\x0a\xa2\xff\x9d\x6d\x56\xab\xfe\xf0\xe2\xbb\xf8\x97\x2d\x44\x20\x4c\x62\x86\x8c\xb0\x21\xed\x4f\xfc\x3d\xbe\xb8\x28\x67\x17\x17\x6e\xf8\x9f\x66\x93\x4d\xd8\x42\x08\x55\x47\xa8\xfb\x04\xaa\x46\xa0\x73\xba\x26\x42\x31\x05\x72\x0c\xa8\xc1\xff\xf5\xf6\xd5\xcb\x31\x9a\xd9\x67\x8b\x5b\x5e\xf0\x87\x6a\xff\xd7\xc2\xbb\x4b\x0f\x9e\xc0\x02\x31\xbc\x38\xba\x8c\xf8\xaa\xa8\x7e\x60\x8e\x8d\x9e\xaf\xe8\xfd\x7c\xe0\xac\xbe\x69\xd6\xbc\xda\x20\x62\xde\xbc\xc1\xed\x70\x24\x03\x09\x40\xaf\xeb\xdb\xa2\xd1\x37\x60\xcc\x0d\xae\x7c\xbb\xe6\x11\x9f\x62\x6f\xaf\xd3\xe8\x25\x5f\x09\x3e\xbc\x3e\x46\xca\xd3\xcb\x00\x36\x08\xb9\xfa\x72\x21\x5e\x84\x69\x56\x43\x24\xce\xa6\x04\xdf\x91\x5b\x11\x8e\xf3\x1e\xbd\x07\x0f\x7a\xbd\xd4\xf6\xf5\x54\x45\x59\x1c\x6e\xf8\x54\x91\x73\xe6\xfd\xd1\x8a\xfb\x87\x0a\x8e\x1e\xe8\xfd\x13\x1e\xda\x31\xb9\xc3\x75\x4a\xe0\x9c\x68\x64\x20\xb7\x4c\xd8\x47\x7a\x00\x6b\xf4\x6b\x08\x20\xfb\xb6\xb5\xa5\xfb\xec\x8e\x99\x20\x0a\x48\x14\x68\x62\xd0\x12\xcf\x84\xfa\x3e\x11\x2b\x82\x4d\x21\x6d\x07\x72\x09\x0c\xcb\x17\x89\xae\x5d\x34\x8b\x10\x1f\xa2\x7d\x81\x39\x6e\x9e\xa7\x8d\xe4\x92\xfc\xfd\xec\xd1\x79\x1b\xd5\x86\xe7\xa7\x43\x16\xe4\x79\xc4\xc0\xaa\xf1\x3d\x77\x68\x1a\xd4\xfc\x31\xbc\x01\x83\x40\xc4\x7e\xdb\x75\x5c\x68\x27\xed\x2b\xcd\xd9\x3d\xfb\xeb\xb4\x6d\x11\xe4\x86\x7b\x1c\x1a\x19\x75\x37\x11\xbf\x76\x1d\x4d\x60\x64\xfc\x97\x77\x34\x24\x19\xce\x44\x79\x73\x12\xf6\xde\xb1\x07\xae\xc3\x73\xc7\x96\xf1\xc2\x54\x20\x5c\x9d\x2e\xec\xc0\x59\x5b\x86\x5f\xc6\x05\xc2\x59\x90\xdf\xe7\xd2\x34\xef\xf4\xf2\xa5\x5e\x9b\x7a\xa3\xe7\x66\x88\xc2\x24\xe5\x15\x6b\x61\x5c\xb1\x77\x7a\x09\x59\x02\xf9\xaa\xa5\x91\x81\xee\x03\xaf\xee\x43\x7b\x5f\xe1\xe5\x1e\xa8\x46\xdb\x8c\xb4\xf2\x91\xcb\x36\xba\xaa\xcd\x6b\xaa\x87\x3a\x04\x0d\xb5\x9e\x17\x57\x72\x27\xcf\x1c\x9d\x0d\x09\x74\xf3\x8c\x24\xd4\x52\xee\x5c\x9b\x66\x18\x6b\x17\x12\x35\xf0\xf2\x96\x01\x8a\xd9\xc2\x71\x4b\x8e\x53\x0e\x33\xe2\x24\x49\x62\xd6\x23\x26\x63\xf9\x9d\x6a\x6b\x80\x26\x13\xb5\x2d\x2c\x97\x59\xa8\xb2\x52\xdb\x02\x78\xfa\x14\xe4\x09\xb0\x58\x69\x3c\xcf\x8e\xf5\xd2\x8d\xaa\xc8\xf1\x8e\xc1\xda\xb2\x06\xf4\x8e\x60\x12\xcf\x78\xe8\xda\xd7\x45\x81\x5e\x64\x55\x24\xd8\x72\x87\xa4\x0e\x35\xd5\x3b\x97\xb5\x8b\x30\xf8\xac\x95\xeb\xf0\x23\x20\x15\x97\x7f\x52\x98\x11\x9e\x44\xea\x9d\xfe\x15\x91\xdd\x6b\xc9\x2d\xe5\xd5\xb2\xcb\xb8\x30\x32\x28\x6e\x6d\xb2\x30\xb7\xff\x8e\x96\xaf\x20\xd9\xbe\x7d\xf9\x76\xe8\x0e\x7d\x8f\x41\xe9\x08\xed\x8e\xd1\x12\xeb\x99\x31\x9b\x1f\xb3\xc2\x12\xc6\x2c\xf4\x0a\x0d\x00\xa3\xde\x74\xc8\x74\x5b\x0f\xab\x24\xce\xa8\x53\xca\xf7\x2a\xb1\xe4\x38\x7b\xdf\xf1\x0d\x62\x17\xdd\x5d\x52\xf8\xd1\xdd\x51\x8b\xb2\x32\xd9\x92\x3c\xe2\x06\x82\x1c\xb0\xbb\x90\x9f\x1d\xbf\xef\xc8\x3f\x37\x28\xe5\xaf\xb3\x80\xbe\x55\xd8\x95\x08\xbe\x29\x9a\x70\x67\x5e\xd1\xe3\x3f\x49\x38\x5e\x41\xd6\x9d\xa8\x54\x1d\xe6\x29\xfc\x42\x79\x94\x2a\xdf\x09\xb4\xba\x01\x42\xe8\x8b\x2f\x04\x7e\x00\x2b\xc7\x30\x9d\x2e\x61\x26\xd5\x69\x8a\xed\x65\xcd\xc5\xc9\x55\xf3\x6a\x39\x18\x05\xa6\xf1\xbc\x6d\xd0\xa6\x45\x2c\x50\xa7\xbe\x17\x18\xac\xca\x2c\xd4\x1f\xbf\xfe\xea\xe8\x2f\x7b\x60\x71\xc2\x2e\x45\x4d\xc9\xfe\x4b\x74\xd2\x1d\x8a\x25\x5e\x06\xa9\x31\x1b\xf0\xbc\xb2\x9b\x0d\xb0\x7c\xd4\x14\xd0\x0d\x2c\x9b\x6e\xab\x9a\x02\x10\x02\xa8\x69\xb6\x14\x80\x10\x04\x7b\x08\x6c\x23\x5c\xc6\xdb\x7b\x57\x0a\x3a\x02\x2d\x2c\xd2\x06\xb6\x15\xa9\x53\xb9\x32\x55\x6d\x64\x5a\xb8\xf8\xb2\x2c\xf4\xa9\xa7\x2c\xa6\xb5\x18\xd7\xc0\x84\x0e\x85\x3c\x61\xdc\x5d\x77\xd1\x6d\xf3\xfc\x98\x15\x77\x00\xbe\xcc\x76\x89\xb0\x3f\xc8\x2d\xdc\x96\x08\xbd\xda\x5d\x39\x74\x7d\x66\x3b\x57\xf0\xaf\x6f\x09\xf3\xa2\x40\xbe\x20\xc6\xf0\xc2\x3a\xca\x10\x9b\x6e\x4b\x79\x9e\xeb\x61\x4b\xdd\x99\x15\xbc\xd4\xdc\xd5\x0e\xcb\x73\xd9\xd8\x17\x5f\xc8\x9f\xd2\xae\xc8\x76\x82\x1d\x57\x02\x1f\x1d\xd7\x23\xaa\xd7\x3d\x73\xb1\xe5\x38\xd5\x11\x90\x81\x91\x23\xcb\x64\x02\xfb\xaf\xcb\xbf\xae\x40\x8f\xae\xac\x96\x31\xfb\x50\x53\x03\xb6\xc0\xd7\x06\x9e\x3a\x04\xbe\x66\xd1\xa3\xf0\xf8\xb2\x77\x4e\xd6\x90\xe8\x59\x57\xcb\x5a\x95\x55\x6a\xaa\xe9\x3d\x59\xda\xa4\x25\x01\x20\x8e\x87\x42\x44\xd9\x4d\x87\x30\x49\x78\x34\x66\xb7\x2d\xf1\xa7\xf0\x52\xaa\xca\x75\xe0\x82\x0f\x01\xc4\x3e\xd5\x0b\xe5\x2a\x72\x3f\x21\xcf\x7c\x27\x94\x0d\xc6\x80\xd1\x82\x80\xfd\x64\x0d\x2b\xeb\xa8\x37\xdb\x59\x9e\xcd\x79\x04\x09\xf6\x3f\x2b\xf6\xf8\x0e\xaf\x0e\x19\x15\x37\x1e\xd1\x98\xd6\x34\x5c\xaa\x7f\x72\x18\xe8\x68\xef\xfd\xbc\x0e\x08\xe5\x44\x1d\x78\x9d\x96\xbd\x81\x18\xbc\xc9\x0e\xc3\xe8\x3a\x33\x95\xfa\xeb\xab\xa7\x4e\x2d\x3c\x76\x60\xf1\x3e\x58\xd5\xcc\xa8\x8a\x30\x88\xdd\xbe\xf9\xeb\xab\xa7\x35\xb1\x6e\x60\x5b\x85\x71\xb1\x60\xa2\xc8\xf0\x2f\x38\x93\xa4\xa8\xdd\x71\x66\x50\x64\xd3\xad\x93\xf0\xf8\xc8\xc4\x2f\xb5\x71\x91\x07\x38\xde\x41\x22\x1b\x74\x4d\x3c\x0b\x70\x55\xc4\xe9\xe9\x86\x4f\xee\x8d\x8c\xeb\xa4\x1e\xfb\x34\xbd\x11\x92\xbb\xc3\x06\xfd\x28\x90\x0f\x7a\xfa\xeb\x96\x65\x90\xc4\xd7\x93\x77\xaf\xff\x57\xf5\x56\x68\x83\xef\xd1\xe3\x18\x09\xd9\x6e\xdf\x8e\x20\x07\xac\xa1\x64\x12\x1a\xa2\x52\x17\x19\x80\xe4\x21\x8e\x45\xed\x15\x91\xa1\x03\x57\x80\x1b\xd6\x09\x3d\x5f\x98\x9b\xe6\x5d\x36\xbf\x0c\xce\xc5\xa2\x88\x88\x4a\xce\x35\x5c\x14\x84\xa3\xef\x62\xcf\x46\xd0\x64\x4e\xcf\xd1\x9e\xc2\x0e\xac\x79\xbc\xf0\x17\xed\x67\x46\xbe\x05\x70\xa5\x2f\x02\x4d\x89\x4d\xbc\x08\x9f\x21\x9b\x25\x78\x76\x9c\x4e\xb9\x32\xb5\x69\x94\x07\xeb\x5b\xe4\x7a\xc9\xc4\x01\x7a\x42\x46\x18\x88\xec\xc5\xec\x25\x2a\x2d\xc4\x01\x7e\x6e\x22\xc3\xce\x1e\x97\x5c\xa5\xc4\x0b\x85\x88\xbd\x12\x3b\x10\xae\xc6\x98\x5e\x72\xa4\xb7\x1c\x94\x20\x03\x5b\x8f\xd5\x45\x7c\xcc\xef\x70\xcb\x94\x41\xf9\xec\x04\x49\x13\xe1\x31\xbe\x65\x3a\xcf\xcb\xeb\xba\xfd\x54\x34\x25\x46\xc2\xd0\x73\x4b\xbc\x71\x25\xa4\x24\xe7\xe0\xb5\x5d\x4f\x3e\x1a\x07\x49\x8a\x21\x5c\x32\xb7\x62\xd0\x60\x6d\xf2\x85\xdf\x39\x3e\x87\x8f\xeb\xe9\x59\x2a\xe9\x2c\x66\xdf\x2b\x89\x4b\xd3\x7e\x05\x1c\x83\x1b\xc7\xfe\xec\x8e\xfe\x89\x95\xed\x3b\x9a\xd6\x2b\xf5\x01\x3e\x92\xfb\x8b\x50\xc4\x89\xcf\x04\x8a\x03\x73\x95\x95\x5b\x16\xca\x82\x05\x0f\xea\x71\x24\x00\x25\x38\x12\xd9\x4b\x7e\x96\xeb\xe2\xb2\xc5\xc9\x7f\x02\x96\x7d\x1c\xe0\x0f\x5b\x81\xe1\x84\x68\x50\x72\x12\xc3\xa9\xec\x29\x7e\xdf\xb9\x4d\x94\x09\xe0\xaa\xba\x26\xf9\xae\xa9\x86\x6f\xfb\x11\xea\x95\xe8\xdf\x85\xd8\x0d\xf2\xd8\xa8\x1e\x64\xb2\xfe\x92\x5d\xc8\xd2\x62\x79\xed\x91\x51\xec\x4d\x88\xe2\x63\x20\xb6\x23\xcb\x02\x58\x44\xcb\x72\x6c\x1b\x77\x6e\x1f\xf4\x79\x21\x7e\x2a\x96\xea\x5d\xde\xc9\x2d\x2c\xdb\x17\xdb\xbc\xc9\x36\x39\xf1\x04\xe8\x6f\x88\x03\x62\xea\x2e\xea\xff\x58\xbd\x89\x06\x34\x08\x50\x54\x07\x51\x64\x6c\x16\xe5\xba\xfa\xc7\x83\xfb\x46\xce\x8a\xfc\xb7\x05\xf3\x7f\x1c\xae\x80\xbf\x92\xbc\xe8\xc4\x23\x03\xb4\xaf\x8d\x58\xe0\x70\x1c\xeb\x02\xb7\x59\x7a\xf0\x15\xca\x6a\xa3\x80\x6d\xd1\x93\x1b\xbd\x68\xe0\xc8\x24\xdf\xb3\x32\xf4\x05\xee\x78\xd6\x2c\x0d\x6f\xdb\xe3\xdb\xee\x62\x9b\xd9\x7b\x1e\x7a\xf0\xf0\x21\xbd\x4c\x60\xd5\xd4\xe8\xaa\x79\x67\x49\x7c\x53\xa4\xef\xd0\xd4\x67\xa7\xb9\xc8\x7d\xf7\x0c\xcb\x31\x4d\x05\xe6\x9c\xe8\x28\x05\xf8\x31\x7e\xe7\x70\xe3\xea\x44\x81\x09\x91\xcd\x7c\x08\x1f\x01\x54\x6e\x48\x1d\x77\x4b\x88\x5d\x0c\x72\x9b\x22\xed\xce\x6b\x5b\x1a\x72\x03\xad\x18\xb0\x1a\xdf\xe0\xa6\x54\x1a\x74\x13\xf0\xd8\x60\x34\x0a\xd6\x69\xb9\xa9\xcb\xea\x5f\xb6\x26\x70\xdc\x98\x4c\xd0\xe7\x50\x84\xb3\x09\xf0\x05\xa4\x42\x5c\x1a\xa1\x04\xc2\x52\x36\x13\xf2\x3c\x92\x17\xe0\x05\x81\x60\x7d\x11\x34\x11\x4c\x6f\x0b\xbd\xce\xe6\x8e\x43\x66\x5c\xcd\xac\xb6\x37\x7b\xd3\xdc\x5a\x1a\xe2\x3a\x21\xc0\xb7\x82\x7d\x05\x7d\x35\x1d\x0d\x72\x5d\x68\x78\x08\xf6\x4d\x3a\x47\xd3\x43\xbb\xc4\x6c\x52\x8b\x11\x58\xb0\xb4\x17\x1e\x5e\xad\x5b\x2e\xb8\x91\x5f\xd8\x5a\x62\x43\xc0\xd4\xb1\x01\xaa\x3b\xa7\xfd\x36\xaa\x57\xeb\xb1\x10\x63\x8a\x08\x79\x41\x14\x9f\x5d\xb1\x8c\x7b\xb6\xb4\x7c\xc1\xde\x8b\x11\xbe\xa6\x10\xe4\xf1\x85\x80\x1c\x15\x62\xa5\x78\xca\x60\x3d\xbe\xb0\x3f\x44\x1c\x09\x5b\xc7\xcf\x6c\xbb\xe4\xeb\xb1\x9f\x4f\xc1\x68\x36\xfc\xf6\x86\x70\xdc\xf9\x9b\x73\x0a\x02\xf6\x01\xbd\x8a\x9f\xc2\x65\x35\x10\xa5\x7c\x20\x1c\x28\x29\x0d\x8a\x05\xf4\x08\xf9\x24\x5b\x8a\x68\xe2\x3d\xb9\x6c\xf9\xb7\x8d\x6e\xa2\xce\x51\x3c\xa0\x56\x7d\x1c\xc8\x07\xdd\x64\xa2\xba\xc2\xde\xe2\xa5\x0a\x3e\x4b\xff\x27\x2f\x12\xbb\x08\x64\xc3\x88\xc6\xe3\x6e\x73\x82\xde\xc4\x76\x4d\x06\xf3\xa1\xdb\x01\xaf\x13\xff\xcd\xe8\x7a\x5b\x99\x21\x98\x33\x2a\x77\xa9\xa0\xb5\xe2\x43\xb5\x0f\x73\xb4\x3f\x4a\x5a\x77\x66\x3b\x66\xb6\x24\x60\x4c\x1e\xd2\xcc\xe8\x7e\x18\xe5\x10\xc2\xfd\xe3\x8e\x98\x47\xd1\x89\x53\xc1\x91\x93\xf6\x6f\x91\xbc\xac\x85\x64\x14\x9e\xa5\xb1\x3c\xb5\x93\x89\x4a\x4b\x64\xf3\xe1\x2e\x64\x2d\xc9\xa0\x56\x0b\x4d\x4e\xcf\xba\x70\xb7\x0f\xc5\x79\x61\x51\x42\x5b\x2a\xd7\x29\x89\xc3\x68\x81\x02\x60\xc7\x39\x1f\x3a\x88\x12\x48\x8f\x58\xab\xae\x2a\xbc\x61\x5e\xbb\xb1\xd3\x7c\xed\xf3\x91\x55\x9e\xc8\x84\x5f\x42\xd8\x87\xa7\x91\xa5\x5a\xd8\x6a\xa7\xa7\x26\x8e\x44\x98\xa7\x77\x56\xe4\x73\xc4\x5d\xff\x59\x18\x64\x77\x17\xcd\x3d\xb8\x97\x1f\x8d\x94\x22\xf6\x96\x94\x16\x33\x58\xd0\x8d\x00\x9f\xd0\xee\x62\x60\xec\x1b\x81\xe5\x60\x8b\xc2\x9c\x50\xd8\x12\x86\x79\x8e\x7d\x8e\xb6\x05\x61\x8f\x6d\x61\x97\x18\xa2\xe7\x25\x40\xa3\xe2\xfb\x60\xb2\xb0\x0f\xcc\xb8\xde\x6e\x7c\xd7\x81\xde\xb1\x1f\xfc\x12\xef\xf6\x8c\xa0\xe2\xc2\x6d\x14\xe4\xd4\x6f\xc3\x3a\x7c\xce\xc0\x8b\x17\x4c\xc1\x65\x4e\x7b\xb9\xb5\x2b\x08\x68\x02\xc8\x4f\xe3\x22\xff\xc9\x54\x70\x73\x2c\xa7\xe3\x8b\xb9\x30\xd7\x6e\x5a\x29\x57\xab\x33\xea\x44\xb5\xfb\x26\x8d\x52\x81\x38\xa8\x08\x27\xac\xb8\x55\xb9\x6e\xcc\x21\x59\xfb\xa7\x13\xdd\x34\x28\x9b\xe7\xc9\x1e\xfe\xf1\xcf\xdf\x7d\xfb\xcd\x48\xe8\x82\x38\x6f\x6b\x56\x5f\x84\x09\xde\x59\x8c\x3a\x80\xf2\x43\x70\x64\x22\xc4\xc5\x80\xa0\xa2\x30\xc3\x61\x25\x92\xed\x10\x3e\x68\xe4\x8f\xe6\x00\x6f\xe2\x52\x31\x13\xd0\xbd\x75\x62\xe2\x44\x4e\x1c\x3a\x97\x84\xed\x04\x60\x4e\xd2\x6e\x5e\xf6\xd2\x79\x39\x3b\xdd\xf9\x99\xcc\x7a\x1e\xf8\x7f\xf5\xa0\x37\xf2\xb4\x74\x1c\x93\x68\x92\xe3\x33\xc2\x13\xc1\x9a\x13\x90\xa8\x37\x3d\x30\x46\x38\x38\x90\x08\xb5\x07\xcb\x99\x6a\xa3\x73\x9f\x05\x7f\x89\x2c\xb1\xa0\x0a\x1b\x94\x4a\x5f\xfc\x82\x86\xb4\xf6\x4c\x60\x15\x51\xd0\x29\xe0\x5f\xb9\xf3\x80\x1d\x44\x7f\xa3\xb3\x85\x5b\x48\xfe\xcc\x76\xb9\xa9\x49\xb7\x1b\x23\xdb\x48\xdc\xb0\xe8\xa7\x6c\xef\xb8\x6b\xb6\xb9\xce\x60\xba\xb1\x62\xee\xbd\xaf\x94\xeb\x73\xc2\x52\x88\x69\x50\x19\x9e\x69\x4b\x85\xd3\x94\x79\xfd\xa2\xb7\x6c\x47\x83\xe6\xeb\xb2\x18\x00\x46\x8b\x93\x03\x12\x28\xcc\xcc\x34\xd7\xc6\x10\xc4\x49\xdd\xa9\x83\xa7\x09\x96\x97\x5c\x45\xd0\xd1\x38\x3a\xb7\x60\x61\x39\xee\xf6\x63\xce\x30\x55\x67\xf8\x17\x95\x13\xbb\x21\x8e\x5a\x81\x09\x23\x87\x94\x8a\xa5\xf9\xef\x73\xa7\x9b\x6e\x99\x60\x63\x67\xbb\x21\x81\x26\x13\x74\xe1\x76\xd8\x89\x7c\x2a\x19\xb9\x9a\xfa\xc9\x9f\xed\x74\xde\xcc\xf3\x6d\x2a\xa7\xad\xe3\x06\xe1\x6e\xb9\x80\x1a\xb4\x39\xb2\xf3\x91\xfa\xe1\x44\x3d\xb2\xfc\x03\x56\xdd\x95\xe3\x7b\xf5\x48\x9e\xe6\xca\xd4\x63\xdb\x4b\x91\xa5\x7d\x72\x63\xf4\xa1\x0e\xd3\x03\x2c\xde\x7a\xfb\x2c\xa7\x19\xb2\xf7\xf7\xb7\xdf\x7b\x30\x0c\xd4\x7f\xe5\xc2\xd6\x26\xad\xf3\x40\x4c\x33\x80\x40\xde\x75\xe4\x8e\x0b\xbb\x14\x45\x2c\x33\xc4\x6f\x31\x18\xcc\x00\x84\x4d\xff\x53\x98\xeb\xff\xb1\x07\xfa\xba\xac\x08\x8f\xe0\x23\x83\x15\x82\x74\x62\xe8\x09\x49\x40\x72\x23\x91\x06\x48\x34\x8e\xf7\x7c\xec\x64\xfa\x80\xae\x83\xf2\x8b\x3b\x10\xf2\xa3\xd0\x47\xd0\x97\x6e\x0d\xf5\xdf\x6b\x13\x09\x4f\x2c\xe5\x2a\x05\x26\x9b\x7c\xbb\xcc\x0a\x79\x44\x48\x59\x61\xd2\xd7\x90\x04\x96\x62\x3c\x9e\x28\xc5\x43\xb3\x77\x14\x3a\x3b\x1f\x09\xf3\xb0\x38\x83\xdb\x50\xdc\x81\x1f\xd4\xe1\x91\x04\xc1\x72\x61\x74\x62\xf9\x44\x9a\x82\x9f\xb2\xce\x2d\x15\xaa\xd7\xa6\x61\x5c\x0d\xf0\x35\xae\x96\x08\xae\x8b\xe7\x91\x61\x91\xeb\x44\x1d\x51\x67\x6c\x8e\xf1\xb6\xa8\x57\xd9\x02\xc3\xdb\x88\x4e\x72\x24\x3e\xe8\xd2\x98\x75\x3b\x27\x9d\x2e\xd5\x2a\xca\x36\x06\x6b\x0b\x1a\x4e\x02\xcd\xb4\x4d\xca\x82\x06\xee\xaa\x18\x2b\x44\x74\x35\x59\x1d\x71\xa5\xd1\x7c\xc2\xd1\xa3\xc9\x0c\xa4\x6e\x34\x87\x3b\xa2\xdd\xc2\x3e\x3a\x38\x8a\x76\x0a\x78\xd6\x06\x7b\x05\xbe\x04\xc0\x90\x3d\xd4\x81\x4c\x4b\xd0\x45\xf7\x53\xfb\x74\x8a\x54\x8f\x50\xb6\xfd\xe9\x4f\xb6\x86\x3f\xa9\x53\x3d\x5f\xf9\xb8\xd6\xe2\xa8\x26\x2a\x2b\xec\xa5\x47\x31\xf9\x13\x04\xfe\x27\xc4\x5c\x2c\x3b\xcf\xd2\xb1\x7a\x67\xef\x02\x53\xe8\x59\x6e\x6a\xb5\x85\xdb\x92\xec\xaa\xd1\xbd\x20\x55\xfb\xc0\x23\x50\x11\xdf\x40\xbd\xcf\xfe\x06\x20\x7f\xd4\xb9\xca\x8a\x95\xa9\x32\xec\x09\xfa\xaa\xcc\x57\x20\x85\x5e\x03\xd5\x09\xdc\xbc\x9d\xcb\x39\xc8\x18\x1f\x31\xa1\x80\x3f\x8f\x48\xd7\x4b\xe3\x7a\x0a\x16\x32\xa2\xc6\xa0\x06\xa2\x02\xe5\x72\x84\x04\x17\x2d\x4b\xf0\xd1\x85\x36\x7b\x25\xe5\x41\x9e\x68\x07\x6a\xbb\xa5\xf4\x83\xaf\x80\xa4\x09\x7f\xd9\xce\xc7\x74\xbe\x25\x70\x5a\xb5\x8f\x2f\xc0\x56\xd2\x5e\x0a\x5d\xdf\x45\xac\x0c\xb0\x17\xf5\x15\x9d\x51\x8b\xe7\xad\xf3\xdf\x95\x49\xde\x07\xc2\x43\x33\x6c\x92\x5d\x34\x71\x04\x92\xaa\x3c\xfe\x04\x01\x4b\x48\xb0\x32\x92\x64\x28\x41\x81\x3c\xc7\x71\xa7\xde\x6e\x67\x72\xad\x7e\xd9\x4a\x18\x8c\x32\x26\xd8\x3b\x9f\x0b\xe5\x23\x7e\xbc\xdd\xce\xbc\xd4\xbb\x25\xb9\xc0\x31\x4a\x55\x72\xab\x8c\x14\x6d\xc0\xba\xce\x7c\x26\xdc\x8c\xf3\x2c\x7d\xf8\xd0\x7f\xdc\x2d\x93\x0c\x66\x95\xb9\xb0\x60\x01\xe0\x9b\xef\xc9\xd9\x00\x18\x86\xc1\x39\x6f\x2a\xaf\x01\xfe\xa9\x64\x90\x50\x38\x40\xe5\x7a\xb3\x65\x70\x25\x0c\x43\x9f\xa8\x6b\x43\xe6\x03\x1c\x53\xf9\x06\x8c\x53\xed\xc5\xaf\x48\x08\x4c\x66\x4c\xbf\x6c\xbd\x4e\xa7\x56\xba\xc1\x4e\x81\x79\x4d\x93\xad\x4d\xc2\xba\x4f\x47\x9b\xf9\x19\x82\x6b\xc1\x3d\x32\x57\x65\x96\xd6\xdd\x71\xe4\x81\x06\xa8\xc9\x78\x24\xb8\x8a\x50\xc2\x37\x76\x1b\x4c\x4c\x24\x21\x79\xc6\xc2\xd4\x4d\x7d\x70\x64\xb3\xb5\x80\x8c\x64\x51\x9e\x93\xb0\xf4\x53\xfa\x1a\x55\xe0\x46\x90\xe7\xe5\xb5\x5a\x6c\x2b\x70\xf0\x76\xd3\x30\x81\xcb\x78\x42\x0f\x90\x0f\x28\x68\xdb\x73\x57\x0c\x2e\x2f\xfe\xf4\x5b\x82\xdf\x03\x4c\x85\x5f\x3e\x11\xc9\x0a\x4c\xda\xd6\x42\xc1\x4f\x37\xab\xae\x6b\xd3\x38\xf3\xbe\x1a\xa0\x8f\xdc\x22\x80\xad\x9d\x71\x93\x3f\xd7\x05\xaa\xb0\x9b\x95\xc9\xec\xe6\x00\x5c\x1b\xac\xc2\xde\xd6\x25\xce\xf0\x93\xb7\x6f\x4f\xdf\x5d\xbc\xfb\xef\xd7\xa7\x6f\x3b\xe2\x8d\xc3\x49\x70\x13\x66\xb7\xa0\xfd\xe2\xf6\x1f\xfe\xa2\x49\xf3\xf0\xe5\xf8\x2a\xa8\xca\xcc\xb7\x55\x0d\xb6\x3e\x26\x5f\x1c\xe6\x65\x79\xb9\xdd\xb8\xb5\x09\x6f\x85\x78\xa5\x88\x91\x65\x06\xd6\x1d\x35\xbf\x34\xe8\x88\xaf\x2a\xb3\x30\x15\x18\x8a\x90\x72\x5e\x8a\x38\x3a\x36\xaf\xc3\x36\xb7\x04\x72\x65\xd3\x03\x5d\x08\x87\xaa\x71\xb2\x0b\x18\xe7\xa0\x76\x15\xda\x29\xe5\x2a\x66\x96\x73\x22\x23\xa5\xb1\x5b\xc4\x48\x38\x12\x9c\xf2\xe3\x68\x9b\xf4\xbc\x30\x3e\x5b\xc0\xfe\xfa\x18\x9b\xef\x3f\x26\x72\xca\x46\x62\xa7\xc0\xdb\x19\x9b\x65\x77\xbd\x00\x72\x5a\xe9\xa1\x78\xbb\x9d\x75\x4b\x88\xe9\x90\xa9\xa1\x3d\x2f\xa1\xb7\x27\xc8\x38\xca\xf5\x66\xdc\xc2\x20\xee\x42\x19\x76\x87\x17\xee\x1f\xa8\xce\xdf\xaf\x89\xda\xbf\x80\x4c\xfb\x18\x07\xab\x2d\xba\x0b\x8f\x6c\xdc\x1d\x77\xed\x45\x3d\xe2\xef\x5d\x9d\x8a\xaf\x05\xbc\xa5\xb8\x8d\x56\x07\xc1\x8d\x88\xcb\x78\x16\xbf\xdf\xba\x15\x5c\x1d\x18\xd6\xb3\xee\xa2\xc4\x9e\xb6\xcf\x36\x8a\xbd\xd5\xda\x34\xab\x32\xad\x3d\x11\x74\xff\xc3\xfa\xcb\xd6\xb8\xa3\xea\x73\xf0\xc5\xe7\x64\x7e\x1e\xb0\x08\x9f\x9a\x50\x3c\xe2\x53\x43\x0e\xd5\xc7\xe6\xa4\x19\x86\xa6\xd4\x43\x35\xa8\x07\xe7\x67\x19\x11\x17\x1d\x76\x0a\x3b\xb5\x35\xf7\x27\x28\x1a\x76\x07\xf2\xfe\x22\x83\xd0\xf2\xa2\x9b\xc8\x10\xda\xdb\x38\xf0\x59\x57\x95\xe8\xa4\x26\xa3\xcd\x88\x09\x09\xdb\xf3\x09\x63\x22\xa4\xe2\x2f\x10\xde\xf3\xb8\xb3\x08\x23\xd1\x07\x20\x5b\x0c\x80\x25\x9a\xbc\xbb\xef\x0e\xa7\x61\x20\x22\x11\xc9\x86\x7a\x58\xa5\x56\x7f\xde\x83\x19\xf0\x54\x7c\x4c\xe8\xa2\x93\xdf\x64\x28\x35\xdf\xa5\x5d\x1b\x23\x98\x98\xe3\x78\x4b\x45\xbb\xd1\x49\x3c\xe0\x84\x8d\xba\xb9\x9c\xa5\x09\x75\x71\x40\x17\xd2\x2d\xe3\x85\x9b\xa0\x31\x87\x94\x71\x27\x06\x09\xa4\x34\x7a\x39\x8a\x22\x24\x35\xf3\x95\xa9\xd5\x70\xa3\x2d\x99\x54\x24\x82\x94\x6d\xcb\xc9\x28\x53\xec\xad\x42\x9f\x1d\xa7\x8e\x55\xfc\x10\x44\x5a\x95\x4c\x2d\x66\xef\x0b\x9e\x2e\x6b\xac\x37\x79\xd6\x0c\x07\xc9\x60\x74\x67\xe5\x59\xfd\xc6\x2c\x4f\x6f\x36\x77\x75\xb2\x31\x75\x83\x95\xb0\x73\x63\xfb\xc4\x16\xe6\xa6\x09\x90\x3d\x40\xf3\x19\x4c\xdc\xa6\xda\x16\xe6\x29\xbc\x44\x43\x87\xce\xe7\xa1\xf3\x30\xfc\xad\xb8\xb7\x21\xe7\x89\x6a\x65\x1d\x43\x0a\xf3\x7b\x14\x1d\xbd\x9d\xcb\x26\x70\x26\xe7\x3a\xd0\xce\xe6\x0d\xa3\x5a\x8f\x80\x6d\x26\x00\x30\x84\xf7\x92\x10\x6e\xe1\x87\x08\xa0\xe4\xf9\xae\x97\xa1\xc1\xa2\x67\xa3\xe2\x5d\x29\xf2\xb7\x34\x7d\x81\x90\xbf\xe0\x00\xaf\x38\x47\xb8\x16\xf2\xa0\xfa\xa9\x3d\x2d\x9a\xea\x16\x2b\xa6\x77\x09\x43\xf4\x5f\x78\x70\x6d\xd5\xf6\x4e\xe9\x58\x23\xa8\x08\xdd\xc2\xa1\x32\x1f\x34\x0d\x2a\xb4\xdf\xb7\x15\x98\x29\x45\x4b\x96\x1e\x1c\x1c\xb5\xe6\xc7\xcf\x8e\x4d\xb5\xc7\xee\x01\x15\x07\x27\x48\x4e\x71\x1e\x37\x94\x38\x96\x4e\x8e\x3e\xd7\x5d\x30\x8e\x1f\xb9\xd7\x2c\x9f\x07\xcf\x0f\xd8\x9b\xeb\xf2\x0a\x80\xb7\x6b\x26\x26\x3e\xa2\x95\x14\xed\xf5\x77\xb7\x1b\x94\x62\x23\x46\x5f\xa2\xf0\x84\x24\x28\x8b\x3e\x27\xdc\xc6\xbf\xf1\x2e\x22\xd0\x17\xbb\x1e\x53\x35\xb0\x9b\x0b\x11\x4b\xc1\x2c\x8c\xf1\xa2\x18\x04\x86\x83\x40\x4c\x59\x2b\x01\x12\x15\x33\x0d\xda\x4e\x48\xd2\xd0\x9b\xb4\xd6\x37\x53\xdf\x3d\x8c\x7f\x75\xee\xf0\x38\x89\x4f\x12\x78\x9c\xf4\xc5\x9b\x23\x63\x18\x1a\x3a\x5c\x21\xb7\x6b\xa7\x49\xc6\x3c\xa1\xb3\x85\x22\xfd\x00\xf0\x33\x68\xc2\x7d\x0b\x6d\x9e\x6d\x15\xb0\x15\x50\x04\x12\x4a\xe7\xe9\x8c\x61\x9e\x71\x70\xd4\xda\xdb\x59\xe6\xa2\x5d\x4d\x9f\x6c\x0f\x5b\x38\xc9\x04\x61\x2b\x7a\x48\x5f\xee\xee\x1f\x86\x7a\xb9\x06\x14\xea\x01\x2d\xd0\x40\xda\xc5\x5f\xe9\xbc\xab\x9f\xd4\x45\x99\x93\x5e\x05\xa7\xde\xc1\x67\xc3\x56\x40\x2f\xc6\xb1\xe3\x8e\x3e\x06\x81\x66\xa8\x75\xda\x03\xff\x9a\xd6\x1f\xdc\xdd\x3c\xce\x1d\x4a\xbf\xc5\xd4\x91\xd2\x3d\x98\x39\x02\x16\x13\x71\x73\xc6\xe4\x51\xe8\x85\x59\x7c\xe3\x2e\x4d\xf3\x53\x56\xd5\xfe\xe6\x03\x23\x02\x84\x2b\x13\xa2\xaf\xb6\x1d\x84\x73\x9c\xde\x05\x5a\x8d\xde\xd1\xd1\xd5\x19\x38\x07\x03\xcb\x46\xc7\xe8\x3e\x57\x72\xdf\x3d\xec\x4d\xf6\xbd\x44\x8f\x85\xfa\xa8\x0e\x42\x5b\x7c\xfa\x25\x33\xb0\xbe\x08\x33\xd0\x2f\x79\xbf\x7b\x52\x78\x42\xc1\x52\x21\x4b\xea\xbe\x0f\xb9\x0d\xb8\x39\x99\x3e\x71\x8b\x4a\xa9\xb4\xb0\xa3\x91\x0c\x7b\x0c\x16\x64\x71\x75\xdc\x23\x92\xc3\xa1\x69\x13\xd6\x45\x49\x5c\x17\x95\xe9\x20\xf5\x25\xa6\xf6\xc7\xbd\x70\x96\xc4\xb1\xf2\xdf\xf9\xce\x81\x74\xff\x88\x2b\x15\x3c\xe4\x98\xca\x8f\xb7\x0a\x00\x92\x70\x27\x60\x8c\x67\x06\xbb\x72\xa3\x84\xe8\xb1\x52\x16\xc7\x7e\xd6\x2c\x19\xc1\x90\xf2\x69\xb6\x00\xd9\x40\xa3\xf2\x72\x1e\xc7\x82\xf3\x95\x95\x20\x48\xd6\x10\xa4\x22\x43\x28\x59\x53\x94\xdb\xe5\x4a\x0d\xff\xf8\xd5\x97\xdf\x7c\xe7\x43\x2b\x3f\x6e\x6d\xde\x31\xa3\xd0\xa9\x87\xed\xdd\x09\x4f\xdc\x63\x35\xdc\x9f\xa2\x05\x69\x57\xfa\x88\x70\xea\x5c\x1b\x53\x3f\x74\xb9\x6f\xfc\x1b\x27\xd7\x67\x07\x06\xbe\xcb\xdf\x07\xb9\x4c\xc3\x87\x50\x6e\xfc\x40\xdb\xf9\x06\x54\x14\x54\x1d\xf2\x26\x68\xbd\xa4\x9c\x64\xbf\xa1\xca\x44\x7e\x6f\xb1\x7d\xc1\x03\x1d\x59\xa7\x77\x57\x41\x31\xbd\xb6\x85\x51\x65\x6e\x1f\x1d\x65\xec\xf3\x10\xb2\x3d\xf6\x4e\x5a\xeb\x1b\xbb\xa5\xa1\x16\xc2\xaa\xf8\x41\x01\xb6\xc1\xf3\xa2\x71\x59\x22\x6e\xad\x9f\x86\xaa\xcf\x1e\x9d\x33\x1d\x85\xe2\xe4\x90\x98\x92\xd6\xf4\x7b\x72\x0d\x42\x20\xea\xc0\x98\xb7\xed\x2d\x0e\xc2\x7d\xb8\x59\xbf\x40\x70\xc8\xb3\x47\xe7\xce\x33\x1c\x20\x98\x31\x58\xff\x53\x8f\x3e\x89\xd4\x87\xa3\x46\xa6\xfe\xcf\x5e\xc9\xc3\xbf\x03\xb4\xeb\x93\xd7\xcf\x43\x5f\x2b\x34\x77\xf4\x11\x0c\x17\xd9\xf2\x19\x5c\x76\xa8\xd2\x70\x5f\xc6\xf6\x40\xf5\xc4\x88\xc3\x4c\xe4\xe9\x48\x24\x9b\x2b\x57\xb7\xcb\xd1\x54\x45\xa8\x2d\xcf\x10\x51\x93\xc1\x41\x49\xf2\x3c\xc6\x9a\x08\x36\x27\x01\x2b\xf9\xac\x48\xb3\xab\x2c\xdd\xea\x5c\x2d\x32\x93\xa7\xb5\x07\x69\xe1\x5b\x4b\x48\xfa\xed\x84\x77\xca\x9c\x87\xa0\xcf\x1a\x60\x03\x83\xc4\xf7\xd9\xa1\x2f\xb2\x4f\xe3\xb6\xc9\x72\x29\x7e\x99\x4c\xd4\xcb\x57\xef\x4e\xa7\x04\x9f\xaa\x2d\x1b\x54\x22\x1c\x6e\x86\x3e\x4e\x1b\x5d\x39\xe7\x64\x72\xe8\xb4\x53\x7f\x48\xf6\xdf\x95\xc9\x6f\x31\x86\x89\x73\x8b\x5c\xab\x6d\x91\x9b\xba\x56\xb7\xe5\x16\x8d\xa3\xae\xed\xff\x53\x1d\x55\x56\x5f\x8e\x59\xdb\x6c\x7b\x73\x22\x94\xec\x53\xf8\x7f\x26\x20\x1b\x63\x59\x75\xfc\x97\x28\x47\xa1\x6d\x98\x06\xbf\x12\x21\xe4\x62\x37\xc2\x69\xf4\x3b\x70\x7c\xc3\xd5\xac\x0d\xbc\xf7\xf6\x43\x6a\x72\xd3\xa0\x84\x23\xe7\x6f\xc2\xc5\x8e\xff\x74\x15\xf4\x59\x8a\x3a\x0a\xf4\x93\x84\x5a\x5d\x82\x85\xfe\xaa\x3f\x7a\xe8\x61\x8a\xef\x09\xbe\xb5\x4d\xa9\xb2\xd4\x14\x4d\xb6\xb8\x85\xb9\xde\x9f\xe9\xda\xec\x07\x2f\x4a\xc3\x82\x75\x40\x98\x06\xa4\xf3\xc3\x92\xa1\x12\x24\x12\x09\xa1\xd8\x66\x05\x20\x77\x0e\x6a\xb5\xde\xe6\x4d\x76\xe8\xd4\x19\xf5\xdc\x14\xba\xca\xca\x7a\x1c\xce\x07\x4a\x79\xd4\x89\xfd\x06\x9d\x24\x79\x8f\xcc\x22\xe3\xb1\xb4\xae\x04\x0a\x05\x86\xe6\x09\x64\xbe\xa0\xa4\x22\x3a\xf8\x76\xea\x6a\x77\x9f\x42\xc9\x24\x25\x91\x75\x85\xbb\x3b\xd8\x2e\xa2\xf7\x3c\x49\xe1\xe8\xe0\x20\xab\xdf\x02\x74\xf6\x20\x81\x25\x5b\x9a\x66\xaa\xf8\xdb\x1b\x46\xd3\xde\xfb\xf8\x09\x35\xd6\x35\x7b\xb0\xcb\x3a\xa5\xf8\xc7\xdf\x30\x3b\x85\x15\xa1\xe0\xd2\x83\xf5\x88\x9f\x63\xdf\x18\xde\xc6\x23\xc4\xcb\x23\xbb\xfc\xbe\x50\xb7\x96\xd3\xa9\xeb\xca\x79\xaf\x52\xe4\x75\xb2\x2d\x40\x17\x8b\x5d\xf7\x51\x4f\xbd\x34\x5e\x00\xa5\x9a\xf6\x35\x8e\x7d\xb4\xb3\xc6\x0e\xf0\x27\x6a\xf0\xe5\xf8\xeb\xf1\xd1\xb7\x83\xe3\x20\x2c\x92\xbf\xb9\x08\x3c\x1d\x3d\xb7\xaf\xcd\xcc\xd9\x62\x83\xaf\xb6\xcd\xe1\xc2\x69\x38\x8f\x79\x7d\xad\x6f\x6d\x2d\x04\xc1\xec\x20\x46\x31\x03\x8e\x11\xa3\xae\x32\x70\xd1\x93\xa6\xa9\x20\xa0\xf3\xa5\x79\xa1\x37\xc3\x01\xc0\x48\x24\xa0\xa4\x1a\xd0\xbc\xea\xa6\xa9\xb2\xd9\x16\x03\x4f\xf9\x70\x62\x80\xc7\x0e\x40\xcf\xa0\x5e\xb0\xbd\x24\x50\x0c\x68\x42\xcf\xe7\x66\xd3\xfc\x02\x58\x5d\xa2\x7e\xc0\x81\x4c\xec\x9c\xe8\xca\xe8\x04\xcf\x50\x52\x9b\xdc\xbe\x22\x9b\xaa\x5c\x56\x06\x5b\x06\xab\xc5\x6d\x6d\x8f\xcd\x6b\x44\xab\x14\xb7\x8d\x5e\x26\x0a\xb7\x9e\xed\x5c\x20\x46\x24\x2c\x62\x0d\xe3\x3a\x39\x61\xe8\x4a\xbb\x87\x44\x97\x08\xac\x89\xa5\xd4\x20\xbe\x9e\x6d\x9b\xa6\x2c\x06\x4c\xab\x8b\x2a\xb0\x7b\x26\x45\x79\x2d\x03\xba\x60\xdf\x07\xa3\x8e\x02\xc0\xe4\xc4\xf9\x11\x02\xb3\x2b\xfb\x7a\xdb\xaa\xfc\x2a\x4b\x4d\x09\x04\xe7\xc8\x45\xa7\xc8\xea\x53\xb2\xb6\x6f\xaf\x1b\x40\x38\x58\x96\x3a\x6b\xf4\x2c\x37\x49\x5a\xe9\xe5\x12\xfe\xaa\x37\x26\xcf\xa1\x43\xb0\xa0\x58\xd1\x8f\x65\x99\x1b\x5d\x44\xb5\xec\x29\x35\x00\x5d\xea\x62\x9b\xe7\xf5\xbc\x32\xa6\x48\x00\x01\x39\xd1\xdb\xa6\x5c\x94\xf3\x6d\x0d\x7f\x6d\x72\x7d\x9b\xd0\x18\x13\x30\x74\x9c\x37\x89\xed\x41\x55\xe6\x75\x92\x9a\x79\xae\x2b\x93\xa0\xeb\xde\x80\x18\xd1\x84\xfe\xe5\x62\xf4\x13\x86\xce\x3f\x78\x9e\xed\x6f\x53\x25\xe4\xbe\x9c\x72\x4d\xa8\xb0\x4c\x93\x45\x59\xad\x8b\x92\x95\x07\xc9\x2a\x4b\x53\x53\x24\x59\x91\x9a\xc6\x54\xeb\xac\xb0\x1f\xb3\xc2\x54\x4d\x92\xd5\x6b\xbd\x49\xb2\xc6\xac\xc1\x05\x39\xc9\xcb\x72\x93\xac\xc9\x2b\x91\xab\xc5\x2e\x14\xe5\xaa\x32\x8b\xc4\x5e\x47\x75\xf6\x0f\x93\x14\x65\xbd\xd2\xa9\xfd\xd7\x35\x54\x94\xd7\x95\xde\x24\xe5\xc6\x14\xc9\xc6\x1e\xc5\xb2\x30\x37\x59\x93\xb0\x7f\x3d\x57\x58\x99\xdf\xb7\x59\x65\xd2\xa4\x32\x00\xb2\x92\x26\xe8\x00\x9d\xd4\x46\xaf\x2d\x2d\x91\xb8\x81\xd6\x65\x85\xeb\x05\xc0\xad\xf6\xac\x72\x25\x96\x32\xad\x37\xc6\xa4\x89\xdd\xa1\xf6\x24\x00\x43\x98\x5c\x65\x75\x36\xcb\xcd\x60\x8f\x17\xf3\x26\xcf\x8a\xcb\x97\x6f\xed\x8d\xb2\x6a\x9a\xcd\x74\x32\xb9\xbe\xbe\x1e\x5f\x7f\x35\x2e\xab\xe5\xe4\xe8\xbb\xef\xbe\x9b\x40\x8e\x81\x5b\xfb\xff\xb2\x3f\x83\xf3\xe4\xe5\xe5\xec\x66\xaf\xd7\x66\x3c\x5f\xe9\xea\x49\x33\xfc\x7a\x84\x3b\x72\xea\xac\x44\xc6\x75\x9e\xcd\xcd\xf0\x51\xa2\x38\x0d\x5b\x70\x1b\x75\x69\x1a\x68\xa4\x75\x70\x5b\x0d\x51\x6f\x28\xe1\xb1\xac\xfe\x1b\xe4\x78\x39\xbe\x24\x30\x60\xe2\x28\xfc\xa4\xf3\xfa\xd6\xee\x5f\xbe\x62\x3a\x84\x32\x4c\xcd\xeb\x9c\xf9\x53\x4b\xd3\xe3\xcf\x13\x16\x88\x77\x99\x13\x2e\x4d\x01\x16\x4a\x1e\xe4\x5a\x04\xcf\xb1\xed\xa7\xc2\xd3\x63\xcc\xe8\xa3\xde\xf9\x25\x08\xba\xb6\x27\xb0\x92\xe2\x84\xeb\x55\x96\x9b\x00\x22\xe9\x65\x27\xaf\x38\x0a\x20\xf4\x58\xe2\xdd\x9f\x3f\x70\x2f\x06\xce\xd4\x95\x63\x30\xa5\x97\xdc\xf5\x10\xa4\x9e\xad\x60\x60\xfc\x10\x53\x21\xcc\x2d\xc2\x35\x78\x8b\xe9\x60\x18\xc1\x14\xf8\x1f\xe4\xdb\x13\x00\x7f\x89\xac\x0e\xbb\xe3\xde\xfd\xc2\xde\xc4\x85\x3a\x4d\xb9\xc9\x5b\xc6\x16\x65\xb4\x24\xdd\x64\x73\xf8\x40\xd1\x67\x11\x03\xa9\x1d\x50\xd2\xb5\xa7\x18\x5a\x8a\xc6\x21\x77\x18\x76\x54\x54\x3a\x55\x84\x23\x8c\xb8\x55\x41\x73\x14\x0e\x5d\x7c\x23\x67\xc6\x39\x96\x94\x78\x59\xd4\x2b\x16\x6c\x9c\x89\xaf\xae\x22\xf8\xc5\xca\xdc\x69\xf0\xb5\x07\xdb\x03\xad\xef\xec\x75\x2f\x3b\xb6\xa7\xd8\xab\x0b\x7e\xb7\x50\x64\x65\x87\x11\xba\x17\xa0\xd8\x44\x91\x56\xb0\x0c\x9c\x83\x60\xf4\x0e\xfd\x95\x56\x23\x28\xfe\x09\xea\x2c\x7b\x19\x48\x94\x7a\x68\x0a\x91\x74\x82\xa5\xd1\xea\xb1\x9a\xa9\xc7\x6a\xa8\x2d\x1f\xa2\x06\xea\xa1\xcd\x30\x55\x5a\x4d\xd5\x70\x06\xd8\xd4\x83\x70\xd9\xc3\x1e\x12\x3a\x77\x9f\x3a\x11\x13\xa3\x71\xbb\x1a\x64\x9e\x2e\x64\xae\xdd\xa5\x83\x4c\xa2\x38\xe9\x20\x11\x07\x75\x87\x06\x92\x11\x64\x3f\x73\x4a\xc3\x51\x04\xf3\xe0\xbd\x0b\x06\x0e\x14\x9c\xb3\x67\x91\x05\x87\x44\x97\xb3\x35\xec\x00\x95\x93\x7b\xcd\x55\x66\xf9\xda\x70\xcf\x40\x35\x67\xd9\x39\x12\x70\x32\x2b\xd0\x71\x83\xd0\x3e\xa2\x32\x35\xca\x44\x6a\xf5\xf0\xc4\xee\x80\x63\x11\xfa\x1c\xbe\x45\x3d\xef\xb8\x3d\xea\xee\x89\xc1\x05\xda\x39\x33\x2d\xc0\x16\x9f\x95\x7c\x44\x61\x30\x6d\xaf\x97\xbb\xbb\xed\x64\x8f\xdd\xdd\x95\x90\x03\x0e\x56\xf0\x85\xde\x90\x88\xa2\xbe\x5a\x4e\xbb\x28\x86\x2f\x1f\x3d\x7a\x34\xa9\xaf\x96\xa0\x31\x5b\xeb\x66\xd5\x99\xeb\xe8\xbb\xef\xfe\x32\x79\xa1\x9b\x15\xfc\xdf\x8b\x9f\xe5\xcb\xfc\xd7\x77\x2f\x7e\x46\xe7\x42\x49\x57\xae\x9a\x75\x9e\xcc\xca\xf4\x36\xb1\x1c\x75\xb2\x32\x3a\x4d\xec\xd3\x9f\xac\x4d\xa3\x13\x64\x3b\x9a\xac\xf1\x64\x99\x4e\x53\xcb\x0b\x24\xba\x6a\xb2\x79\x6e\x12\x5d\x67\xa9\x49\x16\x65\xd9\x98\x0a\x8a\xdb\x7f\x8e\x92\xd5\x97\xc9\xea\xab\x64\xf5\xe7\x64\xf5\x75\xb2\xfa\x26\x59\x2d\xab\x72\xbb\x49\x0a\x7d\x95\xd4\xe8\xfd\xec\xe8\xd0\xec\x2a\x49\xd3\x24\xcd\x93\xb4\x49\x16\xd9\x72\xae\x91\xf7\x58\x64\xcb\x6d\x65\x92\x4d\x36\x6f\xec\xbf\xab\x2a\xc9\xd6\xcb\x24\xcf\x92\xb5\xce\x8a\xa4\xcc\x93\x4d\xb2\xa9\x4c\xb2\xcd\x5d\xc7\x92\x59\xa2\x67\xb3\x2a\x99\xa5\x59\x32\x4b\xcb\x64\x56\x25\xf3\xac\x31\xc9\xbc\x4c\x4d\x02\xaf\x52\xba\x28\x12\xb3\x4e\xb2\xe4\x72\x96\x26\x6b\x5d\x5d\x26\xbf\x27\xd5\x26\xa9\x9a\xa4\x6a\xe6\x49\xb5\x9d\x39\x5a\xb1\x4e\x6a\xbd\xde\x24\xf5\x5a\xe7\x79\x52\x6f\x74\x91\xd4\x4d\x55\x16\xcb\xa4\xde\xce\x92\x7a\xbb\x49\xc0\x94\x73\x9b\x5c\xe9\x2a\xb9\x9e\x55\x09\x70\x4d\x7a\x9b\x66\x65\x62\x49\xdb\xa6\xd2\xf3\xcb\x04\x98\x05\x47\x25\xaf\x67\x26\x4d\x48\x2e\x07\x5e\x03\x49\x5d\x6e\xab\xb9\x49\xe6\xba\xb8\xd2\x75\x82\x21\x85\x2d\x81\x8b\x7f\xa4\x26\x4f\xb2\xa2\xe6\xf2\x3c\x2f\xf3\x32\xb7\xff\xc3\xf9\x24\x22\x15\x56\xad\x81\x55\x6c\xd2\xa4\x59\x25\x4d\xc5\xc5\x90\x7b\x82\xe1\xe7\x59\x6d\x67\xd8\xe4\x69\x6d\x1a\xa0\xd6\x13\x64\xfb\x72\x3d\x33\x79\x92\x9b\xa5\x29\xd2\x04\x9c\x19\x2c\x03\x88\x2d\x10\x27\x48\xb5\x95\xdb\xc6\x16\x60\x86\x90\x19\x44\xc7\x37\x3a\xde\xa2\xd1\x99\x65\x39\x32\x9d\x97\xcb\x64\x6d\x8a\x2d\xfc\x9f\xa5\xf8\x93\x7a\xbb\x5e\xeb\xca\xcd\x34\x71\x48\x09\x61\xc3\x26\x96\xbc\x2f\xaf\x13\xe6\x91\x93\x59\x5e\xce\x2f\x7f\xdf\x96\x96\x79\x58\x54\x7a\x6d\x92\xc6\x6e\x36\x24\xb4\x59\x36\xb5\xd6\x1b\x05\xce\x35\xb6\x2a\xe0\xf3\xf3\x5b\x85\x9d\x83\xb0\xcd\x96\x0d\x50\xf3\xf2\x0a\xe3\x91\xbd\xfd\xe5\xdf\x1d\x14\x2d\xf2\xce\x6b\xe4\xcf\x6d\x5f\x74\x46\xc8\xd3\x2e\xcb\x98\x0e\x90\x2d\x16\x1e\x9e\xfa\x6a\x99\xe8\x22\x5b\xdb\x7e\xce\xb3\x6a\x0e\x2c\x7a\xb6\xd9\xe8\x66\x95\xcc\xb7\x55\x5d\x56\x96\x65\xb2\xac\x57\x3d\x4f\x4c\x9e\x67\x9b\xda\x24\x68\x9b\x90\x2c\xca\xa2\x39\x5c\xe8\xb9\x3b\x58\x01\xa4\x68\xb2\x4c\x96\xf9\xed\x66\x95\x64\x6b\xbd\x34\xf6\x44\x1a\xd8\xb0\xa6\x4a\xd6\xba\xbe\x4c\xd6\x59\x6d\x99\xfc\x43\xcc\x04\x0d\xb2\x99\x0d\x55\xb7\x29\xf3\xdb\x65\x59\x24\xf6\x5f\x28\x5e\xd9\x6a\xeb\xeb\xcc\x72\x2b\xf5\xed\x7a\x56\xe6\xb0\x6e\xf0\x7f\x50\x41\x03\xfb\x7c\x5b\x9b\xe4\x2a\x33\xd7\x70\xd1\x58\x6e\x67\xcf\xb3\xa7\xaf\x2b\x83\x37\x48\xc0\xf3\x0b\xd1\xb6\xe3\x93\x37\x95\x19\x20\x0a\x5e\x28\xd7\xe8\x2e\x2e\x19\x0f\xba\xa6\x30\x05\xc8\x99\xb7\xbf\xfc\xfb\x10\xad\x8b\x8e\x43\xd3\x25\x89\x04\x2d\xaa\xc2\xe7\x8a\x4b\x45\x8f\x2f\x40\x94\x7a\xec\xf1\x99\xae\xb3\xb9\x43\x20\xb7\xaf\x02\x5e\x9c\x8c\x41\xd8\x00\x12\x31\x45\xc4\x71\xf9\x30\x62\x15\xe6\xf4\x3b\x09\x01\x4a\x3c\x6a\x47\x55\x96\x2e\x02\x9b\x9b\x18\x7b\x75\xc7\x04\x01\x7e\x94\x2a\x0c\xc2\x51\x26\xc4\xa4\xa7\xbb\x8c\x10\xbc\xd6\xa2\xfe\x7b\x50\x4a\xcc\xc8\x0e\xcb\x41\x30\x55\xcc\x8a\x1f\xab\xf2\xba\xf6\xbe\xd8\xbc\x9c\x95\x23\x54\xd8\x0e\xaa\x1b\x57\x3b\xb0\x65\x22\xf7\x33\x58\xe9\x46\x2f\xc7\x4d\xf9\x73\x79\x6d\xaa\xa7\xba\x26\x14\x9f\x3b\xba\xd3\x31\xf8\xb3\x46\x2f\xcf\xd5\x03\x64\x16\xa3\x46\xfb\xb2\x0b\xa0\x72\x63\xa9\x9c\xb4\x9c\x83\x1f\xd6\x38\x04\x70\x64\x38\x6b\x5a\x25\x67\x0c\x36\x38\x1c\x84\x0e\x61\x14\xf3\x69\x3a\x99\xd4\x8d\x9e\x5f\xda\xcb\x64\x91\x97\xd7\x10\xf9\x49\x4f\xbe\xfc\xcb\x97\x47\x8f\xbe\xfa\xe6\xcf\x93\xa3\x47\xdf\x3e\xfa\xf2\xcf\x7f\x96\x3d\xec\x1f\xd1\x89\xd3\x69\x9b\x3c\xf4\x8f\x38\x39\x51\xd7\x59\x91\x96\xd7\x63\x7b\x20\xa2\x95\x75\x6a\xeb\xdd\xa5\x28\x3b\xe4\x45\x22\xbe\xc3\xd5\x71\x57\xe7\x26\xed\xb6\x27\x68\xe5\x66\xf2\x71\x53\x52\x7c\xce\xd1\x28\x50\xbf\x65\xf5\x3b\x73\xd3\x3c\xb7\x2f\x0b\x45\xeb\x70\xd2\x30\xb8\x6f\x30\x5c\x40\xb2\xd1\x75\x7d\x5d\x56\x20\x7d\xa9\xe6\xab\xc4\xac\x75\x66\x6f\xa4\x3c\xd9\x56\xf9\x40\x7a\x13\x82\xad\xef\x9f\xd4\x7f\x6c\x4d\x75\xab\xb4\x43\x6b\xa7\xfb\xbd\xac\xec\xd2\xf9\x38\xa6\x3e\x5d\xe7\x95\xd1\xe9\xed\x78\xcf\xd6\xe2\x8e\xc9\xef\x50\xcd\x90\x71\x3f\x04\xe1\x6e\xf2\x5e\xd4\x7a\x16\x0c\xc9\x6d\x04\x15\xbd\xa5\x3e\x0c\x1d\x4a\x08\x1c\x28\xce\xef\x69\xc7\x4f\x0b\xe3\xa1\xd4\xe0\xa9\x2e\xec\x78\x16\x59\xe1\x5e\xa2\x29\xb0\x49\x26\x0f\xb5\x76\xde\x00\xb4\x7b\x83\x5b\x0a\x6b\x30\x6a\x2b\x53\xb9\x8f\x3d\xfb\x02\x9a\xe9\xb6\xcc\x0e\xea\x3f\x38\x82\x73\x63\x2f\xe2\x20\xa4\x3a\x9e\xbb\xf5\xce\x83\xf7\x92\xfd\x96\xe8\xf0\x81\x09\xea\x03\x2f\xda\x8d\x2f\x4a\x03\x51\xe3\xe9\xea\x86\xcb\x46\x95\x15\x0a\x90\x20\x16\x29\x2a\xdb\x41\x2b\xe5\xa4\xe3\x10\xd7\xcc\xe1\x72\x63\xbe\xc2\x45\x8f\xf0\x92\x22\x6f\x41\x93\x7a\x94\xd2\x8e\x6f\x63\x96\x53\x42\x47\x5b\x11\x5c\x4d\xbe\x1e\xd7\xa6\x79\xc2\xcd\x0f\x07\x9c\x7f\x90\x28\xff\xb7\x33\xbf\x13\x23\x6b\x47\x77\xa3\xa9\x7a\xf9\x16\x45\x74\xf0\xd0\x01\x00\xff\xcb\x58\x60\xd7\x3d\xc7\x2f\xdf\x0e\x25\x8b\x71\xe6\x7e\x9c\xfb\x5a\x3a\x9a\xb5\xe7\x17\x84\x3f\x43\xc0\x3e\xde\xd1\x0e\xe7\xc4\x8c\xdd\xf1\xe9\xe8\x2d\xba\xa3\x26\xca\xd8\x51\x11\x86\x91\xff\x11\xa1\x92\x84\x68\x2a\x51\x85\xb9\xc6\x3f\x9c\x1b\x8b\x37\x29\x15\xf2\x27\x59\xc1\xb0\xa7\xcc\x71\x24\x8e\xb1\xfb\x08\x0c\xb0\xd4\x10\x91\xe5\x81\x24\xc4\xaa\x61\x43\x88\x2c\x28\x13\x8a\xaa\xd0\x9b\x8d\x29\xd2\x9d\x55\x88\x2c\x9d\x55\x08\x29\xdc\xd0\x9f\x2b\x16\x05\x7b\xd1\xdd\xcb\x38\x34\x60\x61\x6e\x9a\xb7\xd9\x2c\xb7\xe4\x48\x4f\x49\x91\x25\x28\xca\x87\xb0\xa7\x18\x25\x87\x6c\xb7\x69\xec\x36\x78\x8a\x74\x3c\x0f\xd6\xaf\x37\x16\x14\x39\x4e\x14\xa2\x72\x47\x95\xbc\xb5\xbc\xe6\xdb\x79\xb9\x31\x5c\x07\x88\xe7\x9f\xcb\x29\x0b\x4e\x16\x25\x27\x6a\x30\xc0\x99\x43\x9e\xba\x4c\xcd\xab\x8d\xd0\x98\x2f\x2a\x63\xfe\x61\x86\xef\xf7\xfe\x10\x9c\x8d\x69\x7c\x8d\x25\x51\x86\x97\x6f\xa7\xf1\x31\x74\x59\x78\xdf\x4f\xa3\x13\xe3\x32\xd0\x76\x9e\x86\xc7\x20\xd9\xfb\x83\xdc\x8c\xd3\x60\x6f\x27\x7b\x7f\x10\x9b\x6a\x2a\x37\x61\xb2\xf7\x07\xb1\x59\xa6\x72\x73\x25\x7b\x7f\xf0\xbb\x60\x2a\xf6\x4c\xb2\xf7\x07\xb1\xc8\x53\xb9\x29\x92\xbd\x3f\xd0\x42\x4e\x79\xc1\x93\xbd\x3f\x84\xeb\x38\x8d\xd6\x15\x33\xf8\x35\x9a\x86\x4b\x46\xda\x5e\x21\xdb\x40\x1b\xc0\xf7\xce\xd6\xb6\x65\x6a\xab\x86\x17\xc1\x83\xa1\x9c\x01\xda\x1b\x17\x8d\x82\xec\x2d\x95\x73\x9f\x70\x75\x10\x3a\xcd\xb0\xcc\xd3\x5f\x70\xb7\x04\x55\x01\xe4\x0b\x25\xe1\xe5\x6d\xfb\x63\x6f\xec\xab\xe0\x93\xf4\xf6\xf5\x8d\xfb\x4a\x05\x7a\x74\x5f\xff\x9c\x45\xed\xfd\xa3\xfc\xb7\x2a\xda\x01\xfa\xec\x72\xba\x20\x17\x96\x0c\x5f\x97\x4e\x9b\x12\x9b\xfd\xf1\xd0\x8e\x1d\x75\x0f\xa2\xbb\x4b\x73\x3b\x12\x9c\x9a\x0b\x58\x0f\xd0\x94\x57\x2d\xbc\x7c\x0f\xbc\xdc\x63\x25\xf7\xe1\x03\x25\x19\x44\xf3\xa2\x12\x0c\x71\x66\xff\x3c\x16\x7c\x83\xe8\x70\x97\xbc\xd6\x66\x47\x69\x9b\x5c\x11\x30\x9d\x73\x49\x70\x67\xb7\xf1\x0c\x5c\x3a\x50\x6f\xd1\x9a\xba\xa4\x38\x34\x47\x2b\x52\x4b\x48\x0d\xd8\x09\x7c\x5e\xfc\x24\x41\x71\x61\x2e\xef\xee\x75\xd8\xea\x59\x65\x16\xe7\x91\x69\x5f\xd0\x69\xc7\x6f\x40\xc7\x23\x38\x93\xdd\x88\xd1\xbe\x0e\xb0\x00\xf4\x93\xe3\xdc\x16\x42\x53\x42\xd9\x2f\xda\x1f\x32\xf0\x06\x52\xd9\xbf\x64\x55\xb3\xd5\x39\x84\xa8\x86\xc8\xd0\x10\xd3\x30\x5f\x96\x55\xd6\xac\xd6\x80\x06\x05\x91\x33\xde\x16\x7a\x36\x4b\xcb\xb5\x9a\xdd\xda\x62\x6f\xb3\x75\x59\xa8\x9f\xaa\x2c\xab\xd5\x2f\x59\x91\x6e\xd7\x6a\xf8\x6f\x1b\x9d\xa7\x66\x93\x15\xe9\xc8\x66\xf9\x39\x9b\x9b\x02\xcc\xc3\xc0\x50\xda\x12\x69\x2f\x9e\xbf\xe3\xcf\x36\x47\x47\x48\x5d\x57\xc5\xa4\xa6\x06\x27\xb3\xbc\x9c\x71\x10\xdd\x9f\x9f\x3f\x3d\x7d\xf9\xf6\x74\x4f\x41\xdf\x1d\x08\xd1\xec\x56\x9d\x5e\xe9\x42\xfd\x77\xb9\x55\xc3\x7f\xbb\xbd\xbd\xf9\xee\xbb\x47\x7f\x79\xf4\xd5\x88\xf2\xbd\x2c\x1b\xb0\x2f\x38\x04\xa5\x77\x0b\x54\x0f\x7e\x2c\xb2\x1c\x0c\x5a\x01\xc6\x74\x5e\x65\x4d\x36\xd7\x39\xb8\x87\x43\x74\x87\xb2\x6e\x6c\x4d\xe5\xc2\x32\x37\xb6\x06\x88\x9c\x0b\x23\xb3\xe7\x23\x55\x59\xc3\xe6\xb0\xd7\x65\xd5\xac\x30\x78\x03\xdf\x8d\x00\x1b\xfc\x32\x8e\x46\x34\x18\x24\xea\xfd\xc7\x44\x9d\x9d\xb3\xc4\x85\xe3\xa9\x9e\x11\x72\xa3\x25\x21\xc1\xca\x8c\xfe\xc6\x4b\xd0\xfe\x85\x67\xc5\xfe\x45\x37\xce\xe0\x5c\x0a\x4b\x6a\xbd\x36\xa4\x31\x6d\x69\x62\x90\xe7\xd0\x64\x2e\x7c\xa2\x66\xf0\x97\x08\x06\x23\x82\xf1\xbb\xb0\x39\x33\xf8\x8b\xa0\x6d\x30\x09\x90\x54\x31\x84\x02\x64\xf0\xbf\x45\x36\xbc\x8b\x34\x29\x12\x6d\x46\xfc\x32\x1b\x73\x64\x3b\x97\xd5\xf6\xd9\x71\x91\xd8\x6d\x67\x64\xfd\xe1\x43\x10\xaa\x06\xe3\x47\xda\x63\x59\xdf\x16\xf3\xd7\x1e\x54\x7c\x14\x76\x51\x86\x8d\xa6\x5e\x06\x9f\x82\x8e\x62\xfc\x9b\x30\xc7\x18\x80\x9c\x5d\x3f\xf6\xf8\xff\x23\x4d\x95\xec\xb9\x9c\x71\x7b\xf2\xb5\x8f\x96\xc3\x96\x27\xef\xa5\xbc\xc5\x09\x2d\x32\xbe\x55\xed\x56\x7d\xa2\x78\xa6\x32\x75\xa2\xf4\x38\x8a\x03\x68\x3f\x66\xc8\x9f\xe0\xd7\xb1\x2d\x24\x2b\xf8\x31\xa8\x60\x76\xff\x0a\xb8\x6b\xd8\x89\x93\x13\xaa\x0d\x24\x73\x01\xa3\x0f\x6c\xf4\x13\xaa\xb3\x9d\xf2\x63\x17\x7b\xf0\x37\x73\xfb\xae\x7c\x95\xa7\xcf\xd3\x1b\x35\xf4\xf1\x4b\x66\x66\x99\x15\xcf\xd3\x1b\x80\xf0\x7c\x9e\xde\xf8\x87\x2e\x4b\x58\xbf\x02\x86\x48\xa8\x36\xf9\xe8\x34\x3a\x30\x36\x2a\x0c\x9a\xac\x13\xaa\xe1\x58\x3d\x7c\x98\xf1\xd5\x8a\xaf\xa5\x08\x8b\xe4\xed\xc5\xbd\xaa\x8b\xdf\xcb\xb5\xde\xf0\xa5\x99\x1d\xb7\xb0\xc8\xf4\xa6\x63\x54\xaf\xed\xbd\xf9\x93\x93\x71\xce\xf4\xfc\xd2\x14\x69\x30\x88\xdf\x9c\xf9\xc1\xac\xa6\x21\x78\x48\xb8\x6d\x0e\x9a\x2a\x2a\x37\xa6\x2f\x5c\xc2\x13\xb8\x9c\x81\xbe\x40\x15\x6e\x1a\x64\x54\x65\xa7\xdb\x13\x93\x30\x9f\xd5\x67\x18\x33\x39\x3b\x3f\x17\x78\x64\x50\xc1\x6f\x58\xc1\x6f\xea\x7b\xee\x8f\xa8\xe2\xb7\xf0\x4d\xc4\xe9\xa2\x6c\x67\xbf\x9d\xfb\x5a\x83\x57\x31\x68\x0f\x5f\xac\xce\x32\x5d\x9e\x77\x76\x5c\x0e\x4a\x9d\xef\xce\x27\x8d\x1a\x9a\x7c\x1d\x89\x08\xfc\x75\x4a\xb3\xc2\x3c\x0b\xe4\x0d\x65\x91\x7c\xdd\x06\x71\xdd\x6c\xb6\x56\xa3\xb8\xae\x6f\xd6\x4f\x67\xb4\x4d\x4f\xf3\xb5\x88\x5f\xcf\x7d\x88\x18\x48\x69\xbd\x6d\x67\xea\xf0\x10\xbf\x7b\xe0\x50\x38\x50\x11\x82\x99\xcd\x01\x03\xe0\x86\x7a\x20\xcc\xe2\x9a\x54\x00\x47\x2a\xd4\x8e\x36\x63\x6b\x44\xbe\x1d\x2f\x0b\x93\xa6\x32\x10\x6e\x12\x27\xd0\xf3\x14\x5e\xca\x35\x99\x38\x31\xdb\x5a\xdf\x52\xb4\x07\x94\xb7\x21\xb0\x03\xd6\x9f\xaa\x74\x0b\xd8\x12\x57\x87\xab\x66\x9d\xab\x89\xba\x3a\x74\x51\xf3\xfc\xe6\x89\x4c\x50\x94\x6b\x5a\x72\xd9\x1c\x8c\x3e\xc4\xe3\x0d\x06\x15\x8b\xc0\x0f\x0e\x8e\x3c\xd5\x5c\xfc\xf2\xba\x32\xd1\x6e\xe1\x47\xe4\x01\xa6\xfa\x07\xe0\x81\x0b\x1d\xe7\x3f\xb5\xa2\x40\xa2\xec\x3a\x3d\x65\x3d\x11\xb9\x50\x88\x57\xa4\x27\x63\x5d\xae\x8d\xb0\xd2\xc6\xe4\xd0\xcb\xc2\xe9\x43\xc8\xf5\x98\xf2\x88\x1c\x4a\x3d\x26\xe9\x39\x8a\x64\x5d\x5c\xbb\x30\xd3\x94\x45\xec\x27\x8e\xf9\x69\xf4\xd2\x65\xf9\xe8\x1f\x55\xd7\x6f\x17\x46\x33\x9c\xcd\xb8\x05\x77\x4c\xe0\x1e\x83\xc0\x45\xc5\xf2\x34\x5f\x3f\xc7\xb9\x44\x24\xfd\xd6\x21\x3a\xcd\xd7\x34\xed\x22\x8c\x3f\xf2\xc1\x06\xb9\xae\xff\xd8\x9a\x2d\x7d\x77\x78\xc1\x09\xad\xda\xc2\xfd\x5d\x98\xba\x61\xf8\xd5\xf2\xba\x30\x15\x90\xe4\x5c\x5d\x6a\x6e\x04\xb4\x5e\x1c\x73\x0f\xae\x0d\xf7\xf6\xf9\xd2\x61\xbc\x4c\xc0\xe8\x42\x4b\xe7\x6b\x5d\x73\x60\x29\xa5\x7d\xcc\x0e\x0a\x71\xec\x8b\x14\xe5\x35\x8a\xa2\x21\x33\x40\x7f\xd9\x0b\x09\x77\x60\x79\x65\xaa\x6b\x4b\x49\x16\x4b\x95\x35\x35\x48\x47\xaf\xc1\x66\x17\xe8\x4e\x5f\xcb\xa6\x04\x35\x26\xa0\xcb\x35\xf3\x15\x46\x8f\xa8\x55\x5a\x5e\x17\x14\xca\x4d\xa7\x1c\x00\x53\xb6\xc5\xb2\xb2\x00\x95\xde\x43\xbc\x60\xe0\x96\xe7\xe8\xfb\x01\x88\x46\x73\x70\xdb\xb2\x55\xc2\x28\xcb\xe2\x30\x35\x6b\x4b\xbd\x12\x30\x39\x2f\xaa\xaf\x4d\xd7\x75\x39\xcf\xc0\x51\xd6\x72\x08\x7c\x07\xd8\x47\x23\x73\x38\xf4\x2e\xda\x9b\x9b\xd8\x33\x58\x11\x88\xcc\x6f\x9b\xc4\x0b\x3a\x64\xa1\xfd\x96\x18\x67\xf5\x9b\xb2\xb4\x9c\xa6\xa9\xec\x3d\xf4\x00\x97\x1a\x40\xcf\x21\xea\x57\xa5\x8b\x1a\x21\x14\x4c\xd1\x98\x4a\xc4\xe3\x01\x93\xba\x28\x78\xa6\x3b\xff\xad\x2d\x26\xb6\x17\x6d\xad\x51\x8c\xfe\x15\x74\xae\xcf\xc0\x50\x18\x12\x0a\xd0\xe7\x71\x18\x16\x1d\x08\x31\x8f\xed\x8c\x58\xce\xe1\xe6\x0c\x23\xd5\xfa\xdb\xc0\xe6\x60\x11\x35\x45\xe6\x8f\x6e\x8b\xd6\xe9\x63\x1c\x2d\xd5\xc2\xbb\xe8\xb8\x23\x79\x8a\x5a\xb5\x44\x9e\x5f\x71\x20\x11\xa5\x06\x54\x97\x9a\x6f\xeb\xa6\x5c\x7b\x45\xc5\xf7\x03\xf5\x10\x86\xfb\x50\x0d\x7e\x50\x87\x2a\xcd\x52\xf0\xd4\x09\xe3\x86\x80\x51\x2e\x0a\x38\x88\xad\x62\xdd\xe9\xbc\xac\xd0\x88\xfe\x31\x80\x73\x79\x30\x24\xe9\xd5\xd1\xaa\x0c\xdc\xf1\x00\xc2\x15\xe2\xe8\x20\x2e\x3e\xf8\xa8\x14\x7a\x6d\xf6\x09\x86\x28\x0c\x46\xa2\x42\xe1\x87\x48\xb9\xdb\x6f\x0d\xf5\x1b\xfc\x54\xb8\xdc\x8f\xdd\xfb\x15\x4b\xe3\x39\x2b\x46\xd1\x75\x05\xa6\xdd\x05\xd0\xa0\x3e\xf4\xa1\xab\x4d\x03\x02\x37\x77\x7a\xf8\x6c\xee\x06\x87\x11\x74\x18\x1e\x0f\x0e\xec\x7c\x25\x64\xd3\x40\x7b\xb7\xcf\x89\x98\x87\x76\xa8\x57\x31\x5f\x59\x71\x55\x5e\x52\x2c\x84\xbf\x5a\x7a\xae\xff\xe8\x75\xa3\xb1\x40\xb6\xa1\x38\x94\x6e\x96\xdd\xf9\x3c\x8e\x17\xe2\x7e\x98\x37\xbb\x4e\x4f\x6b\xd7\x1f\x1e\x76\x8a\x51\x70\xec\xc0\x6a\xf2\x35\x45\x0c\xae\x98\x07\xb9\x2f\xc2\x35\x65\x05\xc7\x95\x93\x87\x7b\xe8\x8a\x7b\x0f\x3c\x8e\xdc\xd1\xdb\x9a\x53\xcc\xfc\x73\xcd\xf5\x10\xc2\x32\xd4\xc1\xa7\xdc\xae\x82\xca\xcc\x3a\x6e\x51\xbf\xbb\xb2\x51\x08\x0c\x62\xc9\x20\x16\x7c\xa4\x8e\x93\xed\x11\x4d\x12\x03\xeb\xfc\x49\x8f\x5b\xcc\x0a\x72\xbb\x96\xe7\x68\xb1\xc0\x59\x91\x35\xc1\xbe\xce\x78\x88\xa8\x6f\x9c\xfc\x49\xad\x6e\xd3\x0a\x76\x8c\xfa\xd3\xa4\x6b\x94\xe1\xee\xc1\x67\x13\x62\x73\xcc\x75\x9e\x53\xa4\x46\xf0\x21\x03\xe6\x2c\x21\x8c\x77\x8e\x22\x05\xd8\xbf\xa0\x36\x6a\x45\xf9\x9a\xa8\x8c\xdd\x7c\x06\x57\x0e\xeb\xcf\xe5\x77\x5e\x73\xf6\x05\x67\x24\x87\xac\x19\xfb\x90\xaf\xbe\x22\x7f\xd3\xea\xbc\x2e\x01\xa5\xb4\x36\x4d\x2b\x26\x1b\xf4\x69\x00\xa4\xca\x58\xf4\xa2\x40\xab\x29\x08\x77\x45\x90\x6f\xbf\x6d\xeb\xc6\xfb\x88\x19\xaf\x84\x07\x6a\x42\xa5\x65\x61\xc6\xad\x75\xe8\x5b\xc0\x60\xfe\x09\xb4\xec\x8e\xc7\xbc\x75\x49\xc1\x41\x0d\xf6\xcd\x28\xa6\xb0\x39\xe5\x33\x69\x85\xae\x3b\x2c\x34\x9c\x51\x3b\xf8\xd8\x60\x5c\x3b\xce\x51\x1f\x0d\x8b\x57\x99\x01\xcf\x2e\x24\x95\x64\xe0\xb0\x56\x35\xc0\x71\x13\x9c\x6f\xd7\xf0\x7a\x2b\x3d\x0e\xee\x9a\x76\x06\x81\x1a\xc3\x83\x6d\x3f\x8e\x1d\x40\x34\x26\x97\x87\x1e\x44\x26\x7a\x96\x9b\x56\xfc\xb2\xcf\x79\x55\x5a\x8f\x64\xc7\xdd\x69\x39\x58\x88\xde\x16\x1a\x6c\x89\x6d\x5e\x5f\x66\x1b\x70\x60\xa5\xbd\x7c\x58\x99\x1c\x0e\x1c\x8b\x68\xcc\xcd\xdc\x6c\x90\xf8\xad\xcc\x42\x0d\xff\xf8\xd5\x9f\xbf\xfe\x7a\xe4\xe8\xc7\x6e\x65\x91\xc7\x0d\x60\x42\x05\x47\x48\xb7\x02\x4c\xa9\xbd\x17\x76\xaf\x64\x5b\xff\x14\x71\xf7\xad\xbd\xfd\x4f\x5c\xd5\x8e\xe5\x5f\xe9\xf9\x25\x0c\xf7\x8f\x7f\xfe\xea\xab\xef\xa6\x00\x20\xe9\xaf\x65\x3f\x91\xe4\xd4\x5b\x18\x49\xac\x73\x25\x69\x69\x50\x30\xde\x54\xd9\x72\x69\x2a\xe9\x40\x49\x85\xe8\xd6\xe1\x0b\x0e\xc5\xe0\xce\x6d\x1c\x20\xa7\xb9\x36\xbd\xd4\x59\x31\x56\xcf\xd9\xfe\x27\x4b\x8d\xce\x79\x56\xf3\x2b\x43\x8b\x75\x08\x41\xb3\x16\xd9\x5c\xe5\xe5\x32\x9b\xdb\x2b\x0c\xc2\x6a\xcc\x28\x20\x1f\xba\x78\x56\xc6\x9b\xfa\x19\xb3\xa6\xa0\xbb\x5a\xcd\x00\xe8\x55\x5d\x6b\x88\x42\x9e\x96\x8e\xd7\x41\x58\x93\xc2\x54\xb1\x03\x91\xf7\xbd\xe1\xd4\xae\x77\xca\xef\x71\x5f\xc5\x8e\x02\x51\x70\xc2\xe8\x3d\x73\xe5\x3a\x45\xbb\x7e\x1d\x82\x6b\x30\x16\x17\xce\x67\xf5\x98\x57\xb4\x4b\x6a\x88\xff\xc9\x5c\x67\xd9\xf9\xd0\xc9\xe4\x12\xdf\x8d\x1d\x24\x5e\x7b\x37\x77\x95\x9a\x55\x46\x5f\x76\x48\xbe\x26\x13\xb5\x2d\x20\xbc\x3b\x30\xd7\xf9\xad\xdb\x27\x6e\xc2\x12\xb7\x3b\x82\x0d\xea\x71\xa7\xc4\x5e\xe5\x05\xa7\xa3\x97\x35\x2e\x76\xd8\x7d\xe9\xa4\xf8\x5e\x87\x7a\x84\xb8\x0a\x73\x1f\x1c\x1c\xb5\xaf\xf2\x96\xe0\xcb\x27\x51\x91\x51\xc4\x07\xe2\xe7\xb1\x74\xe6\x3a\x39\x09\x7c\x9f\xf8\x3f\x26\x08\x03\xf3\x97\xae\x5e\x75\xb0\x39\x31\x06\x09\xd7\x25\x4d\x56\x44\x55\x77\x0a\x6d\x43\xa6\x43\xdd\x8b\xeb\xe8\xd5\x15\x73\xa9\x4e\x5e\x19\x64\x01\xcf\x38\xe0\xc4\xdf\xcc\x6d\xed\xf3\xc7\x84\x59\x57\x08\x0c\xce\xdc\xb7\xf9\x9d\x10\x6b\x28\xf4\x07\xdd\x97\xaa\xd8\x2d\x08\xa0\x0f\xf0\x66\x72\xd0\xdd\xfa\x5a\x7a\x11\xab\x6c\x9d\x61\x9c\x6a\x4f\xc2\xb7\x25\xa4\x72\x45\x64\x83\xdd\xdc\x00\x59\x6c\xca\x1a\x77\x08\x53\xdd\xa3\x1c\x99\x2f\xd0\xd5\xd6\x4b\x7e\xbf\x8f\xe4\x40\x7d\x34\x80\xbc\xce\x02\xd3\x44\x49\xe7\x10\xa3\x1c\x1f\xb2\x88\x28\xb8\x07\x01\xe5\x57\x1b\x50\x97\xec\x7a\x1f\x1c\xd1\x95\x87\xd3\x24\xd7\xfc\x40\x04\x82\xf0\x39\xce\xb2\x83\xa3\xe0\xba\x8b\x9f\x61\x15\x31\x36\xc0\x64\x80\xe4\xea\x8d\xb1\x6f\xdc\x95\xae\x32\x3b\xa3\x3b\xf8\x1d\xf1\x95\x5a\x05\x3d\x14\xff\xe8\x68\xdd\x6d\x68\x59\x34\x73\x34\xe1\x5d\x34\x44\x7c\x6a\x29\xb8\x2a\x98\x57\xa9\x2c\x15\xa6\x94\x80\xd5\x00\x3e\xd3\xea\xe9\xdb\xb7\xe3\x10\x1c\x24\x5b\x6f\x90\x4e\x62\xc9\x27\xc7\xa9\xc4\xa8\xb8\x3e\x96\xa7\x01\x49\xe8\xca\x68\x8a\xa2\x53\x2e\xd4\x92\x42\xdb\x55\x80\x1c\x85\x02\xc9\x6a\xad\x73\xd1\xb6\x33\x46\xd8\x54\xe5\xdc\xd4\x80\x08\x12\x98\x8f\xa1\xe5\x58\xb0\x51\x05\xf5\x12\x3e\x96\xb8\x40\x8b\xe2\x2d\x59\x98\xb5\xcf\x55\x60\xdd\x24\x4f\x56\xd6\xc3\x8a\xeb\x4a\x01\xe2\x3b\xa2\xdb\x07\x0f\x35\x9d\x17\x4e\x8e\xaf\x74\xa1\xd7\xa5\x1c\x2c\x8c\x6a\xbd\xe2\x2e\x28\xe1\x45\xdd\xea\xf9\x27\xf5\x3e\x7c\x96\x45\xc7\x5d\x17\x7c\xc8\xbf\x8e\x07\x18\x36\x42\x8e\x18\x3e\x60\xd3\x07\xe0\x17\x84\x40\x01\x1c\xe5\x92\xb8\x49\xea\x27\x86\x15\xb6\x1f\x56\x65\xdd\x38\x56\x75\xdc\xb1\x36\x08\xa3\x23\x99\x79\xde\xdd\xc2\x7e\x8b\x26\xa8\x3b\x71\x51\x3c\x6d\x25\xef\x9c\x44\xc8\xf4\x39\x7b\xa0\x75\x31\xe9\x14\x4f\x59\xad\x86\x2d\x9a\x9a\xce\x6b\x4d\x21\x2a\x85\x7e\xfb\xce\x6b\xeb\xd8\x15\x09\x55\xda\xfc\x55\xdc\x55\xee\x7d\xc2\xc6\xce\x38\x4b\xcf\x23\xd5\xd1\x4b\x10\x74\xb4\x3b\xdb\xff\x50\xc0\x65\xfc\x0c\xed\x4e\xec\x6d\xdc\x75\x0a\x49\xe5\xbd\x53\x8a\xde\x2b\x5d\x0c\x37\x88\xbb\x57\x5b\xc7\x83\x6c\x5f\xf0\xd2\x1b\xc6\xb7\x63\x17\xad\x4b\x25\xa2\xd7\x5e\x26\x59\xfa\xb6\x7d\x51\x76\x5f\x28\x1d\x94\x49\xac\x4e\x0f\x33\x76\x6b\xd5\x55\x7b\x4a\x87\x61\xb9\xb3\xdf\xee\x56\x94\xa3\xee\xb4\x63\x37\xf6\xed\xc2\xcf\xdc\x71\xa8\xfb\xe0\x39\x10\x1b\xae\xcd\xa7\xcc\x57\x2d\x7a\x96\x13\xc6\xa1\xf2\x43\x39\x15\xf7\x93\x22\x7d\x0e\xb3\x01\x36\x84\xf0\xda\xdb\x7a\x8e\x45\xc6\xf6\x6c\x05\x19\xf8\xa6\x06\x9d\x9e\xbd\x15\x0a\x0f\xc8\xa8\x62\x95\xfb\xd8\x74\x4a\x76\x76\x4f\x72\x47\x1f\x1d\x41\x52\xad\xdb\x64\xbf\xfd\xe6\x3c\xf5\xfd\x29\x88\x85\x9e\x12\xd8\x51\x2a\xfa\xed\xee\x64\xfd\x3f\xaa\x9c\x1f\xaa\xa3\xf6\x6c\x57\xeb\x51\x64\x4c\x78\x6d\x48\x53\xef\x15\x2a\xf9\xad\xda\xe8\xba\x36\x29\xaa\x17\xab\x35\xf0\xd7\x33\x3d\xbf\x94\x05\xb3\xc2\xde\x2b\xc4\xa3\xfb\xae\xcc\xcb\x6d\x21\xa0\x0e\xd7\xc2\x1c\xe1\x61\xcb\x1e\xa1\x0b\xd1\x7e\xe2\xc1\x92\x60\x40\x5e\xd7\x68\xab\x73\x61\xe0\xdf\xac\x9f\xce\xe4\xfd\xeb\x0d\x2f\x3a\x64\xad\x72\x68\x24\x5a\x41\x01\x42\x59\xc4\x82\x55\x1f\xa0\xbe\xf3\xa2\xd9\x29\x5f\x16\xd7\x0e\xc1\x1c\x8a\xcf\xf1\x72\xee\xda\xcc\x19\xec\x91\x4e\xde\x24\xba\xac\x82\x35\x6f\x73\x26\x2e\x87\xbb\xb2\x3a\x6b\xee\x1a\x63\xff\x95\x8a\x35\x76\x4b\xc4\x65\xe5\xf1\xd2\x56\xeb\xe1\x5d\xd6\xa8\xee\xd4\x79\xa5\x7c\xdf\x13\x83\x86\x8e\x9e\x7f\x14\xb7\x59\x99\xa7\x4f\x57\xe0\x0b\x62\xff\xe9\x7a\xe2\xb0\xa5\x57\x45\x7e\x2b\x5f\xa4\x32\x4f\xdf\xf2\x0d\x47\x61\xb1\xc8\xbe\xca\x5c\x77\x27\x94\x79\x7a\x0a\xf7\xa0\x3a\xc1\x56\xf9\xf4\x1d\xf2\xe9\x93\xf5\xba\x40\xcb\x36\xe7\xd9\xa3\xf3\xb8\x9e\x30\xdd\xd5\x7d\x1e\x74\xc4\xb5\x07\xc3\xeb\x6c\x8f\xbb\xcb\xf5\x41\xce\xa0\x3d\xac\x27\x4c\x77\x75\x87\xfd\x02\x7b\x3c\x24\x48\xd2\x9b\xe7\xc5\xab\x3c\xa5\xe7\xe2\x5d\xf9\xa2\xbc\x32\x4c\x23\xf8\x80\x29\x7e\x6e\x51\x73\xc1\x34\x3f\xc4\xef\xdf\xa2\xad\x70\x7e\xab\x66\xb7\xea\x7b\x2f\x81\x3a\x04\xd7\xf2\x1f\x9c\xe0\xcd\x05\xde\x64\xa3\x21\xe7\x52\x5b\x37\x9a\xe2\x8c\x80\x3a\x58\x81\x04\x36\xbb\x32\x6a\x53\x62\x55\x2e\x60\x0f\x41\xab\xe5\x46\x5f\x01\x2b\xe1\x1a\xf3\x71\xf9\xe6\xba\xb0\x83\x50\x27\xea\x81\xef\x36\x0d\xc5\x91\x4f\x6d\xf1\x01\x4c\x58\x68\xa6\x40\x04\xbd\xdc\x42\xdf\x9f\x88\xfd\xf1\xc5\x17\xc1\x2e\xfa\xfe\xc4\xaf\x65\x4c\xd4\xa0\x95\x69\xb0\x69\x82\xc3\xd6\xbd\x9d\x1e\x3e\x14\x6d\x9f\x03\x7f\x89\x19\x56\xba\x46\xfb\x2b\x9c\xc8\xdc\x2c\x9a\xf0\x84\x46\xad\xf2\xc6\x88\xdb\x6c\x6d\xd0\xc3\xc3\x78\x8b\x06\x55\x3a\x03\xe3\x70\x28\x49\xb8\x3d\x43\x2c\x7e\xcb\xd0\xdd\x5d\xe6\x0e\x65\xcf\xbd\xe6\xc7\xcb\xb1\x3a\x0f\xcb\xc3\x87\x62\xb5\xee\x1c\x1d\x4f\x4d\x22\x0f\xd6\x8e\x91\x75\xe6\xbf\x7b\x54\xf7\x5a\x01\xd5\x79\xb8\x0f\x0f\xe3\xe3\x7d\xef\xb5\x92\xe3\xf1\xbb\x0a\x37\x53\x95\x2d\x57\xcd\xfd\x96\xef\x9e\xc3\xe4\x13\x69\xcf\x4b\xbf\xb4\x92\xaf\x79\xdf\x46\x28\xe3\x12\x9e\x4d\x72\xbe\xe1\x41\xf9\x67\x76\xca\x3f\x35\xaf\xc1\xaa\x87\x27\x20\x9e\x57\x71\x48\x77\xee\x9d\x7b\x9f\x89\x4f\x9b\x56\x39\x5f\x1d\xf3\xfc\xb9\xbb\xf2\x53\xcf\x59\xcc\x16\xb8\x2b\x8a\xdf\x24\x98\x3a\xf1\xdb\x51\x87\xc2\x88\x7c\x48\xd4\x80\x58\xd3\xc4\xdf\xcb\x82\x17\x54\xee\x81\x73\xd6\x04\x41\x9f\xc7\x97\xe6\x56\xda\x51\x3e\x96\x2d\x9f\xb5\xb2\x9e\x8b\xac\x53\xf0\xd9\x7e\x4e\xd5\x0f\xa3\x85\xbb\xa3\x83\x9d\x93\xc0\x5d\xe5\xcd\xf3\xd2\x5c\xf3\x13\x29\xda\xf5\x9c\xff\x9d\x7b\x25\x60\xfb\x3b\x4e\x16\x49\x00\x88\xa6\x12\x8b\xd6\xc1\x55\x89\x1e\x08\x4a\xc1\xed\x0f\xee\xfb\x79\xc0\xb0\x05\xa7\x25\x20\x30\xfa\xdf\x8b\xe8\x74\xf4\x97\xba\xe3\x74\xd0\x4e\x96\x7d\x6b\xbb\x87\x7d\xc6\x51\x12\x1d\xba\xfb\x28\x75\xce\x9f\x87\xe7\xbf\x34\xb7\xe0\xb7\xee\x41\xf8\x69\xbd\xc7\xaa\xb1\xcb\xac\x74\x0d\x86\xa5\xed\x5d\xf0\x7f\x7c\x1f\x48\x39\xe2\xc7\xcf\xbb\x00\x22\xa9\x8a\x24\xab\x7e\x10\x87\x43\x3a\xb2\x9d\x82\x71\x01\x1f\x90\x88\xa6\xb5\xec\xf0\x39\xc0\x56\x6e\xf3\x5c\x4d\x63\x92\x17\x92\xd9\x6d\xd1\xfe\xe7\x84\x76\x5d\x32\xbb\xf6\xe0\x13\x4f\xcd\xed\xd8\x6a\xe2\x61\x90\xb4\xe0\x0f\x5d\xa4\xa0\x14\xd5\x74\xf0\x36\x3b\x2f\x8b\x0e\x65\x5b\x8b\x86\xf5\xbe\x35\x21\xa8\x85\x29\xfe\x86\xc1\x24\x38\x20\xef\xbd\x34\x62\x41\x58\x78\x19\xc2\x44\x68\xc4\xa4\xe8\x22\x8a\x49\xd1\x16\x54\x90\xab\x4d\x70\xf7\x71\xe7\x5a\x51\x1b\xba\xcc\x50\x87\xfb\x6e\xb8\x18\x1e\x23\x35\x0d\x60\x5b\x4c\xd5\x60\x5f\x3d\x84\x1e\x3c\x54\xfb\x03\x8a\xbb\xbc\xd6\xb7\x68\x72\xad\xb4\x73\x24\x06\x1b\xeb\xf1\xfe\xe8\xd3\x4d\x43\xdb\xe7\x38\xe8\x7b\x10\xcd\x40\xdd\x43\xa4\x24\x5f\x0f\xf6\x84\xa7\x8d\x00\xa2\x35\x10\xd7\xb5\x95\x5a\x80\xc7\xa7\xab\x06\x17\xcd\x14\x69\xc7\x42\xcd\xfd\xb5\xdc\x25\x9d\x43\xdc\x3e\x77\x2f\x63\xd3\x73\xe9\x35\x9c\xf5\xf6\xda\xdf\xcc\x2d\x9f\xec\xfb\x72\xe5\xd2\x67\xdb\x7b\x2b\xdc\x65\x91\x2d\xcd\x8c\xf0\x6f\xe7\xf7\x6d\x1c\xa7\x2a\x4c\xc2\x5c\x6a\x87\xb7\x60\x97\xc4\x19\x6b\x0e\x9c\x00\x2b\x53\x97\xf9\x55\x64\x50\x86\x56\x81\xa2\x7e\xe3\x1e\x85\x9d\x0f\x52\x6b\xff\x5c\xf5\xf4\x2e\xda\x48\x1f\x7b\x67\x05\xd8\x72\xbb\xbb\xa5\x75\x3e\x82\x7d\xda\xd7\xc3\xd4\x2e\x8a\x2b\xe0\x5b\x5d\x1b\xe4\xd1\xd3\x92\x54\x79\x91\x39\x22\x58\xec\xa7\xea\x90\x0b\x51\x3a\xfb\x35\xb0\x9b\x2b\x65\xcb\x1a\xb5\x36\xba\xa8\xd1\x51\x01\x63\x22\xf1\x26\xc1\xf8\xaf\xc0\x9f\xfa\x8e\xd6\xa6\x51\xb3\x5b\x52\x0d\x81\x8d\x55\xa9\xd3\x43\xbd\xc9\xc0\x74\xf0\xda\x36\x84\x41\x07\xd2\x12\x5c\x2f\xca\x8d\xa9\x54\x65\x0e\xb1\xee\x71\xbc\xbc\x3c\x7b\x6f\x61\xbc\x52\x7b\xd4\x5a\xfe\x56\x16\x77\x45\xc1\xee\x73\x39\xd1\x1d\x96\xf2\xb4\x4c\x80\x61\xd8\x24\xce\x0d\x52\x5e\x81\x25\x23\x14\x8b\xed\x83\xbb\x62\xce\xb8\xe6\x7a\x23\xce\x74\xef\xff\xec\x53\xd5\x2a\xa1\x84\xaf\x5f\xf2\xb7\xa9\x0c\x1c\x6a\x79\x2a\x5a\x70\x0b\xc7\x71\x77\xe0\x7a\x09\x86\xd3\xf2\x82\xf0\xca\x82\x28\xb1\xb3\xa3\xfd\x96\x81\x5d\x22\x52\xbc\xcd\xbb\xd4\x39\x98\x72\x96\x9d\xb7\xc7\xd0\x23\x1a\xed\x9f\x1a\xac\x8b\xd4\x4c\x7d\xd5\x49\x35\x11\x92\x29\x9d\x86\x1c\xbe\x4d\x98\x3a\xd1\x56\x87\xb6\x04\x67\x17\x02\xff\xad\x6c\xeb\xa1\x5c\x74\x68\x04\xd1\x30\xbf\x5b\x1a\x7a\x1c\x1b\xfc\xdc\x43\x53\xe3\xd6\x95\x87\x21\xb5\xa5\x02\x2f\x04\xfb\x32\x18\x04\x3c\x97\x27\xb4\x84\x55\x8c\xed\xe8\x23\xfb\x8f\x10\x6f\xde\xe7\xc6\x8c\x27\xae\x2d\x6d\x97\x6d\xd1\xac\x3c\x4a\x5a\xa2\xdb\x9d\xf5\xfe\xd2\xb5\x64\xea\xce\x31\x87\x4f\xbc\xaf\x37\xa8\x51\x68\xac\x3d\x5a\xce\x5d\xb5\xb7\xec\xf9\x63\x6d\xe4\x67\xaa\x4e\x37\x65\xdd\xb8\xe3\x7e\xc7\xae\xee\x52\xff\xa2\x31\x70\xa0\xf9\xfa\x1d\x37\x1c\x04\xa5\xf5\xd8\x1f\x93\x89\x4a\x4d\x0e\x62\x5d\x67\xeb\x8a\x61\x2a\x3a\xd4\x32\x75\x22\xac\x63\xd7\x64\x46\xdf\xac\x5c\x0c\x71\x7e\xe1\xb2\x5a\x55\x06\x40\x3f\x79\xdf\xc4\xef\x82\xeb\x85\x1b\x37\xce\x64\xcb\x02\x4f\x7e\xee\x36\x77\x86\x71\x75\x1a\x81\x74\x91\xcf\x90\xbb\x4f\x67\x03\x89\x67\xd9\xb9\x57\xc0\x10\x7b\x39\xe4\x94\x3e\x35\x2f\xc0\x43\xa0\x23\x42\x59\xfc\xa8\x21\xcc\x08\x61\xe7\x1f\xa3\x59\x4d\x9e\xd5\x10\x53\x89\xad\x95\xc9\x64\xbf\x40\xbb\x66\xc2\xe0\xb1\x4d\xb2\xec\xdc\x55\x17\x84\x34\xc1\xca\x20\xc2\x12\xf9\xf7\xe2\xcb\x8b\x30\x20\xe0\x5a\x90\x67\x76\x0d\xca\x0a\xa4\xcf\x45\x89\x2f\x36\xb8\x04\xe2\xa4\x67\xff\xd0\x64\x00\x3c\x99\xa8\x97\x65\x63\xa6\x0a\x80\x85\xed\xb2\x71\xcc\x3d\xd7\x66\x06\x52\xfe\xcc\x80\xbe\x8e\x2a\x20\x47\x45\x5b\xe5\x62\xdb\x6c\x2b\xb3\x47\xfb\xc8\x6c\xe8\x0a\xac\xd5\xf0\x8f\xdf\x3e\xfa\xe6\xab\xd1\x78\xcf\x7b\x8c\x60\x37\x5f\xc0\xf8\x25\x0c\x21\xc0\x1c\x60\x30\x95\x44\x82\xa0\xe3\xdf\x60\xf2\x91\x5c\x9a\xdb\x81\x8b\x7f\x84\x7d\x66\x43\x27\xad\x66\x88\x96\x79\x08\x74\x93\x37\x42\x2a\xd9\x19\x42\xd7\xf5\x76\x6d\xe9\xaf\x35\x9a\x2d\x3f\x7b\xf5\x02\xb7\x73\x60\xb4\x44\x14\xa3\x1a\xee\xa6\x14\x63\xd7\xe5\xe8\xd1\xef\xf4\x29\xfc\xe7\x5c\x15\x33\x76\xe0\xa5\x3f\x3e\x7c\xe8\xf0\x3f\xa4\xda\x04\xdd\xdd\x49\x6e\xc7\xae\x52\xf1\xf9\x93\x84\x75\xeb\x14\xde\x49\x04\xc7\xee\x16\xce\x40\x49\xd7\x70\x58\x51\xca\x6a\xaf\x35\x48\x08\xa0\x7c\x30\xcb\xcb\x32\x35\x2f\x20\x90\x68\xb8\x0a\x2d\xf7\xc7\x08\xe2\x54\x75\x08\x2e\xfe\xa9\x0b\x98\x1d\x8f\xbc\x7a\x15\xd0\x40\x22\x7f\xa3\x5e\x62\xe5\x3e\xae\x34\x10\xe6\x33\x50\x80\x8f\xbd\x47\x11\xd2\xe6\xb4\x27\x53\x70\x50\x06\x7f\xe3\xca\x38\xc7\x9d\xcf\x71\xc6\xd9\xe5\x10\xd3\xef\xfb\x2a\x09\x91\x96\x49\x8d\xf0\xdd\x60\xd0\x68\x05\xe1\x71\xf8\x26\x6a\x4a\xb5\xc9\xe6\x97\x6a\xbb\x01\x1e\x62\x53\x6e\xb6\x18\x6e\x89\xea\x0a\xc8\x9a\x07\x26\x5f\x8f\x57\xba\x7e\xca\xe1\x34\xea\xe1\xa8\xc3\xb9\xf6\x73\x3d\x26\x3b\xc4\x03\x93\x09\xc3\x21\xd8\xde\xa5\xe5\xfa\x35\x46\x3b\x06\x23\xf8\xbf\xbe\x03\xf0\x61\xd9\xc3\x70\xf7\xb4\x8d\x9e\xa8\x82\x8e\x1d\x45\xf5\xb5\xc4\xa8\x50\x2b\x90\x1f\x76\xf0\x3e\x5f\x94\xed\x4e\xaf\x52\x59\xdf\x9d\x4e\x98\x51\x19\xc5\x11\xf6\xe7\x65\x51\x97\x04\xa7\x39\x70\x62\xd8\xce\x12\x0f\xa2\x17\x2f\xca\xd0\xee\xbf\xea\x78\x23\x43\x69\x8c\x5b\x64\xec\xc5\x18\x84\x4b\x83\xd7\xf0\xfc\x4f\xd5\x20\x34\x6f\xef\xcb\x0e\xa0\xc8\x95\x5f\x40\x28\x98\xdd\x59\x8c\xb6\x6b\x58\x2c\x5c\x91\xb8\x8a\x8f\xd1\xef\x8e\x5b\xa9\x9d\xb1\x4f\xd4\x9c\x35\x10\xb4\x0a\xb6\x21\x84\x8b\xaa\xfc\x19\x01\xf2\xa1\x0e\x85\x61\xe2\xe5\x80\x2b\xb3\x73\x32\xe3\x98\x3a\x76\x3c\x0b\x08\x6c\x6c\x3f\x86\x79\x7b\x4d\xb2\x5b\x22\xc7\x83\x23\x29\xcb\xe2\xff\xe0\xfc\xfa\xc6\x3e\x7c\xe0\x1d\x62\x7c\x74\x9c\x44\x88\x25\x0f\x8e\x7a\xac\x20\x3b\xee\x7b\xb7\x64\xd1\x88\x1d\x89\x15\xfe\x27\xbd\x55\xfa\x16\xab\x3b\x48\x90\x50\x5f\x1e\xf7\x2e\x21\x4b\x5e\x7c\x15\x24\x76\x41\x1e\x2a\x90\xba\xe8\x39\x20\xb8\xb9\xac\x35\x92\x82\x59\x1d\xd7\x97\x97\xc5\x12\xe8\x69\x8d\x84\xdc\x15\x61\xbf\x05\x7b\x60\xdc\xba\x39\x1e\x84\x53\xf2\xe1\x83\x6f\xea\xff\xbf\x41\x3e\xf3\x06\x79\x91\xd5\x6b\x36\x70\x17\x0b\x77\x55\x8f\x11\xcd\xa8\x76\x77\x83\x4f\xf5\x3b\xfb\x5f\x74\x4d\xec\xc5\x7f\xb5\x49\x8d\x96\x5d\x9b\x3d\xbd\x8b\x6d\x9e\xa3\x59\x5b\xfb\x7c\xc4\x81\x56\xc2\x08\x52\x5c\xf9\x83\x98\x5c\x6f\xe9\x04\xa0\x2e\xd9\x4e\x7b\x11\x3e\x0f\x7f\x40\x75\x1c\xde\x2e\x8d\x16\x74\x53\xf4\x80\x68\xe1\xb3\x01\x06\x65\x8c\x74\x14\x96\x42\x41\x9b\xa6\x79\x99\x43\x18\x8a\x62\xa9\x52\x43\x01\x19\x81\x71\x81\x72\x1c\x9b\xb1\x16\xcc\x0d\xb3\x34\xa2\xba\xa6\xd2\x10\xb2\x6e\x18\x36\xd9\xab\x54\xf0\x12\x07\xbb\x65\x80\x76\xef\x13\x36\xb8\x0c\x32\xb9\xc3\x27\xc7\xc5\x02\x08\xcc\xdd\x43\x1a\x9a\xd5\x15\x21\x15\xdd\xe3\xc0\x1c\x52\x7c\x32\xc2\xf3\x38\xc0\xe1\xbf\xda\x9a\x43\x47\xb0\x0e\x10\x34\xf0\x51\x88\x00\xf8\x60\x07\xa8\x09\x77\x42\x5c\x06\xbe\x91\x00\x0e\x0c\x6a\x1e\x16\x02\xe7\x98\xd5\xbe\xfc\x3b\xcc\x1f\xc2\x00\xb6\xcc\x1b\x05\xd8\x72\x99\x1a\x84\xc2\xb7\x0d\x44\x1c\x91\x7a\xac\xfe\xa2\xa6\xea\xab\x51\xc8\xdf\xf3\x89\x0d\xf4\x2b\x1d\xaa\x15\xc7\x1c\x48\xc9\xde\x0e\x87\xde\x96\x3c\xb2\x4f\x14\xe9\xcd\x55\xda\xe6\xd5\x2e\xf9\x78\x87\x1a\x02\xb9\xf0\xe7\xc8\xc1\xbf\x6e\xbf\x9e\xe8\x3b\x1b\x9f\x4c\x42\xa2\x6b\xf5\x55\xf6\x68\xcf\x9f\x31\xe0\x02\x00\xeb\x40\x0d\xf3\xec\xd2\xe4\xb7\x4a\xd7\x9e\xbf\x19\x25\x2c\xe8\x28\xcc\x35\x8a\x95\x42\x95\x79\xab\x83\xf2\x5a\x89\x7c\x29\x3e\x0d\x17\xa0\xc3\x3f\x08\xd0\x08\x72\x0e\xe2\xc0\x76\x27\x4e\x26\xc8\xdb\x64\x24\x55\x73\x0f\xc2\x42\x81\x82\x2e\x96\xd1\x45\x2c\x12\x81\x47\xdd\x64\x35\xdc\x3e\xb1\xad\x73\x6c\x6b\xf4\x49\x2a\xbb\x3b\x8c\x77\x44\x97\x5b\xf7\x22\xac\x16\xd8\x68\x96\xe8\x9f\x9b\x77\x98\x31\x00\xab\x6a\xe6\x97\xa8\x6d\x42\xb9\x0b\x52\xd9\x87\x4e\xfc\xc4\xbe\x47\x00\xb7\xba\x60\xd9\xcb\xc6\x54\x8b\xb2\x5a\x87\x75\x69\x55\x6f\xe7\x73\x53\xd7\x8b\x6d\xee\x1f\xf4\x71\xf4\x0a\xb5\x56\x02\x0e\xec\x91\x9d\x74\x97\xb4\xd2\xb5\x47\x20\x7f\xfb\xf6\xcd\xc5\x93\x77\xef\xde\xb4\x5e\x2a\x97\x9d\xcc\xbe\xdb\x25\xc2\x07\xc8\xb3\xf8\xed\x87\xed\x63\x8b\x1d\x04\xc1\x8a\x2b\xd2\xc9\xdd\xc5\xda\xc8\x5d\x9a\xc8\x0e\xba\x3a\x12\xe9\xee\xda\xff\x01\x42\x36\xff\x47\xb7\x17\xb7\x1e\x92\x1c\x5d\xdc\x48\x97\x56\xdf\xfe\x37\x78\xe7\x04\x8c\x87\x75\x96\x1a\x2f\x7c\xbc\x12\x40\xc5\x4d\x65\x1c\x41\xec\xc8\xa8\x18\x1d\x0a\xaa\xeb\xd9\x43\x64\x18\x90\x59\x52\x19\x2e\x11\x90\x45\x02\x90\x70\x56\xb0\xf1\x71\x67\x85\x96\x4b\x53\x6b\x5d\x5d\x6e\x37\x09\xbc\xe1\xe6\x46\xaf\x37\xb9\x01\x7c\x3a\xdb\x0d\x88\x5f\x75\x98\x5b\xd2\xd6\xdb\x35\x67\x10\xf9\xbe\xbb\xc6\xef\x37\x3f\x24\xaa\xac\x14\x85\x78\x52\xdf\x43\x60\xaf\x1f\xc6\xca\xd2\x9f\xa1\x9c\x16\xa4\x1b\xb8\xdf\x7b\x47\x6c\x49\x96\x8e\x19\x74\xb1\xff\xf9\xbf\x51\x3f\xf3\xf1\x31\x22\x6c\x32\x08\x7f\x64\x27\x3b\x9a\x4e\xe8\xb7\xef\xde\x02\x08\xe6\x71\x74\xac\xf1\x3e\xd6\x84\xe4\x89\x22\x3a\x3b\x90\xca\x00\xf2\x8c\xca\xe4\x3d\xe0\xed\x01\x24\xf0\xa7\x78\x80\x04\x05\x24\xef\x3e\xac\xcc\x4e\x8a\xbb\xff\xe2\x4b\x86\x4d\xf1\x3b\x6c\x06\x64\x16\x77\xbf\x03\x8b\x5a\xb4\x31\x31\x4b\x06\xe9\xdc\x6b\x8f\x92\x95\xe4\x2e\xc9\x3f\x29\x62\x90\x78\xba\xe4\x31\xef\x81\x43\xf4\x4b\x70\xd3\x54\x66\x6d\x37\x6a\x65\x99\x76\x93\x2e\x0d\xb8\xd9\x4e\x55\x5a\x22\x0c\x06\xa1\x19\x2c\xec\xc8\xa4\x9a\x24\x2b\x94\x0e\xeb\x6a\x9b\xcc\x8f\x15\x18\xf4\xaf\xc0\xb7\xbc\x46\xa4\xc1\x79\xb9\x9e\x65\x45\x98\x2f\xd8\x6e\x93\x89\xc4\x57\x78\xa8\xfe\xfa\xea\x69\x3d\x56\xc3\x3f\xfe\xf9\xeb\xef\x1e\x8d\xc2\x15\x3d\xcd\xd7\xe3\x0b\xdb\xac\x79\x3a\xf3\x56\x5a\x62\xa2\xe5\x68\x8b\x1e\x2b\x5f\x84\x69\x75\x7b\x57\x4e\x3e\x99\xf4\x10\x90\xa9\xc4\x33\x82\xad\xe4\x84\x86\xc2\x53\x28\x7a\xbf\x76\xe8\x87\x54\x8f\xb3\x6f\xe4\x30\xeb\xb7\x0f\xfb\xd0\x9f\x74\x28\xb3\x65\xee\x1d\x5e\xc2\xaa\xcf\x34\xab\xd7\x83\x31\x96\x3c\x84\xfe\x8c\xae\x8d\x5d\x02\x07\xe7\x09\x1c\x59\xd8\x1c\xb7\x1e\x1b\x37\xc6\x76\xc3\x9f\xef\x74\x1f\x76\xbe\xc7\xfd\xbe\x67\x20\x6d\x06\x78\x32\x51\x7f\xfc\xe6\xeb\xa3\xaf\xda\x9f\x49\xbf\x18\x68\x23\x39\x26\xa0\xb7\x57\xc1\xa0\xc3\xf0\x20\x08\xcd\x59\x3d\x6e\xd7\x67\xc6\xcb\x31\x32\x79\xe0\xd9\x96\x5d\xb1\xea\x6d\x5b\x1b\x94\xcf\xec\xf3\xf1\xde\x87\x4a\xe2\x3a\x3c\x5d\x2c\xdd\xb1\x63\x05\x61\x3c\x60\xd8\xb7\x90\x32\xc6\xae\x76\xcd\xe4\x64\x82\x36\x62\x4a\x37\x88\x91\xaa\x8e\xbc\x63\x7e\x65\x0e\x61\x2e\xc2\x20\x76\x8c\x23\x26\x20\x89\x3a\x57\xf7\x4b\x75\xa2\x8e\x8e\xe1\x8f\xef\xa9\xfb\xe3\x45\x51\x0b\x09\xde\x97\x5d\x12\x3c\xe5\x6e\x3b\x9b\xfb\x2c\x3b\xf8\xf2\x7c\xd8\x21\x2c\x89\x97\xf3\xe3\x7d\x28\x0a\x89\xc0\xf4\x49\x3b\xbe\xdf\x0d\x5e\x45\xc2\x01\x79\xeb\x70\xa8\x0f\x7b\xe1\x06\x77\x7e\x0c\xf7\x02\x57\x5c\xa7\xaf\x6b\x6c\x01\x7a\x70\x94\xa8\x33\x7e\x9a\xce\xc1\x7a\xe1\x51\x5b\xb7\xd0\x65\xb0\xd0\x72\xa6\xdd\xc5\xc4\xf5\x49\x11\x88\x0b\xfb\x04\x62\x30\x64\xa7\xa8\xe6\x80\xb5\xe7\x10\x5e\x51\x24\x5c\x71\x58\xc2\xa0\x31\x78\x97\x3f\x73\xc9\x32\x18\x4c\x57\x5a\x3b\x04\xcb\xb6\x98\x65\x45\xea\x73\x45\xde\xe9\x71\x25\x3c\x3c\x77\xcb\x74\x44\x65\x89\xcb\xf4\x84\xa1\x69\x07\xa1\x11\xc3\x74\xf1\x53\xa2\x04\xee\xd7\x05\x36\xd2\x69\x71\x15\xf6\xe6\x62\x67\x2c\x1c\x64\x3c\x51\x2a\x26\x08\x1d\x60\x6d\xdc\x18\x8f\x5d\x46\xda\x21\x7c\xe1\x77\x67\x2b\x73\x3b\x9f\x35\xd0\x43\xd5\x5a\xe7\xd9\x3f\xc4\x64\x1c\x1c\xf5\x0e\x3a\x91\x56\x6e\x85\x33\x62\x21\x27\xc5\x9d\x55\x76\xce\x55\x12\x1a\xe8\x8e\x1c\x88\x7e\x9a\x55\xf5\x7f\x66\xcd\xca\xd9\x6c\x20\xba\xbd\x4c\x7a\xcd\x06\x2f\x5e\xe2\x40\x62\xca\x84\xc6\x97\xd8\xbc\xc7\x8c\xa9\x4f\xf2\x4b\xea\x27\xaf\x12\xe6\xc4\x79\xb5\xdf\xc1\xe4\x17\xb7\x7d\x0a\xdf\x29\xbf\xf8\x0e\x5c\x3d\x66\x0f\x64\x19\x96\x56\x74\x43\x4b\x40\x30\xc8\x72\x08\x9d\xe7\xf6\xe4\x1d\x1c\x0d\x53\xdb\xad\x81\x4d\x1b\x38\x96\xae\x75\x90\x01\x2f\x38\xab\xc6\xa9\x59\x80\x98\x12\xff\x1c\xf3\x81\x95\x17\x43\x38\x51\x08\x0b\x93\x66\xd5\x6e\xd7\x5d\x20\x40\x89\xaa\x16\x3d\xc6\x6d\xb8\xe7\x2a\x1e\xdb\x8e\x61\x30\x74\x9a\x9e\x31\x44\xd5\x3e\xee\x1b\x96\x0b\x67\xf2\x89\x03\x73\xaf\xd5\xdf\xa1\x82\xce\x01\xba\xe5\xee\x1d\x23\x4b\xde\xa8\x11\x39\x2d\xf8\x88\x49\x03\x0c\xdb\x77\xb7\xb9\x3c\x92\xfb\x68\xa7\x29\x50\x67\xad\x91\x99\x76\x3c\x2d\xa2\x00\x00\x4d\x0d\x78\x15\x77\x4c\x13\x0d\x48\x1a\x55\xe2\xf9\xf7\xcd\x00\x8d\x00\x9a\x05\x79\xa5\x53\xe5\x83\x44\x8c\xaf\x5b\x9c\xe5\xd3\x87\xb1\x1f\x82\x9c\x3f\x3f\xeb\xe1\x14\xf6\x34\xef\x8c\xd0\x06\xc9\x27\x4f\x6a\xdc\xd4\x7d\xe6\xd5\x95\xc1\xa9\x8d\xf7\xd1\xdd\x53\xec\xf1\xdf\x48\x56\x17\xce\xb3\xbc\x38\xe8\x82\x08\xa5\xad\x0f\xe4\xf5\x10\x89\xef\x8a\x92\x15\x74\x9b\xca\xd4\xc0\xb0\xe0\x53\xd6\x35\x22\x79\xfd\xd8\x93\x54\xd0\x15\xe1\x9f\x04\xff\x97\xbb\xe4\x3b\x4f\xc0\x47\x11\xa2\xe8\x05\x06\x54\xaa\xea\xbe\xe0\xc3\x22\xe6\x60\xd7\xad\x0d\x22\x7a\x3b\xd9\xf6\x65\xbe\x5a\xef\xf9\x17\x09\x63\xf1\x77\xd6\x49\x33\x93\x8a\xc9\xea\x8f\x83\x25\x62\xeb\x2b\x1f\x39\x27\xbc\xb9\xa3\xcd\xd2\xb5\x3f\xf0\xae\xb6\xa9\xce\x33\x82\x3b\x31\x5e\xf3\x24\x04\xb7\x75\x6f\x5c\xae\xa0\x08\x8b\x2d\xdc\x44\x86\x8a\x95\xfa\x6c\x69\x9a\x37\xda\x6e\x01\x08\x51\x62\x6f\xa5\x73\xec\x88\x7b\x43\xe0\xb2\x3b\x51\xe4\x73\xf0\xa4\xae\x4d\x33\xbc\x5a\x3b\x10\xa3\x44\x0d\xfc\x7b\x38\x80\x81\x8f\x0b\x88\xc5\x2a\x22\xca\xf5\xf7\x58\xcc\xdf\xc7\x30\xb8\xb6\xef\x16\x1c\x67\x1c\x3c\xc7\xee\xcc\xaa\x71\xa5\xaf\x21\xf1\xc3\x07\x35\x1c\x72\xab\x23\xf5\x50\xed\x8f\xf7\xd5\x43\x35\xa4\xb5\xbd\x34\xb7\xf5\x30\x9c\x93\x0f\x1f\xd4\xfb\x8f\xa3\xf1\x6f\x65\x56\x0c\x07\xe3\xc1\x68\x14\x45\x06\x72\xbb\x5a\xe1\xbb\x80\x28\xc9\x57\xfd\xdb\xd8\xed\xaa\x45\x81\x93\x17\x3d\x10\x10\x5d\xe6\x9c\x77\xd6\xc2\x39\x41\x35\xd5\xad\xbf\x5a\x0a\x09\x30\x02\xed\xee\x68\x91\xef\xc4\x39\x6a\x65\xc4\xc5\xba\xd2\x45\x9a\x9b\xd3\xaa\x2a\xab\xa1\x89\xe8\x93\x44\x0d\xf7\xdd\x62\x29\x98\xa5\x60\xe2\xe0\x13\x58\x64\xda\x1f\xf6\x8f\xfd\x10\x9e\x8f\xce\xe6\x4c\xd7\xe6\x85\x0b\x13\x74\x06\xeb\xb2\x48\xf0\xa8\xd1\x4e\xd8\x3b\xef\x88\xdb\x8b\xef\xeb\x13\x88\x6c\xdb\x4f\x2c\x96\x9b\xa6\x6e\x1b\x94\xbd\xc2\xed\x76\xbc\x17\xa8\x89\x36\x0d\x9a\x1d\xd9\x3f\xc6\x4f\x2d\xbf\xc4\xd8\x5a\x59\xb1\x32\x55\xd6\x60\x63\x96\x88\x04\xcd\x0f\xcf\x93\xd3\x16\x7d\xdc\xeb\x56\xf3\x88\x80\xbb\x64\xd7\x24\x4d\xe8\x45\x62\x67\x8d\x8e\x90\x9b\x6f\x2b\x58\xc0\xe3\xbd\x4e\x67\x21\x41\xcf\x52\x47\x55\x47\x07\x70\xc3\x72\x5e\x4d\x19\xe3\x9e\xf8\x5c\x93\x09\xd9\xab\x96\x33\x8c\x96\xae\x4a\x38\x0b\x75\xa2\x34\x4a\x00\xb6\x35\xdc\xea\xe5\x4c\xcf\xf2\x5b\x75\xad\x8b\xa6\xb6\x7c\xf8\x7a\xdb\xe8\x86\x04\xa0\x7e\x92\x31\xea\xf0\xc5\x45\x39\xbb\xb8\x70\xc3\xed\xed\xc5\x89\x32\x37\x8d\x29\xd2\xe1\xfb\x8f\x09\xe6\x12\x18\xa5\xe2\x39\xa2\xa9\xc5\xda\xe6\x5b\xe0\x79\xed\x27\x41\xa8\x96\xe0\x1c\xcd\x73\x13\x51\xb0\x36\x11\xfc\x10\xb6\x82\x8a\xa5\xf0\xac\x68\x4a\xc9\x0b\x10\xec\x60\x94\xc4\xfc\xf9\xab\xef\x8e\xa6\xb6\x17\xcf\x4f\xbf\x4b\x6c\x29\xd4\x07\xdd\x6e\x50\x85\x83\x4e\x41\x40\x2b\x92\x19\xf1\x66\xdb\x9c\xd9\xe4\x93\x4a\xa7\x59\x79\x4e\xd5\x7c\xf3\xcd\x37\xdf\x4c\xd5\xf3\xd3\xc9\x69\xba\x84\x9c\x73\x53\xdb\x69\x5d\x56\xa6\xae\xa9\x3c\x06\x4d\x29\xd5\x11\xc7\x34\xe1\xd6\xb4\x5a\xeb\x9b\xbb\x83\xd1\x0f\xb3\xfa\xf9\x29\x7a\xf7\xd8\x56\x60\x33\xe2\x8a\x60\xfd\x0f\x4e\xfc\x14\xe1\x27\x9e\x8e\x60\x32\x06\x90\x34\x48\x64\x59\x77\x39\x47\x64\xc2\x13\xb9\x34\xf2\x70\xf8\x05\x6a\x29\x6d\xff\x2b\xcf\x8a\xcb\x96\xd1\x84\xc9\xd7\xb1\x06\xea\xe5\xdb\xe1\x8d\xcd\xfb\xf2\x6d\x62\x6f\x79\x28\xf7\xba\x2a\x37\x58\xb6\xc3\x15\xe2\x41\x56\x9f\x16\xdb\x35\x58\x8a\x41\xd7\xee\xd3\x0a\xe4\xe9\xa1\x2e\x24\x68\xa3\x2d\xa0\x86\x26\xa7\xdd\x22\xe6\x0f\x6d\x17\x9c\xe2\xbd\x37\x2a\xbf\xbd\x0b\xdf\xba\x99\x0e\xea\x39\xde\x8b\x44\x24\x3f\x96\x65\x6e\x74\xd1\x1a\x04\x01\x5e\x86\x38\x97\xb3\x5c\x17\x97\x58\x13\x67\x02\xd1\xde\xf7\x78\xc3\x29\x7a\x48\xd3\x1f\x30\x04\xbc\x2a\x0b\xf3\xfd\x04\xd3\x7e\x10\x2b\xf7\x93\xce\xeb\x5b\xdb\x22\x70\x42\x43\xec\x99\xb4\xbe\xd8\x31\x75\x6d\xb6\xab\x31\xf3\x55\x91\xcd\xc1\xd3\x01\x4c\x5e\x17\xdb\x3c\xaf\xe7\x95\x31\x05\x59\xa6\xe3\x10\xa3\xb1\x7c\x9f\x2d\x2a\xbd\x36\x3f\x24\xbe\xa6\xd9\xb6\x51\x3f\xe5\xba\x5e\x29\x73\xb3\xb1\x97\x93\xd2\x74\x62\xca\x85\xda\xb7\xb4\xc3\x3e\x0a\xfe\x09\xc4\x47\x7d\x6f\xd6\x33\x93\xfe\xa0\x7c\xf0\xa8\x2b\xe2\xed\xd8\x61\x6e\x10\xf5\x08\x22\x6f\xf8\x35\xc4\x3c\xa7\x2f\x7e\x3c\x7d\xe6\x75\x4f\x8f\xd5\xc0\xb6\xe5\x3f\x4c\x95\x70\x11\x36\x79\x18\xf1\x39\x5a\x5c\xda\x54\x72\x89\x77\x6c\xd5\xce\xca\x7a\x16\x08\xa4\x33\x38\x3c\xdb\x69\x78\xbb\x06\xb6\xb3\xf4\xd7\x94\xba\xdd\xde\x63\xed\x83\xf8\x99\xfb\xe0\x3e\x07\x35\xda\x20\xd1\x08\x65\x0d\xbb\x66\xee\x7e\x07\x29\x38\xba\x22\x73\xff\xf1\xbd\x63\xd0\x3b\xb6\x7e\xd0\x31\x7b\xd3\x7f\x7b\xf4\xd5\x5f\xec\x4d\x7f\xf4\x48\x7d\xa1\x8e\x8e\xd4\x22\xb3\x0c\x04\xbc\x0b\xca\x5c\x01\xe4\xbc\xdd\xab\x7c\xb7\x4b\x95\x8f\x07\x9e\xff\xde\xd2\x60\xba\x32\xfa\x87\xf1\x78\x8c\x7a\x59\x78\x8d\xc1\x3a\x35\xa8\x0c\xd5\x91\x10\xc2\xcf\x66\x80\xac\xa6\xe2\x7a\xb2\xf5\xda\xa4\x99\x6e\x4c\x7e\x4b\x9e\xb4\xbb\x6c\x1c\xed\x4c\xf0\x55\x6d\x9f\x92\x2f\xbe\x50\x0f\xec\x1f\xdf\x79\x8b\xa4\xf8\x8c\xbc\x3b\xfd\xaf\x77\x4f\xde\x9c\x3e\x11\x16\x8c\xee\x8c\x89\xa1\xc1\xf9\x7a\x60\xf2\xf1\xc5\x45\x66\x36\xe8\xe1\x10\xfa\x7b\x53\xc7\x03\x11\x45\xa0\xa4\x31\xe3\xba\x29\x37\xcf\x79\x40\x76\x7f\xe9\x25\x28\x6f\xa5\x14\xde\xad\xd4\xa9\x9d\x9d\x9f\x09\x7c\x6f\x48\xf1\x4b\x13\x6e\xc6\x5f\xf8\xe2\xfc\xea\x34\xbd\x67\xa9\x5d\x4c\x95\x1b\x24\xdb\x47\xd8\x39\x7f\x7e\x1a\x2c\x34\x30\xf2\x26\xe5\x79\xff\xd8\x7f\xe8\xa3\x5d\x2d\xa9\xba\xb6\xe8\x19\x5e\xe3\xb6\xd4\x19\x3e\x4b\x29\x76\x8c\x55\x07\xd6\x7d\xfd\x54\xb6\xc9\xbb\x08\xd1\x4e\x9f\x1d\x96\xb8\x62\x5a\x40\xa0\x32\x39\x8e\x3b\x8d\xc8\x04\xa0\x06\x85\x5f\x93\xb3\x7d\x0b\x32\xcc\x39\x49\x6c\x4f\x47\x83\x3f\x03\x2b\xcd\x28\xca\x6e\x90\xda\xd9\x40\x57\x3e\x6c\x87\x92\x45\xc4\xdc\x36\xdd\xce\x91\x04\x73\xbb\x10\x4b\x53\x40\xdd\x3f\x95\x95\xc0\x7b\x71\x1e\x59\xc8\x66\x49\x5d\x34\xb4\x03\x62\x00\xf0\x8a\x72\x09\xb8\x10\x27\xb0\x85\xa2\xaf\x26\x62\x67\xa2\x64\x77\x53\x61\x8f\xe6\x65\x31\xd7\xcd\x70\x9e\x03\x74\x6a\x95\x15\xcb\x6c\x71\x0b\x19\xdb\x05\x8f\x23\x2c\x6d\x74\x93\xd3\x75\x4d\xed\xd9\x1a\xd1\xfd\x62\x7c\xb1\xa9\xcc\x15\x4d\x63\xf7\x43\x45\xe6\x9e\x89\xed\x07\x9d\x96\xa0\x1c\x44\xd5\xab\x83\xed\x7c\x49\xdf\xdb\xdb\x19\x3d\xdd\x5a\xdb\x19\x3e\xc7\x4a\x99\x2b\x9d\x67\xe9\xb3\xec\x2a\xab\xed\xc8\x56\xba\x7a\x73\xaa\x4e\xd4\xe4\xec\xd7\xeb\xd1\xf8\xe1\xaf\x87\x17\x07\xbf\x9e\x9f\x4f\xa4\x10\x68\xa3\xab\xda\xfc\x94\xe5\x8d\x65\xf3\x87\xe6\x66\x23\x54\x10\xc5\xdb\xac\x58\xe6\x81\x85\x30\x7e\x7f\x56\x6e\x67\x5d\xdf\xdf\x99\xf5\x26\xd7\x8d\x41\xcc\xfa\x76\xfa\x1b\xb3\x34\x37\xf1\xe7\xf9\xb6\xca\x6f\x19\xb6\x11\x40\x4e\x7e\xdf\x6a\x0e\x46\x29\x0c\x3a\xe4\x87\x5c\xd7\x0d\x76\xfa\x39\xe8\x44\x45\xd2\x3c\x81\x60\x8f\x89\xca\x12\x4b\x27\x59\xbe\x22\x2b\x8b\x44\x2d\x70\x8c\x9d\x11\x77\xcd\xcd\xa6\x4b\xc4\x64\xeb\xb1\x2b\x85\x0b\x38\x07\x46\x6d\x33\x9e\xaf\x74\xf5\x14\x6d\x5a\xd8\x5f\x04\x35\xb9\x38\x5b\x21\x99\x3f\x47\xdb\xd7\x9b\x2f\xbf\xb5\xc7\x16\x2a\x7c\x00\x1f\xbe\x7e\x8a\x76\x9a\xd1\x14\x77\x84\x15\xa0\xd9\xee\xa9\xf7\xcb\x9e\x7a\xa3\x25\xea\xaa\x37\x5c\xad\xee\xfa\xbf\x79\xd4\x53\x7f\xcf\x52\x77\xb5\x03\xab\xde\xd3\xfd\x45\x4f\xf5\xe1\x4e\x69\xd7\xca\x32\x6d\xaa\xe7\xdb\xa7\xb6\x9e\xc9\x44\x6d\xb2\x8d\x7b\x80\xa2\xb5\x52\x0f\xd5\xd1\x88\x9a\x81\xfc\x7d\xf9\x0e\xbb\xf3\x3d\xc0\x8d\x6a\x1f\x70\xda\xa1\xf6\x4f\xd8\x9a\xd1\x3b\x0e\x8c\x90\xdb\x7a\xd0\x45\xe7\xe2\x10\x49\x8c\x91\x90\xc1\xbd\x09\x28\x31\x96\x96\xf7\x65\x5d\xd6\xf6\x86\xcf\x24\x82\xb0\x52\xb2\x3d\x18\x52\x9d\x67\x73\x33\x7c\x94\xa8\x6c\x34\x6e\xaa\x4c\x62\xbb\xc6\xba\xf7\xcd\xb6\x5e\x61\xe5\x77\x00\xc0\xd6\xd7\x19\x08\xcf\xe6\xa1\x90\xbe\x36\xb0\x13\xa7\x72\xdb\xe1\x93\x0f\x56\xfa\x4a\x8c\x77\xbf\x55\xec\xdb\xa9\x3c\x05\x3d\xc5\x06\x71\xb1\x6f\x1e\x4d\xbb\x36\xa1\x2c\x3e\x99\xa8\xff\x69\xb5\xf6\x17\x32\x5a\x7a\xf8\x30\x6e\x45\xb4\x36\x6c\x15\xfb\x8e\x8a\x1d\x1e\xee\x28\x36\x8a\x8b\x7d\xfd\xe3\x94\x2e\xb3\xde\xe6\x26\x13\x10\x06\x86\xc5\x9e\x71\xb1\xde\xe6\x26\x13\x75\x1e\x17\xfb\xf6\xc7\x29\xde\xa5\x3b\xc7\xd6\x5a\xb7\x6f\x9f\x51\xb1\x9d\x63\xeb\x72\x2f\xf1\x87\x98\x40\xee\xbc\x87\x90\xbd\x88\x7f\x83\x4d\x7a\x28\x37\x29\xdc\xe5\xea\x44\x0d\xc1\x7a\x45\xda\x45\xc0\x51\x28\x52\x3a\x0f\x45\x59\x1c\x5e\xaf\xb2\xc6\xd4\x1b\x3d\x37\x78\x3f\xd8\x23\xea\xb2\x13\xde\xf9\x6f\xea\x07\x44\x69\x3f\x3c\x0c\x4d\x27\x36\xe2\xae\x7e\xd2\x0c\x7f\x0b\xb1\xc7\x17\x6a\xb8\x41\xcf\x23\x05\xa1\xfc\x71\xd4\xbd\x1e\x24\x1b\xf0\x52\xeb\x78\x59\x31\x88\xf3\xa6\x65\xb6\xc1\x97\xd7\xbd\x40\xa4\xee\x73\x59\xdc\xf3\x70\x8b\xfb\x31\xbe\x2f\x1e\xc8\xa0\xe5\xf1\x79\x8f\x60\xa1\x5c\xaa\xd7\xe3\x0d\xe9\xf5\x04\xfa\x92\xff\x3e\x51\x67\xe7\xa3\x11\x2a\x67\x7d\xa7\xa2\x96\x45\x17\x69\x11\x76\x5c\x65\x6e\x42\xa8\x89\x40\x29\x27\xde\x6b\x4a\xee\x56\x1b\x06\x93\x75\x5d\xe9\x0d\x0d\xb5\x83\x18\xf0\x60\x0d\xb1\x13\x87\xb8\x82\x25\x13\xed\xab\x83\x45\xe3\x8a\x04\xcd\x64\xdf\x2c\xf8\xe6\xe5\x5f\xc3\x81\x53\x91\xd9\xce\x3f\x12\x32\xac\x8b\xc5\x94\xd5\x44\x58\xad\x20\xb0\xd5\x70\xff\x62\x31\xfc\x75\x7f\x5f\x3d\xa4\x2a\xd5\x43\xb5\xff\xeb\xfe\x68\x68\xbf\x98\x9b\x8d\xfd\x39\xda\x1f\xc5\xcc\x37\x98\x66\x00\x63\xca\x3d\x11\xbb\xc5\xfb\x71\xe8\x6a\x59\xc7\x59\xf0\x8d\x3c\xee\xe9\x04\x54\xda\xea\xc2\x10\x2a\x82\xd3\x34\x02\x79\x4b\x32\x50\x0f\xb1\xf6\x29\xfc\x33\x1a\xc5\xd6\x43\x81\x4c\xe2\x3f\xb5\x6d\x65\x5d\x13\x01\xc2\x3e\x76\x00\xee\x36\x1c\xee\x9f\xfd\xb2\xc5\xd0\x5f\x59\x6e\xaa\xf3\x29\xe8\x5b\x6c\xe6\xd1\x71\xb0\x30\x9b\x7c\x3b\xbf\x44\x05\xcb\x4f\x8e\x6f\xde\x53\x8c\xa4\x61\xa9\xe7\x4b\x73\xbb\x17\xa8\xc4\x28\x0d\x46\xfb\x98\x7f\x8d\xd7\x7a\x23\xe2\xbc\xaf\x05\x92\xda\x1a\xe5\xe9\xea\xe3\x68\x8c\xb3\x26\xf2\x5d\x88\x7c\x17\xc7\x1c\xa2\x7d\xaa\xce\xce\x83\x6e\xea\x34\xb5\x0c\x3b\x0a\x60\x50\xe7\x27\x24\x30\x43\x93\x8f\x37\x55\xb9\xc1\x63\xe6\x7e\xc8\x73\xf6\x1e\x4a\x4d\x65\xd9\x29\x89\xbd\x50\xb3\x6d\x4b\xe5\x3a\x2b\x3c\x95\x1d\xb5\xef\x05\x40\x9d\xed\x3b\xc5\x88\xff\xf1\xcf\xb7\x3f\x99\xd8\x96\x95\x56\x95\xbe\x06\xbe\x5d\x0d\x11\xab\x04\xad\x9b\x37\x95\x79\x67\xd9\xb1\x45\x59\xad\xeb\x51\xd0\xdb\x37\xfa\x7a\x47\x87\xb9\x8b\x2f\xf4\xe6\xcc\x26\x9e\x5b\x46\x9c\xed\x55\x38\xf1\xe7\xac\x6e\xee\xd5\xf9\x68\xa2\x9c\x56\x1c\x36\x92\xc9\xed\x1e\x82\x92\x76\x07\xa1\x26\x15\x74\xe4\xb6\xbc\xfd\x43\x57\xcb\x04\x77\x1c\x2a\x4d\xf7\xfc\xa4\x86\x36\x64\xd1\x97\xfe\xe9\xa5\x56\xa6\xae\xb9\xa0\xcf\x89\x6d\x11\x8e\x58\xe2\x1b\x9d\xfa\x3f\xef\xbb\x21\xfe\x0a\x7c\x79\xd5\x31\x4a\x37\x34\x57\xa7\xfd\x91\xad\x37\x65\xd5\x68\x0c\xd9\x77\xad\xab\x82\x06\x2a\x35\xe8\x81\xe6\x18\xd4\xe9\xa8\x5b\x26\x5d\x9b\x2d\x05\xaf\x3a\x4b\xee\x36\xba\x86\x50\xe1\x5c\xee\x6e\x25\x0f\x9c\xaf\xbb\xa3\x48\x43\x4b\x44\xc2\xbb\x4e\x8d\xb9\xe9\x2f\xbe\x90\x1f\xb1\x0f\x42\xd0\x21\xfd\x5b\x06\xdc\x45\xe8\x2e\x95\x9f\xeb\xe2\xd7\x41\xa3\x66\x06\x45\xee\x4d\xb9\x34\xcd\xca\x54\x63\xe1\xd6\x31\x78\x4d\xe5\x56\x34\xcd\x54\x86\xab\x48\xcd\x42\x6f\x73\x92\x62\x92\x87\x47\x20\x8b\x40\x9f\xaa\xb9\xde\x80\x9f\xa9\x98\x20\x3b\x0b\xbe\xf7\x94\xc1\x59\x47\x98\xdc\x34\x46\xb5\xd2\xf1\x6e\xa7\xe7\x61\xf0\x60\x40\xd7\xfa\x31\x46\x38\xad\x50\xba\x4a\xeb\x52\x73\xab\xa9\xd0\xfa\xfa\x1a\x4b\x11\x38\xae\xd5\x5c\xe9\x40\xf0\xb8\xad\xff\xfb\x8e\xb6\x6c\x09\xd6\x37\xee\x5e\xfa\xd6\x92\xf5\xf6\x82\xd2\xc3\x8e\x7c\x71\x47\x47\xfc\x3e\xe0\x25\x70\x86\x32\x6a\x9e\x67\xf3\xcb\x31\xa0\xaa\x23\x04\x03\xfc\x5e\x67\x69\x9a\x1b\x55\x67\xc5\x1c\x01\x98\x54\x5a\x16\x83\x86\xbc\xfa\xf3\x5b\x90\x7d\x87\x91\xd7\xa4\x56\x88\xa1\x89\x38\xde\x69\x02\x9a\x1e\xdd\xa8\xdc\xe8\x1a\xa1\x20\x8b\xf2\x9a\xb3\x01\x3c\x11\x57\x46\x38\x90\x8d\xae\x96\xa6\x51\xa6\xb8\x22\xc3\x75\x30\x82\x87\x6e\x4e\xa8\x73\xd0\x53\x30\x7e\x07\x78\x5b\x27\xb8\x86\xef\x03\xa9\xf8\xf0\xd3\x07\x15\x08\x8c\x33\x9a\x40\x32\x8d\x58\x9b\x62\x3b\x60\xf2\xb6\x35\xf5\x50\xf6\x38\x66\xdb\x7d\x3a\x76\xab\x5d\xf9\xba\xdc\xd6\x66\xbb\x19\x44\x64\x19\xc8\x5f\xed\x0a\x39\xf9\x9f\xaf\xaa\x80\xf8\x12\xbd\x9b\x00\x93\x49\x08\x07\x55\xa0\x68\x11\xbf\x9f\xe2\x17\xba\x96\x83\x6f\x27\xea\xfd\xc7\xb6\x6e\x43\x56\x61\x82\xc2\x26\x2e\xb6\xe7\x0d\x65\xf9\x92\x3d\x71\x44\x9a\xbf\xc8\x89\x3c\xde\x23\x29\x7c\x30\x38\x14\x39\xfa\x0b\x94\x47\xe9\xab\x1c\x77\xde\xbb\x41\xfb\x74\xf5\x40\xa7\xa1\x8f\xf8\x58\x1e\xdf\x7d\xd4\xc2\x28\x9e\x5c\x8f\xd7\x93\xf1\x53\xa0\x1e\xbb\x46\xc6\xdb\xa2\x5e\x65\x8b\x66\xe8\xbb\x38\x52\x53\x9f\x0c\xef\x9c\x48\x8b\x18\x17\xd7\x46\x30\xdd\xee\x75\x97\x2d\x9e\xf9\x5a\x12\x57\xff\xb9\x25\xba\xf8\x47\x22\xe6\xe9\xbc\x67\x25\x5d\xd5\x3e\xab\x9b\xbc\xdd\x6f\xe7\xd2\x34\x3f\x22\x14\x00\x92\x28\xad\xf7\x73\x69\x1a\x04\x2c\x15\x56\x74\xe9\x6d\xa1\xd7\xd9\x9c\x8c\x6c\xa1\x1f\x4b\xd3\x3c\x29\xd2\x37\x4e\xb1\x06\xb4\xce\x60\xca\xd7\xd4\x48\x7d\xf8\xb0\x23\xdf\xd5\xe1\x2c\x2b\x52\x9f\x9b\xb7\x50\xd0\xd0\x03\x8c\xf8\x1d\x4a\xf0\x03\xd9\x6f\x90\x7f\x14\xae\x89\x1b\x07\xec\xc6\xc0\x2a\x08\xe4\xb5\x90\xc6\x66\xc3\x9d\xbd\x74\x3d\x23\xa4\x68\x51\x22\xea\x9a\xeb\xdc\xff\x7a\xfb\xea\xe5\xd8\x09\xed\x65\x11\xe9\x76\x8f\xc4\x65\xe1\xc1\xde\xe0\x3e\x44\xd5\x17\x41\xab\xd8\xb5\x71\xa1\x0c\x61\x23\xab\xa1\x23\x0c\x47\xaa\x2e\xf1\xca\xcc\x9a\x3d\x8a\x74\x6d\xaf\x6e\x7b\x9d\x52\xd4\x4a\x74\x21\xa2\x1f\x68\x34\x62\x73\xfe\x78\xeb\xde\xee\x0c\x43\x12\xab\x97\xaf\xde\xb1\x0e\x32\x6b\x7c\x9b\x6b\xbd\xa1\x16\x5f\xe8\xcd\x28\x88\x9d\x6d\x93\xb2\x1a\x86\x60\x4c\x6a\x52\xc6\xf5\x9b\x97\xa9\x59\x9a\x62\x1c\x6c\xb6\x60\x5a\xbb\x88\x52\x48\xfc\xa9\x2a\xd7\x2f\xf4\x46\x6c\xb9\x2b\x9d\xf3\xa6\x18\x5e\xe9\x1c\xaf\xae\x90\x6e\x1e\xc5\xcb\xc0\xf1\xcb\x44\x66\x3b\x5d\xc7\x9e\x1d\x77\x36\xbd\x89\xb2\x55\x02\xee\x8c\xe3\xc7\xd5\xf7\x2a\x8f\xd8\x72\x90\x4a\x64\x75\x73\x96\x9d\x8f\xdd\xf3\x83\xfb\xdb\x4b\x4f\xa0\x96\x7a\x83\xcc\x68\xa2\x8e\xee\x8c\x2d\xcd\x64\x49\x30\xf6\xe8\x29\x68\x8d\x96\x8d\x78\xd8\xcb\x45\xe7\x92\x3b\x9d\xfc\xe9\x4f\x7b\xea\x4f\xea\x69\x55\xd6\xf5\xe1\x26\xd7\x8d\x65\x4b\x60\x45\xd4\xd2\x14\x86\x1d\x65\x03\xf4\xcb\xab\xc3\x75\x99\x9a\x7c\xcf\x56\x20\x96\xac\x70\xc0\x6b\x2f\x6c\xb2\x58\xb3\x36\x69\x1d\x58\xda\x2e\x62\x1a\xda\x1b\xb3\x15\xdb\xf5\x0c\x1e\x92\xca\x2c\xc6\xf8\xe3\xd8\x69\xd0\xb2\x35\x25\xd8\x3f\x9d\x83\x84\x65\xb3\xe1\xe4\x9c\x4a\xd1\xc8\xe0\xe0\xe0\x6a\x70\xec\xf7\x48\x94\xdc\x51\x88\x77\x91\xad\x7c\x24\x1f\x32\x59\x90\x96\x68\x7f\x48\x48\x3f\x96\x61\xef\xea\xc0\x43\xb5\x8f\x14\x08\x9e\xf1\xc1\xbe\x23\x99\xf7\x1f\xef\x2a\x44\xaf\xa5\xc8\x3e\xdd\x95\x7d\xb4\x7f\x2c\xb6\x09\xce\x57\x6f\xdf\xd5\xfe\x45\x01\xa2\x8d\x38\x25\xa8\x08\xa4\x27\x75\x9d\x2d\x0b\x02\x85\x58\x9a\xe2\x89\xfb\xfd\x14\x34\x9f\xc8\xa0\x45\xd5\xa0\x2a\xd4\xe4\x63\xd8\x2c\x31\x2d\x30\xdc\xf7\x4d\x93\x68\x27\x89\xa4\x7f\x36\x13\xca\x62\x5c\xae\x5f\xf7\x39\x1b\x07\x01\xb4\x99\xbc\x60\x62\xd7\xd4\xa8\xf7\x36\x55\x0c\xe5\xa1\xda\xff\xb8\x4f\x84\xc8\xc7\x5d\x27\x61\x69\x0a\xb5\x32\xf9\xc6\x54\x70\x10\xf8\x5c\x14\x4b\x3e\x09\xd4\x41\x51\xb7\x2d\x36\x6e\x1d\x90\x70\xde\xe0\x80\x78\x86\xda\xa5\xb5\xac\xd0\xe1\xe5\x82\x33\x35\xf4\x96\x01\x78\x0f\xd4\x0e\x0d\xbc\xe3\xc5\x1b\xba\x79\x3b\x09\x87\xde\x92\xa2\x39\xf1\xd7\x41\x6d\x1a\x98\x45\xa8\x1b\x14\xa4\x0f\xd5\x7e\xa2\xdc\xa7\x4b\x73\x2b\x3e\x85\xb3\x49\xe2\x39\x31\x99\xaf\x6d\xcf\x95\x76\xf3\x24\xa4\x95\x59\x01\xf0\x1a\x76\xb5\xd4\x46\x37\x2b\xe0\x30\xb4\x5a\x64\x85\xce\xc1\x9a\xa4\x36\x4b\x08\x28\x62\xeb\x41\x52\xa5\x56\xb3\xb2\x59\xa9\xb4\x6c\x0e\x5d\x89\x4d\x59\xd7\xd9\xcc\xf2\x23\xa8\xa5\x9a\x55\x7a\x7e\x69\x1a\x4b\xfb\x63\x0f\x38\x7d\xae\x6b\x53\x4f\xe9\xeb\xa1\x6a\x4c\xdd\xf8\xbf\xce\x30\x44\x8e\xfb\x69\xff\xef\x08\x3e\xca\xaf\xfb\x7a\xff\x5c\xe4\xbc\xb9\xb9\x01\x99\xf8\x99\x3e\xd3\xe7\xe3\xce\x22\x63\x9b\x47\x9f\xed\xeb\xda\x16\x0d\xb3\xec\x39\x35\x76\x6e\x8a\xe3\x3d\xa4\x2d\x2a\xfc\x63\xbe\xa2\x3f\x40\xac\x7a\x70\x84\x3f\xfc\xec\xbd\x2e\xeb\xf8\xd3\x69\x91\xc2\xd7\xbd\x58\xdd\x4d\x97\xf1\x15\x23\x1c\x4f\x26\xea\xa7\xec\x46\xad\x9a\x66\x53\x4f\x27\x93\x65\xd6\xac\xb6\xb3\xf1\xbc\x5c\x4f\xae\xb6\xe6\xb7\xda\xfe\xff\x64\xb3\xcd\xf3\xc9\xb7\xdf\x7e\xf5\x88\xc0\x75\x01\xbc\x92\x16\xf1\x64\xbf\x9c\xfd\x36\xb6\x4f\xeb\xbe\xbd\x1c\x09\xf3\xc1\x2b\x30\x46\xb8\xab\x51\x38\x25\xe4\xf5\x39\xc2\xb9\xea\x9c\x1f\x4e\x16\x7f\xdb\x4f\x4e\x7c\x7c\x36\x18\xa9\xef\x11\x3e\x09\xb2\xea\xba\x79\xce\x69\xe7\x90\x66\xeb\x01\xa5\x25\x51\xe5\x38\x43\x5c\xb5\xcc\x3f\x1e\x04\x1a\x6b\xcc\x27\x6d\x33\xdd\xc6\x7f\x2f\x35\x8b\xc0\xa8\x08\x31\x32\x16\x14\x81\x37\x2e\xcd\xed\x54\x0d\xf6\x07\x78\x33\xb1\x34\x99\xea\x07\xbd\xeb\x43\x9b\xbc\x4b\xb7\xd8\xdb\x6e\xd4\x8a\x3d\xd5\xdd\x9a\x94\xba\xa9\x70\xcc\x70\x19\xb8\x49\x08\xf6\x48\xf0\x1b\x37\x08\x1a\x0f\xec\x39\x6f\xfe\x07\xa6\x5c\x78\x3c\xd1\xf9\x0a\x5d\x02\x6f\x9c\xd7\xd4\x9d\xa6\x63\x59\x8d\xfa\x48\x08\x00\x33\x9c\xaf\x64\x90\x0a\xd8\x7f\x14\xd2\xdf\xa6\xb4\x38\x64\x68\x0f\x55\xe1\x3f\x46\xc5\x7e\xc4\x83\x2c\xcb\x45\x5a\x0b\xa7\x2d\x8a\x16\x2c\x98\x02\x5a\x36\x98\x4c\x9f\x2b\x9c\xa5\x87\xea\x28\x69\x4d\xd4\xa8\x65\x53\x68\xa7\x85\x95\x44\xd4\x85\xba\xa9\xa4\x36\xfd\xe1\x43\xde\x2c\x41\x41\x4b\x18\x84\xe5\xdc\x66\x3c\xb1\xdb\x39\xc8\x1c\xcc\x27\xcc\x50\x50\xd2\xcf\xd8\x97\x5f\x22\xb2\xa1\xfb\xfd\x6d\xa8\x23\x10\x93\x28\xaa\xc1\x4b\x85\xbf\x9f\xa0\x26\x2a\xde\x34\xee\xd6\xb9\xf7\x36\xf9\xbc\x9d\x00\x0a\x90\x26\x2b\x02\x70\xe4\x8e\x7d\xe1\x7b\xfc\xf0\xe1\x71\x77\xb6\x67\x41\xb6\xc3\x43\x99\x4d\x8c\x57\x2a\x06\x55\xd7\xe1\x10\x43\x0f\xe9\xf0\xb6\x79\xb8\x18\x53\x34\xc1\x48\xe4\xfd\xc7\xb6\x6c\x10\x49\xb3\xfa\xc4\x99\xe4\x71\x89\x7a\x7c\xa7\x3b\xba\x24\x6d\xa2\xae\x75\x55\xd8\xfe\xef\x01\x74\x84\xaa\xcb\x35\xbd\x7d\x89\x10\xfc\x81\x00\x77\xa5\xc1\xa9\x63\x66\x20\x1a\x51\xb5\xce\x0a\x93\x2a\xdd\xa8\x6a\x5b\x34\xd9\xda\xd8\x0a\x10\x31\x1c\x72\x43\x45\x95\x21\x87\x91\xa6\xbc\x34\x45\xed\x19\x38\xd0\x58\x8d\xa1\x03\x6f\x9e\xbc\xfc\xf7\xd3\x8b\x77\xaf\xfe\x76\xfa\xd2\xd2\xdd\x17\x17\xd5\x00\xdf\xaa\xa7\x7f\x3d\x7d\xfa\xb7\x1f\x5f\xfd\xd7\xc5\x9b\x27\xcf\x9e\xbf\x92\x39\xe6\x03\x69\xa4\xb5\x8e\xb8\x87\x34\xab\xec\x3f\x17\x42\xfa\x8e\x83\x54\x27\xf8\x31\x20\xeb\xc9\xc1\xca\x29\x46\x6c\x82\x14\x1a\x05\xae\x5e\x8e\x97\x00\x8c\x72\x34\x73\x75\xdf\x00\x5f\x4b\xf2\x53\x63\xfb\x09\x2e\x4f\xa7\xd2\x04\x5b\xd1\x5a\x5d\x67\xcd\x0a\x0a\x9c\xec\x2f\xb2\xdc\xec\x03\xa8\x7a\x65\x34\x45\x97\xb5\x84\x8a\x73\x22\x59\x19\x2c\x35\x70\xa1\x61\xb1\xdf\xd7\x59\x9e\xab\x66\x55\x95\xd7\x80\x3d\x04\xe1\x9e\xdc\x8e\x80\x0e\x5a\xfe\x01\x8b\xaa\x2f\xbe\xa0\xfe\x81\xf5\x77\x96\x9b\x81\xdf\x20\x38\x39\xde\xac\x63\xff\x7b\xa0\xde\x70\x74\xe8\xc7\xc5\x4f\x79\x8b\xbc\xc6\x41\xfc\x0a\xa3\xf8\x75\xff\x87\xe9\xaf\xc5\xbe\x00\xd1\xd9\xff\xc9\x6e\x61\x1a\x73\x30\xc4\xb1\xfa\x3b\x11\x7a\x65\x31\x9d\xaf\x74\xb1\xf4\x61\xb8\x55\x56\xd4\x8d\xd1\xe9\x98\xcd\x61\xba\x1c\x72\x4d\xee\x5d\xb9\x78\x28\x2d\x96\x12\xa4\x2b\xc4\x6d\x78\xaf\xc7\x63\x9e\xc7\x16\x87\xea\x04\x1c\x00\xf8\x6f\x6e\x9a\x4a\xbb\xdd\xad\xda\xa8\xa3\xe2\x61\x72\xf3\x5d\x83\xf3\xc4\x40\x74\x09\xdd\x29\xfa\xbb\xd2\x55\x4b\xd7\xaa\x81\xaa\x63\x56\xde\xc8\xba\x9f\xd2\xb7\x3b\x46\x7b\xdf\x26\xc0\x19\x49\xd6\xff\xc6\x7e\xf8\xfc\xca\x3f\x7c\x50\xee\x13\xdb\xaa\xcb\xea\x9f\xa1\x88\xe8\xfe\x0d\x3c\x98\x97\xc5\x22\x5b\x8e\xb3\xfa\x0d\xdd\x2c\xef\xf4\x32\x80\x5a\xff\x7f\x69\x0f\x78\x95\x98\x3f\x49\x9f\x72\x8e\x7e\x00\x36\x9d\x0b\x72\x57\x08\x01\xae\xde\x6e\x36\x65\xd5\x70\x80\x8a\xac\x76\xf8\x57\x76\xe9\xc6\xa2\xe4\xe0\xf9\x42\xdd\x96\x5b\x38\x6b\xd7\x65\x05\xd0\x37\x70\xd7\x10\x2c\x9c\x49\xb3\x46\xcf\x72\x93\xa8\xac\xf9\x75\x50\xab\xca\xcc\x01\xb4\x33\xc5\x88\x54\x42\x31\x77\x5d\xe9\x8d\xd2\x2a\xcf\x66\x95\xae\x6e\x55\x6a\x52\x88\x46\x87\x61\x30\x40\x24\xb8\xd9\x56\x9b\xb2\x36\x0c\xfd\xa6\xd5\x7c\x5b\x37\xe5\x5a\x60\xf1\x77\xe8\xeb\x38\x2e\x36\x4e\xa8\xf7\xef\x54\x6b\xd3\xe8\x54\x37\x7a\x2f\xc4\x67\x0d\x25\xca\xe1\x7e\xbf\xaf\xc0\xc8\xc9\x84\xfc\xb5\x1e\xa8\x36\x43\x31\x11\x54\x45\x92\x6b\x14\xd8\x0a\x31\x36\x09\x95\xc1\xf5\x0d\xcc\xc9\x07\x96\xe0\x1e\x78\x11\xd3\x16\x45\x09\xbb\xcb\xdb\x6c\x87\xb2\x12\x70\xbd\xe1\x4a\x60\x67\xdd\xa3\x16\xc8\x17\x54\x83\xbe\x3c\xb6\x1e\xb2\xa4\xc0\x8c\x70\x71\x98\x74\x80\x94\xed\x7e\xa8\xb5\x88\x84\x2a\xb4\x01\xf6\x1f\x5f\x64\x61\x52\xe2\x7e\x71\xaf\x6c\xfe\x1f\x0e\x8f\x60\x8f\xd3\xae\x69\x8f\x1f\x4e\x7e\xe0\x0f\xf5\x58\x0d\xf7\xa7\x2d\x59\x8e\x4b\x9e\xda\xe4\x8b\xdf\x3b\x1a\x6f\x55\x2e\x4a\x82\x01\x3e\x0d\x9c\xd4\x14\x3c\x76\xfb\xb4\xf0\xd0\xed\xf4\x1e\x1c\xe8\x93\x56\xe5\xae\xf9\xc1\xc1\x81\xc9\x4f\x0e\x50\xdf\x8c\x8a\xc3\x44\xa2\x10\xee\x1f\x1c\xcc\x4f\x6c\x9e\x31\xcd\xea\xe3\x61\x6f\xdf\x70\x94\xed\xe5\xb4\x69\xc7\xdc\xe8\x20\x5b\x44\x7a\xa4\x83\x03\x3d\x1a\xbd\x1f\x88\xdb\x00\x7a\x7d\x05\xbd\x26\x19\x9d\x7a\xac\x06\x17\xc5\x70\xd0\x5e\x93\xc1\x68\xa0\xa6\xc1\xc7\x51\x6b\x90\x30\xcc\xec\xe4\x22\xb3\x6d\x25\x07\x07\x57\xa3\x63\xd1\x5c\xb6\x18\xca\x01\x8e\xde\x1f\x1c\x64\xdf\x3f\xfa\xe2\x0b\x14\xf3\xf4\x4a\xf3\x06\x07\x07\x7a\x4c\x2e\x05\x67\x07\x07\x57\xe7\xa3\xc1\x08\x9a\x1e\x7d\x14\x37\x9b\xbd\x2c\x6d\x85\x3f\x1c\x1e\xdd\xaf\x46\xe6\xd2\x0e\x0e\xb2\x11\x57\xef\xbf\x1f\x1c\x64\x0f\x8f\x46\xed\x96\xf6\x3f\x42\x43\x77\xd6\x3f\xa7\x92\x1f\xf7\x71\x87\x20\xf8\x3c\x21\x43\x8f\x62\x85\x96\x78\x0e\xff\xbf\x76\xf7\x04\xe5\xdc\x1e\x19\x06\x12\xdb\xf0\xdc\x44\xbb\xa4\xff\xd6\xb0\xb5\x74\x9d\xc7\x8e\x3a\x77\x1e\xc1\x3b\x04\xc1\xbc\x5b\x13\xb1\x08\xed\x15\x20\xdf\xd4\xff\x2d\xb3\x8f\xa4\x9b\x49\x7f\x01\x61\x14\xdd\x93\x9b\xaa\x6c\x4a\x78\x66\xd1\xaa\xcd\xed\xb0\xf1\x5c\xe7\xf9\x30\xb8\x29\x18\x2b\x20\xe1\x1e\x0f\xcb\xd1\x7b\x46\x8e\x1d\x73\xf5\x1f\xfd\x2d\x1b\x98\xd3\xd9\xcc\xb4\x09\xd4\x89\xfa\x75\xff\x02\x86\xf6\xeb\x3e\x38\x51\xab\xc7\xaa\x1c\xe3\x17\x35\x55\x25\xb1\x2b\xae\x22\x6a\xa4\x7d\x43\x5c\xe9\x1c\x2e\x04\xbb\x6f\x06\xb8\xd5\x47\xfb\x4e\xf5\x11\xc8\xe9\x07\xe1\x58\xd6\xdb\xbc\xc9\x36\xb9\x51\x8f\xd5\xc1\x81\x9c\x9a\x69\xf8\xfb\xec\xd1\xb9\x7b\xba\xe6\x08\x6c\x4a\x37\x56\x34\x9f\xea\x61\x30\xc3\x0f\xd5\xfe\x31\x28\x0d\xa8\x10\xfc\xc3\x98\x11\xfd\x87\x56\x08\xa5\x77\xef\xb6\x39\x46\x99\xdf\xb1\x97\x24\xf5\x79\xdf\x1d\xb5\x8b\xc9\x63\xd3\xad\x6c\xa1\x48\xed\x8c\xeb\x65\x69\xd6\x3c\x9b\x33\xdb\xe7\xb4\x61\x08\x3b\x35\x37\x9b\xc6\x83\x02\xb8\x3c\x50\xde\x56\xed\xb8\x47\x77\x41\xa0\xdc\x4e\xa8\xed\x06\xb2\xb9\xc1\x39\x58\x95\xc9\x64\x4e\xf0\xe6\xad\xb6\x62\x7f\x5f\x74\xd5\x65\x73\x74\x55\x85\xdf\xbd\xe8\x81\x7b\xf4\xc5\x17\xea\x81\xa8\x35\x72\xdb\xdc\xd9\x14\x77\xfb\xb1\x0a\xbf\xd8\x6d\x4b\x7f\x1e\xf7\xb0\xaa\x33\x7f\xfd\x48\x9a\x1a\xc4\xaa\x96\x3b\xed\x9e\x7a\x8e\xf3\x06\x91\xc9\x99\x94\x0e\xa8\x00\xa9\x85\xce\x75\xd3\x98\xca\x05\x8b\x33\x37\x1b\x5d\xa4\x35\x82\x7d\xe3\x02\x73\x2f\xb2\xa2\x31\x55\xa1\xf3\xfc\x76\xd0\xc3\xb9\xde\xa9\xbf\xcc\xf5\x3f\x6e\x49\x49\x69\xff\xfc\x1c\xb5\x26\x5b\xd5\x98\x14\x18\x21\x74\xe3\xfb\xf7\xad\xae\x52\x75\xa2\x1e\x40\x03\xcc\xf4\x3d\x40\xa6\xcf\x1e\x98\x63\x69\x46\xa4\x4e\xa0\x23\x7b\x48\xaf\xf1\x99\xda\x43\xfa\x4c\xb2\x8b\xee\xb3\xcd\x27\x04\x39\x7b\x4c\xcc\x11\x27\xe8\x6e\x9c\xb6\x52\x31\xba\x76\xfc\x7a\xdf\xa9\x4b\x55\xfb\x1d\x45\x59\x01\xfa\x2f\xd6\x6b\x86\x17\xdc\x27\xa8\x34\xa9\x07\x1d\xab\xe1\x44\x7a\x74\x69\x5a\xba\x2b\x18\xcf\x1c\x0b\x14\xcb\x11\x5e\xef\xf6\xba\x87\xdc\xae\x4b\xc1\x83\xcd\x80\x1c\x1d\x9a\xd2\xae\x9b\x12\x9a\xea\xbc\x26\xfd\xd4\xdb\xbd\x19\x4e\x5e\x7c\xdd\xce\xf2\x6d\x35\xb0\xa4\x14\x80\x95\x20\xcc\xd8\x70\x34\xf0\xbe\xc8\xce\x6e\x40\x1a\x0a\x3a\xf5\xde\x15\x46\x37\x03\xd9\xa0\x0b\xe7\x08\x22\xb0\x3e\x29\x23\x58\x97\x64\xcd\xa0\x16\xc6\x4e\x4d\x89\x7e\xd3\xa1\xa5\xa2\xf4\x84\x47\x5b\x17\xb0\x6e\xa1\xa3\xbd\x47\x16\x82\xd7\xab\x32\x37\x6a\x53\x66\x88\x26\x0d\xfc\x29\x8b\xdd\xb8\x9b\xac\x3b\xb6\x04\x59\x0d\xbf\x20\xbc\xe3\xa2\xac\xa0\x9a\x6d\x6d\xaa\x43\xdd\x34\x1a\xbc\xb5\x9d\x29\x57\x07\x94\x18\x99\xce\x0d\x4b\x42\x6c\xba\xc3\xbe\x8c\x30\x89\x8a\x33\x71\xb2\xce\x25\xc8\xc7\xf3\xd3\x10\x4a\xa6\x58\x9a\x73\x9c\x3e\x12\x19\xd4\xea\x7f\xf0\xf0\xfe\x0f\xce\x8b\x7b\x00\xf8\x98\x83\x07\xbf\x3f\xe2\xf2\xc4\xda\xac\x65\x71\x06\x39\xcf\xc1\x0e\x9b\xe9\xef\xa8\x47\x89\xc8\xf6\xe1\x83\x3a\x63\xaf\x11\x32\x30\x89\x72\x1f\x7b\xd8\x1c\x80\xa8\xbf\xd6\xb5\x2a\xab\x6c\x99\x15\x14\xee\xb4\x71\xe2\x88\x45\x76\xa3\xfe\xf8\xe7\xaf\xbf\x3c\x02\x93\x4f\x0f\x1f\x57\x98\xb9\xa9\x6b\x5d\x71\x28\x4f\x08\xa2\xfa\xe5\xf8\xeb\xb1\xfa\x9b\x31\x1b\xb8\x89\xf1\x49\xb5\x8b\x76\xad\xab\x14\x03\x68\xe8\x06\x5f\x01\xd2\xc7\x03\x58\x7e\x6a\xd0\x12\xe9\x7b\xf5\xe5\xf8\xcf\x9f\xb0\x22\x5d\xb2\x69\xbf\x34\x65\x31\x26\x99\x66\x34\x6d\x9d\xc5\x12\x91\xbf\x7b\xfe\x3a\x8b\x05\xfe\xd1\x78\x65\x80\x1c\xdf\xe3\x8e\x81\x9f\xf4\xab\x62\x6e\x9c\x11\xfb\x8a\x0d\x02\xf9\xf4\x4b\xbb\x68\x5b\xcf\x05\x19\xcb\x9e\xf8\x1a\xc1\xe5\x5b\x5f\x19\x35\xdf\x56\x80\x3f\xce\xf6\xb4\x8c\xc1\x5e\xa8\x79\x5e\xd6\x18\xc4\x34\x8e\xf2\x52\xca\xd6\xa5\xf9\x14\x5a\x2a\x50\x7f\xc6\x7a\xb3\xc9\x6f\x87\x78\x0b\xe9\x6a\xb9\x85\xa0\x02\x42\xc1\x61\x73\x3f\x38\x69\xdb\xc2\xad\xcb\x2b\x73\xf0\xe5\x90\x46\x23\x1a\x73\x43\x4b\x78\x48\x31\xee\x98\x34\xf2\x27\x60\x3f\xac\x66\x8f\x31\xcf\x40\x8b\x60\xeb\x3c\x40\x00\x77\xae\x71\x4f\x39\x2b\x68\xec\xcb\xca\x99\xaf\xda\xfd\xf5\x42\xcf\xab\xf2\x9d\xae\x2f\x79\xb6\xdd\x95\x4a\x75\x8d\xd4\x7b\x51\xa4\xb5\x4a\xbd\x8b\x84\x2a\x2a\x5e\x97\x36\x10\x05\xaa\x37\x79\x0c\xc1\x28\x94\xbb\x10\x5e\x3b\xfb\x6d\x7c\xb4\xdf\x73\xf5\x53\x3f\x63\x34\xba\xa9\x73\x3c\xf8\xe8\xde\x73\xca\xd3\xe6\x8e\x79\x2d\x7a\x27\x52\xcc\x1e\xad\x08\xbb\x80\xf0\x9e\x03\x69\x31\x0e\x6e\xd4\x89\xcf\xd1\x33\xc0\xf1\x85\x9d\x76\x3b\xe3\xb6\x8a\x60\xd4\xbd\xdd\x25\xc0\xe3\x57\x2f\xb8\xf2\x5d\x90\xc7\xdd\x58\x6e\xf6\x12\xef\x06\x72\x2b\x8b\x7e\x14\xb7\xb2\x08\x41\xce\xca\x22\x24\x00\xcb\x3c\x7d\x55\xb4\x60\xdb\x64\x2e\x9e\xa3\x18\x6e\x23\x7a\x65\x86\x44\x7e\xe0\x48\xdd\x30\x87\x65\x91\x60\x23\x09\xee\xfc\xc4\x2d\x5d\x1b\x7c\x38\x68\xcc\xf9\x5d\x1e\x3b\x48\x4d\x67\xd2\xdd\xc6\xb7\x16\x33\xdb\x81\x70\x2d\x52\x77\x40\x8e\x3c\x7b\xf5\x02\x02\x81\x7e\xf2\xd2\x44\x11\x44\x5b\x0b\xe4\xd2\xef\x04\xdb\x3b\xbe\x13\x67\xef\x35\x79\xa1\x75\xf7\x20\x5c\x5c\xf6\x58\xeb\xe8\xca\xff\x36\xb4\x3d\x68\x33\x46\xdb\xdb\xd5\x91\x00\x70\x0f\x32\x76\x03\xee\xf1\xd8\xbb\x80\xdd\xa0\x58\x0c\xec\x66\x72\xf4\x11\xb4\x44\x7f\xe0\xc1\x10\xd6\xbb\x91\x95\x22\x90\x9f\xaf\xce\xe9\x6d\xe8\x71\x76\x91\x1d\x21\xac\x92\xc1\x78\x18\xa0\xa8\xf6\x71\xe6\x55\x29\x22\x99\x26\x5c\x01\xce\x65\x1d\xea\x51\xaf\xf5\xad\x47\x82\x76\x41\xae\xd1\x99\x05\x18\x42\x38\x2b\x3a\x47\x75\xab\x53\xc8\x96\x85\xaa\xb7\xb3\xda\xfc\xbe\x85\xf8\x1c\x80\xdb\x53\xab\xe1\x1f\xbf\xfa\xea\x1b\x0a\x15\x62\x27\xc7\x41\x1e\x89\xbe\x81\x8e\xcc\x25\xb8\x5e\x0e\x42\xcb\xe0\x30\xa6\xb5\x7d\x3f\xc2\x2f\x1c\xfc\x1f\x5c\x80\x03\x27\xf4\x2d\x1a\x06\xf0\x5a\x31\x2c\xaf\xb3\xa3\x70\xb9\x11\x7e\xf0\xd1\x11\xa8\x8d\x94\xae\xca\x6d\x91\xaa\xa7\xab\xaa\x5c\x1b\x75\x65\x2a\xe0\x87\xbe\x3f\x51\x5f\x7f\xad\x66\xdb\xa5\xba\x5e\x99\x0a\x5c\x7a\x96\xb9\x81\xa9\x7e\xe9\xe3\x03\xb8\x60\x35\x1c\x6e\x88\xc6\x34\x91\x4b\x52\x99\x46\x67\x45\x0d\x51\x99\x7d\xe8\x19\x58\x68\x53\x35\xb7\x62\x08\x61\x88\x48\x37\xd2\x93\x13\x75\xd4\x8d\xd9\x07\x61\x59\xa3\x62\x67\x8f\xda\x71\xe7\xdb\xeb\xc2\xb2\xd5\xf7\x7e\x24\x75\x63\x77\x19\x9b\x6b\xaa\x0b\xf7\xd7\xb5\xc9\x73\xf4\x69\xf2\x99\x8b\xb2\x38\x44\x13\x0c\x2c\x51\xe3\xde\x9a\x19\x07\xaf\x93\xb9\x00\x9d\xb6\x7f\x17\x6c\x80\x40\x17\x0d\xef\x4c\x8a\x5e\xc1\x16\x00\xf3\x6d\x55\x97\x95\x62\xe6\x11\x91\xc1\xb0\x68\x56\x3b\x41\x86\x10\xb7\xd4\x4d\xf5\x14\xce\x0d\x1f\x47\xc0\xb4\x7c\xac\x06\x96\xbe\x67\xa3\x9a\x6d\x15\xa0\x90\x63\xcc\x6c\x64\xe0\x10\xdc\x0c\x60\x1e\xb1\xaa\x16\x3a\x22\x77\x1c\x93\x77\xa2\x62\x88\x53\xef\x86\x29\xbd\x13\xd0\x83\x8f\xed\x67\xeb\xc9\xb5\x99\x4d\xb6\x4d\x96\x4f\x10\x58\xf2\xb7\x5a\xe9\xf9\xdc\x6c\x1a\xe8\x94\x34\x56\x6c\xf5\x98\xe2\xce\x43\x85\xbf\xb0\xf5\x22\x5b\xaa\x42\x54\x6c\xc7\x51\x7b\x94\x28\xfb\x39\xc0\x2a\x7b\xf5\xfa\xdd\xf3\x57\x2f\x07\xec\x56\x92\xd5\x2f\xcb\xe6\x79\xf1\x53\x39\xdf\xd6\x4f\x20\xea\x42\x73\x1b\xb7\xe3\xb2\x42\xea\x7f\x5a\x1a\x90\xa5\x3b\x51\x56\x4b\x88\x8c\x22\xf3\xad\x76\xfd\x9d\x03\x81\x63\xe5\x14\xa3\xb8\x09\xec\x89\x9a\x95\x37\x6a\x48\x32\x21\xb8\xa8\xc6\x28\x34\xc9\xcb\xda\xd4\x6a\x61\xeb\xc5\x80\x72\x4d\xed\x36\x0d\x3b\xee\x35\xca\xfc\xbe\xd5\xb9\x7d\x3c\xe0\x5d\x41\x94\x70\x87\x11\x09\x72\x24\xd7\x3b\x01\xd9\x00\x97\xc5\xd1\xd7\xdf\x92\xd0\x53\xdc\x18\xcf\x4f\xf9\x7e\x28\x60\xdd\x30\xe2\x57\x5a\xce\x81\xa4\x1f\x6b\x50\xf6\x9e\x7a\xce\x41\xdb\x9b\xbb\xc2\xed\x0b\xe8\xc5\x61\x83\x3d\x05\x29\xd0\xf7\x71\x08\x58\x2c\x9c\x16\x44\x25\x00\xd9\xb8\x16\xe0\xa6\x3c\xad\xd1\x32\xb4\xd7\x8e\x96\xa1\x30\xd7\x6e\x11\xa4\xd5\x90\xab\xb5\xcb\x6a\x08\x8f\xb7\x47\xc9\x46\xdb\x9f\xdf\x40\xfa\x6d\x6f\x45\x16\x2d\x78\xcb\x02\xff\x62\x7b\x2b\x85\x6e\xc7\xc3\x5c\xff\xe3\x36\xb8\xa4\xa4\xe4\x18\xe4\x7b\x14\x00\x9f\x05\x29\xbc\xae\xb0\x2a\x18\x4c\x0b\x37\x86\xe3\xa3\xa2\x60\xba\x1f\x3b\x5a\x0d\xe5\x40\x5e\x4b\x5f\xbe\x84\x04\xc6\x99\xb4\x33\xec\xbe\xd1\xd4\xf5\xd6\x29\xc5\x7b\xd2\xe1\xc4\x89\xf1\x90\xe9\x83\x5a\xbc\x1f\xa0\x27\x1b\x64\x09\x91\x95\xe9\x53\x41\xd2\x74\x52\xa8\x90\xd8\x49\x9d\x42\x4a\x6c\x29\x47\xf6\x7b\xb7\xb9\x79\xf7\xff\x90\xf7\xf7\xef\x6d\xdb\x48\xdf\x28\xfe\xbb\xff\x0a\x58\xf5\x65\x52\x31\x25\xc5\xe9\xee\xde\xad\x5c\xc7\x4f\x9a\xa6\xdd\x3c\xdb\xbc\x7c\x13\xb7\xf7\xf7\xb9\x6d\xc7\x0b\x8b\x90\xc4\x35\x45\x6a\x49\xca\xb6\x36\xce\xff\x7e\x2e\xcc\x0c\x80\x01\x48\xc9\xce\xbe\x9c\x73\x9d\xeb\xf4\x87\xc6\x02\x81\xc1\xfb\x60\x30\x98\xf9\x8c\xba\xd3\x57\xe4\x09\x68\x7c\x18\xce\xc1\xa4\xae\x4f\x6d\x2c\x5a\x77\xcb\x65\xea\xdd\xac\x6e\x7e\x52\x79\xb6\xc8\x1a\xb8\xfc\x8d\x8e\xe2\x93\xdd\xb3\x4f\xf1\xc5\x93\xf3\x7e\x7f\x34\xe3\x72\xa2\x3e\x06\xbd\xac\xe3\x78\x78\xd0\x1f\xc1\x8b\x09\x56\x03\xae\x40\x4d\xec\xd1\xec\x0f\xa7\x65\xf5\x4a\x4e\xe6\xac\x55\x59\xa3\xfc\x68\xa9\xec\x37\xe9\x90\x17\x4b\x7d\x5a\x34\x6a\x41\x34\x5b\x0d\xb0\x67\x45\xb3\x30\x60\x60\xe2\x39\x46\x92\xd4\x97\xf3\xb3\x66\xb1\x3c\x7b\x7a\x41\x73\xa4\x79\xbd\x4e\x38\xbc\xf0\xb1\x4d\x60\xda\xe0\x6f\x8e\xd2\xde\x47\xdb\x43\x88\xa0\x40\xde\x72\xc0\xb0\xc8\xe5\x4e\xd4\x7a\xc4\x11\x48\x90\xeb\xed\x41\x02\xea\xd0\xb1\xc1\x04\x01\xb4\x60\xec\x42\x33\xe3\xa9\xb8\x06\x10\x25\x3f\x27\xbd\x57\x18\x8c\xc1\x75\x8e\x1a\x50\x0c\x2c\xd5\xd8\xea\xb3\x5a\x2c\x2b\x35\x70\x4e\x6f\xe8\x8e\x50\x90\x6c\xee\x1b\x36\x82\x0f\x14\xe9\xa6\x34\xe7\xad\x85\xcc\x6f\xe5\xba\x16\x52\x4c\x2b\x55\xcf\xa9\x50\x22\xea\x12\x55\x99\xb5\x9c\x2a\x90\xe0\x61\x0c\x80\x34\x48\xf1\x06\x9d\xde\xc1\x13\x42\x9b\x49\x2f\x4f\x62\x7a\xf8\x35\xc1\x26\x1b\x8c\x0d\xf8\x61\x3d\x01\x8d\x06\xd6\xba\x41\xa0\x52\x74\x24\x7c\xb9\x05\x9a\x80\x3e\xb5\x9b\x86\xd8\x3c\x1f\xc5\xf4\xfa\x01\x89\xee\x62\xe6\xdb\x34\x78\x79\x02\xaf\x93\xa6\xc4\x8a\xfc\x4c\x4c\x7d\x4f\xae\x52\xfc\xb3\xe7\x1b\xd5\xe5\xb7\x69\xb7\x69\x27\x55\xca\xc9\x3f\x31\x27\x14\x0a\xb3\xe7\x2c\xc3\x70\x09\x10\x53\xbd\x52\xa4\x70\x04\xe9\x32\xaa\x75\x09\xe3\x25\x19\x96\xd4\x53\x0b\x65\x27\xc8\x8f\x6f\x54\x55\x65\x29\x5e\xd1\x7c\x57\x9f\x06\xbb\x65\x02\x87\xc0\x19\x05\x82\xec\x66\x5e\x52\x9b\x85\x6e\x1d\x23\xc2\x52\xf4\x66\xc1\x82\xf4\x03\x79\xdc\x8c\x64\x5e\xec\xc2\xf6\xdb\x56\xbf\x2e\xea\x46\x32\xf8\x82\x4d\x71\xfe\x5b\x05\x86\x97\x8c\xbe\x07\x14\xcb\x49\xec\xef\x33\x1a\xb0\xad\x19\xd8\x66\x6c\x7b\xd5\xda\xa9\x3a\x31\xf6\x4b\xba\x88\xd5\x9e\x67\x04\x6c\x8b\x4a\xd5\x89\x1b\xa3\x6d\xb1\x71\x1e\xaa\xd3\x5d\x92\xfb\x0e\xeb\x77\x63\x25\xf6\xad\x88\x5d\x6b\xd8\xc0\xd3\xb0\xc7\xde\x57\xf7\x23\x0c\xcf\x88\xd1\x08\xed\x57\x1a\xae\x07\x87\x29\x28\xd2\xe7\xb7\xf0\x2d\xc3\x13\x9c\xab\x14\x44\x83\x9f\x81\x93\xba\xfe\xdd\xc0\x66\x7e\x1a\x0c\x46\xe4\x75\x64\x9e\x62\xf0\xc3\x79\xfd\x64\xd7\x26\xed\x51\x9e\x5a\x35\x00\xee\xe3\x41\xf7\x72\xd8\x9a\x47\x3d\x89\x98\xfa\x11\x5c\x0c\x1c\x64\x39\xca\xa8\xee\xcf\x90\x6a\xd2\xe7\x57\xec\xa8\x07\x96\xa4\xac\xc9\x48\x4b\xe7\x79\x1c\xa9\x21\x5d\x6f\x39\x8d\x44\x44\x51\x3f\x11\x91\x4d\x8a\xda\xd8\x08\x28\x4e\xd3\x5c\xa5\x78\xe3\x70\x09\x71\xe0\x05\xee\x33\x50\xde\x3a\x38\x58\x3e\xa2\x3e\xd7\x30\x6c\x64\xe3\x28\xe1\x80\x80\x29\x57\x4d\xb9\xac\xd4\x34\xbb\x03\x4d\xf2\x70\x36\x74\x65\x3f\xa7\x59\xbd\xcc\xe5\x7a\x2c\xce\x7a\x83\x5b\x75\x75\x9d\x35\x83\xab\xf2\xae\x97\x88\xde\x60\x51\x0f\xa6\xb9\xba\xa3\x9f\xfa\xcf\xde\x05\xd3\x11\x7c\x44\xec\xd7\x85\x28\x0b\xa5\xab\x29\x0b\x08\x5a\x99\x22\xac\x34\x62\x70\xe0\xad\x17\x9f\xa1\x20\x3b\x58\x89\x36\x14\x09\x61\x52\xce\x8a\xec\x1f\xe6\xba\xda\x72\x96\x0e\x9d\xbe\xd0\x5b\x5a\x15\xad\xe8\x47\x66\x8e\xce\xfc\x21\x25\x90\x23\x1b\xf5\x66\xe3\xad\x74\x5b\x69\xef\x96\x7a\x44\xd8\xb1\xaa\x48\x4b\x08\x1f\x03\xa0\x44\xd1\x7f\xc3\xb8\x45\x89\x88\xde\x94\xff\xd0\xff\x2c\xea\xe8\xe2\x88\xc5\x1a\x82\xdd\x88\x6b\xdf\x9d\xbb\x1d\x42\xa3\x96\xb5\x08\xb0\xc9\x16\x33\x61\x76\xf0\xc7\xfd\xbd\xbb\x08\x99\x78\xbb\x70\x11\x8a\xa3\x34\xbb\x89\xfa\xd8\x15\xdd\xe8\x25\xee\xb0\x89\x5c\x28\x58\x53\x40\xdb\x3c\x3f\xc0\xc7\x5d\x72\x00\x68\x08\x6e\x1b\x53\xb3\x82\xd5\x17\x1e\xd0\x3a\x07\x53\x8d\x4e\xe4\x92\xd6\xae\xfe\x60\x40\x04\x9f\xf6\x87\x4d\xf9\xdb\x72\xa9\xaa\x97\xb2\x56\x71\x5f\x1c\xe0\x67\xb4\xd3\x43\x57\xf5\xae\x68\x57\x6c\x58\xbb\x00\xeb\x18\x5c\x1b\xcb\x79\x96\x5d\x88\x03\xd3\x10\xb7\x65\x20\xa3\xdf\x95\xf0\x72\x51\x18\x4d\x09\x4d\xae\x17\xf2\x09\xc5\x7f\x3a\x89\x37\x02\x5d\x7f\x3d\xa4\x75\xa0\xab\x0e\x05\x36\x4f\x4b\xcd\xe4\xd0\x10\xe5\xda\x07\xaa\x6e\x17\x75\xdf\x49\xd4\xe9\xd0\x6e\x3b\x54\x61\xe4\xbc\x47\x5b\xd1\xbb\xcb\x3c\xfd\xe8\x2a\xa3\xa8\x77\x41\x13\xbc\xbc\x4c\x2e\x74\x99\xdd\x16\xb3\xcb\xd9\x6b\xaa\x51\x7d\xa3\xc4\x9c\x4d\x7d\xc9\x1b\xd4\xb1\xe6\xac\x32\x96\x36\xc6\x18\x87\x82\xd8\x92\xc4\x8c\x17\xdc\xb4\xd4\x39\xda\x87\x62\xd0\x4e\x6c\x20\xef\x1e\xb6\x8b\x77\xc2\x9a\xad\x6c\xbf\x3b\x30\x2d\x3a\xcd\x9c\xd7\x23\xd4\x21\xba\x51\x20\x6a\xab\x22\x55\x95\x90\x22\xcd\xa6\x53\x05\x72\xe3\xb5\x5a\x23\x9e\x91\xbe\x5e\xea\x64\x2c\xbf\x90\xd7\x4a\x80\xd9\x7c\x53\xd2\xd3\x40\x06\x91\xb0\xe1\xee\x50\x29\x49\xe1\x0a\x2d\xc2\x12\x3e\x10\x50\xe0\x77\x78\x1e\x20\x44\x24\xf6\x46\xa0\x8f\x03\xd6\xee\x70\x8e\x8e\x05\xea\x22\xf0\x18\xa4\xd7\x03\xff\xde\xf1\xf9\x4b\xd7\x4d\xe3\x88\xc1\xfa\x18\x52\x46\xbc\x35\xd2\x2d\xd9\x99\x18\x8e\x60\xb6\xad\x19\xfc\xae\x07\x05\x43\x8d\x00\x39\xdc\xbe\xa6\x13\x9a\x89\x12\x51\xd4\xef\x78\x5c\x30\x95\x18\x42\xfe\x03\x83\x4f\x9e\x39\xd6\xad\x2a\x13\x10\x87\x57\xef\x29\x5f\xd4\xf7\xce\x57\xab\xc4\xd8\xdf\x73\x59\x8b\xa2\x14\x6a\x3a\x85\x6b\xde\x62\x55\x83\x0b\x1d\x05\x88\xc7\x6b\xcb\xc6\xf6\xa3\xce\x1e\x09\x91\xce\x36\x0c\x3a\x44\xfa\x0d\xb3\x2a\xdb\xca\x0d\xbc\x08\xb6\x34\x1b\xec\xa6\xe3\xa1\x79\xbc\x48\x53\x44\x73\x37\xce\x22\x8b\xa5\x6c\xb2\xab\x2c\xcf\x1a\x5c\x91\x1f\x7f\xff\x85\x96\x17\x64\xfb\x35\xab\x9b\x2e\xe7\x14\x4d\x4b\x67\x25\xd3\x80\x1a\x23\x22\xf9\x77\x1d\x99\xa6\x14\x4a\x40\x77\x79\x92\xd7\x8f\x92\xfb\x76\x27\x39\x3c\x91\xed\xc6\x84\x5d\x9f\xd7\x46\xb7\xd0\xcd\xe5\x3a\xe8\x81\x00\x60\x29\xaa\x7c\x68\xfb\xc2\x57\x9c\xa6\x6c\xbd\xcb\x45\x10\x9c\x07\xa0\xf3\x49\x47\x32\x3a\xaf\x0f\x46\x5d\xfa\x96\x09\x43\xbb\xe4\xb5\x0c\x65\x9a\xc6\x93\xfe\x11\xe9\x40\xba\x64\x92\x20\xaf\x85\xc7\x6f\x05\x37\x21\x26\x0e\x76\xb5\xc6\xcb\x68\xd6\x81\xb1\x8f\x86\xe2\x11\x45\x63\xf3\x16\x36\xef\xa5\x3e\x4d\xf3\x5a\x1c\x20\xe6\xef\x0f\x9e\xcf\xeb\x26\xf0\x7e\xd8\x1c\x50\xae\xdf\xa1\xe6\x61\xf7\x69\x84\xe5\xf9\xbf\x6d\x81\xd1\x2b\xd0\xff\x47\xd7\x18\xf6\xfe\xd1\xcb\xcc\x64\xf7\x56\x1a\x0d\x87\x97\xd1\x0f\x77\xda\x19\xec\xc6\x2c\xb9\xff\xc0\x92\x25\x13\x27\x01\xa0\xd4\x7c\xb1\xfa\xba\x0c\xb6\xa8\x1b\x59\xf5\x01\xff\x9a\x0d\x2b\x54\xae\x33\x99\x0b\x5c\x23\xab\x04\x96\x3c\xef\xbb\xcb\xc6\x81\xe1\x69\xd3\x3c\x62\x5f\x30\x66\xdd\x1a\xf8\x47\x0c\x59\xa7\x3d\x04\xa1\x20\x9f\xba\xe8\x20\x71\xaa\xa6\x4e\xdb\xb6\x6b\x7f\x05\xb6\x0c\x0f\x2e\x51\x52\xae\x41\x4c\x4e\x7d\x35\x40\xd5\x64\xd4\x36\xd5\xfa\xcc\xc2\xef\x42\x8c\xe2\xba\x6e\x03\x9e\xf9\xea\x05\x7d\x07\x7d\x59\xd7\xae\xd5\x50\xb0\xa0\x20\x9b\xd1\x4d\xd4\xf7\x06\x9e\x17\xd5\xfd\x39\xe2\x97\x10\x0a\x88\xca\x7c\x4d\x83\x86\x77\x2b\x03\x3b\x9b\xd0\xf7\x02\xe5\x84\x39\xba\xee\x68\x0e\x89\xcb\xc7\x92\x28\x1a\x55\x01\xa7\x19\x93\x84\x71\x20\x7a\x03\x48\xb5\xf0\x44\xfa\xc7\x69\xd9\x9d\x69\xd0\x94\x5e\xbe\x17\x20\xc9\x6d\xc8\x8b\x62\x9e\xc9\x9f\x2b\xd9\x91\x13\x52\xbd\x2c\x1d\x55\x43\x3a\xab\x1a\x7e\x6f\xa8\x1a\xf3\x9a\xaa\xdd\x9d\x09\x81\x13\xfd\x51\xcb\x8a\x1f\x49\x01\x60\x43\x42\xe1\xf5\xf7\xf4\xc3\x8b\xb7\x1f\x5f\x9f\xbe\x7e\x07\xee\xfb\x2e\xb6\x0c\xf9\xf9\xbf\x78\xfb\xfa\xcd\x0b\xf3\x55\x16\xd9\x42\xd2\xc7\x9d\xd1\x48\xb0\x1a\xcc\xa3\xc4\x08\x8d\x57\xeb\x22\x9b\x4e\xb5\x20\xe5\x87\xc7\x21\x25\x53\xbb\x1a\x97\xf0\xaa\x40\xcb\x39\x3f\x9b\x2a\x52\xca\x69\xdb\x60\x68\xf1\x46\x79\x19\x38\x25\x9b\x88\x84\x10\xae\x91\x8d\xd0\xa3\xce\xa0\xdb\xac\x48\xcb\xdb\x61\x59\x78\xed\xf2\x71\xef\xcd\xbd\xd0\xe6\x45\x15\x8e\x5f\x62\x97\x97\x60\x77\xc1\xf6\x40\xa1\x22\xe3\x94\x0f\x97\x9f\x91\x77\xf2\x36\xc8\xfc\x0a\xfa\xea\x74\xf5\xb6\x4d\x7c\x34\x1e\xd5\x7c\xaf\xc0\xa6\xd6\xb7\x66\x06\x1b\xff\x82\xcd\x8f\x97\xad\xdd\xf4\x17\xec\x53\x74\xc4\xec\x10\xcc\xd5\xb2\x29\xa9\x65\x20\x7f\x18\x4b\x63\xb8\x3b\xe9\x7b\xd8\xbc\x6c\x44\xa5\xf2\x52\xa6\xf8\xee\x0d\x02\x08\x00\x67\x34\x55\x36\x69\x00\x8c\x02\xd6\x48\x25\xa7\x7c\x4f\xec\xe8\x9b\x13\x75\xb9\x52\x7f\x5f\xa9\xda\x35\xe5\xe7\xca\x68\x25\xb6\x67\x19\xea\x36\xd2\x10\xdb\x4b\x97\x6a\x4e\xb3\x85\x2a\x57\xcd\x8e\xfe\xd9\xb1\xbc\xe0\x36\xf9\x64\xc4\xd4\xae\x10\x30\xd8\xbe\xfa\x16\xb1\x96\x15\x8e\x02\xe0\x1a\xa8\xd0\xc5\x16\xae\xe4\x34\x6e\x07\x30\x87\x54\x34\x33\xec\x80\x08\x3f\x0d\x42\x56\xf9\xc2\xd8\x4d\x3b\xa8\x95\xda\x18\xd6\xca\x80\xc8\x76\x7c\x39\x36\x06\xd3\xe8\xb4\x10\x7c\xb6\xf2\x00\xd4\xcb\x04\xdb\x76\x4e\x80\x5e\x75\x82\x90\xb9\xa7\xd8\x56\x1f\xb5\x2c\x87\xf1\x34\xdf\xde\x4d\x12\x03\xdb\x0d\x77\x07\x15\x48\x60\x9d\x79\x78\xc5\x82\x0b\xb6\xac\x51\x5e\x00\x86\xb9\x2a\xbc\xbd\x59\x33\xbf\x36\x0c\x8b\xa9\xd2\xd3\xf5\x12\xae\x86\x93\xab\x16\xa8\xe2\x4c\xb1\xad\xfd\xba\x98\x96\xe8\x29\xc2\x0a\xf6\x03\xb4\x13\x70\x3b\x02\x07\x38\x4a\xc6\xb5\x68\xbe\xe0\x2f\xfe\xac\xfd\xb2\x5c\x15\xe6\xb3\xfd\x6d\xa6\x0f\x3c\xc8\xd8\xda\x9c\x5c\xc5\x7d\xab\x77\x34\x2e\x0b\xd6\xff\x88\x1d\x2b\x27\x5d\xec\x6a\xdc\xe6\x03\x56\xdd\x05\x2e\x06\x2c\x2e\x16\x70\xa9\x8e\x10\xfd\x1b\x22\x05\x5a\x83\xf3\x57\x45\x4a\xeb\x45\xb7\xf5\xc8\xa0\x14\xa3\xa5\xef\xab\x80\xa8\xa7\xd9\x50\x43\x63\x6a\x0f\xc6\x2c\xbe\xc1\xdf\xc1\x01\xb6\xf0\xf9\xb1\x1b\x34\x4f\xf9\x5d\xa4\xad\x60\x44\xa6\x6e\xc7\x11\x3a\x76\x2c\xd4\x0c\xa4\x7f\xe8\xa2\xcc\xe8\x02\xbd\xc4\x4e\xa8\x09\x7d\xd1\x15\x01\x31\x18\x0d\xe3\x92\x60\x82\x16\xd0\xa3\xd0\x55\x6c\x53\xee\x65\x9e\xf7\xe3\xe4\x7e\xaf\xef\x45\x5b\x6b\x2d\x3f\xd1\x5e\x7f\xfe\x73\x7e\x0d\x36\xf7\xc0\x31\x67\xaa\x79\x59\x2e\x96\xab\x86\x54\x58\xb1\xca\xdd\x62\xb5\x54\x7f\x52\xb9\x5c\xd7\x60\xb9\xa6\x8b\x9f\x05\xc7\xe1\x81\x88\x20\x47\x74\x41\x17\xb0\x28\xa1\xdb\x41\x40\x66\x85\x40\xa7\xdb\x29\x51\xa6\x87\x88\x9d\xda\x3d\x33\x73\x53\x17\x36\x39\xe9\xaa\xdd\x12\xb3\x0b\x3d\xec\x9f\x7f\x60\x6e\xef\x9e\x23\xd2\xee\x5d\x9b\xce\xd6\xce\xd9\xec\x9d\x7d\x0b\x5a\x9b\x74\xd4\xdc\xb7\x7a\xc4\x6e\xee\xf2\xb4\x8b\xa7\x40\xe2\x03\x02\x16\x5f\x4c\x01\x17\xe1\x7b\xa4\x3d\x3b\xcf\xf9\x55\x92\xb8\x9f\x2b\x6b\xcd\x63\x6c\x0b\x5b\x14\x4c\x16\xde\xe2\x8e\x39\xb5\x68\x87\xa2\x1d\x0e\xb8\xd5\x78\x2b\x3a\xf3\xb6\xb7\x06\xbf\xab\xe9\xb6\x64\xbb\xe5\x61\xf9\xae\x86\xb7\x67\x6c\x53\xbb\xe9\xb8\xb5\xd4\xdf\xc8\x66\x3e\x5c\xc8\xbb\xf6\x10\x27\xad\x9a\x89\x15\x51\x93\x1b\xd7\x1d\x6a\xd1\x49\xc7\x2e\x7a\xde\xa2\x62\xb9\xe6\x09\x9b\x30\x9b\x38\x76\x43\xb1\x63\x52\x8a\x55\x4e\x6f\x80\xde\x64\xad\x97\xce\x6f\xa6\xe3\x14\x62\xf5\x6c\x9e\x57\x56\xef\xc6\x31\xb4\xed\x78\x7a\xc4\xde\xdd\xcc\x65\x02\x60\x66\x8f\xd9\xc0\xf8\x47\x21\x49\xd7\x8c\xfb\xe2\xfb\xf6\x46\x36\x65\x1e\xb8\xa3\x0b\x6e\x9a\xf5\xd9\x56\x80\x1e\xbf\x09\x9f\xc6\xb1\xf9\x23\xf1\x07\x69\xec\xfe\x34\x1e\x3a\xae\xcd\x63\xef\x57\x4b\x98\x72\xfc\x41\xc4\x29\xf1\x85\xd4\xb2\x83\x4d\x77\x27\x12\x6e\x9d\x61\x05\x16\x35\x26\x6a\x3f\x38\x12\x81\x06\x2b\x35\x8c\x92\x0a\x90\x8f\x1e\xfe\x72\x86\x1c\x34\x1a\x66\xd1\x7a\x8e\x6a\x8e\xb4\x1f\x15\x29\x4d\x44\xd6\xb2\x71\x7a\x53\xc7\x69\x5f\x1c\xd0\x5f\x50\xcb\x59\x76\x01\x37\xea\xc0\x22\x58\xe7\x10\x71\xed\xa9\x1b\xc8\x9e\xb2\x76\xa0\x95\x83\xc3\x7e\x5f\x3c\x11\x87\x4f\x9f\x3e\xed\xd4\x18\x81\xce\xc0\xda\x13\x35\xe5\x6c\x96\xab\x9f\xf0\xd9\xdf\x9d\xa1\xe1\xab\x1f\x79\x9f\xc8\x3c\x47\xa5\x80\xf3\x7c\x2d\xca\x5b\xcf\x46\x55\x8b\xab\xa8\x82\xb8\xe2\x56\x13\x26\x6d\x38\x91\xc5\x44\x81\x9f\x2c\x0f\xf4\xc6\x72\xc4\xbe\xb1\x0c\x3d\xaa\xb6\xf4\x5c\xfc\x6d\xcd\x2d\x5d\x17\x31\x8c\xbd\x9a\x3e\x5e\x49\xdb\xf6\xe8\xd4\x0d\x43\x65\xce\x55\x9f\x30\x0f\x74\xbd\xa7\xc6\x49\xfe\x70\xcb\x53\x2a\xc4\xa5\xc5\x08\xc4\x75\x1d\x88\xc7\xd8\x6e\x76\x82\x39\x8d\x91\xf9\xea\x52\xbc\x3c\xa4\xb7\xf1\x72\x51\x9a\x97\x8f\xe9\x6d\xbc\xbc\x2c\xdd\x1e\xca\xcb\xa5\x92\x7e\xe5\x2c\xc9\xcf\x15\x54\xef\x25\xfa\x39\x3b\x1a\xd0\xfa\x60\x4a\xa0\xcf\xf4\xab\x02\x2d\x5a\x21\x2f\x4b\xf2\xfa\xe5\x75\xc6\xd6\x38\x6d\x54\xe5\x15\x77\x29\xfe\x08\xb3\xe5\xc7\x46\xd9\xa4\xfa\xed\x79\x01\xcd\xf5\x1b\x84\x69\x7e\x4f\xfd\xee\x79\x6d\xf2\x49\xb0\xa4\x60\xec\xc3\x66\x05\xc9\x26\xb7\xe1\x2c\x26\x9b\xf9\x6d\x76\x28\xea\xe3\x8c\xd9\x1d\xda\xdb\x90\x89\xe9\x15\xbe\x00\xff\xe0\x36\xcb\x73\x66\xc9\xb8\x90\x85\x9c\xa1\x7b\xbb\xf1\x02\x70\x19\x87\xe2\x5d\xa1\x84\x4a\x67\x08\x00\x0a\xaf\xcd\xe0\x9c\x91\xd5\xe4\x6e\x10\x12\xce\x6a\x74\xbc\x4f\x77\x98\x4b\x95\xa8\xca\xb2\x41\x47\xac\x72\x2a\x24\x1a\xfb\x31\x54\x38\xf1\xba\x30\xbe\xfe\xb5\x12\xb7\x0a\x81\xf6\x4c\x65\x3b\x14\xd9\xde\xd5\x12\x19\x0f\x21\x78\x28\xa2\xa9\x80\xbc\x43\x8b\xca\x00\x0e\x8b\x5a\x2c\xf1\x06\xa6\x2d\x6a\x93\xbd\x9d\x9f\x6d\xb8\x17\x5a\xe7\x05\x05\xf6\xf7\x03\x12\xc6\x4a\xaf\xa5\x4b\x20\xfa\x9d\xb9\xe9\x9a\x68\xdb\x1a\x64\xa2\x0f\x1e\x57\xcc\x6a\xbb\xb0\x76\xe9\xfb\xf0\x32\xab\xdf\xe8\x03\x56\xa5\x18\x78\x13\xf8\x63\x56\x7f\x28\xcb\xe6\x75\x51\xab\xaa\x61\xf6\x26\x54\x7a\x7f\x5f\xec\x4a\xfb\x27\xfd\x05\x86\x40\xd1\x66\xbe\x56\x37\xb2\x6a\xcc\xb6\xe6\xa4\x18\xcf\x20\x9d\x55\x98\x32\x16\x6d\xa6\x26\x3d\x36\xd1\xa6\xc7\xb8\x85\x47\x35\x4c\x1f\xb7\xd8\x9e\x9d\xe5\x72\x33\x75\xe2\x5c\x1e\x65\x9e\x36\x16\x3e\x83\x6d\xf3\xab\x3f\x97\xe5\x35\x23\x4d\x94\x62\x8f\x83\xdc\xdf\xf3\x12\x46\x43\xb7\x89\xc3\x6d\xa0\x48\xef\x1a\x86\xe5\x80\xb9\x16\x1d\xea\x91\x6d\xbb\x69\x70\x9f\xb7\xbe\xcd\x25\x37\x54\xc1\x59\xd6\xfd\x3d\xcb\x6f\xc8\x3d\xc4\x55\x37\xd1\x0d\x98\x9c\x3e\x48\xbd\x72\x5e\x73\x19\xd7\x33\x95\xdc\x2d\xf3\x6c\x92\x35\x50\xf3\x4f\x8e\x07\x5a\x7f\x12\x32\x87\x22\x7b\x71\xc3\x15\xfb\x56\x28\x37\x29\xc8\xee\xad\x18\x6d\x92\xd1\x0d\x9c\x76\xc7\xc3\xd1\xf1\xba\x9b\x13\x84\x82\x01\x46\x64\x3e\xc6\x9d\x45\x12\x11\x41\x7b\x22\x63\x4a\xe6\xed\x71\xbc\xd3\xd5\x2f\x3f\x7e\x14\xc7\xc2\x7b\x54\xe3\xcf\x28\x98\x77\x55\xab\xea\xbf\x65\xd1\xd4\x2f\xcb\xa2\xa9\xca\x1c\xaf\xd5\x7a\x36\x5e\x18\xd0\x84\x5f\x41\xca\x8d\xed\x0a\x73\x17\xe9\xc9\x15\x69\x57\x49\xd0\x11\xc7\x00\x6e\xb0\x49\x49\x64\x9b\x15\xe2\x2d\x04\xfa\x4e\x50\xd1\xd0\xde\xb3\x2a\xa9\xcd\x39\x19\x1f\x68\xbd\x38\x4f\x98\xdc\xe8\xeb\xc3\xba\x5a\xb3\xad\x16\xc7\xbc\x02\x2d\x99\xe8\x5a\xc9\x7a\xae\x5b\xa9\x46\x7f\xd4\x7a\xc6\x0d\xb6\x97\xe6\x31\x5e\x0a\x2b\x68\x85\x5e\x37\xe4\xe6\x6a\xf9\xc5\xad\xc4\x5d\x6e\x3f\x36\x2f\x6f\x19\xc0\x0b\xc5\x4b\x5a\x2a\x7c\x9b\x40\xa1\xdc\x00\x6f\x58\x29\x1f\xbc\x6c\xf5\x96\x00\xd3\xb8\x42\x64\x70\x0e\x88\x79\x59\x22\xee\x37\x58\xc9\xfd\xae\x4f\x19\x68\x1f\xdd\x09\x22\xcc\x16\x25\x1d\x6a\x4f\x6e\xc1\x8e\xab\xc6\x59\x94\x1f\xf1\x1c\xd8\x30\xcf\x8c\x1d\x62\xd6\xc3\x5f\xc3\x4b\xd3\xf0\x76\xd2\x19\x76\xda\xf9\x74\x93\x8d\x2a\x23\xc8\xdc\x02\x58\xf2\xd0\x20\x0a\x23\x01\xfd\x6b\x43\x46\xf0\xc1\xa3\xeb\x06\x65\xe0\xab\x67\x53\xd6\x50\xb1\x2a\x18\xbb\x36\x2b\x85\xe6\x39\x11\x93\x2b\x33\xd7\x1e\xcc\x2d\xac\x3f\x9a\x1d\x77\xce\xef\x88\xd6\x89\xb2\xbf\x1f\x26\x99\xe5\xd3\xbd\xec\xdb\xcf\x2a\xdd\xeb\x7d\x43\xbe\xf6\xee\xb3\x6f\x3d\x1d\x7c\xe0\x2b\x77\x18\xda\xd0\x74\xee\xe1\x8d\x0d\x0a\x19\x07\x51\x09\x19\x1d\xa7\x64\x84\x9b\xdf\x21\xe4\xf8\x56\xce\x1b\xc4\x1e\xf7\x74\xe3\x93\xab\xa4\x9b\xc3\x7b\xd1\xd0\x83\xbd\x8f\xff\xb5\x9f\x59\xb0\x2f\xeb\xa5\x62\x2b\xc2\x5f\x43\xad\x08\xe7\x6e\xbd\x38\x0f\xff\x16\x0b\xf0\xee\xed\x20\x87\xf2\x04\xb3\x52\x1f\x5e\x9f\xb6\x9e\x5d\x76\xdc\xe8\xe3\x65\xd3\x40\xdb\xd7\x0c\x4f\x35\x81\xfc\xc7\x30\x90\x6a\xf1\x28\x4d\x02\xee\x82\xad\x9a\x04\x73\xf5\xe6\x9a\x04\x4a\xdb\xa2\x49\xa0\x1c\xff\x01\x4d\xc2\x23\x54\x00\xa2\xa2\xa0\x94\x5f\xa5\x63\x08\x55\x26\xff\xaa\x3a\xc1\x59\x81\x98\xaf\x2e\xc5\xcb\x13\xdc\xe7\x79\x9a\x97\xaf\xe3\x36\x1f\xa6\xfb\x97\xe7\x5f\x61\x49\x78\x77\x67\x48\xf2\xa8\x7a\xa4\x3c\x31\xd5\x2b\xee\x52\xfc\xfe\x85\xb7\x66\x3f\xd5\x5e\x9a\x55\x2e\xd7\x1e\x3d\x97\xf2\xa8\x8b\xf5\x7f\x4e\x1a\x83\x06\xf7\x5b\x72\x2e\x34\xed\x5f\x92\x73\x81\xf0\xbf\x2a\xe7\xd2\xf2\xec\x6a\x54\x7f\xbb\x98\xeb\xe5\x4d\x44\x04\xcd\xe9\x16\x73\xad\xf4\x49\x5b\xe0\x01\xe9\x93\x0b\x1c\xe8\xc2\xcf\x12\xac\x10\xe1\x59\xe3\x75\x7c\x67\x42\x06\x13\xbd\xb8\xc8\xf9\x75\x62\x2e\xdf\x36\x8f\x90\x75\xc3\x9d\xf3\x1f\x14\x78\xdd\xb6\x6f\x49\x2f\xc1\x1e\xda\xdf\x0f\x52\x36\x0a\xba\xd5\xc2\x89\x42\x6c\xb3\x1a\x81\x17\x7e\x75\x09\xbb\x6e\x86\x3b\x84\x5d\xb7\x27\x3d\x4d\x3c\x12\x5b\xaa\x6a\x5a\x56\x8b\x5f\x69\xbb\x04\x2d\xe2\x5f\x19\xd3\x77\xf1\x75\xd8\x77\xb7\x9c\x08\xe7\x11\x6a\x51\x29\xf1\xa3\x85\x5c\x63\x14\x68\xe3\x48\x72\xa5\x54\x21\xec\x5c\x3c\x30\x41\x96\x6b\x9b\x5e\x83\xa4\x3e\x29\x2b\x24\xaf\x05\x5e\x92\xd1\x2d\xa1\x4d\x32\xbe\x08\xd6\xba\x13\x99\xc9\xe2\xa5\xeb\x13\xc4\x50\xee\x9f\xc5\x76\x75\x83\xc7\x3d\xf3\xbd\xc5\x56\x71\x06\x6d\xe5\xcc\x60\xd6\x36\xad\xb5\x0d\xe2\x5a\xc7\x32\xdb\x96\xb3\xbd\xf6\x1f\x12\x38\xbf\x72\x8d\x3f\x20\x74\x6e\x6f\x5d\xb8\x91\xc5\xe3\xa4\xcf\xed\xf2\x67\x37\xfb\x74\xff\x6d\x90\x40\xfd\x62\x47\x5e\xa1\x4e\x19\xf4\xeb\xa4\x50\x2e\x87\x76\xca\xa4\xde\x26\xce\xcd\x92\xc9\x69\xb1\x30\x7a\x5f\x23\x44\x3a\x31\xd2\x87\xfc\x01\xd7\xd0\x15\xa2\x28\x88\x54\xdd\xa0\xd1\x9c\xc3\xad\xe4\x67\x0d\x20\x4c\x5b\x2f\x61\x1f\xfc\x8d\x54\x65\x37\x32\xc7\x73\x0d\x21\x4a\xac\x4e\xf3\x56\x56\x85\x8b\xc5\xc2\xf5\xd6\x66\xd4\xc1\x0e\xdd\xd8\xba\x3a\xe1\x80\x1c\x0f\x00\xde\x39\x4b\x0d\xf8\xf2\x80\x47\x68\x99\x95\x58\x38\x0e\x02\x31\xa3\x9f\xee\x81\xe8\x0d\x7b\x26\x32\x9f\x07\xaa\x07\x69\x2d\x5f\xe4\xfa\xad\x7c\xeb\xb9\xf8\xfe\x2b\x4d\x7f\x2b\xdf\x7a\x6d\x8d\x80\xfd\x99\x0c\x2c\xa2\xe6\x02\x02\xf5\x5f\x29\x91\x15\x93\xb2\xaa\xd4\xa4\x19\x46\x0f\x35\x3a\x00\xcd\xf1\xf6\x00\x8b\x19\x69\x9e\x3d\xdd\x04\x1d\xb3\x09\x22\x51\xca\x74\x9a\xb9\x8a\xbc\xb5\x8e\xb2\x92\xdd\x9b\x41\x93\x11\xd5\x16\x19\x54\xe0\x53\xee\x50\x9c\xce\x15\x7c\x03\x7e\x7e\xa5\xc6\x18\x48\x53\x1a\xb7\x40\xf8\x14\x67\xc5\x4d\x79\xad\xaa\x3e\x05\xdd\x9a\x2b\x0b\x37\xab\x97\xdf\x70\x5a\xd4\xa6\xd8\x6d\x25\x97\x4b\x95\xf2\xf7\x12\xd5\xcc\xcb\x94\x90\x15\x84\x3e\xda\xe0\x0d\xd9\x14\xc0\xf0\xe7\x8e\x8f\x0d\xdd\x77\x1f\xdd\xa1\x43\x28\x74\xb6\x95\xfc\xfa\x31\x0d\xa1\x23\x5d\xa0\x21\x1b\x8c\x0f\xba\xf3\x33\x58\xe7\x4c\x0b\xdd\xfe\x23\xef\x9e\xe1\x32\x70\xa8\x60\x4a\xe5\x94\x37\x08\xab\xb4\x02\x7c\xff\x73\x46\xd4\x32\x8f\x13\xd6\x96\xb3\xa7\x17\x36\x7d\xcc\xd2\x71\xe9\x6c\x0a\x1f\x3b\x2d\xcc\x90\xea\xe3\x6e\x5a\xd8\x37\xfa\xe7\xe2\xb0\xb5\xda\x2e\xe9\x49\xfb\xb2\xc5\x07\x82\xb3\x15\x31\x88\xaa\x95\x95\x30\xa0\x60\xcc\x85\x52\x6e\xa2\xd6\x36\x5f\x3f\xf1\x3c\xea\xb0\x5e\x08\xb9\xab\x8f\xb3\x20\x0d\x8f\xab\xb1\x08\x81\x50\xf7\x0e\x5b\xf7\xe4\x07\xbc\x31\x1e\xd7\x17\xe2\xce\x8c\xf6\x03\xb2\x1b\x31\x5e\x31\x16\x9f\xa9\xd7\x06\x6b\xed\x4d\x99\xae\xd0\x84\xee\x4c\x77\xae\x69\x10\xaa\xf3\x5a\x1f\x8a\x89\x41\x71\x85\xbf\x0c\x90\x52\x02\x71\x44\xc9\xb5\x90\xe9\xb5\x2e\x3c\x9c\x6f\xe0\x37\x2e\xb0\x13\xd4\xc2\xd1\x53\x96\xcb\x3c\xd3\x92\x98\xac\x9b\x84\xb0\x54\x64\x9e\x83\x0d\xf6\x2a\xcb\x9b\x41\x56\x50\xa1\x1a\x65\x34\x90\xcd\xa8\x14\x86\x0c\x5c\xd8\x96\x07\x7d\x31\x66\x1c\x57\x12\xe2\xd8\xea\x24\xe3\xad\x00\xa8\x8c\x16\x6f\xf7\xbd\xfe\xf5\xb3\x89\xbc\xf1\x19\x9e\x2b\xdf\x2d\xeb\xb1\xf9\x23\x31\x75\x8c\x6d\x65\x08\x54\x64\xf8\x14\xc6\xde\xc2\xb3\xca\x3c\xa8\x8a\x69\x96\x2b\x1b\xc1\x7e\x9a\x97\xb7\x36\x98\x58\x9e\x5d\x43\xb0\x7d\x39\x99\x83\xef\xe7\x13\xe3\xcd\x90\x29\xc0\xee\x24\x87\xfe\x1a\x63\x40\xef\x6c\x54\x28\xe0\x36\x7f\xfd\xea\x7b\xb2\x88\x19\x41\x5c\xde\xf1\x68\x74\x7b\x7b\x3b\x5c\xc8\xa6\xa9\xff\x70\x78\x08\xc1\x79\x97\x65\xdd\x8c\x30\x22\x81\x6a\x06\xfa\xd8\x28\x2b\x55\x0d\xbe\x1f\x94\x05\xe0\x85\x8d\x60\x5e\x0d\xbc\x5a\x68\xd2\x49\x71\xec\xb2\xb2\xb0\x01\x35\xda\xe2\x9a\x55\xfc\x74\xc3\xb4\x31\x09\x33\xa7\x3b\xdc\x0d\xa0\x9e\x31\x63\xb4\x2a\x9b\xcd\x0c\x96\x3c\x02\x8f\x07\x58\x51\x06\xca\xcb\xae\x27\x74\x77\x45\xdd\xb5\x4a\xd9\xc6\x33\x49\x68\x34\x4a\xe6\xfc\xc4\x2b\x12\xeb\x92\xcf\x6f\x99\x4e\x83\xdc\x15\xba\x8f\x60\xee\xbe\x7f\xfa\x2d\xbb\x97\x59\xcf\x7e\x95\x2f\xe0\x18\xe3\x09\xc3\xcb\x9b\x77\x4b\x66\xaf\x84\xff\x6d\xd0\xbe\xeb\xf9\x81\x35\xb9\x41\x01\xaf\xff\xb3\xbd\x76\x58\x3b\x88\x77\x98\x76\xf4\x91\x09\x7c\x5f\xdc\x25\x30\x94\x1d\x6b\xd5\x7c\xa4\x28\x2c\x1d\x34\x3c\xec\x5f\x2e\x20\xea\x7b\x9d\xe9\x1c\x82\x9a\x2f\xe4\x12\x43\xe0\xa8\xdc\xc6\xbd\xd1\x47\xca\xef\x36\xe2\xb8\x27\xe3\x04\x63\x6d\xa3\x01\x6a\xc6\x9f\x01\x9c\xd9\x6b\x3d\xfd\xa7\xeb\x25\xd8\xc2\x83\x21\xb8\x77\xbd\x67\x68\x7a\xe2\xd8\x34\xdb\x8f\xcc\x69\xe5\xd3\xd6\xd7\x00\x33\xaf\xdb\x8c\x39\x9a\xb8\x08\x0d\xa0\xde\x8e\x12\x51\x16\x2c\x6c\x03\x04\xaa\x65\xc3\xfc\x10\x11\x55\xa4\x21\x09\x67\x2f\x4e\x0b\xec\xa3\x9c\xca\x2a\x13\x3f\x88\xc3\xa7\xc3\x67\x62\x5f\xfc\xf6\xfa\xbf\xd5\xd5\xef\x99\x72\xac\x63\x9a\x55\x4a\xf8\x54\x41\xf6\xe7\x54\xea\xdb\xac\x01\xce\x42\x80\x8f\x78\xd7\x83\xf0\x27\x59\xb5\x30\xc8\x64\x06\x40\x74\x32\x2f\x33\x8b\x5b\x4a\x37\x64\x00\x29\xab\x01\x73\x5f\xa1\x19\x47\x56\xd7\x88\x33\x59\x29\x0c\xe6\x4a\xf0\x2e\x35\xa0\xc9\x88\xec\xdd\x47\xc2\x87\xe5\x84\x74\x63\x6b\xd1\x43\xa6\xd1\x33\x91\x3c\x45\x39\x15\x3d\xd8\xde\x3d\x51\xa2\x53\x9e\x6e\x4f\xae\x1a\x35\x7c\x60\x34\x0d\xf7\xd9\x36\x8a\xdb\x42\x4e\x0b\x7b\x5f\xb3\x4c\x93\x55\x88\xfc\xc8\xd3\x2c\x8b\xb6\xaa\x1e\x2c\xe1\x77\x20\x54\x91\xbf\x0b\x19\xf7\x09\x3f\x75\x71\xa1\xaf\xe1\x3d\x5f\xb9\x4f\x31\x78\x2f\x9a\xed\x68\x09\x97\x76\x6a\xa5\x8a\x54\x55\x06\x72\x72\x5a\x56\x78\xa2\xe2\xa8\xa6\x09\x2b\xdc\x44\xb5\x03\x85\x03\xf3\x1c\x88\x80\x61\x50\x66\xcb\x55\x33\x28\xa7\x83\x7a\x5d\x4c\x9c\x1c\x6d\xa9\x53\x75\x0c\x62\x28\x55\x8d\x9a\x34\xa2\x5e\x4d\xe6\x18\x4d\x18\x40\x82\x10\x77\x46\x13\x33\xb8\x45\x50\x93\x8b\xec\x30\x97\xb5\x16\xdf\x65\x43\xc7\xa4\x21\x87\x15\x98\x40\x1e\x3f\xbd\x7b\x63\xaa\x42\x13\x70\xc5\x58\x13\xe7\x54\xfc\xa9\x72\xb2\xaa\xba\x33\x3d\x9e\x9d\x59\x2f\x60\x2a\x39\xd4\xdb\x82\xa9\x31\x4a\x34\xfc\x34\xe2\xed\x6e\x5e\x96\xb5\x7a\xf5\xf7\x95\xcc\xf5\x27\xd6\xcc\xb3\xec\x02\xfc\xb3\xf9\x7a\x04\x53\x2c\x38\x0d\x69\x7a\xc8\xe1\x25\x9b\xf2\x2c\x45\x69\x47\xc7\x0c\xca\x14\x80\x57\xc1\x36\x4a\xdf\x8c\x64\xdd\x00\x38\x93\x01\x71\x75\x23\x50\x28\x95\x7e\x50\xb5\xa2\x17\x5d\x13\xe0\x8b\xed\x88\x13\xcb\x57\x11\x84\x33\xe8\xdf\x0d\xeb\xdc\x5c\xd6\x6f\xcb\x37\xd4\x14\xec\x56\x7c\x93\xb0\x51\x86\x0e\x32\xda\x63\x9f\x36\x08\xb6\x26\x45\x1f\xa2\x90\xb8\xbf\xdf\x49\xd8\x2b\xe9\x57\xe2\x6d\x72\xdb\x43\x7f\xa3\x7b\x52\x06\x71\x94\xfe\xd6\xdd\xbe\xe3\xf9\xc3\xb1\xbd\x18\x6e\x6b\x12\xed\xe5\xa4\x59\xc9\x3c\x5f\x7f\xdc\xb8\x6b\x51\x4e\x7f\xf0\xa1\xe8\xf5\x2b\x3c\x10\x5f\xa5\x33\xcb\x2e\xb6\x3a\xf4\x3c\xbe\x6e\x70\xe7\x79\xda\x71\x8d\xef\x20\xb0\xa1\x9b\x37\x0c\xfe\xd6\x9b\x93\x23\x6b\xb0\xf6\xc6\x84\x8d\xf3\xd6\x98\xbb\xa1\xda\xef\x5a\x78\x6a\x61\x9c\xad\xdc\xa1\xff\xf0\xbb\x85\xaf\x24\x41\x0e\x2a\x6c\xd8\x3a\x3f\xd4\xae\x5d\x42\x4e\xff\xd1\xa7\xc8\xbb\x5c\xad\x43\x8a\x2d\x21\x0b\xbc\xff\x52\x6f\x21\x3c\x5b\x53\xbb\x01\xb9\x5a\x35\xc2\xaa\x80\xf0\x99\x86\x45\x0a\x6c\x4a\x04\xd9\x46\x86\x82\xdd\x22\xcb\xed\xef\xd0\x72\xdb\xaa\x59\x16\x4e\xb7\xd2\x11\x85\xc0\xc4\xcb\x4b\x68\xaf\x1f\xb5\x40\xb4\x12\x91\xe3\x48\x1b\x16\xec\xc1\xa6\x79\x50\x5a\xc4\x2e\x78\x6e\x8b\x92\xe6\xcf\x0d\x3f\x85\x68\x3d\x1c\x0b\x60\x66\xaf\xc9\x9b\x92\x36\xa2\xe1\x8f\x31\xd2\xeb\x23\x90\x05\x67\x96\xf8\xc1\xc6\x3e\x84\x79\x34\x3f\xf8\x26\x0d\xf3\xb9\x5c\xbe\x24\x1a\xc8\xb3\xba\x0a\xc6\x65\xc3\xf6\x50\x40\x2e\x8f\xcf\xd2\xf3\x92\x21\x0f\x3d\x82\x66\x65\x2d\xe9\xc0\xcf\x73\x2c\xb2\x36\xcf\xf0\x1e\x03\x7c\x16\x42\xb2\x68\x7b\x58\x3b\x28\xe3\xa0\xf9\xdb\xb2\x83\x11\x0a\x33\xf0\x25\xbf\x66\x98\x08\x93\xb4\x02\xd4\x8d\xaa\xd6\xfc\x54\xda\x7c\x24\xe1\xe8\x00\xa3\x0e\x5c\x31\x08\x50\x9d\xc6\x91\x57\x13\x5d\x52\x74\xbe\xcc\x54\xb9\x83\x47\x07\x4d\xe1\xa5\x3b\x78\xc6\x26\x0d\x93\x78\x15\x6d\xb9\xda\xba\x47\xaa\x56\xfc\x33\x2b\x9f\x6d\xa4\xf0\xaa\x48\x6d\xf9\xd1\x08\x8e\x5a\x88\x54\x84\x8c\xdf\x9a\x45\x2d\x57\x0d\x9d\xaa\x00\xb3\x55\x8a\x4a\xc9\x1a\x3a\x80\x6a\xed\x76\xe4\x35\x37\x74\x5f\x36\x35\x0d\x14\x74\x47\x3b\xec\x98\x31\x81\x83\xd9\x95\xd6\x73\xf0\xa0\x63\xde\xaa\xeb\x99\x19\x05\xbf\x4c\x13\xd4\xdf\x0d\x00\xfd\xfd\xf9\xf4\xcd\xaf\x18\xd8\x05\x8f\x2e\x35\xcc\x8a\xac\xc1\x8f\xa8\xf2\xd7\x43\xc4\x62\xb8\xa9\x7c\x98\x66\x35\xdc\x30\x31\x17\x45\xc0\x64\x8a\x9a\x4a\x4d\x56\x55\x9d\xdd\x28\x40\x6a\x94\xd5\x64\x0e\xe3\xe2\xc4\x40\xa7\x1e\x33\xbe\xfb\x14\xec\x5b\x4b\x61\x4e\x4b\x5a\x95\x25\x83\x09\xce\xcb\x89\x6c\x14\x3c\xdc\xc6\x4c\xec\x35\x58\xdd\x45\x27\x74\x2c\x40\x11\xb2\x47\x32\x66\xdb\x1c\x5a\x6c\xd0\x72\x73\xd5\xc4\x1b\x68\x12\x1c\xad\x31\x3a\x45\xc0\x68\x03\x9d\x35\x2f\x6f\x49\x95\x00\x11\x27\x9d\x20\xaf\x7f\xe2\xd4\x54\x6a\x1a\x08\xee\xfc\xfc\xab\xd4\xd4\x9c\x7d\xf8\xad\x40\x23\xb8\xb0\x5d\xc4\xdb\x7d\x75\xe3\x1e\x0b\xdf\x63\x30\x5d\x3b\x7b\xeb\x0a\x1b\xa5\xb5\x31\x06\x42\x21\xf6\xf2\xe6\x5d\x98\xee\xae\xcc\x08\xde\x96\x9a\x0f\xa0\x81\x2f\x0b\x15\x19\x58\xb1\x30\x8b\x3b\x0b\x6e\x8c\x34\xe6\x35\x99\x59\x07\x06\x5a\x4a\xff\x02\xc5\xf4\xad\x9b\x74\x1b\xed\xd6\x85\x1d\x3c\x0a\x9f\xa6\xba\x01\x3c\x19\x01\x6c\xf4\x49\x6b\xa4\xc6\xd4\xed\xa3\xe0\x36\x67\xb0\xd1\x02\xfc\xc7\xaf\x9c\x7c\x3b\x3f\x46\x86\xc5\xaf\xe6\x27\xad\x8e\xad\x77\x54\x7c\x20\xc6\xc2\xc7\xc7\xa8\x53\x42\x34\x7d\x8f\xfb\xfc\x67\xd7\x98\xef\xf5\xfa\x15\xb3\x6d\xd7\x8b\xef\xa0\xfe\xe0\x12\xe8\x9c\xc3\xce\x35\xfd\x28\xc5\x96\xa7\x16\xff\x8a\x1a\xf9\xda\x08\xaa\xf8\xca\x75\xd7\xbd\x1f\x37\xae\xbe\x22\x60\x3c\x98\x60\xc3\x92\xa0\x88\x68\x24\x4e\x37\xfd\xf8\xa7\x45\x27\xc5\xb9\xab\x7f\x52\x75\x53\x95\x6b\x06\x95\x42\xc2\x07\x7d\xe8\x6f\xeb\xc0\x96\x41\xf7\xf0\x06\x8d\xb2\xfd\x27\xa3\x96\x34\x91\x15\x40\xd2\x1e\x3b\x75\x25\xbc\x13\xcc\xcb\xdb\x31\xfc\x3f\x38\x75\xde\x57\xe5\x4d\x96\xea\xbb\xbf\x3b\x5e\x08\xe3\x0d\x6f\xb1\x26\xae\x10\xd9\x50\x8c\x9c\xeb\x90\x2e\x6e\x23\x5b\xb2\xe2\x0b\x38\x6c\xca\x15\xbc\x1d\x8c\x44\x56\x0c\xca\x55\xd3\x0f\xdf\x78\x78\x2c\x88\x42\x2e\x94\x89\x85\x03\x4f\x3b\xe0\xba\x30\x16\x3f\x96\x65\xae\x64\x91\x60\x94\x05\xef\xb7\xae\x84\x17\x41\xdf\x58\xf7\x9b\x83\x41\xb9\x54\x8e\xd3\x14\xe4\xb5\xd0\x4c\x41\xee\x8e\xf4\x36\x3a\x54\x50\x66\xc3\x37\xe6\x99\xd3\x4e\xde\x5a\xa6\xa3\x11\xe6\x0d\x79\x2c\xce\xd0\x4e\x2d\x31\x1f\x29\x54\xc0\x05\x5c\x9b\x03\x55\x14\xba\x7f\x19\xf5\xa2\x2c\x84\xbc\xaa\x9b\x4a\x4e\x18\x4c\x3e\xe2\x53\x8b\x1f\xae\x95\x5a\x0e\x64\x9e\xdd\xa8\xe7\x9a\xca\xad\x02\xf0\x52\xd1\x94\x9e\xa4\x52\xa9\xa6\xca\xd4\x8d\x22\x15\x94\xcc\x99\x24\xd2\x94\xe2\xca\xe9\xa5\x3c\x89\xf6\x83\x92\x39\x60\xe3\x7b\x52\x09\xba\x8f\x2d\x96\x4e\x17\x84\x3c\xd6\xf2\x4a\x4b\x9b\xa9\x94\x40\x0b\xc4\x0a\xed\xef\x73\x1a\xc3\x97\x4d\x59\xd9\xfb\x98\xe9\x6d\xf0\xaa\xcb\x1b\xa4\xaf\x2d\x3f\x67\x55\x0d\xf8\x15\x50\x17\x26\x73\x9a\x36\xf6\xd7\xa6\xb7\x54\x94\x6e\xc2\x3b\x84\xba\x83\xca\x9d\x99\x08\x06\xc6\xd0\x94\x5d\xff\xc9\x62\x96\xc5\x20\xb4\x83\xa1\x33\x0e\xf7\x4a\xd7\x75\x10\xae\xcb\x65\xcd\x2f\xa2\x26\x1e\x1c\x35\x15\xbe\xff\x64\x43\x6f\x08\xa0\x6f\x43\x40\x95\x8b\xa5\x0d\xe4\x46\x61\x60\xf1\x9d\x71\x68\x02\xa2\xe3\xe2\xc8\x6d\x5c\x46\x59\xa4\x10\x88\x12\x71\xc7\x91\xc7\xe4\x6b\x13\xb3\x88\x73\x01\x78\xac\xaf\x59\xa8\x15\x24\x70\x6c\x9b\x76\x89\xd6\x54\x36\xf6\xe0\x51\xd0\x8d\xbd\x43\xdd\x11\x5b\xd2\xeb\x80\xc5\xd4\x86\x7c\x60\x71\x65\x33\x9e\x41\x9a\xed\x13\x0b\xdb\xe1\xcd\x06\xf8\x4a\xce\xcb\x3c\x85\x50\xac\x89\xa8\xe4\x2d\x8b\xd6\xa0\x57\xd5\xe8\x3c\x1d\xb8\x5d\xb0\x37\x42\xd7\x7d\x93\x6f\xd8\xc8\x59\x68\x1e\x30\x8f\x23\x57\x20\x4a\x2c\x9b\x87\x59\x18\xdb\x2a\x5a\xeb\xd8\x4d\x13\xf2\xf9\x7e\xd7\xfd\xf3\x3d\x0c\x17\x47\x1d\x64\x5b\xc7\x44\x32\x30\x42\x09\xee\x97\x8e\xf8\x05\xdd\x62\x7c\x60\x4d\x07\x22\x85\x7f\xe4\x30\x3b\x93\x8f\x72\xa1\x68\xf3\xc2\x4e\x80\xf7\x3c\x36\x76\xe6\x0a\x4c\x89\x43\x13\xd2\x6d\x62\x7f\xed\xef\xbb\xaf\x46\xeb\x3e\x31\xbf\xcc\x01\xe7\xc1\xd9\xb9\x63\x82\x63\xc9\x25\x3b\x76\x6c\x83\x93\x05\x78\x27\xed\xf6\x31\xde\xc6\x10\x41\x40\x33\x23\xcf\x40\xa0\xc0\x05\xc0\x05\xcb\x66\x9e\xd5\x20\xaf\xe9\x3f\xcc\x8d\xc2\x04\xef\xa8\x00\x14\x5f\x7f\x19\xee\xd5\x79\xd9\xd4\xc3\x54\x4d\xe5\x2a\x67\x2f\xac\xbb\x2c\x38\xe0\x4e\x4b\x31\x41\xc1\xee\xe0\x7d\xc6\x6a\xe0\xc1\x0d\x15\xe3\x1b\xc6\xf6\xca\x77\x3b\xcf\x1a\x55\x2f\xe5\x44\x91\xa1\x07\x6b\x80\x8d\x36\x88\x44\x36\xa0\x91\x4e\x60\x78\x41\x89\xf9\xa2\x5e\x17\x93\xf7\x6e\xd1\x7b\xa8\xa4\x0f\x4b\xc4\x41\x74\xc3\x07\x7a\x76\x2b\xab\xc2\x69\xff\x0c\x40\xac\xa5\xf6\xb0\x3a\x31\x0c\xa6\xf8\x9c\x07\x18\xe4\xba\x46\x21\x22\xdf\xbd\x9a\xc5\x2c\x07\xab\xb6\xb2\x68\x09\x31\x43\xf1\x5b\xad\x44\x64\x35\x8d\x3e\x8d\xc1\xac\x2a\x57\xcb\xe7\xc0\x8b\x34\x53\xa9\x9d\x21\x96\xa0\x79\xc7\x7d\x45\x89\xf6\x8d\xdc\xae\x93\x05\x39\x1e\xeb\xbc\x0b\xb0\x03\xf5\xc6\x25\x2b\xd0\x94\x6d\x61\xc2\x34\x3e\x6a\x48\xa8\xb6\x05\x1d\x88\xf0\xef\x2e\xc6\xa9\xd4\x02\x56\xe4\x27\xa2\xf8\x15\xa1\x42\x73\xd3\xc0\x99\x86\x78\x03\x88\x92\x55\x24\x0e\xe0\xaf\xaf\xec\xb9\xe1\x70\x6c\x81\x9e\x3d\xbd\x70\xfd\x87\xc0\xa0\x59\x0d\x12\x48\xa0\xb3\x40\x27\x75\x13\xbd\x82\x45\xed\x31\x65\x6d\x3c\x9f\xa2\x91\x59\xa1\x2a\x2a\xa0\x05\x19\x88\x31\x6a\xfb\x90\x88\xfa\x3a\x5b\x0e\xed\xd0\x76\xf0\xce\x18\x7b\x83\x0c\xb4\xc5\xfd\x4c\x2f\x82\x45\x0d\x70\x23\x9e\x1e\x46\x9f\xd5\xe0\x2b\x6f\x32\x63\x00\x7a\x5f\xa0\xe8\xeb\x2c\xb4\xa9\xda\xe2\x16\x3d\xe0\xba\x63\xc3\xe7\x36\xe8\xc6\xe0\x88\xd9\x53\xea\xab\xf6\xed\xa3\x7a\x08\x77\x4e\x3d\x2c\x97\x64\x37\xdd\x2a\xc5\x8e\x4c\xef\xc4\x0c\xc6\x09\xc2\xf6\x2b\x21\x41\x16\x81\x37\xc5\xac\x16\xab\x22\xfb\xfb\x4a\x19\x41\x01\x8f\x29\x30\xda\x81\x19\x2f\x71\x59\x78\xde\x79\xe8\xb4\x64\xd7\x48\x66\x14\x49\x18\xa9\x5e\xd3\x36\x01\x3d\x61\x9f\x83\x60\xda\x72\xd0\xc4\xe8\x03\xa9\xb2\xab\x88\x62\x83\x81\x0c\x9f\x15\x14\x88\x05\x34\xf8\x7a\xac\x7b\x97\x0c\xf7\x6f\x00\x0f\x0a\x38\x24\xab\x0c\x00\x63\x7a\x03\xc2\x40\x76\x67\x19\x3f\xd7\x08\x29\x9e\x46\xed\x84\xbe\x64\xf5\xcb\x72\xb1\x70\xdb\x06\x8c\xf5\x52\x71\x20\xa2\x09\xa6\x47\xf6\xc3\x18\x3f\xb8\xb3\xd0\x26\xd7\xef\xab\x6c\x91\xe9\x3b\x42\x6c\xeb\xe3\xd6\x7f\xb1\x09\x25\x6a\x3f\x5a\xb4\x45\xdd\x74\x7d\xc2\x3e\xb5\x2d\xd2\x6d\xf5\xaa\xf2\x88\x8d\x5d\x22\x3b\xfc\x48\x30\xa5\x0a\x8c\x5a\x90\xff\x44\x4b\xf8\xa1\x67\xc7\xd7\x29\xf3\xc2\x98\xf6\x3d\x6d\xcd\x07\xc7\x38\x70\xc0\x99\x01\x3d\x65\x79\xd9\xb5\x1f\x58\xd1\xbe\x63\x33\x0b\x59\x5d\x8b\x9b\x01\xdc\x77\x29\xc9\x44\x05\x0b\xc5\x54\x34\x88\xd3\xa7\xc6\x5c\xaf\xc4\xf2\x46\x55\xc4\x80\xd0\x8d\x88\x16\xac\xbd\x4d\xdb\xad\xe2\x3a\x3e\x4c\xdd\x15\xdc\x1c\x5d\xe1\x87\xf0\xf5\x36\x65\x87\x74\x8a\x28\xcc\x68\x7a\x30\x2f\x6f\x23\xff\x35\x9a\xd1\x6b\x29\x7c\xd8\xd6\xa5\xdc\x76\xa0\xec\x89\x61\x25\x2d\x3f\xbc\xd7\x2e\x13\xe4\xda\x72\x1c\xcf\xd6\x92\x1c\x3a\xb2\x61\xc4\xe2\xef\xfe\x2b\x64\xea\x86\xd1\x83\xa5\x70\xe1\xe2\x12\xef\x5a\x22\xdd\xea\xe7\xcd\x5f\x69\x69\xb8\x5d\xd5\x0f\x0e\x39\x17\xf2\x58\x53\x21\x36\x1a\x72\x6d\x30\x9b\xa0\xf8\x7f\x05\x8b\x1a\x6c\x43\x46\xc1\xf1\x6f\xa2\x1e\xba\xd2\xf5\xae\x51\xc3\x85\x31\x66\xdc\x18\xb7\x76\x80\x09\xd0\x91\xf2\xd8\x67\xa3\x11\x45\xc7\x0f\x75\x27\x4c\x93\x87\x82\x04\x3b\xcf\x03\x1b\x85\x36\x5f\x76\xa7\xe8\xdf\x57\x6a\x65\xa2\x8d\x22\x62\x0c\x7a\x12\x4c\xb3\x22\xab\xe7\xaa\xf6\x0f\x76\xc3\xf3\x5b\xd6\x37\x81\xc1\x1c\x75\x39\x11\x91\x73\x3b\xda\x62\x33\x87\x92\x34\xa7\x6e\x1f\x68\x82\x2c\x7b\xd3\xb2\x9a\x28\xb4\xd7\x89\x3b\xad\xe7\x1e\x77\x0c\x79\x76\x6e\x6e\xfc\x48\x48\x0a\xdf\x1e\xbb\xc4\x62\xa0\xe5\x77\xc3\xdd\x68\x3e\xf0\x83\x53\x78\x6f\x8f\xd6\xed\x51\xa5\xd6\xcd\xd1\x7d\xf1\xfc\xa1\x02\x70\x53\xaf\x18\x01\x00\x6f\x18\xff\x94\x0d\xfe\x2b\xc2\xb1\x68\x39\x6a\x6d\x2b\xe9\x63\x2b\x3c\xba\xb4\x9b\x77\xe7\x21\xe6\xcd\x7b\x4e\x5e\x64\x5e\x5f\xf4\xb5\x1c\x86\xa2\xad\xc8\xdd\xe1\xf7\x66\x26\x8e\x7c\xf9\x4a\x05\x65\x0e\xc1\x29\x1a\xb5\xa8\x7d\x85\x24\x48\x02\x6c\xdf\x8a\x55\x8d\x96\xc1\x4a\xfc\xfc\xeb\xeb\xf7\xa2\x51\x93\x39\x88\x24\x43\xa8\xe6\x47\xb2\x13\x06\xe1\x24\x2d\x17\x51\xed\x2e\x5b\xb4\x87\x64\x3e\x2b\xab\xac\x99\x2f\x34\x53\xeb\xad\x34\x3b\xba\xca\x55\x4f\x0c\x44\x36\x54\x50\x79\xd6\x58\x3b\xc1\xd9\x4a\x56\xb2\x68\x94\x51\x8c\xe5\x12\xec\x65\xcd\x13\x29\x58\x0c\x4d\x49\x60\x49\xed\x05\x29\x21\x4d\x1b\xec\x05\x11\x5e\x49\xf4\x59\x44\x6d\xc9\x1a\xd6\x3e\x08\xb8\xd4\xdc\x96\xa0\x98\x51\xf5\x98\x94\x7e\xba\xe2\x69\x56\xd5\x0d\xa4\x27\x9a\x2e\x09\x48\x32\xcf\xe9\xae\x89\x16\x5f\x04\xf9\x74\x65\x32\xa4\xd0\x0e\xf6\x5e\xdb\xcc\x55\x56\x59\x79\x8a\x3d\x56\x98\x7a\x6a\x35\x29\x49\x33\x04\x15\xa1\xf9\xef\x48\x13\xc3\xd6\xd0\x31\x3a\x05\x4f\x8f\x54\xd5\x59\x05\xa1\x97\x64\x63\xe4\xb9\x5b\xb9\xee\x20\x66\x1a\x84\xf1\x52\x53\x13\xc4\xbd\x52\x0b\x99\x15\x64\x0e\xd9\xcc\xd5\xda\x59\xb4\x0f\x49\x3b\x4e\xea\x65\xc3\x7d\x77\x84\x68\xe4\x8c\x2b\x50\x2d\x7c\xb3\x49\xdc\xf9\x92\x84\x5a\x04\x2d\x52\xa4\x2a\x57\x0d\x06\xc3\xb7\xb7\x39\x5f\x3d\xf1\x0b\x4c\x0e\xea\x28\x48\x1b\xb1\x44\x1d\xc4\xe3\x35\x0e\x72\x66\x24\x1f\xba\xb0\xb3\x0b\x0a\x1d\x2a\x98\x1e\xd5\x4b\x69\x90\xd5\xe1\xae\x29\x75\xd5\x64\xf5\x82\x8f\xd4\x80\x88\xc8\xc4\xab\x65\xa5\x6e\x5e\x06\xaa\x8b\xae\x34\xb3\xa0\x8e\x5a\xd7\xb9\x8d\x3a\x0f\xdd\xa2\xb3\x8b\xa3\x8d\x1a\x12\xf6\x9b\x67\x6b\x7c\x15\xe8\x03\x62\x22\x14\xeb\x0a\x57\xc7\x9a\xd7\x15\xae\x8e\x9a\x24\x8e\x79\x46\x16\x8b\x10\xa4\x38\x50\xe3\x05\xa7\xc2\x04\x04\x64\xc2\x16\xd2\xd2\x88\x91\xad\x7d\xb9\x3a\xba\xbc\xbc\xd1\xec\x27\xc2\xc8\xd8\x4f\xfd\x33\xc3\x6a\x30\x10\xca\xdc\xf3\x00\x5c\xc8\xe5\xd9\xc4\x78\x42\x4f\xd8\x87\xa3\x78\xc2\x44\xeb\xcd\x62\xb5\x3f\x80\xec\xb4\x6c\x7b\x27\x92\x0e\x19\x14\xc8\x9d\x0a\x74\x9e\x91\xa2\xfb\x41\xfe\x13\xb0\x38\x09\x34\xe8\x26\x5e\x08\x7c\x31\x4b\x32\xea\xeb\x3b\x83\xfe\xc9\xe9\x81\xb2\x21\xee\x75\x68\x56\xec\xaa\x80\x58\x5c\x57\x4a\x5f\xe6\x54\x3a\x16\x3f\x70\x37\xba\xe7\xbd\xfe\x16\xb3\x40\x2b\xf6\xf2\x85\xec\x4f\xfc\xb5\x5a\x36\x6c\xdd\x19\x68\x75\xe4\xb8\x3c\xdd\x2d\x2d\x50\xfa\xe9\xc5\xb5\x77\x08\x90\xdc\x8e\xb4\x5b\x5f\x7b\x87\x7e\xe8\x4b\x58\x63\x50\x90\xe7\x3f\xcb\x48\x05\x4d\x8b\x61\xef\xb0\x43\x34\xdc\x34\x8b\x36\xf7\xb2\x84\x69\xdb\x3b\x04\x87\x86\x99\x6a\x7e\x2c\x57\x70\xb7\x7d\x99\x67\xaa\x68\x3e\xa8\x49\x13\x07\x56\x97\xb0\xb4\xf6\x0e\x61\x71\xf9\x0b\x52\x8f\x07\x2d\xc6\xbd\xc3\xfe\xd6\x35\x43\xa3\xd4\x99\x7b\xc7\xff\x17\xb6\x39\x0d\xf5\x3c\x6e\xe4\x2c\x11\x08\xc8\xaa\xd3\x6c\x39\xc8\xe5\xc6\x9e\xfe\x3a\xea\x10\x05\x3c\x1a\x56\x8f\x6a\x1f\x6a\xd1\x96\xfe\xb7\xd0\x58\x80\x27\x7b\xde\xe6\x78\x92\x42\x7d\xfa\xe8\x5a\x1a\x8c\x39\x14\x7a\x2f\xc1\x22\xe7\xf2\x32\xe6\xcd\xbc\x64\x2f\xbb\xac\x7f\x26\x01\x04\xd8\x04\xc4\xf7\x75\x5a\xc9\xc6\x99\x46\x6b\xc1\xd9\xe1\x44\xbd\x2b\xf2\xb5\x88\x5d\xe8\xdd\x44\xc8\x9b\x32\x4b\x6b\xb1\x2a\x5c\x20\x0b\x9d\x91\x74\xbb\x06\x5e\xd9\x35\xc1\x30\x51\x5d\xfb\x51\x60\x28\x91\xb6\x2c\x25\x52\xdf\x15\x28\xe4\xc4\x7c\x71\xb2\xd3\xa3\x74\xf8\x22\xa4\xae\x34\x09\x9a\x03\xa1\xf2\x83\xc7\x08\x12\x07\x22\x1a\xe8\x4c\x51\xbf\x43\xdf\x3d\x74\xfe\x83\xbb\x50\x74\x2e\xeb\x37\xa5\x51\x59\xa0\x32\x50\x2f\xe5\xc4\x55\xdc\xd2\xbe\x05\xca\xa4\x5b\x7d\xf7\xbe\x31\x66\x4e\x14\xe5\x03\xa4\x89\x4a\x29\x91\x97\xfa\x98\x6f\x4a\x1c\x5b\xb1\xc8\xee\xf4\x2c\xff\xf4\xee\x8d\xa8\x94\x4c\xf1\xb9\xea\xb6\xca\x1a\xa7\xff\xc9\x0a\xa1\xe4\x64\xae\x45\x46\x72\x94\x1d\x88\xdb\x79\x36\x99\x8b\xb9\xca\x97\xb5\xb5\x56\xcb\xe5\x1a\xf4\xf2\xf3\x4a\xd6\x73\xab\x28\x72\x5a\x77\x0a\x02\x36\x91\x79\xfe\x1e\xf5\x4d\x2f\xaf\x8c\x4e\xa3\x95\x0b\xf1\x08\xde\x97\x0c\xe6\xa6\x23\x17\xe8\x17\xe1\x08\xcc\x8d\xe7\x79\xb8\x8c\xc1\x8d\xad\x29\x05\xd9\xce\x55\xeb\x06\x8c\xcf\xb3\xc2\x4a\x96\x56\x59\x59\xd7\xd9\xac\x70\x9a\x35\x33\x44\x57\x4a\x17\x30\x5b\x51\x4b\x5c\x95\x52\x83\x7a\x2e\xaf\xcd\x42\x1e\x8d\xc4\x9e\xae\x67\x90\x66\xb5\x96\x70\x07\x79\x46\x37\x64\x5c\x9b\xd4\x0a\x66\x1b\x77\x55\xa6\xeb\x61\x39\x9d\xd6\xaa\xf9\xb3\xca\x66\xf3\x86\x1a\xde\xea\xa1\xff\x48\xe1\x1d\xc3\xc0\xef\xa0\x51\x21\x73\x05\x97\x90\x89\x89\x84\xea\xd2\xc9\x75\xc0\x86\xdb\xc5\xff\x36\x60\x0c\xb8\xf5\xe6\xb2\xd6\xc3\xc6\x41\x78\x8b\x7a\xc8\x62\xef\xd8\xb4\x36\x76\xb8\x38\x16\x51\xb4\xdd\xed\xa8\x1d\xfe\x22\x01\xb3\x0e\x68\xc4\x15\xbf\xfd\x4d\xae\x58\x20\x0a\x37\x1c\xbb\xb0\xdf\x46\xb6\x79\xe6\xed\x51\x0d\x4d\xd8\xa5\xb7\x3c\xd8\x36\x6b\x4b\x57\x7c\x8c\xae\xe6\xb4\x20\x09\xbc\xf6\x39\x70\x16\xff\x44\x78\xec\xc8\x7a\xa8\x06\xe1\xcd\xaf\xef\x78\x19\x3d\x12\x8f\xa9\x1f\xc4\x2d\x18\x6b\xa3\x14\x11\xd4\xe4\x74\x3d\xdb\xbd\x8d\x60\x2c\x3b\xe2\x3e\x71\x7e\x63\x7d\xba\x79\xb3\x1f\x41\x17\x37\x03\x35\xb0\x83\xac\xf7\x3d\x24\x3f\x12\x3f\xa1\x4f\xce\xed\x5c\x35\x73\x55\x09\x59\x58\x24\x41\xeb\xd1\xc3\x02\x35\x1a\x37\xdd\xb9\xac\x1d\x89\x97\x1f\x3f\xf2\x1b\xee\x50\x7c\xb4\x31\x67\x0d\x2d\x74\xc5\x37\x86\xa2\xb2\xb0\x7a\x6f\x47\x85\x49\x24\xa0\x1e\xcd\x6a\xa1\x19\x8b\x58\x94\x0b\x58\x29\xb7\x0a\x63\x2d\x49\x8a\x76\x5b\x4e\xf5\x3d\x57\x33\x56\x5c\x13\x8e\x92\xbe\x56\x96\xd0\x1b\x46\x73\x42\x91\x81\x4c\x0f\x9a\xd2\xbc\x0f\xc0\x2b\x9d\xdf\x4f\xa6\x87\xab\x9d\x93\xb1\xdb\xf2\xd8\x82\x63\x8c\x9a\x58\x16\x68\x74\xe7\xf9\x03\x6d\x8d\xee\x63\xd6\x79\x3b\xdc\x50\x07\x83\x82\x98\x41\x5e\x98\x1f\xa8\x92\x22\xfd\xb4\x96\x35\x8b\x51\x44\xf9\xda\x1b\x03\x3e\x3c\x60\xf3\x86\x97\x2c\xcd\x55\x96\x4b\x55\xa4\xa4\x9e\xd5\x05\xfb\x5c\x8e\xcd\x8a\x69\xd9\x19\x23\xc8\xcf\x6a\xa9\x51\x3f\x3a\xa8\x19\xf7\x7f\x6f\xbd\x82\x07\xfe\xb4\x1c\xf2\x20\x01\xe6\x99\x27\x34\x08\xf0\x8f\x40\xcb\xda\x1f\xf0\xae\x99\xa0\x8f\x2e\xb2\x1c\x0b\x6c\xc5\x13\x63\x1b\x6b\xe9\x71\xa4\x2c\x64\xbc\x47\x2b\xc0\xa5\xf3\x23\x46\xf1\x53\xd9\x36\x9c\xce\xa2\x42\xdd\xbe\x47\xf1\x7b\xbb\xf0\xed\x39\xef\x04\x27\xb8\xa5\x49\xda\x62\x43\xd0\x08\xf7\x47\x2e\x3c\xb3\xf7\x09\x7f\x9b\xaf\xe9\x1d\x6a\x98\xdf\x97\xf5\x30\x57\xd3\x46\x0c\xa8\x00\xfc\xb2\xb9\xd6\x2e\x57\x53\x2e\x5d\xa6\xa6\x5c\x1a\x3b\xa9\xf4\x0e\xc2\xd7\xaf\xdd\x18\xb9\x63\xd7\xd3\xfc\x9a\xf3\x15\xfb\xce\x8e\xd8\x87\xcf\xcc\x5e\x43\xfd\x57\xb1\xbe\xce\xa5\x77\xfa\x32\xb7\xbc\x4b\xe0\xc7\x1a\x7f\xf4\x7b\x1e\xb5\xd6\xf9\xfa\xb4\x8e\x8e\xba\x6c\x1d\x5f\xba\x57\x53\x54\xb7\xb8\xc5\x3f\x66\x7f\x27\xde\x17\x50\xcf\x8c\xc3\x84\x40\xcd\x08\xaf\x8b\x79\x6e\x6b\x12\xf5\x52\x4d\xb2\x69\x36\x11\xab\x26\xcb\xeb\x9d\xdf\x57\xe0\xe6\x39\xcd\x66\x43\x7d\x69\xfd\xad\x56\x14\xf4\x8e\xfd\x3a\xe2\xb9\xb2\xfa\x83\xaa\x55\x75\xa3\xd2\x53\xd0\xed\x78\xbf\x37\xe4\x7c\xd1\x34\x95\x97\x55\x27\x78\x79\xf5\x7e\x97\x33\x08\xbb\xbf\x94\x13\x0a\xe7\xcd\x53\x02\xca\xbf\x15\xd7\x45\x79\x5b\x10\x06\x00\xd0\xf6\x93\x8e\xba\x7b\x5f\xad\x8a\x26\x5b\xb0\x67\xaf\x5a\xec\xb3\x37\xeb\x1d\x52\xab\xe9\xba\x8c\x66\xc0\x65\x4d\x3a\x6c\x53\xfb\x47\x5d\x65\x1c\xc5\xa4\x63\x8e\xfb\x1b\x1a\x87\xe0\x11\x66\xd3\x41\x87\x9d\xbb\x97\xbd\xd3\x05\x20\x22\x58\x68\x2c\x8a\x52\x4f\x94\xa6\xbb\x5c\x5d\xe5\xd9\x44\x2c\x20\xa0\x0c\x4a\x22\x01\xad\xbd\x05\x05\x9b\x71\xc7\x82\x0d\xe0\xe6\x2e\x7f\xe4\x28\x83\xae\x5f\x00\xe1\xc7\xaa\xfd\xfb\x4a\x55\xeb\x58\xe5\x7d\x31\x76\x31\x14\x8f\x9c\x91\x14\xd4\x60\xbb\x0c\x4c\x58\xcb\x8a\x89\xa3\xdf\x37\x76\x9c\xa9\xba\x69\xca\x32\xaf\xc5\x2c\x2f\xaf\x64\x8e\x88\xc2\x5b\xa2\xb0\xc0\xfb\x86\x69\x0a\x36\xf2\xc1\x68\x64\xb4\x70\x4c\x55\xbe\x90\xde\x4e\x15\xb6\x51\x43\xb5\xc8\x9a\x38\xca\x8a\xac\x89\x12\xf1\x3b\xf3\xd2\x65\xcf\x31\xb6\xd4\x63\x8d\x5d\x3a\x73\x6a\x49\xd8\xcb\x93\xd5\x9e\xeb\x3b\x6f\xde\xa4\x2c\xea\x32\x57\x67\xf4\xef\x10\x8e\xcd\x13\x11\xe9\x7f\x23\x31\x16\x51\x5e\xce\xa2\x8b\x98\x09\xae\xd1\x4f\xe5\x6d\x01\x11\x25\xb5\x68\xf2\xfb\x4a\x89\x9f\xcc\xb8\xc3\x02\xae\xd1\xcb\xb7\x12\x52\x5c\xa9\xa6\x51\x95\x60\x2d\x04\xc4\xca\x2a\x53\xc5\x44\x8d\xcf\x0b\x6e\x60\x24\x44\x34\x6f\x9a\x65\x3d\x1e\x8d\x66\x59\x33\x5f\x5d\x01\x2c\xc8\xcd\x4a\xfd\xad\xd6\xff\x1f\x98\x71\x74\xa6\x00\x1d\x11\xe5\xbe\xce\x52\xe8\xe1\xa1\xa3\xd9\x76\xa5\x4f\xb3\xa5\x87\xb1\x69\xa4\x08\x44\x8e\xa2\x41\x44\x52\x76\x31\x87\x36\x46\x5f\x3b\xe4\xbd\xff\x53\xae\x84\xac\x94\xe6\x39\xf0\x2e\xa2\xc7\x1c\xa1\xc8\xec\xb0\x2e\xca\x54\x0d\xcf\x8b\x1e\x1b\xd0\xde\x1b\x2d\x94\x82\x20\xa9\xef\xb8\xf0\x3c\x07\x51\x69\xa9\x2b\x68\x0b\x05\xef\x9e\xa9\x5a\xe6\xe5\x1a\xf1\x18\x2a\x96\x25\xa4\xf8\x51\x69\x59\x54\x13\xcc\x96\xb5\x16\x86\xcd\x8c\xc1\x34\x0d\xcb\x6a\x36\x9a\xad\xb2\x54\x8d\x90\x20\x5c\x7d\xe7\xcd\x22\xef\xed\x78\x33\x46\xb1\xfa\x9e\xfa\x1e\x5e\xf8\x3a\x08\x4a\xf3\x53\x39\xa3\x28\x7c\x9f\xcf\x3f\xc7\xf1\xc9\x78\x78\x7f\x5e\xf4\x0f\x4e\xfa\xe7\x5f\xce\xbf\x8c\x66\x18\xd5\xb6\x52\x33\x75\xf7\xaa\x9e\xc8\xa5\xc2\xcc\x67\x83\xe1\x93\x83\x93\x4f\x7b\x9f\xbf\xc4\xfd\xfb\xb3\xf3\x8b\xf3\xd1\xf9\xf9\x85\xce\x0d\xd9\xaf\x56\x59\x9e\x7e\xd0\x65\xba\x22\x26\xa7\x2a\xcf\x16\x59\x63\xad\x68\x51\x27\x0c\xca\x21\xf7\xe9\xec\xe9\x85\x8d\xc5\xed\xd5\x9e\x88\xe8\xfc\x7c\x6f\xdf\x05\x94\x9b\xe4\x65\xad\xfc\xb2\x87\x8f\x29\x4b\x3c\xaf\x50\xb7\xe2\x83\x9a\xbd\xba\x5b\xc6\xd0\x88\x03\x11\xd1\x28\xe0\x30\x60\x4c\xf1\xb2\x56\x89\x88\x66\x51\x1f\x63\x1c\x73\xcb\x5d\x59\xd5\xea\x54\x73\x39\xbd\x8a\x1a\x75\x07\xba\x39\xd7\x18\x16\x49\xb3\xa1\xa1\x76\x1f\xc5\x09\x1b\x2b\x6f\x60\xc6\xde\xfc\x1c\xd9\x28\x98\xfa\x17\x5e\xbf\x01\xe4\xa1\x03\x2d\xd8\x84\xbc\xb8\x56\x04\x60\x60\x06\xaa\x92\xb7\xa7\xed\xd4\x5c\xd6\x8d\xf1\x35\x45\xea\x3c\xc5\x06\xd3\x03\x68\x81\x44\xc0\x6b\x43\x82\xd4\x7f\x37\x5e\x4e\xc6\xdc\x77\x41\x38\x4a\x48\x46\xdd\xa9\x09\x35\xd2\x32\x75\xa2\x0a\x19\xf1\xe5\xe2\xc8\x68\x79\x96\xab\x7a\x8e\x86\xa7\x40\xdc\x32\x18\x2c\xf3\xdc\xb5\x93\xa9\xe8\x4c\x87\x50\x35\xec\x1a\xa5\xdb\xa0\xee\x1a\x72\xac\xb6\x25\xa9\xf9\x4e\x93\xdf\xb0\xd2\x01\x5a\x9f\x23\xe6\x47\x22\xd7\x77\x54\x39\x63\x6d\x24\xd4\x5e\xc4\xb8\xaf\xd5\xcf\x60\x00\x5b\xe3\x58\xe8\x85\x88\x91\xe2\x8d\x3e\x95\x55\x18\xf7\x2e\x6b\x10\x4a\x75\xe9\x03\xd1\xeb\xdb\x27\x86\xa0\x5f\x9f\x45\xf4\xbf\xc8\xd9\x27\x1a\x43\x6e\x73\xdd\xe3\x33\x85\xe3\x74\x80\x63\xab\x77\x8f\x0b\xba\x67\x1c\x8e\x5d\xf6\x1f\x70\x7c\x7c\xeb\xd9\xaf\x1d\xce\xae\x4e\x3d\x30\x8a\x5f\x5a\x61\xd2\xad\xcf\xfd\xd8\xd0\xf9\x5b\x99\x15\x71\x74\x10\x91\x27\x3c\xa6\x8e\x5d\xeb\x36\x46\xc2\xb7\x77\x01\x74\x30\xd5\x72\x8b\xe7\x0c\xad\x67\x0a\x4c\x5e\x9d\xd5\x3f\xfc\xbc\xbf\x17\x57\xb2\x56\xff\x2d\xab\xe2\xc8\x45\xb8\x69\xb2\x89\xd1\x45\xcf\x54\xf3\xa2\x48\x3f\xd8\x00\xfd\x84\x16\xe1\xe2\xf3\x3f\xce\x8a\x98\x11\xe5\xba\x71\x8c\xa1\x6f\xd9\x48\xcc\xb2\xd9\x0e\x0c\x19\x6b\x70\x9a\xee\x8a\x2b\x13\x7c\x6b\xda\x1e\xb4\x8e\x10\x0e\x78\x6f\x00\xd8\x60\x2c\xf8\x51\x13\xbd\xd6\xb7\xd2\x65\x49\xb7\x44\xa3\x9e\x69\x9a\x2a\xbb\x5a\x35\x00\xb5\x56\x23\xd2\x9a\x79\x89\xf1\xad\x95\x7f\xab\x95\xb8\x19\x80\x87\x5a\x69\xcc\xd5\xf2\xb2\x10\xf5\xbc\xac\x1a\xb0\x63\x23\xac\x9f\xa1\xf8\xb9\xd4\x9b\x45\x2e\x96\xb9\x66\xa7\x9c\x08\x83\x03\xfa\x21\xcd\x6e\x50\x03\x73\xdc\xfb\xfc\x19\x20\x22\xbf\x7c\xe9\x3d\x4f\xc0\x98\x15\x3e\x8e\xe9\xeb\x8d\xcc\x7b\xcf\x87\x51\xc7\x89\x47\x93\xd2\x31\xe4\xa0\xa4\xe5\xb3\x1b\x2c\x59\x5e\xe4\x88\x31\x54\xa8\xf2\xc7\xcc\xc0\xec\xea\x1b\x38\xfe\x08\x57\x44\x42\x02\xcb\xe8\x89\xce\xf4\x11\xc8\x89\x27\x23\xbb\x50\x38\x21\xd6\xa8\x80\x3e\xff\xd9\x56\x16\xcc\x14\xb9\xeb\x18\xc4\x34\x66\x13\x89\xfa\x60\x83\x65\xd0\x1e\x00\xc8\x76\x70\x2c\x7a\xec\xdb\x18\x8c\x4b\xc3\xfc\x07\xa2\x97\xf4\x38\x03\x09\x9a\xd9\xa2\x38\xf1\x68\xf9\x59\x3d\x62\x81\x1b\x0c\x3c\x90\xea\xdc\xf0\x72\x09\x77\x03\x68\xc7\x5f\xd4\xba\x1e\x8b\xb3\x88\xb5\x2a\xba\xb0\x00\x83\x66\xab\x8f\xfd\x9f\xfa\x3b\x8d\xcf\xd8\xfc\xf1\x30\xbf\xd8\x3b\xfc\x77\x70\x0c\x08\x2f\xbc\x91\x63\x80\xe6\xc2\x71\x0c\x56\x64\x0b\x0a\xa4\xd3\xf4\xf2\xe7\xe4\x6e\xa6\x01\xb4\xb6\x30\x8d\x0e\xb6\x11\x32\x0e\xa1\xd7\xc5\x3a\x57\x1e\xeb\xc0\x6e\x75\xb0\x8e\x7f\x99\x79\xfc\x9b\xd8\x47\x07\x03\xc1\x5e\x6c\x62\x20\xf4\xd5\x67\x20\x1b\xae\x39\x76\x5b\x98\xd9\x0d\x38\x06\xcc\x02\x7c\x0b\xa7\xa2\xef\x03\xdd\x43\xa5\xdb\x59\x08\x2e\x91\x87\x58\x08\x27\xe4\xf1\x35\x8f\x3e\xff\xb9\x91\x85\xe0\xba\x7f\x0c\x13\xf1\x16\x6a\xc0\x44\xe0\x5b\xc0\x44\x28\x7f\x17\x13\xe9\x6a\x3f\xa3\xa8\x69\xc5\x8e\x18\xcf\xab\xe5\xa4\xad\x6c\x04\xb2\x6f\x65\x23\xd0\xae\x87\xd9\xc8\xde\x61\x17\x23\xd9\x3b\x6c\x5f\xa3\x26\x65\xaa\x2a\xba\xf9\xcc\x0d\x0a\x25\x26\xb3\xc7\x23\x4c\x10\xb1\xbe\xa3\xd9\x3e\x63\x59\xb8\x12\xe0\x5f\xf7\xf7\x2d\x90\x0f\x54\x8d\xc5\x51\x9a\xdd\x98\x67\x6e\xca\x3d\xcc\x8a\x42\x55\x7f\x3e\x7d\xf3\xab\x38\x16\x9a\xee\x11\xbb\x0b\xd8\x4c\x5a\x74\x7b\x59\x16\x0d\x9a\xfc\x7f\x09\xdb\x9f\xd5\xbf\x15\xb2\x5a\xa3\x5e\x70\x21\xaf\xd5\x1b\xb9\xd4\xec\x20\x92\x95\x92\x89\x66\x73\xc9\x55\x95\x4c\xca\x3c\x51\x8b\x2b\x95\x26\xd3\x4a\x2e\x54\x32\xaf\x92\x6c\x31\x4b\x00\xc4\x24\xc9\x6a\xbc\x16\x5c\xab\xf5\x4c\x15\x09\x6e\xcc\x28\xcf\x8a\xeb\x64\xa1\x1a\x99\x2c\x65\x25\x17\x49\x5d\xae\xaa\x89\x4a\x9a\x4a\x4e\xae\x93\xdb\xab\x2a\xda\x21\x95\x9a\x01\x38\x45\xfb\xbf\x75\xb9\x12\x13\x59\x68\x59\x5d\x37\x39\x2b\x0b\x99\xe7\x6b\xc2\x65\x87\x7b\xa2\x2e\x13\xc3\x5b\x3a\xbc\x94\xe3\xfd\xaf\x99\xab\x45\xad\x72\x30\x60\x80\xe3\x5a\x16\x3f\xaa\x5f\xd5\xb4\x79\xb7\x54\x45\xbb\x73\x93\x32\x07\xf3\x9f\x24\x4d\x93\xb4\x49\xf2\x2c\x31\xc8\x6e\xcb\xa4\x49\x93\x66\x5a\x96\x4d\xd2\xcc\x93\x66\xae\x64\x9a\x34\x15\xb5\xde\xb6\x59\x0f\xfa\x1f\xf5\x4d\xa0\xb6\xf7\x72\x3d\x01\xc3\x7a\xa9\x26\xc3\xdb\xb9\x6c\x6e\x67\x70\x45\x47\xaf\x33\x39\x53\x23\xbd\x82\x27\xaa\x86\x3b\xfa\x37\xc6\xc4\x72\xf0\x2d\x58\x95\xce\x2b\x09\xf6\xa0\x34\x4d\x8f\x25\x99\x96\x0b\x24\xb7\x24\x02\x83\x09\xcd\x33\x4e\xec\xdb\xb2\x30\xa4\x3b\xa6\x37\x4d\xb5\xe4\x9d\xc8\xaa\xc9\x26\xb9\x4a\xa4\x66\xdc\x34\xdf\x79\x39\xb9\xfe\xfb\xaa\x6c\x54\x72\x55\xa6\xeb\x64\x22\x61\x6c\x60\x11\xf0\x81\xa3\x89\x4e\x55\x23\xb3\xbc\x4e\xd2\x4c\xe6\xe5\x2c\x49\xb3\x9b\x24\xcd\xf5\xa8\x4e\x33\x95\xa7\xb5\xd2\x7f\xcc\x0c\x8d\x69\x36\x5b\x55\x2a\xd1\xe3\xab\xaa\x44\x6f\x36\x43\x66\x7e\x98\xcc\x9f\x25\xf3\x6f\x93\xf9\x1f\x92\xf9\x1f\x93\xf9\x9f\x12\x18\x7b\xfd\x3f\x55\x25\x73\xac\x75\x5e\x25\xba\xc7\x49\xae\x66\xaa\x48\xf5\xbc\x2d\x54\xb1\xca\x1a\xb5\xc0\xa5\x46\xb4\xca\x65\x83\xf9\x71\x56\x69\x05\x56\xcb\xa4\x6a\xcc\x3a\x44\xb0\xe2\x7a\xb5\x58\xc8\x6a\x9d\x34\xd0\xd1\xf6\xcc\x13\xbd\x26\x6b\x72\xbd\x74\x71\xf5\xd2\x2a\xf0\xf1\x7e\x07\x5b\xf0\x7e\x01\x42\x71\x51\xd6\x4d\xbe\x16\x37\xaa\x48\xcb\x4a\xe8\xbd\x69\x11\x7d\x77\x35\x21\xd8\xc7\xef\xf5\x59\x52\x89\x1f\xd7\xe2\x7f\x97\xf3\x42\x7c\x50\x75\x36\x13\xb1\xfa\x5b\x39\x2f\xf4\xe4\x03\xbc\x38\x81\x9d\x02\x72\xe3\xff\x5e\x55\xd9\x5a\xf4\xae\x65\x31\x93\x77\x3d\xf1\x3f\x72\xdd\xd4\xea\x46\xe7\x32\x98\x0d\x50\x93\xce\xfa\xaa\xca\xae\xc5\x8b\xea\x26\x4b\xeb\xba\x2c\x12\xf1\xa6\xfc\x47\x96\xe7\x52\xbc\x47\xcd\xf3\xaf\xd9\x44\x15\xb5\xd2\x45\x09\x37\x58\x55\xd9\xf5\x50\x49\x35\x2c\x54\x33\xaa\x33\x7d\xea\xea\xb1\x87\xe3\xae\x6a\x25\x0c\xff\x56\xef\x98\xf7\x8b\x0f\x6a\xb6\xca\x65\x25\x5e\xd9\xdb\x5d\x8d\xda\x2e\x59\xa1\xdd\xb3\xde\x37\x7a\x07\x3b\x39\x01\x96\xac\xfd\x29\x8e\xc5\xe8\xd3\x79\xfd\x24\x3e\xfb\x74\x5e\xf7\xa2\x1f\x9e\x9f\x8f\x8e\x2f\x0e\xfa\xf1\xc9\x58\x27\x1e\xf7\xf5\xff\x4f\xc6\xbd\xf8\xec\x53\xef\xe2\x49\xbf\x77\x70\x1f\xc5\x67\x9f\xa2\x8b\x27\xfd\xe8\xe0\x9e\xca\x1c\xff\xf0\xfc\xaf\x17\x07\xfd\x7e\xff\x64\x74\xb4\x03\xbe\x5b\xab\x3c\x05\x09\xc0\xec\xae\xdb\xdb\xdb\xe1\xed\xb7\xb0\xa7\x4e\x3f\x8c\x0e\xbf\xff\xfe\xfb\xd1\x87\x57\x2f\x07\x77\x8b\x7c\x50\xc8\x85\xaa\x07\x3a\xe9\xe9\xe1\xe1\x1f\x46\xdf\xbc\x3d\x1d\xfc\xff\xde\xca\x85\x42\x24\x6a\xb4\xf5\xfe\x7d\xa5\x44\xa3\x16\xcb\x5c\x6a\x31\xe7\x16\xdd\x76\x54\x81\xc6\x31\x52\xe0\xf8\x88\xc9\x5c\x8f\x0e\xee\xc8\x62\x42\x16\x8d\xd1\x99\x1c\xfc\xe3\xc5\xe0\x7f\x2e\x2f\xce\xce\xcf\x6f\xcf\xcf\x07\xe7\xe7\xc3\x8b\x27\x11\xea\xda\xfe\xae\x33\xbd\x94\xcb\x66\x55\xe9\xbc\xbd\x58\xf7\x54\x1c\x98\xd2\x5a\x0a\x3b\x1f\xf7\x4f\xfc\xa4\x7e\xef\x88\x8e\x3f\x59\x35\xa7\x72\xf6\x0e\xf5\x69\x4c\xb9\x15\xf7\x3e\x81\x45\x23\x27\xaf\xe5\x13\x5e\xea\x25\xa9\xd2\x70\xec\xcf\x47\x27\xfd\xe7\x23\xcc\xa1\x0a\x7a\x39\x0a\x28\x9e\x9f\x8f\x42\xa2\xba\x39\x67\x9f\x9e\x5f\x3c\x41\x9b\x49\x38\x23\xcb\x09\x85\xa9\x1a\x7d\xfa\x61\xf7\xa7\x77\x2f\x4f\xff\xcf\xfb\x57\x42\x67\x3a\x78\x3e\xca\x60\x7a\xbe\xf9\xaf\x67\xdf\x7f\x37\x16\x0a\x34\x75\x62\x10\x9a\x08\x2d\x65\xad\x52\x21\x6b\xdc\x25\xc6\x85\x07\x14\xaa\x59\x91\x13\xfe\x94\xd0\x5c\x71\x87\x70\x1b\xe8\x89\x49\x57\x78\x3e\x18\x50\x37\x26\x65\x91\x66\x78\xa6\xbc\xf4\xb3\x9c\x8d\xe8\x04\x7f\xfd\xf1\xf2\xc3\xab\x5f\x5e\xfd\xff\x2f\x5f\xbe\x78\x7f\xfa\xdb\x87\xd7\x6f\x7f\xb9\xfc\xf1\xc3\xbb\xbf\xbc\x7a\xeb\xdc\x45\xa2\xbb\xc8\xea\x17\x47\x77\xf1\xb0\x7f\x32\x9a\x71\xef\x83\x45\x22\x48\xac\xd9\x46\x8c\xe0\x5d\xa3\x23\x54\x29\x8e\x46\xe2\xe3\x52\x4d\x32\x99\xbb\x03\x31\xd6\x4b\x8a\x5c\x4c\x85\x2c\xd0\xbe\xaa\x4f\xdc\xfd\x7d\x2e\xb3\x42\x4b\x9c\xee\x3d\xcd\xf0\xf7\xa8\x9e\x54\xd9\xb2\x21\x1e\x67\x01\x95\x2d\x04\x18\xde\x20\x5e\xca\x09\x8a\x2b\x5f\x8e\x98\x28\x93\x15\xb3\x37\xd2\x18\x90\x47\xfb\x79\x73\x14\x8d\x45\xf4\x03\x78\x1d\x47\xfb\x33\xfc\xf9\x9c\x7e\xea\x43\x02\x12\x7a\x94\x20\x17\x4b\xf8\xbd\x4f\xbf\xbf\x39\x7c\x0a\xbf\xcf\x0b\x93\xf0\x3d\xfe\x6e\xa2\x9d\x2f\x66\x69\x69\x0e\x65\x9e\x1b\x47\xfb\xf1\xc9\x38\x6f\xee\x67\xcd\xbd\x26\x7e\x2f\x17\xcb\xfe\x91\x51\x43\xb3\xac\xff\x9d\x35\xf3\xb7\xea\xf6\xd7\xac\x80\x9b\x50\x47\xb1\xfb\x6f\x0e\x9f\xde\x7f\xf3\x3d\x96\x86\x25\xf6\xc7\xef\xbf\x7f\x46\x83\xf7\x1a\x2e\x57\x6f\xd5\xad\x5e\x3b\xfe\xe1\x18\x2d\xab\x4d\x63\x86\x76\xfd\x58\x16\x60\x39\x88\x80\xf7\x28\x07\x16\xa3\x24\xe5\x59\xf3\x1b\x08\x45\xd9\x51\x6f\x0c\x06\xdf\xfb\xfb\x50\xe0\xec\xe9\x05\xae\x89\xf3\x22\x02\xb7\x9b\x9d\x40\x7a\x84\x31\x32\x40\x7f\xd8\x98\x9f\xe0\x03\x11\x64\xd7\x56\xe0\x1d\x5d\x59\xc4\xc9\xc6\x61\x1c\xf3\x2f\x4c\x45\x8e\x88\xaf\x4e\xa3\xee\xad\x75\xd9\x4c\xe6\xdc\x7d\xd0\xad\xa1\x33\xf8\x76\xd1\xc2\x10\x84\xf3\x02\xf6\x31\x08\xc3\x1d\x77\xee\xba\x91\x93\x6b\x4f\x4b\x8d\xe8\x9b\x24\xea\x5a\x38\x43\x9b\x78\x64\x41\x46\x8d\x30\x8b\x10\x57\x26\x27\x13\x72\xef\xef\x45\x51\xda\xb7\x83\x40\x4a\xf4\x4b\xb5\x64\x48\xaf\x6c\x16\xaa\xc8\x31\x6a\x81\xfe\xff\x29\xda\x9e\x93\x5e\x9c\x4b\xfc\xfa\xab\x27\xac\x8f\x46\xc2\xbd\x1d\xdd\xaa\xa8\x52\x10\x60\x46\xef\x77\x8c\x21\x02\x2a\x71\x92\xeb\xac\x25\x15\xc4\x0c\xc0\x4d\x3e\x82\x4d\x6e\x75\x82\xbb\x54\x3f\x58\xbd\xb6\x99\x44\x4c\x9f\xfb\xbe\x75\xba\xae\xe4\x55\x91\x52\xcb\x9c\x4f\xc1\x0f\x91\xa7\x39\xb0\xd9\x42\x07\x83\xd1\x48\x10\x3f\x1d\xbb\x87\x50\x84\xc2\x81\xab\x0c\x3c\x58\xc0\x38\xf4\x5b\xee\x00\x94\xa7\xab\xfa\xc1\xe0\x79\x64\xec\x4e\x5b\x44\x75\x81\xe7\x2d\x47\x07\x8e\x2d\x5a\x0f\x71\x03\xfc\x45\xa9\xa5\x71\xcf\x0c\x32\x0b\xc1\x5e\xff\x61\x80\x50\xe6\x5e\x5d\xe1\xfd\x3e\xfe\x43\xc2\x1a\xd8\xdf\x12\x39\x49\x08\x99\xde\xc8\x62\xa2\x78\xfb\x0e\xc4\xb7\x41\x11\x3d\x93\x59\xb1\x52\xdd\xd6\x89\x3b\x7c\x44\x8d\x18\x56\x0c\x6f\xb3\xeb\x6c\xa9\xd2\x4c\x82\xb0\xa2\x7f\x8d\x5e\xba\x93\xec\x92\x2a\xfc\x06\x1e\x8b\xd5\x8d\xca\x07\x95\xba\x51\x32\x57\xe9\xe5\xa4\x9d\x2d\x98\xa0\xf0\x40\x7c\x60\xae\x6c\xf6\xae\xf9\xba\xe8\x9e\x2e\xaf\x4c\xd7\x94\xb9\x91\xf3\xb2\x1e\x88\x67\xff\xfc\xe8\xfd\x84\x32\x87\x5b\x8f\x4c\x10\x79\x43\x0f\x55\xd0\x7a\xe0\x52\x31\x7d\x09\xfc\x17\x78\x7e\xbf\xd1\xa6\xc9\x3c\x87\x7b\x75\xf1\x9a\xdd\x6a\xb4\xdf\x50\xdd\xd3\x46\xce\xfc\x86\xa2\xbc\xd5\xd1\x4e\xfc\x10\x34\x93\xe5\xee\x98\xb3\x55\xe5\xbd\x11\x1d\x75\xf4\x82\x11\xd8\xd0\x09\x60\xda\xaf\x20\x9b\x97\xfb\xf0\x22\xb1\x35\x98\xf7\xb5\xaf\xe8\x3c\xe2\xbb\xb6\xba\x6f\x04\x52\x33\x00\xa4\x62\xc3\xc4\xd0\xc9\xc4\xcb\xec\xf7\x1f\x7d\x9a\x6d\x41\x3f\xe7\x51\xb0\x50\x37\x9d\xee\x86\x67\xd2\xa9\xbe\x69\xf1\x1e\x6e\x30\x39\xee\x1c\x83\x60\x2c\x0c\x0f\x16\xc7\x22\x06\xa9\xf7\x69\x3f\x11\x95\xaa\xfd\x84\xc2\xcf\xd1\xc5\x99\xc3\xdd\x45\x34\x90\xa9\xc1\xf3\x1d\xe5\x64\x8d\xa5\x63\x8a\x35\x78\x17\x67\x98\x20\xa6\x54\xdd\xf4\xb9\x85\x8b\x10\xbb\xfc\x9a\xb1\x39\x97\xc7\xfd\xbb\x33\x74\x73\x1f\xc8\xeb\x94\xb2\xde\x80\x8f\x46\xe2\x07\x90\xf5\x21\xc0\x16\xbc\xb7\x8b\x2b\x70\x52\x9d\x65\x37\x08\xe7\x9b\x8a\xa6\x52\xb2\x01\xcb\xe0\x5a\xd8\x30\x65\xf8\x1f\x0d\xa2\xae\x82\x9f\x73\x89\x38\x6c\x2d\x08\xc8\xfa\x03\x0c\xa8\xb8\xaa\x94\xbc\xf6\x66\xd5\x0c\xf9\xc1\x31\xd0\xe4\x85\x1f\x33\xea\x8e\x14\x4d\x7c\x70\xf0\x3c\x4d\x44\xbb\x90\x59\x6b\xe1\x17\xbb\x90\xf8\x5a\xf8\xc1\x5f\x0a\xac\x1a\x47\x50\xff\xf2\x7c\x08\x3c\x4a\xf6\x6c\xd4\x97\x58\xc0\x7c\x55\x77\x4d\x1b\x9b\x9b\x32\xc4\x1d\xe1\x61\x02\x77\x2e\xc7\xda\x28\xd2\x19\x89\x4f\x42\x30\xc1\xcf\x18\x29\xd2\xb6\x1b\x36\xe5\xaf\xe5\xad\xaa\x5e\xca\x9a\x99\x57\xa3\x8c\xfb\x91\xe7\xa7\x1b\xcd\x99\x23\x72\x01\x7e\x43\x5d\xe9\xde\x45\x36\x8a\xcf\xce\xcf\xeb\xf3\xf3\x8f\x17\x4f\x4e\xfa\xf1\x0f\xa3\x08\x1f\x3e\x0c\xe5\x03\x11\xe1\x7d\xb6\x1f\x25\x22\xca\xa2\x7e\xd0\x88\xba\x01\xa9\x11\xe6\xcf\x09\xc9\xae\x69\x5c\x5c\x96\x79\x9e\xd0\xa2\x25\x4e\xee\x21\xa3\x7a\x23\x43\x9b\xd0\xbd\xe3\xbb\x89\xe9\x92\xec\x5c\x8b\xfb\xf4\xda\x6c\x3a\x80\x11\x10\x4b\x94\x17\xa3\x00\x13\x01\x57\x45\xb0\x49\x84\x70\x17\x5c\xb8\x3f\xc7\x67\xe7\x35\x8e\xcf\x60\xf0\x5c\xdf\x76\xa3\xbd\xc3\xa8\x2f\xcc\xc5\x7d\x73\xd1\xb3\x97\x3f\xbd\x38\x7d\x71\x7e\xe6\x08\x5c\x5c\x38\x02\x5d\xdb\x61\x2b\x2b\xae\xd9\xa8\x7a\x06\x30\xad\xde\xd0\xc6\xeb\xf2\x16\xec\x58\xdc\x3e\x99\x2d\xcb\xba\x8d\xef\x2e\x22\xf3\x7e\xe4\xac\xf0\xc9\x18\x83\x16\x05\xf9\xa1\x0d\x68\xad\x04\x33\x4a\x5b\x10\xbf\x99\x44\x7e\xdc\xf2\x3e\x23\xe1\x81\xb7\x54\xfc\x63\x97\x79\xa3\x22\xe5\x63\xdc\x49\xae\x87\xad\x5d\xed\x77\x17\x0e\x39\x7e\xb6\x3c\x6c\xd9\xb0\x0b\x4d\x34\xfd\x64\x14\x6f\x65\x55\x74\xf1\x0b\xf2\xc6\x7d\x23\xf3\xc1\xb4\xac\x16\xb2\x69\x14\x08\x41\x42\x36\xba\x6b\xa2\x9c\x5a\xd5\xda\x58\xe0\x4b\x24\x74\x06\x9e\x20\x99\x2b\xae\x99\x0b\xe0\xce\xec\xf5\x1f\x01\x23\x5f\xe6\x4a\x16\x62\xb5\x14\xb2\x58\x93\x97\xbe\xd1\x3e\xee\xf8\x43\x8c\x52\xab\xb3\x9c\x47\x36\x2b\xe2\xc2\xb7\x5c\xd2\xac\x1e\xab\xa6\x49\x0b\x78\x76\xd1\x15\x7a\x98\x8b\x2e\xbe\x57\x24\x9c\xa2\xbe\x78\xc7\x0f\x56\x66\xe9\x01\xc9\xfe\x95\xcd\x18\x5b\x31\x06\x8f\x96\xd7\x63\xa4\xab\x25\x33\x77\x6e\x34\x4d\x55\x8f\xc5\x19\x4b\x82\x4c\x63\xec\x98\x19\x4d\x33\xac\xe6\x98\x41\x42\x6d\x81\x90\xd8\x78\x02\x74\x4d\x22\x89\x11\xbb\x5a\x3c\xec\xee\x14\xa8\x17\xfb\xc0\x9d\x62\x89\xea\x1e\x96\xcb\x2a\x7f\xfb\xde\xa6\x36\x6d\xd1\x9f\x3b\x65\x53\x34\x26\x83\x1e\xa2\x0d\x92\xfe\xb3\xb5\x42\x48\x4c\xe6\x94\xb1\xe0\xaa\x90\xd5\xfa\x63\x2e\x6b\x62\xb9\x67\xdc\x53\x9a\xc9\xc7\x5b\xea\xc6\x0e\x07\xc2\xb5\x31\xa9\xd6\x39\xfc\x23\xb1\xb5\x44\x7c\x29\xd5\xe9\x54\xec\x42\xa1\x99\xb5\x96\x73\xf4\xdb\x79\x47\x78\x9d\x08\xfb\x75\xe4\x38\x82\xd3\x98\xf8\xc6\xd4\x46\x69\x00\xda\xa7\x25\x85\x7e\xf7\x9f\x8f\x62\xaa\xd4\x9b\x1c\xbe\x87\x8c\x66\xa1\x6b\xe4\x3b\xf4\x2c\x96\x1e\x44\x13\x66\xf5\xdb\xf4\xee\x6a\xcc\xe7\xf0\xa5\xde\x1f\x0b\x72\x2e\xb0\xba\x20\x57\xdb\xfd\xbd\xd8\xdd\x6d\x0d\x0e\xe8\x6f\xec\xd8\xe1\x62\xe2\x6c\xda\xbc\x4e\xd4\x24\x39\x60\x58\x1a\xc3\x27\xbb\xe0\x20\xf2\x0e\xf4\x07\x59\xcd\x6a\xbf\x12\x06\x00\x01\x10\x4c\x93\xeb\xac\x9e\xa3\x63\xb1\xac\x20\x7a\xd3\xcf\x3f\x8b\xab\xd5\xcc\x3e\x5a\x5c\xad\x66\xf0\x6c\x33\x5c\xe0\xf3\x0d\x68\x04\xea\x79\x79\x7b\x79\xb5\x9a\x0d\x27\xb3\xec\x24\x4b\x8f\xbf\xfd\xd3\xf7\xff\xf5\x5f\xdf\xb1\xf1\xdf\xac\x87\xde\xdf\x87\x56\xe9\xd5\x6d\xa5\xe1\x5e\x2f\x42\x8c\xb6\xc1\x61\x08\x46\x01\x79\xbf\x25\x35\x65\x44\x08\x3b\xaa\x51\x82\x3e\x1c\x05\x87\x2c\x24\xff\x61\x53\xfe\x3f\x74\xe7\xff\xe3\xa6\xfc\x7f\x64\xf9\xbf\xb0\x71\x35\xd8\xfb\xa6\x75\xf7\xf7\x86\xbe\xfd\xf3\x8f\x17\x08\x0e\xe1\x49\x9b\x5d\xea\xd1\x63\xb7\xdb\x74\x1b\x64\x64\x87\xe8\x90\x9a\x35\xaf\xd4\xd4\x99\x8c\x9c\x08\x5f\xd9\xe4\x93\xfb\xb9\xac\xfe\x5c\x29\x17\xa7\x6b\xbc\x35\xb7\xe5\xbf\xb4\x34\x3c\xf6\x8e\x80\xb3\xd4\x92\x84\x5d\x97\xf3\x95\x1a\x33\x05\xf1\x56\xfd\xb0\xcf\xe9\x99\xac\x80\x5b\xc2\x4d\x37\x9e\xe8\x64\x7f\x0a\x60\x35\x34\x2c\x89\xc8\x8d\x34\xae\xf7\xa3\x4d\xf7\xc5\xf4\xc4\x1c\x39\xb8\x69\x9c\x64\x64\x77\xba\xf0\x98\x18\x6b\x89\x1d\x20\xff\xcc\xf3\x92\x63\xdb\x18\x8c\x4d\x8b\x9b\x3e\xa1\x9d\x05\x59\x12\xc7\x9c\x3d\xc3\xc0\xd6\xd9\x8c\x7c\x45\x38\x92\x54\x9c\x9d\x16\xe0\xf5\x55\xd6\x41\xd7\x5d\xeb\xed\x11\x6d\x30\x17\xf5\xc2\x35\xa7\x3b\x9e\x0b\xcc\x65\x02\x8e\x0b\x97\x8f\x9f\x1e\x1e\xfa\x65\xc8\x07\x5b\x95\xbb\x41\xec\xba\x23\x39\xd4\x82\x9f\x33\x83\x63\xaa\x0f\xe0\xba\x01\x2b\x0a\x12\xb8\xb4\xa4\x35\x57\xa2\xd6\xe4\x9a\xf5\x52\x6d\xae\x1e\x98\x1c\x62\x80\x78\xe2\xde\x40\x1c\x1e\xe9\xd1\x01\xdd\x03\xfc\x35\x18\x84\x6c\x03\x0a\x9c\x2d\xcb\xfa\x62\xe8\xf5\x02\xa5\xd3\xb0\x5f\xbe\x30\xee\x64\x3b\xd1\x86\x5e\xf1\xaf\x97\xa3\x91\x78\x3d\x15\x45\x09\x3d\x83\xa7\xd1\xac\x16\x4b\xc4\xec\x4a\x13\x31\x01\x79\xb0\x9e\x97\x4b\x23\x65\x43\x6f\x9e\xb6\x96\xa0\xe9\x8d\x07\xe3\x87\x8f\xa3\x32\xcf\x29\x8c\xa2\x2a\x1c\x52\x96\x16\x32\x61\x18\x75\x3f\xf9\x78\x99\x43\xa1\x3d\x62\x99\xae\x61\x59\xd6\x47\x22\x6b\x8f\xd7\xe3\x5d\x92\x84\x88\x33\xf1\x1c\x7a\x02\x38\x17\xee\x50\xed\xb8\xcf\x68\xb1\xdb\x26\x77\x5f\x79\x42\x6b\x40\x21\xe2\x9e\x1e\x4d\x78\x3b\xa6\x79\xcc\x2e\x10\xa7\xe8\x40\xf4\x9e\x83\x7d\x1f\x0f\x3d\xa8\x50\x9d\x39\xec\xf1\x08\x7f\x0f\x5e\xc2\x02\xd1\xcc\xb5\x47\x15\xa9\x57\xab\xb7\x3d\xdb\x54\xad\xfa\x02\xac\x10\x10\x76\x2d\x9c\x2d\x31\xad\xca\x45\x6b\xba\xbc\x19\xc2\x99\x69\xb1\x2c\x3d\xca\xe6\x6a\xad\x57\xb3\x9e\xc9\x0b\x87\x34\xc4\x9c\xcb\x3a\xf6\xaa\x9e\xc4\xab\x2a\xf2\x05\xaf\x0d\xcc\x6e\x23\xbb\x3b\xbb\x30\xd1\x9c\x3a\x86\xe1\xcb\x23\x5b\xb1\xfc\x37\x34\x82\x80\x67\x36\xb6\x62\xeb\xf4\xf2\xc9\xed\x62\xbb\x6d\x2b\xcc\x96\xd9\x5a\x59\xa0\x23\xd2\xa7\xff\x75\xff\xe9\x66\x50\x16\x63\x7a\xe1\x4f\xb3\x8a\x3e\xdc\x0c\xee\xf5\x47\xf3\x61\x5a\x56\x2f\xf2\x4c\xd6\xf8\x35\x3e\xfb\x74\xf1\xe4\xa4\x7f\x5e\x1f\xc4\x27\xe3\xac\xb8\x2f\xa7\xf0\xb7\x4e\xed\xbb\x02\xaf\x01\x96\xa5\x24\x8a\x49\x7c\xf6\x29\x39\xff\x72\x7e\x71\xf1\xa4\x1f\x9f\x8c\xf9\xcf\xfe\xc9\xde\xc8\x98\x52\x54\xd9\x12\x00\x9e\xa9\xa6\x4f\xe7\xf1\xfd\x79\x7f\xcf\xfa\x46\xc9\x8a\xfc\xad\xc6\xf1\xf0\x49\xdf\x14\xbb\xca\x8a\x94\xf2\x8f\x75\x87\x20\x00\x0c\x7d\x33\xa1\x8a\xc9\x4d\x6b\x78\xf6\x69\x78\x71\x60\xe9\xe1\xc1\xaf\x45\x7a\xd0\x72\xa5\xce\xe3\x6a\xae\x86\xf8\x91\xcc\x0b\xd0\xc1\x6e\x55\xc9\xab\x5c\x21\xda\xdd\x8e\x31\x70\xde\x7b\x46\xa3\x67\x2d\x86\x8f\x5c\x84\x14\x7d\x43\xa7\xdf\xcb\x4a\x9d\x86\x49\x65\xdd\xb4\xd2\xc8\x39\xf6\x75\xfd\xbe\x52\xf0\x18\xca\x53\xdf\x70\x27\x65\xfe\xe1\x97\xd0\x7b\x98\xfb\x59\xa1\x35\xe4\x8b\x8f\xa7\xc6\xdc\x21\x46\x14\xbd\x84\x07\xa8\x27\x40\x70\x0f\xf9\x1f\x97\x1d\x86\x64\x39\x24\x9f\x12\x92\x5b\xf0\x17\x94\xfe\x35\xab\x9b\xb1\x23\x44\xa9\x6f\xe4\x72\x0c\x86\x01\x2f\xe8\x17\x5c\x2e\x6b\x72\x4d\xc1\xda\xc6\xf4\x2f\xa6\x19\x94\x19\x7d\xd9\x76\xeb\x16\x2c\xc5\x5e\x96\xc5\x8d\xaa\x1a\x34\x61\x41\x8d\x81\x68\x4a\xf1\xe2\xe3\x29\x1a\x82\xf9\x62\x09\xb9\x93\xa1\x12\x44\xd3\xa6\x4d\x43\x9d\xc3\x59\xdb\x6a\x98\xae\xc7\x23\x98\x08\xef\x49\x9c\x92\xec\xa3\x76\xc7\xfc\xb0\xfc\xdc\xd1\xbc\x55\xe4\x97\x96\x27\xb8\x29\x16\xfa\x88\x53\x51\x6e\x7c\x8b\xc1\xfa\x57\x93\x6b\x0c\xcb\x6f\x43\xef\xdb\x9a\x31\xca\x7e\x42\xc1\x16\x8c\x81\x2e\x6a\x0f\xbd\x15\xf9\x68\x42\xbc\x14\xa3\xe5\x2d\xe5\xc7\x13\xe3\xc5\x0c\x35\xcf\x07\x90\x8d\x07\xdf\x61\x1b\xad\x1d\x96\x15\xba\xdc\xff\xb7\x0d\xb9\xc0\x28\x74\x7c\xb4\x5e\xb2\xd6\xd5\xaf\x2c\x2d\x14\xc3\x64\x55\xe9\xe5\x89\xa0\xf3\xce\x78\xe1\xf7\xf7\x60\x22\xe2\x15\xcb\x8a\x8e\x44\xbd\xb0\x80\xad\x50\x2a\x17\xa0\xf5\xb7\x77\xa0\x29\x5b\xd4\x33\xee\xba\xbd\x8b\xa5\x7c\xf7\xa4\x00\xd6\xc1\x2c\x62\x28\xbb\x49\x44\x07\x91\xd5\x6e\x79\x3a\xc2\x19\xa4\x1a\x58\x5d\xea\x01\x23\x8e\x26\x2c\xf4\x0a\x06\x57\x58\x56\x4c\x9c\x6c\xf7\x9a\xbb\x32\x87\x9b\xc5\xd2\x68\x3c\x7b\x89\xd6\x20\x31\xd7\x40\x04\xe5\xd7\x0b\x62\xe0\x96\xf7\x46\x15\x81\xbf\xde\xba\x41\x23\xfd\x3c\x67\xd9\x85\x69\x95\x33\x9b\x09\x86\xce\x9a\xd7\xc4\x96\x7b\x10\x35\x3d\xdc\x63\x1a\x74\xe4\x56\x4e\x1f\x34\xee\xb0\xaa\x31\x11\xc4\x8c\x1e\x65\xdc\x61\x4f\x43\x5c\x2f\xd0\xef\x8c\x37\xda\xd0\x60\xfe\xae\xbb\xe9\xf6\xab\xf1\xe6\x72\x74\xc5\xde\x5e\x9c\x32\x71\x2a\xcc\x2a\x64\x1c\x5a\x81\x98\xea\x50\x47\xea\xa2\x3d\x63\x34\x52\x10\x43\xf9\xc5\xd3\xbb\x27\xe0\x8a\x2c\x0c\xc7\x63\x91\xcf\xb3\x62\xae\xaa\xac\x31\xb1\x23\x8a\x1a\x23\x50\xa8\x0a\xe3\xa8\x5b\xe4\x6f\xb0\x15\xad\x01\xde\x9e\xef\x5d\x80\x73\xe7\x09\xc3\xa2\x06\x7d\xd6\x06\x2e\x0c\xb6\x65\x47\x3b\x5c\xbd\x04\x08\xdf\xaf\x5f\x89\xfa\x66\x26\xae\x56\x16\x77\xe9\x61\x7c\x29\x08\x3f\xbd\xbf\x0f\xcd\x02\x64\xf8\x9b\x99\xf7\x90\x64\x74\x63\xb3\x95\xac\xd2\xd7\xaf\x3e\xfe\xfe\xcb\x8f\xab\x19\x1d\x96\xad\xa7\x45\xc4\x51\x33\x46\x8b\xe1\xc1\xee\x0d\xaf\xd7\x5f\xef\x55\xa2\x08\x80\x94\x70\xab\xc2\xb0\x39\x24\x51\xef\x35\x33\xd3\xab\xe0\x2a\x4b\x53\x58\x88\x8e\x97\xec\xef\x03\xfc\xbc\xe6\xa9\xd5\x07\xc0\xc3\xcd\x8a\x59\xdc\xef\x22\x3f\x35\x04\x5a\xc8\xe4\x8f\x8b\x49\xbd\xf7\xcc\x43\x77\x38\xb5\xe6\xc3\x84\x18\x6c\x62\xc5\x54\xaa\x5e\x96\x05\xc6\xdd\xd1\x9c\x63\x21\x97\x4b\x03\x18\x0d\x7c\xce\x44\x02\x08\x7d\xa9\x5e\x0f\xc5\x0b\x30\x0f\x58\xe6\x72\x62\x4d\xad\x01\xc4\xab\xce\x52\x35\x50\xd3\x29\x44\xb1\xce\x0a\xb1\x2e\x57\x95\x33\x60\x4e\x30\x20\xbf\xac\x03\x92\x3d\xb8\xf8\x35\xf0\x0c\xda\x7b\xae\xff\x8e\x12\x78\x55\x9f\x9b\x90\x17\x45\x09\xa8\xa9\xc0\x76\xd2\x6e\x5f\x2a\xb7\x04\x89\x41\x56\x2a\xe4\x8f\x1b\x38\x24\x3f\xdd\xbb\x19\xa4\x60\x4b\xc9\xcb\xde\xc9\x2b\xf5\x76\x51\x06\x6c\xa6\x63\x8d\xec\xe2\x11\xe1\x29\x9f\xab\x72\xa2\x6a\x7d\x26\xd8\x25\x13\x58\xdf\x74\x1d\x35\x82\x1f\x37\xfe\x52\x09\x35\x98\x5f\x71\xf6\xb8\xd3\x87\x93\xe4\x84\x36\xb6\xff\x83\xbc\x05\x21\xb6\xd5\x09\x76\x5f\xdc\x75\x5d\x81\x32\x3e\xfc\xe1\x68\xa4\xa5\xd6\xd5\xa4\x59\x55\x32\x67\x60\x3c\x61\x4d\x3f\x97\x55\xc7\x48\xd1\xc7\xd7\xd3\xcd\xdf\xde\xc1\x53\x4b\xeb\xeb\x68\x64\xa6\x6c\x50\x4f\xca\xa5\xde\x01\xab\xe9\x34\x2c\x6c\xb8\xc7\x86\xe3\x91\xcd\xb3\x93\x2d\x34\xa7\xfe\x50\x96\xcd\xcb\xb2\xa8\x9b\x4a\x66\x60\x73\xad\x72\xde\xe9\x10\x0c\x51\xe5\x36\x46\x58\x54\xe7\x65\x13\xe1\x8a\x72\x89\x66\x47\x45\xa1\x9d\x90\x91\x95\x7c\xed\x8a\x10\xbd\x97\xb2\xd0\x7b\x08\x9c\x13\x8d\xd7\x9b\xd3\xaf\xc8\x3a\x8c\x62\x61\xd6\xbb\xf3\x2e\x01\x64\x3d\xdf\x2f\x53\xff\x17\x19\xd3\x71\x1b\xfb\x0a\x50\xcb\xd9\x1e\x15\xbe\x6e\xc6\x37\x5b\xa2\xee\x9a\x8b\xd0\x70\x2e\xeb\x77\xb7\x00\x45\xae\xaa\x66\x1d\x47\x37\x83\x69\x59\x45\x2d\x7b\xa8\x4d\xfd\x8c\x58\x3f\xa1\xa8\xc0\x13\xb5\x51\xd3\x55\xfe\x50\x1f\xa3\x76\xe7\xb2\x86\xd0\xcb\xeb\x76\x6c\xaf\xc7\x75\xb1\x4b\x69\xd4\x54\x4a\x89\x85\x2c\xe4\x4c\x31\x63\x49\xd8\x1c\xba\x61\x9e\x6d\x95\x6e\xe8\x71\xc8\x4e\x44\xe7\xb2\x8a\xa1\x70\xd7\x96\xe3\x7a\xa7\x60\xb7\xc9\x3c\x2f\x6f\xbd\xf1\x20\x56\x7e\x33\xc8\xa6\x89\xb8\x19\x68\x2a\x83\x6c\x0a\x26\x4f\xf8\xcb\x63\x4c\xba\xe4\x30\x9b\xc2\x7b\xab\xd9\xd9\x3a\x53\x36\x65\x6c\x10\x52\xfa\x21\x5a\x78\x47\x0f\xda\x1b\x13\x50\x06\x5f\x4f\xad\x29\x2a\x54\x98\x04\xcb\x41\xdd\x2d\xc7\xc2\xaf\x3d\xf1\x32\x80\xb7\x99\xcd\xc2\x27\x6a\x3b\x2c\x74\xf7\x3a\xeb\xbd\x74\x11\x3f\x69\x27\x9a\xc3\xd5\xec\x06\x75\x27\x21\x4c\x64\x59\x28\x6f\x70\x87\xad\x2d\xd4\x7b\x3d\x05\x7f\x44\x59\x29\x8a\xd8\xa0\x47\x5e\xaf\xdb\xd6\x8a\x4b\xda\x85\x71\xa1\x9b\x39\x82\xc8\x5c\x12\x83\x0a\x2c\xac\x4b\x73\x6f\xbb\x92\xd4\x7b\x43\x0d\x65\xc1\xdd\x96\x54\x12\xaa\x91\x1f\x98\x74\x6f\x38\x2d\x7f\xb6\xd3\x59\x3b\x5e\xda\x2d\x86\x79\x0b\xd9\x50\xd6\x4c\xf1\xa3\x66\xd3\x7d\xf1\x19\x8e\x0c\xfd\x77\x2a\x74\x32\x5f\x61\x9e\x1c\x8b\xb6\x7c\x1d\xe1\x58\x18\x0a\x3c\xa7\x7f\x0a\x41\xf4\xe1\x41\xaf\x47\x30\x39\xbd\xe8\xc8\x1f\xa1\x21\x56\xfc\x31\x2f\x1b\x84\x8f\xde\xfc\x15\x31\xed\xcf\x74\x45\x17\x5d\x1b\xba\x63\xf1\xf9\xc4\x7c\x74\xfd\xae\x8d\x62\x0f\x56\x9c\xbd\xe3\xf6\xad\x7c\xf3\xc4\x87\x4f\x73\x41\xed\x5d\x2d\x66\xaf\x77\x1b\x8e\x7b\x46\x8c\xdd\xb0\xdb\xb9\xf1\x6e\x99\x20\x87\x54\x5e\x54\x6a\x55\xa4\x3c\xc2\x8e\xc5\x1a\x17\x9a\x63\xe4\x7a\xb7\xb8\x90\x91\x9d\x92\x3f\xea\xd1\xc3\xa7\x11\x0f\x21\x3f\x97\x75\xf3\x16\xd1\xc7\xcd\x10\x5a\xf4\xee\x30\xa1\x8b\x86\x31\x6e\x78\x4b\x81\x0a\xcd\xdf\x43\xf4\x47\x3b\x3e\x16\xdf\xfa\xc9\x60\x40\xa6\xcf\x71\x81\x96\x4d\x20\x6f\x75\x5d\x05\xdc\x9c\x97\xcb\xb8\xa5\xfc\x1e\x8d\xc4\xb2\x5c\x6e\x7e\x60\x18\x1c\x0b\x6b\xeb\x15\xce\xe6\x03\xc3\xb2\x65\xbe\xcc\x3c\x81\x21\xd7\x98\x0b\x3a\xb2\xaa\xd1\x30\xd4\xd7\xfc\xef\xfa\x1b\x7b\x8b\xdc\x63\x07\xc6\x70\xd5\xc7\x1f\xfb\x6d\x86\x5c\xa9\xbf\xaf\xb2\x4a\xd5\x42\x7a\x0c\x38\x11\x95\x44\xc4\xde\xb9\x2c\xc4\xdf\x56\x35\x46\x22\xdd\x7a\x98\x3b\x06\x14\x73\xe3\x3f\x42\x5d\x7a\x6c\x23\xe3\x1e\x14\x46\x4b\x33\xf8\x13\x2c\xcd\x44\xb9\x6a\x00\xcf\xc2\x93\x49\x4c\xa0\x3f\xbc\x2a\xa7\xfe\x4b\xd7\x83\xc2\x86\x87\x2c\xef\x2d\x98\xd7\xaf\x84\x71\x3b\xf3\x82\x68\xfd\x33\x17\xf5\x6e\x56\xc1\xa5\x54\xf2\x6f\xdb\x9c\xd7\xca\x7d\xbc\x2d\xb8\x02\xac\xad\x68\x1b\x69\x3a\xe8\x56\x00\xfe\xdf\xcd\x36\xcd\x80\xd1\x04\xe2\x15\xe7\xfe\x9e\xcf\xa4\xad\xe5\x44\x64\xf5\xa9\xba\x03\x73\xfe\x60\xf1\x9e\x20\x81\x71\xeb\x49\x04\x17\x3e\x97\xac\xe0\x9e\x6d\x14\xa9\x8c\x4d\xe9\x21\xcc\x9a\x1a\xee\xb4\x55\x36\x9b\x37\x02\x62\x5b\x09\x89\xaa\x1f\xba\x50\x5b\x4a\xe3\x2e\x4d\x6d\x47\xbc\xd8\x13\x60\x27\x63\x66\x7e\x62\xb6\x54\x08\x2f\x5f\xa9\x3a\xb4\xf4\xc5\x4b\x24\x19\x5f\xa3\x66\x01\x79\x53\x1c\x82\xc0\xa0\x5d\x31\x43\x7d\x69\x89\x75\xfc\x98\xf2\x77\x06\xbe\x8f\x3c\x4b\x42\xd9\xcd\xa2\x73\x55\x0a\x54\x83\xf4\xdb\xcf\x67\x31\xba\x54\x3d\xc4\xbf\x83\xef\xea\xae\x19\x87\x36\xc6\x1d\xe2\x9d\x65\x34\xa6\x97\xf7\xf7\xa2\x2b\x9c\x83\x3d\x02\xba\x58\xff\x90\x53\xf8\xda\x01\xf8\xf6\x6b\x1b\x1e\x9e\x93\x50\x8d\xd1\x2a\x3a\x06\x4c\xce\xc6\xc1\x9c\x6f\x93\x22\x98\x5d\x67\xd8\x32\xd7\x2a\x97\x66\x03\x10\x8e\x5d\xb8\x6c\xd7\x54\x50\x0e\x73\xd4\x43\xcd\xd0\x7c\xa7\x4c\xab\xe5\x70\x37\x60\x3d\x1d\xdd\x48\x46\x37\x83\x65\xa5\x20\xd2\x90\x31\x63\x41\xf9\x20\x1f\x2e\x3d\xed\xc4\x97\xae\x3a\x8c\x26\xc2\x07\x9e\x41\xc4\xda\xa1\x7d\x8c\x63\xe6\x77\x70\x88\x73\x5b\x1c\xa3\x6d\x34\xf9\xff\x69\xa3\xbc\x2d\x86\x56\xbc\x2d\x67\xd9\x05\xc4\x1d\x69\x59\x5d\x05\x48\x40\x61\x19\xc8\xd5\xb2\xb9\x0a\x55\x2e\x5c\x71\x04\x91\xc5\x0a\x16\x82\x38\x2b\xe0\x71\x03\x2e\x49\x74\xfb\x2b\x4a\x8e\x9b\x60\xc6\x9d\x44\xe8\xad\x23\x1f\xbe\xa3\x04\xae\xb7\x94\xeb\x2f\x6a\xcd\xe4\x0a\x34\xa5\x4e\x55\xa3\xaa\x45\x56\x28\x1b\x23\xc0\x45\x4e\xc6\xaa\xcd\xe9\x08\x0c\x73\xc7\x89\x83\x9a\x67\x32\xb5\x91\xd7\x72\x2b\x15\x53\xe3\xed\x8d\x86\xc2\xb2\xdb\xdf\xed\x55\xe1\x9a\xfb\x41\xf9\x2a\x25\x4a\xd6\xa2\x7d\x57\xba\x83\x37\xe6\x1f\xbb\x16\x4b\xb3\x55\xf3\xe8\xc4\xd8\xe6\xab\x54\x8e\x5f\x5c\x53\x42\x7d\x5c\xc7\x8c\xfd\x45\xad\xfd\x6d\x82\x50\x93\x5d\xd0\x51\xd7\x6a\xed\xb0\xc5\xd4\xdd\x92\x3f\xc7\x3d\xac\x9d\x7e\x40\x85\x45\xca\xeb\xde\x0f\xe6\x1b\xc4\x36\x27\xd5\x2f\x04\xcc\x1a\x0a\x88\x5b\x09\x5a\x69\x3d\x7d\x00\x69\x2f\x73\xa7\xb9\xb0\x57\x5e\x0f\x4f\x53\xe5\x14\xb7\x58\xdd\x2d\x37\x73\x0c\x35\xf5\x47\xa1\x52\xd3\x0d\xa3\x50\xa9\xa9\x1b\x85\x4a\x4d\x19\x6b\xc2\x42\x95\x9a\x1e\xb1\x94\xd7\xc5\xcf\x65\x05\x61\x93\xd5\xe4\x1a\x7e\xe8\x8a\x36\xb6\x44\x67\x0e\xe7\xc3\xd4\x16\xdb\xb9\xe9\xe4\x98\xa8\x2c\xdb\x80\xfc\x08\xf5\xde\x2d\x37\x81\x3b\x12\x5a\xb9\xa6\x54\x29\xfb\xe2\xe7\xdf\xe4\xc2\xf7\x85\xb8\xf7\x9a\x22\xaa\xa3\xb6\x8d\x1f\xe6\x04\x3b\x6a\x58\x93\xf7\x84\xf8\xa5\x0d\x6f\x8b\xbd\x36\x4b\x0a\x5f\x8a\x8d\x6b\xa6\xba\x5b\x92\x01\xbf\xb3\xb0\xb1\x13\xb0\x4b\xf9\x18\x40\xc0\x97\x1d\xde\xf9\xcf\x5f\xf0\x50\x82\xa0\x17\x20\xfb\xa1\x5b\xe9\x33\x03\x9c\x6a\x5e\xa1\xa5\xa6\xcc\x32\x58\x64\x55\xeb\x6d\xe4\x59\xde\x24\x22\x72\x08\xc1\x19\x99\xf1\x98\x26\x03\x2d\xd7\x68\x67\xe5\x63\xdb\xed\x95\x70\x18\xbb\xf5\xd0\x34\x03\x49\x98\xaa\x3d\x22\xb6\x6a\x2c\x61\x48\x1d\xea\xc6\x73\xb2\xae\x0b\x6e\xd2\xfd\x0c\xcf\x58\xcc\x34\x4e\xea\x59\x8b\xd4\x33\x9f\x14\x3b\x63\x36\x34\xfd\xc8\x47\x50\xab\x54\xdd\xb5\xda\x5f\x4f\x37\x30\x9f\xce\x05\x9e\x4d\x3b\xf9\x8f\xca\x87\xd9\xd4\x6d\xf0\x96\xd2\x50\x97\x76\xeb\x7c\x09\x08\xb7\xe6\xa0\xb5\xba\xc1\x1d\x27\xcd\x78\x1d\xdb\x26\xa2\xe8\x6c\x6d\x19\x05\x1a\x04\x14\xfc\xd0\xd5\xc2\xaa\x27\x40\x57\xb6\xb9\x9b\xa4\xd0\xe3\x21\xc7\xb0\x4c\xab\x06\xec\x36\xfc\x11\x6c\xb0\xf6\x40\x3b\xad\x1b\xe2\x50\x2e\xd9\x8d\x9c\x4c\x42\x6e\xc4\xb1\x98\x66\x45\xfa\xbe\x52\x37\xe6\xfe\xbf\xf4\x45\x47\x3b\xfe\x90\x7d\x7f\x1f\x8a\x0d\x5d\xd3\x82\xa1\xd7\x5f\xc3\xc1\xcf\x03\x25\xed\x83\x53\xe0\xf3\x9d\xde\xcd\xc0\xbc\x61\xd0\x10\x9c\x88\x38\xa2\x31\x3b\xee\x45\x9a\xf1\xd8\x4f\x07\x22\xea\x41\xb8\xc6\x88\xe6\xea\x40\xf4\x98\x1e\xb5\x07\xa1\xf4\x4b\x27\x5b\x74\x3c\x8f\x68\x89\xa8\x5c\x35\x62\x52\x56\xf8\x68\x9a\x1a\x35\x2d\xe9\x56\x3b\x78\x79\x30\x88\xc2\x06\x84\x63\xfc\xcd\x44\xd2\x67\xd1\x16\x1d\xa2\x07\xb3\xd8\xb5\xc1\xd7\x31\x9a\xa7\xd3\x2b\x1d\xf2\xbd\x0b\x9b\x8c\x65\xeb\xe2\xdf\x8f\x3b\xa7\xbd\xba\x36\x5c\x74\xda\x2f\xcd\x5c\xbd\x11\x87\x24\x8c\xb2\x84\x74\x1e\x57\xaa\xb9\x55\xaa\x40\x55\xb7\x7b\x59\x88\x07\x7a\x19\xf9\x4a\xee\x5e\x4b\x15\x62\x3f\xb6\x34\x62\x5d\xfa\xb2\xf6\x7e\xf0\x17\x28\x6e\x05\xeb\x25\xee\x6e\x25\xbb\xc0\x54\xdc\x9e\xf1\x78\x0d\xdb\x4a\xc6\xa8\xea\xcb\x4e\xeb\x1b\x85\x93\xb4\xb4\x3b\xc5\x2f\xb4\x6d\xe2\x2c\xb0\x2c\x26\x0a\x51\x62\x36\xf1\x07\x9d\xc3\x31\x42\x93\xbf\x7d\x53\xd2\x5f\x1e\x12\xd8\xb5\x10\xeb\xdf\xc7\x5a\xef\x8f\x8c\xa2\xfe\x4d\x7e\x01\x5d\xa2\x91\xbe\xc1\x70\xae\xf5\x28\xa1\xf0\x5a\xad\x5b\x52\xa0\x9d\xe4\xde\x5f\xaf\xd5\xfa\xaf\x10\x66\x1a\x54\x26\xe0\xb7\x54\x16\xe2\x07\xdd\x92\xe7\xf6\xf1\x0e\x82\xf4\xc2\x23\x8a\xbc\xaa\x21\xb4\xae\x28\x57\x4d\xae\x9a\xda\x5b\x4f\x3d\xbd\xd8\x26\x12\xa2\x07\xd6\xd9\x55\xbe\xd6\x1c\x09\xf1\x5f\x9b\xb2\xe3\x8d\xcf\x2f\xfc\x5b\xed\xc9\x9e\x52\xdc\x56\x64\x34\x61\xb8\x47\xf8\xe6\xb2\xe9\xb0\x04\x9b\x3c\xf3\x8a\xc1\x99\xfc\x56\x09\xd9\x96\xd8\x0c\xff\xab\xbf\x3a\x9f\xe7\x47\x28\xf3\x1e\x81\x29\xce\x5e\x5b\xb6\xee\xff\xb9\x12\xe7\x3d\x68\xc1\x79\x8f\xe1\xec\x69\xa9\x8b\xbd\xd0\xd4\x62\x2e\x6f\x14\xe2\xf5\xa6\x6a\x59\xa9\x09\xc4\xf2\xd4\x93\x10\xec\x7c\x12\x7d\x00\x84\xf0\xbc\xa7\xcb\x0e\x0c\xf5\x1a\x02\xbf\x3d\x1b\xfe\x71\x28\x4e\xe7\x0a\x6e\xe6\x41\x0e\x57\x7f\x40\x55\xcf\xbe\xcc\x6b\x88\xfe\x6d\x98\xbf\x77\xbd\x04\xcb\x15\x99\x12\x7b\x68\x4a\xc1\x2e\x24\x4d\x19\x92\x4b\x55\x51\x36\xca\xeb\xe0\xb0\xc7\x35\x3c\x4c\x55\xd2\xc1\xb2\x68\x4b\x99\x59\x75\x7f\xdf\xdf\x6f\x9a\x62\xdb\xcb\xa8\xdf\xf2\x09\x88\x1f\xb1\x44\x58\x79\xa6\xbc\xfb\xb7\x2c\x15\xf6\x94\x7f\x46\xd7\x91\x8b\x07\x56\xcd\x8b\xc5\x55\x36\x5b\x95\x2b\x30\x41\xb8\x02\x94\xbd\x55\x2d\x67\x10\x76\xcf\x35\x95\x0e\x09\x7a\xd1\xef\x38\xa3\x83\x59\x89\x31\x6f\x23\xaf\x55\x2d\xe6\xd9\x6c\xae\xb4\x78\x93\x95\x55\xd6\xac\xfb\x43\xa1\x37\x32\xed\x5e\x55\xf1\xf9\x9d\x12\x3c\x74\x40\x8e\xcd\xae\x5e\x02\x10\x1e\x30\x6b\xc0\xd9\xa7\x52\xd5\xbf\x63\xbe\x43\x11\x91\x3d\x41\x6e\x40\x71\x06\xc6\xcc\x7c\xc3\x6c\x01\x4f\x46\xf4\xe8\xf0\x1f\x7a\xf6\x7a\xbd\x48\x9c\xf0\xf7\x4d\x31\x66\x79\x98\xd3\xa8\x55\x63\xc3\x08\xc8\x5a\xe8\x3d\xe4\x6d\xf0\x02\xc3\xfd\xd7\x73\x99\x96\xb7\x10\x8f\x76\x52\x2e\x96\xb2\x71\x34\x40\x1f\x0e\x59\xcb\x62\xe0\x6d\x17\xb6\xc4\x88\x01\xee\x7a\x0c\x90\xd4\x34\xc3\x4e\x46\x24\xd3\x34\x18\x94\x84\xf5\x61\x93\x9b\x47\x78\x08\xba\x07\x24\xef\x24\xbe\x72\xc8\xd6\xb0\xbb\xae\xb6\x02\x6b\x67\x35\xdb\x51\x2a\x77\xc1\xbe\xc4\x31\xa7\xf4\x65\xab\xda\x13\xa1\x26\x07\x8c\xfb\xb7\x8f\x75\xcc\x63\x2c\xfb\x1e\x3a\xe0\xbb\x14\xa1\x59\xdd\x04\xba\x50\x7b\x91\x4d\x44\x9e\x80\x7e\x32\x11\x95\xbc\x45\xbf\x19\x72\xf7\x34\x5e\x22\x75\x22\xb2\x1a\x7d\x2b\x48\xad\x05\x2a\xad\x04\x14\xac\x39\x53\xa1\x75\x28\x44\xe9\xa9\x9d\x48\x53\x7e\xa3\xfc\x3c\xa2\xf5\x8f\xae\xb6\x39\x57\x71\xba\x95\x0e\xee\x37\x08\xe2\x53\xf8\xce\xe2\xa3\x91\x58\xc8\xea\xda\xe9\x09\x6b\x91\xae\x0b\xb9\xc8\x26\x6e\x43\xcc\xa5\x89\x17\x50\x07\x26\x6e\xba\xb4\xe9\x20\x25\xd9\xdf\x46\x87\xf2\xc6\x24\x60\xd5\xfc\xa9\xc5\xe6\xed\x07\x7a\x5e\x71\x0c\xff\xd8\x9b\xbc\xf3\xb5\x61\xd7\x78\xff\x39\x1e\x5d\x75\xfc\x3e\xea\xf6\xa1\xd3\xce\x56\xea\x58\xd4\xa3\xec\x46\xd4\x0b\x1f\x83\x9a\x63\xf6\x14\x54\x93\x3b\x46\x60\x22\xb1\xb1\x73\xc1\x27\x08\xd5\x1b\xbe\x86\x5a\xa2\xbe\x7d\x22\x6b\xfd\x44\x2e\x54\x9e\xfd\x43\xf9\x23\xea\xe8\x17\xd6\xa7\x0c\xa1\xc8\x9b\x45\x0e\x4e\xdb\x06\xe7\xd6\x02\x94\x47\x47\x9e\x69\x59\x68\x66\xe6\xda\x09\x35\x86\x0d\x7d\xb0\x35\x9b\xe9\xd5\xeb\x62\xd2\x46\xec\x4a\xff\x0c\xa6\xd0\x55\xf8\x0e\x8c\xd1\xf4\xf8\x7f\x71\x0f\x03\x9a\x23\xb8\xbd\xdf\x82\x7e\x3f\xcc\x3d\x53\xc5\x0b\x08\x75\xad\x97\xf8\xcb\x32\x55\xc6\x19\xbb\xb7\x07\x81\xbc\xbf\xf6\xa9\x18\x5f\x74\x8d\xcf\x8d\x0f\xd1\xc5\x79\x98\xbe\xe9\xb7\xbd\x77\x88\x63\x27\xfc\xe8\x87\x0b\x2a\xf2\x10\x86\xb0\xe5\x8f\x90\x4c\x53\x2a\x6e\x98\x4d\xb8\x1c\x3b\x2c\x62\x38\xaf\xdf\x50\x68\xc7\x2b\x8c\xb7\xa3\xee\x9d\x54\x16\x5b\xf7\x91\x2e\x16\xec\x22\x36\xa7\x41\x0b\x3c\xd6\x48\x6e\x8b\x28\xeb\xb4\x6c\x62\xf0\xf9\xa5\x5a\x74\x9b\xb7\x76\xb5\x04\xf8\x5d\xd0\x14\x7d\x26\x83\x07\x97\xac\x66\x6c\x97\x83\xeb\x9f\x51\x41\x02\x11\xc2\x3d\xa9\x66\xa4\x79\xf4\x72\x22\x9c\x01\xe6\x47\x04\x02\xa3\x35\xf4\x77\xbf\xac\x66\xfe\xec\xf1\x66\x22\x06\xd2\xd3\x44\x0c\x74\x3e\xf3\x4a\x7a\x20\x0e\xfb\x9d\x1e\xb9\x32\x4d\x6d\x90\x4c\x36\x8c\xe1\x51\x23\xab\x19\x1b\xd4\xc0\x1a\xfa\x61\x41\xd4\x31\x8d\x45\x99\xaa\xbc\xf5\x46\xab\x26\xd7\x3f\x93\x2a\xf9\x8d\xce\x00\x2d\xd9\xb8\x98\xba\x54\x29\xa3\x91\xc8\x41\x41\xca\xde\x9b\xe8\x53\xeb\xb9\xdd\x7b\x41\xa7\x0e\x76\x04\x4e\xe9\x0c\x9d\xd2\x25\x34\xd3\x04\x1c\x88\x1e\x85\x50\x41\xfe\xde\x19\x3c\xe5\xdf\x10\x3e\xe5\xdf\x16\x40\xa5\x23\x84\x4a\x96\x6e\x8c\x9f\xa2\x3f\x85\xc1\x53\xb6\x5a\x30\xb6\xd9\x43\xf0\x76\x7a\xc3\x63\xad\xc1\x14\x7e\xf3\xa7\xef\xbe\xfb\x2f\x31\xcd\x2a\x35\x2d\xef\x40\xc9\x50\x44\x8d\x40\x76\x2c\x16\x2b\x7d\x31\x45\x5f\x88\x6c\x2a\x6a\xd5\x88\x9b\x4c\xb6\xa6\x7b\x34\x12\x9a\xf1\x8a\x6c\xb1\x50\x69\x26\x1b\x95\xaf\xc9\xa4\xc3\xc8\x21\xe0\x7e\x92\x59\x86\x63\xb4\x4b\x9c\xb7\xb6\x36\x17\xac\x5d\xdd\x80\xc0\x15\xff\x9f\xe0\xc1\x81\xb8\x1c\xf0\xdd\x48\x9f\xcc\xa1\x18\xd2\x11\xf4\xda\x3e\x5e\xf9\xa2\xe4\xd2\x19\x08\x32\xed\xe5\xd2\x33\xf6\x02\x6d\x31\x6a\x91\xb5\xb4\xa8\x37\xac\x8d\xa6\xd9\x52\x61\xda\xfb\x13\xb6\xc4\xd2\x27\x02\x4b\x6b\xcd\xc8\xde\x16\x30\xa2\x7d\x0b\x00\xda\x4a\x6b\x28\x45\xb8\x46\x2f\xda\x2c\xd2\x49\x65\x56\xaf\xd6\xc2\x48\xaa\xe0\x16\xf5\x99\x5e\xd9\x11\xfb\xa3\x1d\x48\x7d\x41\x2f\x51\x67\x0b\x8b\x0f\x77\x61\x04\x20\x6b\x2d\x61\x1f\x45\x9a\xd6\x48\x73\x5f\x60\x41\xfe\x4d\xac\xe5\x4b\xdb\x04\xff\x41\x19\xa5\x6f\x0f\x56\xa8\x43\xfc\xce\x5c\x54\xda\xc7\x02\x40\x2c\xe4\xf2\xcc\x18\x31\x0c\xd1\x80\x15\xdd\x99\xd0\x5d\x6b\x37\xab\x5f\xa5\x33\x9c\xb2\x96\x1a\x2f\x4a\x57\xcb\x3c\x9b\xe8\x1d\x64\xb7\xcd\x58\x73\x05\xe1\x51\xf4\x9e\x6b\x3b\xea\x3b\x76\xd9\xed\x65\x80\x4d\xff\x42\x62\x74\xeb\x11\xe9\x9a\xaa\x6c\xa9\x2f\x72\xc3\xd9\x10\x0c\x5b\x8e\x7b\x77\x23\x73\x9f\xea\xf5\x35\x03\x43\xd0\x7a\x91\x96\xa0\x52\xa4\xb0\x39\x26\xb0\x89\x9d\x08\x6b\x05\xe6\x56\x3c\x55\xe8\x69\x48\x11\xf2\x30\xf4\xd1\xc0\x28\x4b\xde\xc4\xfa\xce\x61\x2d\xa2\xb1\xb9\xde\x05\x34\xc4\xfd\x3d\x7c\x89\xbb\x6a\xdd\xdf\xb7\xf3\xb9\x1b\x6e\x7f\x53\x50\xb4\x18\x83\x33\xd0\x1b\xfd\x4d\xde\x48\x22\x86\x53\xa8\x45\xb6\xbe\x09\x75\x94\xa9\xb7\x1f\x7f\x5c\xcd\x00\x34\xe0\x6e\x91\x17\xf5\xf8\xed\xc7\xf3\xf4\x80\x50\x03\xf4\xd7\xf7\x95\x9a\x66\x77\x90\x01\x3e\x8d\x47\x10\xb7\x64\x63\x90\x66\x17\x1c\x8a\xbb\xf1\xb5\xd6\x39\x9e\x9a\xa8\x59\xef\x32\x9c\x08\xd6\xb9\x5b\xe0\xc6\x76\x87\xad\x19\x77\x75\xdc\xa5\xfe\xa0\x3c\xa8\xbf\x0f\x03\x0e\x69\xd3\xa8\xfc\xd0\x13\xc5\x5c\x87\x3d\x79\xac\x52\x6d\x58\xb8\x2f\xdb\x5f\x3f\xdb\x22\x88\x60\x32\x88\x1d\x86\x4b\x8a\xb2\xcd\x58\xeb\xa5\xbb\xbb\xe8\x2e\x5d\xaa\x1c\x98\xea\xfe\xbe\xce\x6c\x5e\x60\x8f\x8f\x39\x25\xd1\xa5\x57\x6f\x29\xd1\xc4\xcd\x00\x84\xa5\xb6\x4c\xf1\xdc\x17\x2a\x6c\x00\x63\xa3\x0e\xa1\x82\x24\xd0\xe6\x6b\x88\xac\x41\xea\x3a\x7c\x44\x86\x77\x17\x78\xcf\xf6\x29\x9d\xce\xb3\xda\x73\xf1\x03\xe0\x88\xa6\x44\xd1\x6f\x0d\x92\x06\xd2\xc1\x90\x3a\x42\x56\x95\x5c\x5b\xad\xbf\x47\xeb\xb6\xca\x1a\xc2\x3b\xd0\xc5\x70\x24\xb2\x1a\x81\xec\x91\x1e\x60\xf7\x3a\x93\xb9\xbc\x9c\xc8\x5c\x8f\x73\xa6\xab\x0d\x9a\xf6\xb2\x04\x41\xa9\x22\xaf\x0c\x59\x50\xdd\xe5\x54\x94\x57\x7f\x03\x0f\x47\x2d\xf7\xa0\x07\x06\xf6\x5f\x77\xb2\xa0\xaf\x62\x49\x4e\x4c\xdb\xde\x04\x04\x4d\xb0\x9e\x39\x7e\xb0\xf1\x50\xfd\x08\xec\xf0\xca\x3c\x53\x2c\x57\xcd\x19\xd5\x77\x81\xb6\x5a\xe9\x5a\x16\x8b\x6c\x02\xac\xce\x4c\x49\x8d\x0f\x1a\x37\x83\x6c\x0a\x2f\x6b\xe8\x17\x52\x6b\x4a\xa7\x70\xc8\xce\xb3\x7a\xac\x7f\x09\xf1\x03\xd0\xb4\x93\xdf\x4b\x65\x23\xcf\x34\xad\x8b\x9e\x18\x23\xfb\xd4\xff\xef\x3d\xd7\xd9\x81\x6a\x57\xe1\x6c\x8a\xd9\x90\xb3\xc0\xea\xbe\x2a\xef\xa2\x1e\x31\x60\x93\xd0\xeb\xac\xe7\x79\x40\xcc\x3e\xe5\x3a\x8a\x95\x4c\xb3\xd2\x92\x83\x5f\x8f\xa7\xe5\xf5\x63\x63\xa9\x91\xa7\x3a\xf3\xc1\x1b\x3a\x02\x37\x86\xef\x35\x50\x61\xc4\xd9\x10\x9e\xd5\x8c\xf7\x32\x46\xb4\x40\x0d\x39\xde\x4e\x2e\x42\x21\x88\x16\x88\xa5\xa4\x1b\xc9\xa2\xec\x19\xf1\x64\x79\x16\x41\xcf\x22\x00\x9e\x23\x92\x00\xeb\x82\xa9\x8e\x2c\x23\xb0\x41\x79\x09\x25\xbc\xa5\x69\xda\x89\x27\x86\x3e\xe9\x39\x95\xfd\x7d\x5e\xe1\xe6\xba\x7a\x18\x65\x2f\xc8\x7b\x20\x7a\x7d\xa0\xdb\x3b\xe2\x7d\x05\xe3\x5c\x57\xdc\xd1\x04\xee\xcf\x1e\x6d\xb7\x5a\x88\xd8\x78\x2a\x9d\x65\x5f\xdd\x35\x95\x14\xc7\x1e\xb9\x13\x11\xf7\xf6\xf7\xa1\xa5\x3c\x19\x23\x26\x8b\xb1\xe8\xf5\x38\xb1\xb9\xac\x5f\xa1\x71\xc7\x56\xb3\x10\x6a\x86\xd1\xdf\x72\x0a\xfa\xfb\xeb\x47\x75\xc7\x58\x82\x04\x7d\x1a\x8d\xc4\xe1\x50\x98\x5d\xc5\x28\x5f\x55\xb2\x98\xcc\x9f\x42\x74\xd7\xb2\xe0\x2e\xef\x2a\xef\x7b\x1a\x7d\xd0\x0d\x0b\x7a\x51\xd1\x0c\x73\x21\xb3\x02\x0c\x42\x29\x17\x73\xb5\x25\xaa\x96\x80\x4c\x53\xb2\xb0\x35\x9f\xcc\x0a\x4a\xd8\xe6\xb7\xd9\x03\xf7\x59\x5b\x24\x74\x9f\xa5\x0f\xce\x35\xd8\x8a\xcd\xf8\x06\x71\x03\x4f\xac\x0d\x82\x6b\xa5\xe5\xea\x2a\x57\x03\x9b\x37\xa0\x01\x86\x31\xb8\xf8\xf8\x82\xd4\x53\x7a\x7c\x7c\xcc\x39\xd4\x41\x6b\x75\xb0\x6e\x72\x53\x16\xdb\x6e\xe6\xa9\x73\xb7\x1c\xb3\x2a\x9d\xae\x8e\x4c\x5a\xe8\x93\xb9\x5b\xf1\x19\x78\x36\xd4\xf4\x05\xb0\x32\x61\x3c\xf8\xac\xad\x40\x6b\x4a\x0f\xb7\x4f\x69\x6b\xfd\x50\x29\x6b\x16\x18\x2c\xa0\xd6\x0c\x1e\xb2\x19\x44\x66\xbb\x7d\xfa\x0e\xdb\xd3\xf7\xc8\xe1\xda\x3c\x29\x86\xc9\xb7\x67\x64\xc3\xc0\x1e\x76\x0d\xec\xb7\x43\x51\x36\x73\x30\x10\xf6\x87\xf0\xd9\x3f\x35\x84\xcf\x1e\x3d\x84\x3a\xe7\x98\xc6\x90\x73\xb1\xad\xe3\xf8\xec\x9f\x1e\x47\x36\x46\x1b\x86\xe7\x19\x1b\x1e\xa6\x7b\x20\xfe\xc5\xd5\x02\x66\x09\xb7\x8c\xd6\x02\xff\x4b\x8f\x6d\x6d\x22\x60\x6d\xd2\x58\x5e\xa7\x5e\xf0\x6f\xfb\x7c\x83\x74\x28\x1d\xfc\xd9\x6a\x5d\x99\x5a\xb8\x1e\xa1\x26\x04\xec\xb9\xf1\x16\xde\x87\x54\x52\x4c\x98\xfb\x0d\x9c\xbf\x80\x71\xf5\x39\x00\x7a\xc2\x70\xad\x61\x0a\x2b\xb7\xca\x15\xc6\x92\x3e\xdb\x11\x26\xb2\x74\x02\xf1\x60\x21\x3a\xac\xfe\x93\xa8\xef\x5c\x74\x45\x86\xd6\xf7\x21\xe0\xf2\x69\x56\x39\x89\x22\xcd\xaa\xa1\x27\xb9\x73\x85\x4d\xc4\xe2\xac\x46\x89\xb0\xd1\xfd\x79\x29\x16\xe5\xbf\x3b\x84\x3d\xc0\x8a\x7f\x6d\xc5\xee\x91\xe5\x91\xd5\x12\x4e\x1e\xe9\xb7\x6d\xb0\x5c\x18\x91\x31\xfe\x03\xd1\x71\x3d\x37\x13\xdd\xb4\x31\xfc\x3f\x84\xe2\xbb\x92\xb5\x7a\xb7\x34\x06\x57\x9a\x12\x87\x15\x02\xb4\x42\xa4\xae\x67\x65\xec\xa6\x47\xa7\xba\x66\x8c\xbd\x26\xe9\x6f\x06\xa1\x6c\x6c\xff\xc2\x54\x07\x47\xe4\xc3\x10\x31\x84\xb2\x31\xff\xa1\xbf\xb5\x01\x8a\xba\x80\x89\xb2\xfa\x03\xbe\xa5\xa7\x44\x9e\xfd\xc4\x68\xc0\x1e\xc0\xce\x38\x4c\x48\x82\x90\xc3\x33\x55\x7c\xb4\x3f\x63\xdb\xf1\xfe\xce\x97\xa3\x30\x08\xaf\xcd\x77\xe4\xc2\xfb\x81\x2e\x91\x35\x81\x10\xff\x3c\xaa\x21\xe8\x9f\xf7\x71\xef\x90\x05\x2a\xfd\xa5\x94\xb9\x81\x7b\xd5\x5c\x6d\x91\xfd\x43\x55\x63\x71\x2b\xf3\x6b\x48\x9b\xa9\x42\xdf\x06\x55\xea\xfc\x34\x5f\x7c\x3c\x05\x40\x04\x5d\x5c\xdf\x70\x52\xd5\xe8\xdb\x53\xbd\xba\x1a\xe8\x64\x8a\xd8\xab\x6f\x9b\xcb\x55\xa5\xf2\x35\x75\x3e\x11\xd9\x50\x0d\xc5\x52\x56\x4d\x2d\xca\xa9\x2e\xad\x2b\xf8\xe9\xdd\x1b\x2c\x50\xa8\x1b\x55\x89\x42\xa9\xb4\x26\xb7\xf8\x62\x06\xc1\x50\x21\x6e\x69\x31\x51\xe2\x56\x99\xba\x9a\xb9\xaa\x95\xab\x31\xa1\x40\x9b\x63\xca\x7d\x38\x14\x7f\x2e\xb3\xba\x31\x5e\xf5\x9a\x5c\x59\xd4\x8d\x04\x97\xfc\xba\xc4\x0a\x6f\x95\x28\x4a\x91\x97\xc5\x8c\xea\x15\x4d\x89\x57\x11\xe2\x52\x62\x5a\xa9\x7a\x8e\xf0\x18\xc6\xce\x64\x01\x96\xa7\x72\x32\x17\x95\x1a\x20\xd2\xc4\x91\x2e\xf4\x6c\x28\x5e\x96\x8b\x65\xae\x40\x99\x5c\x5f\x67\x4b\x53\x39\xf4\x72\x69\x80\x50\xe9\x44\x09\xb0\xfd\xcc\xc8\x0b\x02\x4b\x68\x5d\x5b\x0c\xcc\x04\xb7\x97\x67\xcb\x03\xc4\xd1\xd6\xfc\x73\x08\x4f\xfa\x80\x70\xd7\xa8\x2f\xed\x5a\x4c\x1e\x14\x20\x4f\xb7\xe0\x7e\xa3\x91\x98\x66\x55\xdd\x88\xa5\xac\xf5\xbe\x95\xd5\x35\xc0\xdf\x82\x8d\x08\xc6\x24\x47\x3c\x11\xbd\xf7\x64\x75\x8d\x8d\xda\x3b\x74\x58\x17\xa3\x91\xa8\x95\x16\x9b\x38\x09\x2a\xaa\x33\x05\x45\x3f\xe8\x24\x1a\x17\xd0\x1a\x07\x46\x99\xc1\xda\x16\xf1\xb5\x5a\xd7\xde\x99\xc3\x02\x1c\x0b\x14\x99\x12\x7d\xe4\xd8\xe3\x26\x31\xb7\xbd\x04\xac\xca\x12\x42\x6e\x34\xb6\xa9\xf8\xd9\xbc\x8b\x00\x79\x71\x22\xa2\x24\x12\x07\x02\x7e\x8c\xf5\x90\x1a\x1d\x1c\x53\x0a\xbb\xce\x8b\x58\x8f\x09\x36\x4a\xff\x45\x33\x02\x78\xf4\x98\x07\x33\x18\x3d\x76\xe1\x39\xa3\x1f\x32\x3f\x30\x52\x80\x82\x3d\x93\x7b\x8d\x00\x23\x1f\x13\x0e\x10\x69\x0f\xd1\x17\x0b\xc2\xa5\xd6\xa6\xb4\xbe\x81\x98\x42\x68\x9c\x69\xb5\x37\x2b\x78\x34\x01\x42\x30\x7f\x3b\x4e\xe8\xa5\xc6\x7a\x95\x4c\x65\x96\xe3\xa6\x98\x97\xcd\xa0\x52\x79\x29\xb5\xe4\x14\x6a\xac\x77\x3b\x57\x19\xf5\x0f\x63\xd2\x50\x4e\x93\x84\x6a\x6d\x04\xd1\xf1\x3f\x3a\x3b\xb5\xd0\xe2\xe6\xc2\x20\x67\x07\x2a\x6d\xef\x6a\x2e\x5a\xde\x7f\xa8\x7f\x07\xda\xa1\x55\xf7\x46\xb0\x7e\xc8\x18\x96\x62\x78\xfd\xde\x92\x87\xef\x9e\xc9\x09\xfa\xad\xd2\xf4\x7b\x66\x27\xde\xaa\xf0\xec\x39\xbe\x04\x37\x7c\xc8\xda\x65\xe7\xcc\xfb\x07\xe7\xf7\x61\x22\x72\xf8\xa3\x55\xc4\xf5\x73\xef\x50\xf7\x74\xef\x10\xfe\xf4\x21\xb4\xe0\x18\xd7\xd2\x69\x17\x85\xb3\x6c\xef\xf0\x62\x08\x9f\xdd\xfb\x9e\xd7\x7b\xf8\x16\x3c\x95\xee\x42\x62\x47\xff\xb7\x8e\x40\xeb\x61\xb7\xe3\x05\xc6\x63\x17\x38\x48\x89\xc8\x6a\x78\xff\x72\x7c\x74\xc3\xc6\xb2\x9f\xa8\x7e\xe0\x76\xa9\x02\x6b\x6c\xd7\x4a\x96\xc3\x38\x88\x51\x05\x5c\x09\x33\x1a\xc1\x83\xaa\x44\x4f\xcd\xa6\x14\x7f\x5f\xc9\x3c\x9b\xae\xc1\x08\x8f\xf3\xb9\x44\x5f\x8c\x09\x85\x06\x0c\x6c\xad\x4b\xbc\x3e\x9e\x0c\x2d\x49\xb1\x3f\x01\xf8\x80\x4a\x83\x7d\xbe\x78\xa7\xaf\x4c\xb7\x19\x59\x38\x4f\xca\xba\xd1\x07\xf9\x5c\x1f\x7c\xfa\xa4\x29\x57\x08\x4a\x60\x08\x95\xab\xe6\x56\x65\xb3\x39\xe4\xbe\x52\x85\x9a\x66\xa4\x10\x85\x40\xe4\x57\xaa\x69\x54\x25\xca\x29\x80\xd2\x40\x6d\x32\xbf\x95\xeb\x9a\xf0\x94\xf0\x16\xaf\xea\xf9\xb0\x73\xc4\xf6\xf7\x3b\x37\x12\x28\xa1\x62\x3e\x7e\x61\x06\x98\x87\x60\xa7\xdb\x7d\xf5\x94\xb9\x4e\x7c\x4b\x8f\x1d\x5d\xd3\xf1\x01\x41\x97\xf8\x25\x88\xef\x7d\xdf\x0e\xa0\x5d\xae\x13\xf8\xd3\x6b\x49\xd7\x16\xfb\x67\x58\x48\xfb\x60\x0b\x19\x89\x5d\xb4\x18\x93\x04\x3e\x4f\xcb\x30\x74\xcd\xff\x03\xdc\xa0\xb3\xe1\x1b\x38\x82\xdb\x79\x0f\x3d\x52\x9b\xf3\x8f\x9f\x90\xed\x8d\xfa\x8c\x6c\x80\x9c\xb7\xe2\x8e\x9b\x63\x7a\x4b\x76\x66\x9a\x7e\xd9\x6f\xa9\xac\xf5\xd7\xf7\x5f\xad\xd9\x8b\xcf\xee\x2e\x16\x5d\x22\xd0\x04\x2e\x5b\x9c\x01\x6e\x83\xb8\xbf\x8f\x16\x41\xc6\x52\xd1\x6a\xf0\x59\x7e\xc4\xd7\xb2\xb3\x67\xcb\x34\x84\x15\x55\x19\xd3\xe8\x8a\x43\x74\xed\x66\xf5\x8f\xab\x2c\x6f\x5e\x17\xe1\x21\x69\x4a\x4b\x71\xa5\x33\x0c\x32\x1c\x80\x87\x4f\x57\x57\xd0\x1e\xfc\xa6\x2a\x34\xea\x79\xa9\x97\xde\xbb\xa9\x31\x4d\xfd\xb9\xac\x68\x26\x68\x4b\xbe\x83\xb7\x91\xa1\x16\x74\xf0\xc3\x50\xcb\xe9\xeb\x98\x49\x9f\x20\xfb\xf4\x83\x69\xdd\x44\x9d\x4f\x34\xbd\x8f\xe1\xa0\x7b\xf6\x07\x05\xa2\xfb\xb0\x2f\x47\xfe\x8a\x6f\xdb\x1c\xb7\xcc\x12\xcc\xb2\x68\xed\x96\xa9\x39\x11\xda\xab\xa1\xf5\x0a\x68\xed\x14\xf8\xc5\x6c\x5a\xbc\xba\x5b\x12\x30\x7b\x7c\x76\x7e\xbb\x77\x79\x71\x70\x7f\x1e\x9f\x7d\xea\x5f\x3c\x39\x39\xef\xf7\xcf\xeb\x27\xc7\xcf\xef\x3f\x99\xd1\x38\xaf\x9f\x9c\xc7\x06\x09\x3e\xd3\x97\x84\xf7\xb2\x99\x53\xf9\xb3\x17\x83\xff\x91\x83\x7f\x5c\xee\x5d\x68\x42\x17\x4f\xe2\x93\xf1\xf9\x30\x4c\xbc\x3f\x3f\x8b\xce\x3e\x45\x17\x4f\x4e\xa2\x8b\xfb\xf3\xb3\xde\xd9\xa7\xde\xc5\x93\x93\x9e\xfe\xfb\x3c\x3d\xd0\xff\x84\x25\x2e\xfa\x4f\xf6\x46\x88\xf0\xfe\x17\xb5\xbe\x2a\x65\x95\xbe\xba\x21\x27\xfa\x97\x7a\x70\xe1\xb1\x4d\xd5\xd0\x28\x4a\xb3\x17\xf5\x7a\x32\x16\xcf\xfe\x0b\x6e\xfb\xf2\x6a\x2c\xbe\xd7\x7f\xa9\xa2\xd1\x17\xc3\x43\x40\x9c\xa0\x8b\xee\xb7\x80\x0c\xb2\x5a\x8e\xc5\xb7\xdf\xe9\xbf\x72\x35\x6d\xc6\xe2\x5b\x28\x09\x40\x29\x63\xf1\x2d\x14\x4e\xcb\xdb\x62\x2c\xfe\xf0\x14\xa2\xbd\x63\xec\x9e\x68\x2c\xce\xbe\x4b\xc4\x1f\xfe\x74\x81\xf7\xde\x8e\x76\x86\x6d\x84\x1b\x35\x6f\x63\xf4\xaa\x9e\xc8\xa5\x8a\x6c\x4b\xa3\x53\x79\x15\xb1\xd6\x46\xaf\xf4\xbf\x11\x6b\x72\x24\xe0\x17\x84\x28\xfc\xee\xe9\x9f\xc6\xe2\xf5\xab\xc3\x43\xb1\xaa\x55\x0d\x3e\x42\x00\xd0\x6b\xdd\x07\xff\xfa\xa2\xaa\xca\xdb\xbf\x8a\x25\xbe\x98\xeb\x0d\x2b\x75\x0a\x88\xfd\x43\xea\xfa\x59\xf4\xdb\x32\x4a\x44\x04\x79\x7f\x5b\x46\x17\x6e\x24\xce\xa2\x5f\xd5\xb4\xb1\x1f\xe1\xc7\x05\x1b\x9c\xb3\xe8\x83\xfe\xc3\x66\xc0\x5f\x17\x6e\xc4\xce\xa2\x9f\xca\xdb\xc2\x7e\x87\x1f\x17\xc1\x20\x46\x3f\xca\xc9\x35\xf4\x4e\xe7\xfb\x09\xd3\xed\xa0\x7e\xf3\x87\xef\xfe\xf4\xdd\x98\x99\x45\xc3\xd5\xd7\x68\xe6\xb5\x28\xa0\xee\xd4\x64\x85\xb7\x50\x54\x03\xe4\x59\xdd\xe8\x5b\xbf\x2e\x4e\xd7\x62\xcd\x78\xf3\x6c\x92\x35\xf9\xda\x6c\x0c\x2d\x6e\xf3\xab\xf4\x44\x16\x5d\x78\x10\x25\x99\xa8\x69\x62\x9c\x38\x0c\x26\xc8\x57\x46\x7b\xf1\xcb\x4a\x56\xa9\x17\x33\x9f\xbb\xf8\x59\x93\x8b\x5e\x36\x05\x55\xd6\xc4\x7b\xf3\x61\x8d\x3a\xea\xf5\x31\x4c\x3e\x8f\x92\xf0\x12\xf9\xc9\x67\x50\xc5\x94\xcb\xb1\x88\xd0\xf4\x76\xa8\x7f\xbd\xaf\xca\xa5\x9c\xc1\x93\x77\xdc\x3f\x82\xf5\x41\x03\xe4\xf2\x51\xc2\x4f\xe8\x77\x61\xb2\xd5\x2a\x9f\x8e\x6d\xe3\x63\xb2\xe7\x1d\x36\xe8\xb1\xa1\xf9\x13\xa5\x10\xa6\x0b\xba\x38\xf4\xc0\x42\x78\xd2\x54\x39\x2f\xbb\x6b\xb2\x36\x55\xfe\x17\xb5\xc6\x4c\xf5\x3c\xd3\x0b\xa9\x9d\x0b\x3e\xd8\x6c\x32\xef\xcc\x24\x73\x97\x65\xa1\x1a\xd9\x95\x47\xa7\xdb\x4c\xb8\x6c\x5d\xa6\xe8\x6a\xd5\x34\x65\x11\x89\xac\xa0\x9e\xe8\x23\x85\x4a\xe2\x37\xe8\xe5\x53\xaa\x23\x4b\xd3\x5c\xfd\x33\x04\x0e\x91\x00\xed\x8b\xaf\x2f\xff\xac\x87\xea\x33\xae\x15\x20\xcb\xdf\x1a\x0e\x72\x28\x51\xa3\x3a\xef\x2d\x78\xbe\x24\x14\x2f\x61\x27\x34\x61\x31\x19\xf4\x25\x1f\x9d\x64\xde\x15\xe3\xcf\x80\xd4\x54\xea\x3f\x3c\x03\x17\x8c\x27\x54\x10\x7d\x06\x0a\x20\x0e\x8e\x21\x2c\xa5\x38\xb0\xa6\x9f\xe7\x3d\xb4\x17\x77\x6d\x8b\xd1\xa0\x0f\x0b\x23\xd4\x20\x7a\xdc\x26\xbd\xb6\x2f\x3e\xb3\xdb\x3d\xd4\x99\xa2\x2f\x51\xa8\x08\x21\xb2\xd0\x63\x03\x4d\x83\xf8\xde\xd5\x0e\x53\x26\x51\x92\x6b\x2d\x46\x2c\x35\x94\xe2\xfe\xe7\x2f\x91\x01\x8e\xd7\x05\x00\x42\x67\x98\xd5\x08\xa5\x63\x4a\x07\xc5\xe3\xde\x19\xf4\x8e\x3e\x0f\x17\x72\xc9\x4c\xec\x5c\x95\x26\x7f\x6b\x14\x4c\x96\x23\xf1\xa5\x3f\xfc\x5b\x99\x15\x71\x94\x44\x38\x1c\x17\x60\x22\xff\xc5\xc4\x46\xc8\xea\x37\xaa\x99\x97\xa9\x3e\x46\xc5\xb1\x77\xa6\x52\xa0\x7a\x6a\x83\xb3\x0f\xc6\x62\x26\x5e\xc3\x2b\x2b\x47\x6a\x86\x83\xa7\x79\x77\xc9\x60\xc8\x86\x2d\xb7\x0a\x34\xc6\x67\xed\xb9\xbf\xef\xac\xa8\x25\x71\x78\x35\xf1\xfb\xe3\x36\xb7\x3e\x3b\xd6\x76\xae\x70\x2f\xf4\x3f\x7b\x63\xef\x54\xfc\x5f\x7a\x7d\x04\x99\xcf\x35\x5b\x06\xcb\x58\x02\x54\x6d\x79\xb7\x4e\x90\x47\x1a\x38\x32\x62\xcb\x6f\x7c\x0e\xca\xbf\x82\xf2\xcb\x98\x7c\xb1\x3d\xa1\x0f\xd2\xcc\x75\xb0\xc3\x13\x85\x7b\x63\x68\xc2\x67\xd7\x6a\xed\xb9\x1d\x86\x15\x1f\x1c\x8b\x56\x7e\xcf\xb6\x5e\x73\xae\x11\xb0\x0f\x4f\xdf\x60\x24\x9b\x56\x05\x02\xcf\x70\xb0\x01\xbb\x56\xeb\xed\x0e\x09\x80\x45\xa3\x85\x4d\x00\x89\x8d\x42\x1d\x09\xf7\x39\x8a\xdb\xbd\x3e\xda\xd6\x2b\xcb\xea\x58\xcb\xce\x22\x7d\x06\xe8\xd3\x1c\xb8\xbc\xfe\x43\xe6\xf0\x8f\x66\xd6\xd1\x05\xcb\x2a\xc4\x70\x0a\xce\x41\x6c\xab\x5d\xab\xb5\xa9\x85\x6d\xb7\x5d\xdb\xa0\x33\x96\xe1\x42\x6f\x36\x9f\x9e\xbf\x6f\xbb\x89\xd9\x93\xae\x87\x3a\x50\x93\x45\xaf\x38\x38\x4c\xda\x64\x71\x43\xdf\xdf\x47\xcc\xc1\x24\xf4\xb4\xd8\xd9\x3e\x37\xe1\xd5\x17\x72\x85\x18\xc9\x13\x37\xb0\x7f\x51\x6b\xf4\x9c\x42\x95\x70\xa0\xa4\x79\x23\xaf\x95\xa8\x57\x95\x62\xf3\x07\x86\x67\x46\x38\x92\x45\x0a\xc2\x82\xd0\x67\x39\x4a\x49\x2a\x25\x3b\x71\xbd\x22\x70\xe0\xb9\xd2\x33\x98\xdf\xce\x46\xf1\x0c\xa1\x0b\x29\xad\x1d\xda\x6a\x9c\xab\x10\x9d\x13\x11\xf7\x68\x0a\x36\xec\x77\xc3\x0f\xac\x4f\xd1\xb8\x93\x1b\xd9\x91\x66\x14\xe3\x0d\x24\xfb\x2d\x9a\x9a\xaa\x97\xef\xe8\x5f\xe5\x5c\x38\x40\xde\x00\x20\xf3\x6a\x29\x0c\xf8\xbc\x76\xe8\xfa\x41\x3e\xdc\x8d\xdb\x72\x43\x7f\x7f\x1f\xfa\x07\x8b\x46\xaf\xf1\x99\x2a\x90\x0a\x4c\x15\xad\xcf\xfd\x7d\x3a\x71\x02\x89\x32\x6c\x82\x2b\x09\x14\x9d\x08\x71\xad\xd6\xbf\xcb\xdc\xb8\x8f\xbc\x2e\x1a\xfd\x39\x11\x87\x4f\xad\x6e\x1f\x73\xb4\x0e\x4f\xda\x50\xc4\xb0\x76\x8f\x8f\x69\x6b\xe9\xbc\x24\x0a\xb0\xab\x9a\x38\x16\x1e\x6b\x3b\x72\x9f\xc9\x49\xd3\xdc\x97\xec\x67\xcf\x66\xb9\x77\x79\x1d\xfb\x35\x26\xc6\x94\x32\x0e\x5c\x30\x74\xf7\x48\x26\xd9\x9c\x03\xc6\xd0\xcf\xc5\x7a\xe4\xd2\x60\x0a\xda\xc5\x29\x84\x30\x65\xea\xf7\xcc\x63\x4a\xeb\x29\xdc\xa0\x7f\x78\x0f\xe1\x0f\x3b\x17\xa5\x59\xc7\x31\x04\x41\x0d\x7b\x37\x83\xb2\xb0\x17\x3e\x59\xcd\x56\x60\xb6\x60\xc1\x2b\xea\xd5\x72\x59\x56\x8d\xe3\x11\x84\x54\x46\xd8\x21\xb7\x95\x5c\xfe\x4a\x57\x9a\x3a\xb8\xbc\x00\x03\x70\x13\x7c\x39\x8b\xd9\x4a\x87\x71\xea\x78\x8f\x87\xab\x4b\x57\xcf\xaf\xb2\x22\xdd\x3b\x0c\x7a\x4f\x2d\xf8\x49\x36\xb2\xab\x72\x7f\x85\x5d\x5e\xf9\x0d\x88\x42\x73\xe2\xa8\xab\x4d\x2e\xcd\xb1\xc9\x70\x44\xc1\x8f\x54\x8b\xcb\xe0\x98\xa2\x05\x65\x50\x9b\x00\x74\xcf\x43\x45\xeb\x75\x31\x81\xe7\x34\x5b\x36\x32\x63\xb1\x23\x82\xc1\x30\x76\x05\xd6\x1d\xcd\x68\x03\xca\x62\x2c\xd0\xa6\x06\x6c\x29\x69\xb0\xe0\x96\x95\x97\xf2\x7a\x2c\x8a\xb2\x5c\x86\x94\xf4\x9a\xa5\xc7\x43\xc5\x47\xcf\x4b\x8f\xbd\xa7\xd8\x66\x9e\xd5\xc3\xd2\x5a\x35\xd0\x5f\x47\xe6\x0b\x44\x5b\xdb\x16\x7c\x8d\xf2\xfd\x2b\x41\xce\x5e\xda\xc0\x64\x40\x2a\x95\x8d\xfc\x45\x15\x3f\x17\x8f\x27\x35\x53\x85\x5e\x30\x9c\x08\x1f\x4f\x83\x2a\x87\xff\x7c\xfe\x92\x04\x43\xde\x4f\x5c\xd8\x32\x97\xe8\x64\xec\xaf\x7a\x4d\x86\xea\x17\x72\x7d\xa5\x5e\x32\xd7\x7b\xb7\x90\xc1\x7a\xc8\x8a\x30\x1e\x11\xb3\x72\x8f\x10\x43\x13\xa7\xa6\x98\xa8\xd7\xa9\x89\xde\x0a\x49\xa4\xe8\x87\x37\x8c\x9f\x2d\xf8\xcf\x17\x3f\x8c\xa0\xb1\x72\x00\xee\x28\xeb\xa6\x1d\x59\x8f\x42\xb2\xc1\x4a\x29\xd4\xad\xb7\x48\x62\x6e\x0e\xc6\x24\x69\x59\x37\xe2\x44\xd3\x36\xb6\x4e\x9a\x32\x52\x01\x78\xab\xcb\x49\xdc\x4b\xb3\x9b\x5e\x3f\x3a\x0a\x03\x13\xe2\x9b\xcb\x58\xc4\x3d\xcd\x9a\x62\xdd\x93\xfe\x67\x76\xf2\x4f\xdc\x09\x69\x83\x52\xf1\x6e\x8e\xb1\x9a\xb0\xf7\x5d\xa7\x29\xb3\xd4\xb2\x8d\x63\xd6\xd2\xec\xa1\xc4\x80\x3a\x40\xca\xfb\x30\x18\x8c\xbb\xd6\xd1\x8b\xb6\xa3\xb7\x13\xd8\xa4\x21\xca\x11\x91\xd3\x7f\x6f\x21\x46\xe1\x5f\x36\x92\x22\xed\xfa\x2e\xfe\xb9\x85\x10\x82\x38\x6e\xa4\x43\x1a\x7b\xf8\x6b\x0b\x15\x88\x54\xb3\x91\x48\x1b\x0a\x88\x23\x61\xf8\x60\x1f\x8e\xe6\x4b\x7a\xfd\xe1\x33\x70\x7f\x2f\x22\x08\xd9\xf4\x34\xda\x5c\x8b\x07\xf5\xc4\xc6\x1f\x41\x4e\xdb\xad\xb4\x56\x04\xce\x72\xa0\xac\xbc\x18\x1b\x66\xf5\x7a\x00\x47\x36\x77\x20\xd0\x82\x3c\xcb\x91\x53\x5d\xce\x44\xb8\xfa\x8f\xba\x1e\xe1\xc0\xda\x0c\x8f\x2d\x8b\x53\x7b\xe2\x7c\x0c\x05\x28\x81\x34\x9b\xda\x7b\x16\x73\x52\xe1\x53\x3c\x22\x88\xb7\x11\x36\x4e\x50\x41\x39\xee\x1e\xdf\xc0\x2e\x94\x7a\xd3\xbb\x9c\xc4\xed\x43\x11\xcf\x3f\xdd\x54\x2d\x2b\xc3\x71\xa8\x7f\xf5\xed\x51\x65\xa1\xd3\x5c\x06\xf7\x6c\xc8\xce\xb3\xe0\xfe\x81\x0c\x59\x3c\x22\x22\x20\x6e\xe5\xed\xc0\xb3\xb6\x13\x61\x66\x44\x9f\x4d\xc4\xc4\xd8\x97\x98\x26\x18\x83\x4c\x34\x1f\xff\x42\xee\x78\xf0\x7c\x6c\x2d\x3e\xac\x49\x57\xb9\x6a\x3a\x2c\x6e\x5a\x3c\xa3\xcd\x1d\xd8\xab\x6c\x27\x47\xc2\x5b\xde\x26\x36\x17\x33\xe6\xe9\xaa\x32\x8a\x0d\x0e\x5d\x1d\xf7\x2e\x17\xb1\x89\x46\xdd\x51\x8d\x83\x02\xef\xd3\x04\xf3\x87\xfc\x0d\xf2\x07\x0e\x09\x82\xb7\x79\xdd\x37\x08\x70\x61\xe7\x3d\x5e\xc6\xba\xfe\xef\xe4\x31\xac\xdd\xdc\x75\x05\xf1\x72\xb9\x7a\x86\xf9\xf9\x7a\x6f\x68\x9d\x0e\xbf\xa1\xcb\x6f\xdf\xbf\x8d\x3b\x6f\xde\x6b\xb5\x76\x0a\x0d\x1e\x85\xdd\xe8\x4d\x36\xbb\xff\x7a\x6e\x2a\x1e\x84\xdc\x23\x20\xcd\x60\x56\xfd\x38\xe4\x3d\x9c\x19\x78\x7a\x30\x31\xf2\x00\x1f\x8c\x1c\xe6\x09\x51\x6a\x2e\x1b\x91\xd5\x06\x8f\x38\xf0\xe9\xe2\xe3\xde\x5e\x69\xed\xed\x12\xf7\x2e\xcb\xf8\x81\xa5\x99\xb0\x75\x88\x12\x89\xde\xa7\xe6\x83\x1e\x4d\x27\xdf\xfa\x78\xac\x5b\x4f\xd0\xf0\xe0\x7e\x8d\x66\x56\x68\x96\x8b\x6c\x0d\x9f\x02\x7e\x51\x05\xfd\xf5\x6a\xb1\x6c\xd6\x3b\x76\x79\xb2\x55\xc7\xfd\x34\xc0\x46\x4c\x54\x6a\xb2\xaa\x48\x37\xc0\xd7\xa2\x17\xf9\xc7\x37\x1b\xb0\x66\xda\xc4\x54\xa9\x6e\x5b\x73\xeb\xda\xec\x23\x9a\x6a\xf1\xdc\xfe\x7c\x5c\x27\xd0\x86\x2a\x34\x5c\x08\xb6\x90\x29\x03\x87\xe8\xa5\x8a\xfb\x11\x57\x1e\x4f\x98\x0f\x0f\xa3\x04\xaa\xb6\xd8\xde\xd2\xed\x97\x21\x03\xad\xb5\x6b\x00\x57\x40\x90\x47\x4f\xea\x89\x59\x1a\xa7\xaa\x2a\x64\xb5\x7e\x75\xb7\x64\xd9\xd0\x22\x0a\x72\xda\x97\x00\x6f\x80\xd9\x70\x6c\x1c\xd3\xfe\xa6\x75\x13\xf7\x1e\x53\xb9\x55\xa4\x03\x67\xcb\xa6\x26\x76\x18\xec\x24\xb2\x46\xb2\xe2\x30\x9c\x29\xa0\x14\x8b\x65\xff\xe4\x72\x11\x3f\xed\x8f\x2f\x17\xf1\x61\x9f\x07\xe3\xf5\x2a\xe4\x91\xf2\xdc\x74\xfc\xa2\x0a\xab\xc1\xc2\x9f\xe1\x26\x13\x62\x6c\x18\x28\xd3\x4d\x75\xca\x7f\x26\xfb\xa6\x1d\xdb\xda\x28\x60\x75\xf0\xc0\x4e\xf9\xb3\xca\x97\xf6\x91\xc4\xa1\x1a\xa3\x54\x79\x14\xc0\x4d\x1b\xaf\xdd\xa3\x00\x4a\xfa\x90\x44\x11\xfb\xd3\x0a\x04\x31\x4f\xee\xf7\x6d\xd4\x0f\x5e\xfa\x99\x5f\xfa\x59\x77\xe9\x67\xb6\xf4\x63\xb5\x1f\xf6\x7e\xa0\x82\x9b\x16\x4c\x15\x7d\xe7\x30\x6e\x9e\xf1\xe5\x26\x7c\x37\xb4\x22\x41\x2c\xd0\x1d\x67\x74\xd9\xe6\xd3\x9d\x6e\xcb\xd3\xb2\x22\xa7\x65\x1c\x53\x9d\x9a\x15\x06\x81\xdc\xfa\x30\x3b\x39\x35\xcf\xea\xc6\x98\xa2\xa9\x14\x17\x2d\xc3\x04\x26\x8f\x63\x66\x4c\x67\x5e\xac\x51\x97\xcc\xf3\x7e\x54\x4a\xcc\x9b\x66\x59\x8f\x47\xa3\x9b\x95\xfa\x5b\x3d\x2c\xab\xd9\x68\xb6\xca\x52\x35\x02\x28\xb4\x79\xb3\xc8\xbf\x01\x0d\x6f\x59\x89\x05\xa8\x31\x8b\x69\xe9\x30\x03\x35\xe3\x14\xa3\x27\xa2\xc9\x96\x46\xbb\x49\x3a\x21\x64\xb3\xfc\x1e\xf2\x28\x3e\x1b\xdb\x05\x88\x2c\x2b\x47\x11\x24\x8e\xd9\x78\xf4\x9d\x12\xcd\x6a\x50\xf9\xf8\xb9\x45\x77\xc0\x56\x94\x2e\xf8\x99\xf5\x9d\xcb\x56\x31\x6e\x00\xc4\xad\x34\x5b\xa9\xef\x1f\x66\x50\x2e\xfa\xd2\x6f\xbd\x34\xa2\x68\xde\x92\x81\x98\x58\x1f\x7d\x8e\x6c\x9c\x08\xa7\x5f\x00\xdb\xf1\x61\x2b\x79\x21\xd7\xc6\x04\x19\x6c\x12\xf2\xa8\x46\x97\x30\xe3\x94\x9d\xa9\x5a\x5c\xa9\xa9\x9e\x0c\x08\x32\x2b\x2b\xe6\x9b\x30\x34\x35\x67\x18\x6e\x58\x15\x4e\x5d\x11\xfb\x17\x11\x72\x9c\xa9\xfb\xe2\x33\x36\xf4\xe0\x18\x8b\x1d\x88\x28\x01\x3c\x32\x6c\x1b\xae\x6a\x12\xb9\x98\xac\x62\xca\xf4\xae\xd5\x7a\x6c\x16\x36\x7c\xf7\x5e\x6d\x21\xce\xc5\xd4\x51\x60\x31\x07\x2c\x85\x4a\x4d\x2d\x05\xf8\xee\x51\x70\x05\x3d\x51\x8f\x97\x86\x0f\x63\x70\xa5\xe1\x15\x2f\x2b\xe5\xca\xb3\x28\x22\xb6\xe8\xb2\x52\xed\x52\x95\x9a\x94\x55\x2a\xca\x2a\x9b\x65\x85\xcc\x21\xb8\x2f\x3c\x5c\xeb\x4d\xc0\x8c\xc6\xd1\xb7\xbe\x97\xd5\x3d\x0f\x56\x67\xd3\x55\xd1\x56\xda\xc8\xd9\x98\xa0\xa6\x19\x2b\x38\xf7\xc7\x8c\xee\x44\x50\x88\x66\x17\xa0\xb9\x69\xe1\xd5\x1b\xa0\x25\x90\xe9\x38\x55\x58\xd7\x05\xc9\x34\x24\xcc\x8b\xf7\xa3\x3e\x6b\x84\x17\x8c\x84\x87\x3b\x6d\xf5\x09\x52\xc7\x9f\xcd\xb1\xfb\xbe\x2a\x97\xb5\xcb\x8d\xd7\x14\xaf\x7f\x3f\xbd\x7b\x03\x0b\xba\xe6\x13\x54\x2e\xdb\x94\xd3\x72\x01\xd4\x3a\x88\x63\x81\x0e\xe2\xf8\x6a\x45\x4f\x28\xac\x06\xdf\x34\xc1\x54\xc1\xac\x10\x6a\x97\xcb\x62\xa1\x39\x3e\x1e\x1a\x24\x10\x55\xb4\x8c\x78\xf5\x28\xda\x3c\x2f\x5e\xbd\xb7\x55\x30\x1a\x11\x6c\x2b\xa8\x4b\x76\xb6\x43\x91\x32\x45\x95\x83\x4a\xdd\x84\x3e\x6a\x87\x57\x7f\xb1\x7b\x8f\xab\x66\x5a\x0d\xe9\xae\xca\x85\x89\xec\xea\xfa\x47\xf7\x39\xc8\x1d\x5c\x13\x58\x45\xee\xa4\x23\x88\x01\x57\x1b\xfc\x6c\x75\x01\xfd\xf2\x3e\x63\xc8\x21\xd3\x15\x48\xe4\xca\xfb\x89\xcc\xf3\x2b\x39\xb9\x0e\x72\x98\x64\xcc\xc4\x62\x80\xf8\xd9\x14\xb3\x5e\x68\x2d\xb7\xc0\x09\x83\xdd\x72\x3d\x4d\x0c\xbf\xa3\xb6\x50\x50\xb5\xfc\xeb\xa5\xc5\xa1\xda\x08\xcc\x2b\x3a\x09\xba\xb1\x08\xc8\xda\xa1\x75\xc6\x9c\x74\x24\xe9\x7f\x2c\x32\xcc\x28\xd9\x1b\x25\x74\xdb\x8f\xbe\x44\x47\x46\x24\x06\xc8\x34\x28\x70\x5b\xc9\xa5\xeb\x96\x79\x68\xf1\x66\xe2\x98\x3f\xc1\x80\x96\x88\x73\x12\x78\x5b\xda\x40\xca\xbe\x1a\x75\xd3\xb3\x9f\x7d\xa2\x74\x7e\xeb\xb4\xd6\x89\xec\x4e\xd3\xce\x53\x39\x33\xc1\xb1\xdc\xb1\x6b\x0e\xc4\x5d\x73\x22\x76\x46\x63\x89\x98\x3b\xe6\x99\x95\x5a\xe7\xb2\xfe\xb0\x2a\x9a\x0c\x1e\x1b\xad\x01\xbd\xc3\xa3\x4d\xb3\x2a\x01\xf3\x41\xca\x75\xd4\x01\x3c\xab\xab\xdd\x62\x2e\x9f\x66\x15\x65\xb2\x0e\x36\x8c\xa0\x67\xef\x4f\xc6\x2a\x56\xff\xe5\x9a\x7c\x96\x66\x08\x09\xc4\x40\x85\x66\xdc\xa0\x9f\x36\x5f\x96\xab\x01\x90\xb5\x45\x51\x73\xb0\x90\x45\xb6\x5c\x61\x3c\xfe\x17\x1f\x4f\x87\xae\x14\x0e\x56\x8d\xe2\x20\x84\x08\x44\x94\x72\x74\x61\x94\xa2\xa2\x76\x4e\xca\x15\xc0\xf9\xc9\xaa\x31\xa5\xfd\x6e\xec\xee\xce\xe8\x42\x04\x83\xc6\x38\x63\xcb\x27\xc1\x95\x73\x1d\xf0\x66\xc2\x77\x81\x40\x2b\xb4\xcf\x10\xbb\x8c\x0e\x5f\x33\x1c\xe6\xf8\x25\x18\x47\xfe\x99\x92\x28\x87\xff\x26\x88\x97\x12\xe4\x3a\x9d\x2e\xc5\x2d\x6e\x12\xbc\xf6\xba\xec\x7d\xa6\x42\xd5\xa9\xb2\x9a\x21\x75\x59\xcd\x78\x73\x00\xcb\x12\x9b\x12\x94\x70\xcf\x8a\x50\xce\xfe\xdc\x58\xb1\x7b\x03\x66\x95\x1b\xbe\xe6\xd8\x05\xb9\xdc\x07\x23\xbd\xc5\x12\xef\x22\xea\x54\xd0\xf8\x7c\xa9\x6b\x57\x4a\x83\xea\xcc\x5c\x5e\x8e\x1e\xfd\xac\x6d\xf1\xc7\x42\xa7\x1a\x30\xa9\xd4\x82\xbd\xac\x1b\xf4\x84\x80\x94\x1d\xe6\x3f\xc3\x2e\x6a\xd1\x6b\x9f\x95\x73\x71\x6f\xb1\xaa\x1b\x73\xa1\x72\x01\xb2\xd1\x0b\xce\xc4\x61\x8b\xfa\x5c\x36\xb0\x55\x7a\x1e\x56\x8e\xfd\xf3\xa7\x38\x23\xc1\xb3\x97\xb1\xa1\x8f\xad\xe0\xcc\x3a\xbc\xc1\x1c\x7f\xa6\x17\x32\x66\xb3\x08\x93\x1e\xd4\x31\xc4\x6c\x34\xcd\xe1\x23\xd9\x59\x67\x91\x50\x75\xec\x1b\x44\xb5\xde\xf5\x83\x16\xb0\x97\xb9\x2e\x43\xc6\x0d\x26\x2d\x4c\x64\x80\x59\x05\x81\xc3\x6a\x2b\x76\x02\x13\x17\x26\x51\x8c\x2f\x57\x31\x76\x83\x3b\x6a\x40\xf1\x7e\xdb\x94\xab\x65\x84\xe8\x55\x8d\xa6\x2a\x50\x16\xac\x46\x7c\x2d\x68\xab\x2b\xfd\xb6\x59\x8c\xa3\x05\xbd\xd0\xf4\x7c\xbd\xcb\x8e\xf7\xb6\xf8\x35\x6f\x77\x61\x33\x03\x95\x0f\xae\xb0\xa9\x3e\x01\xfc\xfb\x71\xfc\x11\x38\x40\xec\xc9\x83\x7d\xff\x62\xec\x5d\x8b\x3b\x9f\xf1\xac\xf6\x0a\x54\xa0\xdc\x7a\x0a\x95\xa2\x40\xd0\x2a\xff\x36\x3d\xe8\xd9\xe7\x2d\xe4\x3b\x63\xfb\xbb\x17\xe8\xb5\x1e\x26\xb0\xb3\x55\x0b\x46\x2f\x24\xde\xfb\xc8\x67\x73\x63\x25\x05\x74\x32\x45\x06\x3d\x2d\x8c\xa9\x55\x5b\x6d\xf6\xd8\xf9\xfc\x7f\x87\xc2\xec\x9f\x53\xce\xf4\x2e\xf3\xff\xb0\x22\xa6\x63\x13\x3e\x4e\x09\x63\x96\x49\x97\x76\x13\xe0\x92\x3e\x5e\x67\x4b\xa7\xea\xa4\x75\xe2\x12\x00\x1f\xc5\xcd\xa0\xff\xa0\xca\xe3\x30\xf3\x78\x56\x81\xce\x1d\xc1\xb1\xc0\xaf\x30\x38\xc3\xf0\xe2\x66\x90\x0d\xea\xac\x98\xe5\xf4\x26\x63\xe5\x99\x07\x9c\x41\x35\x5d\x62\x12\x3c\x65\xb3\x3e\x32\xcc\x00\x9a\x4c\x54\xd0\x85\x9c\x2f\xf6\xc6\xa4\xad\x04\xdb\x3b\xec\x78\x04\x02\x8b\x7f\x80\x02\xcf\xfe\x01\xaa\x89\x53\x38\xe8\xdc\x60\xef\x38\xcd\x75\xf3\x36\xcc\x68\xbb\x9b\x74\xaa\x63\x9d\x22\xfc\x69\x28\xd4\xba\xe9\xa2\x86\xbe\xb5\x6f\xf4\x81\xf5\xbd\x1d\xd1\xe0\xd4\xf2\x0d\xef\xe3\x89\xbd\x65\x75\x19\xdb\x6b\x42\xed\x6e\xda\xdd\xd5\xfa\x44\xb2\x54\x9f\xbd\x23\x3b\x57\xa0\x66\xae\xfc\x12\x20\xfd\xaa\xd4\x46\x6e\xb1\xcb\x0e\xd0\x1a\x87\xba\xf4\xd3\xb1\x28\xca\xce\x52\xfa\xeb\xe1\x98\x4c\xfe\xbb\xe9\xc6\x14\x29\x4a\x89\xc3\x41\xae\x45\x28\x91\x2a\xb5\x14\x85\xaa\xc1\x7e\x57\x57\xd2\xd7\x64\x9e\x8d\xc5\x74\x05\xc8\x15\x1d\xd5\xb0\x6d\xd6\x9e\x48\x7c\xba\x32\x93\x09\x68\x15\x7c\x22\x5b\x1e\x25\x4f\x8f\x36\x28\xae\xda\x3e\xc3\x3e\x2c\x2a\x40\x4d\xb6\x50\x06\x8c\x19\x88\x95\xeb\xb8\x11\x40\xd1\x64\xc5\xaa\xe5\xf6\xa8\xaf\x24\x5e\x37\x40\xf7\x6f\xd1\x66\x85\x08\xdf\xf6\x28\x36\x90\xff\xdc\x57\x2e\xd4\x86\x35\xd5\x51\xc1\x84\x5e\x9c\xf4\x0a\xeb\xf3\xed\xa7\x47\xe4\x99\x03\x90\x33\x2f\xc8\xae\xb5\x1d\xaf\x14\xff\xbe\x96\x06\xc4\xb7\xb6\xf2\xd0\xbf\x16\x6c\x00\x86\x6d\xf7\xbd\x0b\x7d\xb8\x05\xa3\x1d\xc2\x0f\x3b\x66\x16\xe2\x12\x03\x13\x0b\x58\x3f\x42\x5c\x22\xca\x41\x60\xb4\xb5\x01\xe3\xa0\xfd\xce\xcd\x4b\xc3\x9b\x62\x87\xf3\xb4\x75\xec\xb7\xa1\xc4\x3b\xec\x97\xf0\x03\x3a\xf6\x6e\x7e\xd4\x06\x2c\x7d\x9b\x27\xec\xd0\x29\xa0\x6b\xb9\x48\xe8\xee\xbd\xfd\x06\xe5\x38\x0c\x4a\x68\x3d\xc2\x77\x90\xcf\x42\xaa\xbb\x72\x92\x67\x36\xb8\x2d\xc2\x86\xeb\x5b\xe4\x59\x99\x57\x4a\xa6\x6b\x8a\x21\x95\x8a\xac\x10\x97\x75\x8c\x4c\x77\xec\xcc\x70\x3e\x2e\xd5\x24\x93\xf9\x5b\x75\xab\x6f\x05\x75\x78\x8b\xc4\x56\xe8\x56\xf6\xb9\x99\x88\x77\x2a\x9b\xb0\xee\x13\x3e\x60\xae\x3f\x2a\xee\xba\x9e\x52\x66\xa2\xbd\x81\xb4\x89\xfc\xd7\xba\x47\xb2\x20\x7f\x3c\xe4\x9f\x96\x19\x5d\xac\xa8\xa3\xf6\x39\xdf\x2d\x6b\x1e\x79\xdc\xab\x77\xd9\x40\x8b\x2d\xd5\xc7\x98\x3c\x59\x09\x30\x0c\xca\xae\xaf\xad\xbd\xcf\x46\x48\x43\x50\x68\xff\xac\x92\xfc\x7a\xe5\x82\xab\x48\x03\xfc\x6c\x5f\xcf\xa5\x51\x24\x74\x1c\x62\x5f\x7a\xb6\x09\x60\x0c\xbc\x77\xe8\x03\xbb\x3a\x68\x53\x23\xdf\x20\x9e\x35\xd8\xeb\x62\x01\x70\x9f\xdf\x0d\x11\x20\x8c\x52\x25\x29\x56\x79\xee\x29\xc5\xbd\x37\x02\x9b\xad\x67\x90\xd3\x79\x56\x5b\x83\x97\x99\x5a\x70\x22\x22\xb0\x43\x82\x1a\x22\x67\x38\x42\x85\xda\x8e\x81\xe2\x40\x44\x28\x1c\x72\x45\x32\x4c\x55\x56\x0b\xdf\x2c\xaf\xa1\x40\x68\xb2\x76\xc6\xee\x4d\x29\xea\xf9\xaa\x10\xd3\xbc\xbc\x8d\x6a\xb1\xd4\x5b\x69\x91\xd5\x80\x53\x02\xbc\x6a\xe1\x81\xac\x73\xa3\x3f\x32\xe2\x60\x55\x6e\xbe\x20\xfc\x7b\xec\xf5\xdc\x3e\x9a\x90\x39\x3b\xef\xaf\xb5\xbe\xe9\xb2\x1e\xfc\x1a\x63\xbd\xd6\xd6\x83\xf7\x17\x11\xb3\xe7\x1a\xa6\x21\x8d\x36\x1d\xf0\x90\x7d\xd3\xe9\xbe\xc4\x38\x4b\x90\xc7\x1e\xee\x5b\x9d\x68\x3e\x07\x9a\x3d\xd2\x92\x69\x0a\x5c\xa9\x87\xdb\x63\x23\x4b\x83\xec\xb4\x75\xba\xb4\xe5\x1b\x14\x5d\xb4\xc0\xbe\xf9\xf6\xbb\xef\xff\x98\x88\x6f\xfe\xf0\xec\x4f\xdf\x31\x70\xc4\x0d\x95\x75\xb0\x74\x0b\xc4\xe1\x54\xf1\xe7\xab\x67\x4f\x9f\x7d\x37\x9a\x25\x22\x3a\xc7\xbf\xc9\x4d\x2c\xc8\xf2\x3d\xcb\xf2\x7d\xe4\xb9\x88\xa0\x7f\x79\x0d\xa1\x3e\x6f\xcb\x2a\xad\x8d\x35\x00\xa0\x5f\x2c\x97\x0a\x14\x50\x60\x17\xe6\x8e\x8b\x3a\x11\x57\xab\x46\x94\x4b\xbc\xa6\xa1\x03\x18\x90\x5a\x2f\x55\x39\x4d\x00\x09\x5c\x16\x13\x55\x62\xd0\xdb\xac\xc0\x80\xa5\x79\x5e\xde\xaa\x74\x87\x26\x71\x9e\x5d\x65\x8d\x4a\xff\x82\x15\x03\xae\x43\xa1\x6e\xc5\x07\x35\x7b\x75\xb7\x8c\xa3\xf3\xf3\xab\x48\xcf\x07\xb8\xed\x97\x49\x36\x4d\xa6\x65\x95\xe4\xaa\x49\x0a\x75\x9b\x34\xd5\x3a\xb9\x91\x55\x32\x91\xb5\x4a\xf4\xb9\x99\xdc\x66\xcd\x3c\x91\xb7\x32\x6b\x12\x90\x8e\x92\x89\x6c\x26\xf3\x64\x92\xcb\xba\x4e\x00\xc7\x2e\x41\x30\xb2\xa8\x5e\x2d\x55\x95\x34\xf3\xaa\xbc\x4d\xc0\xaa\x2f\x59\x67\x2a\x4f\x13\x04\x07\x48\xd4\xdd\xb2\xac\x9a\x24\x5b\xc0\x3f\x38\xfa\x49\x7d\x9b\x69\x6a\x74\x28\x18\x4a\x68\xed\x5f\x27\xd3\xac\x90\x79\xbe\x4e\x8c\x1c\x99\xa4\xea\x6a\x35\x9b\xa9\x2a\x31\x33\x9d\x18\xb6\x51\x47\x3b\xfd\x61\xbd\xcc\xb3\x06\xb8\x2e\xf1\xdf\xf3\xf3\xab\x7b\xdd\x61\x50\x8b\xc2\x1f\x47\x6c\x6e\x56\x85\xac\xd6\x6c\xb8\xd9\x14\x19\xdb\x3d\x59\x5b\x1c\xf6\xd1\x02\xfc\xdf\x10\x94\x01\x06\x1b\xca\xbf\x33\xc5\xb7\x8f\x34\x8e\x01\x4d\xa4\xbe\xdc\x6f\x68\x6f\xfd\xe4\xfc\x3c\x3e\xfb\x74\x7e\xde\xbf\x78\x72\x7e\xde\xe7\xad\x0f\x3e\x99\xbe\x40\x4c\x79\x81\x47\x37\x84\x1e\x65\x0b\x0a\x41\x3e\x74\x06\xd4\x3b\x21\xca\x47\x14\x9f\x8c\xcf\x3e\x45\xe7\xe7\x17\xf7\xe7\xe7\xc3\xfe\x93\xe8\xbe\x07\x29\x3d\x9b\xd2\xbb\xff\x2b\xa4\xfc\xd5\xa6\x9c\xef\x9d\x7f\xbe\x3f\xff\x12\xa4\xfe\xb5\x95\xef\xaf\xa3\xd9\x91\xbd\x72\x21\x8a\xfd\x55\xae\x16\x60\x9f\xcb\x1a\x06\x6b\xd7\x82\x38\xba\x8d\x8b\xa5\x5e\x55\x95\x9e\x8f\x58\xd6\x2c\xba\xb8\xc2\x44\xe3\xe1\x4b\xca\x5d\xc3\xc1\xe0\xce\xab\xc5\x50\x54\xde\x62\xe6\xf0\xa1\x0a\x53\xdb\x71\x14\xb8\xf8\x4a\x25\x1f\x92\x5f\x5b\x2e\xf7\x1e\x28\x9c\x6f\xc7\xba\x39\xe0\x20\xf6\xcc\x44\xd7\xf3\x71\xe5\xd8\x2b\x91\x21\x14\x84\x64\x10\xad\x08\x77\x88\x33\xec\xe7\x70\xe1\xaa\xa8\x87\x71\x8f\x5b\x3c\xb1\x30\x0d\xbd\xbe\x37\x70\xe6\xbf\xad\xb1\xd0\xda\x15\xc1\xc3\xba\x89\x52\x15\x6f\x0e\x34\xb5\xbd\xb6\x2e\xc2\x76\xf9\xfc\x0b\xd4\x77\xc2\xbf\x3a\x11\xac\x1e\x02\xda\xc2\xe3\x74\x03\xd0\x96\x0f\x54\xe5\x16\x66\x1b\x5b\x2b\x68\x20\x43\xa5\x72\x43\xde\x86\x9e\xda\xe9\x1a\x11\xc8\xe6\x36\x58\x42\x08\x86\xea\x2e\xdc\x0c\xad\xc5\x0f\xf3\x25\x62\x75\xb7\x4c\x84\x97\x9f\x79\x1a\x65\x70\x47\x39\xd6\x3b\xd8\x9e\x81\x1e\x5b\x49\xb8\x94\x4d\x67\x9e\x89\x23\x47\xc5\x29\x4e\x52\xc8\x34\xb9\x27\xaa\x2b\x05\x36\xd6\x58\x6c\x32\x97\xd5\x8b\xc6\xfb\x3c\xcc\x8a\x54\xdd\xa1\xf9\x3c\x68\xd6\xf6\x5c\x88\x71\x68\x3b\xda\xf1\x1b\xed\x26\x2a\x53\xd1\x54\xe8\x7f\xcb\x1b\xf9\x11\x03\xfc\xf8\xec\x9f\xb3\x7a\xd8\xd4\x3c\x66\x89\x11\x6d\x78\x23\xce\x9e\x5e\x98\x70\xf4\x1e\xd3\x15\xec\x7e\x88\x41\xeb\xb9\x4d\x5e\x7b\xe6\xda\x03\x7f\xd4\x19\xe9\xc5\x30\xa8\xf6\x1c\x75\xae\x85\x29\x62\xb6\x45\x51\x8b\x38\x15\x78\x9d\xaa\xa2\x81\xf7\x47\x2c\x00\x1a\x63\x83\x55\x8e\xfa\xe3\x47\x97\xb5\x5a\x66\x5b\xde\xa4\x7c\x35\x89\x67\x8f\x20\xd1\x8e\x2b\x66\x89\xc1\x71\x9b\xa5\xa4\x58\x06\x74\x55\x82\x89\x86\xab\x00\x1e\x00\x8e\xbb\xe3\x81\x8c\x05\x4c\xcc\x24\xbd\xa2\xed\x7a\x6a\xaa\xb5\xdd\xcc\xfa\x70\xb7\x9e\x8c\x71\x4f\x2f\x75\xc0\x9a\x87\xc2\x9a\x0b\x5d\xf6\xfa\xd6\xbf\x08\xc4\x24\x11\x33\x76\xcd\x97\x66\xdc\xcb\x8a\x1b\x99\x67\x10\xb3\x1c\x23\xaf\x1c\x88\x9e\xc0\x65\x66\x09\x86\x4b\x6b\xdc\x5e\x5b\x7d\x2f\x5c\x50\x7b\x73\xbb\x65\xb9\x69\x87\xbb\x1e\x06\xfd\x63\x8f\x02\xea\x6e\x49\xd8\xdc\xad\x7e\x75\x6c\xf8\xed\x5c\x82\xf8\x40\x87\xa4\xca\x8c\x6c\x38\xc5\xee\x11\xb4\x0c\x76\xd3\xf6\x26\x12\x5b\xf7\xf5\x43\x3b\xfb\xbc\x10\xe2\x83\xbc\xdd\x3e\x05\x44\xab\xdb\xb3\xac\xbb\xc9\x66\xf2\x5b\x74\xd5\x70\xa1\xea\x5a\xce\xf0\xee\x24\xb2\xe2\xbc\x38\x2f\xbc\xf6\xe2\xff\x9c\x61\x72\xfb\xfb\x83\x0d\xc6\x72\x1d\xe1\x84\xba\x01\xdf\x11\x7e\xfa\x67\xef\xcd\x79\xd3\x12\xb2\x0a\x58\xb6\x92\x26\x56\x11\x67\xd6\x4e\x55\x75\xb1\xeb\xcf\xfa\xd7\x58\xff\x0f\xfd\xc3\xc6\xf8\x7a\x1d\x44\xbc\x03\xdf\xe9\xd6\x5a\x87\x26\xbe\x44\xc3\x99\xd3\xd2\x54\xfd\x33\xb4\x16\x12\xd9\xc5\x5f\x4e\xe6\x5a\xda\xa2\x17\x6a\x2c\x1a\x43\x84\x6c\xb0\x10\x36\x50\x7b\x96\x76\x48\xb5\x26\x4b\x07\x23\xbd\xa2\x21\x36\x19\x0a\xe0\x8f\x9b\x05\x33\x42\x77\x1e\xda\xcc\x8b\xd9\xb7\x2b\xd0\xed\x82\x00\x5b\xa0\x18\x0a\x3d\xb6\x6f\xc9\x5b\x5b\x08\xbc\x46\x78\x19\xc8\x09\xf1\x51\x17\x75\x26\x96\x9b\x47\x89\x97\x1f\xdf\xeb\xab\x75\x53\x65\xd0\x37\xca\xc8\xb9\x5e\xc0\x17\x22\x1a\x9f\x43\x16\xf2\xb1\x83\xd9\xd1\xbb\xc0\xb0\x29\xe9\xc5\xdb\xec\xfb\xd1\xaa\xa8\xe5\x54\x0d\xd4\x8d\xcc\xef\x5f\x7e\x7c\x3f\x0a\xa4\x48\x1a\x06\x3f\x5c\x69\xf4\xba\x11\xb5\x52\x8b\x5a\xac\x29\x74\x18\x6e\xf7\x66\x0e\x28\x42\x45\x2a\xf3\xb2\x50\x80\x46\x09\xa1\x41\x7f\x5f\xa9\xe1\xdf\xf0\x92\x51\xb4\x63\x88\xaa\xe2\x26\xab\x4a\x88\x7f\x8c\xa6\xfb\x14\x12\x41\x7c\x54\x93\x55\x95\x35\x6b\xf1\xbe\xcc\xb3\xc9\xda\xa0\xd3\x21\xa3\xaa\x05\x6b\x79\x47\x88\xd3\xd3\xb9\x12\x9e\xcd\x4a\x96\x2b\xbd\xda\x0a\x7d\x9f\xbc\x2d\xab\x6b\x44\x5f\xcf\x6a\xc1\xea\x1f\x0a\x1b\x26\xac\x45\xb0\x52\xb9\xbc\x33\xbd\x5c\x52\x8b\x4a\xbc\xee\xf3\xb6\x88\x52\xdf\xfb\xd5\x00\xeb\xd4\x05\xd6\xe5\xaa\x83\x9e\x69\x1c\xc5\xf6\x22\x20\x5d\x6b\xcd\xfc\xb8\x28\xa9\x14\x7f\x63\x34\xc2\xf3\x05\x77\x13\x3f\x08\xd8\xfa\x75\x21\x6a\xed\x43\x24\x2d\x86\x76\x0e\xcd\x94\x98\xf1\xa6\x40\x35\x38\x25\xb8\x73\x01\xaa\x0b\xe0\x93\x8c\xeb\xa8\xfd\x14\x36\x14\xe7\xc2\x36\x92\x7e\x43\x40\x02\xfc\x33\xb6\x5b\x99\xed\xcb\xa0\x9f\x90\x13\xdf\x53\x90\x69\x8d\x9a\x6c\x59\x7b\xdb\x0b\x3d\xb5\x90\xf8\x90\xae\xab\xfb\xfb\x22\x48\x6a\x61\x04\x75\xae\xf9\x1e\xdc\x81\x85\x9b\x53\xd3\xc4\x31\x71\x7c\xb7\xd6\x3a\x4e\x01\xd1\xaa\xd4\x57\x6a\xfb\x36\x43\x03\x3c\x50\xb8\xe2\xfa\xbc\x20\xad\x43\x11\xf1\xf8\xe1\xc0\xd7\x82\x15\xf2\xa5\xab\xf7\x7a\x6c\xbc\xbe\xeb\x84\x8e\x9e\xfb\xdf\x3b\x62\xa1\xd6\x33\xd6\xd4\x26\x5b\xea\x94\x44\xdc\x2c\xfa\x47\x2c\x68\x4f\xb8\x34\xad\x2b\x31\xae\x74\x6e\xb0\xff\x7f\x11\x77\x74\xbd\x8d\xdb\xb0\xf7\xfe\x0a\x5e\xee\x70\x89\x81\xcc\xb9\x6d\xc0\xe1\xe0\x34\x2d\xb0\xdd\xd7\x5e\x56\x60\x1d\xfa\x72\x77\xd8\xb9\xb1\xd2\x18\x73\xac\xcc\x96\x53\x04\x4b\xff\xfb\x20\x51\x1f\xa4\xec\x24\x7d\x5b\x5f\xea\xe8\x83\xfa\x22\x29\x8a\xa2\x48\xaa\x28\x75\x81\x58\xd1\x56\xe7\x93\xa8\x3f\x70\x15\x03\x06\x66\xb4\x74\xb2\x88\xf6\xc1\x30\x56\x2c\x30\xa5\x20\x92\x50\xbd\xef\xf1\xc1\x57\x7c\x96\x69\x57\x84\xe4\x71\x1f\xf4\x56\x4c\x1b\xc6\x99\xe8\xa1\x6f\xec\x5b\x22\xa0\x71\xea\x27\x4d\xb3\x26\xab\x02\x33\x06\xee\xeb\x7c\xbb\x15\xb5\x5e\x57\xb5\x16\x8d\xd1\xde\xe7\x70\xdf\x3d\xb8\x28\x12\x9e\xc7\x95\xaa\x15\xd5\xca\x03\xda\xc8\x56\x59\xfb\xf8\x25\xfa\xa5\x00\x62\x3b\x08\x9d\x75\x83\xfb\xac\x1d\xcb\x5c\x85\xbc\x88\x89\xea\x70\x80\x38\xcd\xe3\xd6\xeb\xd7\x74\x3e\x9e\x49\x6c\x1f\x73\xc3\x10\x94\x0c\x8f\x0d\x23\xf6\x98\xf5\x49\x8c\x36\xc3\x97\x8e\xbc\xaf\xf1\x94\x83\x1a\x2c\x58\x40\x23\x56\xba\xcf\xf3\x5e\xb6\x7d\x32\xaf\xf3\xd1\xe9\x01\x2b\xe1\xaf\xa0\x44\xd3\x90\x5d\x35\x92\x13\xbd\xc1\xdf\xd7\x7a\x44\x79\x38\xa5\xeb\xe7\x11\xb4\xa5\x26\xd7\x6c\xe0\xae\xa6\x8b\x6d\x72\x46\x5c\xb4\xb2\x58\xf3\xab\xfe\xa5\x0f\xaf\xf7\x79\xeb\x12\x99\xfe\xfd\x48\x3d\xac\x70\x43\xdd\xdd\x40\x4f\x26\xf3\x81\x1d\xb8\x28\x16\xbc\xa5\x00\xb3\xb5\x31\x74\x5e\xd6\x79\x15\x22\x01\x71\x21\x90\x36\x39\xbf\x60\x0b\xc7\xf9\x82\x8d\xaa\xa8\x99\x1c\x4d\xa4\xc0\x9d\xfb\x1d\xc6\xcb\xa6\xba\x0e\x45\x8d\x89\x2a\xb7\x70\x8d\x90\x32\x27\x53\xa3\x38\xac\x59\x9f\x5f\x12\x16\x7f\x4b\xf2\x59\x71\x64\x27\x9a\x07\x01\xcb\xae\x55\x72\xe3\x02\x18\x31\x61\x2c\xf2\xbd\xc3\x31\x94\x75\xdd\x96\x80\x05\xc3\x40\x3a\x3d\xbe\xc8\xe1\x00\x5f\xbe\x25\xe9\x52\xd6\xcb\x5c\xf5\x9a\xe8\x4b\x11\xfd\xae\x06\xe3\xf8\xc1\xde\x12\xaf\x3e\xc7\x3b\x3c\xe4\x32\x88\xf5\xfd\xe8\x3a\xd3\xaa\x87\x83\xb9\xf6\x63\x04\x02\x03\xee\x85\x4e\xcb\x49\x56\xe6\xd8\xee\xed\x93\x41\x8a\x8b\x30\xe0\x98\x72\x60\x35\xfd\x19\x18\xb5\x5b\x76\x36\x8d\x91\xb5\x4f\x0c\xdd\xe9\xe9\x7b\xe9\xd4\x38\x92\x95\xe4\xe7\x39\x85\x28\x41\x71\x22\x2b\x11\x02\x26\xf2\x12\x6d\xcb\x4f\x46\xe8\x0e\x39\xdf\xa5\xf9\x76\x5b\xed\x27\x98\x32\x65\x9a\xfe\xb0\x97\xe6\xad\x4a\x7a\x92\x45\xcc\xfe\x17\x16\xec\x3c\xce\xb7\x04\xa9\xff\x45\xfe\x14\x5c\x91\x01\xd6\xf6\x2f\x87\x92\xb9\x8f\x29\x4f\x27\xe7\xbf\xec\xf8\x79\xd3\x1f\x37\x87\xcf\xd4\xb3\x19\x7c\x1f\xe4\x8f\xdf\x51\xac\x6f\x6d\xa8\xff\xfa\xc1\xef\xae\xd6\x47\x36\xda\x8c\x28\xd1\xe0\xeb\x35\x0d\xc9\xf8\xf4\x6b\x66\x3e\x4e\xd6\xcc\xee\xb6\x53\x10\x29\x1e\x1a\x6e\x6f\xff\x70\x26\x8f\x14\xa4\x31\x6a\xfb\xac\xb7\xf4\x47\x81\xb1\x35\xf0\x6e\x0e\x72\xb0\x17\x71\x61\x6f\x0f\xe7\x2c\x97\x65\x42\x66\xa5\xe6\x66\x29\x62\xd9\x8b\x61\xde\x1f\x36\x46\x82\x42\x86\x75\x53\xb6\xdd\x77\x70\x85\xaf\x11\xcc\x28\x3d\xc2\x59\x15\x06\x3f\x3f\x53\x76\xe1\x2d\x3c\x35\x91\x60\x80\x26\x72\x0c\xdf\x18\xd3\x8d\x56\xf1\xfa\x4f\xdc\x51\x16\x7f\x06\x40\x0b\x32\x8c\xc9\x5b\x95\x39\xef\x5c\xc1\x45\x96\x86\xe1\x24\x42\x93\xd1\x73\x85\xb5\x24\x01\x3f\xb8\x27\xac\x24\x8a\xb8\xd6\x88\x15\x5a\xb2\xb2\x59\x8d\x76\x29\x42\xa5\x54\x45\xb1\xc0\xda\x69\x3f\x6b\xce\xd0\x11\xc5\x43\xe7\x20\xdd\x3a\x06\x87\xfb\x46\x3e\xb6\xa2\x01\x51\x2f\x8d\x27\xfe\x1c\x96\xeb\x70\x63\xed\x9f\xa4\xe2\x85\x4b\x6b\x83\xf5\xed\xe6\xcc\x4e\xf1\xd6\x88\x92\xef\x31\x36\xfe\x64\xed\x65\xa2\xa2\xdc\x99\x77\x54\x3b\xcd\x6f\x0b\xb9\x34\xd7\xb7\x96\x33\x3b\x5b\xb0\x71\x51\xee\x50\xbf\x50\x94\xbb\xd4\x07\x0f\x84\x05\x68\x38\x70\x0d\xa3\xcb\xdc\x7c\x2e\xbe\xea\xb3\xcf\x68\x76\x35\x82\x0c\x46\x97\x1a\x6a\x1e\xd2\xc8\x9a\x31\x38\x78\x7f\x70\xb3\x9a\x8c\x5f\xbf\xfc\xf1\xcd\x7c\x9c\xc0\x15\xbc\xf1\x06\x05\x6f\xdf\xfe\x9c\xc1\x6f\x1f\xfc\xe0\x6b\x67\x3c\x70\x64\xf8\xd6\x85\x0d\x72\x7b\x3b\x73\x2d\x14\xb2\x1e\x2b\xbc\x7f\x25\x13\xe1\x0d\x11\x16\x50\xd6\xbf\xd8\x59\xbe\x8e\xa7\x6b\x62\x11\x37\x73\x4f\xd9\x74\xbf\xde\xbe\xfb\xe9\x5d\x06\xcb\x75\x23\x37\xc2\xf7\xcd\x05\xc0\x2a\x6b\xc8\xbf\xe8\x09\xf9\x76\xb4\xc9\x8f\xb2\xf9\xac\x27\xef\x74\xcb\x18\x72\xd8\x37\xcc\x23\x00\x16\x7f\x4a\xf2\x5c\xd2\x46\xf3\x0b\x02\x4e\x59\x90\xdb\xda\x0a\x16\xf0\x4f\x67\x62\x73\x14\x94\x78\x44\xe5\x4c\x24\xdd\x6a\x20\xe2\xa3\xeb\xe2\xce\xf8\xea\xb9\xeb\x44\xba\x6d\xa4\x92\x6a\xbf\x15\xe9\x2b\x93\x3c\xbf\x18\x4a\x65\x02\x96\xb7\xf5\x59\xef\x8b\xc6\x70\x51\xef\x6c\xc6\x58\xf9\xe8\x86\xb1\x4b\xc2\xea\xfa\x4e\x9c\x41\xd0\xac\xd5\xdc\x09\x78\x24\xbd\x97\xc5\x1e\xed\x21\x79\xba\xfb\x70\x06\xdb\x96\x43\x9c\x7f\x32\xc5\x3c\x53\xbc\xb7\xc1\xd4\xcc\xb8\xee\x3a\x13\xad\xe9\x72\xad\x36\xd5\x15\xc8\x06\x2e\x75\xe3\x57\xf0\x83\xcd\x57\xce\x20\xd9\xbd\x7b\x6a\xa3\x38\xf1\x5c\x6b\xaa\x0f\x77\xd4\xed\x4c\xd0\x49\x1a\xb7\x84\xaf\x88\xef\x48\xf3\x96\xb0\x95\xd5\x2e\xa8\xb3\x66\xa2\x32\x06\x27\x4b\x59\xef\x44\xa3\xa0\xaf\x3d\x72\x4f\x37\x1d\x1b\x76\x0f\x9d\xc2\x1d\x81\x0a\x88\xe3\x0a\xf5\xd5\x3b\xaa\xf7\xaa\x96\x5c\xd2\x04\x08\x03\xf7\x34\x31\x04\x77\x6d\xf8\x26\xc1\xe2\x2f\x23\x29\x89\x74\x87\xa2\x75\xe8\x01\x15\x92\x4e\x1e\x55\x43\xdb\xe7\xd7\xfb\x45\x7f\x80\xf8\xc7\x5d\x49\xe1\xdf\x64\xe4\x89\xcd\xae\xb1\xc1\x8f\x95\xec\xea\x42\x63\x44\xd9\x82\xd8\x6c\xd5\xde\x6a\xf8\xe3\x59\x4d\x22\x39\x16\x1c\x12\x84\xbf\x73\x57\xe3\xe1\x16\xda\x4f\x6b\x2d\x0b\x81\x46\xf6\x61\x00\x64\x2e\x7d\x39\x4f\xdd\x73\x0e\x2c\xd4\x8a\x95\xbe\x93\xb1\xbb\x15\xf1\xf0\x70\x48\xd9\x98\x68\xbb\xa6\x66\x14\x83\x62\x37\x47\xf5\x90\xc7\x1c\x96\x85\x7e\x2b\xfa\xf0\x5b\xdd\x74\x0a\xfb\x1b\x22\x3a\x3f\x9d\x40\xcb\x33\x18\xf1\x3c\x6c\x58\xca\x7a\x55\x3e\xa4\x5b\xd1\xac\x34\x29\x5b\x2f\x94\x9b\xbc\xf9\x9b\xce\xae\xfe\x3d\x19\xdb\x7d\x9c\x28\xdd\xa9\x98\x8e\x7c\xbd\xbf\xd7\x13\x41\x3d\x00\x1c\xda\x1c\xb2\xc1\xd4\xe9\xc9\x3a\x76\x43\x19\xae\x6a\x33\x03\x84\xa0\xea\xcd\x06\x14\xc4\x53\xaa\x11\x34\xec\x2c\x94\x72\x29\x6e\xe0\x11\x0a\xe0\xf8\xad\x8e\xae\x11\xab\xd4\xc7\x41\xf5\xd9\x7d\x1d\x9c\x2e\x17\xa5\xce\xb9\xee\x20\x25\x30\x29\x3c\x1e\xc5\x94\xc2\xec\xc1\xfb\x9f\x71\x05\x44\x5d\x8c\x09\xa5\x6c\x44\xde\x76\x8d\x98\x4c\x46\xbb\x4e\xd8\x5b\x41\xcd\xfc\xff\xf2\xb6\x9a\x0e\x83\x46\xc9\x14\x3c\xc6\x85\x4f\x0e\x90\xc6\x50\x0b\xc1\x4d\xf5\xe6\x64\x1c\x3a\x18\xd8\xf8\x74\xcc\x6f\xc7\x2e\xa8\x30\xc6\xfa\x15\x0a\xa4\xa3\x3a\x90\x2b\xbf\x91\x19\xb3\x60\x73\x5e\xc9\x1b\x13\xd6\x57\xae\xe0\xf6\xee\x13\xdd\xe8\xb4\x78\x96\xb7\xf0\x28\xaa\x2a\x8a\x5d\x4b\x49\x39\xd0\xbb\x73\xf4\xea\x72\xa2\x57\x03\x34\x6b\x38\x16\x45\xad\xf2\xb2\x36\xd8\x70\x56\x62\x85\x50\x3c\x35\x1a\xd4\xc2\x58\x10\x1b\x7f\x34\x95\xac\xc5\xef\x5e\xcc\xe2\x5b\x74\xa8\x15\x24\x23\x7b\x7c\xd4\x92\x8f\x5b\x83\x21\x32\x9f\x5f\x5c\x58\x20\x77\x9d\x16\xda\x9e\x92\x24\x99\xff\x17\x00\x00\xff\xff\xc6\x98\xb6\x0a\x16\x6a\x04\x00")
-
-func assetsJsVueJsBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsJsVueJs,
-		"assets/js/vue.js",
-	)
-}
-
-func assetsJsVueJs() (*asset, error) {
-	bytes, err := assetsJsVueJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/js/vue.js", size: 289302, mode: os.FileMode(420), modTime: time.Unix(1535148486, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _templatesIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4d\x6f\xe3\x36\x13\xbe\xfb\x57\x4c\xf8\x1e\x74\x79\x69\x05\x6d\xd1\x0f\x41\x72\xd3\xfd\x28\x10\x60\xd1\x2d\xda\x6c\x81\x62\xb1\x87\x91\x34\x96\xe8\x50\xa4\x40\x8e\x94\x04\xd9\xfc\xf7\x82\x92\xec\x58\xb2\x77\x1b\xd4\x17\x91\xc3\x99\x87\x0f\xe7\x19\x72\x9c\x5e\xbc\x79\xff\xfa\xe6\xef\xdf\xdf\x42\xcd\x8d\xde\xac\xd2\xf0\x01\x8d\xa6\xca\x04\x19\xb1\x59\xad\xd2\x9a\xb0\xdc\xac\x00\x00\xd2\x86\x18\xa1\xa8\xd1\x79\xe2\x4c\x7c\xb8\xf9\x55\xfe\x28\xa6\x25\x56\xac\x69\xc3\xaa\x21\x5f\x13\x31\x60\xdb\xa6\xf1\x68\x1c\x1d\xb4\x32\xb7\xe0\x48\x67\xc2\xf3\x83\x1e\xbd\x04\xd4\x8e\xb6\xc1\x82\xac\x8a\x18\xbd\x27\xf6\x71\xe1\x7d\x9c\x5b\xcb\x9e\x1d\xb6\xeb\xc2\x7b\x01\xf1\x04\xe2\x0b\xa7\x5a\x06\xef\x8a\x65\xd0\xce\xc7\x7d\x47\xeb\x9d\x17\x9b\x34\x1e\xdd\x5e\x18\x23\x1d\x79\xdb\xb9\xe2\x6c\xf0\x85\x94\x1f\xd5\x16\x34\x13\x5c\xbf\x85\x9f\x3e\x8d\xe6\x13\xdc\x9a\xb9\xf5\x49\x1c\x17\xa5\xd9\xf9\x75\xa1\x6d\x57\x6e\x35\x3a\x5a\x17\xb6\x89\x71\x87\xf7\xb1\x56\xb9\x8f\x5f\xa1\xa7\xef\xbf\x8b\x2f\xd7\xdf\xae\x2f\xe3\x7c\x98\xac\x1b\x65\xce\x6e\xfc\x91\x4c\xa9\xb6\x9f\xa4\xdc\xac\xd2\x78\xd4\x60\x95\xe6\xb6\x7c\x98\x1c\x4a\xd5\x83\x2a\x33\x81\x6d\x2b\x8e\x58\x19\xec\xa1\xd0\xe8\x7d\x26\x0c\xf6\x39\x3a\x18\x3f\x92\x6d\x55\x69\xc2\x5c\x93\x6c\xca\xbd\x51\x99\x9e\x9c\x27\xc8\xab\xfd\xf0\x08\x6b\xc0\xcb\x3b\x66\x6b\xe6\x90\x13\xd6\x02\xda\x49\xa7\xaa\x9a\x05\x24\x93\xf3\x63\x54\x58\xad\xb1\xf5\x54\x46\x49\xf0\x7d\xbd\x9f\x3e\x09\xe0\x87\x96\x32\x31\xa2\x0b\x28\x91\x71\x82\xc9\xc4\x3e\x4a\xcc\x98\x84\xdf\xe8\x86\xae\x0a\xe5\xf7\xbf\x71\xf7\x1b\xba\x67\x01\xe8\x14\xca\xc2\x1a\x76\x56\x1f\x78\x8e\x4b\xc9\xb0\x46\xf7\x2d\x9a\x92\xca\x4c\x5c\x1c\x33\x99\x22\x35\xe6\xa1\x32\x6f\x06\x06\x81\xaa\xaa\x90\x95\x35\xa7\x14\xae\x0a\xad\x8a\xdb\x61\x87\x03\x48\x36\x87\xdc\x9c\x04\xa5\xbe\xc5\x2f\xe4\x50\xaa\xc2\x9a\x41\xfd\x16\xcd\x22\xf7\xf1\x98\x9e\x85\xf5\x0c\x56\xee\xd0\x94\x62\x79\xfb\xce\x20\x86\xa2\x99\x87\x1e\x92\x0d\xc9\x70\x2f\xe7\x27\x83\x9f\x21\x8a\x20\x81\xa8\xa6\xa0\x6d\x02\x3f\x5c\xb6\xf7\x91\x18\x0a\x6f\x96\xe3\x13\xc9\x17\x8a\xff\x1f\x0e\x2b\xe0\x6b\x7b\x17\x25\x70\x31\xaf\x88\x93\xa4\x7d\x5d\xb7\x33\x49\xee\xf4\xe2\x6c\xe1\x2e\x34\x4e\x62\xc7\x36\x64\xb8\xd3\x2f\x54\x66\x38\x52\x2f\x03\xcf\x4c\xf4\x8a\xee\x20\xcb\x32\x88\x5a\x67\x77\x54\xb0\x8f\xce\x6c\x3e\x12\xf0\xe4\x20\x31\xd8\x50\x26\xc2\xf8\x37\x6c\xe8\xf0\x76\xcd\x95\x3d\xd5\x66\xb0\x6f\xad\x6b\xf6\x6c\xc2\x58\x2a\xa3\x95\x21\x68\xb4\xfc\xe6\x3f\x51\x9a\x5f\xdf\x9c\x0d\xe4\x6c\xa4\x6f\x86\x8f\xed\x38\xa0\xcb\xd6\xa9\x06\xdd\xc3\xf2\x52\x8e\xa5\xbe\x6e\x1d\xf5\x64\x38\x13\xda\x56\xef\x3b\x16\x9b\x77\xb6\xb2\x1d\x9f\xaf\xce\xf1\x74\x81\xfa\xb2\x96\x4b\xd5\x1f\x3d\x53\xb1\xc1\x7e\xb3\x7a\x9e\x1f\x15\x66\xb8\xc5\xa8\x0c\xb9\xe5\x53\x14\x7c\x7a\xa9\xb6\xc7\x09\xd0\xb6\x52\x26\x82\xcf\x9f\xe1\xd9\xe6\xa8\x52\x9e\xc9\x9d\x4b\x4a\x8a\x1d\xd7\xb2\x40\x57\x42\x12\x22\x46\x2c\x01\x57\x9e\x58\x8e\x06\x4f\xfc\xd7\x64\x63\xeb\x48\x0e\x11\xca\x6c\x6d\x68\x1f\xd6\xd1\x2f\x1d\xd7\xd7\x66\x6b\x4f\x94\x5d\x9c\xf1\x8b\x9c\xbf\x26\x5a\x3a\x2d\x4a\xad\x3c\x43\x12\xaa\x48\x86\xcb\x16\x06\xd7\xa5\x80\xab\x1c\x4b\xc9\xf6\x96\xcc\x41\x8f\x7f\xa1\x71\x34\x9d\x86\xb3\xb6\x38\x4a\x1e\x4a\x3e\xde\x61\x8f\xa3\xf5\x88\xd8\x9d\x32\xa5\xbd\x5b\x3f\x3f\x2d\x19\x3c\xce\xb6\x0b\x6d\xec\xc3\x1f\xef\x12\x88\xe2\x32\x8f\x1f\x1f\xd7\x7f\x96\xf9\x9b\x57\xa1\xf4\x9f\x9e\xa2\x83\xe7\xd3\x44\xe0\xe5\x9d\x19\xdb\x76\xd1\x17\xd3\x78\x6c\x7f\xa1\x1f\x86\x3f\x2b\xff\x04\x00\x00\xff\xff\x74\x69\x0b\x4a\xbc\x08\x00\x00")
-
-func templatesIndexHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesIndexHtml,
-		"templates/index.html",
-	)
-}
-
-func templatesIndexHtml() (*asset, error) {
-	bytes, err := templatesIndexHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/index.html", size: 2236, mode: os.FileMode(420), modTime: time.Unix(1583004819, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"assets/css/bootstrap.css":  assetsCssBootstrapCss,
-	"assets/js/app.js":          assetsJsAppJs,
-	"assets/js/vue-resource.js": assetsJsVueResourceJs,
-	"assets/js/vue.js":          assetsJsVueJs,
-	"templates/index.html":      templatesIndexHtml,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-
-var _bintree = &bintree{nil, map[string]*bintree{
-	"assets": &bintree{nil, map[string]*bintree{
-		"css": &bintree{nil, map[string]*bintree{
-			"bootstrap.css": &bintree{assetsCssBootstrapCss, map[string]*bintree{}},
-		}},
-		"js": &bintree{nil, map[string]*bintree{
-			"app.js":          &bintree{assetsJsAppJs, map[string]*bintree{}},
-			"vue-resource.js": &bintree{assetsJsVueResourceJs, map[string]*bintree{}},
-			"vue.js":          &bintree{assetsJsVueJs, map[string]*bintree{}},
-		}},
-	}},
-	"templates": &bintree{nil, map[string]*bintree{
-		"index.html": &bintree{templatesIndexHtml, map[string]*bintree{}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
@@ -2,10 +2,9 @@
 
 APP_NAME=timesheet
 
-go get -u -v github.com/go-bindata/go-bindata/...@v3.1.2
-
-# build assets bindata.go file
-$GOPATH/bin/go-bindata -fs ./assets/... ./templates
+# precompress the static assets, they're embedded (go:embed) along with the originals
+# and served as they are to the clients accepting gzip/brotli
+go run ./cmd/precompress ./assets
 
 # build executable for linux, osx and windows
 GOOS=linux GOARCH=amd64 go build -o $APP_NAME-linux64
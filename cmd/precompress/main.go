@@ -0,0 +1,95 @@
+// precompress writes the gzip (.gz) and brotli (.br) variants of the static assets,
+// so that they can be embedded and served without compressing them on every request
+//
+// usage: go run ./cmd/precompress [-min-size 1024] ./assets
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// compressible lists the extensions of the files worth compressing
+var compressible = map[string]bool{
+	".css":  true,
+	".html": true,
+	".js":   true,
+	".json": true,
+	".svg":  true,
+	".txt":  true,
+}
+
+func main() {
+	minSize := flag.Int64("min-size", 1024, "smallest file (in bytes) to be compressed")
+	flag.Parse()
+
+	for _, root := range flag.Args() {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !compressible[strings.ToLower(filepath.Ext(path))] {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if info.Size() < *minSize {
+				return nil
+			}
+			return compress(path)
+		})
+		if err != nil {
+			log.Fatalln(fmt.Errorf("error precompressing %s: %w", root, err))
+		}
+	}
+}
+
+func compress(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	variants := map[string]func(io.Writer) io.WriteCloser{
+		".gz": func(w io.Writer) io.WriteCloser {
+			zw, _ := gzip.NewWriterLevel(w, gzip.BestCompression)
+			return zw
+		},
+		".br": func(w io.Writer) io.WriteCloser {
+			return brotli.NewWriterLevel(w, brotli.BestCompression)
+		},
+	}
+	for ext, newWriter := range variants {
+		buf := bytes.Buffer{}
+		zw := newWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return fmt.Errorf("error compressing %s: %w", path, err)
+		}
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("error compressing %s: %w", path, err)
+		}
+
+		// not worth it, the original is served instead
+		if buf.Len() >= len(data) {
+			os.Remove(path + ext)
+			continue
+		}
+		if err := os.WriteFile(path+ext, buf.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("os.WriteFile: %w", err)
+		}
+		log.Printf("%s%s: %d -> %d bytes", path, ext, len(data), buf.Len())
+	}
+	return nil
+}
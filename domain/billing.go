@@ -0,0 +1,574 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+// rate levels, the more specific ones win: the user on a project, the project, its client
+const (
+	RateLevelUser    = "user"
+	RateLevelProject = "project"
+	RateLevelClient  = "client"
+)
+
+// dateLayout is the format of the dates with no time
+const dateLayout = "2006-01-02"
+
+// maxHourlyRate is the biggest hourly rate accepted, the DB keeps them as decimal(10,2)
+const maxHourlyRate = 99999999.99
+
+// ErrClientInUse - the client can't be deleted while its projects refer to it
+var ErrClientInUse error = conflictError("the client still has projects")
+
+// ErrClientInvoiced - the invoiced clients are kept along with their invoices
+var ErrClientInvoiced error = conflictError("the client has invoices")
+
+// Client - a customer the projects are done for, its rates are in its currency
+type Client struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Currency  string    `json:"currency"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ClientInput - the editable client fields
+type ClientInput struct {
+	Name string `json:"name"`
+	// Currency is an ISO 4217 code, i.e. EUR
+	Currency string `json:"currency"`
+}
+
+// Validate checks the input against the DB constraints
+func (in *ClientInput) Validate() error {
+	in.Name, in.Currency = strings.TrimSpace(in.Name), strings.ToUpper(strings.TrimSpace(in.Currency))
+	ve := &ValidationError{}
+	if in.Name == "" {
+		ve.add("name", "this field is required")
+	}
+	if len(in.Name) > 50 {
+		ve.add("name", "can be at most 50 characters long")
+	}
+	if len(in.Currency) != 3 || strings.Trim(in.Currency, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		ve.add("currency", "expected a 3 letter ISO 4217 code, i.e. EUR")
+	}
+	return ve.errOrNil()
+}
+
+// clientRow - a client table row, user_id is the user who created it (and manages it)
+type clientRow struct {
+	ID        int    `json:"id,omitempty"`
+	UserID    int    `json:"user_id"`
+	Name      string `json:"name"`
+	Currency  string `json:"currency"`
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+func (r clientRow) client() Client {
+	return Client{ID: r.ID, Name: r.Name, Currency: r.Currency, CreatedAt: parseSdbTime(r.Timestamp)}
+}
+
+// Rate - an hourly rate, in the client currency, effective from a date until the next one of its level
+type Rate struct {
+	ID    int    `json:"id"`
+	Level string `json:"level"`
+	// ClientID is set on the client rates, ProjectID on the project and the user ones, UserID on the user ones
+	ClientID      int     `json:"client_id,omitempty"`
+	ProjectID     int     `json:"project_id,omitempty"`
+	UserID        int     `json:"user_id,omitempty"`
+	HourlyRate    float64 `json:"hourly_rate"`
+	EffectiveFrom string  `json:"effective_from"`
+}
+
+// RateInput - a rate to set, the one of the same level and effective date is replaced
+type RateInput struct {
+	HourlyRate float64 `json:"hourly_rate"`
+	// EffectiveFrom is a date (2006-01-02)
+	EffectiveFrom string `json:"effective_from"`
+	// UserID makes a project rate the rate of the user on the project, the user has to be on the project
+	UserID int `json:"user_id,omitempty"`
+}
+
+// Validate checks the input
+func (in *RateInput) Validate() error {
+	in.EffectiveFrom = strings.TrimSpace(in.EffectiveFrom)
+	ve := &ValidationError{}
+	if in.HourlyRate < 0 || in.HourlyRate > maxHourlyRate || math.IsNaN(in.HourlyRate) {
+		ve.add("hourly_rate", fmt.Sprintf("has to be between 0 and %.2f", maxHourlyRate))
+	}
+	if _, err := time.Parse(dateLayout, in.EffectiveFrom); err != nil {
+		ve.add("effective_from", "expected a date (YYYY-MM-DD)")
+	}
+	if in.UserID < 0 {
+		ve.add("user_id", "has to be a user ID")
+	}
+	return ve.errOrNil()
+}
+
+// rateRow - a rate table row, the level is told by the IDs set
+type rateRow struct {
+	ID            int     `json:"id,omitempty"`
+	ClientID      *int    `json:"client_id"`
+	ProjectID     *int    `json:"project_id"`
+	UserID        *int    `json:"user_id"`
+	HourlyRate    float64 `json:"hourly_rate"`
+	EffectiveFrom string  `json:"effective_from"`
+}
+
+// deref returns the value of the nullable ID, 0 for null
+func deref(id *int) int {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
+
+// effective returns the date the rate is effective from
+func (r rateRow) effective() time.Time {
+	// SlashDB may return the dates as datetimes
+	t, _ := time.Parse(dateLayout, r.EffectiveFrom[:min(len(r.EffectiveFrom), len(dateLayout))])
+	return t
+}
+
+func (r rateRow) rate() Rate {
+	rate := Rate{
+		ID:            r.ID,
+		Level:         RateLevelClient,
+		ClientID:      deref(r.ClientID),
+		ProjectID:     deref(r.ProjectID),
+		UserID:        deref(r.UserID),
+		HourlyRate:    r.HourlyRate,
+		EffectiveFrom: r.effective().Format(dateLayout),
+	}
+	switch {
+	case rate.UserID != 0:
+		rate.Level = RateLevelUser
+	case rate.ProjectID != 0:
+		rate.Level = RateLevelProject
+	}
+	return rate
+}
+
+// inFilter returns a part filtering the column by any of the IDs
+func inFilter(name, column string, ids []int) slashdb.Part {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = strconv.Itoa(id)
+	}
+	return slashdb.Part{
+		Name:      name,
+		Filter:    slashdb.Filter{Values: map[string][]string{column: values}, Order: []string{column}},
+		Separator: ",",
+	}
+}
+
+// ListClients returns the clients the user manages, by name
+func (s *Service) ListClients(ctx context.Context, userID int) ([]Client, error) {
+	rows := []clientRow{}
+	err := s.get(ctx, s.request(filter("client", "user_id", strconv.Itoa(userID))), &rows)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	clients := make([]Client, len(rows))
+	for i, r := range rows {
+		clients[i] = r.client()
+	}
+	sort.SliceStable(clients, func(i, j int) bool { return strings.ToLower(clients[i].Name) < strings.ToLower(clients[j].Name) })
+	return clients, nil
+}
+
+// Client returns one of the clients the user manages
+func (s *Service) Client(ctx context.Context, userID, clientID int) (Client, error) {
+	rows := []clientRow{}
+	req := s.request(filter("client", "id", strconv.Itoa(clientID), "user_id", strconv.Itoa(userID)))
+	if err := s.get(ctx, req, &rows); err != nil {
+		return Client{}, err
+	}
+	if len(rows) != 1 {
+		return Client{}, ErrNotFound
+	}
+	return rows[0].client(), nil
+}
+
+// CreateClient creates a client managed by the user
+func (s *Service) CreateClient(ctx context.Context, userID int, in ClientInput) (Client, error) {
+	if err := in.Validate(); err != nil {
+		return Client{}, err
+	}
+	id, err := s.create(ctx, s.request(slashdb.Part{Name: "client"}), clientRow{UserID: userID, Name: in.Name, Currency: in.Currency})
+	if err != nil {
+		return Client{}, err
+	}
+	clientID, err := strconv.Atoi(id)
+	if err != nil {
+		return Client{}, fmt.Errorf("%w: unexpected client ID %q", ErrUpstream, id)
+	}
+	return s.Client(ctx, userID, clientID)
+}
+
+// UpdateClient updates one of the clients the user manages, the currency change applies to all its rates
+func (s *Service) UpdateClient(ctx context.Context, userID, clientID int, in ClientInput) (Client, error) {
+	if err := in.Validate(); err != nil {
+		return Client{}, err
+	}
+	if _, err := s.Client(ctx, userID, clientID); err != nil {
+		return Client{}, err
+	}
+	if err := s.update(ctx, s.request(filter("client", "id", strconv.Itoa(clientID))), in); err != nil {
+		return Client{}, err
+	}
+	return s.Client(ctx, userID, clientID)
+}
+
+// DeleteClient deletes one of the clients the user manages, along with its rates. The projects have to be unlinked first,
+// the invoiced clients can't be deleted
+func (s *Service) DeleteClient(ctx context.Context, userID, clientID int) error {
+	if _, err := s.Client(ctx, userID, clientID); err != nil {
+		return err
+	}
+	id := strconv.Itoa(clientID)
+	projects := []projectRow{}
+	err := s.get(ctx, s.request(filter("project", "client_id", id)), &projects)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if len(projects) > 0 {
+		return ErrClientInUse
+	}
+	invoices, err := s.invoiceRows(ctx, filter("invoice", "client_id", id))
+	if err != nil {
+		return err
+	}
+	if len(invoices) > 0 {
+		return ErrClientInvoiced
+	}
+	if err := s.delete(ctx, s.request(filter("rate", "client_id", id))); err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	return s.delete(ctx, s.request(filter("client", "id", id)))
+}
+
+// rates returns the rate rows of the filter, ErrNotFound is no rates
+func (s *Service) rates(ctx context.Context, part slashdb.Part) ([]rateRow, error) {
+	rows := []rateRow{}
+	err := s.get(ctx, s.request(part), &rows)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// sortRates orders the rates by their level, user and effective date
+func sortRates(rates []Rate) {
+	levels := map[string]int{RateLevelClient: 0, RateLevelProject: 1, RateLevelUser: 2}
+	sort.SliceStable(rates, func(i, j int) bool {
+		a, b := rates[i], rates[j]
+		if a.Level != b.Level {
+			return levels[a.Level] < levels[b.Level]
+		}
+		if a.UserID != b.UserID {
+			return a.UserID < b.UserID
+		}
+		return a.EffectiveFrom < b.EffectiveFrom
+	})
+}
+
+// setRate creates the rate row, or updates the one of the same level and date in existing
+func (s *Service) setRate(ctx context.Context, existing []rateRow, row rateRow) (Rate, bool, error) {
+	row.HourlyRate = math.Round(row.HourlyRate*100) / 100
+	for _, r := range existing {
+		if deref(r.ClientID) == deref(row.ClientID) && deref(r.ProjectID) == deref(row.ProjectID) &&
+			deref(r.UserID) == deref(row.UserID) && r.effective().Format(dateLayout) == row.EffectiveFrom {
+			payload := map[string]interface{}{"hourly_rate": row.HourlyRate}
+			if err := s.update(ctx, s.request(filter("rate", "id", strconv.Itoa(r.ID))), payload); err != nil {
+				return Rate{}, false, err
+			}
+			row.ID = r.ID
+			return row.rate(), false, nil
+		}
+	}
+	id, err := s.create(ctx, s.request(slashdb.Part{Name: "rate"}), row)
+	if err != nil {
+		return Rate{}, false, err
+	}
+	if row.ID, err = strconv.Atoi(id); err != nil {
+		return Rate{}, false, fmt.Errorf("%w: unexpected rate ID %q", ErrUpstream, id)
+	}
+	return row.rate(), true, nil
+}
+
+// ClientRates returns the rates of one of the clients the user manages
+func (s *Service) ClientRates(ctx context.Context, userID, clientID int) ([]Rate, error) {
+	if _, err := s.Client(ctx, userID, clientID); err != nil {
+		return nil, err
+	}
+	rows, err := s.rates(ctx, filter("rate", "client_id", strconv.Itoa(clientID)))
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]Rate, len(rows))
+	for i, r := range rows {
+		rates[i] = r.rate()
+	}
+	sortRates(rates)
+	return rates, nil
+}
+
+// SetClientRate sets the client rate effective from the date, it reports if the rate was created
+func (s *Service) SetClientRate(ctx context.Context, userID, clientID int, in RateInput) (Rate, bool, error) {
+	if err := in.Validate(); err != nil {
+		return Rate{}, false, err
+	}
+	if in.UserID != 0 {
+		return Rate{}, false, &ValidationError{Fields: map[string][]string{"user_id": {"the user rates are set on the projects"}}}
+	}
+	if _, err := s.Client(ctx, userID, clientID); err != nil {
+		return Rate{}, false, err
+	}
+	existing, err := s.rates(ctx, filter("rate", "client_id", strconv.Itoa(clientID)))
+	if err != nil {
+		return Rate{}, false, err
+	}
+	return s.setRate(ctx, existing, rateRow{ClientID: &clientID, HourlyRate: in.HourlyRate, EffectiveFrom: in.EffectiveFrom})
+}
+
+// ProjectRates returns the project and the user rates of one of the user projects
+func (s *Service) ProjectRates(ctx context.Context, userID, projectID int) ([]Rate, error) {
+	if _, err := s.Project(ctx, userID, projectID, false); err != nil {
+		return nil, err
+	}
+	rows, err := s.rates(ctx, filter("rate", "project_id", strconv.Itoa(projectID)))
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]Rate, len(rows))
+	for i, r := range rows {
+		rates[i] = r.rate()
+	}
+	sortRates(rates)
+	return rates, nil
+}
+
+// onProject reports if the user has any rows (an entry or the membership) on the project
+func (s *Service) onProject(ctx context.Context, userID, projectID int) (bool, error) {
+	rows := []timesheetRow{}
+	req := s.request(filter("timesheet", "user_id", strconv.Itoa(userID), "project_id", strconv.Itoa(projectID)))
+	req.SetLimit(1)
+	err := s.get(ctx, req, &rows)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return len(rows) > 0, err
+}
+
+// checkRateOwner checks the user can change the project rate of the rateUserID (0 for the project wide one),
+// the members can only change their own rates, the owner any of them
+func (s *Service) checkRateOwner(ctx context.Context, userID, rateUserID int, p Project) error {
+	if rateUserID == userID {
+		return nil
+	}
+	return s.checkOwner(ctx, userID, p)
+}
+
+// SetProjectRate sets the rate of one of the user projects, or of a user on it (in.UserID), effective from the date,
+// only the project owner can set the project wide rates and the rates of the other members. It reports if the rate
+// was created
+func (s *Service) SetProjectRate(ctx context.Context, userID, projectID int, in RateInput) (Rate, bool, error) {
+	if err := in.Validate(); err != nil {
+		return Rate{}, false, err
+	}
+	p, err := s.Project(ctx, userID, projectID, false)
+	if err != nil {
+		return Rate{}, false, err
+	}
+	if err := s.checkRateOwner(ctx, userID, in.UserID, p); err != nil {
+		return Rate{}, false, err
+	}
+	row := rateRow{ProjectID: &projectID, HourlyRate: in.HourlyRate, EffectiveFrom: in.EffectiveFrom}
+	if in.UserID != 0 {
+		ok, err := s.onProject(ctx, in.UserID, projectID)
+		if err != nil {
+			return Rate{}, false, err
+		}
+		if !ok {
+			return Rate{}, false, &ValidationError{Fields: map[string][]string{"user_id": {"the user is not on the project"}}}
+		}
+		row.UserID = &in.UserID
+	}
+	existing, err := s.rates(ctx, filter("rate", "project_id", strconv.Itoa(projectID)))
+	if err != nil {
+		return Rate{}, false, err
+	}
+	return s.setRate(ctx, existing, row)
+}
+
+// DeleteRate deletes a rate of one of the clients the user manages or of one of the user projects, the same as
+// SetProjectRate, only the project owner can delete the rates of the others
+func (s *Service) DeleteRate(ctx context.Context, userID, rateID int) error {
+	rows, err := s.rates(ctx, filter("rate", "id", strconv.Itoa(rateID)))
+	if err != nil {
+		return err
+	}
+	if len(rows) != 1 {
+		return ErrNotFound
+	}
+	if r := rows[0].rate(); r.Level == RateLevelClient {
+		_, err = s.Client(ctx, userID, r.ClientID)
+	} else {
+		var p Project
+		if p, err = s.Project(ctx, userID, r.ProjectID, false); err == nil {
+			err = s.checkRateOwner(ctx, userID, r.UserID, p)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return s.delete(ctx, s.request(filter("rate", "id", strconv.Itoa(rateID))))
+}
+
+// Billing - the rates of the user projects, it prices the user entries
+type Billing struct {
+	userID   int
+	projects map[int]Project
+	clients  map[int]Client
+	// the rates of each level, newest first
+	userRates, projectRates, clientRates map[int][]rateRow
+}
+
+// Billing returns the rates of the user projects
+func (s *Service) Billing(ctx context.Context, userID int) (*Billing, error) {
+	b := &Billing{
+		userID:       userID,
+		clients:      map[int]Client{},
+		userRates:    map[int][]rateRow{},
+		projectRates: map[int][]rateRow{},
+		clientRates:  map[int][]rateRow{},
+	}
+	var err error
+	if b.projects, err = s.projects(ctx, userID); err != nil {
+		return nil, err
+	}
+	projectIDs, clientIDs := []int{}, []int{}
+	seen := map[int]bool{}
+	for id, p := range b.projects {
+		projectIDs = append(projectIDs, id)
+		if p.ClientID != 0 && !seen[p.ClientID] {
+			seen[p.ClientID] = true
+			clientIDs = append(clientIDs, p.ClientID)
+		}
+	}
+	if len(clientIDs) == 0 {
+		// no clients, no currencies to bill in
+		return b, nil
+	}
+	sort.Ints(projectIDs)
+	sort.Ints(clientIDs)
+
+	clients := []clientRow{}
+	if err := s.get(ctx, s.request(inFilter("client", "id", clientIDs)), &clients); err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	for _, c := range clients {
+		b.clients[c.ID] = c.client()
+	}
+	rows, err := s.rates(ctx, inFilter("rate", "client_id", clientIDs))
+	if err != nil {
+		return nil, err
+	}
+	projectRows, err := s.rates(ctx, inFilter("rate", "project_id", projectIDs))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range append(rows, projectRows...) {
+		switch rate := r.rate(); rate.Level {
+		case RateLevelUser:
+			// only the user own rates price the user entries
+			if rate.UserID == userID {
+				b.userRates[rate.ProjectID] = append(b.userRates[rate.ProjectID], r)
+			}
+		case RateLevelProject:
+			b.projectRates[rate.ProjectID] = append(b.projectRates[rate.ProjectID], r)
+		default:
+			b.clientRates[rate.ClientID] = append(b.clientRates[rate.ClientID], r)
+		}
+	}
+	for _, m := range []map[int][]rateRow{b.userRates, b.projectRates, b.clientRates} {
+		for _, rates := range m {
+			sort.SliceStable(rates, func(i, j int) bool { return rates[i].effective().After(rates[j].effective()) })
+		}
+	}
+	return b, nil
+}
+
+// effectiveRate returns the newest of the rates effective on the date
+func effectiveRate(rates []rateRow, date time.Time) (float64, bool) {
+	for _, r := range rates {
+		if !r.effective().After(date) {
+			return r.HourlyRate, true
+		}
+	}
+	return 0, false
+}
+
+// Rate returns the hourly rate of the user on the project on the date (the most specific one effective then)
+// and the client currency, ok is false for the projects with no client or no rate
+func (b *Billing) Rate(projectID int, date time.Time) (rate float64, currency string, ok bool) {
+	p, found := b.projects[projectID]
+	if !found {
+		return 0, "", false
+	}
+	c, found := b.clients[p.ClientID]
+	if !found {
+		return 0, "", false
+	}
+	for _, rates := range [][]rateRow{b.userRates[projectID], b.projectRates[projectID], b.clientRates[c.ID]} {
+		if rate, ok := effectiveRate(rates, date); ok {
+			return rate, c.Currency, true
+		}
+	}
+	return 0, "", false
+}
+
+// Amount returns the billable amount of the entry and its currency, ok is false if it's not billable
+func (b *Billing) Amount(e Entry) (amount float64, currency string, ok bool) {
+	rate, currency, ok := b.Rate(e.ProjectID, e.Date)
+	if !ok {
+		return 0, "", false
+	}
+	return roundMoney(e.Duration * rate), currency, true
+}
+
+// Currencies returns the currencies of the user project clients, sorted
+func (b *Billing) Currencies() []string {
+	seen := map[string]bool{}
+	currencies := []string{}
+	for _, p := range b.projects {
+		if c, ok := b.clients[p.ClientID]; ok && !seen[c.Currency] {
+			seen[c.Currency] = true
+			currencies = append(currencies, c.Currency)
+		}
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// roundMoney rounds the amount to the cents
+func roundMoney(a float64) float64 {
+	return math.Round(a*100) / 100
+}
+
+// Amounts - the billable amounts by their currencies
+type Amounts map[string]float64
+
+// add adds the amount in the currency
+func (a Amounts) add(currency string, amount float64) {
+	a[currency] = roundMoney(a[currency] + amount)
+}
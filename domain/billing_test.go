@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestBillingRate(t *testing.T) {
+	s, fake := newTestService(t, Config{})
+	fake.Insert("client", sdbtest.Row{"id": 1, "user_id": 1, "name": "Client", "currency": "EUR", "timestamp": "2026-01-01T00:00:00"})
+	fake.Insert("project",
+		sdbtest.Row{"id": 2, "name": "Rates", "description": "", "client_id": 1, "timestamp": "2026-01-01T00:00:00"},
+		sdbtest.Row{"id": 3, "name": "Client rate", "description": "", "client_id": 1, "timestamp": "2026-01-01T00:00:00"},
+	)
+	fake.Insert("timesheet",
+		sdbtest.Row{"user_id": 1, "project_id": 2, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""},
+		sdbtest.Row{"user_id": 1, "project_id": 3, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""},
+	)
+	fake.Insert("rate",
+		sdbtest.Row{"id": 1, "client_id": 1, "project_id": nil, "user_id": nil, "hourly_rate": 40, "effective_from": "2026-01-01"},
+		sdbtest.Row{"id": 2, "client_id": nil, "project_id": 2, "user_id": nil, "hourly_rate": 55, "effective_from": "2026-03-01T00:00:00"},
+		sdbtest.Row{"id": 3, "client_id": nil, "project_id": 2, "user_id": nil, "hourly_rate": 50, "effective_from": "2026-02-01"},
+		sdbtest.Row{"id": 4, "client_id": nil, "project_id": 2, "user_id": 1, "hourly_rate": 70, "effective_from": "2026-03-15"},
+		// the rate of the other user doesn't price the user entries
+		sdbtest.Row{"id": 5, "client_id": nil, "project_id": 2, "user_id": 2, "hourly_rate": 90, "effective_from": "2026-01-01"},
+	)
+
+	b, err := s.Billing(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Billing: %v", err)
+	}
+	if got := strings.Join(b.Currencies(), ","); got != "EUR" {
+		t.Errorf("got currencies %s, want EUR", got)
+	}
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name      string
+		projectID int
+		date      time.Time
+		want      float64
+		wantOK    bool
+	}{
+		{name: "before any rate", projectID: 2, date: time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC)},
+		{name: "the client rate", projectID: 2, date: at(1, 15, 9), want: 40, wantOK: true},
+		{name: "the project rate from its date", projectID: 2, date: at(2, 1, 0), want: 50, wantOK: true},
+		{name: "the project rate until the next one", projectID: 2, date: at(2, 28, 23), want: 50, wantOK: true},
+		{name: "the next project rate", projectID: 2, date: at(3, 1, 9), want: 55, wantOK: true},
+		{name: "the user rate", projectID: 2, date: at(3, 15, 9), want: 70, wantOK: true},
+		{name: "the user rate later on", projectID: 2, date: at(6, 1, 9), want: 70, wantOK: true},
+		{name: "no client", projectID: 1, date: at(3, 1, 9)},
+		{name: "only the client rate", projectID: 3, date: at(3, 1, 9), want: 40, wantOK: true},
+		{name: "not a user project", projectID: 9, date: at(3, 1, 9)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, currency, ok := b.Rate(tt.projectID, tt.date)
+			if ok != tt.wantOK || rate != tt.want {
+				t.Fatalf("got rate %v (ok %v), want %v (ok %v)", rate, ok, tt.want, tt.wantOK)
+			}
+			if ok && currency != "EUR" {
+				t.Errorf("got currency %s, want EUR", currency)
+			}
+		})
+	}
+
+	amount, currency, ok := b.Amount(Entry{ProjectID: 2, Date: at(3, 2, 9), Duration: 1.25})
+	if !ok || amount != 68.75 || currency != "EUR" {
+		t.Errorf("got amount %v %s (ok %v), want 68.75 EUR", amount, currency, ok)
+	}
+}
+
+func TestProjectRateOwner(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID int
+		// rateUserID is the user the rate is of, 0 for the project wide one
+		rateUserID int
+		wantErr    error
+	}{
+		{name: "the owner, the project rate", userID: 1},
+		{name: "the owner, a member rate", userID: 1, rateUserID: 2},
+		{name: "a member, own rate", userID: 2, rateUserID: 2},
+		{name: "a member, the project rate", userID: 2, wantErr: ErrNotProjectOwner},
+		{name: "a member, the owner rate", userID: 2, rateUserID: 1, wantErr: ErrNotProjectOwner},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, fake := newTestService(t, Config{})
+			fake.Insert("project", sdbtest.Row{"id": 2, "name": "Shared", "description": "", "client_id": nil, "user_id": 1, "timestamp": "2026-01-01T00:00:00"})
+			fake.Insert("timesheet",
+				sdbtest.Row{"user_id": 1, "project_id": 2, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""},
+				sdbtest.Row{"user_id": 2, "project_id": 2, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""},
+			)
+			var rateUserID interface{}
+			if tt.rateUserID != 0 {
+				rateUserID = tt.rateUserID
+			}
+			fake.Insert("rate", sdbtest.Row{"id": 1, "client_id": nil, "project_id": 2, "user_id": rateUserID, "hourly_rate": 50, "effective_from": "2026-01-01"})
+
+			in := RateInput{HourlyRate: 60, EffectiveFrom: "2026-03-01", UserID: tt.rateUserID}
+			if _, _, err := s.SetProjectRate(context.Background(), tt.userID, 2, in); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetProjectRate: got error %v, want %v", err, tt.wantErr)
+			}
+			if err := s.DeleteRate(context.Background(), tt.userID, 1); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteRate: got error %v, want %v", err, tt.wantErr)
+			}
+			// the set one is new, it's effective from another date
+			if got, want := len(fake.Rows("rate")), 1; got != want {
+				t.Errorf("got %d rates, want %d", got, want)
+			}
+			if kept := count(fake, "rate", "id", 1) == 1; kept != (tt.wantErr != nil) {
+				t.Errorf("got the rate kept %v, want %v", kept, tt.wantErr != nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// calendarTokenSize is the number of the random bytes of a calendar feed token
+const calendarTokenSize = 32
+
+// calendarTokenRow - the calendar feed token column of a user table row, null until the user asks for the feed
+type calendarTokenRow struct {
+	CalendarToken *string `json:"calendar_token"`
+}
+
+// validCalendarToken reports if the token looks like a calendar feed token, the others needn't be looked up
+func validCalendarToken(token string) bool {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	return err == nil && len(b) == calendarTokenSize
+}
+
+// newCalendarToken returns a new random calendar feed token
+func newCalendarToken() (string, error) {
+	b := make([]byte, calendarTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating the calendar token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CalendarToken returns the user calendar feed token, it's generated the first time
+func (s *Service) CalendarToken(ctx context.Context, userID int) (string, error) {
+	rows := []calendarTokenRow{}
+	if err := s.get(ctx, s.request(filter("user", "id", strconv.Itoa(userID))), &rows); err != nil {
+		return "", err
+	}
+	if len(rows) != 1 {
+		return "", ErrNotFound
+	}
+	if t := rows[0].CalendarToken; t != nil && *t != "" {
+		return *t, nil
+	}
+
+	token, err := newCalendarToken()
+	if err != nil {
+		return "", err
+	}
+	// only while there's none, the token of a concurrent request is kept
+	req := s.request(filter("user", "id", strconv.Itoa(userID), "calendar_token", sdbNull))
+	err = s.update(ctx, req, calendarTokenRow{CalendarToken: &token})
+	if errors.Is(err, ErrNotFound) {
+		if err := s.get(ctx, s.request(filter("user", "id", strconv.Itoa(userID))), &rows); err != nil {
+			return "", err
+		}
+		if len(rows) != 1 || rows[0].CalendarToken == nil {
+			return "", ErrNotFound
+		}
+		return *rows[0].CalendarToken, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RotateCalendarToken replaces the user calendar feed token, the feed URL with the old one stops working
+func (s *Service) RotateCalendarToken(ctx context.Context, userID int) (string, error) {
+	token, err := newCalendarToken()
+	if err != nil {
+		return "", err
+	}
+	req := s.request(filter("user", "id", strconv.Itoa(userID)))
+	if err := s.update(ctx, req, calendarTokenRow{CalendarToken: &token}); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// CalendarUser returns the ID of the user with the calendar feed token
+func (s *Service) CalendarUser(ctx context.Context, token string) (int, error) {
+	if !validCalendarToken(token) {
+		return 0, ErrNotFound
+	}
+	users := []User{}
+	if err := s.get(ctx, s.request(filter("user", "calendar_token", token)), &users); err != nil {
+		return 0, err
+	}
+	if len(users) != 1 {
+		return 0, ErrNotFound
+	}
+	return users[0].ID, nil
+}
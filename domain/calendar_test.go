@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestValidCalendarToken(t *testing.T) {
+	token, err := newCalendarToken()
+	if err != nil {
+		t.Fatalf("newCalendarToken: %v", err)
+	}
+	tests := []struct {
+		token string
+		want  bool
+	}{
+		{token: token, want: true},
+		{token: ""},
+		{token: token[:42]},
+		{token: token + "A"},
+		{token: strings.Repeat("+", 43)},
+		{token: "1.c2lnbmF0dXJl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			if got := validCalendarToken(tt.token); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarToken(t *testing.T) {
+	s, fake := newTestService(t, Config{})
+	ctx := context.Background()
+
+	token, err := s.CalendarToken(ctx, 1)
+	if err != nil {
+		t.Fatalf("CalendarToken: %v", err)
+	}
+	if !validCalendarToken(token) {
+		t.Fatalf("got an invalid token %q", token)
+	}
+	if again, err := s.CalendarToken(ctx, 1); err != nil || again != token {
+		t.Errorf("got token %q (error %v) the second time, want %q", again, err, token)
+	}
+	if other, err := s.CalendarToken(ctx, 2); err != nil || other == token {
+		t.Errorf("got token %q (error %v) of the other user, want a different one", other, err)
+	}
+	if id, err := s.CalendarUser(ctx, token); err != nil || id != 1 {
+		t.Errorf("got user %d (error %v), want 1", id, err)
+	}
+
+	rotated, err := s.RotateCalendarToken(ctx, 1)
+	if err != nil {
+		t.Fatalf("RotateCalendarToken: %v", err)
+	}
+	if rotated == token {
+		t.Fatal("the rotated token is the same")
+	}
+	if _, err := s.CalendarUser(ctx, token); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v of the old token, want %v", err, ErrNotFound)
+	}
+	if id, err := s.CalendarUser(ctx, rotated); err != nil || id != 1 {
+		t.Errorf("got user %d (error %v) of the rotated token, want 1", id, err)
+	}
+
+	before := len(fake.Requests())
+	if _, err := s.CalendarUser(ctx, "../user"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v of a malformed token, want %v", err, ErrNotFound)
+	}
+	if len(fake.Requests()) != before {
+		t.Error("the malformed token was looked up")
+	}
+	if _, err := s.CalendarToken(ctx, 3); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v of a missing user, want %v", err, ErrNotFound)
+	}
+}
+
+func TestCalendarTokenConcurrently(t *testing.T) {
+	s, fake := newTestService(t, Config{})
+	ctx := context.Background()
+
+	// the other request sets the token once the first one is about to
+	set := false
+	var other string
+	var otherErr error
+	fake.Before = func(r *http.Request) {
+		if r.Method == http.MethodPut && !set {
+			set = true
+			other, otherErr = s.CalendarToken(ctx, 1)
+		}
+	}
+	token, err := s.CalendarToken(ctx, 1)
+	if err != nil || otherErr != nil {
+		t.Fatalf("CalendarToken: %v, the other one: %v", err, otherErr)
+	}
+	if token != other {
+		t.Errorf("got tokens %q and %q, want the same one", token, other)
+	}
+	if got := count(fake, "user", "calendar_token", token); got != 1 {
+		t.Errorf("got %d users with the token, want 1", got)
+	}
+}
@@ -0,0 +1,293 @@
+// Package domain holds the timesheet domain model (users, clients, projects, their time entries and invoices)
+// and its operations, implemented on top of SlashDB, so the app doesn't have to expose the DB layout
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// domain errors, the transport maps them to the HTTP statuses
+var (
+	ErrNotFound = errors.New("not found")
+	ErrConflict = errors.New("the resource already exists")
+	// ErrForbidden - the user can see the resource, but not change it
+	ErrForbidden = errors.New("forbidden")
+	// ErrTimerRunning - the user can only have one timer running
+	ErrTimerRunning error = conflictError("a timer is already running")
+	// ErrNotProjectOwner - only the owner of a shared project can change its client and rates
+	ErrNotProjectOwner error = forbiddenError("only the project owner can change that")
+)
+
+// conflictError - a conflict, with a more specific message
+type conflictError string
+
+func (e conflictError) Error() string { return string(e) }
+
+// Is makes errors.Is(err, ErrConflict) work
+func (e conflictError) Is(target error) bool { return target == ErrConflict }
+
+// forbiddenError - a forbidden change, with a more specific message
+type forbiddenError string
+
+func (e forbiddenError) Error() string { return string(e) }
+
+// Is makes errors.Is(err, ErrForbidden) work
+func (e forbiddenError) Is(target error) bool { return target == ErrForbidden }
+
+// ValidationError - the per field validation errors
+type ValidationError struct {
+	Fields map[string][]string
+}
+
+func (e *ValidationError) Error() string {
+	msgs := []string{}
+	for field, errs := range e.Fields {
+		msgs = append(msgs, field+": "+strings.Join(errs, ", "))
+	}
+	sort.Strings(msgs)
+	return "invalid input: " + strings.Join(msgs, "; ")
+}
+
+// add records a field error
+func (e *ValidationError) add(field, msg string) {
+	if e.Fields == nil {
+		e.Fields = map[string][]string{}
+	}
+	e.Fields[field] = append(e.Fields[field], msg)
+}
+
+// errOrNil returns the error only if there's anything in it
+func (e *ValidationError) errOrNil() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+// User - an app user, without the credentials
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+}
+
+// Project - a project, users are linked to the projects through their time entries
+type Project struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	// ClientID is the client the project is done for, 0 for none
+	ClientID int `json:"client_id,omitempty"`
+	// OwnerID is the user who created the project, 0 for the projects created before the owners were recorded
+	OwnerID int `json:"owner_id,omitempty"`
+	// Entries are only set when asked for
+	Entries []Entry `json:"entries,omitempty"`
+}
+
+// ProjectInput - the editable project fields
+type ProjectInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// ClientID links the project to one of the clients the user manages, 0 unlinks it, nil leaves it as it is
+	ClientID *int `json:"client_id,omitempty"`
+}
+
+// payload returns the project table columns of the input
+func (in ProjectInput) payload() map[string]interface{} {
+	p := map[string]interface{}{"name": in.Name, "description": in.Description}
+	if in.ClientID != nil {
+		p["client_id"] = in.ClientID
+		if *in.ClientID == 0 {
+			p["client_id"] = nil
+		}
+	}
+	return p
+}
+
+// Validate checks the input against the DB constraints
+func (in *ProjectInput) Validate() error {
+	in.Name, in.Description = strings.TrimSpace(in.Name), strings.TrimSpace(in.Description)
+	ve := &ValidationError{}
+	if in.Name == "" {
+		ve.add("name", "this field is required")
+	}
+	if len(in.Name) > 50 {
+		ve.add("name", "can be at most 50 characters long")
+	}
+	if len(in.Description) > 150 {
+		ve.add("description", "can be at most 150 characters long")
+	}
+	if in.ClientID != nil && *in.ClientID < 0 {
+		ve.add("client_id", "has to be a client ID")
+	}
+	return ve.errOrNil()
+}
+
+// Entry - a single time entry, it's identified by the user, the project and the date
+type Entry struct {
+	ID        string    `json:"id"`
+	UserID    int       `json:"user_id"`
+	ProjectID int       `json:"project_id"`
+	Date      time.Time `json:"date"`
+	// Duration is in hours
+	Duration        float64 `json:"duration"`
+	Accomplishments string  `json:"accomplishments"`
+	// InvoiceID is the invoice the entry is billed on, the invoiced entries can't be changed
+	InvoiceID int `json:"invoice_id,omitempty"`
+}
+
+// entryIDLayout is the date part of the entry ID
+const entryIDLayout = "20060102T150405"
+
+// EntryID returns the ID of the project entry started at date
+func EntryID(projectID int, date time.Time) string {
+	return fmt.Sprintf("%d_%s", projectID, date.UTC().Format(entryIDLayout))
+}
+
+// ParseEntryID splits the entry ID into the project ID and the date
+func ParseEntryID(id string) (projectID int, date time.Time, err error) {
+	tmp := strings.SplitN(id, "_", 2)
+	if len(tmp) != 2 {
+		return 0, time.Time{}, ErrNotFound
+	}
+	if _, err := fmt.Sscanf(tmp[0], "%d", &projectID); err != nil {
+		return 0, time.Time{}, ErrNotFound
+	}
+	if date, err = time.Parse(entryIDLayout, tmp[1]); err != nil {
+		return 0, time.Time{}, ErrNotFound
+	}
+	return projectID, date, nil
+}
+
+// EntryInput - the editable entry fields, the project and the date can only be set on creation
+type EntryInput struct {
+	ProjectID int `json:"project_id"`
+	// Date defaults to now
+	Date            *time.Time `json:"date"`
+	Duration        float64    `json:"duration"`
+	Accomplishments string     `json:"accomplishments"`
+}
+
+// maxEntryDuration is the longest a single entry (in hours) can be
+const maxEntryDuration = 24 * 7
+
+// MaxAccomplishmentsLen is the longest (in bytes) the entry accomplishments can be
+const MaxAccomplishmentsLen = 150
+
+// Validate checks the input against the DB constraints, creating tells if it's a new entry
+func (in *EntryInput) Validate(creating bool) error {
+	in.Accomplishments = strings.TrimSpace(in.Accomplishments)
+	ve := &ValidationError{}
+	if creating && in.ProjectID <= 0 {
+		ve.add("project_id", "this field is required")
+	}
+	if in.Duration <= 0 {
+		ve.add("duration", "has to be greater than 0")
+	}
+	if in.Duration > maxEntryDuration {
+		ve.add("duration", fmt.Sprintf("can be at most %d hours", maxEntryDuration))
+	}
+	if in.Accomplishments == "" {
+		ve.add("accomplishments", "this field is required")
+	}
+	if len(in.Accomplishments) > MaxAccomplishmentsLen {
+		ve.add("accomplishments", fmt.Sprintf("can be at most %d characters long", MaxAccomplishmentsLen))
+	}
+	return ve.errOrNil()
+}
+
+// EntryFilter - narrows down the entries, zero values don't filter
+type EntryFilter struct {
+	ProjectID int
+	// From is inclusive, To exclusive
+	From, To time.Time
+}
+
+func (f EntryFilter) match(e Entry) bool {
+	if f.ProjectID != 0 && e.ProjectID != f.ProjectID {
+		return false
+	}
+	if !f.From.IsZero() && e.Date.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !e.Date.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+// Page - the pagination and sorting settings of a listing
+type Page struct {
+	Limit  int
+	Offset int
+	// Sort is a field name, optionally prefixed with "-" for the descending order
+	Sort string
+}
+
+// MaxPageLimit is the biggest page size
+const MaxPageLimit = 1000
+
+// DefaultPageLimit is the page size used when none is given
+const DefaultPageLimit = 50
+
+// Pagination - the pagination info of a listing
+type Pagination struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// normalize fixes the out of range values
+func (p Page) normalize() Page {
+	if p.Limit <= 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		p.Limit = MaxPageLimit
+	}
+	if p.Offset < 0 {
+		p.Offset = 0
+	}
+	return p
+}
+
+// paginate returns the page of items
+func paginate[T any](items []T, p Page) ([]T, Pagination) {
+	pg := Pagination{Total: len(items), Limit: p.Limit, Offset: p.Offset}
+	if p.Offset >= len(items) {
+		return []T{}, pg
+	}
+	end := p.Offset + p.Limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[p.Offset:end], pg
+}
+
+// Summary - the total hours of a group of entries
+type Summary struct {
+	// Key is the project ID or the day (2006-01-02), depending on the grouping
+	Key         string  `json:"key"`
+	ProjectID   int     `json:"project_id,omitempty"`
+	ProjectName string  `json:"project_name,omitempty"`
+	Entries     int     `json:"entries"`
+	Hours       float64 `json:"hours"`
+}
+
+// summary groupings
+const (
+	GroupByProject = "project"
+	GroupByDay     = "day"
+)
+
+// Summaries - the grouped entry totals
+type Summaries struct {
+	GroupBy string    `json:"group_by"`
+	Groups  []Summary `json:"groups"`
+	Total   Summary   `json:"total"`
+}
@@ -0,0 +1,369 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+// the import defaults
+const (
+	defaultImportBatchSize   = 100
+	defaultImportConcurrency = 4
+)
+
+// ImportRow - an entry to import, Line is where it comes from (i.e. the CSV record number) for the error reports
+type ImportRow struct {
+	Line int
+	// Project is the project name, it's mapped to one of the user projects, or created
+	Project         string
+	Date            time.Time
+	Duration        float64
+	Accomplishments string
+	// Warning is a note on how the row was read, i.e. its description was cut short
+	Warning string
+}
+
+// ImportError - why a row wasn't imported
+type ImportError struct {
+	Line    int    `json:"line"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportProject - what a project name of the import is mapped to
+type ImportProject struct {
+	Name string `json:"name"`
+	// ID is 0 for the projects the dry run would create
+	ID      int  `json:"id"`
+	Created bool `json:"created"`
+}
+
+// ImportInput - the rows to import and how
+type ImportInput struct {
+	Rows []ImportRow
+	// Errors are the rows that couldn't even be parsed, they're reported along
+	Errors []ImportError
+	// DryRun validates the rows and maps the projects without writing anything
+	DryRun bool
+	// CreateProjects creates the projects the user doesn't have, the rows of the unknown ones fail without it
+	CreateProjects bool
+	// ProjectMap maps the project names to the user project IDs, the names not in it are matched by the project names
+	ProjectMap map[string]int
+	// ProjectID puts all the rows in one of the user projects, whatever their project names
+	ProjectID int
+}
+
+// ImportPreview - a row as the dry run would import it
+type ImportPreview struct {
+	Line      int    `json:"line"`
+	Project   string `json:"project"`
+	ProjectID int    `json:"project_id"`
+	// Date is the entry date, it's moved a minute or a few if the row collides with the other ones
+	Date            time.Time `json:"date"`
+	Duration        float64   `json:"duration"`
+	Accomplishments string    `json:"accomplishments"`
+	// Duplicate rows match the existing entries, they're skipped
+	Duplicate bool `json:"duplicate"`
+}
+
+// ImportResult - the import outcome
+type ImportResult struct {
+	DryRun bool `json:"dry_run"`
+	Rows   int  `json:"rows"`
+	// Imported are the rows created, or in the dry run the ones that would be
+	Imported int `json:"imported"`
+	// Duplicates are the rows skipped, as the user already has an entry of the project at that time
+	Duplicates int             `json:"duplicates"`
+	Failed     int             `json:"failed"`
+	Projects   []ImportProject `json:"projects"`
+	Errors     []ImportError   `json:"errors"`
+	// Warnings are the notes on the rows imported as far as they could be
+	Warnings []ImportError `json:"warnings,omitempty"`
+	// Preview lists the rows the dry run would import and the duplicates
+	Preview []ImportPreview `json:"preview,omitempty"`
+}
+
+// fail records a row error, Failed is counted from them once the import is done
+func (r *ImportResult) fail(line int, field, msg string) {
+	r.Errors = append(r.Errors, ImportError{Line: line, Field: field, Message: msg})
+}
+
+// importProjects maps the project names of the rows to the user project IDs, creating the missing ones
+// if allowed, the names it can't map are left out, with the reasons in unmapped
+func (s *Service) importProjects(
+	ctx context.Context, userID int, in ImportInput, names []string, result *ImportResult,
+) (ids map[string]int, unmapped map[string]string, err error) {
+	projects, err := s.projects(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	byName := map[string]int{}
+	for id, p := range projects {
+		byName[strings.ToLower(p.Name)] = id
+	}
+
+	ids, unmapped = map[string]int{}, map[string]string{}
+	if in.ProjectID != 0 {
+		p, ok := projects[in.ProjectID]
+		if !ok {
+			return nil, nil, &ValidationError{Fields: map[string][]string{"project_id": {"not one of your projects"}}}
+		}
+		for _, name := range names {
+			ids[name] = in.ProjectID
+		}
+		result.Projects = append(result.Projects, ImportProject{Name: p.Name, ID: in.ProjectID})
+		return ids, unmapped, nil
+	}
+	for _, name := range names {
+		if id, ok := in.ProjectMap[name]; ok {
+			if _, ok := projects[id]; !ok {
+				return nil, nil, &ValidationError{Fields: map[string][]string{
+					"project_map": {fmt.Sprintf("%q is mapped to %d, which is not one of your projects", name, id)},
+				}}
+			}
+			ids[name] = id
+			result.Projects = append(result.Projects, ImportProject{Name: name, ID: id})
+			continue
+		}
+		if id, ok := byName[strings.ToLower(name)]; ok {
+			ids[name] = id
+			result.Projects = append(result.Projects, ImportProject{Name: name, ID: id})
+			continue
+		}
+		if !in.CreateProjects {
+			unmapped[name] = fmt.Sprintf("no such project %q", name)
+			continue
+		}
+		pin := ProjectInput{Name: name}
+		var ve *ValidationError
+		if err := pin.Validate(); errors.As(err, &ve) {
+			unmapped[name] = fmt.Sprintf("can't create the %q project: %s", name, strings.Join(ve.Fields["name"], ", "))
+			continue
+		}
+		p := ImportProject{Name: name, Created: true}
+		if !in.DryRun {
+			created, err := s.CreateProject(ctx, userID, pin)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error creating the %q project: %w", name, err)
+			}
+			p.ID = created.ID
+		}
+		// the dry run maps the name to 0, it only has to tell the known projects apart
+		ids[name] = p.ID
+		byName[strings.ToLower(name)] = p.ID
+		result.Projects = append(result.Projects, p)
+	}
+	return ids, unmapped, nil
+}
+
+// Import validates the rows and creates the entries of the valid ones, in batches created concurrently.
+// The rows are imported as far as possible, the ones that fail are reported in the result errors.
+// Once the rows are created, the result is returned even if ctx is done, along with its error,
+// the rows not created by then are failed
+func (s *Service) Import(ctx context.Context, userID int, in ImportInput) (ImportResult, error) {
+	result := ImportResult{DryRun: in.DryRun, Projects: []ImportProject{}, Errors: append([]ImportError{}, in.Errors...)}
+
+	valid := []ImportRow{}
+	names := []string{}
+	seenNames := map[string]bool{}
+	for _, row := range in.Rows {
+		row.Project = strings.TrimSpace(row.Project)
+		if row.Project == "" && in.ProjectID == 0 {
+			result.fail(row.Line, "project", "this field is required")
+			continue
+		}
+		if row.Date.IsZero() {
+			result.fail(row.Line, "date", "this field is required")
+			continue
+		}
+		// the project is checked further, once the names are mapped
+		input := EntryInput{Duration: row.Duration, Accomplishments: row.Accomplishments}
+		var ve *ValidationError
+		if err := input.Validate(false); errors.As(err, &ve) {
+			fields := make([]string, 0, len(ve.Fields))
+			for field := range ve.Fields {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			for _, field := range fields {
+				result.fail(row.Line, field, strings.Join(ve.Fields[field], ", "))
+			}
+			continue
+		}
+		row.Accomplishments = input.Accomplishments
+		valid = append(valid, row)
+		if row.Warning != "" {
+			result.Warnings = append(result.Warnings, ImportError{Line: row.Line, Message: row.Warning})
+		}
+		if !seenNames[row.Project] {
+			seenNames[row.Project] = true
+			names = append(names, row.Project)
+		}
+	}
+
+	projectIDs, unmapped, err := s.importProjects(ctx, userID, in, names, &result)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	// the preview shows the names of the projects the rows end up in
+	previewName := func(row ImportRow) string {
+		if in.ProjectID != 0 {
+			return result.Projects[0].Name
+		}
+		return row.Project
+	}
+
+	existing, err := s.rows(ctx, userID)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	// an entry is keyed by its project and its date (to a second), see EntryID
+	type entryKey struct {
+		project string
+		date    string
+	}
+	// the rows matching the entries are taken for duplicates, the ones matching the project
+	// memberships (or the other imported rows) are just moved
+	taken, occupied := map[entryKey]bool{}, map[entryKey]bool{}
+	for _, r := range existing {
+		k := entryKey{fmt.Sprint(r.ProjectID), formatSdbTime(parseSdbTime(r.Date))}
+		if r.membership() {
+			occupied[k] = true
+		} else {
+			taken[k] = true
+		}
+	}
+
+	toCreate := []ImportRow{}
+	rows := []timesheetRow{}
+	for _, row := range valid {
+		id, ok := projectIDs[row.Project]
+		if !ok {
+			result.fail(row.Line, "project", unmapped[row.Project])
+			continue
+		}
+		project := fmt.Sprint(id)
+		if id == 0 {
+			// not created in the dry run yet
+			project = "new:" + row.Project
+		}
+		date := row.Date.UTC().Truncate(time.Second)
+		if taken[entryKey{project, formatSdbTime(date)}] {
+			result.Duplicates++
+			if in.DryRun {
+				result.Preview = append(result.Preview, ImportPreview{
+					Line: row.Line, Project: previewName(row), ProjectID: id, Date: date,
+					Duration: row.Duration, Accomplishments: row.Accomplishments, Duplicate: true,
+				})
+			}
+			continue
+		}
+		// the exports without the start times (or with a few entries started at once) would collide,
+		// those are moved a minute apart
+		for k := (entryKey{project, formatSdbTime(date)}); occupied[k] || taken[k]; k.date = formatSdbTime(date) {
+			date = date.Add(time.Minute)
+		}
+		occupied[entryKey{project, formatSdbTime(date)}] = true
+		if in.DryRun {
+			result.Preview = append(result.Preview, ImportPreview{
+				Line: row.Line, Project: previewName(row), ProjectID: id, Date: date,
+				Duration: row.Duration, Accomplishments: row.Accomplishments,
+			})
+		}
+
+		toCreate = append(toCreate, row)
+		rows = append(rows, timesheetRow{
+			UserID:          userID,
+			ProjectID:       id,
+			Date:            formatSdbTime(date),
+			Duration:        row.Duration,
+			Accomplishments: row.Accomplishments,
+		})
+	}
+
+	if in.DryRun {
+		result.Imported = len(rows)
+	} else {
+		s.createBatches(ctx, toCreate, rows, &result)
+	}
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Line < result.Errors[j].Line })
+	failed := map[int]bool{}
+	for _, e := range result.Errors {
+		failed[e.Line] = true
+	}
+	result.Failed = len(failed)
+	unparsed := map[int]bool{}
+	for _, e := range in.Errors {
+		unparsed[e.Line] = true
+	}
+	result.Rows = len(in.Rows) + len(unparsed)
+	return result, ctx.Err()
+}
+
+// createBatches creates the rows in batches, a few at a time, the rows of the batches that fail are retried
+// one by one, so the failing ones can be told apart
+func (s *Service) createBatches(ctx context.Context, src []ImportRow, rows []timesheetRow, result *ImportResult) {
+	size, concurrency := s.cfg.ImportBatchSize, s.cfg.ImportConcurrency
+	if size <= 0 {
+		size = defaultImportBatchSize
+	}
+	if concurrency <= 0 {
+		concurrency = defaultImportConcurrency
+	}
+
+	mu := sync.Mutex{}
+	sem := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for start := 0; start < len(rows); start += size {
+		end := min(start+size, len(rows))
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			for _, row := range src[start:] {
+				result.fail(row.Line, "", "the import was cancelled")
+			}
+			mu.Unlock()
+			wg.Wait()
+			return
+		}
+		wg.Add(1)
+		go func(src []ImportRow, rows []timesheetRow) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			req := s.request(slashdb.Part{Name: "timesheet"})
+			if _, err := s.create(ctx, req, rows); err == nil {
+				mu.Lock()
+				result.Imported += len(rows)
+				mu.Unlock()
+				return
+			}
+			for i, row := range rows {
+				err := ctx.Err()
+				if err == nil {
+					_, err = s.create(ctx, req, row)
+				}
+				mu.Lock()
+				switch {
+				case err == nil:
+					result.Imported++
+				case errors.Is(err, ErrConflict):
+					result.Duplicates++
+				default:
+					result.fail(src[i].Line, "", err.Error())
+				}
+				mu.Unlock()
+			}
+		}(src[start:end], rows[start:end])
+	}
+	wg.Wait()
+}
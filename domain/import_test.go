@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestImport(t *testing.T) {
+	at := func(day, hour int) time.Time { return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC) }
+	rows := []ImportRow{
+		{Line: 2, Project: "Project", Date: at(2, 9), Duration: 1, Accomplishments: "work"},
+		{Line: 3, Project: "project", Date: at(3, 9), Duration: 2, Accomplishments: "cut short…", Warning: "the description was cut"},
+		// the entry exists
+		{Line: 4, Project: "Project", Date: at(4, 9), Duration: 1, Accomplishments: "work"},
+		{Line: 5, Project: "Project", Date: at(5, 9), Duration: 0, Accomplishments: "work"},
+		{Line: 6, Project: "Other", Date: at(6, 9), Duration: 1, Accomplishments: "work"},
+		{Line: 7, Project: "", Date: at(7, 9), Duration: 1, Accomplishments: "work"},
+	}
+	tests := []struct {
+		name   string
+		dryRun bool
+		// the timesheet rows added
+		wantCreated int
+	}{
+		{name: "imported", wantCreated: 2},
+		{name: "dry run", dryRun: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, fake := newTestService(t, Config{})
+			row := entryRow(1, 1, "", 1)
+			row["date"] = formatSdbTime(at(4, 9))
+			fake.Insert("timesheet", row)
+			before := len(fake.Rows("timesheet"))
+
+			result, err := s.Import(context.Background(), 1, ImportInput{
+				Rows: rows, Errors: []ImportError{{Line: 8, Field: "date", Message: "can't parse"}}, DryRun: tt.dryRun,
+			})
+			if err != nil {
+				t.Fatalf("Import: %v", err)
+			}
+			if result.Rows != 7 || result.Imported != 2 || result.Duplicates != 1 || result.Failed != 4 {
+				t.Errorf("got %d rows, %d imported, %d duplicates, %d failed, want 7, 2, 1, 4",
+					result.Rows, result.Imported, result.Duplicates, result.Failed)
+			}
+			if len(result.Warnings) != 1 || result.Warnings[0].Line != 3 {
+				t.Errorf("got warnings %v, want the line 3 one", result.Warnings)
+			}
+			if got := len(fake.Rows("timesheet")) - before; got != tt.wantCreated {
+				t.Errorf("got %d rows created, want %d", got, tt.wantCreated)
+			}
+		})
+	}
+}
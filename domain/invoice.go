@@ -0,0 +1,581 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+// invoice statuses, the drafts can be changed or deleted, the issued invoices are final
+const (
+	InvoiceDraft  = "draft"
+	InvoiceIssued = "issued"
+)
+
+// how the entries are grouped into the invoice lines, either way a line has a single hourly rate
+const (
+	InvoiceByProject = "project"
+	// InvoiceByTask has a line per project and accomplishments
+	InvoiceByTask = "task"
+)
+
+// defaultInvoicePrefix starts the invoice numbers, i.e. INV-2026-0001
+const defaultInvoicePrefix = "INV-"
+
+// issueAttempts is how many numbers issuing tries, when the ones it picks are taken meanwhile
+const issueAttempts = 3
+
+var (
+	// ErrEntryInvoiced - the invoiced entries are locked, deleting the draft invoice releases them
+	ErrEntryInvoiced error = conflictError("the entry is invoiced")
+	// ErrProjectInvoiced - the projects with invoiced entries are kept along with the invoices
+	ErrProjectInvoiced error = conflictError("the project has invoiced entries")
+	// ErrInvoiceIssued - only the draft invoices can be changed or deleted
+	ErrInvoiceIssued error = conflictError("the invoice is issued")
+)
+
+// InvoiceLine - the hours of a project, or of a task on it, at an hourly rate
+type InvoiceLine struct {
+	ID          int     `json:"id"`
+	ProjectID   int     `json:"project_id"`
+	Description string  `json:"description"`
+	Hours       float64 `json:"hours"`
+	HourlyRate  float64 `json:"hourly_rate"`
+	Amount      float64 `json:"amount"`
+}
+
+// InvoiceTerms - the editable invoice fields
+type InvoiceTerms struct {
+	// DiscountPercent is taken off the subtotal, TaxPercent is added to what's left
+	DiscountPercent float64 `json:"discount_percent"`
+	TaxPercent      float64 `json:"tax_percent"`
+	// DueDate is a date (2006-01-02)
+	DueDate string `json:"due_date,omitempty"`
+	Notes   string `json:"notes,omitempty"`
+}
+
+// validate adds the terms errors to ve
+func (t *InvoiceTerms) validate(ve *ValidationError) {
+	t.DueDate, t.Notes = strings.TrimSpace(t.DueDate), strings.TrimSpace(t.Notes)
+	if t.DiscountPercent < 0 || t.DiscountPercent > 100 || math.IsNaN(t.DiscountPercent) {
+		ve.add("discount_percent", "has to be between 0 and 100")
+	}
+	if t.TaxPercent < 0 || t.TaxPercent > 100 || math.IsNaN(t.TaxPercent) {
+		ve.add("tax_percent", "has to be between 0 and 100")
+	}
+	if _, err := time.Parse(dateLayout, t.DueDate); t.DueDate != "" && err != nil {
+		ve.add("due_date", "expected a date (YYYY-MM-DD)")
+	}
+	if len(t.Notes) > 500 {
+		ve.add("notes", "can be at most 500 characters long")
+	}
+}
+
+// Validate checks the terms
+func (t *InvoiceTerms) Validate() error {
+	ve := &ValidationError{}
+	t.validate(ve)
+	return ve.errOrNil()
+}
+
+// Invoice - the entries of a client projects billed over a period, the amounts are in the client currency
+type Invoice struct {
+	ID int `json:"id"`
+	// Number is given on issuing, the drafts have none
+	Number     string `json:"number,omitempty"`
+	Status     string `json:"status"`
+	ClientID   int    `json:"client_id"`
+	ClientName string `json:"client_name"`
+	Currency   string `json:"currency"`
+	// PeriodFrom and PeriodTo are the first and the last day invoiced
+	PeriodFrom string        `json:"period_from"`
+	PeriodTo   string        `json:"period_to"`
+	Lines      []InvoiceLine `json:"lines,omitempty"`
+	Subtotal   float64       `json:"subtotal"`
+	Discount   float64       `json:"discount"`
+	Tax        float64       `json:"tax"`
+	Total      float64       `json:"total"`
+	InvoiceTerms
+	IssuedAt  *time.Time `json:"issued_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// total sets the invoice subtotal, the discount, the tax and the total from its lines and terms
+func (inv *Invoice) total() {
+	subtotal := 0.0
+	for _, l := range inv.Lines {
+		subtotal += l.Amount
+	}
+	inv.Subtotal = roundMoney(subtotal)
+	inv.Discount = roundMoney(inv.Subtotal * inv.DiscountPercent / 100)
+	inv.Tax = roundMoney((inv.Subtotal - inv.Discount) * inv.TaxPercent / 100)
+	inv.Total = roundMoney(inv.Subtotal - inv.Discount + inv.Tax)
+}
+
+// InvoiceInput - the client and the period to invoice
+type InvoiceInput struct {
+	ClientID int `json:"client_id"`
+	// PeriodFrom and PeriodTo are the first and the last day invoiced (2006-01-02)
+	PeriodFrom string `json:"period_from"`
+	PeriodTo   string `json:"period_to"`
+	// GroupBy is project (the default) or task
+	GroupBy string `json:"group_by,omitempty"`
+	InvoiceTerms
+}
+
+// Validate checks the input
+func (in *InvoiceInput) Validate() error {
+	in.PeriodFrom, in.PeriodTo = strings.TrimSpace(in.PeriodFrom), strings.TrimSpace(in.PeriodTo)
+	ve := &ValidationError{}
+	if in.ClientID <= 0 {
+		ve.add("client_id", "this field is required")
+	}
+	from, fromErr := time.Parse(dateLayout, in.PeriodFrom)
+	if fromErr != nil {
+		ve.add("period_from", "expected a date (YYYY-MM-DD)")
+	}
+	to, toErr := time.Parse(dateLayout, in.PeriodTo)
+	if toErr != nil {
+		ve.add("period_to", "expected a date (YYYY-MM-DD)")
+	}
+	if fromErr == nil && toErr == nil && to.Before(from) {
+		ve.add("period_to", "can't be before period_from")
+	}
+	if in.GroupBy == "" {
+		in.GroupBy = InvoiceByProject
+	}
+	if in.GroupBy != InvoiceByProject && in.GroupBy != InvoiceByTask {
+		ve.add("group_by", fmt.Sprintf("expected %s or %s", InvoiceByProject, InvoiceByTask))
+	}
+	in.InvoiceTerms.validate(ve)
+	return ve.errOrNil()
+}
+
+// filter returns the entry filter of the (valid) input period
+func (in InvoiceInput) filter() EntryFilter {
+	from, _ := time.Parse(dateLayout, in.PeriodFrom)
+	to, _ := time.Parse(dateLayout, in.PeriodTo)
+	return EntryFilter{From: from, To: to.AddDate(0, 0, 1)}
+}
+
+// invoiceRow - an invoice table row, user_id is the user who invoices, the client name is kept as it was invoiced
+type invoiceRow struct {
+	ID              int     `json:"id,omitempty"`
+	UserID          int     `json:"user_id"`
+	ClientID        int     `json:"client_id"`
+	ClientName      string  `json:"client_name"`
+	Number          *string `json:"number"`
+	Status          string  `json:"status"`
+	PeriodFrom      string  `json:"period_from"`
+	PeriodTo        string  `json:"period_to"`
+	Currency        string  `json:"currency"`
+	Subtotal        float64 `json:"subtotal"`
+	DiscountPercent float64 `json:"discount_percent"`
+	Discount        float64 `json:"discount"`
+	TaxPercent      float64 `json:"tax_percent"`
+	Tax             float64 `json:"tax"`
+	Total           float64 `json:"total"`
+	DueDate         *string `json:"due_date"`
+	Notes           string  `json:"notes"`
+	IssuedAt        *string `json:"issued_at"`
+	Timestamp       string  `json:"timestamp,omitempty"`
+}
+
+// sdbDate returns the date part of the SlashDB date, it may be returned as a datetime
+func sdbDate(s string) string {
+	return s[:min(len(s), len(dateLayout))]
+}
+
+func (r invoiceRow) invoice() Invoice {
+	inv := Invoice{
+		ID:         r.ID,
+		Status:     r.Status,
+		ClientID:   r.ClientID,
+		ClientName: r.ClientName,
+		Currency:   r.Currency,
+		PeriodFrom: sdbDate(r.PeriodFrom),
+		PeriodTo:   sdbDate(r.PeriodTo),
+		Subtotal:   r.Subtotal,
+		Discount:   r.Discount,
+		Tax:        r.Tax,
+		Total:      r.Total,
+		InvoiceTerms: InvoiceTerms{
+			DiscountPercent: r.DiscountPercent,
+			TaxPercent:      r.TaxPercent,
+			Notes:           r.Notes,
+		},
+		CreatedAt: parseSdbTime(r.Timestamp),
+	}
+	if r.Number != nil {
+		inv.Number = *r.Number
+	}
+	if r.DueDate != nil {
+		inv.DueDate = sdbDate(*r.DueDate)
+	}
+	if r.IssuedAt != nil {
+		t := parseSdbTime(*r.IssuedAt)
+		inv.IssuedAt = &t
+	}
+	return inv
+}
+
+// termsPayload returns the update of the invoice terms and the amounts depending on them
+func (inv Invoice) termsPayload() map[string]interface{} {
+	payload := map[string]interface{}{
+		"discount_percent": inv.DiscountPercent,
+		"discount":         inv.Discount,
+		"tax_percent":      inv.TaxPercent,
+		"tax":              inv.Tax,
+		"total":            inv.Total,
+		"due_date":         nil,
+		"notes":            inv.Notes,
+	}
+	if inv.DueDate != "" {
+		payload["due_date"] = inv.DueDate
+	}
+	return payload
+}
+
+// invoiceLineRow - an invoice_line table row
+type invoiceLineRow struct {
+	ID          int     `json:"id,omitempty"`
+	InvoiceID   int     `json:"invoice_id"`
+	ProjectID   int     `json:"project_id"`
+	Description string  `json:"description"`
+	Hours       float64 `json:"hours"`
+	HourlyRate  float64 `json:"hourly_rate"`
+	Amount      float64 `json:"amount"`
+}
+
+// invoiceRows returns the invoice rows of the filter, ErrNotFound is no invoices
+func (s *Service) invoiceRows(ctx context.Context, part slashdb.Part) ([]invoiceRow, error) {
+	rows := []invoiceRow{}
+	err := s.get(ctx, s.request(part), &rows)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ListInvoices returns the user invoices, of one of the clients with the clientID, newest first and without their lines
+func (s *Service) ListInvoices(ctx context.Context, userID, clientID int) ([]Invoice, error) {
+	pairs := []string{"user_id", strconv.Itoa(userID)}
+	if clientID != 0 {
+		pairs = append(pairs, "client_id", strconv.Itoa(clientID))
+	}
+	rows, err := s.invoiceRows(ctx, filter("invoice", pairs...))
+	if err != nil {
+		return nil, err
+	}
+	invoices := make([]Invoice, len(rows))
+	for i, r := range rows {
+		invoices[i] = r.invoice()
+	}
+	sort.SliceStable(invoices, func(i, j int) bool { return invoices[i].ID > invoices[j].ID })
+	return invoices, nil
+}
+
+// Invoice returns one of the user invoices, along with its lines
+func (s *Service) Invoice(ctx context.Context, userID, invoiceID int) (Invoice, error) {
+	id := strconv.Itoa(invoiceID)
+	rows, err := s.invoiceRows(ctx, filter("invoice", "id", id, "user_id", strconv.Itoa(userID)))
+	if err != nil {
+		return Invoice{}, err
+	}
+	if len(rows) != 1 {
+		return Invoice{}, ErrNotFound
+	}
+	inv := rows[0].invoice()
+
+	lines := []invoiceLineRow{}
+	err = s.get(ctx, s.request(filter("invoice_line", "invoice_id", id)), &lines)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return Invoice{}, err
+	}
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].ID < lines[j].ID })
+	inv.Lines = make([]InvoiceLine, len(lines))
+	for i, l := range lines {
+		inv.Lines[i] = InvoiceLine{
+			ID:          l.ID,
+			ProjectID:   l.ProjectID,
+			Description: l.Description,
+			Hours:       l.Hours,
+			HourlyRate:  l.HourlyRate,
+			Amount:      l.Amount,
+		}
+	}
+	return inv, nil
+}
+
+// invoiceLines groups the uninvoiced entries of the client projects into the invoice lines, the oldest first by project,
+// it returns them along with the entries billed. The entries with no rate fail it, so none are left out unnoticed
+func invoiceLines(b *Billing, clientID int, entries []Entry, groupBy string) ([]InvoiceLine, []Entry, error) {
+	type lineKey struct {
+		projectID int
+		rate      float64
+		task      string
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Date.Before(entries[j].Date) })
+	lines, index := []InvoiceLine{}, map[lineKey]int{}
+	billed, unrated := []Entry{}, map[int]int{}
+	for _, e := range entries {
+		p, ok := b.projects[e.ProjectID]
+		if !ok || p.ClientID != clientID || e.InvoiceID != 0 {
+			continue
+		}
+		rate, _, ok := b.Rate(e.ProjectID, e.Date)
+		if !ok {
+			unrated[e.ProjectID]++
+			continue
+		}
+		key, description := lineKey{projectID: e.ProjectID, rate: rate}, p.Name
+		if groupBy == InvoiceByTask {
+			if key.task = strings.TrimSpace(e.Accomplishments); key.task != "" {
+				description += ": " + key.task
+			}
+		}
+		i, ok := index[key]
+		if !ok {
+			i, index[key] = len(lines), len(lines)
+			lines = append(lines, InvoiceLine{ProjectID: e.ProjectID, Description: description, HourlyRate: rate})
+		}
+		lines[i].Hours += e.Duration
+		billed = append(billed, e)
+	}
+
+	if len(unrated) > 0 {
+		ve := &ValidationError{}
+		ids := make([]int, 0, len(unrated))
+		for id := range unrated {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		for _, id := range ids {
+			ve.add("period", fmt.Sprintf("%d entries of %s have no hourly rate", unrated[id], b.projects[id].Name))
+		}
+		return nil, nil, ve
+	}
+	if len(lines) == 0 {
+		return nil, nil, &ValidationError{Fields: map[string][]string{
+			"period": {"nothing to invoice, the client projects have no uninvoiced entries in the period"},
+		}}
+	}
+	for i := range lines {
+		lines[i].Hours = roundHours(lines[i].Hours)
+		lines[i].Amount = roundMoney(lines[i].Hours * lines[i].HourlyRate)
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return strings.ToLower(b.projects[lines[i].ProjectID].Name) < strings.ToLower(b.projects[lines[j].ProjectID].Name)
+	})
+	return lines, billed, nil
+}
+
+// CreateInvoice creates a draft invoice of the user uninvoiced entries of the client projects over the period,
+// priced with the rates effective on the entry dates. The entries are marked as invoiced
+func (s *Service) CreateInvoice(ctx context.Context, userID int, in InvoiceInput) (Invoice, error) {
+	if err := in.Validate(); err != nil {
+		return Invoice{}, err
+	}
+	c, err := s.Client(ctx, userID, in.ClientID)
+	if errors.Is(err, ErrNotFound) {
+		return Invoice{}, &ValidationError{Fields: map[string][]string{"client_id": {"no such client"}}}
+	} else if err != nil {
+		return Invoice{}, err
+	}
+	b, err := s.Billing(ctx, userID)
+	if err != nil {
+		return Invoice{}, err
+	}
+	entries, err := s.entries(ctx, userID, in.filter())
+	if err != nil {
+		return Invoice{}, err
+	}
+	lines, billed, err := invoiceLines(b, c.ID, entries, in.GroupBy)
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	inv := Invoice{
+		Status:       InvoiceDraft,
+		ClientID:     c.ID,
+		ClientName:   c.Name,
+		Currency:     c.Currency,
+		PeriodFrom:   in.PeriodFrom,
+		PeriodTo:     in.PeriodTo,
+		Lines:        lines,
+		InvoiceTerms: in.InvoiceTerms,
+	}
+	inv.total()
+	row := invoiceRow{
+		UserID:          userID,
+		ClientID:        inv.ClientID,
+		ClientName:      inv.ClientName,
+		Status:          inv.Status,
+		PeriodFrom:      inv.PeriodFrom,
+		PeriodTo:        inv.PeriodTo,
+		Currency:        inv.Currency,
+		Subtotal:        inv.Subtotal,
+		DiscountPercent: inv.DiscountPercent,
+		Discount:        inv.Discount,
+		TaxPercent:      inv.TaxPercent,
+		Tax:             inv.Tax,
+		Total:           inv.Total,
+		Notes:           inv.Notes,
+	}
+	if inv.DueDate != "" {
+		row.DueDate = &inv.DueDate
+	}
+	id, err := s.create(ctx, s.request(slashdb.Part{Name: "invoice"}), row)
+	if err != nil {
+		return Invoice{}, err
+	}
+	if inv.ID, err = strconv.Atoi(id); err != nil {
+		return Invoice{}, fmt.Errorf("%w: unexpected invoice ID %q", ErrUpstream, id)
+	}
+
+	lineRows := make([]invoiceLineRow, len(lines))
+	for i, l := range lines {
+		lineRows[i] = invoiceLineRow{
+			InvoiceID:   inv.ID,
+			ProjectID:   l.ProjectID,
+			Description: l.Description,
+			Hours:       l.Hours,
+			HourlyRate:  l.HourlyRate,
+			Amount:      l.Amount,
+		}
+	}
+	if _, err = s.create(ctx, s.request(slashdb.Part{Name: "invoice_line"}), lineRows); err == nil {
+		err = s.markInvoiced(ctx, inv.ID, billed)
+	}
+	if err != nil {
+		// the request may be gone, the half made invoice is removed either way
+		return Invoice{}, errors.Join(err, s.discardInvoice(context.WithoutCancel(ctx), inv.ID))
+	}
+	return s.Invoice(ctx, userID, inv.ID)
+}
+
+// markInvoiced links the entries to the invoice, each only while it's still uninvoiced, it fails with a conflict
+// if any of them got invoiced meanwhile (by an invoice created at the same time, the one marking it first has it)
+func (s *Service) markInvoiced(ctx context.Context, invoiceID int, entries []Entry) error {
+	payload := map[string]interface{}{"invoice_id": invoiceID}
+	for _, e := range entries {
+		req := s.request(filter(
+			"timesheet",
+			"user_id", strconv.Itoa(e.UserID), "project_id", strconv.Itoa(e.ProjectID), "date", formatSdbTime(e.Date),
+			"invoice_id", sdbNull,
+		))
+		err := s.update(ctx, req, payload)
+		if errors.Is(err, ErrNotFound) {
+			return conflictError("some of the entries got invoiced or deleted meanwhile, try again")
+		}
+		if err != nil {
+			return fmt.Errorf("error marking the entries invoiced: %w", err)
+		}
+	}
+	return nil
+}
+
+// discardInvoice releases the entries of the invoice and deletes it along with its lines
+func (s *Service) discardInvoice(ctx context.Context, invoiceID int) error {
+	id := strconv.Itoa(invoiceID)
+	release := map[string]interface{}{"invoice_id": nil}
+	if err := s.update(ctx, s.request(filter("timesheet", "invoice_id", id)), release); err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("error releasing the invoiced entries: %w", err)
+	}
+	if err := s.delete(ctx, s.request(filter("invoice_line", "invoice_id", id))); err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	return s.delete(ctx, s.request(filter("invoice", "id", id)))
+}
+
+// UpdateInvoice changes the terms of one of the user draft invoices, the amounts are recalculated
+func (s *Service) UpdateInvoice(ctx context.Context, userID, invoiceID int, t InvoiceTerms) (Invoice, error) {
+	if err := t.Validate(); err != nil {
+		return Invoice{}, err
+	}
+	inv, err := s.Invoice(ctx, userID, invoiceID)
+	if err != nil {
+		return Invoice{}, err
+	}
+	if inv.Status != InvoiceDraft {
+		return Invoice{}, ErrInvoiceIssued
+	}
+	inv.InvoiceTerms = t
+	inv.total()
+	if err := s.update(ctx, s.request(filter("invoice", "id", strconv.Itoa(invoiceID))), inv.termsPayload()); err != nil {
+		return Invoice{}, err
+	}
+	return s.Invoice(ctx, userID, invoiceID)
+}
+
+// DeleteInvoice deletes one of the user draft invoices, its entries can be changed and invoiced again
+func (s *Service) DeleteInvoice(ctx context.Context, userID, invoiceID int) error {
+	inv, err := s.Invoice(ctx, userID, invoiceID)
+	if err != nil {
+		return err
+	}
+	if inv.Status != InvoiceDraft {
+		return ErrInvoiceIssued
+	}
+	return s.discardInvoice(ctx, invoiceID)
+}
+
+// nextInvoiceNumber returns the number following the user last one of the year, the numbers are
+// the prefix, the year and the sequence number in it, i.e. INV-2026-0001, the numbers are read past the cache,
+// a cached list would give the same, already taken, number on every retry
+func (s *Service) nextInvoiceNumber(ctx context.Context, userID, year int) (string, error) {
+	prefix := s.cfg.InvoicePrefix
+	if prefix == "" {
+		prefix = defaultInvoicePrefix
+	}
+	prefix += strconv.Itoa(year) + "-"
+	rows, err := s.invoiceRows(WithoutCache(ctx), filter("invoice", "user_id", strconv.Itoa(userID)))
+	if err != nil {
+		return "", err
+	}
+	last := 0
+	for _, r := range rows {
+		if r.Number == nil || !strings.HasPrefix(*r.Number, prefix) {
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimPrefix(*r.Number, prefix)); err == nil && n > last {
+			last = n
+		}
+	}
+	return fmt.Sprintf("%s%04d", prefix, last+1), nil
+}
+
+// IssueInvoice issues one of the user draft invoices, it gets the next number, after that it can't be changed
+func (s *Service) IssueInvoice(ctx context.Context, userID, invoiceID int) (Invoice, error) {
+	inv, err := s.Invoice(ctx, userID, invoiceID)
+	if err != nil {
+		return Invoice{}, err
+	}
+	if inv.Status != InvoiceDraft {
+		return Invoice{}, ErrInvoiceIssued
+	}
+	now := s.now().UTC()
+	for attempt := 1; ; attempt++ {
+		number, err := s.nextInvoiceNumber(ctx, userID, now.Year())
+		if err != nil {
+			return Invoice{}, err
+		}
+		payload := map[string]interface{}{"status": InvoiceIssued, "number": number, "issued_at": formatSdbTime(now)}
+		err = s.update(ctx, s.request(filter("invoice", "id", strconv.Itoa(invoiceID))), payload)
+		// the numbers are unique per user, another invoice may have taken it meanwhile
+		if errors.Is(err, ErrConflict) && attempt < issueAttempts {
+			continue
+		}
+		if err != nil {
+			return Invoice{}, err
+		}
+		return s.Invoice(ctx, userID, invoiceID)
+	}
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestInvoiceTotal(t *testing.T) {
+	lines := []InvoiceLine{{Amount: 100.10}, {Amount: 33.33}}
+	tests := []struct {
+		name  string
+		terms InvoiceTerms
+		// want is the subtotal, the discount, the tax and the total
+		want [4]float64
+	}{
+		{name: "no terms", want: [4]float64{133.43, 0, 0, 133.43}},
+		{name: "discount", terms: InvoiceTerms{DiscountPercent: 10}, want: [4]float64{133.43, 13.34, 0, 120.09}},
+		{name: "tax", terms: InvoiceTerms{TaxPercent: 23}, want: [4]float64{133.43, 0, 30.69, 164.12}},
+		{name: "tax after the discount", terms: InvoiceTerms{DiscountPercent: 10, TaxPercent: 23}, want: [4]float64{133.43, 13.34, 27.62, 147.71}},
+		{name: "everything off", terms: InvoiceTerms{DiscountPercent: 100, TaxPercent: 23}, want: [4]float64{133.43, 133.43, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := Invoice{Lines: lines, InvoiceTerms: tt.terms}
+			inv.total()
+			if got := [4]float64{inv.Subtotal, inv.Discount, inv.Tax, inv.Total}; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testBilling returns the billing of three projects of the client 1, the first one priced higher from 2026-03-05,
+// the last one with no project rate, and a project of the client 2
+func testBilling() *Billing {
+	return &Billing{
+		userID: 1,
+		projects: map[int]Project{
+			1: {ID: 1, Name: "web", ClientID: 1},
+			2: {ID: 2, Name: "App", ClientID: 1},
+			3: {ID: 3, Name: "Unrated", ClientID: 1},
+			4: {ID: 4, Name: "Other", ClientID: 2},
+		},
+		clients: map[int]Client{1: {ID: 1, Currency: "EUR"}, 2: {ID: 2, Currency: "USD"}},
+		projectRates: map[int][]rateRow{
+			1: {{HourlyRate: 60, EffectiveFrom: "2026-03-05"}, {HourlyRate: 50, EffectiveFrom: "2026-01-01"}},
+			4: {{HourlyRate: 70, EffectiveFrom: "2026-01-01"}},
+		},
+		clientRates: map[int][]rateRow{},
+	}
+}
+
+func TestInvoiceLines(t *testing.T) {
+	at := func(day int) time.Time { return time.Date(2026, 3, day, 9, 0, 0, 0, time.UTC) }
+	entries := []Entry{
+		{ProjectID: 1, Date: at(6), Duration: 1, Accomplishments: "Review"},
+		{ProjectID: 1, Date: at(2), Duration: 1.5, Accomplishments: "Planning"},
+		{ProjectID: 1, Date: at(3), Duration: 0.25, Accomplishments: " Planning "},
+		{ProjectID: 1, Date: at(4), Duration: 2, Accomplishments: "Review", InvoiceID: 9},
+		{ProjectID: 2, Date: at(2), Duration: 1, Accomplishments: ""},
+		{ProjectID: 4, Date: at(2), Duration: 3, Accomplishments: "Other"},
+	}
+	b := testBilling()
+	b.clientRates[1] = []rateRow{{HourlyRate: 40.5, EffectiveFrom: "2026-01-01"}}
+
+	tests := []struct {
+		name    string
+		groupBy string
+		// want lists the lines as "<project> <description> <hours> x <rate> = <amount>"
+		want []string
+	}{
+		{
+			name:    "by project",
+			groupBy: InvoiceByProject,
+			want: []string{
+				"2 App 1 x 40.5 = 40.5",
+				"1 web 1.75 x 50 = 87.5",
+				"1 web 1 x 60 = 60",
+			},
+		},
+		{
+			name:    "by task",
+			groupBy: InvoiceByTask,
+			want: []string{
+				"2 App 1 x 40.5 = 40.5",
+				"1 web: Planning 1.75 x 50 = 87.5",
+				"1 web: Review 1 x 60 = 60",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, billed, err := invoiceLines(b, 1, append([]Entry(nil), entries...), tt.groupBy)
+			if err != nil {
+				t.Fatalf("invoiceLines: %v", err)
+			}
+			got := []string{}
+			for _, l := range lines {
+				got = append(got, fmt.Sprintf("%d %s %v x %v = %v", l.ProjectID, l.Description, l.Hours, l.HourlyRate, l.Amount))
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("got lines %q, want %q", got, tt.want)
+			}
+			if len(billed) != 4 {
+				t.Errorf("got %d entries billed, want 4", len(billed))
+			}
+		})
+	}
+
+	errTests := []struct {
+		name    string
+		entries []Entry
+		want    string
+	}{
+		{name: "no rate", entries: append(entries, Entry{ProjectID: 3, Date: time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC), Duration: 1}), want: "1 entries of Unrated have no hourly rate"},
+		{name: "nothing to invoice", entries: entries[3:4], want: "nothing to invoice"},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := invoiceLines(b, 1, tt.entries, InvoiceByProject)
+			var ve *ValidationError
+			if !errors.As(err, &ve) || !strings.Contains(strings.Join(ve.Fields["period"], ""), tt.want) {
+				t.Errorf("got error %v, want a period validation error %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateInvoiceConcurrently(t *testing.T) {
+	s, fake := newTestService(t, Config{})
+	fake.Insert("client", sdbtest.Row{"id": 1, "user_id": 1, "name": "Client", "currency": "EUR", "timestamp": "2026-01-01T00:00:00"})
+	fake.Insert("project", sdbtest.Row{"id": 2, "name": "Billed", "description": "", "client_id": 1, "timestamp": "2026-01-01T00:00:00"})
+	fake.Insert("rate", sdbtest.Row{"id": 1, "client_id": 1, "project_id": nil, "user_id": nil, "hourly_rate": 50, "effective_from": "2026-01-01"})
+	fake.Insert("timesheet",
+		sdbtest.Row{"user_id": 1, "project_id": 2, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": "", "invoice_id": nil},
+		entryRow(1, 2, "2026-03-02", 1.5), entryRow(1, 2, "2026-03-03", 2), entryRow(1, 2, "2026-03-04", 1),
+	)
+	in := InvoiceInput{ClientID: 1, PeriodFrom: "2026-03-01", PeriodTo: "2026-03-31"}
+
+	// the other invoice is created once the first one is about to mark its entries
+	created := false
+	var other Invoice
+	var otherErr error
+	fake.Before = func(r *http.Request) {
+		if r.Method == http.MethodPut && strings.Contains(r.URL.Path, "/timesheet/") && !created {
+			created = true
+			other, otherErr = s.CreateInvoice(context.Background(), 1, in)
+		}
+	}
+	_, err := s.CreateInvoice(context.Background(), 1, in)
+	if otherErr != nil {
+		t.Fatalf("the other invoice failed: %v", otherErr)
+	}
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("got error %v, want %v", err, ErrConflict)
+	}
+	if other.Total != 225 {
+		t.Errorf("got the other invoice total %v, want 225", other.Total)
+	}
+	if got := count(fake, "timesheet", "invoice_id", other.ID); got != 3 {
+		t.Errorf("got %d entries on the other invoice, want 3", got)
+	}
+	if got, want := len(fake.Rows("invoice")), 1; got != want {
+		t.Errorf("got %d invoices, want %d", got, want)
+	}
+	if got, want := len(fake.Rows("invoice_line")), count(fake, "invoice_line", "invoice_id", other.ID); got != want {
+		t.Errorf("got %d invoice lines, want the %d of the other invoice", got, want)
+	}
+}
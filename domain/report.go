@@ -0,0 +1,410 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+	"gitlab.com/boromil/goslashdb/types"
+)
+
+// report dimensions, GroupByProject and GroupByDay are the other two
+const (
+	GroupByUser  = "user"
+	GroupByWeek  = "week"
+	GroupByMonth = "month"
+)
+
+// maxReportLevels is how deep the report subtotals can go
+const maxReportLevels = 3
+
+// report data sources
+const (
+	ReportSourceQuery   = "query"
+	ReportSourceEntries = "entries"
+)
+
+// ReportGroup - the totals of a group of entries and, one level down, its subtotals
+type ReportGroup struct {
+	// Key is the user or the project ID, the day (2006-01-02), the ISO week (2006-W01) or the month (2006-01)
+	Key string `json:"key"`
+	// Label is the user or the project name
+	Label   string  `json:"label,omitempty"`
+	Entries int     `json:"entries"`
+	Hours   float64 `json:"hours"`
+	// Amounts are the billable amounts of the entries with the rates, by the currency
+	Amounts Amounts       `json:"amounts,omitempty"`
+	Groups  []ReportGroup `json:"groups,omitempty"`
+}
+
+// Report - the entry totals, grouped by up to three dimensions
+type Report struct {
+	GroupBy []string      `json:"group_by"`
+	From    *time.Time    `json:"from,omitempty"`
+	To      *time.Time    `json:"to,omitempty"`
+	Groups  []ReportGroup `json:"groups"`
+	Total   ReportGroup   `json:"total"`
+	// Source tells if the report was aggregated by SlashDB (query) or from the entries
+	Source string `json:"source"`
+}
+
+// reportRow - the entry totals of a project over a period, both the report queries and the fallback produce them
+type reportRow struct {
+	ProjectID   int     `json:"project_id"`
+	ProjectName string  `json:"project_name"`
+	Period      string  `json:"period"`
+	Hours       float64 `json:"hours"`
+	Entries     int     `json:"entries"`
+	// Amounts are priced by the app, the queries don't know the rates
+	Amounts Amounts `json:"-"`
+}
+
+// reportPeriods are the SQL expressions of the period starts, by the granularity
+var reportPeriods = map[string]string{
+	GroupByDay:   "DATE(t.date)",
+	GroupByWeek:  "DATE(DATE_SUB(t.date, INTERVAL WEEKDAY(t.date) DAY))",
+	GroupByMonth: "DATE_FORMAT(t.date, '%Y-%m-01')",
+}
+
+// reportQueryID returns the ID of the custom query aggregating by the period
+func reportQueryID(period string) string {
+	return "timesheet-report-" + period
+}
+
+// ReportQueries returns the SlashDB custom query definitions the reports are built on (MySQL dialect)
+func ReportQueries(dbName string) []types.QueryConfig {
+	qcs := []types.QueryConfig{}
+	for _, period := range []string{GroupByDay, GroupByWeek, GroupByMonth} {
+		qcs = append(qcs, types.QueryConfig{
+			ID:         reportQueryID(period),
+			DatabaseID: dbName,
+			Desc:       "timesheet hours per project and " + period + ", used by the app reports",
+			SQLStr: "SELECT t.project_id, p.name AS project_name, " + reportPeriods[period] + " AS period, " +
+				"SUM(t.duration) AS hours, COUNT(*) AS entries " +
+				"FROM timesheet t JOIN project p ON p.id = t.project_id " +
+				"WHERE t.user_id = :user_id AND t.date >= :from AND t.date < :to AND t.duration > 0 " +
+				"GROUP BY t.project_id, p.name, " + reportPeriods[period],
+			HTTPMethods: map[string]bool{"GET": true},
+		})
+	}
+	return qcs
+}
+
+// EnsureReportQueries creates the missing report queries, the reports can use them once it succeeds
+func EnsureReportQueries(ctx context.Context, qcm slashdb.QueryConfigManager, dbName string) error {
+	existing, err := qcm.QueryConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, qc := range ReportQueries(dbName) {
+		if _, ok := existing[qc.ID]; ok {
+			continue
+		}
+		if err := qcm.CreateQueryConfig(ctx, qc); err != nil {
+			return fmt.Errorf("error creating the %s query: %w", qc.ID, err)
+		}
+	}
+	return nil
+}
+
+// ParseGroupBy parses the comma separated report dimensions
+func ParseGroupBy(s string) ([]string, error) {
+	if s == "" {
+		return []string{GroupByProject}, nil
+	}
+	dims := strings.Split(s, ",")
+	if len(dims) > maxReportLevels {
+		return nil, fmt.Errorf("can group by at most %d dimensions", maxReportLevels)
+	}
+	seen := map[string]bool{}
+	for _, d := range dims {
+		switch d {
+		case GroupByUser, GroupByProject, GroupByDay, GroupByWeek, GroupByMonth:
+		default:
+			return nil, fmt.Errorf("unknown dimension %q, expected user, project, day, week or month", d)
+		}
+		if seen[d] {
+			return nil, fmt.Errorf("dimension %q repeated", d)
+		}
+		seen[d] = true
+	}
+	return dims, nil
+}
+
+// reportGranularity returns the period the rows have to be aggregated by, to be able to group them by the dims
+func reportGranularity(dims []string) string {
+	periods := []string{}
+	for _, d := range dims {
+		if _, ok := reportPeriods[d]; ok {
+			periods = append(periods, d)
+		}
+	}
+	switch len(periods) {
+	case 0:
+		// the coarsest one, the least rows
+		return GroupByMonth
+	case 1:
+		return periods[0]
+	}
+	// the weeks don't fit in the months
+	return GroupByDay
+}
+
+// periodStart returns the start of the period t is in
+func periodStart(t time.Time, period string) time.Time {
+	t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case GroupByWeek:
+		return t.AddDate(0, 0, -(int(t.Weekday())+6)%7)
+	case GroupByMonth:
+		return t.AddDate(0, 0, 1-t.Day())
+	}
+	return t
+}
+
+// periodKey returns the key of the period (day, week or month) t is in
+func periodKey(t time.Time, period string) string {
+	switch period {
+	case GroupByWeek:
+		y, w := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", y, w)
+	case GroupByMonth:
+		return t.Format("2006-01")
+	}
+	return t.Format("2006-01-02")
+}
+
+// queryReportRows aggregates the rows with the SlashDB report query
+func (s *Service) queryReportRows(ctx context.Context, userID int, f EntryFilter, period string) ([]reportRow, error) {
+	from, to := f.From, f.To
+	if from.IsZero() {
+		from = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if to.IsZero() {
+		to = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	}
+	req := slashdb.NewQueryRequest("")
+	req.AddParts(filter(
+		reportQueryID(period),
+		"user_id", strconv.Itoa(userID), "from", formatSdbTime(from), "to", formatSdbTime(to),
+	))
+
+	rows := []reportRow{}
+	err := s.get(ctx, req, &rows)
+	if errors.Is(err, ErrNotFound) {
+		// no rows in the range
+		return []reportRow{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if f.ProjectID == 0 {
+		return rows, nil
+	}
+	filtered := rows[:0]
+	for _, r := range rows {
+		if r.ProjectID == f.ProjectID {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+// entryReportRows aggregates the rows from the user entries, with their amounts if there's the billing
+func (s *Service) entryReportRows(
+	ctx context.Context, userID int, f EntryFilter, period string, b *Billing,
+) ([]reportRow, error) {
+	entries, err := s.entries(ctx, userID, f)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.projects(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	type rowKey struct {
+		projectID int
+		period    string
+	}
+	byKey := map[rowKey]*reportRow{}
+	rows := []*reportRow{}
+	for _, e := range entries {
+		k := rowKey{e.ProjectID, periodStart(e.Date, period).Format("2006-01-02")}
+		r, ok := byKey[k]
+		if !ok {
+			r = &reportRow{ProjectID: e.ProjectID, ProjectName: projects[e.ProjectID].Name, Period: k.period}
+			byKey[k] = r
+			rows = append(rows, r)
+		}
+		r.Hours += e.Duration
+		r.Entries++
+		if b == nil {
+			continue
+		}
+		if amount, currency, ok := b.Amount(e); ok {
+			if r.Amounts == nil {
+				r.Amounts = Amounts{}
+			}
+			r.Amounts.add(currency, amount)
+		}
+	}
+
+	out := make([]reportRow, len(rows))
+	for i, r := range rows {
+		out[i] = *r
+	}
+	return out, nil
+}
+
+// priceReportRows sets the amounts of the query rows, they're priced from the entries, as the rates
+// change on their effective dates
+func (s *Service) priceReportRows(
+	ctx context.Context, userID int, f EntryFilter, period string, b *Billing, rows []reportRow,
+) error {
+	priced, err := s.entryReportRows(ctx, userID, f, period, b)
+	if err != nil {
+		return err
+	}
+	type rowKey struct {
+		projectID int
+		period    string
+	}
+	amounts := map[rowKey]Amounts{}
+	for _, r := range priced {
+		amounts[rowKey{r.ProjectID, r.Period}] = r.Amounts
+	}
+	for i, r := range rows {
+		// SlashDB may return the period as a datetime
+		rows[i].Amounts = amounts[rowKey{r.ProjectID, r.Period[:min(len(r.Period), 10)]}]
+	}
+	return nil
+}
+
+// addAmounts adds the amounts to the group ones
+func (g *ReportGroup) addAmounts(a Amounts) {
+	for currency, amount := range a {
+		if g.Amounts == nil {
+			g.Amounts = Amounts{}
+		}
+		g.Amounts.add(currency, amount)
+	}
+}
+
+// Report returns the user entry totals, grouped by the dims (see ParseGroupBy), with the subtotals
+// of each next dimension nested in the groups of the previous one and, with amounts, the billable amounts
+// of the entries with the rates
+func (s *Service) Report(ctx context.Context, userID int, f EntryFilter, dims []string, amounts bool) (Report, error) {
+	period := reportGranularity(dims)
+	report := Report{GroupBy: dims, Source: ReportSourceEntries}
+	if !f.From.IsZero() {
+		report.From = &f.From
+	}
+	if !f.To.IsZero() {
+		report.To = &f.To
+	}
+
+	var b *Billing
+	if amounts {
+		var err error
+		if b, err = s.Billing(ctx, userID); err != nil {
+			return Report{}, err
+		}
+		if len(b.Currencies()) == 0 {
+			// nothing to price
+			b = nil
+		}
+	}
+
+	var rows []reportRow
+	var err error
+	if s.cfg.ReportQueries {
+		rows, err = s.queryReportRows(ctx, userID, f, period)
+		if err == nil {
+			report.Source = ReportSourceQuery
+			if b != nil {
+				err = s.priceReportRows(ctx, userID, f, period, b, rows)
+			}
+		} else if ctx.Err() == nil {
+			// the query may be gone or not permitted anymore, the entries still add up
+			rows, err = s.entryReportRows(ctx, userID, f, period, b)
+		}
+	} else {
+		rows, err = s.entryReportRows(ctx, userID, f, period, b)
+	}
+	if err != nil {
+		return Report{}, err
+	}
+
+	userLabel := ""
+	for _, d := range dims {
+		if d == GroupByUser {
+			u, err := s.User(ctx, userID)
+			if err != nil {
+				return Report{}, err
+			}
+			userLabel = u.Username
+		}
+	}
+
+	// keyOf returns the key and the label of the row group of the dim
+	keyOf := func(r reportRow, dim string) (string, string) {
+		switch dim {
+		case GroupByUser:
+			return strconv.Itoa(userID), userLabel
+		case GroupByProject:
+			return strconv.Itoa(r.ProjectID), r.ProjectName
+		}
+		// SlashDB may return the period as a datetime
+		start, _ := time.Parse("2006-01-02", r.Period[:min(len(r.Period), 10)])
+		return periodKey(start, dim), ""
+	}
+
+	var group func(rows []reportRow, dims []string) []ReportGroup
+	group = func(rows []reportRow, dims []string) []ReportGroup {
+		if len(dims) == 0 {
+			return nil
+		}
+		byKey := map[string][]reportRow{}
+		groups := []ReportGroup{}
+		for _, r := range rows {
+			key, label := keyOf(r, dims[0])
+			if _, ok := byKey[key]; !ok {
+				groups = append(groups, ReportGroup{Key: key, Label: label})
+			}
+			byKey[key] = append(byKey[key], r)
+		}
+		for i := range groups {
+			g := &groups[i]
+			for _, r := range byKey[g.Key] {
+				g.Hours += r.Hours
+				g.Entries += r.Entries
+				g.addAmounts(r.Amounts)
+			}
+			g.Hours = roundHours(g.Hours)
+			g.Groups = group(byKey[g.Key], dims[1:])
+		}
+		sort.SliceStable(groups, func(i, j int) bool {
+			if groups[i].Label != groups[j].Label {
+				return strings.ToLower(groups[i].Label) < strings.ToLower(groups[j].Label)
+			}
+			return groups[i].Key < groups[j].Key
+		})
+		return groups
+	}
+
+	report.Groups = group(rows, dims)
+	report.Total.Key = "total"
+	for _, r := range rows {
+		report.Total.Hours += r.Hours
+		report.Total.Entries += r.Entries
+		report.Total.addAmounts(r.Amounts)
+	}
+	report.Total.Hours = roundHours(report.Total.Hours)
+	return report, nil
+}
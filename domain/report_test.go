@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestParseGroupBy(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []string
+		wantErr bool
+	}{
+		{in: "", want: []string{GroupByProject}},
+		{in: "user", want: []string{GroupByUser}},
+		{in: "project,week", want: []string{GroupByProject, GroupByWeek}},
+		{in: "user,project,month", want: []string{GroupByUser, GroupByProject, GroupByMonth}},
+		{in: "user,project,day,week", wantErr: true},
+		{in: "project,project", wantErr: true},
+		{in: "year", wantErr: true},
+		{in: "project,", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseGroupBy(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReportGranularity(t *testing.T) {
+	tests := []struct {
+		dims []string
+		want string
+	}{
+		{dims: []string{GroupByProject}, want: GroupByMonth},
+		{dims: []string{GroupByUser, GroupByProject}, want: GroupByMonth},
+		{dims: []string{GroupByProject, GroupByWeek}, want: GroupByWeek},
+		{dims: []string{GroupByDay}, want: GroupByDay},
+		{dims: []string{GroupByMonth, GroupByWeek}, want: GroupByDay},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.dims, ","), func(t *testing.T) {
+			if got := reportGranularity(tt.dims); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// flattenReport lists the groups as "<keys> <hours>h <entries> <amounts>", the subgroups after their group
+func flattenReport(groups []ReportGroup, prefix string) []string {
+	out := []string{}
+	for _, g := range groups {
+		key := prefix + g.Key
+		out = append(out, fmt.Sprintf("%s %vh %d %v", key, g.Hours, g.Entries, g.Amounts["EUR"]))
+		out = append(out, flattenReport(g.Groups, key+"/")...)
+	}
+	return out
+}
+
+func TestReport(t *testing.T) {
+	weekRows := []reportRow{
+		{ProjectID: 1, ProjectName: "Project", Period: "2026-03-02T00:00:00", Hours: 3, Entries: 2},
+		{ProjectID: 2, ProjectName: "Beta", Period: "2026-02-23T00:00:00", Hours: 1.5, Entries: 1},
+		{ProjectID: 2, ProjectName: "Beta", Period: "2026-03-09T00:00:00", Hours: 0.5, Entries: 1},
+	}
+	// the second project is billed, 100 EUR/h and 120 from March
+	priced := []string{"2 2h 2 210", "2/2026-W09 1.5h 1 150", "2/2026-W11 0.5h 1 60", "1 3h 2 0", "1/2026-W10 3h 2 0"}
+	unpriced := []string{"2 2h 2 0", "2/2026-W09 1.5h 1 0", "2/2026-W11 0.5h 1 0", "1 3h 2 0", "1/2026-W10 3h 2 0"}
+
+	tests := []struct {
+		name    string
+		queries bool
+		// query serves the report queries, they're 404 without it
+		query      http.HandlerFunc
+		amounts    bool
+		wantSource string
+		want       []string
+		wantTotal  string
+		// wantEntries is set when the entries have to be read
+		wantEntries bool
+	}{
+		{
+			name: "entries", amounts: true,
+			wantSource: ReportSourceEntries, want: priced, wantTotal: "total 5h 4 210", wantEntries: true,
+		},
+		{
+			name:       "entries with no amounts",
+			wantSource: ReportSourceEntries, want: unpriced, wantTotal: "total 5h 4 0", wantEntries: true,
+		},
+		{
+			name: "query", queries: true, amounts: true,
+			query: func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(weekRows)
+			},
+			wantSource: ReportSourceQuery, want: priced, wantTotal: "total 5h 4 210", wantEntries: true,
+		},
+		{
+			name: "query with no amounts", queries: true,
+			query: func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(weekRows)
+			},
+			wantSource: ReportSourceQuery, want: unpriced, wantTotal: "total 5h 4 0",
+		},
+		{
+			name: "query failing", queries: true, amounts: true,
+			query: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "not permitted", http.StatusForbidden)
+			},
+			wantSource: ReportSourceEntries, want: priced, wantTotal: "total 5h 4 210", wantEntries: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, fake := newTestService(t, Config{ReportQueries: tt.queries})
+			fake.Other = tt.query
+			fake.Insert("client", sdbtest.Row{"id": 1, "user_id": 1, "name": "Client", "currency": "EUR", "timestamp": "2026-01-01T00:00:00"})
+			fake.Insert("project", sdbtest.Row{"id": 2, "name": "Beta", "description": "", "client_id": 1, "timestamp": "2026-01-01T00:00:00"})
+			fake.Insert("rate",
+				sdbtest.Row{"id": 1, "client_id": nil, "project_id": 2, "user_id": nil, "hourly_rate": 100, "effective_from": "2026-01-01"},
+				sdbtest.Row{"id": 2, "client_id": nil, "project_id": 2, "user_id": nil, "hourly_rate": 120, "effective_from": "2026-03-01"},
+			)
+			fake.Insert("timesheet",
+				entryRow(1, 1, "2026-03-02", 2), entryRow(1, 1, "2026-03-03", 1),
+				entryRow(1, 2, "2026-02-25", 1.5), entryRow(1, 2, "2026-03-09", 0.5),
+				// another user entries don't count
+				entryRow(2, 1, "2026-03-02", 8),
+			)
+
+			report, err := s.Report(context.Background(), 1, EntryFilter{}, []string{GroupByProject, GroupByWeek}, tt.amounts)
+			if err != nil {
+				t.Fatalf("Report: %v", err)
+			}
+			if report.Source != tt.wantSource {
+				t.Errorf("got source %s, want %s", report.Source, tt.wantSource)
+			}
+			if got := flattenReport(report.Groups, ""); strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("got groups %v, want %v", got, tt.want)
+			}
+			if got := flattenReport([]ReportGroup{report.Total}, "")[0]; got != tt.wantTotal {
+				t.Errorf("got total %s, want %s", got, tt.wantTotal)
+			}
+			read := false
+			for _, r := range fake.Requests() {
+				read = read || strings.Contains(r.Path, "/timesheet/")
+			}
+			if read != tt.wantEntries {
+				t.Errorf("got the entries read %v, want %v", read, tt.wantEntries)
+			}
+		})
+	}
+}
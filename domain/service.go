@@ -0,0 +1,828 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+// sdbTimeLayout is the SlashDB datetime format, the times are stored in UTC
+const sdbTimeLayout = "2006-01-02T15:04:05"
+
+// sdbNull is the SlashDB filter value matching the nulls
+const sdbNull = "<null>"
+
+// projectRow - a project table row
+type projectRow struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ClientID    *int   `json:"client_id"`
+	UserID      *int   `json:"user_id"`
+	Timestamp   string `json:"timestamp"`
+}
+
+func (r projectRow) project() Project {
+	return Project{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		ClientID:    deref(r.ClientID),
+		OwnerID:     deref(r.UserID),
+		CreatedAt:   parseSdbTime(r.Timestamp),
+	}
+}
+
+// timesheetRow - a timesheet table row
+type timesheetRow struct {
+	UserID          int     `json:"user_id"`
+	ProjectID       int     `json:"project_id"`
+	Date            string  `json:"date,omitempty"`
+	Duration        float64 `json:"duration"`
+	Accomplishments string  `json:"accomplishments"`
+	InvoiceID       *int    `json:"invoice_id,omitempty"`
+}
+
+// membership reports if the row only links the user to the project, the app adds one
+// (with no duration nor accomplishments) when the user creates the project
+func (r timesheetRow) membership() bool {
+	return r.Duration == 0 && r.Accomplishments == ""
+}
+
+func (r timesheetRow) entry() Entry {
+	date := parseSdbTime(r.Date)
+	return Entry{
+		ID:              EntryID(r.ProjectID, date),
+		UserID:          r.UserID,
+		ProjectID:       r.ProjectID,
+		Date:            date,
+		Duration:        r.Duration,
+		Accomplishments: r.Accomplishments,
+		InvoiceID:       deref(r.InvoiceID),
+	}
+}
+
+func parseSdbTime(s string) time.Time {
+	// SlashDB may add the fractional seconds
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s = s[:i]
+	}
+	t, _ := time.Parse(sdbTimeLayout, s)
+	return t
+}
+
+func formatSdbTime(t time.Time) string {
+	return t.UTC().Format(sdbTimeLayout)
+}
+
+// Config - container for the domain service settings
+type Config struct {
+	// DBName is the SlashDB DB name
+	DBName        string
+	TimerRounding Rounding
+	// ReportQueries makes the reports use the SlashDB custom queries (see EnsureReportQueries)
+	ReportQueries bool
+	// ImportBatchSize is how many rows an import creates per request, ImportConcurrency how many requests
+	// it runs at once, they default to 100 and 4
+	ImportBatchSize,
+	ImportConcurrency int
+	// InvoicePrefix starts the invoice numbers, followed by the year and the number in it, it defaults to INV-
+	InvoicePrefix string
+}
+
+// Service - the timesheet operations, all of them are scoped to a single user
+type Service struct {
+	sdb slashdb.CRUDer
+	cfg Config
+	now func() time.Time
+}
+
+// NewService returns a new domain service, the SlashDB service should use a status doer (NewStatusDoer)
+func NewService(sdb slashdb.CRUDer, cfg Config) *Service {
+	return &Service{sdb: sdb, cfg: cfg, now: time.Now}
+}
+
+type noCacheKey struct{}
+
+// WithoutCache makes the SlashDB reads done with the context skip the read cache (see the transport
+// NewCachedService), i.e. the ones that must see the latest rows, or the export pages, which would only
+// evict the entries worth keeping
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// CacheSkipped reports if the context was made by WithoutCache
+func CacheSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(noCacheKey{}).(bool)
+	return skip
+}
+
+// request returns a new SlashDB data request, parts are table name and filter pairs
+func (s *Service) request(parts ...slashdb.Part) *slashdb.Request {
+	req := slashdb.NewDataRequest("")
+	req.AddParts(append([]slashdb.Part{{Name: s.cfg.DBName}}, parts...)...)
+	return req
+}
+
+// filter returns a part filtering by the given, ordered, column value pairs
+func filter(name string, pairs ...string) slashdb.Part {
+	f := slashdb.Filter{Values: map[string][]string{}}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		f.Values[pairs[i]] = []string{pairs[i+1]}
+		f.Order = append(f.Order, pairs[i])
+	}
+	return slashdb.Part{Name: name, Filter: f}
+}
+
+func (s *Service) get(ctx context.Context, req fmt.Stringer, container interface{}) error {
+	ctx, status := withStatus(ctx)
+	return mapErr(s.sdb.Get(ctx, req, container), *status)
+}
+
+func (s *Service) create(ctx context.Context, req fmt.Stringer, payload interface{}) (string, error) {
+	ctx, status := withStatus(ctx)
+	resp, err := s.sdb.Create(ctx, req, payload)
+	if err != nil {
+		return "", mapErr(err, *status)
+	}
+	return strings.Trim(resp.ID, "\" \n"), nil
+}
+
+func (s *Service) update(ctx context.Context, req fmt.Stringer, payload interface{}) error {
+	ctx, status := withStatus(ctx)
+	return mapErr(s.sdb.Update(ctx, req, payload), *status)
+}
+
+func (s *Service) delete(ctx context.Context, req fmt.Stringer) error {
+	ctx, status := withStatus(ctx)
+	return mapErr(s.sdb.Delete(ctx, req), *status)
+}
+
+// User returns the user
+func (s *Service) User(ctx context.Context, userID int) (User, error) {
+	users := []User{}
+	if err := s.get(ctx, s.request(filter("user", "id", strconv.Itoa(userID))), &users); err != nil {
+		return User{}, err
+	}
+	if len(users) != 1 {
+		return User{}, ErrNotFound
+	}
+	return users[0], nil
+}
+
+// rows returns all the user timesheet rows, including the membership ones
+func (s *Service) rows(ctx context.Context, userID int) ([]timesheetRow, error) {
+	rows := []timesheetRow{}
+	err := s.get(ctx, s.request(filter("timesheet", "user_id", strconv.Itoa(userID))), &rows)
+	if errors.Is(err, ErrNotFound) {
+		// SlashDB answers with a 404 when nothing matches the filter
+		return []timesheetRow{}, nil
+	}
+	return rows, err
+}
+
+// projects returns all the user projects, by their IDs
+func (s *Service) projects(ctx context.Context, userID int) (map[int]Project, error) {
+	rows := []projectRow{}
+	err := s.get(ctx, s.request(filter("timesheet", "user_id", strconv.Itoa(userID)), slashdb.Part{Name: "project"}), &rows)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	projects := map[int]Project{}
+	for _, r := range rows {
+		projects[r.ID] = r.project()
+	}
+	return projects, nil
+}
+
+// entries returns the user entries, newest first
+func (s *Service) entries(ctx context.Context, userID int, f EntryFilter) ([]Entry, error) {
+	rows, err := s.rows(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	entries := []Entry{}
+	for _, r := range rows {
+		if r.membership() {
+			continue
+		}
+		if e := r.entry(); f.match(e) {
+			entries = append(entries, e)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Date.After(entries[j].Date) })
+	return entries, nil
+}
+
+// exportPageSize is how many rows EachEntry fetches at once
+const exportPageSize = 500
+
+// EachEntry calls fn with each of the user entries, the oldest first, the entries are fetched page by page,
+// so there's never more than a page of them in memory
+func (s *Service) EachEntry(ctx context.Context, userID int, f EntryFilter, fn func(Entry) error) error {
+	pairs := []string{"user_id", strconv.Itoa(userID)}
+	if f.ProjectID != 0 {
+		pairs = append(pairs, "project_id", strconv.Itoa(f.ProjectID))
+	}
+	for offset := 0; ; offset += exportPageSize {
+		req := s.request(filter("timesheet", pairs...))
+		req.SetSort("date")
+		req.SetLimit(exportPageSize)
+		req.SetOffset(offset)
+
+		rows := []timesheetRow{}
+		err := s.get(ctx, req, &rows)
+		if errors.Is(err, ErrNotFound) {
+			// past the last page
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, r := range rows {
+			if r.membership() {
+				continue
+			}
+			if e := r.entry(); f.match(e) {
+				if err := fn(e); err != nil {
+					return err
+				}
+			}
+		}
+		if len(rows) < exportPageSize {
+			return nil
+		}
+	}
+}
+
+// ProjectNames returns the names of the user projects, by their IDs
+func (s *Service) ProjectNames(ctx context.Context, userID int) (map[int]string, error) {
+	projects, err := s.projects(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(projects))
+	for id, p := range projects {
+		names[id] = p.Name
+	}
+	return names, nil
+}
+
+// EntriesCSVPath returns the SlashDB path of the user timesheet rows as CSV (with the headers),
+// including the rows linking the user to the projects
+func (s *Service) EntriesCSVPath(userID, projectID int) string {
+	pairs := []string{"user_id", strconv.Itoa(userID)}
+	if projectID != 0 {
+		pairs = append(pairs, "project_id", strconv.Itoa(projectID))
+	}
+	req := s.request(filter("timesheet", pairs...))
+	req.SetSort("date")
+	req.HeadersOn()
+	req.SetCSVNullStr("")
+	path, query, _ := strings.Cut(req.String(), "?")
+	return strings.TrimSuffix(path, ".json") + ".csv?" + query
+}
+
+func sortProjects(projects []Project, by string) {
+	desc := strings.HasPrefix(by, "-")
+	less := func(a, b Project) bool { return a.CreatedAt.Before(b.CreatedAt) }
+	switch strings.TrimPrefix(by, "-") {
+	case "name":
+		less = func(a, b Project) bool { return strings.ToLower(a.Name) < strings.ToLower(b.Name) }
+	case "id":
+		less = func(a, b Project) bool { return a.ID < b.ID }
+	}
+	sort.SliceStable(projects, func(i, j int) bool {
+		if desc {
+			return less(projects[j], projects[i])
+		}
+		return less(projects[i], projects[j])
+	})
+}
+
+// ListProjects returns a page of the user projects, sortable by created_at (the default is newest first),
+// name or id, withEntries adds the project entries
+func (s *Service) ListProjects(ctx context.Context, userID int, page Page, withEntries bool) ([]Project, Pagination, error) {
+	page = page.normalize()
+	byID, err := s.projects(ctx, userID)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	projects := make([]Project, 0, len(byID))
+	for _, p := range byID {
+		projects = append(projects, p)
+	}
+	if page.Sort == "" {
+		page.Sort = "-created_at"
+	}
+	sortProjects(projects, page.Sort)
+	projects, pg := paginate(projects, page)
+
+	if withEntries {
+		entries, err := s.entries(ctx, userID, EntryFilter{})
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		for i := range projects {
+			projects[i].Entries = []Entry{}
+			for _, e := range entries {
+				if e.ProjectID == projects[i].ID {
+					projects[i].Entries = append(projects[i].Entries, e)
+				}
+			}
+		}
+	}
+	return projects, pg, nil
+}
+
+// Project returns one of the user projects
+func (s *Service) Project(ctx context.Context, userID, projectID int, withEntries bool) (Project, error) {
+	byID, err := s.projects(ctx, userID)
+	if err != nil {
+		return Project{}, err
+	}
+	p, ok := byID[projectID]
+	if !ok {
+		return Project{}, ErrNotFound
+	}
+	if withEntries {
+		if p.Entries, err = s.entries(ctx, userID, EntryFilter{ProjectID: projectID}); err != nil {
+			return Project{}, err
+		}
+	}
+	return p, nil
+}
+
+// checkClient checks the client the project input links to is one the user manages
+func (s *Service) checkClient(ctx context.Context, userID int, in ProjectInput) error {
+	if in.ClientID == nil || *in.ClientID == 0 {
+		return nil
+	}
+	_, err := s.Client(ctx, userID, *in.ClientID)
+	if errors.Is(err, ErrNotFound) {
+		return &ValidationError{Fields: map[string][]string{"client_id": {"no such client"}}}
+	}
+	return err
+}
+
+// CreateProject creates a project and links the user to it
+func (s *Service) CreateProject(ctx context.Context, userID int, in ProjectInput) (Project, error) {
+	if err := in.Validate(); err != nil {
+		return Project{}, err
+	}
+	if err := s.checkClient(ctx, userID, in); err != nil {
+		return Project{}, err
+	}
+	payload := in.payload()
+	payload["user_id"] = userID
+	id, err := s.create(ctx, s.request(slashdb.Part{Name: "project"}), payload)
+	if err != nil {
+		return Project{}, err
+	}
+	projectID, err := strconv.Atoi(id)
+	if err != nil {
+		return Project{}, fmt.Errorf("%w: unexpected project ID %q", ErrUpstream, id)
+	}
+
+	membership := timesheetRow{UserID: userID, ProjectID: projectID, Date: formatSdbTime(s.now())}
+	if _, err := s.create(ctx, s.request(slashdb.Part{Name: "timesheet"}), membership); err != nil {
+		return Project{}, fmt.Errorf("error linking the project: %w", err)
+	}
+	return s.Project(ctx, userID, projectID, false)
+}
+
+// checkOwner checks the user owns the project, the projects with no owner recorded are owned by their only member
+func (s *Service) checkOwner(ctx context.Context, userID int, p Project) error {
+	if p.OwnerID == userID {
+		return nil
+	}
+	if p.OwnerID == 0 {
+		others, err := s.otherMembers(ctx, userID, p.ID)
+		if err != nil || !others {
+			return err
+		}
+	}
+	return ErrNotProjectOwner
+}
+
+// UpdateProject updates one of the user projects, only the owner of a shared project can change its client,
+// it decides whom the project is billed to
+func (s *Service) UpdateProject(ctx context.Context, userID, projectID int, in ProjectInput) (Project, error) {
+	if err := in.Validate(); err != nil {
+		return Project{}, err
+	}
+	p, err := s.Project(ctx, userID, projectID, false)
+	if err != nil {
+		return Project{}, err
+	}
+	// the other members send the client back as it is along with the rest of the project
+	if in.ClientID != nil && *in.ClientID != p.ClientID {
+		if err := s.checkOwner(ctx, userID, p); err != nil {
+			return Project{}, err
+		}
+		if err := s.checkClient(ctx, userID, in); err != nil {
+			return Project{}, err
+		}
+	}
+	if err := s.update(ctx, s.request(filter("project", "id", strconv.Itoa(projectID))), in.payload()); err != nil {
+		return Project{}, err
+	}
+	return s.Project(ctx, userID, projectID, false)
+}
+
+// otherMembers reports if anyone else is on the project
+func (s *Service) otherMembers(ctx context.Context, userID, projectID int) (bool, error) {
+	members := []User{}
+	req := s.request(filter("timesheet", "project_id", strconv.Itoa(projectID)), slashdb.Part{Name: "user"})
+	err := s.get(ctx, req, &members)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return false, err
+	}
+	for _, u := range members {
+		if u.ID != userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// projectShared reports if anyone else references the project: another member, another user timer or rate,
+// or an invoice line
+func (s *Service) projectShared(ctx context.Context, userID, projectID int) (bool, error) {
+	if others, err := s.otherMembers(ctx, userID, projectID); err != nil || others {
+		return others, err
+	}
+
+	pid := strconv.Itoa(projectID)
+	timers := []timerRow{}
+	err := s.get(ctx, s.request(filter("timer", "project_id", pid)), &timers)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return false, err
+	}
+	for _, t := range timers {
+		if t.UserID != userID {
+			return true, nil
+		}
+	}
+
+	rates, err := s.rates(ctx, filter("rate", "project_id", pid))
+	if err != nil {
+		return false, err
+	}
+	for _, r := range rates {
+		if id := deref(r.UserID); id != 0 && id != userID {
+			return true, nil
+		}
+	}
+
+	lines := []invoiceLineRow{}
+	req := s.request(filter("invoice_line", "project_id", pid))
+	req.SetLimit(1)
+	err = s.get(ctx, req, &lines)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return false, err
+	}
+	return len(lines) > 0, nil
+}
+
+// DeleteProject deletes the user entries of the project and the user rates on it. The project itself,
+// along with its rates, goes too unless someone else references it, the others keep it as it is.
+// The projects with invoiced entries of the user are kept
+func (s *Service) DeleteProject(ctx context.Context, userID, projectID int) error {
+	if _, err := s.Project(ctx, userID, projectID, false); err != nil {
+		return err
+	}
+	entries, err := s.entries(ctx, userID, EntryFilter{ProjectID: projectID})
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.InvoiceID != 0 {
+			return ErrProjectInvoiced
+		}
+	}
+	// checked up front, the user rows are gone by the time the project could turn out to be in use
+	shared, err := s.projectShared(ctx, userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	// a timer running on the project would keep it referenced
+	if t, err := s.timer(ctx, userID); err == nil && t.ProjectID == projectID {
+		if err := s.DiscardTimer(ctx, userID); err != nil {
+			return err
+		}
+	} else if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	uid, pid := strconv.Itoa(userID), strconv.Itoa(projectID)
+	userRates := s.request(filter("rate", "project_id", pid, "user_id", uid))
+	if err := s.delete(ctx, userRates); err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err := s.delete(ctx, s.request(filter("timesheet", "user_id", uid, "project_id", pid))); err != nil {
+		return err
+	}
+	if shared {
+		return nil
+	}
+	// no one else's, the project level rates and the project go with the user rows
+	if err := s.delete(ctx, s.request(filter("rate", "project_id", pid))); err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	err = s.delete(ctx, s.request(filter("project", "id", pid)))
+	if errors.Is(err, ErrConflict) {
+		// someone joined it in the meantime, it's theirs now
+		return nil
+	}
+	return err
+}
+
+// ListEntries returns a page of the user entries, sorted by date (the default is newest first) or duration
+func (s *Service) ListEntries(ctx context.Context, userID int, f EntryFilter, page Page) ([]Entry, Pagination, error) {
+	page = page.normalize()
+	switch page.Sort {
+	case "", "-date", "date":
+		return s.entryPage(ctx, userID, f, page)
+	}
+	entries, err := s.entries(ctx, userID, f)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	switch page.Sort {
+	case "duration":
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].Duration < entries[j].Duration })
+	case "-duration":
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].Duration > entries[j].Duration })
+	}
+	entries, pg := paginate(entries, page)
+	return entries, pg, nil
+}
+
+// entryPairs returns the filter of the user timesheet rows matching f, the dates as an inclusive range
+func entryPairs(userID int, f EntryFilter) []string {
+	pairs := []string{"user_id", strconv.Itoa(userID)}
+	if f.ProjectID != 0 {
+		pairs = append(pairs, "project_id", strconv.Itoa(f.ProjectID))
+	}
+	if !f.From.IsZero() || !f.To.IsZero() {
+		from, to := "", ""
+		if !f.From.IsZero() {
+			from = formatSdbTime(f.From)
+		}
+		if !f.To.IsZero() {
+			// the stored dates are whole seconds
+			to = formatSdbTime(f.To.Add(-time.Nanosecond))
+		}
+		pairs = append(pairs, "date", from+".."+to)
+	}
+	return pairs
+}
+
+// entryCount returns the number of the user entries matching f, the report query counts them when there's one,
+// otherwise only a column of the rows is read, the membership ones are subtracted
+func (s *Service) entryCount(ctx context.Context, userID int, f EntryFilter, memberships int) (int, error) {
+	if s.cfg.ReportQueries {
+		rows, err := s.queryReportRows(ctx, userID, f, GroupByMonth)
+		if err == nil {
+			n := 0
+			for _, r := range rows {
+				if f.ProjectID == 0 || r.ProjectID == f.ProjectID {
+					n += r.Entries
+				}
+			}
+			return n, nil
+		}
+		if ctx.Err() != nil {
+			return 0, err
+		}
+		// the query may be gone or not permitted anymore, the rows still add up
+	}
+	part := filter("timesheet", entryPairs(userID, f)...)
+	part.Fields = []string{"duration"}
+	durations := []float64{}
+	err := s.get(ctx, s.request(part), &durations)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return 0, err
+	}
+	return len(durations) - memberships, nil
+}
+
+// entryPage returns a page of the user entries sorted by date (the newest first by default) as SlashDB pages them.
+// The rows with no duration (the membership ones, the reports skip them too) are read beforehand, the page is
+// read with as many more rows, where they fall in it is told by their order
+func (s *Service) entryPage(ctx context.Context, userID int, f EntryFilter, page Page) ([]Entry, Pagination, error) {
+	pairs := entryPairs(userID, f)
+	// the dates of a user are only unique on a project
+	asc := page.Sort == "date"
+	less := func(a, b timesheetRow) bool {
+		if da, db := parseSdbTime(a.Date), parseSdbTime(b.Date); !da.Equal(db) {
+			return da.Before(db) == asc
+		}
+		return a.ProjectID < b.ProjectID
+	}
+	sortBy := []string{"-date", "project_id"}
+	if asc {
+		sortBy[0] = "date"
+	}
+
+	memberships := []timesheetRow{}
+	err := s.get(ctx, s.request(filter("timesheet", append(pairs, "duration", "0")...)), &memberships)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, Pagination{}, err
+	}
+	total, err := s.entryCount(ctx, userID, f, len(memberships))
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	pg := Pagination{Total: total, Limit: page.Limit, Offset: page.Offset}
+
+	req := s.request(filter("timesheet", pairs...))
+	req.SetSort(sortBy...)
+	req.SetOffset(page.Offset)
+	req.SetLimit(page.Limit + len(memberships))
+	rows := []timesheetRow{}
+	err = s.get(ctx, req, &rows)
+	if errors.Is(err, ErrNotFound) {
+		// past the last page
+		return []Entry{}, pg, nil
+	}
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	entries := []Entry{}
+	for i, r := range rows {
+		if r.Duration == 0 {
+			continue
+		}
+		// the index of the entry, without the membership rows sorted before it
+		index := page.Offset + i
+		for _, m := range memberships {
+			if less(m, r) {
+				index--
+			}
+		}
+		if index >= page.Offset && index < page.Offset+page.Limit {
+			entries = append(entries, r.entry())
+		}
+	}
+	return entries, pg, nil
+}
+
+// Entry returns one of the user entries
+func (s *Service) Entry(ctx context.Context, userID int, entryID string) (Entry, error) {
+	projectID, date, err := ParseEntryID(entryID)
+	if err != nil {
+		return Entry{}, err
+	}
+	rows := []timesheetRow{}
+	req := s.request(filter(
+		"timesheet",
+		"user_id", strconv.Itoa(userID), "project_id", strconv.Itoa(projectID), "date", formatSdbTime(date),
+	))
+	if err := s.get(ctx, req, &rows); err != nil {
+		return Entry{}, err
+	}
+	if len(rows) != 1 || rows[0].membership() {
+		return Entry{}, ErrNotFound
+	}
+	return rows[0].entry(), nil
+}
+
+// CreateEntry adds an entry to one of the user projects
+func (s *Service) CreateEntry(ctx context.Context, userID int, in EntryInput) (Entry, error) {
+	if err := in.Validate(true); err != nil {
+		return Entry{}, err
+	}
+	if _, err := s.Project(ctx, userID, in.ProjectID, false); errors.Is(err, ErrNotFound) {
+		return Entry{}, &ValidationError{Fields: map[string][]string{"project_id": {"no such project"}}}
+	} else if err != nil {
+		return Entry{}, err
+	}
+
+	date := s.now()
+	if in.Date != nil {
+		date = *in.Date
+	}
+	row := timesheetRow{
+		UserID:          userID,
+		ProjectID:       in.ProjectID,
+		Date:            formatSdbTime(date),
+		Duration:        in.Duration,
+		Accomplishments: in.Accomplishments,
+	}
+	if _, err := s.create(ctx, s.request(slashdb.Part{Name: "timesheet"}), row); err != nil {
+		return Entry{}, err
+	}
+	return row.entry(), nil
+}
+
+// UpdateEntry updates the duration and the accomplishments of one of the user entries, unless it's invoiced
+func (s *Service) UpdateEntry(ctx context.Context, userID int, entryID string, in EntryInput) (Entry, error) {
+	if err := in.Validate(false); err != nil {
+		return Entry{}, err
+	}
+	e, err := s.Entry(ctx, userID, entryID)
+	if err != nil {
+		return Entry{}, err
+	}
+	if e.InvoiceID != 0 {
+		return Entry{}, ErrEntryInvoiced
+	}
+	payload := map[string]interface{}{"duration": in.Duration, "accomplishments": in.Accomplishments}
+	if err := s.update(ctx, s.entryRequest(e), payload); err != nil {
+		return Entry{}, err
+	}
+	e.Duration, e.Accomplishments = in.Duration, in.Accomplishments
+	return e, nil
+}
+
+// DeleteEntry deletes one of the user entries, unless it's invoiced
+func (s *Service) DeleteEntry(ctx context.Context, userID int, entryID string) error {
+	e, err := s.Entry(ctx, userID, entryID)
+	if err != nil {
+		return err
+	}
+	if e.InvoiceID != 0 {
+		return ErrEntryInvoiced
+	}
+	return s.delete(ctx, s.entryRequest(e))
+}
+
+func (s *Service) entryRequest(e Entry) *slashdb.Request {
+	return s.request(filter(
+		"timesheet",
+		"user_id", strconv.Itoa(e.UserID), "project_id", strconv.Itoa(e.ProjectID), "date", formatSdbTime(e.Date),
+	))
+}
+
+func roundHours(h float64) float64 {
+	return math.Round(h*100) / 100
+}
+
+// Summaries returns the user entry totals, grouped by project or by day
+func (s *Service) Summaries(ctx context.Context, userID int, f EntryFilter, groupBy string) (Summaries, error) {
+	if groupBy == "" {
+		groupBy = GroupByProject
+	}
+	if groupBy != GroupByProject && groupBy != GroupByDay {
+		return Summaries{}, &ValidationError{Fields: map[string][]string{
+			"group_by": {fmt.Sprintf("expected %s or %s", GroupByProject, GroupByDay)},
+		}}
+	}
+
+	entries, err := s.entries(ctx, userID, f)
+	if err != nil {
+		return Summaries{}, err
+	}
+	projects := map[int]Project{}
+	if groupBy == GroupByProject {
+		if projects, err = s.projects(ctx, userID); err != nil {
+			return Summaries{}, err
+		}
+	}
+
+	groups := map[string]*Summary{}
+	keys := []string{}
+	total := Summary{Key: "total"}
+	for _, e := range entries {
+		key := e.Date.Format("2006-01-02")
+		if groupBy == GroupByProject {
+			key = strconv.Itoa(e.ProjectID)
+		}
+		g, ok := groups[key]
+		if !ok {
+			g = &Summary{Key: key}
+			if groupBy == GroupByProject {
+				g.ProjectID, g.ProjectName = e.ProjectID, projects[e.ProjectID].Name
+			}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.Entries++
+		g.Hours += e.Duration
+		total.Entries++
+		total.Hours += e.Duration
+	}
+
+	sort.Strings(keys)
+	out := Summaries{GroupBy: groupBy, Groups: make([]Summary, 0, len(keys)), Total: total}
+	for _, key := range keys {
+		g := *groups[key]
+		g.Hours = roundHours(g.Hours)
+		out.Groups = append(out.Groups, g)
+	}
+	if groupBy == GroupByProject {
+		sort.SliceStable(out.Groups, func(i, j int) bool { return out.Groups[i].ProjectID < out.Groups[j].ProjectID })
+	}
+	out.Total.Hours = roundHours(out.Total.Hours)
+	return out, nil
+}
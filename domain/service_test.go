@@ -0,0 +1,329 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+const testDB = "timesheet"
+
+// testNow is the service clock in the tests
+var testNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// newTestService returns a service on top of a fake SlashDB, with two users and a project of the first one
+func newTestService(t *testing.T, cfg Config) (*Service, *sdbtest.Server) {
+	t.Helper()
+	fake := sdbtest.New(t, testDB)
+	sdb, err := slashdb.NewService(fake.URL, "apikey", "key", "", false, NewStatusDoer(fake.Client()))
+	if err != nil {
+		t.Fatalf("slashdb.NewService: %v", err)
+	}
+	cfg.DBName = testDB
+	s := NewService(sdb, cfg)
+	s.now = func() time.Time { return testNow }
+
+	fake.Insert("user",
+		sdbtest.Row{"id": 1, "username": "one", "email": "", "passwd": "x"},
+		sdbtest.Row{"id": 2, "username": "two", "email": "", "passwd": "x"},
+	)
+	fake.Insert("project", sdbtest.Row{"id": 1, "name": "Project", "description": "", "client_id": nil, "timestamp": "2026-01-01T00:00:00"})
+	fake.Insert("timesheet", sdbtest.Row{"user_id": 1, "project_id": 1, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""})
+	return s, fake
+}
+
+// entryRow returns a timesheet row of an entry
+func entryRow(userID, projectID int, date string, duration float64) sdbtest.Row {
+	return sdbtest.Row{
+		"user_id": userID, "project_id": projectID, "date": date + "T00:00:00",
+		"duration": duration, "accomplishments": "work", "invoice_id": nil,
+	}
+}
+
+func ptr(v int) *int { return &v }
+
+// count returns the number of the table rows matching the column values
+func count(fake *sdbtest.Server, table string, pairs ...interface{}) int {
+	n := 0
+	for _, r := range fake.Rows(table) {
+		match := true
+		for i := 0; i+1 < len(pairs); i += 2 {
+			match = match && r[pairs[i].(string)] == pairs[i+1]
+		}
+		if match {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDeleteProject(t *testing.T) {
+	projectRate := sdbtest.Row{"id": 1, "client_id": nil, "project_id": 1, "user_id": nil, "hourly_rate": 50, "effective_from": "2026-01-01"}
+	ownRate := sdbtest.Row{"id": 2, "client_id": nil, "project_id": 1, "user_id": 1, "hourly_rate": 60, "effective_from": "2026-01-01"}
+	otherRate := sdbtest.Row{"id": 3, "client_id": nil, "project_id": 1, "user_id": 2, "hourly_rate": 70, "effective_from": "2026-01-01"}
+
+	tests := []struct {
+		name    string
+		userID  int
+		seed    func(fake *sdbtest.Server)
+		wantErr error
+		// wantProject is set when the project has to be kept
+		wantProject bool
+		// wantRates are the rate IDs left
+		wantRates []int
+		// wantRows is the number of the timesheet rows left
+		wantRows int
+	}{
+		{
+			name: "the only member",
+			seed: func(fake *sdbtest.Server) {
+				fake.Insert("timesheet", entryRow(1, 1, "2026-02-01", 2))
+				fake.Insert("rate", projectRate, ownRate)
+				fake.Insert("timer", sdbtest.Row{"user_id": 1, "project_id": 1, "started_at": "2026-03-10T10:00:00", "accomplishments": ""})
+			},
+		},
+		{
+			name: "another member",
+			seed: func(fake *sdbtest.Server) {
+				fake.Insert("timesheet", entryRow(1, 1, "2026-02-01", 2), entryRow(2, 1, "2026-02-01", 3))
+				fake.Insert("rate", projectRate, ownRate, otherRate)
+			},
+			wantProject: true,
+			wantRates:   []int{1, 3},
+			wantRows:    1,
+		},
+		{
+			name: "another user timer",
+			seed: func(fake *sdbtest.Server) {
+				fake.Insert("timer", sdbtest.Row{"user_id": 2, "project_id": 1, "started_at": "2026-03-10T10:00:00", "accomplishments": ""})
+				fake.Insert("rate", projectRate)
+			},
+			wantProject: true,
+			wantRates:   []int{1},
+		},
+		{
+			name: "another user rate",
+			seed: func(fake *sdbtest.Server) {
+				fake.Insert("rate", otherRate)
+			},
+			wantProject: true,
+			wantRates:   []int{3},
+		},
+		{
+			name: "on an invoice",
+			seed: func(fake *sdbtest.Server) {
+				fake.Insert("invoice_line", sdbtest.Row{
+					"id": 1, "invoice_id": 9, "project_id": 1, "description": "Project", "hours": 1, "hourly_rate": 10, "amount": 10,
+				})
+			},
+			wantProject: true,
+		},
+		{
+			name: "invoiced entries",
+			seed: func(fake *sdbtest.Server) {
+				row := entryRow(1, 1, "2026-02-01", 2)
+				row["invoice_id"] = 9
+				fake.Insert("timesheet", row)
+				fake.Insert("rate", ownRate)
+			},
+			wantErr:     ErrProjectInvoiced,
+			wantProject: true,
+			wantRates:   []int{2},
+			wantRows:    2,
+		},
+		{
+			name:   "not a member",
+			userID: 2,
+			seed: func(fake *sdbtest.Server) {
+				fake.Insert("rate", projectRate)
+			},
+			wantErr:     ErrNotFound,
+			wantProject: true,
+			wantRates:   []int{1},
+			wantRows:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, fake := newTestService(t, Config{})
+			tt.seed(fake)
+			userID := tt.userID
+			if userID == 0 {
+				userID = 1
+			}
+
+			err := s.DeleteProject(context.Background(), userID, 1)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got := count(fake, "project", "id", 1) == 1; got != tt.wantProject {
+				t.Errorf("got project kept %v, want %v", got, tt.wantProject)
+			}
+			rates := []int{}
+			for _, r := range fake.Rows("rate") {
+				rates = append(rates, r["id"].(int))
+			}
+			if len(rates) != len(tt.wantRates) {
+				t.Fatalf("got rates %v, want %v", rates, tt.wantRates)
+			}
+			for i := range rates {
+				if rates[i] != tt.wantRates[i] {
+					t.Fatalf("got rates %v, want %v", rates, tt.wantRates)
+				}
+			}
+			if got := len(fake.Rows("timesheet")); got != tt.wantRows {
+				t.Errorf("got %d timesheet rows, want %d", got, tt.wantRows)
+			}
+			if tt.wantErr == nil && count(fake, "timer", "user_id", userID) != 0 {
+				t.Errorf("the user timer on the project was kept")
+			}
+		})
+	}
+}
+
+func TestUpdateProjectClient(t *testing.T) {
+	project := func(ownerID, clientID interface{}) sdbtest.Row {
+		return sdbtest.Row{"id": 2, "name": "Shared", "description": "", "client_id": clientID, "user_id": ownerID, "timestamp": "2026-01-01T00:00:00"}
+	}
+	membership := func(userID int) sdbtest.Row {
+		return sdbtest.Row{"user_id": userID, "project_id": 2, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""}
+	}
+	tests := []struct {
+		name    string
+		userID  int
+		ownerID interface{}
+		members []int
+		// linked links the project to the client 1 of the owner
+		linked bool
+		// clientID is the client the user links the project to, nil leaves it as it is
+		clientID *int
+		wantErr  error
+	}{
+		{name: "the owner", userID: 1, ownerID: 1, members: []int{1, 2}, clientID: ptr(1)},
+		{name: "the owner unlinks", userID: 1, ownerID: 1, members: []int{1, 2}, linked: true, clientID: ptr(0)},
+		{name: "another member", userID: 2, ownerID: 1, members: []int{1, 2}, clientID: ptr(2), wantErr: ErrNotProjectOwner},
+		{name: "another member unlinks", userID: 2, ownerID: 1, members: []int{1, 2}, linked: true, clientID: ptr(0), wantErr: ErrNotProjectOwner},
+		{name: "another member keeps the client", userID: 2, ownerID: 1, members: []int{1, 2}, linked: true, clientID: ptr(1)},
+		{name: "another member renames", userID: 2, ownerID: 1, members: []int{1, 2}},
+		{name: "no owner, the only member", userID: 2, members: []int{2}, clientID: ptr(2)},
+		{name: "no owner, shared", userID: 2, members: []int{1, 2}, clientID: ptr(2), wantErr: ErrNotProjectOwner},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, fake := newTestService(t, Config{})
+			fake.Insert("client",
+				sdbtest.Row{"id": 1, "user_id": 1, "name": "One", "currency": "EUR", "timestamp": "2026-01-01T00:00:00"},
+				sdbtest.Row{"id": 2, "user_id": 2, "name": "Two", "currency": "EUR", "timestamp": "2026-01-01T00:00:00"},
+			)
+			var clientID interface{}
+			if tt.linked {
+				clientID = 1
+			}
+			fake.Insert("project", project(tt.ownerID, clientID))
+			for _, id := range tt.members {
+				fake.Insert("timesheet", membership(id))
+			}
+
+			p, err := s.UpdateProject(context.Background(), tt.userID, 2, ProjectInput{Name: "Renamed", ClientID: tt.clientID})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrForbidden) {
+					t.Errorf("got error %v, want it forbidden", err)
+				}
+				if got := count(fake, "project", "name", "Shared", "client_id", clientID); got != 1 {
+					t.Errorf("the project was changed")
+				}
+				return
+			}
+			if tt.clientID != nil && p.ClientID != *tt.clientID {
+				t.Errorf("got client %d, want %d", p.ClientID, *tt.clientID)
+			}
+		})
+	}
+
+	s, _ := newTestService(t, Config{})
+	p, err := s.CreateProject(context.Background(), 2, ProjectInput{Name: "New"})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	if p.OwnerID != 2 {
+		t.Errorf("got owner %d, want 2", p.OwnerID)
+	}
+}
+
+func TestListEntries(t *testing.T) {
+	s, fake := newTestService(t, Config{})
+	fake.Insert("project", sdbtest.Row{"id": 2, "name": "Second", "description": "", "client_id": nil, "user_id": 1, "timestamp": "2026-02-15T00:00:00"})
+	fake.Insert("timesheet",
+		sdbtest.Row{"user_id": 1, "project_id": 2, "date": "2026-02-15T00:00:00", "duration": 0, "accomplishments": ""},
+		// the same date on both projects
+		entryRow(1, 1, "2026-01-10", 1), entryRow(1, 2, "2026-01-10", 2),
+		entryRow(1, 1, "2026-02-01", 3), entryRow(1, 2, "2026-02-20", 4), entryRow(1, 1, "2026-03-01", 5),
+		entryRow(2, 1, "2026-02-05", 6),
+	)
+	filters := []struct {
+		name string
+		f    EntryFilter
+	}{
+		{name: "all"},
+		{name: "project", f: EntryFilter{ProjectID: 1}},
+		{name: "range", f: EntryFilter{From: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)}},
+	}
+	for _, ft := range filters {
+		for _, by := range []string{"", "-date", "date"} {
+			// the page has to be the same as the one of all the entries sorted in memory
+			all, err := s.entries(context.Background(), 1, ft.f)
+			if err != nil {
+				t.Fatalf("entries: %v", err)
+			}
+			if by == "date" {
+				sort.SliceStable(all, func(i, j int) bool { return all[i].Date.Before(all[j].Date) })
+			}
+			for limit := 1; limit <= 3; limit++ {
+				for offset := 0; offset <= len(all); offset++ {
+					page := Page{Limit: limit, Offset: offset, Sort: by}
+					read := len(fake.Requests())
+					got, pg, err := s.ListEntries(context.Background(), 1, ft.f, page)
+					if err != nil {
+						t.Fatalf("ListEntries: %v", err)
+					}
+					// the rows are only read page by page, or just their durations
+					for _, r := range fake.Requests()[read:] {
+						if !strings.Contains(r.Path, "limit=") && !strings.Contains(r.Path, "/duration/0") &&
+							!strings.Contains(r.Path, "/duration.json") {
+							t.Fatalf("got a read of all the rows %s", r.Path)
+						}
+					}
+					want, wantPg := paginate(all, page)
+					if pg != wantPg || fmt.Sprint(got) != fmt.Sprint(want) {
+						t.Errorf("%s sorted by %q, page %d+%d: got %v %+v, want %v %+v", ft.name, by, offset, limit, got, pg, want, wantPg)
+					}
+				}
+			}
+		}
+	}
+
+	// the report query counts the entries
+	s, fake = newTestService(t, Config{ReportQueries: true})
+	fake.Other = func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]reportRow{{ProjectID: 1, Entries: 7}, {ProjectID: 2, Entries: 2}, {ProjectID: 1, Entries: 1}})
+	}
+	_, pg, err := s.ListEntries(context.Background(), 1, EntryFilter{ProjectID: 1}, Page{})
+	if err != nil {
+		t.Fatalf("ListEntries: %v", err)
+	}
+	if pg.Total != 8 {
+		t.Errorf("got total %d, want the 8 counted by the query", pg.Total)
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+// ErrUpstream - SlashDB failed in an unexpected way
+var ErrUpstream = errors.New("SlashDB request failed")
+
+type statusKey struct{}
+
+// withStatus returns a context the status doer records the SlashDB response status in,
+// the slashdb.Service errors don't carry it
+func withStatus(ctx context.Context) (context.Context, *int) {
+	status := new(int)
+	return context.WithValue(ctx, statusKey{}, status), status
+}
+
+type statusDoer struct {
+	next slashdb.Doer
+}
+
+// NewStatusDoer wraps the doer of the slashdb.Service used by the domain Service,
+// so the SlashDB errors can be told apart (i.e. not found from a conflict)
+func NewStatusDoer(next slashdb.Doer) slashdb.Doer {
+	return &statusDoer{next: next}
+}
+
+func (d *statusDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.next.Do(req)
+	if status, ok := req.Context().Value(statusKey{}).(*int); ok && resp != nil {
+		*status = resp.StatusCode
+	}
+	return resp, err
+}
+
+// mapErr turns a SlashDB error into a domain one, based on the recorded status
+func mapErr(err error, status int) error {
+	if err == nil {
+		return nil
+	}
+	switch status {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	}
+	return fmt.Errorf("%w: %w", ErrUpstream, err)
+}
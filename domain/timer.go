@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+// rounding modes
+const (
+	RoundNearest = "nearest"
+	RoundUp      = "up"
+	RoundDown    = "down"
+)
+
+// Rounding - how the stopped timer durations are rounded, the zero value doesn't round
+type Rounding struct {
+	Mode string
+	Step time.Duration
+}
+
+// ParseRounding parses the "<mode>:<step>" rule (i.e. nearest:6m or up:15m), "" and "none" don't round
+func ParseRounding(s string) (Rounding, error) {
+	if s == "" || s == "none" {
+		return Rounding{}, nil
+	}
+	mode, step, ok := strings.Cut(s, ":")
+	if !ok {
+		return Rounding{}, fmt.Errorf("expected <mode>:<step>, got %q", s)
+	}
+	if mode != RoundNearest && mode != RoundUp && mode != RoundDown {
+		return Rounding{}, fmt.Errorf("unknown rounding mode %q, expected %s, %s or %s", mode, RoundNearest, RoundUp, RoundDown)
+	}
+	d, err := time.ParseDuration(step)
+	if err != nil {
+		return Rounding{}, fmt.Errorf("invalid rounding step: %w", err)
+	}
+	if d < time.Minute {
+		return Rounding{}, fmt.Errorf("the rounding step has to be at least a minute, got %s", d)
+	}
+	return Rounding{Mode: mode, Step: d}, nil
+}
+
+func (r Rounding) String() string {
+	if r.Step <= 0 {
+		return "none"
+	}
+	return r.Mode + ":" + r.Step.String()
+}
+
+// Hours returns the rounded duration in hours, a timer always takes at least one step
+// (or 0.01 hour without the rounding), so there are no empty entries
+func (r Rounding) Hours(d time.Duration) float64 {
+	if r.Step <= 0 {
+		return max(roundHours(d.Hours()), 0.01)
+	}
+	switch r.Mode {
+	case RoundUp:
+		d = (d + r.Step - 1).Truncate(r.Step)
+	case RoundDown:
+		d = d.Truncate(r.Step)
+	default:
+		d = d.Round(r.Step)
+	}
+	return roundHours(max(d, r.Step).Hours())
+}
+
+// Timer - the running timer of a user
+type Timer struct {
+	ProjectID       int       `json:"project_id"`
+	StartedAt       time.Time `json:"started_at"`
+	Accomplishments string    `json:"accomplishments"`
+	// ElapsedSeconds is the time passed since the start, as of the response
+	ElapsedSeconds int64 `json:"elapsed_seconds"`
+}
+
+// TimerStatus - tells if the user has a running timer
+type TimerStatus struct {
+	Running bool   `json:"running"`
+	Timer   *Timer `json:"timer,omitempty"`
+	// Rounding is the rule the duration is rounded with on stop
+	Rounding string `json:"rounding"`
+}
+
+// TimerInput - the timer start fields, the accomplishments can also be given on stop
+type TimerInput struct {
+	ProjectID       int    `json:"project_id"`
+	Accomplishments string `json:"accomplishments"`
+}
+
+// timerRow - a timer table row, its primary key is the user_id
+type timerRow struct {
+	UserID          int    `json:"user_id"`
+	ProjectID       int    `json:"project_id"`
+	StartedAt       string `json:"started_at"`
+	Accomplishments string `json:"accomplishments"`
+}
+
+func (s *Service) timerRequest(userID int) *slashdb.Request {
+	return s.request(filter("timer", "user_id", strconv.Itoa(userID)))
+}
+
+// timer returns the running timer of the user, or ErrNotFound
+func (s *Service) timer(ctx context.Context, userID int) (timerRow, error) {
+	rows := []timerRow{}
+	if err := s.get(ctx, s.timerRequest(userID), &rows); err != nil {
+		return timerRow{}, err
+	}
+	if len(rows) != 1 {
+		return timerRow{}, ErrNotFound
+	}
+	return rows[0], nil
+}
+
+func (s *Service) timerFromRow(r timerRow) *Timer {
+	started := parseSdbTime(r.StartedAt)
+	return &Timer{
+		ProjectID:       r.ProjectID,
+		StartedAt:       started,
+		Accomplishments: r.Accomplishments,
+		ElapsedSeconds:  int64(max(s.now().Sub(started), 0) / time.Second),
+	}
+}
+
+// TimerStatus returns the running timer of the user, if there's any
+func (s *Service) TimerStatus(ctx context.Context, userID int) (TimerStatus, error) {
+	status := TimerStatus{Rounding: s.cfg.TimerRounding.String()}
+	row, err := s.timer(ctx, userID)
+	if errors.Is(err, ErrNotFound) {
+		return status, nil
+	}
+	if err != nil {
+		return TimerStatus{}, err
+	}
+	status.Running, status.Timer = true, s.timerFromRow(row)
+	return status, nil
+}
+
+// StartTimer starts a timer on one of the user projects, there can only be one running
+func (s *Service) StartTimer(ctx context.Context, userID int, in TimerInput) (Timer, error) {
+	in.Accomplishments = strings.TrimSpace(in.Accomplishments)
+	ve := &ValidationError{}
+	if in.ProjectID <= 0 {
+		ve.add("project_id", "this field is required")
+	}
+	if len(in.Accomplishments) > 150 {
+		ve.add("accomplishments", "can be at most 150 characters long")
+	}
+	if err := ve.errOrNil(); err != nil {
+		return Timer{}, err
+	}
+	if _, err := s.Project(ctx, userID, in.ProjectID, false); errors.Is(err, ErrNotFound) {
+		return Timer{}, &ValidationError{Fields: map[string][]string{"project_id": {"no such project"}}}
+	} else if err != nil {
+		return Timer{}, err
+	}
+
+	row := timerRow{
+		UserID:          userID,
+		ProjectID:       in.ProjectID,
+		StartedAt:       formatSdbTime(s.now()),
+		Accomplishments: in.Accomplishments,
+	}
+	// the timer primary key is the user_id, the DB makes sure there's only one
+	if _, err := s.create(ctx, s.request(slashdb.Part{Name: "timer"}), row); errors.Is(err, ErrConflict) {
+		return Timer{}, ErrTimerRunning
+	} else if err != nil {
+		return Timer{}, err
+	}
+	return *s.timerFromRow(row), nil
+}
+
+// StopTimer turns the running timer into an entry, started at the timer start, with the rounded duration,
+// a non-empty accomplishments replaces the one given on start. The timers left running for over a week
+// are capped at the longest entry there can be
+func (s *Service) StopTimer(ctx context.Context, userID int, accomplishments string) (Entry, error) {
+	row, err := s.timer(ctx, userID)
+	if err != nil {
+		return Entry{}, err
+	}
+	t := s.timerFromRow(row)
+	if accomplishments = strings.TrimSpace(accomplishments); accomplishments == "" {
+		accomplishments = t.Accomplishments
+	}
+	in := EntryInput{
+		ProjectID:       t.ProjectID,
+		Date:            &t.StartedAt,
+		Duration:        min(s.cfg.TimerRounding.Hours(time.Duration(t.ElapsedSeconds)*time.Second), maxEntryDuration),
+		Accomplishments: accomplishments,
+	}
+	if err := in.Validate(true); err != nil {
+		// the timer keeps running, so the user can fix the input or discard it
+		return Entry{}, err
+	}
+
+	// the timer goes first, of the concurrent stops only the one removing it creates the entry,
+	// the others find no timer running
+	req := s.request(filter("timer", "user_id", strconv.Itoa(userID), "started_at", formatSdbTime(t.StartedAt)))
+	if err := s.delete(ctx, req); err != nil {
+		return Entry{}, err
+	}
+	e, err := s.CreateEntry(ctx, userID, in)
+	if err != nil {
+		// it's put back, unless another one was started in the meantime
+		_, restoreErr := s.create(context.WithoutCancel(ctx), s.request(slashdb.Part{Name: "timer"}), row)
+		if restoreErr != nil && !errors.Is(restoreErr, ErrConflict) {
+			return Entry{}, errors.Join(err, fmt.Errorf("error restoring the timer: %w", restoreErr))
+		}
+		return Entry{}, err
+	}
+	return e, nil
+}
+
+// DiscardTimer stops the running timer without creating an entry
+func (s *Service) DiscardTimer(ctx context.Context, userID int) error {
+	return s.delete(ctx, s.timerRequest(userID))
+}
@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestParseRounding(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Rounding
+		wantErr bool
+	}{
+		{in: "", want: Rounding{}},
+		{in: "none", want: Rounding{}},
+		{in: "nearest:6m", want: Rounding{Mode: RoundNearest, Step: 6 * time.Minute}},
+		{in: "up:15m", want: Rounding{Mode: RoundUp, Step: 15 * time.Minute}},
+		{in: "down:1h", want: Rounding{Mode: RoundDown, Step: time.Hour}},
+		{in: "up", wantErr: true},
+		{in: "sideways:15m", wantErr: true},
+		{in: "up:soon", wantErr: true},
+		{in: "up:30s", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRounding(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundingHours(t *testing.T) {
+	nearest := Rounding{Mode: RoundNearest, Step: 6 * time.Minute}
+	up := Rounding{Mode: RoundUp, Step: 15 * time.Minute}
+	down := Rounding{Mode: RoundDown, Step: 15 * time.Minute}
+	tests := []struct {
+		name     string
+		rounding Rounding
+		d        time.Duration
+		want     float64
+	}{
+		{name: "none", d: 90 * time.Minute, want: 1.5},
+		{name: "none, at least 0.01", d: time.Second, want: 0.01},
+		{name: "none, rounded to 2 places", d: 61 * time.Minute, want: 1.02},
+		{name: "nearest down", rounding: nearest, d: 62 * time.Minute, want: 1},
+		{name: "nearest up", rounding: nearest, d: 63 * time.Minute, want: 1.1},
+		{name: "nearest, at least a step", rounding: nearest, d: time.Minute, want: 0.1},
+		{name: "up", rounding: up, d: 61 * time.Minute, want: 1.25},
+		{name: "up, exact", rounding: up, d: time.Hour, want: 1},
+		{name: "up, a second", rounding: up, d: time.Second, want: 0.25},
+		{name: "down", rounding: down, d: 74 * time.Minute, want: 1},
+		{name: "down, at least a step", rounding: down, d: 5 * time.Minute, want: 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rounding.Hours(tt.d); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// timerRowAt returns the first user timer row on the project
+func timerRowAt(startedAt time.Time, accomplishments string) sdbtest.Row {
+	return sdbtest.Row{
+		"user_id": 1, "project_id": 1, "started_at": formatSdbTime(startedAt), "accomplishments": accomplishments,
+	}
+}
+
+func TestStopTimer(t *testing.T) {
+	tests := []struct {
+		name            string
+		timer           sdbtest.Row
+		seed            func(fake *sdbtest.Server)
+		accomplishments string
+		wantErr         error
+		wantDuration    float64
+		// wantTimer is set when the timer has to keep running
+		wantTimer bool
+	}{
+		{
+			name:         "stopped",
+			timer:        timerRowAt(testNow.Add(-90*time.Minute), "work"),
+			wantDuration: 1.5,
+		},
+		{
+			name:            "accomplishments replaced",
+			timer:           timerRowAt(testNow.Add(-time.Hour), ""),
+			accomplishments: "done",
+			wantDuration:    1,
+		},
+		{
+			name:         "capped at a week",
+			timer:        timerRowAt(testNow.Add(-400*time.Hour), "forgotten"),
+			wantDuration: maxEntryDuration,
+		},
+		{
+			name:      "no accomplishments",
+			timer:     timerRowAt(testNow.Add(-time.Hour), ""),
+			wantErr:   &ValidationError{},
+			wantTimer: true,
+		},
+		{
+			name:  "the entry exists",
+			timer: timerRowAt(testNow.Add(-time.Hour), "work"),
+			seed: func(fake *sdbtest.Server) {
+				row := entryRow(1, 1, "", 1)
+				row["date"] = formatSdbTime(testNow.Add(-time.Hour))
+				fake.Insert("timesheet", row)
+			},
+			wantErr:   ErrConflict,
+			wantTimer: true,
+		},
+		{
+			name:    "not running",
+			wantErr: ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, fake := newTestService(t, Config{})
+			if tt.timer != nil {
+				fake.Insert("timer", tt.timer)
+			}
+			if tt.seed != nil {
+				tt.seed(fake)
+			}
+			before := len(fake.Rows("timesheet"))
+
+			e, err := s.StopTimer(context.Background(), 1, tt.accomplishments)
+			var ve *ValidationError
+			switch {
+			case errors.As(tt.wantErr, &ve):
+				if !errors.As(err, &ve) {
+					t.Fatalf("got error %v, want a validation error", err)
+				}
+			case !errors.Is(err, tt.wantErr):
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got := count(fake, "timer", "user_id", 1) == 1; got != tt.wantTimer {
+				t.Errorf("got timer running %v, want %v", got, tt.wantTimer)
+			}
+			if tt.wantErr != nil {
+				if got := len(fake.Rows("timesheet")); got != before {
+					t.Errorf("got %d timesheet rows, want %d", got, before)
+				}
+				return
+			}
+			if e.Duration != tt.wantDuration {
+				t.Errorf("got duration %v, want %v", e.Duration, tt.wantDuration)
+			}
+			if got := len(fake.Rows("timesheet")); got != before+1 {
+				t.Errorf("got %d timesheet rows, want %d", got, before+1)
+			}
+		})
+	}
+}
+
+func TestStopTimerConcurrently(t *testing.T) {
+	s, fake := newTestService(t, Config{})
+	fake.Insert("timer", timerRowAt(testNow.Add(-time.Hour), "work"))
+
+	// the other stop runs once the first one is about to remove the timer
+	stopped := false
+	var otherErr error
+	fake.Before = func(r *http.Request) {
+		if r.Method == http.MethodDelete && strings.Contains(r.URL.Path, "/timer/") && !stopped {
+			stopped = true
+			_, otherErr = s.StopTimer(context.Background(), 1, "")
+		}
+	}
+	_, err := s.StopTimer(context.Background(), 1, "")
+	if otherErr != nil {
+		t.Fatalf("the other stop failed: %v", otherErr)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got error %v, want %v", err, ErrNotFound)
+	}
+	if got := count(fake, "timesheet", "accomplishments", "work"); got != 1 {
+		t.Errorf("got %d entries, want 1", got)
+	}
+	if got := len(fake.Rows("timer")); got != 0 {
+		t.Errorf("got %d timers, want none", got)
+	}
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// TimesheetEntry - an entry along with its project name
+type TimesheetEntry struct {
+	Entry
+	ProjectName string
+}
+
+// TimesheetDay - the entries of a day and their subtotal
+type TimesheetDay struct {
+	Date    time.Time
+	Entries []TimesheetEntry
+	Hours   float64
+}
+
+// Timesheet - the user entries over a period, by day, i.e. to be printed and signed off
+type Timesheet struct {
+	User User
+	// Project is set when the timesheet covers just one of the user projects
+	Project *Project
+	// From and Through are the first and the last day covered, the filter ones or, without them, the entry ones
+	From, Through time.Time
+	Days          []TimesheetDay
+	Entries       int
+	Hours         float64
+	GeneratedAt   time.Time
+}
+
+// Timesheet returns the user entries matching the filter, the oldest first, grouped by day
+func (s *Service) Timesheet(ctx context.Context, userID int, f EntryFilter) (Timesheet, error) {
+	u, err := s.User(ctx, userID)
+	if err != nil {
+		return Timesheet{}, err
+	}
+	ts := Timesheet{User: u, From: f.From, GeneratedAt: time.Now().UTC()}
+	if !f.To.IsZero() {
+		ts.Through = f.To.AddDate(0, 0, -1)
+	}
+
+	projects, err := s.projects(ctx, userID)
+	if err != nil {
+		return Timesheet{}, err
+	}
+	if f.ProjectID != 0 {
+		p, ok := projects[f.ProjectID]
+		if !ok {
+			return Timesheet{}, ErrNotFound
+		}
+		ts.Project = &p
+	}
+
+	entries, err := s.entries(ctx, userID, f)
+	if err != nil {
+		return Timesheet{}, err
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Date.Before(entries[j].Date) })
+	for _, e := range entries {
+		day := time.Date(e.Date.Year(), e.Date.Month(), e.Date.Day(), 0, 0, 0, 0, time.UTC)
+		if len(ts.Days) == 0 || !ts.Days[len(ts.Days)-1].Date.Equal(day) {
+			ts.Days = append(ts.Days, TimesheetDay{Date: day})
+		}
+		d := &ts.Days[len(ts.Days)-1]
+		d.Entries = append(d.Entries, TimesheetEntry{Entry: e, ProjectName: projects[e.ProjectID].Name})
+		d.Hours += e.Duration
+		ts.Hours += e.Duration
+		ts.Entries++
+	}
+	for i := range ts.Days {
+		ts.Days[i].Hours = roundHours(ts.Days[i].Hours)
+	}
+	ts.Hours = roundHours(ts.Hours)
+
+	if len(ts.Days) > 0 {
+		if ts.From.IsZero() {
+			ts.From = ts.Days[0].Date
+		}
+		if ts.Through.IsZero() {
+			ts.Through = ts.Days[len(ts.Days)-1].Date
+		}
+	}
+	return ts, nil
+}
@@ -0,0 +1,78 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type csvWriter struct {
+	w    *csv.Writer
+	opts Options
+	rec  []string
+}
+
+// NewCSVWriter returns a CSV table writer
+func NewCSVWriter(w io.Writer, opts Options) TableWriter {
+	cw := csv.NewWriter(w)
+	cw.Comma = opts.Delimiter
+	return &csvWriter{w: cw, opts: opts}
+}
+
+func (c *csvWriter) number(f float64) string {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if c.opts.DecimalComma {
+		s = strings.Replace(s, ".", ",", 1)
+	}
+	return s
+}
+
+func (c *csvWriter) money(f float64) string {
+	s := strconv.FormatFloat(f, 'f', 2, 64)
+	if c.opts.DecimalComma {
+		s = strings.Replace(s, ".", ",", 1)
+	}
+	return s
+}
+
+func (c *csvWriter) WriteRow(style RowStyle, cells ...Cell) error {
+	if style == StyleTotal && !c.opts.Totals {
+		return nil
+	}
+	c.rec = c.rec[:0]
+	for _, cell := range cells {
+		switch cell.Kind {
+		case KindNumber:
+			c.rec = append(c.rec, c.number(cell.F))
+		case KindDuration:
+			if c.opts.Duration == DurationHHMM {
+				c.rec = append(c.rec, formatHHMM(cell.F))
+			} else {
+				c.rec = append(c.rec, c.number(cell.F))
+			}
+		case KindMoney:
+			c.rec = append(c.rec, c.money(cell.F))
+		case KindTime:
+			c.rec = append(c.rec, cell.T.Format("2006-01-02 15:04:05"))
+		default:
+			c.rec = append(c.rec, neutralizeFormula(cell.S))
+		}
+	}
+	// csv.Writer passes the rows on once its buffer fills up, so nothing accumulates
+	return c.w.Write(c.rec)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// neutralizeFormula prefixes the values the spreadsheets would run as formulas (i.e. =HYPERLINK(...))
+// with a quote, the accomplishments are user input
+func neutralizeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
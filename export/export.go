@@ -0,0 +1,123 @@
+// Package export writes tables (the timesheet entries and reports) as CSV or XLSX spreadsheets,
+// row by row, so the big ones are never held in memory
+package export
+
+import (
+	"fmt"
+	"time"
+)
+
+// export formats
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// duration formats
+const (
+	DurationHours = "hours"
+	DurationHHMM  = "hhmm"
+)
+
+// CellKind - the type of the cell value
+type CellKind int
+
+// cell kinds
+const (
+	KindString CellKind = iota
+	KindNumber
+	// KindDuration is a number of hours, written as decimal hours or [h]:mm
+	KindDuration
+	KindTime
+	// KindMoney is an amount, written with two decimals
+	KindMoney
+)
+
+// Cell - a typed table cell
+type Cell struct {
+	Kind CellKind
+	S    string
+	F    float64
+	T    time.Time
+}
+
+// String returns a string cell
+func String(s string) Cell { return Cell{Kind: KindString, S: s} }
+
+// Number returns a number cell
+func Number(f float64) Cell { return Cell{Kind: KindNumber, F: f} }
+
+// Duration returns a duration cell, h is in hours
+func Duration(h float64) Cell { return Cell{Kind: KindDuration, F: h} }
+
+// Money returns an amount cell
+func Money(f float64) Cell { return Cell{Kind: KindMoney, F: f} }
+
+// Time returns a date and time cell
+func Time(t time.Time) Cell { return Cell{Kind: KindTime, T: t} }
+
+// RowStyle - how the row stands out
+type RowStyle int
+
+// row styles
+const (
+	StyleData RowStyle = iota
+	StyleHeader
+	// StyleTotal marks the total and subtotal rows
+	StyleTotal
+)
+
+// TableWriter - writes the table rows, Close finishes the document
+type TableWriter interface {
+	WriteRow(style RowStyle, cells ...Cell) error
+	Close() error
+}
+
+// Options - the export settings
+type Options struct {
+	Format string
+	// Delimiter separates the CSV fields
+	Delimiter rune
+	// DecimalComma writes the CSV decimals with a comma
+	DecimalComma bool
+	// Duration is DurationHours or DurationHHMM
+	Duration string
+	// Totals adds the total and subtotal rows
+	Totals bool
+}
+
+// DefaultOptions are the CSV options when none are given
+var DefaultOptions = Options{Format: FormatCSV, Delimiter: ',', Duration: DurationHours, Totals: true}
+
+// Validate checks the options
+func (o Options) Validate() error {
+	switch {
+	case o.Format != FormatCSV && o.Format != FormatXLSX:
+		return fmt.Errorf("unknown format %q, expected %s or %s", o.Format, FormatCSV, FormatXLSX)
+	case o.Duration != DurationHours && o.Duration != DurationHHMM:
+		return fmt.Errorf("unknown duration format %q, expected %s or %s", o.Duration, DurationHours, DurationHHMM)
+	case o.Delimiter == '"' || o.Delimiter == '\r' || o.Delimiter == '\n' || o.Delimiter == 0:
+		return fmt.Errorf("invalid delimiter %q", o.Delimiter)
+	case o.DecimalComma && o.Delimiter == ',':
+		return fmt.Errorf("the decimal comma needs a delimiter other than a comma")
+	}
+	return nil
+}
+
+// ContentType returns the media type of the format
+func (o Options) ContentType() string {
+	if o.Format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// formatHHMM formats the hours as h:mm
+func formatHHMM(h float64) string {
+	minutes := int64(h*60 + 0.5)
+	sign := ""
+	if minutes < 0 {
+		sign, minutes = "-", -minutes
+	}
+	return fmt.Sprintf("%s%d:%02d", sign, minutes/60, minutes%60)
+}
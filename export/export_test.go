@@ -0,0 +1,209 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// writeTable writes the rows with w, the first one as the header and the last one as the total
+func writeTable(t *testing.T, w TableWriter, rows ...[]Cell) {
+	t.Helper()
+	for i, row := range rows {
+		style := StyleData
+		if i == 0 {
+			style = StyleHeader
+		} else if i == len(rows)-1 {
+			style = StyleTotal
+		}
+		if err := w.WriteRow(style, row...); err != nil {
+			t.Fatalf("WriteRow: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+var testRows = [][]Cell{
+	{String("Date"), String("Duration"), String("Accomplishments"), String("Amount")},
+	{Time(time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)), Duration(1.5), String(`=HYPERLINK("http://evil.example")`), Money(135)},
+	{Time(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)), Duration(0.25), String("fixed \"the\" bug, <again>\nand tested"), Money(22.5)},
+	{String("Total"), Duration(1.75), String(""), Money(157.5)},
+}
+
+func TestCSVWriter(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want [][]string
+	}{
+		{
+			name: "default",
+			opts: DefaultOptions,
+			want: [][]string{
+				{"Date", "Duration", "Accomplishments", "Amount"},
+				{"2026-03-02 09:30:00", "1.5", `'=HYPERLINK("http://evil.example")`, "135.00"},
+				{"2026-03-03 00:00:00", "0.25", "fixed \"the\" bug, <again>\nand tested", "22.50"},
+				{"Total", "1.75", "", "157.50"},
+			},
+		},
+		{
+			name: "decimal comma, h:mm, no totals",
+			opts: Options{Format: FormatCSV, Delimiter: ';', DecimalComma: true, Duration: DurationHHMM},
+			want: [][]string{
+				{"Date", "Duration", "Accomplishments", "Amount"},
+				{"2026-03-02 09:30:00", "1:30", `'=HYPERLINK("http://evil.example")`, "135,00"},
+				{"2026-03-03 00:00:00", "0:15", "fixed \"the\" bug, <again>\nand tested", "22,50"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			writeTable(t, NewCSVWriter(buf, tt.opts), testRows...)
+
+			r := csv.NewReader(buf)
+			r.Comma = tt.opts.Delimiter
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("error reading the CSV back: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNeutralizeFormula(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"plain text", "plain text"},
+		{"1+1", "1+1"},
+		{"=1+1", "'=1+1"},
+		{"+1", "'+1"},
+		{"-1", "'-1"},
+		{"@SUM(A1:A2)", "'@SUM(A1:A2)"},
+		{"\t=1+1", "'\t=1+1"},
+		{"\r=1+1", "'\r=1+1"},
+	}
+	for _, tt := range tests {
+		if got := neutralizeFormula(tt.in); got != tt.want {
+			t.Errorf("neutralizeFormula(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// xlsxCells reads the sheet cells of the XLSX, by their reference
+func xlsxCells(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("the XLSX is not a zip archive: %v", err)
+	}
+	parts := map[string][]byte{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("error opening %s: %v", f.Name, err)
+		}
+		body, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("error reading %s: %v", f.Name, err)
+		}
+		// all the parts are well formed XML
+		for d := xml.NewDecoder(bytes.NewReader(body)); ; {
+			if _, err := d.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not valid XML: %v", f.Name, err)
+			}
+		}
+		parts[f.Name] = body
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Fatalf("got the parts %v, want %s among them", reflect.ValueOf(parts).MapKeys(), name)
+		}
+	}
+
+	sheet := struct {
+		Rows []struct {
+			Cells []struct {
+				Ref    string `xml:"r,attr"`
+				Value  string `xml:"v"`
+				Inline string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}{}
+	if err := xml.Unmarshal(parts["xl/worksheets/sheet1.xml"], &sheet); err != nil {
+		t.Fatalf("error decoding the sheet: %v", err)
+	}
+	cells := map[string]string{}
+	for _, row := range sheet.Rows {
+		for _, c := range row.Cells {
+			cells[c.Ref] = c.Value + c.Inline
+		}
+	}
+	return cells
+}
+
+func TestXLSXWriter(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want map[string]string
+	}{
+		{
+			name: "hours",
+			opts: Options{Format: FormatXLSX, Duration: DurationHours, Totals: true},
+			want: map[string]string{
+				"A1": "Date", "B1": "Duration", "C1": "Accomplishments", "D1": "Amount",
+				"A2": "46083.395833333336", "B2": "1.5", "C2": `=HYPERLINK("http://evil.example")`, "D2": "135.00",
+				"A3": "46084", "B3": "0.25", "C3": "fixed \"the\" bug, <again>\nand tested", "D3": "22.50",
+				"A4": "Total", "B4": "1.75", "D4": "157.50",
+			},
+		},
+		{
+			name: "h:mm, no totals",
+			opts: Options{Format: FormatXLSX, Duration: DurationHHMM},
+			want: map[string]string{
+				"A1": "Date", "B1": "Duration", "C1": "Accomplishments", "D1": "Amount",
+				"A2": "46083.395833333336", "B2": "0.0625", "C2": `=HYPERLINK("http://evil.example")`, "D2": "135.00",
+				"A3": "46084", "B3": "0.010416666666666666", "C3": "fixed \"the\" bug, <again>\nand tested", "D3": "22.50",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w, err := NewXLSXWriter(buf, tt.opts, "entries <2026>", 18, 16, 60, 14)
+			if err != nil {
+				t.Fatalf("NewXLSXWriter: %v", err)
+			}
+			writeTable(t, w, testRows...)
+
+			// the strings are inline ones, which the spreadsheets don't run as formulas, so they are kept as they are
+			if got := xlsxCells(t, buf.Bytes()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got the cells %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(i); got != want {
+			t.Errorf("columnName(%d) = %s, want %s", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// the package parts written ahead of the sheet, it's the last one so it can be streamed
+var xlsxParts = []struct{ name, body string }{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`},
+	// the cell formats are: general, date and time, hours (0.00) and duration ([h]:mm), then the same in bold
+	{"xl/styles.xml", xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<numFmts count="2"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm"/><numFmt numFmtId="165" formatCode="[h]:mm"/></numFmts>` +
+		`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="8">` +
+		`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`<xf numFmtId="2" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+		`<xf numFmtId="164" fontId="1" fillId="0" borderId="0" xfId="0" applyNumberFormat="1" applyFont="1"/>` +
+		`<xf numFmtId="2" fontId="1" fillId="0" borderId="0" xfId="0" applyNumberFormat="1" applyFont="1"/>` +
+		`<xf numFmtId="165" fontId="1" fillId="0" borderId="0" xfId="0" applyNumberFormat="1" applyFont="1"/>` +
+		`</cellXfs></styleSheet>`},
+}
+
+// xlsx style indexes, see styles.xml, the bold ones are styleBold further
+const (
+	styleGeneral = iota
+	styleTime
+	styleHours
+	styleHHMM
+	styleBold
+)
+
+// excelEpoch is the day 0 of the spreadsheet dates
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+type xlsxWriter struct {
+	zw   *zip.Writer
+	w    *bufio.Writer
+	opts Options
+	row  int
+}
+
+// NewXLSXWriter returns a XLSX (single sheet) table writer, widths are the column widths in characters
+func NewXLSXWriter(w io.Writer, opts Options, sheetName string, widths ...float64) (TableWriter, error) {
+	zw := zip.NewWriter(w)
+	for _, p := range xlsxParts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := zw.Create("xl/workbook.xml")
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprint(f, xml.Header+`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" `+
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`)
+	xml.EscapeText(f, []byte(sheetName))
+	fmt.Fprint(f, `" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+
+	if f, err = zw.Create("xl/worksheets/sheet1.xml"); err != nil {
+		return nil, err
+	}
+	x := &xlsxWriter{zw: zw, w: bufio.NewWriter(f), opts: opts}
+	x.w.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if len(widths) > 0 {
+		x.w.WriteString("<cols>")
+		for i, width := range widths {
+			fmt.Fprintf(x.w, `<col min="%d" max="%d" width="%g" customWidth="1"/>`, i+1, i+1, width)
+		}
+		x.w.WriteString("</cols>")
+	}
+	x.w.WriteString("<sheetData>")
+	return x, nil
+}
+
+// columnName returns the spreadsheet column name (A, B, ..., AA, ...) of the 0 based index
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func (x *xlsxWriter) WriteRow(style RowStyle, cells ...Cell) error {
+	if style == StyleTotal && !x.opts.Totals {
+		return nil
+	}
+	bold := 0
+	if style != StyleData {
+		bold = styleBold
+	}
+
+	x.row++
+	fmt.Fprintf(x.w, `<row r="%d">`, x.row)
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(x.row)
+		switch cell.Kind {
+		case KindNumber:
+			fmt.Fprintf(x.w, `<c r="%s" s="%d"><v>%s</v></c>`, ref, bold+styleGeneral, strconv.FormatFloat(cell.F, 'f', -1, 64))
+		case KindDuration:
+			if x.opts.Duration == DurationHHMM {
+				// the durations are fractions of a day
+				fmt.Fprintf(x.w, `<c r="%s" s="%d"><v>%s</v></c>`, ref, bold+styleHHMM, strconv.FormatFloat(cell.F/24, 'f', -1, 64))
+			} else {
+				fmt.Fprintf(x.w, `<c r="%s" s="%d"><v>%s</v></c>`, ref, bold+styleHours, strconv.FormatFloat(cell.F, 'f', -1, 64))
+			}
+		case KindMoney:
+			// the same 0.00 format as the hours
+			fmt.Fprintf(x.w, `<c r="%s" s="%d"><v>%s</v></c>`, ref, bold+styleHours, strconv.FormatFloat(cell.F, 'f', 2, 64))
+		case KindTime:
+			days := cell.T.Sub(excelEpoch).Hours() / 24
+			fmt.Fprintf(x.w, `<c r="%s" s="%d"><v>%s</v></c>`, ref, bold+styleTime, strconv.FormatFloat(days, 'f', -1, 64))
+		default:
+			if cell.S == "" {
+				continue
+			}
+			fmt.Fprintf(x.w, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">`, ref, bold+styleGeneral)
+			xml.EscapeText(x.w, []byte(cell.S))
+			x.w.WriteString(`</t></is></c>`)
+		}
+	}
+	_, err := x.w.WriteString("</row>")
+	return err
+}
+
+func (x *xlsxWriter) Close() error {
+	x.w.WriteString("</sheetData></worksheet>")
+	if err := x.w.Flush(); err != nil {
+		return err
+	}
+	return x.zw.Close()
+}
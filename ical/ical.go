@@ -0,0 +1,118 @@
+// Package ical writes the time entries as iCalendar (RFC 5545) events and reads the events of the calendar files
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// utcLayout is the iCalendar UTC date and time format
+const utcLayout = "20060102T150405Z"
+
+// Event - a calendar event
+type Event struct {
+	// UID identifies the event across the feed updates
+	UID         string
+	Start       time.Time
+	Duration    time.Duration
+	Summary     string
+	Description string
+	Categories  []string
+}
+
+// Writer - writes the events of a calendar, Close ends it
+type Writer struct {
+	w     *bufio.Writer
+	stamp string
+}
+
+// NewWriter starts a calendar of the name
+func NewWriter(w io.Writer, name string) *Writer {
+	cw := &Writer{w: bufio.NewWriter(w), stamp: time.Now().UTC().Format(utcLayout)}
+	cw.line("BEGIN", "VCALENDAR")
+	cw.line("VERSION", "2.0")
+	cw.line("PRODID", "-//timesheet//timesheet//EN")
+	cw.line("CALSCALE", "GREGORIAN")
+	cw.line("METHOD", "PUBLISH")
+	if name != "" {
+		cw.line("X-WR-CALNAME", escape(name))
+	}
+	return cw
+}
+
+// line writes the content line, folded at 75 octets (without breaking the UTF-8 characters)
+func (cw *Writer) line(name, value string) {
+	s := name + ":" + value
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		cw.w.WriteString(s[:cut] + "\r\n ")
+		s = s[cut:]
+		// the continuation lines start with the space
+		limit = 74
+	}
+	cw.w.WriteString(s + "\r\n")
+}
+
+// escape escapes the text value
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`).Replace(s)
+}
+
+// FormatDuration returns the iCalendar duration (i.e. PT1H30M) of d, to a second
+func FormatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d <= 0 {
+		return "PT0S"
+	}
+	s := "PT"
+	if h := d / time.Hour; h > 0 {
+		s += fmt.Sprintf("%dH", h)
+	}
+	if m := d % time.Hour / time.Minute; m > 0 {
+		s += fmt.Sprintf("%dM", m)
+	}
+	if sec := d % time.Minute / time.Second; sec > 0 {
+		s += fmt.Sprintf("%dS", sec)
+	}
+	return s
+}
+
+// WriteEvent writes the event
+func (cw *Writer) WriteEvent(e Event) error {
+	cw.line("BEGIN", "VEVENT")
+	cw.line("UID", escape(e.UID))
+	cw.line("DTSTAMP", cw.stamp)
+	cw.line("DTSTART", e.Start.UTC().Format(utcLayout))
+	cw.line("DURATION", FormatDuration(e.Duration))
+	cw.line("SUMMARY", escape(e.Summary))
+	if e.Description != "" {
+		cw.line("DESCRIPTION", escape(e.Description))
+	}
+	if len(e.Categories) > 0 {
+		cats := make([]string, len(e.Categories))
+		for i, c := range e.Categories {
+			cats[i] = escape(c)
+		}
+		cw.line("CATEGORIES", strings.Join(cats, ","))
+	}
+	// the entries are time already spent, they shouldn't block the calendar
+	cw.line("TRANSP", "TRANSPARENT")
+	cw.line("END", "VEVENT")
+	// bufio keeps the first error
+	_, err := cw.w.Write(nil)
+	return err
+}
+
+// Close ends the calendar
+func (cw *Writer) Close() error {
+	cw.line("END", "VCALENDAR")
+	return cw.w.Flush()
+}
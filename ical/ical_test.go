@@ -0,0 +1,118 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{d: 0, want: "PT0S"},
+		{d: -time.Hour, want: "PT0S"},
+		{d: 90 * time.Minute, want: "PT1H30M"},
+		{d: 2 * time.Hour, want: "PT2H"},
+		{d: 36*time.Minute + 400*time.Millisecond, want: "PT36M"},
+		{d: 30 * time.Hour, want: "PT30H"},
+		{d: time.Hour + 5*time.Second, want: "PT1H5S"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatDuration(tt.d); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriterFolding(t *testing.T) {
+	tests := []struct {
+		name, value string
+	}{
+		{name: "short", value: "Review"},
+		{name: "exactly the limit", value: strings.Repeat("a", 75-len("SUMMARY:"))},
+		{name: "long", value: strings.Repeat("abcdefghij", 30)},
+		{name: "multibyte", value: strings.Repeat("zażółć gęślą jaźń ", 12)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			cw := &Writer{w: bufio.NewWriter(buf)}
+			cw.line("SUMMARY", tt.value)
+			cw.w.Flush()
+
+			out := buf.String()
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("the line isn't ended with CRLF: %q", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			for i, l := range lines {
+				if len(l) > 75 {
+					t.Errorf("line %d is %d octets long", i, len(l))
+				}
+				if !utf8.ValidString(l) {
+					t.Errorf("line %d breaks a character: %q", i, l)
+				}
+				if i > 0 && !strings.HasPrefix(l, " ") {
+					t.Errorf("continuation line %d doesn't start with a space: %q", i, l)
+				}
+			}
+			if got := strings.ReplaceAll(strings.TrimSuffix(out, "\r\n"), "\r\n ", ""); got != "SUMMARY:"+tt.value {
+				t.Errorf("got %q unfolded, want %q", got, "SUMMARY:"+tt.value)
+			}
+		})
+	}
+}
+
+func TestWriteEvent(t *testing.T) {
+	events := []Event{
+		{
+			UID: "1-1-20260302T091500@timesheet", Start: time.Date(2026, 3, 2, 9, 15, 0, 0, time.UTC),
+			Duration: 90 * time.Minute, Summary: "Website, phase 1",
+			Description: "Planning; the layout\nand a long description " + strings.Repeat("of the work done ", 10),
+		},
+		{
+			UID: "1-2-20260303T000000@timesheet", Start: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+			Duration: 20 * time.Minute, Summary: `C:\Projects`, Categories: []string{"a,b", "c"},
+		},
+	}
+	buf := &bytes.Buffer{}
+	cw := NewWriter(buf, "Timesheet - user")
+	for _, e := range events {
+		if err := cw.WriteEvent(e); err != nil {
+			t.Fatalf("WriteEvent: %v", err)
+		}
+	}
+	if err := cw.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	for _, want := range []string{"BEGIN:VCALENDAR\r\n", "X-WR-CALNAME:Timesheet - user\r\n", "TRANSP:TRANSPARENT\r\n", "END:VCALENDAR\r\n"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("%q is missing", want)
+		}
+	}
+
+	parsed, err := Parse(buf)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(parsed) != len(events) {
+		t.Fatalf("got %d events back, want %d", len(parsed), len(events))
+	}
+	for i, p := range parsed {
+		e := events[i]
+		if p.Err != nil {
+			t.Errorf("event %d: %v", i, p.Err)
+		}
+		if p.UID != e.UID || !p.Start.Equal(e.Start) || p.Duration != e.Duration || p.Summary != e.Summary ||
+			p.Description != e.Description || strings.Join(p.Categories, "|") != strings.Join(e.Categories, "|") {
+			t.Errorf("event %d: got %+v back, want %+v", i, p.Event, e)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	// the calendars name their time zones, the hosts don't always have the zoneinfo
+	_ "time/tzdata"
+)
+
+// property - a content line: NAME;PARAM=value:value
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseProperty splits the content line, the colon ending the name and the parameters can't be in a quoted value
+func parseProperty(line string) (property, bool) {
+	quoted := false
+	end := -1
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		}
+		if c == ':' && !quoted {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return property{}, false
+	}
+	p := property{value: line[end+1:], params: map[string]string{}}
+	parts := strings.Split(line[:end], ";")
+	p.name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		k, v, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return p, true
+}
+
+// unescape reverses escape
+func unescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// splitList splits the comma separated text values, the escaped commas are a part of the values
+func splitList(s string) []string {
+	items := []string{}
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case ',':
+			items = append(items, unescape(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(items, unescape(s[start:]))
+}
+
+// parseTime parses the DATE or DATE-TIME value of the property, the floating times (with no zone) are taken as UTC
+func parseTime(p property) (t time.Time, allDay bool, err error) {
+	if p.params["VALUE"] == "DATE" || len(p.value) == len("20060102") {
+		t, err = time.Parse("20060102", p.value)
+		return t, true, err
+	}
+	if strings.HasSuffix(p.value, "Z") {
+		t, err = time.Parse(utcLayout, p.value)
+		return t, false, err
+	}
+	loc := time.UTC
+	if tzid := p.params["TZID"]; tzid != "" {
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown time zone %q", tzid)
+		}
+	}
+	t, err = time.ParseInLocation("20060102T150405", p.value, loc)
+	return t.UTC(), false, err
+}
+
+// ParseDuration parses the iCalendar duration, i.e. PT1H30M or P1D
+func ParseDuration(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid duration %q", s)
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = -1, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, invalid
+	}
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+	d, n := time.Duration(0), ""
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			n += string(c)
+		case c == 'T':
+			if n != "" {
+				return 0, invalid
+			}
+			units = map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+		default:
+			unit, ok := units[c]
+			v, err := strconv.Atoi(n)
+			if !ok || err != nil {
+				return 0, invalid
+			}
+			d += time.Duration(v) * unit
+			n = ""
+		}
+	}
+	if n != "" {
+		return 0, invalid
+	}
+	return sign * d, nil
+}
+
+// ParsedEvent - an event read from a calendar file
+type ParsedEvent struct {
+	Event
+	// Line is where the event starts in the file
+	Line int
+	// AllDay events have the dates only
+	AllDay bool
+	// Recurring events have a recurrence rule, they're read as their first occurrence
+	Recurring bool
+	// Err is why the event couldn't be read, the other fields are as far as it got
+	Err error
+}
+
+// lineReader reads the unfolded content lines
+type lineReader struct {
+	r *bufio.Reader
+	// n is the number of the lines read, next is the line read ahead, it starts on the line nextNo
+	n, nextNo int
+	next      string
+	hasNext   bool
+}
+
+// raw returns the next physical line
+func (lr *lineReader) raw() (string, bool, error) {
+	s, err := lr.r.ReadString('\n')
+	if s == "" && err != nil {
+		if errors.Is(err, io.EOF) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	lr.n++
+	s = strings.TrimRight(s, "\r\n")
+	if lr.n == 1 {
+		// the BOM some editors add
+		s = strings.TrimPrefix(s, "\ufeff")
+	}
+	return s, true, nil
+}
+
+// read returns the next content line, with its continuation lines joined, and the number of the line it starts on.
+// It returns io.EOF at the end
+func (lr *lineReader) read() (string, int, error) {
+	if !lr.hasNext {
+		s, ok, err := lr.raw()
+		if err != nil {
+			return "", 0, err
+		}
+		if !ok {
+			return "", 0, io.EOF
+		}
+		lr.next, lr.nextNo, lr.hasNext = s, lr.n, true
+	}
+	line, start := lr.next, lr.nextNo
+	for {
+		s, ok, err := lr.raw()
+		if err != nil {
+			return "", 0, err
+		}
+		if !ok {
+			lr.hasNext = false
+			return line, start, nil
+		}
+		if strings.HasPrefix(s, " ") || strings.HasPrefix(s, "\t") {
+			line += s[1:]
+			continue
+		}
+		lr.next, lr.nextNo = s, lr.n
+		return line, start, nil
+	}
+}
+
+// Parse reads the events of the calendar, the ones it can't read have their Err set.
+// It fails if it's not a calendar at all
+func Parse(r io.Reader) ([]ParsedEvent, error) {
+	lr := &lineReader{r: bufio.NewReader(r)}
+	events := []ParsedEvent{}
+	var cur *ParsedEvent
+	var end time.Time
+	hasEnd, hasDuration, inCalendar, depth := false, false, false, 0
+	for {
+		line, start, err := lr.read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading the calendar: %w", err)
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		p, ok := parseProperty(line)
+		if !ok {
+			if !inCalendar {
+				return nil, errors.New("not an iCalendar file, expected BEGIN:VCALENDAR")
+			}
+			if cur != nil && cur.Err == nil {
+				cur.Err = fmt.Errorf("line %d: malformed %q", start, line)
+			}
+			continue
+		}
+		if !inCalendar {
+			if p.name != "BEGIN" || !strings.EqualFold(p.value, "VCALENDAR") {
+				return nil, errors.New("not an iCalendar file, expected BEGIN:VCALENDAR")
+			}
+			inCalendar = true
+			continue
+		}
+
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT") && cur == nil:
+			cur = &ParsedEvent{Line: start}
+			end, hasEnd, hasDuration, depth = time.Time{}, false, false, 0
+			continue
+		case cur == nil:
+			continue
+		case p.name == "BEGIN":
+			// the alarms and the like have their own properties
+			depth++
+			continue
+		case p.name == "END" && depth > 0:
+			depth--
+			continue
+		case depth > 0:
+			continue
+		case p.name == "END" && strings.EqualFold(p.value, "VEVENT"):
+			switch {
+			case cur.Err != nil:
+			case cur.Start.IsZero():
+				cur.Err = errors.New("the event has no start (DTSTART)")
+			case hasEnd && !hasDuration:
+				cur.Duration = end.Sub(cur.Start)
+			case !hasEnd && !hasDuration && cur.AllDay:
+				cur.Duration = 24 * time.Hour
+			}
+			events = append(events, *cur)
+			cur = nil
+			continue
+		}
+
+		var perr error
+		switch p.name {
+		case "UID":
+			cur.UID = p.value
+		case "SUMMARY":
+			cur.Summary = unescape(p.value)
+		case "DESCRIPTION":
+			cur.Description = unescape(p.value)
+		case "CATEGORIES":
+			cur.Categories = append(cur.Categories, splitList(p.value)...)
+		case "DTSTART":
+			cur.Start, cur.AllDay, perr = parseTime(p)
+		case "DTEND":
+			end, _, perr = parseTime(p)
+			hasEnd = true
+		case "DURATION":
+			cur.Duration, perr = ParseDuration(p.value)
+			hasDuration = true
+		case "RRULE", "RDATE":
+			cur.Recurring = true
+		}
+		if perr != nil && cur.Err == nil {
+			cur.Err = fmt.Errorf("%s: %w", p.name, perr)
+		}
+	}
+	if !inCalendar {
+		return nil, errors.New("the file is empty")
+	}
+	return events, nil
+}
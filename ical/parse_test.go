@@ -0,0 +1,158 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "PT1H30M", want: 90 * time.Minute},
+		{in: "PT45S", want: 45 * time.Second},
+		{in: "P1D", want: 24 * time.Hour},
+		{in: "P1W", want: 7 * 24 * time.Hour},
+		{in: "P1DT2H", want: 26 * time.Hour},
+		{in: "+PT15M", want: 15 * time.Minute},
+		{in: "-PT15M", want: -15 * time.Minute},
+		{in: "PT", wantErr: true},
+		{in: "P", wantErr: true},
+		{in: "1H", wantErr: true},
+		{in: "PT1D", wantErr: true},
+		{in: "P1H", wantErr: true},
+		{in: "PT1", wantErr: true},
+		{in: "P1T2H", wantErr: true},
+		{in: "PTH", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDuration(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// calendar returns the calendar of the event lines, with the CRLF line endings
+func calendar(lines ...string) string {
+	return strings.Join(append(append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, lines...), "END:VCALENDAR"), "\r\n") + "\r\n"
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		// want lists the events as "<line> <start> <duration> <summary>|<description>|<categories>", or "<line> error"
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "folded lines",
+			in: calendar(
+				"BEGIN:VEVENT",
+				"DTSTART:20260302T091500Z",
+				"DURATION:PT1H30M",
+				"SUMMARY:Web",
+				" site",
+				"DESCRIPTION:Planning\\, the lay",
+				"\tout\\nand more",
+				"CATEGORIES:Work,Client\\, A",
+				"END:VEVENT",
+			),
+			want: []string{"3 2026-03-02T09:15:00Z 1h30m0s Website|Planning, the layout\nand more|Work,Client, A"},
+		},
+		{
+			name: "the end and the time zone",
+			in: calendar(
+				"BEGIN:VEVENT",
+				"DTSTART;TZID=Europe/Warsaw:20260302T100000",
+				"DTEND;TZID=Europe/Warsaw:20260302T103000",
+				"SUMMARY:Call",
+				"BEGIN:VALARM",
+				"DESCRIPTION:Reminder",
+				"END:VALARM",
+				"END:VEVENT",
+			),
+			want: []string{"3 2026-03-02T09:00:00Z 30m0s Call||"},
+		},
+		{
+			name: "LF line endings and a BOM",
+			in:   "\ufeffBEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20260302T091500Z\nDURATION:PT1H\nSUMMARY:Review\nEND:VEVENT\nEND:VCALENDAR\n",
+			want: []string{"2 2026-03-02T09:15:00Z 1h0m0s Review||"},
+		},
+		{
+			name: "all-day and recurring",
+			in: calendar(
+				"BEGIN:VEVENT",
+				"DTSTART;VALUE=DATE:20260302",
+				"SUMMARY:Holiday",
+				"END:VEVENT",
+				"BEGIN:VEVENT",
+				"DTSTART:20260303T080000Z",
+				"DURATION:PT15M",
+				"RRULE:FREQ=DAILY",
+				"SUMMARY:Standup",
+				"END:VEVENT",
+			),
+			want: []string{
+				"3 2026-03-02T00:00:00Z 24h0m0s Holiday|| all-day",
+				"7 2026-03-03T08:00:00Z 15m0s Standup|| recurring",
+			},
+		},
+		{
+			name: "unreadable events",
+			in: calendar(
+				"BEGIN:VEVENT",
+				"SUMMARY:No start",
+				"END:VEVENT",
+				"BEGIN:VEVENT",
+				"DTSTART:20260302T091500Z",
+				"DURATION:soon",
+				"END:VEVENT",
+				"BEGIN:VEVENT",
+				"DTSTART:20260302T091500Z",
+				"malformed",
+				"END:VEVENT",
+			),
+			want: []string{"3 error", "6 error", "10 error"},
+		},
+		{name: "not a calendar", in: "date,duration\n", wantErr: true},
+		{name: "empty", in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := Parse(strings.NewReader(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			got := []string{}
+			for _, e := range events {
+				if e.Err != nil {
+					got = append(got, fmt.Sprintf("%d error", e.Line))
+					continue
+				}
+				s := fmt.Sprintf("%d %s %s %s|%s|%s", e.Line, e.Start.Format(time.RFC3339), e.Duration,
+					e.Summary, e.Description, strings.Join(e.Categories, ","))
+				if e.AllDay {
+					s += " all-day"
+				}
+				if e.Recurring {
+					s += " recurring"
+				}
+				got = append(got, s)
+			}
+			if strings.Join(got, "\n---\n") != strings.Join(tt.want, "\n---\n") {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
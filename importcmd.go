@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/boromil/timesheet/domain"
+	"github.com/boromil/timesheet/importer"
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+// runImport runs the import command: timesheet [flags] import [import flags] <file.csv, file.ics or - for stdin>.
+// It returns the exit code: 0 if all the rows were imported, 2 if some failed and 1 if the import did
+func runImport(ctx context.Context, args []string, sdb slashdb.CRUDer, dbName string) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: timesheet [flags] import [import flags] <file.csv, file.ics or - for stdin>")
+		fs.PrintDefaults()
+	}
+	userID := fs.Int("user-id", 0, "ID of the user the entries are imported for")
+	format := fs.String("format", importer.FormatCSV, "the file format: csv, toggl, harvest, clockify or ics")
+	delimiter := fs.String("delimiter", "", "CSV delimiter, a single character or tab, defaults to a comma")
+	override := importer.Mapping{}
+	fs.StringVar(&override.Date, "date", "", "header of the date column (the csv format defaults to date)")
+	fs.StringVar(&override.Time, "time", "", "header of the start time column, if it's not in the date one (time)")
+	fs.StringVar(&override.Duration, "duration", "", "header of the duration column: decimal hours, h:mm[:ss] or 1h30m (duration)")
+	fs.StringVar(&override.Project, "project", "", "header of the project name column (project)")
+	fs.StringVar(&override.Description, "description", "", "header of the description column (description|accomplishments)")
+	fs.Func("date-layout", "Go time layout of the dates, i.e. 02/01/2006", func(s string) error {
+		override.DateLayouts = []string{s}
+		return nil
+	})
+	fs.Func("time-layout", "Go time layout of the start times, i.e. 3:04 PM", func(s string) error {
+		override.TimeLayouts = []string{s}
+		return nil
+	})
+	projectMap := []string{}
+	fs.Func("project-map", "maps a project name to one of the user project IDs: <name>=<id>, can be repeated", func(s string) error {
+		projectMap = append(projectMap, s)
+		return nil
+	})
+	projectID := fs.Int("project-id", 0, "put all the entries in one of the user projects")
+	sel := importer.Selection{}
+	fs.Func("from", "ics: import the events starting on or after the date (YYYY-MM-DD)", func(s string) (err error) {
+		sel.From, err = time.Parse("2006-01-02", s)
+		return err
+	})
+	fs.Func("to", "ics: import the events starting before the date (YYYY-MM-DD)", func(s string) (err error) {
+		sel.To, err = time.Parse("2006-01-02", s)
+		return err
+	})
+	fs.StringVar(&sel.Keyword, "keyword", "", "ics: import the events with the keyword in their summaries or descriptions")
+	fs.Func("category", "ics: import the events of the category, can be repeated", func(s string) error {
+		sel.Categories = append(sel.Categories, s)
+		return nil
+	})
+	fs.StringVar(&sel.ProjectFrom, "project-from", importer.ProjectFromSummary, "ics: the project names are the event summary or category")
+	dryRun := fs.Bool("dry-run", false, "only validate the file, map the projects and preview the entries")
+	createProjects := fs.Bool("create-projects", true, "create the projects the user doesn't have")
+	batchSize := fs.Int("batch-size", 100, "how many entries to create per SlashDB request")
+	concurrency := fs.Int("concurrency", 4, "how many SlashDB requests to run at once")
+	jsonOut := fs.Bool("json", false, "print the result as JSON")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if *userID <= 0 || fs.NArg() != 1 {
+		fs.Usage()
+		return 1
+	}
+
+	fail := func(err error) int {
+		fmt.Fprintln(os.Stderr, "import failed:", err)
+		return 1
+	}
+	var parse func(io.Reader) ([]domain.ImportRow, []domain.ImportError, error)
+	if *format == importer.FormatICS {
+		if err := sel.Validate(); err != nil {
+			return fail(fmt.Errorf("-project-from: %w", err))
+		}
+		parse = func(r io.Reader) ([]domain.ImportRow, []domain.ImportError, error) {
+			return importer.ParseICS(r, sel)
+		}
+	} else {
+		m, err := importer.Preset(*format)
+		if err != nil {
+			return fail(err)
+		}
+		parse = func(r io.Reader) ([]domain.ImportRow, []domain.ImportError, error) {
+			return importer.Parse(r, m.Override(override))
+		}
+	}
+	switch {
+	case *delimiter == "":
+	case *delimiter == "tab":
+		override.Delimiter = '\t'
+	case utf8.RuneCountInString(*delimiter) == 1:
+		override.Delimiter, _ = utf8.DecodeRuneInString(*delimiter)
+	default:
+		return fail(fmt.Errorf("-delimiter: expected a single character or tab, got %q", *delimiter))
+	}
+	pm, err := importer.ParseProjectMap(projectMap)
+	if err != nil {
+		return fail(fmt.Errorf("-project-map: %w", err))
+	}
+
+	var in io.Reader = os.Stdin
+	if path := fs.Arg(0); path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fail(err)
+		}
+		defer f.Close()
+		in = f
+	}
+	rows, rowErrs, err := parse(in)
+	if err != nil {
+		return fail(err)
+	}
+
+	// Ctrl+C stops the import, the rows not created yet are reported as failed
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+	svc := domain.NewService(sdb, domain.Config{DBName: dbName, ImportBatchSize: *batchSize, ImportConcurrency: *concurrency})
+	result, err := svc.Import(ctx, *userID, domain.ImportInput{
+		Rows:           rows,
+		Errors:         rowErrs,
+		DryRun:         *dryRun,
+		CreateProjects: *createProjects,
+		ProjectMap:     pm,
+		ProjectID:      *projectID,
+	})
+	if err != nil && result.Rows == 0 {
+		return fail(err)
+	}
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(result)
+	} else {
+		printImportResult(os.Stdout, result)
+	}
+	if err != nil {
+		return fail(err)
+	}
+	if result.Failed > 0 {
+		return 2
+	}
+	return 0
+}
+
+// printImportResult prints the import summary, the projects, the dry run preview, the row errors and the warnings
+func printImportResult(w io.Writer, r domain.ImportResult) {
+	verb := "imported"
+	if r.DryRun {
+		verb = "to import (dry run)"
+	}
+	fmt.Fprintf(w, "%d rows: %d %s, %d duplicates, %d failed\n", r.Rows, r.Imported, verb, r.Duplicates, r.Failed)
+	for _, p := range r.Projects {
+		switch {
+		case p.Created && p.ID == 0:
+			fmt.Fprintf(w, "project %q: to be created\n", p.Name)
+		case p.Created:
+			fmt.Fprintf(w, "project %q: created, ID %d\n", p.Name, p.ID)
+		default:
+			fmt.Fprintf(w, "project %q: ID %d\n", p.Name, p.ID)
+		}
+	}
+	for _, p := range r.Preview {
+		state := ""
+		if p.Duplicate {
+			state = " (duplicate)"
+		}
+		fmt.Fprintf(w, "line %d: %s %s %.2fh %q%s\n", p.Line, p.Date.Format("2006-01-02 15:04"), p.Project, p.Duration, p.Accomplishments, state)
+	}
+	for _, e := range r.Errors {
+		field := ""
+		if e.Field != "" {
+			field = e.Field + ": "
+		}
+		fmt.Fprintf(w, "line %d: %s%s\n", e.Line, field, strings.TrimSpace(e.Message))
+	}
+	for _, e := range r.Warnings {
+		fmt.Fprintf(w, "line %d: warning: %s\n", e.Line, e.Message)
+	}
+}
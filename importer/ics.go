@@ -0,0 +1,145 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/boromil/timesheet/domain"
+	"github.com/boromil/timesheet/ical"
+)
+
+// FormatICS is the iCalendar import format
+const FormatICS = "ics"
+
+// what the project names of the calendar events are taken from
+const (
+	ProjectFromSummary  = "summary"
+	ProjectFromCategory = "category"
+)
+
+// Selection - which calendar events are imported and how, the zero values don't filter
+type Selection struct {
+	// From is inclusive, To exclusive, the events are selected by their starts
+	From, To time.Time
+	// Keyword is looked for in the event summaries and descriptions, case insensitive
+	Keyword string
+	// Categories select the events with any of them
+	Categories []string
+	// ProjectFrom is where the project names come from: the event summaries (the default) or their categories
+	// (the first one selected), the descriptions are the accomplishments either way (or the summaries without them)
+	ProjectFrom string
+}
+
+// Validate checks the selection options
+func (s Selection) Validate() error {
+	if s.ProjectFrom != "" && s.ProjectFrom != ProjectFromSummary && s.ProjectFrom != ProjectFromCategory {
+		return fmt.Errorf("unknown project source %q, expected %s or %s", s.ProjectFrom, ProjectFromSummary, ProjectFromCategory)
+	}
+	return nil
+}
+
+// match reports whether the event is selected
+func (s Selection) match(e ical.ParsedEvent) bool {
+	if !s.From.IsZero() && e.Start.Before(s.From) {
+		return false
+	}
+	if !s.To.IsZero() && !e.Start.Before(s.To) {
+		return false
+	}
+	return s.matchText(e)
+}
+
+// matchText reports whether the event matches the keyword and the categories
+func (s Selection) matchText(e ical.ParsedEvent) bool {
+	if kw := strings.ToLower(strings.TrimSpace(s.Keyword)); kw != "" &&
+		!strings.Contains(strings.ToLower(e.Summary), kw) && !strings.Contains(strings.ToLower(e.Description), kw) {
+		return false
+	}
+	return len(s.Categories) == 0 || s.category(e) != ""
+}
+
+// category returns the first of the event categories selected, or its first category without the selection
+func (s Selection) category(e ical.ParsedEvent) string {
+	for _, c := range e.Categories {
+		if len(s.Categories) == 0 {
+			return strings.TrimSpace(c)
+		}
+		for _, want := range s.Categories {
+			if strings.EqualFold(strings.TrimSpace(c), strings.TrimSpace(want)) {
+				return strings.TrimSpace(c)
+			}
+		}
+	}
+	return ""
+}
+
+// ParseICS reads the events of the calendar file matching the selection, the ones it can't import
+// (unreadable, all-day or recurring) are reported as errors, by the lines they start on. The descriptions
+// too long for the accomplishments are cut, with a warning
+func ParseICS(r io.Reader, s Selection) ([]domain.ImportRow, []domain.ImportError, error) {
+	events, err := ical.Parse(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, rowErrs := []domain.ImportRow{}, []domain.ImportError{}
+	for _, e := range events {
+		// the unreadable events can't be selected by their dates, they're reported unless their texts don't match
+		if e.Err != nil {
+			if s.matchText(e) {
+				rowErrs = append(rowErrs, domain.ImportError{Line: e.Line, Message: e.Err.Error()})
+			}
+			continue
+		}
+		if !s.match(e) {
+			continue
+		}
+		switch {
+		case e.AllDay:
+			rowErrs = append(rowErrs, domain.ImportError{Line: e.Line, Field: "date", Message: "an all-day event, it has no start time"})
+			continue
+		case e.Recurring:
+			rowErrs = append(rowErrs, domain.ImportError{
+				Line: e.Line, Field: "date", Message: "a recurring event, only the single events (and the moved occurrences) are imported",
+			})
+			continue
+		}
+
+		row := domain.ImportRow{
+			Line:            e.Line,
+			Project:         strings.TrimSpace(e.Summary),
+			Date:            e.Start,
+			Duration:        math.Round(e.Duration.Hours()*100) / 100,
+			Accomplishments: strings.TrimSpace(e.Description),
+		}
+		if s.ProjectFrom == ProjectFromCategory {
+			row.Project = s.category(e)
+		}
+		if row.Accomplishments == "" {
+			row.Accomplishments = strings.TrimSpace(e.Summary)
+		}
+		if len(row.Accomplishments) > domain.MaxAccomplishmentsLen {
+			// the calendar descriptions run longer than the accomplishments can
+			row.Accomplishments = truncate(row.Accomplishments, domain.MaxAccomplishmentsLen)
+			row.Warning = fmt.Sprintf("the description was cut to %d characters", domain.MaxAccomplishmentsLen)
+		}
+		rows = append(rows, row)
+	}
+	return rows, rowErrs, nil
+}
+
+// truncate cuts s to at most n bytes, ending it with an ellipsis, without breaking the UTF-8 characters
+func truncate(s string, n int) string {
+	const ellipsis = "…"
+	if len(s) <= n {
+		return s
+	}
+	cut := n - len(ellipsis)
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return strings.TrimSpace(s[:cut]) + ellipsis
+}
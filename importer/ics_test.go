@@ -0,0 +1,124 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/boromil/timesheet/domain"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{name: "short", in: "Review", n: 10, want: "Review"},
+		{name: "exactly", in: "0123456789", n: 10, want: "0123456789"},
+		{name: "long", in: "0123456789ab", n: 10, want: "0123456…"},
+		{name: "trailing space", in: "012345 789ab", n: 10, want: "012345…"},
+		{name: "multibyte", in: "zażółć gęślą", n: 10, want: "zażó…"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.in, tt.n)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if len(got) > tt.n || !utf8.ValidString(got) {
+				t.Errorf("got %q, %d bytes", got, len(got))
+			}
+		})
+	}
+}
+
+// event returns the lines of a calendar event starting at the time, lasting an hour
+func event(start, summary, description, categories string) string {
+	lines := []string{"BEGIN:VEVENT", "DTSTART:" + start, "DURATION:PT1H", "SUMMARY:" + summary}
+	if description != "" {
+		lines = append(lines, "DESCRIPTION:"+description)
+	}
+	if categories != "" {
+		lines = append(lines, "CATEGORIES:"+categories)
+	}
+	return strings.Join(append(lines, "END:VEVENT"), "\r\n")
+}
+
+func TestParseICS(t *testing.T) {
+	long := strings.Repeat("a long description ", 10)
+	cal := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		event("20260302T090000Z", "Alpha", "Planning", "Work"),
+		event("20260303T090000Z", "Beta", "", "Work,Client"),
+		event("20260304T090000Z", "Alpha", long, ""),
+		event("20260310T090000Z", "Gamma", "Review", "Home"),
+		"BEGIN:VEVENT\r\nSUMMARY:Broken\r\nEND:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	tests := []struct {
+		name string
+		sel  Selection
+		// want lists the rows as "<line> <project> <date> <duration> <accomplishments>"
+		want     []string
+		wantErrs int
+	}{
+		{
+			name: "all",
+			want: []string{
+				"2 Alpha 2026-03-02 1 Planning",
+				"9 Beta 2026-03-03 1 Beta",
+				"15 Alpha 2026-03-04 1 " + truncate(strings.TrimSpace(long), domain.MaxAccomplishmentsLen),
+				"21 Gamma 2026-03-10 1 Review",
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "the period",
+			sel:  Selection{From: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)},
+			want: []string{
+				"9 Beta 2026-03-03 1 Beta",
+				"15 Alpha 2026-03-04 1 " + truncate(strings.TrimSpace(long), domain.MaxAccomplishmentsLen),
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "a keyword",
+			sel:  Selection{Keyword: "REVIEW"},
+			want: []string{"21 Gamma 2026-03-10 1 Review"},
+		},
+		{
+			name: "the projects from the categories",
+			sel:  Selection{Categories: []string{"client", "home"}, ProjectFrom: ProjectFromCategory},
+			want: []string{"9 Client 2026-03-03 1 Beta", "21 Home 2026-03-10 1 Review"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, rowErrs, err := ParseICS(strings.NewReader(cal), tt.sel)
+			if err != nil {
+				t.Fatalf("ParseICS: %v", err)
+			}
+			got := []string{}
+			for _, r := range rows {
+				got = append(got, fmt.Sprintf("%d %s %s %v %s", r.Line, r.Project, r.Date.Format("2006-01-02"), r.Duration, r.Accomplishments))
+				if len(r.Accomplishments) > domain.MaxAccomplishmentsLen {
+					t.Errorf("line %d: the accomplishments are %d bytes long", r.Line, len(r.Accomplishments))
+				}
+				if cut := r.Line == 15; cut != (r.Warning != "") {
+					t.Errorf("line %d: got warning %q", r.Line, r.Warning)
+				}
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("got rows %q, want %q", got, tt.want)
+			}
+			if len(rowErrs) != tt.wantErrs {
+				t.Errorf("got row errors %v, want %d", rowErrs, tt.wantErrs)
+			}
+		})
+	}
+}
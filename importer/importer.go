@@ -0,0 +1,269 @@
+// Package importer parses the time entries exported by the other trackers (Toggl, Harvest and Clockify),
+// any CSV with its columns mapped or the calendar events, into the rows the domain service imports
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boromil/timesheet/domain"
+)
+
+// import formats
+const (
+	FormatCSV      = "csv"
+	FormatToggl    = "toggl"
+	FormatHarvest  = "harvest"
+	FormatClockify = "clockify"
+)
+
+// Mapping - where the entry fields are in the CSV. The columns are given by their headers (case insensitive),
+// a few of them separated by | are alternatives, the first one with a value is used
+type Mapping struct {
+	Date, Time, Duration, Project, Description string
+	// DateLayouts and TimeLayouts are the Go time layouts the dates and the times are tried with,
+	// the date column can have the time too
+	DateLayouts, TimeLayouts []string
+	Delimiter                rune
+}
+
+// defaultDateLayouts are tried when the mapping has no date layouts
+var defaultDateLayouts = []string{
+	"2006-01-02", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04:05", time.RFC3339,
+}
+
+// presets are the mappings of the tracker exports (their detailed time entry reports)
+var presets = map[string]Mapping{
+	FormatCSV: {
+		Date: "date", Time: "time", Duration: "duration", Project: "project", Description: "description|accomplishments",
+		DateLayouts: defaultDateLayouts, TimeLayouts: []string{"15:04:05", "15:04"},
+	},
+	FormatToggl: {
+		Date: "Start date", Time: "Start time", Duration: "Duration", Project: "Project", Description: "Description|Task",
+		DateLayouts: []string{"2006-01-02"}, TimeLayouts: []string{"15:04:05", "15:04"},
+	},
+	FormatHarvest: {
+		Date: "Date|Spent Date", Duration: "Hours", Project: "Project", Description: "Notes|Task",
+		DateLayouts: []string{"2006-01-02", "01/02/2006"},
+	},
+	FormatClockify: {
+		Date: "Start Date", Time: "Start Time", Duration: "Duration (decimal)|Duration (h)", Project: "Project",
+		Description: "Description|Task",
+		DateLayouts: []string{"01/02/2006", "2006-01-02", "02.01.2006"},
+		TimeLayouts: []string{"03:04:05 PM", "03:04 PM", "15:04:05", "15:04"},
+	},
+}
+
+// Preset returns the mapping of the format
+func Preset(format string) (Mapping, error) {
+	m, ok := presets[format]
+	if !ok {
+		return Mapping{}, fmt.Errorf(
+			"unknown format %q, expected %s, %s, %s, %s or %s", format, FormatCSV, FormatToggl, FormatHarvest, FormatClockify, FormatICS,
+		)
+	}
+	m.Delimiter = ','
+	return m, nil
+}
+
+// Override returns the mapping with the non zero fields of o replacing its own
+func (m Mapping) Override(o Mapping) Mapping {
+	for _, f := range []struct{ dst, src *string }{
+		{&m.Date, &o.Date}, {&m.Time, &o.Time}, {&m.Duration, &o.Duration},
+		{&m.Project, &o.Project}, {&m.Description, &o.Description},
+	} {
+		if *f.src != "" {
+			*f.dst = *f.src
+		}
+	}
+	if len(o.DateLayouts) > 0 {
+		m.DateLayouts = o.DateLayouts
+	}
+	if len(o.TimeLayouts) > 0 {
+		m.TimeLayouts = o.TimeLayouts
+	}
+	if o.Delimiter != 0 {
+		m.Delimiter = o.Delimiter
+	}
+	return m
+}
+
+// ParseProjectMap parses the <project name>=<project ID> items
+func ParseProjectMap(items []string) (map[string]int, error) {
+	m := map[string]int{}
+	for _, item := range items {
+		i := strings.LastIndex(item, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("expected <project name>=<project ID>, got %q", item)
+		}
+		id, err := strconv.Atoi(strings.TrimSpace(item[i+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("expected <project name>=<project ID>, got %q", item)
+		}
+		m[strings.TrimSpace(item[:i])] = id
+	}
+	return m, nil
+}
+
+// columns - the indexes of a mapped field columns
+type columns []int
+
+// value returns the first non empty value of the columns in the record
+func (c columns) value(record []string) string {
+	for _, i := range c {
+		if i < len(record) {
+			if v := strings.TrimSpace(record[i]); v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+// ParseDuration parses the hours as a decimal number (1.5 or 1,5), h:mm[:ss] (1:30) or a Go duration (1h30m)
+func ParseDuration(s string) (float64, error) {
+	switch {
+	case strings.Contains(s, ":"):
+		parts := strings.Split(s, ":")
+		if len(parts) > 3 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		hours := 0.0
+		for i, p := range parts {
+			n, err := strconv.Atoi(p)
+			if err != nil || n < 0 || (i > 0 && n > 59) {
+				return 0, fmt.Errorf("invalid duration %q", s)
+			}
+			hours += float64(n) / [3]float64{1, 60, 3600}[i]
+		}
+		return hours, nil
+	case strings.HasSuffix(s, "h") || strings.HasSuffix(s, "m") || strings.HasSuffix(s, "s"):
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return d.Hours(), nil
+	}
+	hours, err := strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return hours, nil
+}
+
+// parseTime tries the layouts in turn
+func parseTime(s string, layouts []string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("can't parse %q, expected %s", s, strings.Join(layouts, " or "))
+}
+
+// Parse reads the CSV export of the mapping, the rows it can't parse are reported as errors,
+// by the lines they start on. It fails if the mapped columns are missing or the CSV is malformed
+func Parse(r io.Reader, m Mapping) ([]domain.ImportRow, []domain.ImportError, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = m.Delimiter
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, errors.New("the file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading the header: %w", err)
+	}
+	byName := map[string]int{}
+	for i, h := range header {
+		if i == 0 {
+			// the spreadsheets like to start the UTF-8 files with a BOM
+			h = strings.TrimPrefix(h, "\ufeff")
+		}
+		byName[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	// find returns the columns of the alternatives found in the header
+	find := func(spec string, required bool) (columns, error) {
+		c := columns{}
+		for _, name := range strings.Split(spec, "|") {
+			if i, ok := byName[strings.ToLower(strings.TrimSpace(name))]; ok {
+				c = append(c, i)
+			}
+		}
+		if len(c) == 0 && required {
+			return nil, fmt.Errorf("no %q column, the columns are: %s", spec, strings.Join(header, ", "))
+		}
+		return c, nil
+	}
+
+	var dateCol, timeCol, durationCol, projectCol, descriptionCol columns
+	for _, f := range []struct {
+		cols     *columns
+		spec     string
+		required bool
+	}{
+		{&dateCol, m.Date, true}, {&timeCol, m.Time, false}, {&durationCol, m.Duration, true},
+		{&projectCol, m.Project, true}, {&descriptionCol, m.Description, false},
+	} {
+		if *f.cols, err = find(f.spec, f.required); err != nil {
+			return nil, nil, err
+		}
+	}
+	dateLayouts := m.DateLayouts
+	if len(dateLayouts) == 0 {
+		dateLayouts = defaultDateLayouts
+	}
+
+	rows, rowErrs := []domain.ImportRow{}, []domain.ImportError{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading the CSV: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		row := domain.ImportRow{Line: line, Project: projectCol.value(record), Accomplishments: descriptionCol.value(record)}
+		errs := []domain.ImportError{}
+		if v := dateCol.value(record); v == "" {
+			errs = append(errs, domain.ImportError{Line: line, Field: "date", Message: "this field is required"})
+		} else if row.Date, err = parseTime(v, dateLayouts); err != nil {
+			errs = append(errs, domain.ImportError{Line: line, Field: "date", Message: err.Error()})
+		}
+		if v := timeCol.value(record); v != "" && len(errs) == 0 {
+			if t, err := parseTime(v, m.TimeLayouts); err != nil {
+				errs = append(errs, domain.ImportError{Line: line, Field: "time", Message: err.Error()})
+			} else {
+				row.Date = row.Date.Add(time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+					time.Duration(t.Second())*time.Second)
+			}
+		}
+		if v := durationCol.value(record); v == "" {
+			errs = append(errs, domain.ImportError{Line: line, Field: "duration", Message: "this field is required"})
+		} else if row.Duration, err = ParseDuration(v); err != nil {
+			errs = append(errs, domain.ImportError{Line: line, Field: "duration", Message: err.Error()})
+		}
+		if len(errs) > 0 {
+			rowErrs = append(rowErrs, errs...)
+			continue
+		}
+		// the app keeps the hundredths of an hour
+		row.Duration = math.Round(row.Duration*100) / 100
+		rows = append(rows, row)
+	}
+	return rows, rowErrs, nil
+}
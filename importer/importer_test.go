@@ -0,0 +1,160 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{in: "1.5", want: 1.5},
+		{in: "1,5", want: 1.5},
+		{in: "2", want: 2},
+		{in: "1:30", want: 1.5},
+		{in: "0:45:00", want: 0.75},
+		{in: "1h30m", want: 1.5},
+		{in: "90m", want: 1.5},
+		{in: "5400s", want: 1.5},
+		{in: "1:60", wantErr: true},
+		{in: "1:2:3:4", wantErr: true},
+		{in: "-1:30", wantErr: true},
+		{in: "1:3x", wantErr: true},
+		{in: "1x30m", wantErr: true},
+		{in: "soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDuration(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	toggl, _ := Preset(FormatToggl)
+	csv, _ := Preset(FormatCSV)
+	clockify, _ := Preset(FormatClockify)
+	tests := []struct {
+		name    string
+		m       Mapping
+		in      string
+		want    []string
+		wantErr bool
+		// wantRowErrs list the row errors as line:field
+		wantRowErrs []string
+	}{
+		{
+			name: "toggl",
+			m:    toggl,
+			in: "User,Project,Description,Start date,Start time,Duration\n" +
+				"a,Alpha,Planning,2026-03-02,09:15:00,01:30:00\n" +
+				"a,Beta,,2026-03-02,11:00,0:20\n",
+			want: []string{
+				"2 Alpha 2026-03-02T09:15:00Z 1.5 Planning",
+				"3 Beta 2026-03-02T11:00:00Z 0.33 ",
+			},
+		},
+		{
+			name: "alternative columns, a BOM and a blank line",
+			m:    csv,
+			in:   "\ufeffDate;Duration;Project;Accomplishments\n2026-03-02 10:00;1,25;Alpha;Review\n\n2026-03-03;2h;Alpha;Fixes\n",
+			want: []string{
+				"2 Alpha 2026-03-02T10:00:00Z 1.25 Review",
+				"4 Alpha 2026-03-03T00:00:00Z 2 Fixes",
+			},
+		},
+		{
+			name: "the 12 hour clock",
+			m:    clockify,
+			in:   "Project,Description,Start Date,Start Time,Duration (decimal)\nAlpha,Call,03/02/2026,01:30 PM,0.50\n",
+			want: []string{"2 Alpha 2026-03-02T13:30:00Z 0.5 Call"},
+		},
+		{
+			name: "row errors",
+			m:    csv,
+			in: "date,time,duration,project\n" +
+				"2026-03-02,,x,Alpha\n" +
+				",,1,Alpha\n" +
+				"2026-03-02,25:00,1,Alpha\n" +
+				"\"2026-03-02\",,\"1\n\",Alpha\n" +
+				"02/03/2026,,,Alpha\n",
+			want: []string{"5 Alpha 2026-03-02T00:00:00Z 1 "},
+			wantRowErrs: []string{
+				"2:duration", "3:date", "4:time", "7:date", "7:duration",
+			},
+		},
+		{
+			name:    "missing column",
+			m:       csv,
+			in:      "date,hours,project\n2026-03-02,1,Alpha\n",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			m:       csv,
+			in:      "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if strings.Contains(tt.in, ";") {
+				tt.m = tt.m.Override(Mapping{Delimiter: ';'})
+			}
+			rows, rowErrs, err := Parse(strings.NewReader(tt.in), tt.m)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			got := []string{}
+			for _, r := range rows {
+				got = append(got, fmt.Sprintf("%d %s %s %v %s", r.Line, r.Project, r.Date.Format(time.RFC3339), r.Duration, r.Accomplishments))
+			}
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("got rows %q, want %q", got, tt.want)
+			}
+			gotErrs := []string{}
+			for _, e := range rowErrs {
+				gotErrs = append(gotErrs, fmt.Sprintf("%d:%s", e.Line, e.Field))
+			}
+			if strings.Join(gotErrs, ",") != strings.Join(tt.wantRowErrs, ",") {
+				t.Errorf("got row errors %v, want %v", gotErrs, tt.wantRowErrs)
+			}
+		})
+	}
+}
+
+func TestParseProjectMap(t *testing.T) {
+	tests := []struct {
+		in      []string
+		want    map[string]int
+		wantErr bool
+	}{
+		{in: nil, want: map[string]int{}},
+		{in: []string{"Alpha=1", " A=B = 2 "}, want: map[string]int{"Alpha": 1, "A=B": 2}},
+		{in: []string{"=1"}, wantErr: true},
+		{in: []string{"Alpha"}, wantErr: true},
+		{in: []string{"Alpha=x"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, ","), func(t *testing.T) {
+			got, err := ParseProjectMap(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) && !tt.wantErr {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
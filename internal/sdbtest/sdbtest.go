@@ -0,0 +1,416 @@
+// Package sdbtest is an in-memory stand-in for the SlashDB data API, for the tests. It serves the tables of a single DB:
+//
+//	/db/<db>/<table>[/<column>/<value,value...|from..to>]...[/<related table>|/<column,column...>].json
+//
+// with the GET, POST (a row or an array of them), PUT and DELETE methods, the limit, offset and sort parameters
+// and the <null> filter values. The ranges are inclusive, either end can be left out, the values compare
+// as strings (fine for the dates). Nothing matching the filter is a 404, a duplicate key a 409, as SlashDB does
+package sdbtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Null is the filter value matching the nulls
+const Null = "<null>"
+
+// Row - a table row, the numbers are float64 as decoded from JSON
+type Row map[string]interface{}
+
+// Request - a request served, as logged
+type Request struct {
+	Method, Path string
+	Header       http.Header
+	Body         string
+}
+
+// Server - the fake SlashDB
+type Server struct {
+	*httptest.Server
+	// Before is called with every data request, before it's served and outside of the lock,
+	// so it can make requests of its own
+	Before func(r *http.Request)
+	// Other serves the requests outside of the DB, i.e. the custom queries, they're 404 without it
+	Other http.HandlerFunc
+
+	db       string
+	mu       sync.Mutex
+	tables   map[string][]Row
+	autoID   map[string]bool
+	unique   map[string][][]string
+	requests []Request
+}
+
+// New starts a fake SlashDB serving the db, closed at the end of the test. The tables with the generated ids
+// are the app ones (project, client, rate, invoice, invoice_line), the timesheet and the timer have their primary keys
+func New(t testing.TB, db string) *Server {
+	s := &Server{
+		db:     db,
+		tables: map[string][]Row{},
+		autoID: map[string]bool{"project": true, "client": true, "rate": true, "invoice": true, "invoice_line": true},
+		unique: map[string][][]string{
+			"user":      {{"username"}, {"calendar_token"}},
+			"timesheet": {{"user_id", "project_id", "date"}},
+			"timer":     {{"user_id"}},
+			"invoice":   {{"user_id", "number"}},
+		},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Insert adds the rows to the table, as they are
+func (s *Server) Insert(table string, rows ...Row) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range rows {
+		s.tables[table] = append(s.tables[table], normalize(r))
+	}
+}
+
+// Rows returns a copy of the table rows
+func (s *Server) Rows(table string) []Row {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rows := make([]Row, len(s.tables[table]))
+	for i, r := range s.tables[table] {
+		rows[i] = Row{}
+		for k, v := range r {
+			rows[i][k] = v
+		}
+	}
+	return rows
+}
+
+// Requests returns the requests served so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+// normalize makes the integral numbers ints, so the rows compare and print the same however they were made
+func normalize(r Row) Row {
+	out := Row{}
+	for k, v := range r {
+		if f, ok := v.(float64); ok && f == float64(int64(f)) {
+			v = int(f)
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// value formats the row value as it's given in the URLs
+func value(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return Null
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type filter struct {
+	column string
+	values []string
+}
+
+func (f filter) match(r Row) bool {
+	v := value(r[f.column])
+	for _, want := range f.values {
+		if from, to, ok := strings.Cut(want, ".."); ok && r[f.column] != nil {
+			if (from == "" || v >= from) && (to == "" || v <= to) {
+				return true
+			}
+			continue
+		}
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
+
+// less compares the row values, the numbers as numbers
+func less(a, b interface{}) bool {
+	x, xok := number(a)
+	y, yok := number(b)
+	if xok && yok {
+		return x < y
+	}
+	return value(a) < value(b)
+}
+
+func number(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func matchAll(r Row, filters []filter) bool {
+	for _, f := range filters {
+		if !f.match(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeErr(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]interface{}{"http_code": status, "description": msg})
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.RequestURI(), Header: r.Header.Clone(), Body: string(body)})
+	s.mu.Unlock()
+
+	prefix := "/db/" + s.db + "/"
+	path, err := url.PathUnescape(r.URL.EscapedPath())
+	if err != nil || !strings.HasPrefix(path, prefix) {
+		if s.Other != nil {
+			s.Other(w, r)
+			return
+		}
+		writeErr(w, http.StatusNotFound, "no such resource")
+		return
+	}
+	if s.Before != nil {
+		s.Before(r)
+	}
+
+	segs := strings.Split(strings.TrimSuffix(strings.TrimPrefix(path, prefix), ".json"), "/")
+	table, filters, related := segs[0], []filter{}, ""
+	for i := 1; i < len(segs); i += 2 {
+		if i+1 == len(segs) {
+			related = segs[i]
+			break
+		}
+		filters = append(filters, filter{column: segs[i], values: strings.Split(segs[i+1], ",")})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodGet:
+		s.get(w, r, table, filters, related)
+	case http.MethodPost:
+		s.post(w, table, body)
+	case http.MethodPut:
+		s.put(w, table, filters, body)
+	case http.MethodDelete:
+		kept := []Row{}
+		for _, row := range s.tables[table] {
+			if !matchAll(row, filters) {
+				kept = append(kept, row)
+			}
+		}
+		if len(kept) == len(s.tables[table]) {
+			writeErr(w, http.StatusNotFound, "nothing matches")
+			return
+		}
+		s.tables[table] = kept
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeErr(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request, table string, filters []filter, related string) {
+	rows := []Row{}
+	for _, row := range s.tables[table] {
+		if matchAll(row, filters) {
+			rows = append(rows, row)
+		}
+	}
+	// the columns of the table, not another one, select just them
+	var columns []string
+	if len(rows) > 0 && related != "" {
+		if _, ok := rows[0][strings.Split(related, ",")[0]]; ok {
+			columns, related = strings.Split(related, ","), ""
+		}
+	}
+	if related != "" {
+		ids := map[string]bool{}
+		for _, row := range rows {
+			ids[value(row[related+"_id"])] = true
+		}
+		rows = []Row{}
+		for _, row := range s.tables[related] {
+			if ids[value(row["id"])] {
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	q := r.URL.Query()
+	if sortBy := q.Get("sort"); sortBy != "" {
+		sort.SliceStable(rows, func(i, j int) bool {
+			for _, by := range strings.Split(sortBy, ",") {
+				desc := strings.HasPrefix(by, "-")
+				by = strings.TrimPrefix(by, "-")
+				a, b := rows[i][by], rows[j][by]
+				if desc {
+					a, b = b, a
+				}
+				if less(a, b) {
+					return true
+				}
+				if less(b, a) {
+					return false
+				}
+			}
+			return false
+		})
+	}
+	if offset, _ := strconv.Atoi(q.Get("offset")); offset > 0 {
+		rows = rows[min(offset, len(rows)):]
+	}
+	if limit, _ := strconv.Atoi(q.Get("limit")); limit > 0 {
+		rows = rows[:min(limit, len(rows))]
+	}
+	if len(rows) == 0 {
+		writeErr(w, http.StatusNotFound, "nothing matches")
+		return
+	}
+	switch len(columns) {
+	case 0:
+		writeJSON(w, http.StatusOK, rows)
+	case 1:
+		// a single column is an array of its values
+		values := make([]interface{}, len(rows))
+		for i, row := range rows {
+			values[i] = row[columns[0]]
+		}
+		writeJSON(w, http.StatusOK, values)
+	default:
+		selected := make([]Row, len(rows))
+		for i, row := range rows {
+			selected[i] = Row{}
+			for _, c := range columns {
+				selected[i][c] = row[c]
+			}
+		}
+		writeJSON(w, http.StatusOK, selected)
+	}
+}
+
+// duplicate reports if the row has the unique key values of another one, the keys with nulls are never duplicates
+func (s *Server) duplicate(table string, row Row, rows []Row) bool {
+	for _, key := range s.unique[table] {
+		for _, other := range rows {
+			same := true
+			for _, col := range key {
+				if row[col] == nil || value(row[col]) != value(other[col]) {
+					same = false
+					break
+				}
+			}
+			if same {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Server) post(w http.ResponseWriter, table string, body []byte) {
+	rows := []Row{}
+	if err := json.Unmarshal(body, &rows); err != nil {
+		row := Row{}
+		if err := json.Unmarshal(body, &row); err != nil {
+			writeErr(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rows = []Row{row}
+	}
+	created := append([]Row{}, s.tables[table]...)
+	last := ""
+	for _, row := range rows {
+		row = normalize(row)
+		if s.autoID[table] {
+			id := 1
+			for _, other := range created {
+				if n, ok := other["id"].(int); ok && n >= id {
+					id = n + 1
+				}
+			}
+			row["id"] = id
+			if _, ok := row["timestamp"]; !ok {
+				row["timestamp"] = "2026-01-01T00:00:00"
+			}
+		}
+		if s.duplicate(table, row, created) {
+			writeErr(w, http.StatusConflict, "duplicate key")
+			return
+		}
+		created = append(created, row)
+		last = value(row["id"])
+	}
+	s.tables[table] = created
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, "/db/%s/%s/id/%s", s.db, table, last)
+}
+
+func (s *Server) put(w http.ResponseWriter, table string, filters []filter, body []byte) {
+	changes := Row{}
+	if err := json.Unmarshal(body, &changes); err != nil {
+		writeErr(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	changes = normalize(changes)
+	rows := s.tables[table]
+	updated := make([]Row, len(rows))
+	matched := false
+	for i, row := range rows {
+		updated[i] = row
+		if !matchAll(row, filters) {
+			continue
+		}
+		matched = true
+		changed := Row{}
+		for k, v := range row {
+			changed[k] = v
+		}
+		for k, v := range changes {
+			changed[k] = v
+		}
+		others := append(append([]Row{}, updated[:i]...), rows[i+1:]...)
+		if s.duplicate(table, changed, others) {
+			writeErr(w, http.StatusConflict, "duplicate key")
+			return
+		}
+		updated[i] = changed
+	}
+	if !matched {
+		writeErr(w, http.StatusNotFound, "nothing matches")
+		return
+	}
+	s.tables[table] = updated
+	w.WriteHeader(http.StatusNoContent)
+}
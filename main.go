@@ -3,79 +3,255 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"flag"
 	"fmt"
+	"io/fs"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/boromil/timesheet/domain"
 	transport "github.com/boromil/timesheet/transport"
 	"gitlab.com/boromil/goslashdb/slashdb"
 )
 
+// tracingFlushTimeout is how long the last spans get to be exported on shutdown
+const tracingFlushTimeout = 5 * time.Second
+
 func main() {
 	parsedArgs := Parse()
 
+	logger, err := transport.NewLogger(os.Stderr, transport.LogConfig{
+		Format:        parsedArgs.LogFormat,
+		Level:         parsedArgs.LogLevel,
+		RedactHeaders: parsedArgs.LogRedactHeaders,
+		Secrets:       parsedArgs.LogRedactValues,
+	})
+	if err != nil {
+		log.Fatalf("transport.NewLogger: %v", err)
+	}
+	slog.SetDefault(logger)
+
 	appCtx, cancelCtx := context.WithCancel(context.Background())
 	defer cancelCtx()
 
-	signals := make(chan os.Signal, 1)
-	signal.Notify(signals, os.Interrupt)
-
-	s := &http.Server{Addr: parsedArgs.Address, Handler: nil}
-
-	go func() {
-		<-signals
-		cancelCtx()
-
-		if err := s.Shutdown(appCtx); err != nil {
-			log.Printf("error shuting down the server: %v\n", err)
-		}
-	}()
+	shutdownTracing, err := transport.SetupTracing(appCtx, transport.TracingConfig{
+		Exporter:    parsedArgs.TraceExporter,
+		Endpoint:    parsedArgs.TraceEndpoint,
+		Insecure:    parsedArgs.TraceInsecure,
+		ServiceName: "timesheet",
+		SampleRatio: parsedArgs.TraceSampleRatio,
+	}, os.Stdout)
+	if err != nil {
+		fatal(logger, "transport.SetupTracing", err)
+	}
 
-	fmt.Println(AssetNames())
+	// the proxy and the SlashDB client share the transport, so they share the upstream pool
+	// (with its per upstream circuit breakers and health probes) too
+	sdbPool := transport.NewUpstreamPool(
+		parsedArgs.SdbUpstreams,
+		transport.NewTracingRoundTripper(&http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true,
+			},
+			IdleConnTimeout:     time.Second * 10,
+			MaxIdleConns:        30,
+			MaxIdleConnsPerHost: 3,
+		}),
+		transport.PoolConfig{
+			BreakerThreshold: parsedArgs.SdbBreakerThreshold,
+			BreakerCooldown:  parsedArgs.SdbBreakerCooldown,
+			ProbePath:        parsedArgs.SdbProbePath,
+			ProbeInterval:    parsedArgs.SdbProbeInterval,
+			ProbeTimeout:     parsedArgs.SdbTimeout,
+			APIKey:           parsedArgs.SdbAPIKey,
+			APIValue:         parsedArgs.SdbAPIValue,
+		},
+		logger,
+	)
+	go sdbPool.Run(appCtx)
+	upstreamTransport := transport.NewResilientTransport(
+		sdbPool,
+		nil,
+		transport.ResilienceConfig{
+			MaxRetries:     parsedArgs.SdbRetries,
+			BaseBackoff:    time.Millisecond * 100,
+			MaxBackoff:     time.Second * 2,
+			AttemptTimeout: parsedArgs.SdbTimeout,
+		},
+	)
+	externalHTTPClient := &http.Client{Transport: upstreamTransport}
 
-	err := transport.SetupReverseProxy(
-		parsedArgs.SdbDBName,
+	sdbService, err := slashdb.NewService(
 		parsedArgs.SdbInstanceAddr,
 		parsedArgs.SdbAPIKey,
 		parsedArgs.SdbAPIValue,
+		parsedArgs.RefIDPrefix,
+		// the logging doer replaces the echo mode, it doesn't dump the request bodies
+		false,
+		// the status doer lets the domain service tell the SlashDB errors apart
+		domain.NewStatusDoer(transport.NewLoggingDoer(externalHTTPClient, logger, parsedArgs.EchoMode)),
 	)
 	if err != nil {
-		log.Fatalf("transport.SetupReverseProxy: %v", err)
+		fatal(logger, "error initing SlashDB service", err)
 	}
 
-	err = transport.SetupBasicHandlers(parsedArgs.SdbDBName, AssetFile())
-	if err != nil {
-		log.Fatalf("transport.SetupBasicHandlers: %v", err)
+	if flag.Arg(0) == "import" {
+		// the import command runs instead of the server
+		os.Exit(runImport(appCtx, flag.Args()[1:], sdbService, parsedArgs.SdbDBName))
 	}
 
-	externalHTTPClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
+	var cache transport.Cache
+	if parsedArgs.CacheTTL > 0 {
+		cache = transport.NewLRUCache(parsedArgs.CacheMaxEntries)
+	}
+
+	reportQueries := false
+	if parsedArgs.SdbReportQueries {
+		ctx, cancel := context.WithTimeout(appCtx, parsedArgs.SdbTimeout)
+		if err := domain.EnsureReportQueries(ctx, sdbService, parsedArgs.SdbDBName); err != nil {
+			logger.Warn("the report queries are unavailable, the reports are aggregated by the app", slog.String("error", err.Error()))
+		} else {
+			reportQueries = true
+		}
+		cancel()
+	}
+
+	var assets fs.FS = embeddedAssets
+	if parsedArgs.DevMode {
+		logger.Warn("dev mode, serving the assets from the working directory")
+		assets = transport.SubDirs(os.DirFS("."), "assets", "templates")
+	}
+
+	health := transport.NewHealth()
+	for name, state := range sdbPool.States() {
+		health.AddComponent("slashdb "+name, state)
+	}
+	handler, err := transport.NewServer(
+		transport.Config{
+			SdbDBName:       parsedArgs.SdbDBName,
+			SdbInstanceAddr: parsedArgs.SdbInstanceAddr,
+			SdbAPIKey:       parsedArgs.SdbAPIKey,
+			SdbAPIValue:     parsedArgs.SdbAPIValue,
+			CORS: transport.CORSConfig{
+				AllowedOrigins:   parsedArgs.CORSOrigins,
+				AllowedMethods:   parsedArgs.CORSMethods,
+				AllowedHeaders:   parsedArgs.CORSHeaders,
+				AllowCredentials: parsedArgs.CORSCredentials,
+				MaxAge:           parsedArgs.CORSMaxAge,
+			},
+			RateLimit: transport.RateLimitConfig{
+				Auth:           parsedArgs.RateLimitAuth,
+				API:            parsedArgs.RateLimitAPI,
+				TrustedProxies: parsedArgs.TrustedProxies,
+			},
+			Cache:           transport.CacheConfig{TTL: parsedArgs.CacheTTL, MaxBodySize: parsedArgs.CacheMaxBodySize},
+			DevMode:         parsedArgs.DevMode,
+			Proxy:           parsedArgs.SdbProxy,
+			PDFTemplate:     parsedArgs.PDFTemplate,
+			InvoiceTemplate: parsedArgs.InvoiceTemplate,
+			Domain: domain.Config{
+				TimerRounding: parsedArgs.TimerRounding,
+				ReportQueries: reportQueries,
+				InvoicePrefix: parsedArgs.InvoicePrefix,
+			},
+			Auth: transport.AuthConfig{
+				CookieMode:     parsedArgs.AuthCookies,
+				CookieInsecure: parsedArgs.AuthCookieInsecure,
+				SameSite:       parsedArgs.AuthCookieSameSite,
+			},
+			Security: transport.SecurityConfig{
+				CSP:               parsedArgs.CSP,
+				CSPReportOnly:     parsedArgs.CSPReportOnly,
+				FrameAncestors:    parsedArgs.FrameAncestors,
+				ReferrerPolicy:    parsedArgs.ReferrerPolicy,
+				PermissionsPolicy: parsedArgs.PermissionsPolicy,
+			},
+			Limits: transport.LimitsConfig{
+				AuthBodySize: parsedArgs.MaxAuthBodySize,
+				APIBodySize:  parsedArgs.MaxAPIBodySize,
+			},
+			Compression: transport.CompressionConfig{
+				MinSize:      parsedArgs.CompressMinSize,
+				ContentTypes: parsedArgs.CompressTypes,
 			},
-			ResponseHeaderTimeout: time.Second * 10,
-			IdleConnTimeout:       time.Second * 10,
-			MaxIdleConns:          30,
-			MaxIdleConnsPerHost:   3,
 		},
-	}
+		transport.Deps{
+			SdbService: transport.NewCachedService(
+				transport.NewTracedService(sdbService), cache, parsedArgs.CacheTTL,
+			),
+			Cache:  cache,
+			Assets: assets,
+			Logger: logger,
+			Health: health,
 
-	sdbService, err := slashdb.NewService(
-		parsedArgs.SdbInstanceAddr,
-		parsedArgs.SdbAPIKey,
-		parsedArgs.SdbAPIValue,
-		parsedArgs.RefIDPrefix,
-		parsedArgs.EchoMode,
-		externalHTTPClient,
+			UpstreamTransport: upstreamTransport,
+		},
 	)
 	if err != nil {
-		log.Fatalf("error initing SlashDB service: %v\n", err)
+		fatal(logger, "transport.NewServer", err)
 	}
-	transport.Init(sdbService)
 
-	fmt.Printf("Serving on http://%s/app/\n", parsedArgs.Address)
-	log.Fatal(s.ListenAndServe())
+	s := &http.Server{
+		Addr:    parsedArgs.Address,
+		Handler: handler,
+		// the slow clients can't hold the connections forever, there's no write timeout though,
+		// the dev mode reload events and the big listings are streamed
+		ReadHeaderTimeout: parsedArgs.ReadHeaderTimeout,
+		ReadTimeout:       parsedArgs.ReadTimeout,
+		IdleTimeout:       parsedArgs.IdleTimeout,
+		MaxHeaderBytes:    parsedArgs.MaxHeaderBytes,
+	}
+
+	// Heroku and Kubernetes send SIGTERM, SIGINT is what we get from the terminal
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+
+	serverErrs := make(chan error, 1)
+	go func() {
+		logger.Info("serving", slog.String("url", fmt.Sprintf("http://%s/app/", parsedArgs.Address)))
+		serverErrs <- s.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErrs:
+		fatal(logger, "s.ListenAndServe", err)
+	case <-signalCtx.Done():
+	}
+	// restore the default signal behavior, so a second signal kills the app right away
+	stopSignals()
+
+	// first let the load balancer know we're going away, then drain the in-flight requests
+	logger.Info(
+		"shutting down",
+		slog.Duration("delay", parsedArgs.ShutdownDelay),
+		slog.Duration("timeout", parsedArgs.ShutdownTimeout),
+	)
+	health.SetReady(false)
+	time.Sleep(parsedArgs.ShutdownDelay)
+
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), parsedArgs.ShutdownTimeout)
+	defer cancelDrain()
+	if err := s.Shutdown(drainCtx); err != nil {
+		logger.Error("error shuting down the server", slog.String("error", err.Error()))
+	}
+
+	// stop the background workers and flush whatever they have buffered
+	cancelCtx()
+	// the drain may have used up its timeout, the flush gets its own
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), tracingFlushTimeout)
+	defer cancelFlush()
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.Error("error flushing the traces", slog.String("error", err.Error()))
+	}
+	logger.Info("shutdown complete")
+}
+
+func fatal(logger *slog.Logger, msg string, err error) {
+	logger.Error(msg, slog.String("error", err.Error()))
+	os.Exit(1)
 }
@@ -0,0 +1,154 @@
+// Package pdf renders simple documents (text, tables and rules in the standard fonts) as PDF,
+// without any external tools, see Render for the layouts
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// page sizes, in points
+var (
+	A4     = Size{595.28, 841.89}
+	Letter = Size{612, 792}
+)
+
+// Size - the page width and height, in points
+type Size struct {
+	Width, Height float64
+}
+
+// Document - a PDF document being drawn, the pages are kept in memory until WriteTo
+type Document struct {
+	size  Size
+	pages []*bytes.Buffer
+	cur   *bytes.Buffer
+	// Title goes into the document info
+	Title string
+}
+
+// NewDocument returns an empty document with the pages of the size
+func NewDocument(size Size) *Document {
+	return &Document{size: size}
+}
+
+// Size returns the page size
+func (d *Document) Size() Size {
+	return d.size
+}
+
+// AddPage starts a new page, the drawing goes on it
+func (d *Document) AddPage() {
+	d.cur = &bytes.Buffer{}
+	d.pages = append(d.pages, d.cur)
+}
+
+// Pages returns the page count
+func (d *Document) Pages() int {
+	return len(d.pages)
+}
+
+// SetPage moves the drawing back to the page (1 based), i.e. to add the page numbers once they're known
+func (d *Document) SetPage(n int) {
+	d.cur = d.pages[n-1]
+}
+
+// num formats the coordinate, to a hundredth of a point
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
+
+// the coordinates are from the top left corner, PDF has them from the bottom left one
+
+// Text draws s with its baseline at y
+func (d *Document) Text(x, y float64, f Font, size float64, s string) {
+	if s == "" {
+		return
+	}
+	fmt.Fprintf(d.cur, "BT /F%d %s Tf %s %s Td %s Tj ET\n", f+1, num(size), num(x), num(d.size.Height-y), pdfString(s))
+}
+
+// Line draws a line of the width and the gray level (0 is black, 1 white)
+func (d *Document) Line(x1, y1, x2, y2, width, gray float64) {
+	fmt.Fprintf(d.cur, "q %s w %s G %s %s m %s %s l S Q\n",
+		num(width), num(gray), num(x1), num(d.size.Height-y1), num(x2), num(d.size.Height-y2))
+}
+
+// Rect fills the rectangle with the gray level
+func (d *Document) Rect(x, y, w, h, gray float64) {
+	fmt.Fprintf(d.cur, "q %s g %s %s %s %s re f Q\n", num(gray), num(x), num(d.size.Height-y-h), num(w), num(h))
+}
+
+// pdfString returns the PDF literal string of s
+func pdfString(s string) string {
+	b := &bytes.Buffer{}
+	b.WriteByte('(')
+	for _, c := range encode(s) {
+		if c == '(' || c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// WriteTo writes the PDF file
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	out := &bytes.Buffer{}
+	offsets := []int{}
+	// object writes the next object, the IDs go from 1, in the order of the calls
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// 1 the catalog, 2 the page tree, 3 the info, then the fonts and the pages with their contents
+	fontsID := 4
+	pagesID := fontsID + len(baseFonts)
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	kids := &bytes.Buffer{}
+	for i := range d.pages {
+		fmt.Fprintf(kids, "%d 0 R ", pagesID+2*i)
+	}
+	object(fmt.Sprintf("<< /Type /Pages /Kids [ %s] /Count %d >>", kids, len(d.pages)))
+	object(fmt.Sprintf("<< /Title %s /Producer (timesheet) /CreationDate (D:%s) >>",
+		pdfString(d.Title), time.Now().UTC().Format("20060102150405Z")))
+
+	fonts := &bytes.Buffer{}
+	for i, name := range baseFonts {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+		fmt.Fprintf(fonts, "/F%d %d 0 R ", i+1, fontsID+i)
+	}
+
+	for i, page := range d.pages {
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s>> >> /Contents %d 0 R >>",
+			num(d.size.Width), num(d.size.Height), fonts, pagesID+2*i+1,
+		))
+		content := &bytes.Buffer{}
+		zw := zlib.NewWriter(content)
+		zw.Write(page.Bytes())
+		if err := zw.Close(); err != nil {
+			return 0, err
+		}
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", content.Len(), content))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, o := range offsets {
+		fmt.Fprintf(out, "%010d 00000 n \n", o)
+	}
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root 1 0 R /Info 3 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return out.WriteTo(w)
+}
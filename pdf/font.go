@@ -0,0 +1,148 @@
+package pdf
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Font - one of the PDF standard fonts, they need no embedding
+type Font int
+
+// the fonts
+const (
+	Helvetica Font = iota
+	HelveticaBold
+	HelveticaOblique
+)
+
+// baseFonts are the PDF names of the fonts
+var baseFonts = []string{"Helvetica", "Helvetica-Bold", "Helvetica-Oblique"}
+
+// the character widths (in 1/1000 of the font size) of the ASCII characters from the space on, by the font,
+// the oblique one shares them with the regular one
+var (
+	helveticaWidths = [95]int{
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+	}
+	helveticaBoldWidths = [95]int{
+		278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+		975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+		333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+		611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+	}
+)
+
+// winAnsi maps the characters WinAnsiEncoding has outside of Latin-1 to their codes
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, '‰': 0x89, 'Š': 0x8a, '‹': 0x8b,
+	'Œ': 0x8c, 'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+	'™': 0x99, 'š': 0x9a, '›': 0x9b, 'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// strokes are the letters that don't decompose into a base letter and a diacritic
+var strokes = map[rune]rune{'ł': 'l', 'Ł': 'L', 'đ': 'd', 'Đ': 'D', 'ħ': 'h', 'Ħ': 'H', 'ı': 'i'}
+
+// encodeRune returns the WinAnsiEncoding code of r, if it has one
+func encodeRune(r rune) (byte, bool) {
+	switch {
+	case r >= 0x20 && r < 0x7f, r >= 0xa0 && r <= 0xff:
+		return byte(r), true
+	case winAnsi[r] != 0:
+		return winAnsi[r], true
+	}
+	return 0, false
+}
+
+// encode returns the WinAnsiEncoding bytes of s, the letters it lacks are replaced with their base ones
+// (i.e. ż with z), the other characters it can't encode with question marks
+func encode(s string) []byte {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r == '\t' {
+			r = ' '
+		}
+		if c, ok := encodeRune(r); ok {
+			b = append(b, c)
+			continue
+		}
+		base, ok := strokes[r]
+		if !ok {
+			base, _ = utf8.DecodeRuneInString(norm.NFD.String(string(r)))
+		}
+		if c, ok := encodeRune(base); ok {
+			b = append(b, c)
+		} else {
+			b = append(b, '?')
+		}
+	}
+	return b
+}
+
+// charWidth returns the width of the encoded character, the ones beyond ASCII get the average one
+func (f Font) charWidth(c byte) int {
+	if c < 0x20 || c > 0x7e {
+		return 556
+	}
+	if f == HelveticaBold {
+		return helveticaBoldWidths[c-0x20]
+	}
+	return helveticaWidths[c-0x20]
+}
+
+// Width returns the width of s set in the font of the size
+func (f Font) Width(s string, size float64) float64 {
+	w := 0
+	for _, c := range encode(s) {
+		w += f.charWidth(c)
+	}
+	return float64(w) * size / 1000
+}
+
+// Wrap breaks s into the lines fitting in the width, the words too long for a line are split
+func (f Font) Wrap(s string, size, width float64) []string {
+	lines := []string{}
+	for _, para := range bytes.Split([]byte(s), []byte("\n")) {
+		line := ""
+		for _, word := range bytes.Fields(para) {
+			w := string(word)
+			candidate := w
+			if line != "" {
+				candidate = line + " " + w
+			}
+			if f.Width(candidate, size) <= width {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			// split the overlong word by characters
+			for f.Width(w, size) > width && utf8.RuneCountInString(w) > 1 {
+				n := 1
+				for i := range w {
+					if i > 0 && f.Width(w[:i], size) > width {
+						break
+					}
+					n = i
+				}
+				if n == 0 {
+					_, n = utf8.DecodeRuneInString(w)
+				}
+				lines = append(lines, w[:n])
+				w = w[n:]
+			}
+			line = w
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
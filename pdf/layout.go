@@ -0,0 +1,389 @@
+package pdf
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// node - an element of the layout
+type node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Text    string     `xml:",chardata"`
+	Nodes   []node     `xml:",any"`
+}
+
+func (n node) attr(name, def string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return def
+}
+
+func (n node) float(name string, def float64) (float64, error) {
+	v := n.attr(name, "")
+	if v == "" {
+		return def, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("<%s %s=%q>: expected a positive number", n.XMLName.Local, name, v)
+	}
+	return f, nil
+}
+
+// text returns the element text with the whitespace collapsed, as the template indentation isn't meant to show
+func (n node) text() string {
+	return strings.Join(strings.Fields(n.Text), " ")
+}
+
+func (n node) font() Font {
+	switch {
+	case n.attr("bold", "") == "true":
+		return HelveticaBold
+	case n.attr("italic", "") == "true":
+		return HelveticaOblique
+	}
+	return Helvetica
+}
+
+// layout - the layout being drawn into the document
+type layout struct {
+	doc            *Document
+	margin, size   float64
+	y, left, width float64
+	footer         *node
+}
+
+const (
+	// lineSpacing is the line height, relative to the font size
+	lineSpacing = 1.35
+	// cellPadding is the horizontal and vertical table cell padding
+	cellPadding = 4
+)
+
+// Render draws the XML layout and writes the PDF to w, nothing is written if the layout is invalid.
+// The layout is a <document size="A4|letter" margin="40" font-size="10" title="..."> with the blocks:
+//
+//	<heading size="16">...</heading>
+//	<text size="" bold="true" italic="true" align="left|center|right">...</text>
+//	<fields><field label="Period">...</field></fields>
+//	<table><column width="80|*" align="left|right">Date</column><row style="subtotal|total"><cell>...</cell></row></table>
+//	<signatures><signature>Employee</signature></signatures>
+//	<space height="12"/>, <rule/> and <page-break/>
+//	<footer align="center">Page {page} of {pages}</footer>, drawn on every page
+//
+// The tables are split over pages, repeating the column titles
+func Render(w io.Writer, r io.Reader) error {
+	root := node{}
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return fmt.Errorf("error parsing the layout: %w", err)
+	}
+	if root.XMLName.Local != "document" {
+		return fmt.Errorf("the layout root is <%s>, expected <document>", root.XMLName.Local)
+	}
+
+	size := A4
+	switch s := strings.ToLower(root.attr("size", "a4")); s {
+	case "a4":
+	case "letter":
+		size = Letter
+	default:
+		return fmt.Errorf("unknown page size %q, expected A4 or letter", s)
+	}
+	l := &layout{doc: NewDocument(size)}
+	var err error
+	if l.margin, err = root.float("margin", 40); err != nil {
+		return err
+	}
+	if l.size, err = root.float("font-size", 10); err != nil {
+		return err
+	}
+	l.doc.Title = root.attr("title", "")
+	l.left, l.width = l.margin, size.Width-2*l.margin
+	if l.width <= 0 {
+		return fmt.Errorf("the margins leave no room for the content")
+	}
+
+	for i, n := range root.Nodes {
+		if n.XMLName.Local == "footer" {
+			l.footer = &root.Nodes[i]
+		}
+	}
+	l.newPage()
+	for _, n := range root.Nodes {
+		if err := l.block(n); err != nil {
+			return err
+		}
+	}
+	if l.footer != nil {
+		l.drawFooter()
+	}
+	_, err = l.doc.WriteTo(w)
+	return err
+}
+
+// bottom returns the lowest y the content can reach
+func (l *layout) bottom() float64 {
+	b := l.doc.Size().Height - l.margin
+	if l.footer != nil {
+		b -= 2 * l.size
+	}
+	return b
+}
+
+func (l *layout) newPage() {
+	l.doc.AddPage()
+	l.y = l.margin
+}
+
+// fit starts a new page unless there's h of room left on this one
+func (l *layout) fit(h float64) {
+	if l.y+h > l.bottom() && l.y > l.margin {
+		l.newPage()
+	}
+}
+
+// alignedX returns the x of the text of width tw, aligned in the w wide box at x
+func alignedX(align string, x, w, tw float64) float64 {
+	switch align {
+	case "right":
+		return x + w - tw
+	case "center":
+		return x + (w-tw)/2
+	}
+	return x
+}
+
+func (l *layout) block(n node) error {
+	switch n.XMLName.Local {
+	case "footer":
+		// drawn at the end, once the page count is known
+		return nil
+	case "heading":
+		size, err := n.float("size", l.size*1.6)
+		if err != nil {
+			return err
+		}
+		l.paragraph(n.text(), HelveticaBold, size, n.attr("align", "left"))
+		l.y += size / 2
+	case "text":
+		size, err := n.float("size", l.size)
+		if err != nil {
+			return err
+		}
+		l.paragraph(n.text(), n.font(), size, n.attr("align", "left"))
+	case "space":
+		h, err := n.float("height", l.size)
+		if err != nil {
+			return err
+		}
+		l.y += h
+	case "rule":
+		l.fit(l.size)
+		l.y += l.size / 2
+		l.doc.Line(l.left, l.y, l.left+l.width, l.y, 0.5, 0)
+		l.y += l.size / 2
+	case "page-break":
+		l.newPage()
+	case "fields":
+		return l.fields(n)
+	case "table":
+		return l.table(n)
+	case "signatures":
+		return l.signatures(n)
+	default:
+		return fmt.Errorf("unknown layout element <%s>", n.XMLName.Local)
+	}
+	return nil
+}
+
+// paragraph draws the wrapped text
+func (l *layout) paragraph(s string, f Font, size float64, align string) {
+	lh := size * lineSpacing
+	for _, line := range f.Wrap(s, size, l.width) {
+		l.fit(lh)
+		l.y += lh
+		l.doc.Text(alignedX(align, l.left, l.width, f.Width(line, size)), l.y-(lh-size), f, size, line)
+	}
+}
+
+// fields draws the labels (in bold) and their values side by side
+func (l *layout) fields(n node) error {
+	labelWidth := 0.0
+	for _, field := range n.Nodes {
+		if field.XMLName.Local != "field" {
+			return fmt.Errorf("unknown <fields> element <%s>", field.XMLName.Local)
+		}
+		labelWidth = max(labelWidth, HelveticaBold.Width(field.attr("label", ""), l.size))
+	}
+	labelWidth += l.size
+	lh := l.size * lineSpacing
+	for _, field := range n.Nodes {
+		lines := Helvetica.Wrap(field.text(), l.size, l.width-labelWidth)
+		l.fit(lh * float64(len(lines)))
+		l.doc.Text(l.left, l.y+l.size, HelveticaBold, l.size, field.attr("label", ""))
+		for _, line := range lines {
+			l.doc.Text(l.left+labelWidth, l.y+l.size, Helvetica, l.size, line)
+			l.y += lh
+		}
+	}
+	return nil
+}
+
+// column - a table column
+type column struct {
+	title string
+	align string
+	width float64
+}
+
+func (l *layout) table(n node) error {
+	cols := []column{}
+	rows := []node{}
+	fixed, flex := 0.0, 0
+	for _, c := range n.Nodes {
+		switch c.XMLName.Local {
+		case "column":
+			col := column{title: c.text(), align: c.attr("align", "left")}
+			if w := c.attr("width", "*"); w != "*" {
+				var err error
+				if col.width, err = c.float("width", 0); err != nil {
+					return err
+				}
+				if col.width == 0 {
+					return fmt.Errorf("<column width=%q>: expected a positive number or *", w)
+				}
+				fixed += col.width
+			} else {
+				flex++
+			}
+			cols = append(cols, col)
+		case "row":
+			rows = append(rows, c)
+		default:
+			return fmt.Errorf("unknown <table> element <%s>", c.XMLName.Local)
+		}
+	}
+	if len(cols) == 0 {
+		return fmt.Errorf("the <table> has no columns")
+	}
+	if fixed > l.width || (flex > 0 && fixed == l.width) {
+		return fmt.Errorf("the <table> columns are wider than the page")
+	}
+	for i := range cols {
+		if cols[i].width == 0 {
+			cols[i].width = (l.width - fixed) / float64(flex)
+		}
+	}
+
+	header := make([]string, len(cols))
+	for i, c := range cols {
+		header[i] = c.title
+	}
+	l.fit(4 * l.size * lineSpacing)
+	l.tableRow(cols, header, "header")
+	for _, row := range rows {
+		cells := []string{}
+		for _, c := range row.Nodes {
+			if c.XMLName.Local != "cell" {
+				return fmt.Errorf("unknown <row> element <%s>", c.XMLName.Local)
+			}
+			cells = append(cells, c.text())
+		}
+		if len(cells) > len(cols) {
+			return fmt.Errorf("a <row> has %d cells, the <table> %d columns", len(cells), len(cols))
+		}
+		if h := l.rowHeight(cols, cells, row.attr("style", "")); l.y+h > l.bottom() {
+			l.newPage()
+			l.tableRow(cols, header, "header")
+		}
+		l.tableRow(cols, cells, row.attr("style", ""))
+	}
+	l.y += l.size / 2
+	return nil
+}
+
+// rowFont returns the font of the table row style
+func rowFont(style string) Font {
+	if style == "" {
+		return Helvetica
+	}
+	return HelveticaBold
+}
+
+func (l *layout) rowHeight(cols []column, cells []string, style string) float64 {
+	lines := 1
+	for i, c := range cells {
+		lines = max(lines, len(rowFont(style).Wrap(c, l.size, cols[i].width-2*cellPadding)))
+	}
+	return float64(lines)*l.size*lineSpacing + cellPadding
+}
+
+// tableRow draws a row of the style: header, subtotal, total or "" (the plain ones)
+func (l *layout) tableRow(cols []column, cells []string, style string) {
+	f := rowFont(style)
+	h := l.rowHeight(cols, cells, style)
+	switch style {
+	case "header":
+		l.doc.Rect(l.left, l.y, l.width, h, 0.85)
+	case "subtotal":
+		l.doc.Rect(l.left, l.y, l.width, h, 0.95)
+	case "total":
+		l.doc.Line(l.left, l.y, l.left+l.width, l.y, 1, 0)
+	}
+
+	x := l.left
+	for i, c := range cols {
+		if i < len(cells) {
+			for j, line := range f.Wrap(cells[i], l.size, c.width-2*cellPadding) {
+				baseline := l.y + cellPadding/2 + float64(j)*l.size*lineSpacing + l.size
+				l.doc.Text(alignedX(c.align, x+cellPadding, c.width-2*cellPadding, f.Width(line, l.size)), baseline, f, l.size, line)
+			}
+		}
+		x += c.width
+	}
+	l.y += h
+	l.doc.Line(l.left, l.y, l.left+l.width, l.y, 0.5, 0.6)
+}
+
+// signatures draws the signature lines side by side, with their labels under them
+func (l *layout) signatures(n node) error {
+	for _, s := range n.Nodes {
+		if s.XMLName.Local != "signature" {
+			return fmt.Errorf("unknown <signatures> element <%s>", s.XMLName.Local)
+		}
+	}
+	if len(n.Nodes) == 0 {
+		return nil
+	}
+	gap := 2 * l.size
+	w := (l.width - gap*float64(len(n.Nodes)-1)) / float64(len(n.Nodes))
+	l.fit(6 * l.size)
+	l.y += 4 * l.size
+	for i, s := range n.Nodes {
+		x := l.left + float64(i)*(w+gap)
+		l.doc.Line(x, l.y, x+w, l.y, 0.5, 0)
+		label := s.text()
+		l.doc.Text(alignedX("center", x, w, Helvetica.Width(label, l.size*0.9)), l.y+l.size*1.2, Helvetica, l.size*0.9, label)
+	}
+	l.y += 2 * l.size
+	return nil
+}
+
+// drawFooter draws the footer on every page, with the {page} and {pages} placeholders replaced
+func (l *layout) drawFooter() {
+	pages := l.doc.Pages()
+	size := l.size * 0.8
+	for p := 1; p <= pages; p++ {
+		l.doc.SetPage(p)
+		s := strings.NewReplacer("{page}", strconv.Itoa(p), "{pages}", strconv.Itoa(pages)).Replace(l.footer.text())
+		y := l.doc.Size().Height - l.margin + size
+		l.doc.Text(alignedX(l.footer.attr("align", "center"), l.left, l.width, Helvetica.Width(s, size)), y, Helvetica, size, s)
+	}
+}
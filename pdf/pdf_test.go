@@ -0,0 +1,234 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// parsedPDF - the parts of a rendered PDF the tests check
+type parsedPDF struct {
+	// objects are the object bodies, by their ID
+	objects map[int]string
+	title   string
+	// texts are the strings drawn on each page
+	texts [][]string
+}
+
+var (
+	refRe      = regexp.MustCompile(`(\d+) 0 R`)
+	lengthRe   = regexp.MustCompile(`^<< /Length (\d+)`)
+	contentsRe = regexp.MustCompile(`/Contents (\d+) 0 R`)
+	textRe     = regexp.MustCompile(`\(((?:\\.|[^\\)])*)\) Tj`)
+	titleRe    = regexp.MustCompile(`/Title \(((?:\\.|[^\\)])*)\)`)
+	escapeRe   = regexp.MustCompile(`\\(.)`)
+)
+
+// unescape returns the bytes of the PDF literal string body
+func unescape(s string) string {
+	return escapeRe.ReplaceAllString(s, "$1")
+}
+
+// parsePDF reads the PDF through its cross-reference table, from the trailer to the page contents
+func parsePDF(t *testing.T, data []byte) parsedPDF {
+	t.Helper()
+	s := string(data)
+	if !strings.HasPrefix(s, "%PDF-1.4\n") || !strings.HasSuffix(s, "%%EOF\n") {
+		t.Fatalf("got %.20q...%q, want a PDF 1.4 file", s, s[max(0, len(s)-10):])
+	}
+	i := strings.LastIndex(s, "startxref\n")
+	if i < 0 {
+		t.Fatalf("no startxref")
+	}
+	xref, err := strconv.Atoi(strings.Fields(s[i+len("startxref\n"):])[0])
+	if err != nil || !strings.HasPrefix(s[xref:], "xref\n0 ") {
+		t.Fatalf("startxref %d doesn't point at the xref table: %v", xref, err)
+	}
+	var count int
+	if _, err := fmt.Sscanf(s[xref:], "xref\n0 %d\n", &count); err != nil {
+		t.Fatalf("error reading the xref table size: %v", err)
+	}
+	entries := s[xref+strings.Index(s[xref:], "0000000000 65535 f \n"):]
+	if !strings.Contains(s, fmt.Sprintf("/Size %d /Root 1 0 R", count)) {
+		t.Errorf("got no trailer with /Size %d and the root", count)
+	}
+
+	p := parsedPDF{objects: map[int]string{}}
+	for id := 1; id < count; id++ {
+		// the entries are 20 bytes each, the first one is the free one
+		offset, err := strconv.Atoi(entries[id*20 : id*20+10])
+		if err != nil {
+			t.Fatalf("error reading the xref entry %d: %v", id, err)
+		}
+		head := fmt.Sprintf("%d 0 obj\n", id)
+		if !strings.HasPrefix(s[offset:], head) {
+			t.Fatalf("the xref entry %d points at %.20q", id, s[offset:])
+		}
+		body := s[offset+len(head):]
+		if m := lengthRe.FindStringSubmatch(body); m != nil {
+			// the stream length comes first, the stream may have anything in it
+			n, _ := strconv.Atoi(m[1])
+			start := strings.Index(body, "stream\n") + len("stream\n")
+			if !strings.HasPrefix(body[start+n:], "\nendstream\nendobj\n") {
+				t.Fatalf("the object %d stream isn't %d bytes long", id, n)
+			}
+			p.objects[id] = body[:start+n]
+			continue
+		}
+		end := strings.Index(body, "\nendobj\n")
+		if end < 0 {
+			t.Fatalf("the object %d has no end", id)
+		}
+		p.objects[id] = body[:end]
+	}
+
+	if m := titleRe.FindStringSubmatch(p.objects[3]); m != nil {
+		p.title = unescape(m[1])
+	}
+	if !strings.Contains(p.objects[1], "/Type /Catalog /Pages 2 0 R") {
+		t.Fatalf("got the catalog %q", p.objects[1])
+	}
+	kids := refRe.FindAllStringSubmatch(p.objects[2], -1)
+	if !strings.Contains(p.objects[2], fmt.Sprintf("/Count %d", len(kids))) {
+		t.Fatalf("got the page tree %q, want the count of its kids", p.objects[2])
+	}
+	for _, kid := range kids {
+		id, _ := strconv.Atoi(kid[1])
+		page := p.objects[id]
+		if !strings.Contains(page, "/Type /Page /Parent 2 0 R") {
+			t.Fatalf("got the page %d %q", id, page)
+		}
+		m := contentsRe.FindStringSubmatch(page)
+		if m == nil {
+			t.Fatalf("the page %d has no contents", id)
+		}
+		cid, _ := strconv.Atoi(m[1])
+		stream := p.objects[cid]
+		zr, err := zlib.NewReader(strings.NewReader(stream[strings.Index(stream, "stream\n")+len("stream\n"):]))
+		if err != nil {
+			t.Fatalf("the page %d contents: %v", id, err)
+		}
+		content, err := io.ReadAll(zr)
+		if err != nil {
+			t.Fatalf("the page %d contents: %v", id, err)
+		}
+		texts := []string{}
+		for _, m := range textRe.FindAllStringSubmatch(string(content), -1) {
+			texts = append(texts, unescape(m[1]))
+		}
+		p.texts = append(p.texts, texts)
+	}
+	return p
+}
+
+// contains reports whether the page texts have s
+func contains(texts []string, s string) bool {
+	for _, t := range texts {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRender(t *testing.T) {
+	rows := &strings.Builder{}
+	for i := 1; i <= 100; i++ {
+		fmt.Fprintf(rows, "<row><cell>2026-03-%02d</cell><cell>task %d</cell><cell>1.00</cell></row>", i%28+1, i)
+	}
+	layout := `<document size="A4" title="Timesheet (March)">
+		<heading>Timesheet</heading>
+		<fields><field label="Name">Jan (the \ one)</field></fields>
+		<table>
+			<column width="70">Date</column><column>Accomplishments</column><column width="55" align="right">Hours</column>
+			` + rows.String() + `
+			<row style="total"><cell>Total</cell><cell></cell><cell>100.00</cell></row>
+		</table>
+		<footer>page {page} of {pages}</footer>
+	</document>`
+
+	out := &bytes.Buffer{}
+	if err := Render(out, strings.NewReader(layout)); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	p := parsePDF(t, out.Bytes())
+
+	if p.title != "Timesheet (March)" {
+		t.Errorf("got the title %q, want Timesheet (March)", p.title)
+	}
+	if len(p.texts) < 2 {
+		t.Fatalf("got %d pages, want the table split over several", len(p.texts))
+	}
+	if !contains(p.texts[0], "Timesheet") || !contains(p.texts[0], `Jan (the \ one)`) {
+		t.Errorf("got the first page texts %q, want the heading and the escaped field", p.texts[0])
+	}
+	drawn := 0
+	for i, texts := range p.texts {
+		// the column titles are repeated on every page
+		if !contains(texts, "Accomplishments") {
+			t.Errorf("the page %d has no column titles", i+1)
+		}
+		if want := fmt.Sprintf("page %d of %d", i+1, len(p.texts)); !contains(texts, want) {
+			t.Errorf("the page %d has no footer %q", i+1, want)
+		}
+		for _, s := range texts {
+			if strings.HasPrefix(s, "task ") {
+				drawn++
+			}
+		}
+	}
+	if drawn != 100 {
+		t.Errorf("got %d rows drawn, want 100", drawn)
+	}
+	if last := p.texts[len(p.texts)-1]; !contains(last, "100.00") {
+		t.Errorf("got the last page texts %q, want the total", last)
+	}
+}
+
+func TestRenderInvalid(t *testing.T) {
+	tests := []struct {
+		name, layout string
+	}{
+		{name: "not XML", layout: `<document>`},
+		{name: "other root", layout: `<html></html>`},
+		{name: "page size", layout: `<document size="A3"></document>`},
+		{name: "margins", layout: `<document margin="400"></document>`},
+		{name: "element", layout: `<document><image/></document>`},
+		{name: "no columns", layout: `<document><table></table></document>`},
+		{name: "too many cells", layout: `<document><table><column>A</column><row><cell>1</cell><cell>2</cell></row></table></document>`},
+		{name: "too wide", layout: `<document><table><column width="600">A</column></table></document>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			if err := Render(out, strings.NewReader(tt.layout)); err == nil {
+				t.Fatalf("got no error, want one")
+			}
+			if out.Len() > 0 {
+				t.Errorf("got %d bytes written, want none", out.Len())
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain", "plain"},
+		{"café €5", "caf\xe9 \x805"},
+		{"żółw", "z\xf3lw"},
+		{"日", "?"},
+		{"a\tb", "a b"},
+	}
+	for _, tt := range tests {
+		if got := string(encode(tt.in)); got != tt.want {
+			t.Errorf("encode(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -1,20 +1,10 @@
+DROP TABLE IF EXISTS invoice_line;
+DROP TABLE IF EXISTS rate;
+DROP TABLE IF EXISTS timer;
 DROP TABLE IF EXISTS timesheet;
-
+DROP TABLE IF EXISTS invoice;
 DROP TABLE IF EXISTS project;
-CREATE TABLE `project` (
-  `id` int(11) NOT NULL AUTO_INCREMENT,
-  `name` varchar(50) NOT NULL,
-  `description` varchar(150) DEFAULT NULL,
-  `timestamp` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
-  PRIMARY KEY (`id`),
-  UNIQUE KEY `project_id_uindex` (`id`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8;
-
-INSERT INTO project (id, name, description)
-VALUES
-  (1, 'My Home Project', 'small home project'),
-  (2, 'Website for John', 'John wants a website for his business'),
-  (3, 'Build RESTful API for new app', 'project manager wants a RESTful API for the new app');
+DROP TABLE IF EXISTS client;
 
 DROP TABLE IF EXISTS user;
 CREATE TABLE `user` (
@@ -22,26 +12,137 @@ CREATE TABLE `user` (
   `username` varchar(35) NOT NULL,
   `email` varchar(50) DEFAULT NULL,
   `passwd` varchar(150) NOT NULL,
+  `calendar_token` char(43) DEFAULT NULL,
   PRIMARY KEY (`id`),
   UNIQUE KEY `user_id_uindex` (`id`),
-  UNIQUE KEY `user_username_uindex` (`username`)
+  UNIQUE KEY `user_username_uindex` (`username`),
+  UNIQUE KEY `user_calendar_token_uindex` (`calendar_token`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 
 # user: slashdb, password: slashdb
 INSERT INTO user (id, username, description, email,passwd)
 VALUES (1, 'slashdb', 'slashdb@vtenterprise.com', '3514555726a77ab19eb675b499141dc6c407680a56c42b6d3411fc598b3ff97c');
 
+# user_id is the user who manages the client
+CREATE TABLE `client` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `name` varchar(50) NOT NULL,
+  `currency` char(3) NOT NULL,
+  `timestamp` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+INSERT INTO client (id, user_id, name, currency)
+VALUES
+  (1, 1, 'John', 'USD');
+
+# user_id is the owner, the user who created the project, only they can change the client and the rates of a shared project
+CREATE TABLE `project` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `name` varchar(50) NOT NULL,
+  `description` varchar(150) DEFAULT NULL,
+  `client_id` int(11) DEFAULT NULL,
+  `user_id` int(11) DEFAULT NULL,
+  `timestamp` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `project_id_uindex` (`id`),
+  FOREIGN KEY (`client_id`) REFERENCES `client` (`id`),
+  FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+INSERT INTO project (id, name, description, client_id, user_id)
+VALUES
+  (1, 'My Home Project', 'small home project', NULL, 1),
+  (2, 'Website for John', 'John wants a website for his business', 1, 1),
+  (3, 'Build RESTful API for new app', 'project manager wants a RESTful API for the new app', NULL, 1);
+
+# user_id is the user who invoices, the number is given on issuing, the client name is kept as it was invoiced
+CREATE TABLE `invoice` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `client_id` int(11) NOT NULL,
+  `client_name` varchar(50) NOT NULL,
+  `number` varchar(40) DEFAULT NULL,
+  `status` enum('draft','issued') NOT NULL DEFAULT 'draft',
+  `period_from` date NOT NULL,
+  `period_to` date NOT NULL,
+  `currency` char(3) NOT NULL,
+  `subtotal` decimal(12,2) NOT NULL,
+  `discount_percent` decimal(5,2) NOT NULL DEFAULT 0,
+  `discount` decimal(12,2) NOT NULL DEFAULT 0,
+  `tax_percent` decimal(5,2) NOT NULL DEFAULT 0,
+  `tax` decimal(12,2) NOT NULL DEFAULT 0,
+  `total` decimal(12,2) NOT NULL,
+  `due_date` date DEFAULT NULL,
+  `notes` varchar(500) DEFAULT NULL,
+  `issued_at` datetime DEFAULT NULL,
+  `timestamp` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `invoice_user_number_uindex` (`user_id`,`number`),
+  FOREIGN KEY (`user_id`) REFERENCES `user` (`id`),
+  FOREIGN KEY (`client_id`) REFERENCES `client` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+# invoice_id is set on the invoiced entries, they can't be changed then
 CREATE TABLE `timesheet` (
   `user_id` int(11) NOT NULL,
   `project_id` int(11) NOT NULL,
   `date` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `duration` double NOT NULL,
   `accomplishments` varchar(150) DEFAULT NULL,
+  `invoice_id` int(11) DEFAULT NULL,
   PRIMARY KEY (`user_id`,`project_id`,`date`),
+  KEY `timesheet_invoice_id_index` (`invoice_id`),
+  FOREIGN KEY (`user_id`) REFERENCES `user` (`id`),
+  FOREIGN KEY (`project_id`) REFERENCES `project` (`id`),
+  FOREIGN KEY (`invoice_id`) REFERENCES `invoice` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `timer` (
+  `user_id` int(11) NOT NULL,
+  `project_id` int(11) NOT NULL,
+  `started_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `accomplishments` varchar(150) DEFAULT NULL,
+  PRIMARY KEY (`user_id`),
   FOREIGN KEY (`user_id`) REFERENCES `user` (`id`),
   FOREIGN KEY (`project_id`) REFERENCES `project` (`id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 
+# the hourly rates, in the client currency: of a client (client_id), a project (project_id)
+# or a user on a project (project_id and user_id), each effective until the next one of its level
+CREATE TABLE `rate` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `client_id` int(11) DEFAULT NULL,
+  `project_id` int(11) DEFAULT NULL,
+  `user_id` int(11) DEFAULT NULL,
+  `hourly_rate` decimal(10,2) NOT NULL,
+  `effective_from` date NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `rate_effective_from_index` (`effective_from`),
+  FOREIGN KEY (`client_id`) REFERENCES `client` (`id`),
+  FOREIGN KEY (`project_id`) REFERENCES `project` (`id`),
+  FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `invoice_line` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `invoice_id` int(11) NOT NULL,
+  `project_id` int(11) NOT NULL,
+  `description` varchar(255) NOT NULL,
+  `hours` double NOT NULL,
+  `hourly_rate` decimal(10,2) NOT NULL,
+  `amount` decimal(12,2) NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`invoice_id`) REFERENCES `invoice` (`id`),
+  FOREIGN KEY (`project_id`) REFERENCES `project` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+INSERT INTO rate (client_id, project_id, user_id, hourly_rate, effective_from)
+VALUES
+  (1, NULL, NULL, 80, '2000-01-01');
+
 INSERT INTO timesheet (user_id, project_id, duration, accomplishments)
 VALUES
   (1, 1, 5, 'drew blueprints'),
@@ -0,0 +1,430 @@
+package transport
+
+import (
+	"encoding/json"
+	"errors"
+	"html/template"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/boromil/timesheet/domain"
+)
+
+// apiPrefix is where the versioned domain API is served
+const apiPrefix = "/api/v1"
+
+// listResponse - the envelope of the API listings
+type listResponse[T any] struct {
+	Items      []T               `json:"items"`
+	Pagination domain.Pagination `json:"pagination"`
+}
+
+// apiHandler - the domain API handlers
+type apiHandler struct {
+	svc *domain.Service
+	// raw passes the raw CSV export requests on to SlashDB
+	raw http.Handler
+	// pdfTemplate returns the timesheet PDF layout template
+	pdfTemplate func() (*template.Template, error)
+	// invoiceTemplate returns the invoice PDF layout template
+	invoiceTemplate func() (*template.Template, error)
+}
+
+// routes registers the API handlers on mux, wrapped with the mws
+func (h *apiHandler) routes(mux *http.ServeMux, mws ...middleware) {
+	handle := func(pattern string, fn func(http.ResponseWriter, *http.Request, int)) {
+		mux.Handle(pattern, chain(h.withUser(fn), mws...))
+	}
+	handle("GET "+apiPrefix+"/me", h.me)
+	handle("GET "+apiPrefix+"/projects", h.listProjects)
+	handle("POST "+apiPrefix+"/projects", h.createProject)
+	handle("GET "+apiPrefix+"/projects/{id}", h.getProject)
+	handle("PUT "+apiPrefix+"/projects/{id}", h.updateProject)
+	handle("DELETE "+apiPrefix+"/projects/{id}", h.deleteProject)
+	handle("GET "+apiPrefix+"/projects/{id}/rates", h.projectRates)
+	handle("POST "+apiPrefix+"/projects/{id}/rates", h.setProjectRate)
+	handle("GET "+apiPrefix+"/clients", h.listClients)
+	handle("POST "+apiPrefix+"/clients", h.createClient)
+	handle("GET "+apiPrefix+"/clients/{id}", h.getClient)
+	handle("PUT "+apiPrefix+"/clients/{id}", h.updateClient)
+	handle("DELETE "+apiPrefix+"/clients/{id}", h.deleteClient)
+	handle("GET "+apiPrefix+"/clients/{id}/rates", h.clientRates)
+	handle("POST "+apiPrefix+"/clients/{id}/rates", h.setClientRate)
+	handle("DELETE "+apiPrefix+"/rates/{id}", h.deleteRate)
+	handle("GET "+apiPrefix+"/invoices", h.listInvoices)
+	handle("POST "+apiPrefix+"/invoices", h.createInvoice)
+	handle("GET "+apiPrefix+"/invoices/{id}", h.getInvoice)
+	handle("PUT "+apiPrefix+"/invoices/{id}", h.updateInvoice)
+	handle("DELETE "+apiPrefix+"/invoices/{id}", h.deleteInvoice)
+	handle("POST "+apiPrefix+"/invoices/{id}/issue", h.issueInvoice)
+	handle("GET "+apiPrefix+"/invoices/{id}/pdf", h.invoicePDF)
+	handle("GET "+apiPrefix+"/entries", h.listEntries)
+	handle("GET "+apiPrefix+"/entries/export", h.exportEntries)
+	handle("POST "+apiPrefix+"/entries/import", h.importEntries)
+	handle("POST "+apiPrefix+"/entries", h.createEntry)
+	handle("GET "+apiPrefix+"/entries/{id}", h.getEntry)
+	handle("PUT "+apiPrefix+"/entries/{id}", h.updateEntry)
+	handle("DELETE "+apiPrefix+"/entries/{id}", h.deleteEntry)
+	handle("GET "+apiPrefix+"/summaries", h.summaries)
+	handle("GET "+apiPrefix+"/reports", h.report)
+	handle("GET "+apiPrefix+"/reports/export", h.exportReport)
+	handle("GET "+apiPrefix+"/reports/pdf", h.timesheetPDF)
+	handle("GET "+apiPrefix+"/calendar", h.calendar)
+	handle("GET "+apiPrefix+"/calendar/feed", h.calendarFeedURL)
+	handle("POST "+apiPrefix+"/calendar/feed/rotate", h.rotateCalendarFeed)
+	handle("GET "+apiPrefix+"/timer", h.timerStatus)
+	handle("POST "+apiPrefix+"/timer/start", h.startTimer)
+	handle("POST "+apiPrefix+"/timer/stop", h.stopTimer)
+	handle("DELETE "+apiPrefix+"/timer", h.discardTimer)
+	mux.Handle(apiPrefix+"/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, r, http.StatusNotFound, codeNotFound, "no such API endpoint")
+	}))
+}
+
+// withUser passes the authenticated user ID on to fn
+func (h *apiHandler) withUser(fn func(http.ResponseWriter, *http.Request, int)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, ok := claimsFrom(r.Context())
+		if !ok || c.ID <= 0 {
+			writeError(w, r, http.StatusUnauthorized, codeUnauthorized, "")
+			return
+		}
+		fn(w, r, c.ID)
+	})
+}
+
+// writeJSON writes v as the JSON response body
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeDomainError maps the domain errors to the error envelope
+func writeDomainError(w http.ResponseWriter, r *http.Request, err error) {
+	var vErr *domain.ValidationError
+	switch {
+	case errors.As(err, &vErr):
+		writeValidationErrors(w, r, vErr.Fields)
+	case errors.Is(err, domain.ErrNotFound):
+		writeError(w, r, http.StatusNotFound, codeNotFound, "")
+	case errors.Is(err, domain.ErrConflict):
+		writeError(w, r, http.StatusConflict, codeConflict, err.Error())
+	case errors.Is(err, domain.ErrForbidden):
+		writeError(w, r, http.StatusForbidden, codeForbidden, err.Error())
+	case errors.Is(err, ErrCircuitOpen):
+		logAndWrite(r, err, "SlashDB unavailable", w)
+	case errors.Is(err, domain.ErrUpstream):
+		loggerFrom(r.Context()).Error("SlashDB request failed", slog.String("error", err.Error()))
+		writeError(w, r, http.StatusBadGateway, codeUpstream, "SlashDB request failed")
+	default:
+		logAndWrite(r, err, "API request failed", w)
+	}
+}
+
+// decodeBody decodes the JSON request body into v, it writes the error response on failure
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err == nil {
+		return true
+	}
+	switch {
+	case bodyLimitExceeded(r, err):
+		writeTooLarge(w, r, 0)
+	case errors.Is(err, io.EOF):
+		writeError(w, r, http.StatusBadRequest, codeBadRequest, "missing request body")
+	default:
+		writeError(w, r, http.StatusBadRequest, codeBadRequest, "malformed JSON: "+err.Error())
+	}
+	return false
+}
+
+// queryParams collects the query parameter errors
+type queryParams struct {
+	r    *http.Request
+	errs map[string][]string
+}
+
+func (q *queryParams) int(name string) int {
+	v := q.r.URL.Query().Get(name)
+	if v == "" {
+		return 0
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		q.errs[name] = append(q.errs[name], "expected an integer")
+	}
+	return i
+}
+
+// time accepts a date (2006-01-02) or a RFC 3339 timestamp
+func (q *queryParams) time(name string) time.Time {
+	v := q.r.URL.Query().Get(name)
+	if v == "" {
+		return time.Time{}
+	}
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return t
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		q.errs[name] = append(q.errs[name], "expected a date (YYYY-MM-DD) or a RFC 3339 timestamp")
+	}
+	return t
+}
+
+func (q *queryParams) page() domain.Page {
+	return domain.Page{Limit: q.int("limit"), Offset: q.int("offset"), Sort: q.r.URL.Query().Get("sort")}
+}
+
+func (q *queryParams) entryFilter() domain.EntryFilter {
+	return domain.EntryFilter{ProjectID: q.int("project_id"), From: q.time("from"), To: q.time("to")}
+}
+
+// valid writes the query parameter errors, if there are any
+func (q *queryParams) valid(w http.ResponseWriter) bool {
+	if len(q.errs) == 0 {
+		return true
+	}
+	writeValidationErrors(w, q.r, q.errs)
+	return false
+}
+
+func newQueryParams(r *http.Request) *queryParams {
+	return &queryParams{r: r, errs: map[string][]string{}}
+}
+
+// pathID returns the {id} path value, it writes a 404 if it's not a (project, client, rate or invoice) ID
+func pathID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, http.StatusNotFound, codeNotFound, "")
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *apiHandler) me(w http.ResponseWriter, r *http.Request, userID int) {
+	u, err := h.svc.User(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (h *apiHandler) listProjects(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	page := q.page()
+	if !q.valid(w) {
+		return
+	}
+	projects, pg, err := h.svc.ListProjects(r.Context(), userID, page, r.URL.Query().Get("expand") == "entries")
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse[domain.Project]{Items: projects, Pagination: pg})
+}
+
+func (h *apiHandler) getProject(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	p, err := h.svc.Project(r.Context(), userID, id, r.URL.Query().Get("expand") == "entries")
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (h *apiHandler) createProject(w http.ResponseWriter, r *http.Request, userID int) {
+	var in domain.ProjectInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	p, err := h.svc.CreateProject(r.Context(), userID, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", apiPrefix+"/projects/"+strconv.Itoa(p.ID))
+	writeJSON(w, http.StatusCreated, p)
+}
+
+func (h *apiHandler) updateProject(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	var in domain.ProjectInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	p, err := h.svc.UpdateProject(r.Context(), userID, id, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (h *apiHandler) deleteProject(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteProject(r.Context(), userID, id); err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *apiHandler) listEntries(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	f, page := q.entryFilter(), q.page()
+	if !q.valid(w) {
+		return
+	}
+	entries, pg, err := h.svc.ListEntries(r.Context(), userID, f, page)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse[domain.Entry]{Items: entries, Pagination: pg})
+}
+
+func (h *apiHandler) getEntry(w http.ResponseWriter, r *http.Request, userID int) {
+	e, err := h.svc.Entry(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (h *apiHandler) createEntry(w http.ResponseWriter, r *http.Request, userID int) {
+	var in domain.EntryInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	e, err := h.svc.CreateEntry(r.Context(), userID, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", apiPrefix+"/entries/"+e.ID)
+	writeJSON(w, http.StatusCreated, e)
+}
+
+func (h *apiHandler) updateEntry(w http.ResponseWriter, r *http.Request, userID int) {
+	var in domain.EntryInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	e, err := h.svc.UpdateEntry(r.Context(), userID, r.PathValue("id"), in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (h *apiHandler) deleteEntry(w http.ResponseWriter, r *http.Request, userID int) {
+	if err := h.svc.DeleteEntry(r.Context(), userID, r.PathValue("id")); err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *apiHandler) summaries(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	f := q.entryFilter()
+	if !q.valid(w) {
+		return
+	}
+	s, err := h.svc.Summaries(r.Context(), userID, f, r.URL.Query().Get("group_by"))
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, s)
+}
+
+func (h *apiHandler) timerStatus(w http.ResponseWriter, r *http.Request, userID int) {
+	status, err := h.svc.TimerStatus(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (h *apiHandler) startTimer(w http.ResponseWriter, r *http.Request, userID int) {
+	var in domain.TimerInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	t, err := h.svc.StartTimer(r.Context(), userID, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, t)
+}
+
+func (h *apiHandler) stopTimer(w http.ResponseWriter, r *http.Request, userID int) {
+	// the body is optional, it can only override the accomplishments
+	var in struct {
+		Accomplishments string `json:"accomplishments"`
+	}
+	if r.ContentLength != 0 && !decodeBody(w, r, &in) {
+		return
+	}
+	e, err := h.svc.StopTimer(r.Context(), userID, in.Accomplishments)
+	if errors.Is(err, domain.ErrNotFound) {
+		writeError(w, r, http.StatusNotFound, codeNotFound, "there's no running timer")
+		return
+	}
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", apiPrefix+"/entries/"+e.ID)
+	writeJSON(w, http.StatusCreated, e)
+}
+
+func (h *apiHandler) discardTimer(w http.ResponseWriter, r *http.Request, userID int) {
+	if err := h.svc.DiscardTimer(r.Context(), userID); err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *apiHandler) report(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	f := q.entryFilter()
+	dims, err := domain.ParseGroupBy(r.URL.Query().Get("group_by"))
+	if err != nil {
+		q.errs["group_by"] = append(q.errs["group_by"], err.Error())
+	}
+	if !q.valid(w) {
+		return
+	}
+	report, err := h.svc.Report(r.Context(), userID, f, dims, r.URL.Query().Get("amounts") == "true")
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,198 @@
+package transport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// assetsDir is the Assets directory served under /app/static/assets/
+	assetsDir = "assets"
+	// staticPrefix is the asset URL prefix, relative to the page index
+	staticPrefix = "static/"
+)
+
+// assetManifest maps the asset names to their fingerprinted (content hash carrying) variants,
+// those can be cached by the browsers for good, a changed asset gets a new name
+type assetManifest struct {
+	fingerprinted map[string]string
+	// names maps the fingerprinted names back to the actual ones
+	names map[string]string
+	etags map[string]string
+	// precompressed holds the build time compressed variants matching their assets, the stale ones
+	// (i.e. left over by an older build) are not served
+	precompressed map[string]bool
+}
+
+func fingerprintedName(name, sum string) string {
+	ext := path.Ext(name)
+	return strings.TrimSuffix(name, ext) + "." + sum + ext
+}
+
+// isPrecompressed reports if name is one of the build time compressed variants
+func isPrecompressed(name string) bool {
+	for _, ext := range precompressedExt {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+func newAssetManifest(fsys fs.FS) (*assetManifest, error) {
+	m := &assetManifest{
+		fingerprinted: map[string]string{},
+		names:         map[string]string{},
+		etags:         map[string]string{},
+		precompressed: map[string]bool{},
+	}
+	err := fs.WalkDir(fsys, assetsDir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || isPrecompressed(name) {
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("fs.ReadFile: %w", err)
+		}
+		sum := sha256.Sum256(data)
+		fp := fingerprintedName(name, hex.EncodeToString(sum[:4]))
+		m.fingerprinted[name] = fp
+		m.names[fp] = name
+		m.etags[name] = `"` + hex.EncodeToString(sum[:16]) + `"`
+
+		for enc, ext := range precompressedExt {
+			compressed, err := fs.ReadFile(fsys, name+ext)
+			if err != nil {
+				continue
+			}
+			if content, err := decompress(enc, compressed); err == nil && sha256.Sum256(content) == sum {
+				m.precompressed[name+ext] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fs.WalkDir: %w", err)
+	}
+	return m, nil
+}
+
+// url returns the asset URL, relative to the page index, the asset helper of the index template
+func (m *assetManifest) url(name string) string {
+	if m != nil {
+		if fp, ok := m.fingerprinted[name]; ok {
+			return staticPrefix + fp
+		}
+	}
+	return staticPrefix + name
+}
+
+// subDirsFS - a fs.FS limited to some of its top level directories
+type subDirsFS struct {
+	fsys fs.FS
+	dirs []string
+}
+
+func (s subDirsFS) Open(name string) (fs.File, error) {
+	top, _, _ := strings.Cut(name, "/")
+	for _, dir := range s.dirs {
+		if top == dir && fs.ValidPath(name) {
+			return s.fsys.Open(name)
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// SubDirs limits fsys to the dirs, with their paths kept as they are, i.e. the working directory
+// of the dev mode to the assets and templates ones, as the release builds embed them
+func SubDirs(fsys fs.FS, dirs ...string) fs.FS {
+	return subDirsFS{fsys: fsys, dirs: dirs}
+}
+
+// staticHandler serves the assets, the fingerprinted names are cached for good, the rest is revalidated,
+// a nil manifest (the dev mode) serves the files as they are on the disk
+func staticHandler(fsys fs.FS, manifest *assetManifest) http.Handler {
+	httpFS := http.FS(fsys)
+	fileServer := http.FileServer(httpFS)
+	if manifest == nil {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Cache-Control", "no-store")
+			fileServer.ServeHTTP(w, r)
+		})
+	}
+
+	valid := func(name string) bool { return manifest.precompressed[strings.TrimPrefix(name, "/")] }
+	precompressedServer := precompressed(httpFS, valid, fileServer)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/")
+		if actual, ok := manifest.names[name]; ok {
+			name = actual
+			r2 := r.Clone(r.Context())
+			r2.URL.Path = "/" + actual
+			r = r2
+			w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		} else {
+			w.Header().Set("Cache-Control", "no-cache")
+		}
+		if etag, ok := manifest.etags[name]; ok {
+			w.Header().Set("ETag", etag)
+		}
+		precompressedServer.ServeHTTP(w, r)
+	})
+}
+
+// assetsSignature sums up the names, sizes and modification times of all the files in the dirs
+func assetsSignature(fsys fs.FS, dirs ...string) string {
+	h := sha256.New()
+	for _, dir := range dirs {
+		fs.WalkDir(fsys, dir, func(name string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			if info, err := d.Info(); err == nil {
+				fmt.Fprintf(h, "%s|%d|%d\n", name, info.Size(), info.ModTime().UnixNano())
+			}
+			return nil
+		})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// devReloadHandler streams (as server-sent events) a reload event once any of the assets
+// or templates changes on the disk, the page index listens to it in the dev mode
+func devReloadHandler(fsys fs.FS) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rc := http.NewResponseController(w)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ": watching for changes\n\n")
+		rc.Flush()
+
+		initial := assetsSignature(fsys, assetsDir, "templates")
+		ticker := time.NewTicker(time.Millisecond * 500)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-ticker.C:
+			}
+			if assetsSignature(fsys, assetsDir, "templates") != initial {
+				fmt.Fprint(w, "event: reload\ndata: {}\n\n")
+				rc.Flush()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package transport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/andybalholm/brotli"
+)
+
+func TestSubDirs(t *testing.T) {
+	fsys := SubDirs(fstest.MapFS{
+		"assets/js/app.js":     {Data: []byte("app")},
+		"templates/index.html": {Data: []byte("index")},
+		"go.mod":               {Data: []byte("module")},
+		".git/config":          {Data: []byte("[core]")},
+		"assetsx/file":         {Data: []byte("x")},
+	}, "assets", "templates")
+	tests := []struct {
+		name   string
+		wantOK bool
+	}{
+		{name: "assets/js/app.js", wantOK: true},
+		{name: "assets", wantOK: true},
+		{name: "templates/index.html", wantOK: true},
+		{name: "go.mod"},
+		{name: ".git/config"},
+		{name: "."},
+		{name: "assetsx/file"},
+		{name: "assets/../go.mod"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := fsys.Open(tt.name)
+			if err == nil {
+				f.Close()
+			}
+			if (err == nil) != tt.wantOK {
+				t.Fatalf("got error %v, want ok %v", err, tt.wantOK)
+			}
+		})
+	}
+	if _, err := fs.ReadDir(fsys, "assets/js"); err != nil {
+		t.Errorf("fs.ReadDir: %v", err)
+	}
+}
+
+func TestStaticRoutes(t *testing.T) {
+	for _, dev := range []bool{false, true} {
+		h, _ := newTestServer(t, Config{DevMode: dev})
+		tests := []struct {
+			path string
+			want int
+		}{
+			{path: "/app/static/assets/js/app.js", want: http.StatusOK},
+			{path: "/app/static/templates/index.html", want: http.StatusNotFound},
+			{path: "/app/static/go.mod", want: http.StatusNotFound},
+			{path: "/app/static/.git/config", want: http.StatusNotFound},
+			{path: "/app/static/main.go", want: http.StatusNotFound},
+		}
+		for _, tt := range tests {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.want {
+				t.Errorf("dev %v, %s: got status %d, want %d", dev, tt.path, rec.Code, tt.want)
+			}
+		}
+	}
+	// the dev mode working directory, as main sets it up
+	if _, err := fs.Stat(SubDirs(os.DirFS(".."), "assets", "templates"), "go.mod"); err == nil {
+		t.Error("go.mod is reachable")
+	}
+}
+
+func TestStaticPrecompressed(t *testing.T) {
+	gz := func(data string) []byte {
+		buf := bytes.Buffer{}
+		zw := gzip.NewWriter(&buf)
+		zw.Write([]byte(data))
+		zw.Close()
+		return buf.Bytes()
+	}
+	br := func(data string) []byte {
+		buf := bytes.Buffer{}
+		zw := brotli.NewWriter(&buf)
+		zw.Write([]byte(data))
+		zw.Close()
+		return buf.Bytes()
+	}
+	fsys := fstest.MapFS{
+		"assets/fresh.js":    {Data: []byte("fresh")},
+		"assets/fresh.js.gz": {Data: gz("fresh")},
+		"assets/fresh.js.br": {Data: br("fresh")},
+		// left over by an older build
+		"assets/stale.js":     {Data: []byte("changed")},
+		"assets/stale.js.gz":  {Data: gz("stale")},
+		"assets/stale.js.br":  {Data: br("stale")},
+		"assets/broken.js":    {Data: []byte("broken")},
+		"assets/broken.js.gz": {Data: []byte("not gzip")},
+	}
+	manifest, err := newAssetManifest(fsys)
+	if err != nil {
+		t.Fatalf("newAssetManifest: %v", err)
+	}
+	h := staticHandler(fsys, manifest)
+
+	tests := []struct {
+		name, path, acceptEncoding string
+		wantEncoding, wantBody     string
+	}{
+		{name: "brotli", path: "/assets/fresh.js", acceptEncoding: "gzip, br", wantEncoding: "br", wantBody: "fresh"},
+		{name: "gzip", path: "/assets/fresh.js", acceptEncoding: "gzip", wantEncoding: "gzip", wantBody: "fresh"},
+		{name: "fingerprinted", path: "/" + manifest.fingerprinted["assets/fresh.js"], acceptEncoding: "gzip", wantEncoding: "gzip", wantBody: "fresh"},
+		{name: "not accepted", path: "/assets/fresh.js", wantBody: "fresh"},
+		{name: "stale", path: "/assets/stale.js", acceptEncoding: "gzip, br", wantBody: "changed"},
+		{name: "broken", path: "/assets/broken.js", acceptEncoding: "gzip", wantBody: "broken"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			r.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, r)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+			}
+			encoding := rec.Header().Get("Content-Encoding")
+			if encoding != tt.wantEncoding {
+				t.Fatalf("got Content-Encoding %q, want %q", encoding, tt.wantEncoding)
+			}
+			body := rec.Body.Bytes()
+			if encoding != "" {
+				if body, err = decompress(encoding, body); err != nil {
+					t.Fatalf("decompress: %v", err)
+				}
+			}
+			if string(body) != tt.wantBody {
+				t.Errorf("got body %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
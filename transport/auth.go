@@ -1,20 +1,23 @@
 package transport
 
 import (
+	"context"
 	"crypto/sha512"
 	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
+	"log/slog"
 	"net/http"
 	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/dgrijalva/jwt-go/request"
 	"gitlab.com/boromil/goslashdb/slashdb"
+	"go.opentelemetry.io/otel/attribute"
 
 	"golang.org/x/crypto/pbkdf2"
 )
@@ -30,9 +33,15 @@ var (
 	defaultClient = &http.Client{Transport: defaultTransport}
 )
 
-func logAndWrite(err error, logMsg string, w http.ResponseWriter) {
-	log.Println(logMsg+",", err.Error())
-	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+func logAndWrite(r *http.Request, err error, logMsg string, w http.ResponseWriter) {
+	loggerFrom(r.Context()).Error(logMsg, slog.String("error", err.Error()))
+	var coErr *CircuitOpenError
+	if errors.As(err, &coErr) {
+		w.Header().Set("Retry-After", seconds(coErr.RetryAfter))
+		writeError(w, r, http.StatusServiceUnavailable, codeUnavailable, "SlashDB instance unavailable, try again later")
+		return
+	}
+	writeError(w, r, http.StatusInternalServerError, codeInternal, "")
 }
 
 var defaultSalt = []byte("timesheet app salt")
@@ -56,23 +65,10 @@ func basicValidation(name, val string, minLen, maxLen int) []string {
 	return errs
 }
 
-func writeValidationErrors(w http.ResponseWriter, vData map[string][]string) {
-	w.WriteHeader(http.StatusBadRequest)
-	if err := json.NewEncoder(w).Encode(vData); err != nil {
-		log.Printf("data: %v, error: %v\n", vData, err)
-		w.Write([]byte(http.StatusText(http.StatusBadRequest)))
-	}
-}
-
 func regHandler(
-	sdbService *slashdb.Service,
-) func(w http.ResponseWriter, r *http.Request) {
+	sdbService slashdb.CRUDer,
+) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
-			return
-		}
-
 		w.Header().Set("Content-Type", "application/json")
 		if r != nil && r.Body != nil {
 			defer func() {
@@ -81,8 +77,12 @@ func regHandler(
 			}()
 		}
 		if err := r.ParseForm(); err != nil {
-			log.Printf("failed to parse form: %v\n", err)
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			loggerFrom(r.Context()).Warn("failed to parse form", slog.String("error", err.Error()))
+			if bodyLimitExceeded(r, err) {
+				writeTooLarge(w, r, 0)
+				return
+			}
+			writeError(w, r, http.StatusBadRequest, codeBadRequest, "malformed form data")
 			return
 		}
 
@@ -105,7 +105,7 @@ func regHandler(
 
 		userNames := []string{}
 		if err := sdbService.Get(r.Context(), userReq, &userNames); err != nil {
-			logAndWrite(err, fmt.Sprintf("couldn't find user %q or SlashDB instance unavailable", un), w)
+			logAndWrite(r, err, fmt.Sprintf("couldn't find user %q or SlashDB instance unavailable", un), w)
 			return
 		}
 
@@ -134,7 +134,7 @@ func regHandler(
 		}
 
 		if len(validationData) > 0 {
-			writeValidationErrors(w, validationData)
+			writeValidationErrors(w, r, validationData)
 			return
 		}
 
@@ -151,22 +151,25 @@ func regHandler(
 			},
 		)
 		if _, err := sdbService.Create(r.Context(), userReq, userData); err != nil {
-			logAndWrite(err, fmt.Sprintf("couldn't create user %q SlashDB instance unavailable", un), w)
+			logAndWrite(r, err, fmt.Sprintf("couldn't create user %q SlashDB instance unavailable", un), w)
 			return
 		}
 
 		w.WriteHeader(http.StatusCreated)
-		w.Write([]byte(fmt.Sprintf("User %q was created successfully!", un)))
+		json.NewEncoder(w).Encode(map[string]string{"message": fmt.Sprintf("User %q was created successfully!", un)})
 	}
 }
 
 var defaultSecret = []byte("timesheet app secret")
 
+// tokenTTL is how long the JWT (and the session cookie carrying it) is valid
+const tokenTTL = time.Hour * 24
+
 func genJWTToken(username string, id int, secret []byte) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{
 		"username": username,
 		"id":       id,
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
+		"exp":      time.Now().Add(tokenTTL).Unix(),
 	})
 	if len(secret) == 0 {
 		secret = defaultSecret
@@ -175,14 +178,10 @@ func genJWTToken(username string, id int, secret []byte) (string, error) {
 }
 
 func loginHandler(
-	sdbService *slashdb.Service,
-) func(w http.ResponseWriter, r *http.Request) {
+	sdbService slashdb.CRUDer,
+	authCfg AuthConfig,
+) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
-			return
-		}
-
 		w.Header().Set("Content-Type", "application/json")
 		if r != nil && r.Body != nil {
 			defer func() {
@@ -191,8 +190,12 @@ func loginHandler(
 			}()
 		}
 		if err := r.ParseForm(); err != nil {
-			log.Printf("failed to parse form: %v\n", err)
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			loggerFrom(r.Context()).Warn("failed to parse form", slog.String("error", err.Error()))
+			if bodyLimitExceeded(r, err) {
+				writeTooLarge(w, r, 0)
+				return
+			}
+			writeError(w, r, http.StatusBadRequest, codeBadRequest, "malformed form data")
 			return
 		}
 
@@ -212,8 +215,15 @@ func loginHandler(
 
 		unErrors := []string{}
 		userData := []User{}
-		if err := sdbService.Get(r.Context(), userReq, &userData); err != nil || len(userData) != 1 {
-			log.Printf("couldn't find user %q or SlashDB instance unavailable: %v", un, err)
+		err := sdbService.Get(r.Context(), userReq, &userData)
+		if errors.Is(err, ErrCircuitOpen) {
+			logAndWrite(r, err, "SlashDB unavailable", w)
+			return
+		}
+		if err != nil || len(userData) != 1 {
+			loggerFrom(r.Context()).Warn(
+				"couldn't find user or SlashDB instance unavailable", slog.String("username", un), slog.Any("error", err),
+			)
 			unErrors = append(unErrors, "no such user")
 		}
 
@@ -223,7 +233,7 @@ func loginHandler(
 		}
 
 		if len(validationData) > 0 {
-			writeValidationErrors(w, validationData)
+			writeValidationErrors(w, r, validationData)
 			return
 		}
 
@@ -232,78 +242,135 @@ func loginHandler(
 		encodedPass := genPassword(un+r.FormValue("password"), nil)
 		if dataUn != un || dataPasswd != encodedPass {
 			errMsg := "wrong username or password"
-			log.Printf(
-				"%s, expected: u: %q, p: %q, got: u: %q, p: %q\n", errMsg, dataUn, dataPasswd, un, encodedPass,
-			)
-			w.WriteHeader(http.StatusUnauthorized)
-			w.Write([]byte(`{"form":"` + errMsg + `"}`))
+			loggerFrom(r.Context()).Info(errMsg, slog.String("username", un))
+			writeAPIError(w, r, http.StatusUnauthorized, apiError{
+				Code:    "invalid_credentials",
+				Message: errMsg,
+				Fields:  map[string][]string{"form": {errMsg}},
+			})
 			return
 		}
 
 		st, err := genJWTToken(un, userData[0].ID, nil)
 		if err != nil {
-			logAndWrite(err, "error generating JWT token", w)
+			logAndWrite(r, err, "error generating JWT token", w)
 			return
 		}
-		w.Write([]byte(`{"accessToken":"` + st + `"}`))
+		if !authCfg.CookieMode {
+			w.Write([]byte(`{"accessToken":"` + st + `"}`))
+			return
+		}
+
+		// the token never reaches the app JS, it only gets what it needs to know about the user
+		resp := struct {
+			User struct {
+				ID       int    `json:"id"`
+				Username string `json:"username"`
+			} `json:"user"`
+			CSRFToken string `json:"csrfToken"`
+		}{}
+		resp.User.ID, resp.User.Username = userData[0].ID, un
+		resp.CSRFToken = authCfg.setSessionCookies(w, st)
+		json.NewEncoder(w).Encode(resp)
 	}
 }
 
-// Init setups http routing
-func Init(
-	sdbService *slashdb.Service,
-) {
-	http.HandleFunc("/app/reg", regHandler(sdbService))
-	http.HandleFunc("/app/login", loginHandler(sdbService))
+type claimsKey struct{}
+
+// userClaims - the authenticated user, as stated by the token
+type userClaims struct {
+	ID       int
+	Username string
+}
+
+// claimsFrom returns the user authenticated by authorizationMiddleware
+func claimsFrom(ctx context.Context) (userClaims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(userClaims)
+	return c, ok
 }
 
+// authorizationMiddleware checks the request token, with sdbDBName set the requests are restricted
+// to the SlashDB timesheet of the token user
 func authorizationMiddleware(
 	sdbDBName string,
-	fn func(http.ResponseWriter, *http.Request),
 	secret []byte,
-) func(w http.ResponseWriter, r *http.Request) {
+	authCfg AuthConfig,
+) middleware {
 	baseURL := "/db/" + sdbDBName + "/timesheet/user_id/"
-	return func(w http.ResponseWriter, r *http.Request) {
-		token, err := request.ParseFromRequest(r, request.OAuth2Extractor, func(token *jwt.Token) (interface{}, error) {
-			// we simply check the token claims, but this is a good place
-			// to parse the r.URL.Path or other request parameters
-			// and determine if a given user can access requested data
-			// i.e. check if user of ID = 8 can access /db/timesheet/timesheet/user_id/8/project.json etc.
-			mc := token.Claims.(jwt.MapClaims)
-			userID, ok := mc["id"]
-			if !ok {
-				return nil, fmt.Errorf("token lacks 'id' claim")
-			}
-
-			userPath := baseURL + fmt.Sprintf("%.0f", userID)
-			userPathLen := len(userPath) + 1
-			// if: userID = 10
-			// and: r.URL.Path = "/db/timesheet/timesheet/user_id/10/project.json
-			// userPath = "/db/timesheet/timesheet/user_id/10/"
-			// then: check if r.URL.Path starts with uskerPath
-			if len(r.URL.Path) < userPathLen {
-				return nil, fmt.Errorf("restricted access to this resource")
+	var extractor request.Extractor = request.OAuth2Extractor
+	if authCfg.CookieMode {
+		// the bearer header takes precedence over the session cookie, the access_token
+		// argument is not accepted, parsing it would consume the form bodies passed on to SlashDB
+		extractor = request.MultiExtractor{request.AuthorizationHeaderExtractor, sessionCookieExtractor{}}
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := tracer.Start(r.Context(), "auth.authorize")
+			token, err := request.ParseFromRequest(r, extractor, func(token *jwt.Token) (interface{}, error) {
+				// we simply check the token claims, but this is a good place
+				// to parse the r.URL.Path or other request parameters
+				// and determine if a given user can access requested data
+				// i.e. check if user of ID = 8 can access /db/timesheet/timesheet/user_id/8/project.json etc.
+				mc := token.Claims.(jwt.MapClaims)
+				userID, ok := mc["id"]
+				if !ok {
+					return nil, fmt.Errorf("token lacks 'id' claim")
+				}
+
+				// the domain API (no sdbDBName) scopes the data to the token user itself
+				if sdbDBName != "" {
+					userPath := baseURL + fmt.Sprintf("%.0f", userID)
+					userPathLen := len(userPath) + 1
+					// if: userID = 10
+					// and: r.URL.Path = "/db/timesheet/timesheet/user_id/10/project.json
+					// userPath = "/db/timesheet/timesheet/user_id/10/"
+					// then: check if r.URL.Path starts with uskerPath
+					if len(r.URL.Path) < userPathLen {
+						return nil, fmt.Errorf("restricted access to this resource")
+					}
+
+					baseUserPath := r.URL.Path[:userPathLen]
+					if baseUserPath != userPath+"/" && baseUserPath != userPath+"." {
+						return nil, fmt.Errorf("restricted access to this resource")
+					}
+				}
+
+				if _, ok = mc["username"]; !ok {
+					return nil, fmt.Errorf("token lacks 'username' claim")
+				}
+
+				if len(secret) == 0 {
+					secret = defaultSecret
+				}
+				return secret, nil
+			})
+
+			if err == nil && !token.Valid {
+				err = fmt.Errorf("invalid token")
 			}
-
-			baseUserPath := r.URL.Path[:userPathLen]
-			if baseUserPath != userPath+"/" && baseUserPath != userPath+"." {
-				return nil, fmt.Errorf("restricted access to this resource")
+			if err != nil {
+				endSpan(span, err)
+				writeError(w, r, http.StatusUnauthorized, codeUnauthorized, err.Error())
+				return
 			}
-
-			if _, ok = mc["username"]; !ok {
-				return nil, fmt.Errorf("token lacks 'username' claim")
+			_, bearerErr := request.AuthorizationHeaderExtractor.ExtractToken(r)
+			if authCfg.CookieMode && bearerErr != nil && !csrfSafe(r) {
+				// a cookie authenticated request, it could have been forged by another site
+				err = fmt.Errorf("missing or invalid %s header", csrfHeader)
+				endSpan(span, err)
+				writeError(w, r, http.StatusForbidden, "csrf_failed", err.Error())
+				return
 			}
-
-			if len(secret) == 0 {
-				secret = defaultSecret
+			if mc, ok := token.Claims.(jwt.MapClaims); ok {
+				userID := fmt.Sprintf("%.0f", mc["id"])
+				setRequestUserID(ctx, userID)
+				span.SetAttributes(attribute.String("enduser.id", userID))
+				id, _ := mc["id"].(float64)
+				username, _ := mc["username"].(string)
+				r = r.WithContext(context.WithValue(r.Context(), claimsKey{}, userClaims{ID: int(id), Username: username}))
 			}
-			return secret, nil
+			endSpan(span, nil)
+			next.ServeHTTP(w, r)
 		})
-
-		if err != nil || !token.Valid {
-			http.Error(w, http.StatusText(http.StatusUnauthorized)+": "+err.Error(), http.StatusUnauthorized)
-			return
-		}
-		fn(w, r)
 	}
 }
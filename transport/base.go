@@ -1,78 +1,119 @@
 package transport
 
 import (
+	"errors"
 	"fmt"
 	"html/template"
-	"io/ioutil"
-	"log"
+	"io/fs"
+	"log/slog"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strings"
 )
 
-// SetupBasicHandlers setups page index and static assets filestystem
-func SetupBasicHandlers(sdbDBName string, afs http.FileSystem) error {
-	tmplData := struct{ SdbDBName string }{SdbDBName: sdbDBName}
-	indexTmpl := template.New("index.html")
-	indexFile, err := afs.Open("templates/index.html")
-	if err != nil {
-		return fmt.Errorf("afs.Open: %w", err)
+// newIndexHandler parses the page index template and returns its handler,
+// in the dev mode (nil manifest) the template is parsed on every request, so it can be edited on the fly
+func newIndexHandler(sdbDBName string, fsys fs.FS, manifest *assetManifest) (http.HandlerFunc, error) {
+	type indexData struct {
+		SdbDBName string
+		DevMode   bool
+		// CSPNonce goes on every script tag, the inline ones are blocked by the CSP otherwise
+		CSPNonce string
 	}
-	defer indexFile.Close()
+	devMode := manifest == nil
 
-	templateData, err := ioutil.ReadAll(indexFile)
-	if err != nil {
-		return fmt.Errorf("ioutil.ReadAll: %w", err)
+	parse := func() (*template.Template, error) {
+		indexTmpl, err := template.New("index.html").
+			Funcs(template.FuncMap{"asset": manifest.url}).
+			ParseFS(fsys, "templates/index.html")
+		if err != nil {
+			return nil, fmt.Errorf("template.ParseFS: %w", err)
+		}
+		return indexTmpl, nil
 	}
 
-	if _, err = indexTmpl.Parse(string(templateData)); err != nil {
-		return fmt.Errorf("indexTmpl.Parse: %w", err)
+	indexTmpl, err := parse()
+	if err != nil {
+		return nil, err
 	}
 
-	http.HandleFunc("/app/", func(w http.ResponseWriter, r *http.Request) {
-		if err = indexTmpl.Execute(w, tmplData); err != nil {
-			log.Printf("indexTmpl.Execute: %v\n", err)
+	return func(w http.ResponseWriter, r *http.Request) {
+		tmpl := indexTmpl
+		if devMode {
+			var err error
+			if tmpl, err = parse(); err != nil {
+				loggerFrom(r.Context()).Error("error parsing the index template", slog.String("error", err.Error()))
+				writeError(w, r, http.StatusInternalServerError, codeInternal, err.Error())
+				return
+			}
+		}
+		w.Header().Set("Cache-Control", "no-cache")
+		tmplData := indexData{SdbDBName: sdbDBName, DevMode: devMode, CSPNonce: cspNonceFrom(r.Context())}
+		if err := tmpl.Execute(w, tmplData); err != nil {
+			loggerFrom(r.Context()).Error("indexTmpl.Execute", slog.String("error", err.Error()))
 			return
 		}
-	})
-	http.Handle("/app/static/", http.StripPrefix("/app/static/", http.FileServer(afs)))
-
-	return nil
+	}, nil
 }
 
-// SetupReverseProxy setups the reverse proxy to SlashDB instance
-func SetupReverseProxy(
-	sdbDBName,
+// newReverseProxy returns the reverse proxy to SlashDB instance
+func newReverseProxy(
 	sdbInstanceAddr,
 	sdbAPIKey,
 	sdbAPIValue string,
-) error {
+	rt http.RoundTripper,
+) (http.Handler, error) {
 	// get address for the SlashDB instance and parse the URL
 	url, err := url.Parse(sdbInstanceAddr)
 	if err != nil {
-		return fmt.Errorf("failed to parse sdbInstanceAddr: %w", err)
+		return nil, fmt.Errorf("failed to parse sdbInstanceAddr: %w", err)
 	}
 
 	// create a reverse proxy
 	proxy := httputil.NewSingleHostReverseProxy(url)
-	// make it play nice with https endpoints, also add some timeouts
-	proxy.Transport = defaultTransport
+	proxy.Transport = rt
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		// SlashDB doesn't need the app credentials, the session cookie carries the JWT
+		r.Header.Del("Cookie")
+		if !strings.EqualFold(sdbAPIKey, "Authorization") {
+			r.Header.Del("Authorization")
+		}
+	}
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		loggerFrom(r.Context()).Error("SlashDB proxy error", slog.String("error", err.Error()))
+		if bodyLimitExceeded(r, err) {
+			writeTooLarge(w, r, 0)
+			return
+		}
+		var coErr *CircuitOpenError
+		if errors.As(err, &coErr) {
+			w.Header().Set("Retry-After", seconds(coErr.RetryAfter))
+			writeError(w, r, http.StatusServiceUnavailable, codeUnavailable, "SlashDB instance unavailable, try again later")
+			return
+		}
+		writeError(w, r, http.StatusBadGateway, codeBadGateway, "SlashDB instance unavailable")
+	}
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		// the CORS policy is ours to set, not SlashDB's
+		for _, h := range corsHeaders {
+			resp.Header.Del(h)
+		}
+		// SlashDB errors are passed on in the same envelope as the app ones
+		return mapUpstreamError(resp)
+	}
 
 	proxyHandler := func(w http.ResponseWriter, r *http.Request) {
 		// set API key header
 		r.Header.Set(sdbAPIKey, sdbAPIValue)
-		// set CORS headers for easy proxy to SDB communication
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
-		w.Header().Set(
-			"Access-Control-Allow-Headers",
-			"Accept, Origin, Content-Type, Content-Length, X-Requested-With, Accept-Encoding, X-CSRF-Token, Authorization",
+		// the request ID header set by requestLogging is passed on as-is
+		loggerFrom(r.Context()).Debug(
+			"passing on a request to SlashDB", slog.String("method", r.Method), slog.String("url", redactPath(r.URL.String())),
 		)
-		log.Printf("passing on a %q request to: %q\n", r.Method, r.URL.String())
 		proxy.ServeHTTP(w, r)
 	}
-	// bind the proxy handler to "/"
-	http.HandleFunc("/", authorizationMiddleware(sdbDBName, proxyHandler, nil))
 
-	return nil
+	return http.HandlerFunc(proxyHandler), nil
 }
@@ -0,0 +1,165 @@
+package transport
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/boromil/timesheet/domain"
+)
+
+func (h *apiHandler) listClients(w http.ResponseWriter, r *http.Request, userID int) {
+	clients, err := h.svc.ListClients(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse[domain.Client]{
+		Items:      clients,
+		Pagination: domain.Pagination{Total: len(clients), Limit: len(clients)},
+	})
+}
+
+func (h *apiHandler) getClient(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	c, err := h.svc.Client(r.Context(), userID, id)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (h *apiHandler) createClient(w http.ResponseWriter, r *http.Request, userID int) {
+	var in domain.ClientInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	c, err := h.svc.CreateClient(r.Context(), userID, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", apiPrefix+"/clients/"+strconv.Itoa(c.ID))
+	writeJSON(w, http.StatusCreated, c)
+}
+
+func (h *apiHandler) updateClient(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	var in domain.ClientInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	c, err := h.svc.UpdateClient(r.Context(), userID, id, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (h *apiHandler) deleteClient(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteClient(r.Context(), userID, id); err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeRates writes the rates as a listing
+func writeRates(w http.ResponseWriter, rates []domain.Rate) {
+	writeJSON(w, http.StatusOK, listResponse[domain.Rate]{
+		Items:      rates,
+		Pagination: domain.Pagination{Total: len(rates), Limit: len(rates)},
+	})
+}
+
+// writeRate writes the rate set, 201 if it's a new one
+func writeRate(w http.ResponseWriter, rate domain.Rate, created bool) {
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	writeJSON(w, status, rate)
+}
+
+func (h *apiHandler) clientRates(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	rates, err := h.svc.ClientRates(r.Context(), userID, id)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeRates(w, rates)
+}
+
+func (h *apiHandler) setClientRate(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	var in domain.RateInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	rate, created, err := h.svc.SetClientRate(r.Context(), userID, id, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeRate(w, rate, created)
+}
+
+func (h *apiHandler) projectRates(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	rates, err := h.svc.ProjectRates(r.Context(), userID, id)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeRates(w, rates)
+}
+
+func (h *apiHandler) setProjectRate(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	var in domain.RateInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	rate, created, err := h.svc.SetProjectRate(r.Context(), userID, id, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeRate(w, rate, created)
+}
+
+func (h *apiHandler) deleteRate(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteRate(r.Context(), userID, id); err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
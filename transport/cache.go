@@ -0,0 +1,384 @@
+package transport
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/boromil/timesheet/domain"
+	"gitlab.com/boromil/goslashdb/slashdb"
+	"gitlab.com/boromil/goslashdb/types"
+)
+
+// CacheEntry - a single cached response
+type CacheEntry struct {
+	Header http.Header
+	Body   []byte
+	ETag   string
+}
+
+// Cache - a key/value store for the read responses, keys are prefixed with the user they belong to
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry, ttl time.Duration)
+	// InvalidatePrefix drops all the entries whose key starts with the prefix
+	InvalidatePrefix(prefix string)
+}
+
+// CacheConfig - container for the response cache settings
+type CacheConfig struct {
+	TTL time.Duration
+	// MaxBodySize is the biggest response body (in bytes) that gets cached
+	MaxBodySize int
+}
+
+type lruItem struct {
+	key       string
+	entry     CacheEntry
+	expiresAt time.Time
+}
+
+// LRUCache - an in-memory, least recently used, Cache implementation
+type LRUCache struct {
+	maxEntries int
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+// NewLRUCache returns a new in-memory cache holding up to maxEntries entries
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      map[string]*list.Element{},
+	}
+}
+
+// Get returns a not yet expired entry
+func (c *LRUCache) Get(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	item := el.Value.(*lruItem)
+	if time.Now().After(item.expiresAt) {
+		c.remove(el)
+		return CacheEntry{}, false
+	}
+	c.ll.MoveToFront(el)
+	return item.entry, true
+}
+
+// Set stores the entry, evicting the least recently used one when the cache is full
+func (c *LRUCache) Set(key string, entry CacheEntry, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item := &lruItem{key: key, entry: entry, expiresAt: time.Now().Add(ttl)}
+	if el, ok := c.items[key]; ok {
+		el.Value = item
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(item)
+	for c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		c.remove(c.ll.Back())
+	}
+}
+
+// InvalidatePrefix drops all the entries whose key starts with the prefix
+func (c *LRUCache) InvalidatePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, el := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(el)
+		}
+	}
+}
+
+func (c *LRUCache) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*lruItem).key)
+}
+
+// cacheUsersPrefix starts the keys of all the users
+const cacheUsersPrefix = "user:"
+
+// cacheUserPrefix returns the per user key prefix
+func cacheUserPrefix(ctx context.Context) string {
+	userID := ""
+	if ri := requestInfoFrom(ctx); ri != nil {
+		userID = ri.userID
+	}
+	return cacheUsersPrefix + userID + "|"
+}
+
+// invalidateAll drops the entries of all the users after a successful write (be it through the proxy
+// or the SlashDB service), the rows are shared: the project members read each other's entries,
+// timers and rates, the project clients and the invoices of the shared projects
+func invalidateAll(cache Cache) {
+	cache.InvalidatePrefix(cacheUsersPrefix)
+}
+
+// cacheable reports if the reads can be cached, only the authenticated users ones are, the others
+// (i.e. the login and the registration user lookups) would all share the same prefix
+func cacheable(ctx context.Context) bool {
+	ri := requestInfoFrom(ctx)
+	return ri != nil && ri.userID != ""
+}
+
+// sdbCacheKey returns the SlashDB request URL with its filters and parameters in a stable order,
+// the request String iterates over the maps as they come
+func sdbCacheKey(sdbReq fmt.Stringer) string {
+	req, ok := sdbReq.(*slashdb.Request)
+	if !ok {
+		return sdbReq.String()
+	}
+	var b strings.Builder
+	b.WriteString(req.Kind)
+	for _, part := range req.Parts {
+		if len(part.Filter.Order) == 0 && len(part.Filter.Values) > 1 {
+			part.Filter.Order = make([]string, 0, len(part.Filter.Values))
+			for k := range part.Filter.Values {
+				part.Filter.Order = append(part.Filter.Order, k)
+			}
+			sort.Strings(part.Filter.Order)
+		}
+		b.WriteString(part.String())
+	}
+	b.WriteString(".json")
+	keys := make([]string, 0, len(req.Params))
+	for k := range req.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i == 0 {
+			b.WriteString("?")
+		} else {
+			b.WriteString("&")
+		}
+		b.WriteString(k + "=" + req.Params[k])
+	}
+	return b.String()
+}
+
+func etagFor(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches checks the If-None-Match header value against the etag
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponse holds the response back until it's complete, so that its ETag can be set,
+// once the body outgrows the limit (or it's not a 200) it falls back to a pass-through
+type bufferedResponse struct {
+	http.ResponseWriter
+	status      int
+	buf         bytes.Buffer
+	limit       int
+	passThrough bool
+}
+
+func (br *bufferedResponse) WriteHeader(status int) {
+	if br.status == 0 {
+		br.status = status
+	}
+}
+
+func (br *bufferedResponse) Write(p []byte) (int, error) {
+	if br.status == 0 {
+		br.status = http.StatusOK
+	}
+	if br.passThrough {
+		return br.ResponseWriter.Write(p)
+	}
+	if br.status != http.StatusOK || br.buf.Len()+len(p) > br.limit {
+		br.passThrough = true
+		br.ResponseWriter.WriteHeader(br.status)
+		if br.buf.Len() > 0 {
+			if _, err := br.ResponseWriter.Write(br.buf.Bytes()); err != nil {
+				return 0, err
+			}
+			br.buf.Reset()
+		}
+		return br.ResponseWriter.Write(p)
+	}
+	return br.buf.Write(p)
+}
+
+func writeCached(w http.ResponseWriter, r *http.Request, entry CacheEntry, hit bool) {
+	h := w.Header()
+	for k, vs := range entry.Header {
+		h[k] = vs
+	}
+	h.Set("ETag", entry.ETag)
+	h.Set("Cache-Control", "private, no-cache")
+	if hit {
+		h.Set("X-Cache", "HIT")
+	} else {
+		h.Set("X-Cache", "MISS")
+	}
+
+	if etagMatches(r.Header.Get("If-None-Match"), entry.ETag) {
+		h.Del("Content-Length")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		w.Write(entry.Body)
+	}
+}
+
+// responseCache caches the successful reads per user, answers If-None-Match with 304s
+// and drops all the entries after a successful write
+func responseCache(cache Cache, cfg CacheConfig) middleware {
+	if cache == nil || cfg.TTL <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !cacheable(r.Context()) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				sr := &statusRecorder{ResponseWriter: w}
+				next.ServeHTTP(sr, r)
+				if sr.status < http.StatusBadRequest {
+					invalidateAll(cache)
+				}
+				return
+			}
+
+			// the representation depends on these headers too
+			key := cacheUserPrefix(r.Context()) + "proxy:" + r.URL.RequestURI() +
+				"|" + r.Header.Get("Accept") + "|" + r.Header.Get("Accept-Encoding")
+			if entry, ok := cache.Get(key); ok && !strings.Contains(r.Header.Get("Cache-Control"), "no-cache") {
+				writeCached(w, r, entry, true)
+				return
+			}
+
+			br := &bufferedResponse{ResponseWriter: w, limit: cfg.MaxBodySize}
+			next.ServeHTTP(br, r)
+			if br.passThrough {
+				return
+			}
+			if br.status == 0 {
+				br.status = http.StatusOK
+			}
+			if br.status != http.StatusOK || w.Header().Get("Set-Cookie") != "" || r.Method == http.MethodHead {
+				w.WriteHeader(br.status)
+				w.Write(br.buf.Bytes())
+				return
+			}
+
+			entry := CacheEntry{Header: w.Header().Clone(), Body: br.buf.Bytes(), ETag: etagFor(br.buf.Bytes())}
+			// the per request headers, set by the outer middlewares, are not replayed
+			for _, h := range append(corsHeaders, requestIDHeader, "Vary", "Retry-After") {
+				entry.Header.Del(h)
+			}
+			// the CSP nonce is per request too
+			for _, h := range securityHeaderNames {
+				entry.Header.Del(h)
+			}
+			for h := range entry.Header {
+				if strings.HasPrefix(h, "Ratelimit-") {
+					entry.Header.Del(h)
+				}
+			}
+			cache.Set(key, entry, cfg.TTL)
+			writeCached(w, r, entry, false)
+		})
+	}
+}
+
+// cachedService caches the SlashDB Get calls per user, the other calls drop all the entries
+type cachedService struct {
+	next  slashdb.CRUDer
+	cache Cache
+	ttl   time.Duration
+}
+
+// NewCachedService wraps a SlashDB service with a per user read cache
+func NewCachedService(next slashdb.CRUDer, cache Cache, ttl time.Duration) slashdb.CRUDer {
+	if cache == nil || ttl <= 0 {
+		return next
+	}
+	return &cachedService{next: next, cache: cache, ttl: ttl}
+}
+
+func (s *cachedService) Get(ctx context.Context, sdbReq fmt.Stringer, container interface{}) error {
+	if domain.CacheSkipped(ctx) || !cacheable(ctx) {
+		return s.next.Get(ctx, sdbReq, container)
+	}
+	key := cacheUserPrefix(ctx) + "sdb:" + sdbCacheKey(sdbReq)
+	if entry, ok := s.cache.Get(key); ok {
+		return json.Unmarshal(entry.Body, container)
+	}
+
+	var raw json.RawMessage
+	if err := s.next.Get(ctx, sdbReq, &raw); err != nil {
+		return err
+	}
+	s.cache.Set(key, CacheEntry{Body: raw, ETag: etagFor(raw)}, s.ttl)
+	if err := json.Unmarshal(raw, container); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+}
+
+func (s *cachedService) invalidate(err error) {
+	if err == nil {
+		invalidateAll(s.cache)
+	}
+}
+
+func (s *cachedService) Create(
+	ctx context.Context,
+	sdbReq fmt.Stringer,
+	payload interface{},
+) (types.CreateResponse, error) {
+	resp, err := s.next.Create(ctx, sdbReq, payload)
+	s.invalidate(err)
+	return resp, err
+}
+
+func (s *cachedService) Update(ctx context.Context, sdbReq fmt.Stringer, payload interface{}) error {
+	err := s.next.Update(ctx, sdbReq, payload)
+	s.invalidate(err)
+	return err
+}
+
+func (s *cachedService) Delete(ctx context.Context, sdbReq fmt.Stringer) error {
+	err := s.next.Delete(ctx, sdbReq)
+	s.invalidate(err)
+	return err
+}
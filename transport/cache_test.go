@@ -0,0 +1,294 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boromil/timesheet/domain"
+	"gitlab.com/boromil/goslashdb/slashdb"
+	"gitlab.com/boromil/goslashdb/types"
+)
+
+// withTestUser returns the context of a request authenticated as the user
+func withTestUser(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, requestInfoKey, &requestInfo{id: "test", userID: userID})
+}
+
+func TestLRUCache(t *testing.T) {
+	entry := func(body string) CacheEntry { return CacheEntry{Body: []byte(body)} }
+	type op struct {
+		// do is one of set, get, invalidate
+		do, key, body string
+		ttl           time.Duration
+		wantHit       bool
+	}
+	tests := []struct {
+		name       string
+		maxEntries int
+		ops        []op
+	}{
+		{
+			name: "hit and miss",
+			ops: []op{
+				{do: "set", key: "a", body: "1", ttl: time.Minute},
+				{do: "get", key: "a", body: "1", wantHit: true},
+				{do: "get", key: "b"},
+			},
+		},
+		{
+			name: "expired",
+			ops: []op{
+				{do: "set", key: "a", body: "1", ttl: -time.Second},
+				{do: "get", key: "a"},
+			},
+		},
+		{
+			name: "overwrite",
+			ops: []op{
+				{do: "set", key: "a", body: "1", ttl: time.Minute},
+				{do: "set", key: "a", body: "2", ttl: time.Minute},
+				{do: "get", key: "a", body: "2", wantHit: true},
+			},
+		},
+		{
+			name:       "least recently used evicted",
+			maxEntries: 2,
+			ops: []op{
+				{do: "set", key: "a", body: "1", ttl: time.Minute},
+				{do: "set", key: "b", body: "2", ttl: time.Minute},
+				{do: "get", key: "a", body: "1", wantHit: true},
+				{do: "set", key: "c", body: "3", ttl: time.Minute},
+				{do: "get", key: "b"},
+				{do: "get", key: "a", body: "1", wantHit: true},
+				{do: "get", key: "c", body: "3", wantHit: true},
+			},
+		},
+		{
+			name: "prefix invalidated",
+			ops: []op{
+				{do: "set", key: "user:1|a", body: "1", ttl: time.Minute},
+				{do: "set", key: "user:1|b", body: "2", ttl: time.Minute},
+				{do: "set", key: "user:10|a", body: "3", ttl: time.Minute},
+				{do: "invalidate", key: "user:1|"},
+				{do: "get", key: "user:1|a"},
+				{do: "get", key: "user:1|b"},
+				{do: "get", key: "user:10|a", body: "3", wantHit: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewLRUCache(tt.maxEntries)
+			for i, o := range tt.ops {
+				switch o.do {
+				case "set":
+					c.Set(o.key, entry(o.body), o.ttl)
+				case "invalidate":
+					c.InvalidatePrefix(o.key)
+				case "get":
+					got, ok := c.Get(o.key)
+					if ok != o.wantHit || string(got.Body) != o.body {
+						t.Fatalf("op %d: got (%q, %v), want (%q, %v)", i, got.Body, ok, o.body, o.wantHit)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := etagFor([]byte("body"))
+	tests := []struct {
+		name, ifNoneMatch string
+		want              bool
+	}{
+		{name: "empty", ifNoneMatch: "", want: false},
+		{name: "same", ifNoneMatch: etag, want: true},
+		{name: "weak", ifNoneMatch: "W/" + etag, want: true},
+		{name: "in a list", ifNoneMatch: `"other", ` + etag, want: true},
+		{name: "any", ifNoneMatch: "*", want: true},
+		{name: "other", ifNoneMatch: `"other"`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.ifNoneMatch, etag); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if etagFor([]byte("a")) == etagFor([]byte("b")) {
+		t.Error("different bodies have the same ETag")
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	calls := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/big":
+			w.Write([]byte(strings.Repeat("x", 100)))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"path":"` + r.URL.Path + `"}`))
+		}
+	})
+	h := responseCache(NewLRUCache(10), CacheConfig{TTL: time.Minute, MaxBodySize: 50})(next)
+	etag := etagFor([]byte(`{"path":"/a"}`))
+
+	tests := []struct {
+		name, method, path, user, ifNoneMatch string
+		wantStatus                            int
+		wantCache                             string
+		wantCalls                             int
+	}{
+		{name: "miss", method: http.MethodGet, path: "/a", user: "1", wantStatus: http.StatusOK, wantCache: "MISS", wantCalls: 1},
+		{name: "hit", method: http.MethodGet, path: "/a", user: "1", wantStatus: http.StatusOK, wantCache: "HIT", wantCalls: 1},
+		{name: "not modified", method: http.MethodGet, path: "/a", user: "1", ifNoneMatch: etag, wantStatus: http.StatusNotModified, wantCache: "HIT", wantCalls: 1},
+		{name: "per user", method: http.MethodGet, path: "/a", user: "2", wantStatus: http.StatusOK, wantCache: "MISS", wantCalls: 2},
+		{name: "write invalidates", method: http.MethodPost, path: "/a", user: "1", wantStatus: http.StatusCreated, wantCalls: 3},
+		{name: "miss after the write", method: http.MethodGet, path: "/a", user: "1", wantStatus: http.StatusOK, wantCache: "MISS", wantCalls: 4},
+		// the other users may read the written rows too
+		{name: "other user invalidated", method: http.MethodGet, path: "/a", user: "2", wantStatus: http.StatusOK, wantCache: "MISS", wantCalls: 5},
+		{name: "errors not cached", method: http.MethodGet, path: "/missing", user: "1", wantStatus: http.StatusNotFound, wantCalls: 6},
+		{name: "errors not cached again", method: http.MethodGet, path: "/missing", user: "1", wantStatus: http.StatusNotFound, wantCalls: 7},
+		{name: "too big passed through", method: http.MethodGet, path: "/big", user: "1", wantStatus: http.StatusOK, wantCalls: 8},
+		{name: "too big not cached", method: http.MethodGet, path: "/big", user: "1", wantStatus: http.StatusOK, wantCalls: 9},
+		{name: "anonymous", method: http.MethodGet, path: "/a", wantStatus: http.StatusOK, wantCalls: 10},
+		{name: "anonymous not cached", method: http.MethodGet, path: "/a", wantStatus: http.StatusOK, wantCalls: 11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.user != "" {
+				req = req.WithContext(withTestUser(req.Context(), tt.user))
+			}
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("X-Cache"); got != tt.wantCache {
+				t.Errorf("got X-Cache %q, want %q", got, tt.wantCache)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("got %d handler calls, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// countingService counts the SlashDB reads, it returns the request URL as the result
+type countingService struct {
+	gets map[string]int
+}
+
+func (s *countingService) Get(ctx context.Context, sdbReq fmt.Stringer, container interface{}) error {
+	s.gets[sdbReq.String()]++
+	b, _ := json.Marshal([]string{sdbReq.String()})
+	return json.Unmarshal(b, container)
+}
+
+func (s *countingService) Create(context.Context, fmt.Stringer, interface{}) (types.CreateResponse, error) {
+	return types.CreateResponse{}, nil
+}
+
+func (s *countingService) Update(context.Context, fmt.Stringer, interface{}) error { return nil }
+
+func (s *countingService) Delete(context.Context, fmt.Stringer) error { return nil }
+
+func TestCachedService(t *testing.T) {
+	req := func() *slashdb.Request {
+		r := slashdb.NewDataRequest("")
+		r.AddParts(slashdb.Part{Name: "timesheet", Filter: slashdb.Filter{
+			Values: map[string][]string{"user_id": {"1"}, "project_id": {"2"}, "date": {"2026-01-01"}},
+		}})
+		r.Params = map[string]string{"limit": "10", "offset": "20", "sort": "date", "headers": "true"}
+		return r
+	}
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		write bool
+		// repeat is the number of reads, one by default
+		repeat    int
+		wantReads int
+	}{
+		{name: "first read", ctx: withTestUser(context.Background(), "1"), wantReads: 1},
+		// the map orders differ from call to call, the key must not
+		{name: "cached", ctx: withTestUser(context.Background(), "1"), repeat: 10, wantReads: 1},
+		{name: "another user", ctx: withTestUser(context.Background(), "2"), wantReads: 2},
+		{name: "write invalidates", ctx: withTestUser(context.Background(), "1"), write: true, wantReads: 3},
+		{name: "cached again", ctx: withTestUser(context.Background(), "1"), wantReads: 3},
+		// e.g. a project member reads the entries of the writer
+		{name: "the other users invalidated too", ctx: withTestUser(context.Background(), "2"), wantReads: 4},
+		{name: "the other user cached again", ctx: withTestUser(context.Background(), "2"), wantReads: 4},
+		{name: "no user", ctx: context.Background(), wantReads: 5},
+		{name: "no user not cached", ctx: context.Background(), wantReads: 6},
+		{name: "no authenticated user", ctx: withTestUser(context.Background(), ""), wantReads: 7},
+		{name: "skipped", ctx: domain.WithoutCache(withTestUser(context.Background(), "1")), wantReads: 8},
+	}
+	next := &countingService{gets: map[string]int{}}
+	svc := NewCachedService(next, NewLRUCache(100), time.Minute)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.write {
+				if err := svc.Update(tt.ctx, req(), nil); err != nil {
+					t.Fatalf("Update: %v", err)
+				}
+			}
+			for i := 0; i < max(tt.repeat, 1); i++ {
+				got := []string{}
+				if err := svc.Get(tt.ctx, req(), &got); err != nil {
+					t.Fatalf("Get: %v", err)
+				}
+				if len(got) != 1 {
+					t.Fatalf("got %v, want the request URL", got)
+				}
+			}
+			reads := 0
+			for _, n := range next.gets {
+				reads += n
+			}
+			if reads != tt.wantReads {
+				t.Errorf("got %d reads, want %d", reads, tt.wantReads)
+			}
+		})
+	}
+}
+
+func TestSDBCacheKey(t *testing.T) {
+	r := slashdb.NewDataRequest("")
+	r.AddParts(slashdb.Part{Name: "timesheet", Filter: slashdb.Filter{
+		Values: map[string][]string{"user_id": {"1"}, "project_id": {"2", "3"}},
+	}})
+	r.Params = map[string]string{"sort": "date", "limit": "10"}
+	want := "/db/timesheet/project_id/2,3/user_id/1.json?limit=10&sort=date"
+	for i := 0; i < 20; i++ {
+		if got := sdbCacheKey(r); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+
+	ordered := slashdb.NewDataRequest("")
+	ordered.AddParts(slashdb.Part{Name: "timesheet", Filter: slashdb.Filter{
+		Values: map[string][]string{"user_id": {"1"}, "project_id": {"2"}},
+		Order:  []string{"user_id", "project_id"},
+	}})
+	if got, want := sdbCacheKey(ordered), "/db/timesheet/user_id/1/project_id/2.json"; got != want {
+		t.Errorf("got %s, want %s, the given order is kept", got, want)
+	}
+}
@@ -0,0 +1,138 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/boromil/timesheet/domain"
+	"github.com/boromil/timesheet/ical"
+)
+
+// calendarFeedPath is where the calendar feeds are served, by their tokens
+const calendarFeedPath = "/app/calendar/"
+
+// requestOrigin returns the scheme and the host the request was made to
+func requestOrigin(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if p, _, _ := strings.Cut(r.Header.Get("X-Forwarded-Proto"), ","); p == "https" || p == "http" {
+		scheme = p
+	}
+	return scheme + "://" + r.Host
+}
+
+// calendarFeedURL returns the user calendar feed URLs, to subscribe to in the calendar apps
+func (h *apiHandler) calendarFeedURL(w http.ResponseWriter, r *http.Request, userID int) {
+	// the token may have just been set by a concurrent request, the cached user would miss it
+	token, err := h.svc.CalendarToken(domain.WithoutCache(r.Context()), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeCalendarFeedURL(w, r, token)
+}
+
+// rotateCalendarFeed replaces the user calendar feed token, the old feed URL stops working
+func (h *apiHandler) rotateCalendarFeed(w http.ResponseWriter, r *http.Request, userID int) {
+	token, err := h.svc.RotateCalendarToken(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeCalendarFeedURL(w, r, token)
+}
+
+// writeCalendarFeedURL writes the URLs of the calendar feed with the token
+func writeCalendarFeedURL(w http.ResponseWriter, r *http.Request, token string) {
+	u := requestOrigin(r) + calendarFeedPath + token + ".ics"
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, map[string]string{
+		"url":        u,
+		"webcal_url": "webcal://" + strings.SplitN(u, "://", 2)[1],
+	})
+}
+
+// calendar downloads the user entries as an .ics file, filtered like the entries list
+func (h *apiHandler) calendar(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	f := q.entryFilter()
+	if !q.valid(w) {
+		return
+	}
+	h.writeCalendar(w, r, userID, f, func(hd http.Header) {
+		hd.Set("Content-Disposition", fmt.Sprintf(
+			`attachment; filename="timesheet-%s.ics"`, time.Now().UTC().Format("20060102"),
+		))
+		hd.Set("Cache-Control", "no-store")
+	})
+}
+
+// calendarFeed serves the calendar of the feed token user, with all the entries
+func (h *apiHandler) calendarFeed(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.svc.CalendarUser(r.Context(), strings.TrimSuffix(r.PathValue("token"), ".ics"))
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	h.writeCalendar(w, r, userID, domain.EntryFilter{}, func(hd http.Header) {
+		// the calendar apps poll the feeds
+		hd.Set("Cache-Control", "private, max-age=300")
+	})
+}
+
+// writeCalendar writes the user entries as the calendar events, headers sets the response specific headers
+func (h *apiHandler) writeCalendar(
+	w http.ResponseWriter, r *http.Request, userID int, f domain.EntryFilter, headers func(http.Header),
+) {
+	u, err := h.svc.User(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	names, err := h.svc.ProjectNames(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+
+	// the output starts with the first entry, so the errors before it still get the error envelope
+	var cw *ical.Writer
+	begin := func() {
+		hd := w.Header()
+		hd.Set("Content-Type", "text/calendar; charset=utf-8")
+		hd.Set("X-Content-Type-Options", "nosniff")
+		headers(hd)
+		w.WriteHeader(http.StatusOK)
+		cw = ical.NewWriter(w, "Timesheet - "+u.Username)
+	}
+	err = h.svc.EachEntry(domain.WithoutCache(r.Context()), userID, f, func(e domain.Entry) error {
+		if cw == nil {
+			begin()
+		}
+		return cw.WriteEvent(ical.Event{
+			UID:         fmt.Sprintf("%d-%s@timesheet", userID, e.ID),
+			Start:       e.Date,
+			Duration:    time.Duration(e.Duration * float64(time.Hour)),
+			Summary:     names[e.ProjectID],
+			Description: e.Accomplishments,
+		})
+	})
+	if err != nil && cw == nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	if err != nil {
+		abortExport(r, err)
+	}
+	if cw == nil {
+		// no entries, an empty calendar
+		begin()
+	}
+	if err := cw.Close(); err != nil {
+		abortExport(r, err)
+	}
+}
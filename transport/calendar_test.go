@@ -0,0 +1,134 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestCalendarFeed(t *testing.T) {
+	h, fake := newTestServer(t, Config{})
+	fake.Insert("user", sdbtest.Row{"id": 1, "username": "user", "passwd": "x", "email": "", "calendar_token": nil})
+	fake.Insert("project", sdbtest.Row{"id": 1, "name": "Project", "description": "", "client_id": nil, "timestamp": "2026-01-01T00:00:00"})
+	fake.Insert("timesheet",
+		sdbtest.Row{"user_id": 1, "project_id": 1, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""},
+		sdbtest.Row{"user_id": 1, "project_id": 1, "date": "2026-03-02T09:15:00", "duration": 1.5, "accomplishments": "Planning"},
+	)
+	token := testToken(t, 1)
+
+	// feedURL asks the API for the feed URL, the path of it is returned
+	feedURL := func(method, path string) string {
+		t.Helper()
+		r := httptest.NewRequest(method, apiPrefix+path, nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s %s: got status %d, want 200: %s", method, path, rec.Code, rec.Body)
+		}
+		urls := map[string]string{}
+		if err := json.NewDecoder(rec.Body).Decode(&urls); err != nil {
+			t.Fatalf("error decoding the feed URLs: %v", err)
+		}
+		u, err := url.Parse(urls["url"])
+		if err != nil || !strings.HasPrefix(urls["webcal_url"], "webcal://") {
+			t.Fatalf("got feed URLs %v", urls)
+		}
+		return u.Path
+	}
+	// feed returns the status of the feed
+	feed := func(path string) int {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code == http.StatusOK && !strings.Contains(rec.Body.String(), "SUMMARY:Project") {
+			t.Errorf("the feed has no entry: %s", rec.Body)
+		}
+		return rec.Code
+	}
+
+	path := feedURL(http.MethodGet, "/calendar/feed")
+	if again := feedURL(http.MethodGet, "/calendar/feed"); again != path {
+		t.Errorf("got feed URL %s the second time, want %s", again, path)
+	}
+	if got := feed(path); got != http.StatusOK {
+		t.Errorf("got feed status %d, want 200", got)
+	}
+
+	rotated := feedURL(http.MethodPost, "/calendar/feed/rotate")
+	if rotated == path {
+		t.Fatal("the rotated feed URL is the same")
+	}
+	if got := feed(path); got != http.StatusNotFound {
+		t.Errorf("got the old feed status %d, want 404", got)
+	}
+	if got := feed(rotated); got != http.StatusOK {
+		t.Errorf("got the rotated feed status %d, want 200", got)
+	}
+	for _, path := range []string{calendarFeedPath + "1.c2lnbmF0dXJl.ics", calendarFeedPath + "x.ics"} {
+		if got := feed(path); got != http.StatusNotFound {
+			t.Errorf("got feed %s status %d, want 404", path, got)
+		}
+	}
+}
+
+func TestRedactPath(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: calendarFeedPath + "c2VjcmV0.ics", want: calendarFeedPath + redactedValue + ".ics"},
+		{in: calendarFeedPath + "c2VjcmV0", want: calendarFeedPath + redactedValue},
+		{in: "http://sdb/db/x/user/calendar_token/c2VjcmV0.json?limit=1", want: "http://sdb/db/x/user/calendar_token/" + redactedValue + ".json?limit=1"},
+		{in: "/db/x/user/calendar_token/c2VjcmV0/id/1.json", want: "/db/x/user/calendar_token/" + redactedValue + "/id/1.json"},
+		{in: "/api/v1/calendar/feed", want: "/api/v1/calendar/feed"},
+		{in: "/db/x/timesheet/user_id/1.json", want: "/db/x/timesheet/user_id/1.json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := redactPath(tt.in); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarFeedTokenNotLogged(t *testing.T) {
+	spans := recordSpans()
+	buf := &bytes.Buffer{}
+	logger, err := NewLogger(buf, LogConfig{Format: "json", Level: "debug"})
+	if err != nil {
+		t.Fatalf("NewLogger: %v", err)
+	}
+	const secret = "c2VjcmV0LXRva2Vu"
+	var got string
+	h := chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Path + " " + r.RequestURI
+	}), tracing, requestLogging(logger))
+	before := len(spans.Ended())
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, calendarFeedPath+secret+".ics", nil))
+
+	if path := calendarFeedPath + secret + ".ics"; got != path+" "+path {
+		t.Errorf("the handler got %q, want the feed path as it came", got)
+	}
+	if strings.Contains(buf.String(), secret) || !strings.Contains(buf.String(), redactedValue) {
+		t.Errorf("the token is logged: %s", buf)
+	}
+	ended := spans.Ended()[before:]
+	if len(ended) != 1 {
+		t.Fatalf("got %d spans, want 1", len(ended))
+	}
+	if name := ended[0].Name(); strings.Contains(name, secret) {
+		t.Errorf("the token is in the span name %q", name)
+	}
+	for _, a := range ended[0].Attributes() {
+		if strings.Contains(a.Value.Emit(), secret) {
+			t.Errorf("the token is in the span attribute %s=%s", a.Key, a.Value.Emit())
+		}
+	}
+}
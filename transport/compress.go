@@ -0,0 +1,355 @@
+package transport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+// supported content encodings, in the order of preference
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+var encodings = []string{encodingBrotli, encodingGzip}
+
+// precompressedExt maps the content encodings to the extensions of the files precompressed at build time
+var precompressedExt = map[string]string{encodingBrotli: ".br", encodingGzip: ".gz"}
+
+// decompress returns the content of a precompressed file
+func decompress(encoding string, data []byte) ([]byte, error) {
+	var r io.Reader = brotli.NewReader(bytes.NewReader(data))
+	if encoding == encodingGzip {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("gzip.NewReader: %w", err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+	return io.ReadAll(r)
+}
+
+// CompressionConfig - container for the response compression settings
+type CompressionConfig struct {
+	// MinSize is the smallest response body (in bytes) worth compressing, a negative one turns the compression off
+	MinSize int
+	// ContentTypes lists the compressible media types, an entry ending with "/" matches the whole type i.e. "text/"
+	ContentTypes []string
+}
+
+// DefaultCompressibleTypes - the media types compressed by default
+var DefaultCompressibleTypes = []string{
+	"text/",
+	"application/json",
+	"application/javascript",
+	"application/xml",
+	"image/svg+xml",
+}
+
+func (c CompressionConfig) compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, ct := range c.ContentTypes {
+		if mediaType == ct || (strings.HasSuffix(ct, "/") && strings.HasPrefix(mediaType, ct)) {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateEncoding picks the most preferred of the offered encodings accepted by the client,
+// it returns "" when none is
+func negotiateEncoding(acceptEncoding string, offered []string) string {
+	best, bestQ := "", 0.0
+	for _, enc := range offered {
+		q := encodingQuality(acceptEncoding, enc)
+		if q > bestQ {
+			best, bestQ = enc, q
+		}
+	}
+	return best
+}
+
+func encodingQuality(acceptEncoding, encoding string) float64 {
+	q := 0.0
+	for _, item := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(item), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != encoding && name != "*" {
+			continue
+		}
+		itemQ := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				itemQ = parsed
+			}
+		}
+		if name == encoding {
+			// an exact match beats the wildcard
+			return itemQ
+		}
+		q = itemQ
+	}
+	return q
+}
+
+var (
+	gzipWriters   = sync.Pool{New: func() any { return gzip.NewWriter(io.Discard) }}
+	brotliWriters = sync.Pool{New: func() any { return brotli.NewWriterLevel(io.Discard, 4) }}
+)
+
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(io.Writer)
+}
+
+func getEncoder(encoding string, w io.Writer) encoder {
+	var enc encoder
+	if encoding == encodingBrotli {
+		enc = brotliWriters.Get().(*brotli.Writer)
+	} else {
+		enc = gzipWriters.Get().(*gzip.Writer)
+	}
+	enc.Reset(w)
+	return enc
+}
+
+func putEncoder(enc encoder) {
+	enc.Reset(io.Discard)
+	switch e := enc.(type) {
+	case *brotli.Writer:
+		brotliWriters.Put(e)
+	case *gzip.Writer:
+		gzipWriters.Put(e)
+	}
+}
+
+// compressWriter holds the response back until MinSize bytes are written (or the handler is done),
+// then it decides whether to compress it, based on the status and the response headers
+type compressWriter struct {
+	http.ResponseWriter
+	cfg      CompressionConfig
+	encoding string
+
+	status  int
+	buf     []byte
+	decided bool
+	enc     encoder
+}
+
+func (cw *compressWriter) WriteHeader(status int) {
+	if status < http.StatusOK {
+		// informational responses go out right away
+		cw.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if cw.status == 0 {
+		cw.status = status
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if cw.status == 0 {
+		cw.status = http.StatusOK
+	}
+	if !cw.decided {
+		cw.buf = append(cw.buf, p...)
+		if len(cw.buf) < cw.cfg.MinSize {
+			return len(p), nil
+		}
+		if err := cw.decide(); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	}
+	if cw.enc != nil {
+		return cw.enc.Write(p)
+	}
+	return cw.ResponseWriter.Write(p)
+}
+
+func (cw *compressWriter) decide() error {
+	cw.decided = true
+	if cw.status == 0 {
+		cw.status = http.StatusOK
+	}
+
+	h := cw.Header()
+	if h.Get("Content-Type") == "" && len(cw.buf) > 0 {
+		h.Set("Content-Type", http.DetectContentType(cw.buf))
+	}
+	if h.Get("Content-Encoding") == "" && cw.cfg.compressible(h.Get("Content-Type")) {
+		if !headerHasToken(h, "Vary", "Accept-Encoding") {
+			h.Add("Vary", "Accept-Encoding")
+		}
+		if len(cw.buf) >= cw.cfg.MinSize && len(cw.buf) > 0 && h.Get("Content-Range") == "" &&
+			cw.status != http.StatusNoContent && cw.status != http.StatusNotModified {
+			h.Del("Content-Length")
+			h.Set("Content-Encoding", cw.encoding)
+			// the compressed representation is not byte for byte the same
+			if etag := h.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+				h.Set("ETag", "W/"+etag)
+			}
+			cw.enc = getEncoder(cw.encoding, cw.ResponseWriter)
+		}
+	}
+
+	cw.ResponseWriter.WriteHeader(cw.status)
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if cw.enc != nil {
+		_, err := cw.enc.Write(buf)
+		return err
+	}
+	_, err := cw.ResponseWriter.Write(buf)
+	return err
+}
+
+func headerHasToken(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// close writes out whatever is still held back and finishes the compressed stream
+func (cw *compressWriter) close() error {
+	if !cw.decided {
+		if cw.status == 0 && len(cw.buf) == 0 {
+			// nothing written, let the server do its defaults
+			cw.decided = true
+			return nil
+		}
+		if err := cw.decide(); err != nil {
+			return err
+		}
+	}
+	if cw.enc == nil {
+		return nil
+	}
+	err := cw.enc.Close()
+	putEncoder(cw.enc)
+	cw.enc = nil
+	return err
+}
+
+func (cw *compressWriter) Flush() {
+	if !cw.decided {
+		cw.decide()
+	}
+	if cw.enc != nil {
+		cw.enc.Flush()
+	}
+	http.NewResponseController(cw.ResponseWriter).Flush()
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// compression compresses the responses of the allowed content types with brotli or gzip,
+// depending on what the client accepts, the already encoded responses pass through untouched
+func compression(cfg CompressionConfig) middleware {
+	if cfg.MinSize < 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"), encodings)
+			if encoding == "" || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &compressWriter{ResponseWriter: w, cfg: cfg, encoding: encoding}
+			// not deferred, a panicking handler leaves the response to the recovery middleware
+			next.ServeHTTP(cw, r)
+			if err := cw.close(); err != nil {
+				loggerFrom(r.Context()).Debug("error finishing a compressed response", slog.String("error", err.Error()))
+			}
+		})
+	}
+}
+
+// precompressed serves the precompressed (at build time) variant of the requested file,
+// if there's one the client accepts and valid reports it matches the file, falling back to next otherwise
+func precompressed(fs http.FileSystem, valid func(name string) bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		name := r.URL.Path
+		if !strings.HasPrefix(name, "/") {
+			name = "/" + name
+		}
+		if strings.HasSuffix(name, "/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var (
+			f        http.File
+			encoding string
+		)
+		for _, enc := range encodings {
+			if encodingQuality(r.Header.Get("Accept-Encoding"), enc) <= 0 {
+				continue
+			}
+			variant := name + precompressedExt[enc]
+			if !valid(variant) {
+				continue
+			}
+			if tmp, err := fs.Open(variant); err == nil {
+				f, encoding = tmp, enc
+				break
+			}
+		}
+		if f == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		defer f.Close()
+
+		stat, err := f.Stat()
+		if err != nil || stat.IsDir() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// ServeContent would sniff the compressed bytes otherwise
+		ct := mime.TypeByExtension(path.Ext(name))
+		if ct == "" {
+			ct = "application/octet-stream"
+		}
+		w.Header().Set("Content-Type", ct)
+		w.Header().Set("Content-Encoding", encoding)
+		// the encoded variant is not byte for byte the same
+		if etag := w.Header().Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			w.Header().Set("ETag", "W/"+etag)
+		}
+		http.ServeContent(w, r, name, stat.ModTime(), f)
+	})
+}
@@ -0,0 +1,115 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CORSConfig - container for the CORS policy settings
+type CORSConfig struct {
+	// AllowedOrigins lists the allowed origins i.e. "https://example.com", "*" allows any origin,
+	// when empty only same-origin requests are allowed
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	// AllowCredentials lets the browser send cookies/authorization along, the matched origin
+	// is echoed back in that case, it can't be combined with the "*" origin
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// corsHeaders are dropped from the SlashDB responses, the policy is set by the cors middleware only
+var corsHeaders = []string{
+	"Access-Control-Allow-Origin",
+	"Access-Control-Allow-Methods",
+	"Access-Control-Allow-Headers",
+	"Access-Control-Allow-Credentials",
+	"Access-Control-Expose-Headers",
+	"Access-Control-Max-Age",
+}
+
+// corsExposedHeaders are the response headers the cross-origin clients can read,
+// the request ID, the caching and the rate limiting ones
+var corsExposedHeaders = strings.Join([]string{
+	requestIDHeader, "ETag", "Retry-After", "RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset",
+}, ", ")
+
+// Validate checks the policy, any site could read the users data with the credentials allowed for any origin
+func (c CORSConfig) Validate() error {
+	for _, o := range c.AllowedOrigins {
+		if o == "*" && c.AllowCredentials {
+			return errors.New(`the credentials can't be allowed for any origin ("*"), list the origins`)
+		}
+	}
+	return nil
+}
+
+func (c CORSConfig) originAllowed(origin string) (allowed, wildcard bool) {
+	for _, o := range c.AllowedOrigins {
+		if o == "*" {
+			return true, true
+		}
+		if strings.EqualFold(o, origin) {
+			return true, false
+		}
+	}
+	return false, false
+}
+
+// cors applies the CORS policy, preflight requests are answered right away
+// so they never reach the auth middleware
+func cors(cfg CORSConfig) middleware {
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+	maxAge := strconv.Itoa(int(cfg.MaxAge.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+			h := w.Header()
+			h.Add("Vary", "Origin")
+			if preflight {
+				h.Add("Vary", "Access-Control-Request-Method")
+				h.Add("Vary", "Access-Control-Request-Headers")
+			}
+
+			allowed, wildcard := cfg.originAllowed(origin)
+			if origin == "" || !allowed {
+				if preflight {
+					// no CORS headers, the browser will block the actual request
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if wildcard {
+				h.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				h.Set("Access-Control-Allow-Origin", origin)
+			}
+			if cfg.AllowCredentials {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			if !preflight {
+				h.Set("Access-Control-Expose-Headers", corsExposedHeaders)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h.Set("Access-Control-Allow-Methods", methods)
+			h.Set("Access-Control-Allow-Headers", headers)
+			if cfg.MaxAge > 0 {
+				h.Set("Access-Control-Max-Age", maxAge)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCORSConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     CORSConfig
+		wantErr bool
+	}{
+		{name: "listed origins with the credentials", cfg: CORSConfig{AllowedOrigins: []string{"https://app.example"}, AllowCredentials: true}},
+		{name: "any origin", cfg: CORSConfig{AllowedOrigins: []string{"*"}}},
+		{name: "any origin with the credentials", cfg: CORSConfig{AllowedOrigins: []string{"https://app.example", "*"}, AllowCredentials: true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want an error %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	_, err := NewServer(Config{CORS: CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true}}, Deps{})
+	if err == nil || !strings.HasPrefix(err.Error(), "CORS: ") {
+		t.Errorf("got NewServer error %v, want the CORS one", err)
+	}
+}
+
+func TestCORS(t *testing.T) {
+	tests := []struct {
+		name            string
+		cfg             CORSConfig
+		origin          string
+		wantOrigin      string
+		wantCredentials bool
+	}{
+		{name: "any origin", cfg: CORSConfig{AllowedOrigins: []string{"*"}}, origin: "https://app.example", wantOrigin: "*"},
+		{
+			name:            "a listed origin with the credentials",
+			cfg:             CORSConfig{AllowedOrigins: []string{"https://app.example"}, AllowCredentials: true},
+			origin:          "https://app.example",
+			wantOrigin:      "https://app.example",
+			wantCredentials: true,
+		},
+		{name: "not listed", cfg: CORSConfig{AllowedOrigins: []string{"https://app.example"}}, origin: "https://evil.example"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/entries", nil)
+			r.Header.Set("Origin", tt.origin)
+			rec := httptest.NewRecorder()
+			cors(tt.cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).ServeHTTP(rec, r)
+
+			h := rec.Header()
+			if got := h.Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("got the allowed origin %q, want %q", got, tt.wantOrigin)
+			}
+			if got := h.Get("Access-Control-Allow-Credentials") == "true"; got != tt.wantCredentials {
+				t.Errorf("got the credentials allowed %v, want %v", got, tt.wantCredentials)
+			}
+			if tt.wantOrigin == "" {
+				return
+			}
+			exposed := h.Get("Access-Control-Expose-Headers")
+			for _, name := range []string{requestIDHeader, "ETag", "Retry-After", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset"} {
+				if !strings.Contains(exposed, name) {
+					t.Errorf("got the exposed headers %q, want %s among them", exposed, name)
+				}
+			}
+		})
+	}
+}
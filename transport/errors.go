@@ -0,0 +1,178 @@
+package transport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"gitlab.com/boromil/goslashdb/types"
+)
+
+// error codes used in the error envelope
+const (
+	codeBadRequest       = "bad_request"
+	codeValidation       = "validation_failed"
+	codeUnauthorized     = "unauthorized"
+	codeForbidden        = "forbidden"
+	codeNotFound         = "not_found"
+	codeMethodNotAllowed = "method_not_allowed"
+	codeConflict         = "conflict"
+	codeTooLarge         = "payload_too_large"
+	codeInternal         = "internal_error"
+	codeBadGateway       = "bad_gateway"
+	codeUnavailable      = "service_unavailable"
+	codeUpstream         = "upstream_error"
+)
+
+// apiError - the error model returned by every app endpoint and by the proxy
+type apiError struct {
+	Code      string              `json:"code"`
+	Message   string              `json:"message"`
+	Fields    map[string][]string `json:"fields,omitempty"`
+	RequestID string              `json:"request_id,omitempty"`
+}
+
+type errorEnvelope struct {
+	Error apiError `json:"error"`
+}
+
+// codeForStatus returns the default error code for a HTTP status
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return codeBadRequest
+	case http.StatusUnauthorized:
+		return codeUnauthorized
+	case http.StatusForbidden:
+		return codeForbidden
+	case http.StatusNotFound:
+		return codeNotFound
+	case http.StatusMethodNotAllowed:
+		return codeMethodNotAllowed
+	case http.StatusConflict:
+		return codeConflict
+	case http.StatusRequestEntityTooLarge:
+		return codeTooLarge
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return codeBadGateway
+	case http.StatusServiceUnavailable:
+		return codeUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return codeInternal
+	}
+	return codeBadRequest
+}
+
+func encodeErrorEnvelope(r *http.Request, e apiError) []byte {
+	e.RequestID = requestIDFrom(r.Context())
+	b, err := json.Marshal(errorEnvelope{Error: e})
+	if err != nil {
+		loggerFrom(r.Context()).Error("failed to encode the error envelope", slog.String("error", err.Error()))
+		return []byte(`{"error":{"code":"` + codeInternal + `","message":"internal error"}}`)
+	}
+	return append(b, '\n')
+}
+
+func writeAPIError(w http.ResponseWriter, r *http.Request, status int, e apiError) {
+	h := w.Header()
+	h.Set("Content-Type", "application/json")
+	h.Set("X-Content-Type-Options", "nosniff")
+	h.Del("Content-Length")
+	w.WriteHeader(status)
+	w.Write(encodeErrorEnvelope(r, e))
+}
+
+// writeError writes the error envelope, an empty code or message default to the status ones
+func writeError(w http.ResponseWriter, r *http.Request, status int, code, msg string) {
+	if code == "" {
+		code = codeForStatus(status)
+	}
+	if msg == "" {
+		msg = http.StatusText(status)
+	}
+	writeAPIError(w, r, status, apiError{Code: code, Message: msg})
+}
+
+// writeValidationErrors writes the field errors (field name -> error messages) as the error envelope
+func writeValidationErrors(w http.ResponseWriter, r *http.Request, vData map[string][]string) {
+	writeAPIError(w, r, http.StatusBadRequest, apiError{
+		Code:    codeValidation,
+		Message: "some of the fields are invalid",
+		Fields:  vData,
+	})
+}
+
+// recovery turns a handler panic into a logged stack trace and a 500 error envelope, a response
+// already (partly) sent can't be replaced though, its connection is aborted instead
+func recovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusRecorder{ResponseWriter: w}
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				// the handler wants the connection aborted, let net/http do it
+				panic(rec)
+			}
+			loggerFrom(r.Context()).Error(
+				"handler panic", slog.String("panic", fmt.Sprint(rec)), slog.String("stack", string(debug.Stack())),
+				slog.Bool("headers_sent", sw.status != 0),
+			)
+			if sw.status != 0 {
+				// a second WriteHeader would be ignored, the client would get a truncated 200 as if it was complete
+				panic(http.ErrAbortHandler)
+			}
+			writeError(w, r, http.StatusInternalServerError, codeInternal, "")
+		}()
+		next.ServeHTTP(sw, r)
+	})
+}
+
+// mapUpstreamError rewrites SlashDB error responses (types.APIError or plain text/HTML) into the error envelope
+func mapUpstreamError(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	var reader io.Reader = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		if gz, err := gzip.NewReader(resp.Body); err == nil {
+			reader = gz
+		}
+	}
+	body, err := io.ReadAll(reader)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read the upstream error response: %w", err)
+	}
+
+	msg := http.StatusText(resp.StatusCode)
+	var sdbErr types.APIError
+	if strings.Contains(resp.Header.Get("Content-Type"), "json") &&
+		json.Unmarshal(body, &sdbErr) == nil && sdbErr.Description != "" {
+		msg = sdbErr.Description
+	}
+
+	code := codeForStatus(resp.StatusCode)
+	if resp.StatusCode >= http.StatusInternalServerError {
+		code = codeUpstream
+	}
+
+	data := encodeErrorEnvelope(resp.Request, apiError{Code: code, Message: msg})
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	resp.ContentLength = int64(len(data))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(data)))
+	resp.Header.Set("Content-Type", "application/json")
+	resp.Header.Del("Content-Encoding")
+	return nil
+}
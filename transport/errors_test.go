@@ -0,0 +1,78 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRecovery(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		// wantAbort is set when the connection has to be aborted, with no error response
+		wantAbort  bool
+		wantStatus int
+	}{
+		{
+			name:       "panic before the response",
+			handler:    func(w http.ResponseWriter, r *http.Request) { panic("boom") },
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "panic after the headers",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				panic("boom")
+			},
+			wantAbort: true,
+		},
+		{
+			name: "panic after a part of the body",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"items":[`))
+				panic("boom")
+			},
+			wantAbort: true,
+		},
+		{
+			name:      "abort",
+			handler:   func(w http.ResponseWriter, r *http.Request) { panic(http.ErrAbortHandler) },
+			wantAbort: true,
+		},
+		{
+			name:       "no panic",
+			handler:    func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) },
+			wantStatus: http.StatusTeapot,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			var aborted interface{}
+			func() {
+				defer func() { aborted = recover() }()
+				recovery(tt.handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			}()
+			if tt.wantAbort {
+				if aborted != http.ErrAbortHandler {
+					t.Fatalf("got panic %v, want http.ErrAbortHandler", aborted)
+				}
+				if strings.Contains(rec.Body.String(), codeInternal) {
+					t.Errorf("the error envelope was appended to the response: %s", rec.Body)
+				}
+				return
+			}
+			if aborted != nil {
+				t.Fatalf("unexpected panic %v", aborted)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusInternalServerError && !strings.Contains(rec.Body.String(), codeInternal) {
+				t.Errorf("got body %s, want the %s envelope", rec.Body, codeInternal)
+			}
+		})
+	}
+}
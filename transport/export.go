@@ -0,0 +1,316 @@
+package transport
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/boromil/timesheet/domain"
+	"github.com/boromil/timesheet/export"
+)
+
+// exportOptions parses the export query parameters: format (csv or xlsx), delimiter (a character or tab),
+// decimal (. or ,), duration (hours or hhmm) and totals (false drops the total rows)
+func (q *queryParams) exportOptions() export.Options {
+	v := q.r.URL.Query()
+	opts := export.DefaultOptions
+	if f := v.Get("format"); f != "" {
+		opts.Format = f
+	}
+	if d := v.Get("duration"); d != "" {
+		opts.Duration = d
+	}
+	switch v.Get("decimal") {
+	case "", ".":
+	case ",":
+		opts.DecimalComma = true
+		// the spreadsheets localized to use the decimal comma expect semicolons
+		opts.Delimiter = ';'
+	default:
+		q.errs["decimal"] = append(q.errs["decimal"], "expected . or ,")
+	}
+	switch d := v.Get("delimiter"); {
+	case d == "":
+	case d == "tab":
+		opts.Delimiter = '\t'
+	case utf8.RuneCountInString(d) == 1:
+		opts.Delimiter, _ = utf8.DecodeRuneInString(d)
+	default:
+		q.errs["delimiter"] = append(q.errs["delimiter"], "expected a single character or tab")
+	}
+	if t := v.Get("totals"); t == "false" || t == "0" {
+		opts.Totals = false
+	}
+	if err := opts.Validate(); err != nil {
+		q.errs["format"] = append(q.errs["format"], err.Error())
+	}
+	return opts
+}
+
+// startExport sets the download headers and returns the table writer
+func startExport(
+	w http.ResponseWriter, opts export.Options, name string, widths ...float64,
+) (export.TableWriter, error) {
+	h := w.Header()
+	h.Set("Content-Type", opts.ContentType())
+	h.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%s.%s"`, name, time.Now().UTC().Format("20060102"), opts.Format))
+	h.Set("Cache-Control", "no-store")
+	h.Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if opts.Format == export.FormatXLSX {
+		return export.NewXLSXWriter(w, opts, name, widths...)
+	}
+	return export.NewCSVWriter(w, opts), nil
+}
+
+// abortExport drops the connection, the export response is already on its way, so the client
+// can't be told about the error otherwise (and shouldn't get a file that looks complete)
+func abortExport(r *http.Request, err error) {
+	loggerFrom(r.Context()).Error("export failed", slog.String("error", err.Error()))
+	panic(http.ErrAbortHandler)
+}
+
+// durationHeader returns the duration column header for the format
+func durationHeader(opts export.Options) string {
+	if opts.Duration == export.DurationHHMM {
+		return "Duration (h:mm)"
+	}
+	return "Duration (hours)"
+}
+
+func (h *apiHandler) exportEntries(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	f, opts := q.entryFilter(), q.exportOptions()
+	raw := r.URL.Query().Get("raw") == "true"
+	if raw && (opts.Format != export.FormatCSV || !f.From.IsZero() || !f.To.IsZero()) {
+		q.errs["raw"] = append(q.errs["raw"], "the raw export is CSV only and can only be filtered by the project_id")
+	}
+	if !q.valid(w) {
+		return
+	}
+	if raw {
+		h.rawEntries(w, r, userID, f.ProjectID)
+		return
+	}
+
+	names, err := h.svc.ProjectNames(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	billing, err := h.svc.Billing(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	// the rate and the amount columns (one per currency) are only there with the clients to bill
+	currencies := billing.Currencies()
+
+	// the output starts with the first entry, so the errors before it still get the error envelope
+	var tw export.TableWriter
+	begin := func() error {
+		header := []export.Cell{
+			export.String("Date"), export.String("Project"), export.String(durationHeader(opts)), export.String("Accomplishments"),
+		}
+		widths := []float64{18, 30, 16, 60}
+		if len(currencies) > 0 {
+			header = append(header, export.String("Rate"))
+			widths = append(widths, 10)
+		}
+		for _, c := range currencies {
+			header = append(header, export.String("Amount ("+c+")"))
+			widths = append(widths, 14)
+		}
+		var err error
+		if tw, err = startExport(w, opts, "timesheet-entries", widths...); err != nil {
+			return err
+		}
+		return tw.WriteRow(export.StyleHeader, header...)
+	}
+	var total float64
+	amounts := domain.Amounts{}
+	err = h.svc.EachEntry(domain.WithoutCache(r.Context()), userID, f, func(e domain.Entry) error {
+		if tw == nil {
+			if err := begin(); err != nil {
+				return err
+			}
+		}
+		total += e.Duration
+		cells := []export.Cell{
+			export.Time(e.Date), export.String(names[e.ProjectID]), export.Duration(e.Duration), export.String(e.Accomplishments),
+		}
+		if len(currencies) > 0 {
+			rate, currency, ok := billing.Rate(e.ProjectID, e.Date)
+			amount, _, _ := billing.Amount(e)
+			if ok {
+				cells = append(cells, export.Money(rate))
+				amounts[currency] += amount
+			} else {
+				cells = append(cells, export.String(""))
+			}
+			cells = append(cells, amountCells(currencies, domain.Amounts{currency: amount})...)
+		}
+		return tw.WriteRow(export.StyleData, cells...)
+	})
+	if err != nil && tw == nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	if err == nil && tw == nil {
+		// no entries, just the header
+		err = begin()
+	}
+	if err != nil {
+		abortExport(r, err)
+	}
+	totals := []export.Cell{export.String("Total"), export.String(""), export.Duration(total)}
+	if len(currencies) > 0 {
+		totals = append(append(totals, export.String(""), export.String("")), amountCells(currencies, amounts)...)
+	}
+	if err := tw.WriteRow(export.StyleTotal, totals...); err != nil {
+		abortExport(r, err)
+	}
+	if err := tw.Close(); err != nil {
+		abortExport(r, err)
+	}
+}
+
+// amountCells returns the amount cells of the currencies, empty for the ones with no amount
+func amountCells(currencies []string, amounts domain.Amounts) []export.Cell {
+	cells := make([]export.Cell, len(currencies))
+	for i, c := range currencies {
+		if amount, ok := amounts[c]; ok {
+			cells[i] = export.Money(amount)
+		} else {
+			cells[i] = export.String("")
+		}
+	}
+	return cells
+}
+
+// rawEntries passes the SlashDB CSV of the user timesheet rows through, as it is
+func (h *apiHandler) rawEntries(w http.ResponseWriter, r *http.Request, userID, projectID int) {
+	if h.raw == nil {
+		writeError(w, r, http.StatusNotFound, codeNotFound, "the raw export is unavailable")
+		return
+	}
+	path, query, _ := strings.Cut(h.svc.EntriesCSVPath(userID, projectID), "?")
+	sdbReq := r.Clone(r.Context())
+	sdbReq.URL.Path, sdbReq.URL.RawPath, sdbReq.URL.RawQuery = path, "", query
+	// SlashDB doesn't need the app credentials
+	sdbReq.Header.Del("Authorization")
+	sdbReq.Header.Del("Cookie")
+	h.raw.ServeHTTP(&attachmentWriter{ResponseWriter: w, name: "timesheet-raw"}, sdbReq)
+}
+
+// attachmentWriter marks the successful responses as CSV file downloads, the errors are left as they are
+type attachmentWriter struct {
+	http.ResponseWriter
+	name string
+}
+
+func (a *attachmentWriter) WriteHeader(code int) {
+	if code < http.StatusMultipleChoices {
+		a.Header().Set("Content-Disposition", fmt.Sprintf(
+			`attachment; filename="%s-%s.csv"`, a.name, time.Now().UTC().Format("20060102"),
+		))
+	}
+	a.ResponseWriter.WriteHeader(code)
+}
+
+func (a *attachmentWriter) Unwrap() http.ResponseWriter {
+	return a.ResponseWriter
+}
+
+func (h *apiHandler) exportReport(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	f, opts := q.entryFilter(), q.exportOptions()
+	dims, err := domain.ParseGroupBy(r.URL.Query().Get("group_by"))
+	if err != nil {
+		q.errs["group_by"] = append(q.errs["group_by"], err.Error())
+	}
+	if !q.valid(w) {
+		return
+	}
+	report, err := h.svc.Report(r.Context(), userID, f, dims, r.URL.Query().Get("amounts") == "true")
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+
+	header := []export.Cell{}
+	widths := []float64{}
+	for _, d := range dims {
+		header = append(header, export.String(strings.ToUpper(d[:1])+d[1:]))
+		widths = append(widths, 24)
+	}
+	header = append(header, export.String("Entries"), export.String(durationHeader(opts)))
+	widths = append(widths, 10, 16)
+	// an amount column per currency billed
+	currencies := make([]string, 0, len(report.Total.Amounts))
+	for c := range report.Total.Amounts {
+		currencies = append(currencies, c)
+	}
+	sort.Strings(currencies)
+	for _, c := range currencies {
+		header = append(header, export.String("Amount ("+c+")"))
+		widths = append(widths, 14)
+	}
+	tw, err := startExport(w, opts, "timesheet-report", widths...)
+	if err != nil {
+		abortExport(r, err)
+	}
+	if err := tw.WriteRow(export.StyleHeader, header...); err != nil {
+		abortExport(r, err)
+	}
+
+	// the leaf groups get a row each (with all their parent groups), the others a subtotal row after their children
+	var write func(groups []domain.ReportGroup, path []export.Cell) error
+	write = func(groups []domain.ReportGroup, path []export.Cell) error {
+		for _, g := range groups {
+			name := g.Label
+			if name == "" {
+				name = g.Key
+			}
+			cells := append(append([]export.Cell{}, path...), export.String(name))
+			if len(g.Groups) > 0 {
+				if err := write(g.Groups, cells); err != nil {
+					return err
+				}
+			}
+			style := export.StyleData
+			if len(g.Groups) > 0 {
+				style = export.StyleTotal
+				cells[len(cells)-1] = export.String(name + " total")
+			}
+			for len(cells) < len(dims) {
+				cells = append(cells, export.String(""))
+			}
+			cells = append(cells, export.Number(float64(g.Entries)), export.Duration(g.Hours))
+			if err := tw.WriteRow(style, append(cells, amountCells(currencies, g.Amounts)...)...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := write(report.Groups, nil); err != nil {
+		abortExport(r, err)
+	}
+
+	total := []export.Cell{export.String("Total")}
+	for len(total) < len(dims) {
+		total = append(total, export.String(""))
+	}
+	total = append(total, export.Number(float64(report.Total.Entries)), export.Duration(report.Total.Hours))
+	total = append(total, amountCells(currencies, report.Total.Amounts)...)
+	if err := tw.WriteRow(export.StyleTotal, total...); err != nil {
+		abortExport(r, err)
+	}
+	if err := tw.Close(); err != nil {
+		abortExport(r, err)
+	}
+}
@@ -0,0 +1,121 @@
+package transport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestExportEntries(t *testing.T) {
+	tests := []struct {
+		name, query string
+		wantStatus  int
+		wantType    string
+		// wantRows are the CSV rows
+		wantRows [][]string
+	}{
+		{
+			name:       "csv",
+			query:      "format=csv&from=2026-03-01&to=2026-04-01",
+			wantStatus: http.StatusOK,
+			wantType:   "text/csv; charset=utf-8",
+			wantRows: [][]string{
+				{"Date", "Project", "Duration (hours)", "Accomplishments"},
+				{"2026-03-02 00:00:00", "'=Project", "1.5", `'=HYPERLINK("http://evil.example","x")`},
+				{"2026-03-03 00:00:00", "'=Project", "2", "fixed, \"tested\"\nand shipped"},
+				{"Total", "", "3.5"},
+			},
+		},
+		{
+			name:       "csv, decimal comma",
+			query:      "format=csv&decimal=,&duration=hhmm&totals=false&from=2026-03-01&to=2026-04-01",
+			wantStatus: http.StatusOK,
+			wantType:   "text/csv; charset=utf-8",
+			wantRows: [][]string{
+				{"Date", "Project", "Duration (h:mm)", "Accomplishments"},
+				{"2026-03-02 00:00:00", "'=Project", "1:30", `'=HYPERLINK("http://evil.example","x")`},
+				{"2026-03-03 00:00:00", "'=Project", "2:00", "fixed, \"tested\"\nand shipped"},
+			},
+		},
+		{
+			name:       "xlsx",
+			query:      "format=xlsx",
+			wantStatus: http.StatusOK,
+			wantType:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		},
+		{name: "unknown format", query: "format=ods", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, fake := newTestServer(t, Config{})
+			fake.Insert("user", sdbtest.Row{"id": 1, "username": "user", "passwd": "x", "email": ""})
+			fake.Insert("project", sdbtest.Row{
+				"id": 1, "name": "=Project", "description": "", "client_id": nil, "user_id": 1, "timestamp": "2026-01-01T00:00:00",
+			})
+			fake.Insert("timesheet",
+				sdbtest.Row{"user_id": 1, "project_id": 1, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""},
+				sdbtest.Row{
+					"user_id": 1, "project_id": 1, "date": "2026-03-02T00:00:00", "duration": 1.5,
+					"accomplishments": `=HYPERLINK("http://evil.example","x")`,
+				},
+				sdbtest.Row{
+					"user_id": 1, "project_id": 1, "date": "2026-03-03T00:00:00", "duration": 2,
+					"accomplishments": "fixed, \"tested\"\nand shipped",
+				},
+			)
+
+			r := httptest.NewRequest(http.MethodGet, apiPrefix+"/entries/export?"+tt.query, nil)
+			r.Header.Set("Authorization", "Bearer "+testToken(t, 1))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, r)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.wantType {
+				t.Errorf("got Content-Type %q, want %q", got, tt.wantType)
+			}
+			if got := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(got, `attachment; filename="timesheet-entries-`) {
+				t.Errorf("got Content-Disposition %q, want a timesheet-entries attachment", got)
+			}
+
+			body := rec.Body.Bytes()
+			if tt.wantRows == nil {
+				zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+				if err != nil {
+					t.Fatalf("the XLSX is not a zip archive: %v", err)
+				}
+				names := []string{}
+				for _, f := range zr.File {
+					names = append(names, f.Name)
+				}
+				if !strings.Contains(strings.Join(names, ","), "xl/worksheets/sheet1.xml") {
+					t.Errorf("got the parts %v, want the sheet among them", names)
+				}
+				return
+			}
+			cr := csv.NewReader(bytes.NewReader(body))
+			cr.Comma, cr.FieldsPerRecord = ',', -1
+			if strings.Contains(tt.query, "decimal=,") {
+				cr.Comma = ';'
+			}
+			got, err := cr.ReadAll()
+			if err != nil {
+				t.Fatalf("error reading the CSV: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.wantRows) {
+				t.Errorf("got %q, want %q", got, tt.wantRows)
+			}
+		})
+	}
+}
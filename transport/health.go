@@ -0,0 +1,71 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// Health keeps track of the app readiness, it's flipped to not ready when the app starts draining
+type Health struct {
+	ready atomic.Bool
+
+	mu         sync.Mutex
+	components map[string]func() string
+}
+
+// NewHealth returns a new, ready, instance of Health
+func NewHealth() *Health {
+	h := &Health{components: map[string]func() string{}}
+	h.ready.Store(true)
+	return h
+}
+
+// SetReady sets the readiness state
+func (h *Health) SetReady(ready bool) {
+	h.ready.Store(ready)
+}
+
+// AddComponent registers a component (i.e. an upstream circuit breaker) whose state is reported
+// by the readiness endpoint, it doesn't affect the readiness itself
+func (h *Health) AddComponent(name string, state func() string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.components[name] = state
+}
+
+// Ready reports if the app accepts new traffic
+func (h *Health) Ready() bool {
+	return h.ready.Load()
+}
+
+func (h *Health) livenessHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write([]byte(`{"status":"ok"}`))
+}
+
+func (h *Health) readinessHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	status := "ready"
+	if !h.Ready() {
+		status = "draining"
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	h.mu.Lock()
+	components := make(map[string]string, len(h.components))
+	for name, state := range h.components {
+		components[name] = state()
+	}
+	h.mu.Unlock()
+
+	json.NewEncoder(w).Encode(struct {
+		Status     string            `json:"status"`
+		Components map[string]string `json:"components,omitempty"`
+	}{Status: status, Components: components})
+}
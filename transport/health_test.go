@@ -0,0 +1,106 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestReadiness(t *testing.T) {
+	health := NewHealth()
+	health.AddComponent("sdb", func() string { return "closed" })
+	h, _ := newTestServerWith(t, Config{}, Deps{Health: health})
+
+	for _, tt := range []struct {
+		ready      bool
+		wantStatus int
+		want       string
+	}{
+		{ready: true, wantStatus: http.StatusOK, want: "ready"},
+		{ready: false, wantStatus: http.StatusServiceUnavailable, want: "draining"},
+	} {
+		health.SetReady(tt.ready)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/app/readyz", nil))
+		if rec.Code != tt.wantStatus {
+			t.Fatalf("ready %v: got status %d, want %d", tt.ready, rec.Code, tt.wantStatus)
+		}
+		body := struct {
+			Status     string            `json:"status"`
+			Components map[string]string `json:"components"`
+		}{}
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("error decoding the readiness: %v", err)
+		}
+		if body.Status != tt.want || body.Components["sdb"] != "closed" {
+			t.Errorf("ready %v: got %+v, want %s with the sdb component", tt.ready, body, tt.want)
+		}
+		// the liveness doesn't depend on the readiness
+		rec = httptest.NewRecorder()
+		if h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/app/healthz", nil)); rec.Code != http.StatusOK {
+			t.Errorf("ready %v: got liveness status %d, want 200", tt.ready, rec.Code)
+		}
+	}
+}
+
+func TestShutdownDrainsProxiedRequests(t *testing.T) {
+	h, fake := newTestServer(t, Config{Proxy: true})
+	fake.Insert("timesheet", sdbtest.Row{"user_id": 1, "project_id": 1, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""})
+	started, release := make(chan struct{}), make(chan struct{})
+	fake.Before = func(r *http.Request) {
+		close(started)
+		<-release
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen: %v", err)
+	}
+	srv := &http.Server{Handler: h}
+	go srv.Serve(ln)
+
+	type result struct {
+		status int
+		err    error
+	}
+	results := make(chan result, 1)
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "http://"+ln.Addr().String()+"/db/"+testDB+"/timesheet/user_id/1.json", nil)
+		req.Header.Set("Authorization", "Bearer "+testToken(t, 1))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			results <- result{err: err}
+			return
+		}
+		resp.Body.Close()
+		results <- result{status: resp.StatusCode}
+	}()
+
+	<-started
+	shutdownErrs := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		shutdownErrs <- srv.Shutdown(ctx)
+	}()
+	// the shutdown waits for the request in flight
+	select {
+	case err := <-shutdownErrs:
+		t.Fatalf("the shutdown didn't wait for the request in flight: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+
+	if err := <-shutdownErrs; err != nil {
+		t.Errorf("Shutdown: %v", err)
+	}
+	if r := <-results; r.err != nil || r.status != http.StatusOK {
+		t.Errorf("got the request in flight status %d, error %v, want 200", r.status, r.err)
+	}
+}
@@ -0,0 +1,123 @@
+package transport
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/boromil/timesheet/domain"
+	"github.com/boromil/timesheet/importer"
+)
+
+// importMapping returns the column mapping of the format with the query overrides: the date, time, duration,
+// project and description column headers, the date_layout and time_layout (Go time layouts) and the delimiter
+func (q *queryParams) importMapping() importer.Mapping {
+	v := q.r.URL.Query()
+	format := v.Get("format")
+	if format == "" {
+		format = importer.FormatCSV
+	}
+	m, err := importer.Preset(format)
+	if err != nil {
+		q.errs["format"] = append(q.errs["format"], err.Error())
+		return m
+	}
+
+	o := importer.Mapping{
+		Date: v.Get("date"), Time: v.Get("time"), Duration: v.Get("duration"),
+		Project: v.Get("project"), Description: v.Get("description"),
+	}
+	if l := v.Get("date_layout"); l != "" {
+		o.DateLayouts = []string{l}
+	}
+	if l := v.Get("time_layout"); l != "" {
+		o.TimeLayouts = []string{l}
+	}
+	switch d := v.Get("delimiter"); {
+	case d == "":
+	case d == "tab":
+		o.Delimiter = '\t'
+	case utf8.RuneCountInString(d) == 1 && d != `"` && d != "\r" && d != "\n":
+		o.Delimiter, _ = utf8.DecodeRuneInString(d)
+	default:
+		q.errs["delimiter"] = append(q.errs["delimiter"], "expected a single character or tab")
+	}
+	return m.Override(o)
+}
+
+// icsSelection returns the calendar events selection: from and to (the event starts), keyword,
+// category (can be repeated) and project_from (summary or category)
+func (q *queryParams) icsSelection() importer.Selection {
+	v := q.r.URL.Query()
+	s := importer.Selection{
+		From: q.time("from"), To: q.time("to"),
+		Keyword: v.Get("keyword"), Categories: v["category"], ProjectFrom: v.Get("project_from"),
+	}
+	if err := s.Validate(); err != nil {
+		q.errs["project_from"] = append(q.errs["project_from"], err.Error())
+	}
+	return s
+}
+
+// importEntries imports the CSV request body, see importMapping for the format parameters, or with format=ics
+// the calendar file events, see icsSelection. dry_run=true only validates it (and previews the rows),
+// create_projects=false makes the rows of the unknown projects fail, the project_map=<name>=<id> parameters
+// map the project names to the user projects and project_id puts all the rows in one of them
+func (h *apiHandler) importEntries(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	var parse func(io.Reader) ([]domain.ImportRow, []domain.ImportError, error)
+	if r.URL.Query().Get("format") == importer.FormatICS {
+		sel := q.icsSelection()
+		parse = func(body io.Reader) ([]domain.ImportRow, []domain.ImportError, error) {
+			return importer.ParseICS(body, sel)
+		}
+	} else {
+		m := q.importMapping()
+		parse = func(body io.Reader) ([]domain.ImportRow, []domain.ImportError, error) {
+			return importer.Parse(body, m)
+		}
+	}
+	projectMap, err := importer.ParseProjectMap(r.URL.Query()["project_map"])
+	if err != nil {
+		q.errs["project_map"] = append(q.errs["project_map"], err.Error())
+	}
+	projectID := q.int("project_id")
+	if !q.valid(w) {
+		return
+	}
+
+	rows, rowErrs, err := parse(r.Body)
+	switch {
+	case bodyLimitExceeded(r, err):
+		writeTooLarge(w, r, 0)
+		return
+	case err != nil:
+		writeError(w, r, http.StatusBadRequest, codeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.svc.Import(r.Context(), userID, domain.ImportInput{
+		Rows:           rows,
+		Errors:         rowErrs,
+		DryRun:         r.URL.Query().Get("dry_run") == "true",
+		CreateProjects: !strings.EqualFold(r.URL.Query().Get("create_projects"), "false"),
+		ProjectMap:     projectMap,
+		ProjectID:      projectID,
+	})
+	if err != nil && result.Rows == 0 {
+		writeDomainError(w, r, err)
+		return
+	}
+	if err != nil {
+		// interrupted midway (i.e. timed out), the rows created stay and the rest are reported as failed
+		loggerFrom(r.Context()).Warn("import interrupted",
+			slog.String("error", err.Error()), slog.Int("imported", result.Imported), slog.Int("failed", result.Failed))
+	}
+	status := http.StatusOK
+	if !result.DryRun && result.Imported > 0 {
+		status = http.StatusCreated
+	}
+	writeJSON(w, status, result)
+}
@@ -0,0 +1,62 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/boromil/timesheet/domain"
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestImportEntries(t *testing.T) {
+	csv := "date,duration,project,description\n2026-03-02,1,Project,work\n2026-03-03,2,Project,more work\n2026-03-04,x,Project,\n"
+	tests := []struct {
+		name string
+		// cancel cancels the request once the entries are being created
+		cancel       bool
+		wantStatus   int
+		wantImported int
+		wantFailed   int
+	}{
+		{name: "imported", wantStatus: http.StatusCreated, wantImported: 2, wantFailed: 1},
+		{name: "interrupted", cancel: true, wantStatus: http.StatusOK, wantFailed: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, fake := newTestServer(t, Config{})
+			fake.Insert("user", sdbtest.Row{"id": 1, "username": "user", "passwd": "x", "email": ""})
+			fake.Insert("project", sdbtest.Row{"id": 1, "name": "Project", "description": "", "client_id": nil, "timestamp": "2026-01-01T00:00:00"})
+			fake.Insert("timesheet", sdbtest.Row{"user_id": 1, "project_id": 1, "date": "2026-01-01T00:00:00", "duration": 0, "accomplishments": ""})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				fake.Before = func(r *http.Request) {
+					if r.Method == http.MethodPost {
+						cancel()
+					}
+				}
+			}
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, apiPrefix+"/entries/import", strings.NewReader(csv))
+			r.Header.Set("Authorization", "Bearer "+testToken(t, 1))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, r)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			result := domain.ImportResult{}
+			if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+				t.Fatalf("error decoding the result: %v", err)
+			}
+			if result.Rows != 3 || result.Imported != tt.wantImported || result.Failed != tt.wantFailed {
+				t.Errorf("got %d rows, %d imported, %d failed, want 3, %d, %d",
+					result.Rows, result.Imported, result.Failed, tt.wantImported, tt.wantFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package transport
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boromil/timesheet/domain"
+)
+
+// invoiceDocument - the data of the invoice PDF layout template
+type invoiceDocument struct {
+	domain.Invoice
+	// User is the one invoicing
+	User        domain.User
+	GeneratedAt time.Time
+}
+
+func (h *apiHandler) listInvoices(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	clientID := q.int("client_id")
+	if !q.valid(w) {
+		return
+	}
+	invoices, err := h.svc.ListInvoices(r.Context(), userID, clientID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse[domain.Invoice]{
+		Items:      invoices,
+		Pagination: domain.Pagination{Total: len(invoices), Limit: len(invoices)},
+	})
+}
+
+func (h *apiHandler) getInvoice(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	inv, err := h.svc.Invoice(r.Context(), userID, id)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, inv)
+}
+
+func (h *apiHandler) createInvoice(w http.ResponseWriter, r *http.Request, userID int) {
+	var in domain.InvoiceInput
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	inv, err := h.svc.CreateInvoice(r.Context(), userID, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", apiPrefix+"/invoices/"+strconv.Itoa(inv.ID))
+	writeJSON(w, http.StatusCreated, inv)
+}
+
+func (h *apiHandler) updateInvoice(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	var in domain.InvoiceTerms
+	if !decodeBody(w, r, &in) {
+		return
+	}
+	inv, err := h.svc.UpdateInvoice(r.Context(), userID, id, in)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, inv)
+}
+
+func (h *apiHandler) deleteInvoice(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteInvoice(r.Context(), userID, id); err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *apiHandler) issueInvoice(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	inv, err := h.svc.IssueInvoice(r.Context(), userID, id)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, inv)
+}
+
+// invoicePDF renders one of the user invoices, the drafts are marked as such
+func (h *apiHandler) invoicePDF(w http.ResponseWriter, r *http.Request, userID int) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	inv, err := h.svc.Invoice(r.Context(), userID, id)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+	u, err := h.svc.User(r.Context(), userID)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+
+	name := "invoice-draft-" + strconv.Itoa(inv.ID)
+	if inv.Number != "" {
+		// the numbers may have the separators of the prefix in them, i.e. INV/2026-0001
+		name = "invoice-" + strings.Map(func(r rune) rune {
+			if r == '/' || r == '\\' || r == '"' {
+				return '-'
+			}
+			return r
+		}, inv.Number)
+	}
+	writePDF(w, r, h.invoiceTemplate, invoiceDocument{Invoice: inv, User: u, GeneratedAt: time.Now().UTC()}, name+".pdf")
+}
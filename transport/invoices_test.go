@@ -0,0 +1,58 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boromil/timesheet/domain"
+	"github.com/boromil/timesheet/internal/sdbtest"
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+func TestIssueInvoiceCached(t *testing.T) {
+	fake := sdbtest.New(t, testDB)
+	sdb, err := slashdb.NewService(fake.URL, "apikey", "key", "", false, domain.NewStatusDoer(fake.Client()))
+	if err != nil {
+		t.Fatalf("slashdb.NewService: %v", err)
+	}
+	svc := domain.NewService(NewCachedService(sdb, NewLRUCache(100), time.Minute), domain.Config{DBName: testDB})
+
+	prefix := fmt.Sprintf("INV-%d-", time.Now().UTC().Year())
+	invoice := func(id int, number interface{}) sdbtest.Row {
+		status := domain.InvoiceDraft
+		if number != nil {
+			status = domain.InvoiceIssued
+		}
+		return sdbtest.Row{
+			"id": id, "user_id": 1, "client_id": 1, "client_name": "Client", "number": number, "status": status,
+			"period_from": "2026-03-01", "period_to": "2026-03-31", "currency": "EUR", "due_date": nil, "issued_at": nil,
+		}
+	}
+	fake.Insert("invoice", invoice(1, prefix+"0001"), invoice(2, nil))
+
+	ctx := withTestUser(context.Background(), "1")
+	// the invoice list is cached
+	if _, err := svc.ListInvoices(ctx, 1, 0); err != nil {
+		t.Fatalf("ListInvoices: %v", err)
+	}
+	// the next number is taken (i.e. on another instance) right before the invoice gets it
+	taken := false
+	fake.Before = func(r *http.Request) {
+		if r.Method == http.MethodPut && strings.Contains(r.URL.Path, "/invoice/") && !taken {
+			taken = true
+			fake.Insert("invoice", invoice(3, prefix+"0002"))
+		}
+	}
+
+	inv, err := svc.IssueInvoice(ctx, 1, 2)
+	if err != nil {
+		t.Fatalf("IssueInvoice: %v", err)
+	}
+	if want := prefix + "0003"; inv.Number != want || inv.Status != domain.InvoiceIssued {
+		t.Errorf("got the invoice %s %s, want %s issued", inv.Number, inv.Status, want)
+	}
+}
@@ -0,0 +1,80 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// LimitsConfig - container for the request body size limits (in bytes), 0 means no limit
+type LimitsConfig struct {
+	// AuthBodySize limits the login and registration forms
+	AuthBodySize int64
+	// APIBodySize limits the requests passed on to SlashDB
+	APIBodySize int64
+}
+
+// bodyLimitReader is http.MaxBytesReader, which also remembers that the limit was hit,
+// for the cases the error gets lost on the way (i.e. wrapped by the reverse proxy transport)
+type bodyLimitReader struct {
+	io.ReadCloser
+	exceeded *bool
+}
+
+func (b bodyLimitReader) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	var mbErr *http.MaxBytesError
+	if errors.As(err, &mbErr) {
+		*b.exceeded = true
+	}
+	return n, err
+}
+
+type bodyLimitKey struct{}
+
+// bodyLimitExceeded reports if the request body went over the limit set by maxBodySize
+func bodyLimitExceeded(r *http.Request, err error) bool {
+	var mbErr *http.MaxBytesError
+	if errors.As(err, &mbErr) {
+		return true
+	}
+	exceeded, ok := r.Context().Value(bodyLimitKey{}).(*bool)
+	return ok && *exceeded
+}
+
+// writeTooLarge writes the 413 error envelope
+func writeTooLarge(w http.ResponseWriter, r *http.Request, limit int64) {
+	msg := "request body too large"
+	if limit > 0 {
+		msg = fmt.Sprintf("request body too large, the limit is %d bytes", limit)
+	}
+	writeError(w, r, http.StatusRequestEntityTooLarge, codeTooLarge, msg)
+}
+
+// maxBodySize limits the request body size, the requests declaring a bigger Content-Length
+// are rejected right away, the others fail once they read past the limit
+func maxBodySize(limit int64) middleware {
+	if limit <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				writeTooLarge(w, r, limit)
+				return
+			}
+			if r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			exceeded := false
+			r = r.WithContext(context.WithValue(r.Context(), bodyLimitKey{}, &exceeded))
+			r.Body = bodyLimitReader{ReadCloser: http.MaxBytesReader(w, r.Body, limit), exceeded: &exceeded}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
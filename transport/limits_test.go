@@ -0,0 +1,77 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMaxBodySize(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int64
+		body  string
+		// chunked hides the body size, it's only found out by reading it
+		chunked    bool
+		wantStatus int
+	}{
+		{name: "within the limit", limit: 10, body: "0123456789", wantStatus: http.StatusOK},
+		{name: "declared too large", limit: 10, body: "0123456789a", wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "read past the limit", limit: 10, body: "0123456789a", chunked: true, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "no limit", body: strings.Repeat("x", 1<<16), wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := maxBodySize(tt.limit)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if _, err := io.ReadAll(r.Body); err != nil {
+					if !bodyLimitExceeded(r, err) {
+						t.Errorf("the limit error isn't recognised: %v", err)
+					}
+					writeTooLarge(w, r, tt.limit)
+				}
+			}))
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			if tt.chunked {
+				r.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, r)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusRequestEntityTooLarge && !strings.Contains(rec.Body.String(), `"code":"`+codeTooLarge+`"`) {
+				t.Errorf("got body %s, want the %s envelope", rec.Body, codeTooLarge)
+			}
+		})
+	}
+}
+
+func TestRouteBodyLimits(t *testing.T) {
+	h, _ := newTestServer(t, Config{Limits: LimitsConfig{AuthBodySize: 64, APIBodySize: 128}})
+	token := testToken(t, 1)
+	tests := []struct {
+		name       string
+		path       string
+		size       int
+		wantStatus int
+	}{
+		{name: "login", path: "/app/login", size: 65, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "registration", path: "/app/reg", size: 65, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "API", path: apiPrefix + "/projects", size: 129, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "API within the limit", path: apiPrefix + "/projects", size: 128, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(strings.Repeat("x", tt.size)))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			r.Header.Set("Authorization", "Bearer "+token)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, r)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
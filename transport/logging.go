@@ -0,0 +1,308 @@
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+	redactedValue   = "[REDACTED]"
+	maxRequestIDLen = 128
+)
+
+// LogConfig - container for the structured logger settings
+type LogConfig struct {
+	// Format is either "json" or "logfmt"
+	Format string
+	// Level is one of "debug", "info", "warn" or "error"
+	Level string
+	// RedactHeaders lists header (and attribute) names whose values are never logged
+	RedactHeaders []string
+	// Secrets lists values which are masked wherever they show up in a log line
+	Secrets []string
+}
+
+// NewLogger builds a leveled, structured logger which redacts the configured secrets and headers
+func NewLogger(w io.Writer, cfg LogConfig) (*slog.Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("unknown log level %q: %w", cfg.Level, err)
+	}
+
+	redactKeys := map[string]bool{"password": true, "password2": true, "passwd": true}
+	for _, h := range cfg.RedactHeaders {
+		if h = strings.TrimSpace(h); h != "" {
+			redactKeys[strings.ToLower(h)] = true
+		}
+	}
+	secrets := []string{}
+	for _, s := range cfg.Secrets {
+		if s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+
+	opts := &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if redactKeys[strings.ToLower(a.Key)] {
+				return slog.String(a.Key, redactedValue)
+			}
+			if a.Value.Kind() != slog.KindString {
+				return a
+			}
+			v := a.Value.String()
+			for _, s := range secrets {
+				v = strings.ReplaceAll(v, s, redactedValue)
+			}
+			return slog.String(a.Key, v)
+		},
+	}
+
+	switch cfg.Format {
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	case "logfmt", "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	}
+	return nil, fmt.Errorf("unknown log format %q, expected json or logfmt", cfg.Format)
+}
+
+type ctxKey int
+
+const requestInfoKey ctxKey = iota
+
+// requestInfo is shared by the request scoped middlewares,
+// the auth middleware fills in the user ID once the token is verified
+type requestInfo struct {
+	id     string
+	userID string
+	logger *slog.Logger
+}
+
+func requestInfoFrom(ctx context.Context) *requestInfo {
+	ri, _ := ctx.Value(requestInfoKey).(*requestInfo)
+	return ri
+}
+
+func requestIDFrom(ctx context.Context) string {
+	if ri := requestInfoFrom(ctx); ri != nil {
+		return ri.id
+	}
+	return ""
+}
+
+func setRequestUserID(ctx context.Context, userID string) {
+	if ri := requestInfoFrom(ctx); ri != nil {
+		ri.userID = userID
+	}
+}
+
+// loggerFrom returns the request scoped logger or the default one
+func loggerFrom(ctx context.Context) *slog.Logger {
+	if ri := requestInfoFrom(ctx); ri != nil && ri.logger != nil {
+		return ri.logger
+	}
+	return slog.Default()
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for _, c := range id {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// secretPathPrefixes are followed by a secret path segment, the calendar feed token: in the feed URL
+// and in the SlashDB user lookup by it
+var secretPathPrefixes = []string{calendarFeedPath, "/calendar_token/"}
+
+// redactPath masks the secret path segments of the path (or the URL), keeping their extensions
+func redactPath(path string) string {
+	for _, prefix := range secretPathPrefixes {
+		i := strings.Index(path, prefix)
+		if i < 0 {
+			continue
+		}
+		start := i + len(prefix)
+		end := len(path)
+		if j := strings.IndexAny(path[start:], "/?"); j >= 0 {
+			end = start + j
+		}
+		segment, ext := path[start:end], ""
+		if j := strings.LastIndexByte(segment, '.'); j >= 0 {
+			segment, ext = segment[:j], segment[j:]
+		}
+		if segment == "" {
+			continue
+		}
+		path = path[:start] + redactedValue + ext + path[end:]
+	}
+	return path
+}
+
+// headerAttrs converts headers into a log group, redaction is done by the logger itself
+func headerAttrs(h http.Header) slog.Attr {
+	attrs := make([]any, 0, len(h))
+	for k, vs := range h {
+		attrs = append(attrs, slog.String(k, strings.Join(vs, ", ")))
+	}
+	return slog.Group("headers", attrs...)
+}
+
+// statusRecorder captures the response status and size for the access log
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (sr *statusRecorder) WriteHeader(status int) {
+	if sr.status == 0 {
+		sr.status = status
+	}
+	sr.ResponseWriter.WriteHeader(status)
+}
+
+func (sr *statusRecorder) Write(b []byte) (int, error) {
+	if sr.status == 0 {
+		sr.status = http.StatusOK
+	}
+	n, err := sr.ResponseWriter.Write(b)
+	sr.bytes += n
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer (i.e. for flushing)
+func (sr *statusRecorder) Unwrap() http.ResponseWriter {
+	return sr.ResponseWriter
+}
+
+// requestLogging assigns (or propagates) a request ID and writes one access log line per request
+func requestLogging(logger *slog.Logger) middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			id := r.Header.Get(requestIDHeader)
+			if !validRequestID(id) {
+				id = newRequestID()
+				r.Header.Set(requestIDHeader, id)
+			}
+			w.Header().Set(requestIDHeader, id)
+
+			reqLogger := logger.With(slog.String("request_id", id))
+			if sc := trace.SpanContextFromContext(r.Context()); sc.IsValid() {
+				reqLogger = reqLogger.With(slog.String("trace_id", sc.TraceID().String()))
+			}
+			ri := &requestInfo{id: id, logger: reqLogger}
+			r = r.WithContext(context.WithValue(r.Context(), requestInfoKey, ri))
+
+			ri.logger.Debug("request started",
+				slog.String("method", r.Method),
+				slog.String("path", redactPath(r.URL.Path)),
+				headerAttrs(r.Header),
+			)
+
+			sr := &statusRecorder{ResponseWriter: w}
+			completed := false
+			// logged from a defer, so the aborted requests (the handler panics with http.ErrAbortHandler)
+			// get their line too, the panic carries on afterwards
+			defer func() {
+				aborted := !completed
+				if sr.status == 0 {
+					sr.status = http.StatusOK
+					if aborted {
+						sr.status = http.StatusInternalServerError
+					}
+				}
+				level := slog.LevelInfo
+				if sr.status >= http.StatusInternalServerError || aborted {
+					level = slog.LevelError
+				}
+				attrs := []slog.Attr{
+					slog.String("method", r.Method),
+					slog.String("path", redactPath(r.URL.Path)),
+					slog.String("query", r.URL.RawQuery),
+					slog.Int("status", sr.status),
+					slog.Int("bytes", sr.bytes),
+					slog.String("user_id", ri.userID),
+					slog.String("remote_addr", r.RemoteAddr),
+					slog.Duration("latency", time.Since(start)),
+				}
+				if aborted {
+					attrs = append(attrs, slog.Bool("aborted", true))
+				}
+				ri.logger.LogAttrs(r.Context(), level, "request", attrs...)
+			}()
+			next.ServeHTTP(sr, r)
+			completed = true
+		})
+	}
+}
+
+type loggingDoer struct {
+	next   slashdb.Doer
+	logger *slog.Logger
+	level  slog.Level
+}
+
+// NewLoggingDoer wraps a SlashDB client, it forwards the request ID and logs every upstream call
+// (without the payloads) - when echo is set the calls are logged on the info level, otherwise on debug
+func NewLoggingDoer(next slashdb.Doer, logger *slog.Logger, echo bool) slashdb.Doer {
+	level := slog.LevelDebug
+	if echo {
+		level = slog.LevelInfo
+	}
+	return &loggingDoer{next: next, logger: logger, level: level}
+}
+
+func (d *loggingDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	logger := d.logger
+	if id := requestIDFrom(ctx); id != "" {
+		req.Header.Set(requestIDHeader, id)
+		logger = logger.With(slog.String("request_id", id))
+	}
+
+	start := time.Now()
+	resp, err := d.next.Do(req)
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", redactPath(req.URL.String())),
+		slog.Duration("latency", time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		logger.LogAttrs(ctx, slog.LevelError, "slashdb request failed", attrs...)
+		return resp, err
+	}
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	logger.LogAttrs(ctx, d.level, "slashdb request", attrs...)
+	return resp, nil
+}
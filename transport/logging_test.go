@@ -0,0 +1,147 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// logLines decodes the JSON log lines
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	lines := []map[string]interface{}{}
+	for _, l := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if l == "" {
+			continue
+		}
+		line := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(l), &line); err != nil {
+			t.Fatalf("error decoding the log line %q: %v", l, err)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestRequestLogging(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		header  http.Header
+		// wantStatus is the logged status
+		wantStatus  float64
+		wantLevel   string
+		wantAborted bool
+	}{
+		{
+			name:       "ok",
+			handler:    func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok")) },
+			wantStatus: http.StatusOK,
+			wantLevel:  "INFO",
+		},
+		{
+			name:       "the request ID propagated",
+			handler:    func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNotFound) },
+			header:     http.Header{requestIDHeader: {"abc-123"}},
+			wantStatus: http.StatusNotFound,
+			wantLevel:  "INFO",
+		},
+		{
+			name:        "aborted before the response",
+			handler:     func(w http.ResponseWriter, r *http.Request) { panic(http.ErrAbortHandler) },
+			wantStatus:  http.StatusInternalServerError,
+			wantLevel:   "ERROR",
+			wantAborted: true,
+		},
+		{
+			name: "aborted halfway through the body",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("id,date\n"))
+				panic(http.ErrAbortHandler)
+			},
+			wantStatus:  http.StatusOK,
+			wantLevel:   "ERROR",
+			wantAborted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger, err := NewLogger(buf, LogConfig{Format: "json", Level: "info"})
+			if err != nil {
+				t.Fatalf("NewLogger: %v", err)
+			}
+			r := httptest.NewRequest(http.MethodGet, "/export?format=csv", nil)
+			for k, vs := range tt.header {
+				r.Header.Set(k, vs[0])
+			}
+			rec := httptest.NewRecorder()
+			var aborted interface{}
+			func() {
+				defer func() { aborted = recover() }()
+				requestLogging(logger)(tt.handler).ServeHTTP(rec, r)
+			}()
+			if (aborted != nil) != tt.wantAborted {
+				t.Fatalf("got panic %v, want aborted %v", aborted, tt.wantAborted)
+			}
+
+			lines := logLines(t, buf)
+			if len(lines) != 1 {
+				t.Fatalf("got %d log lines, want 1: %s", len(lines), buf)
+			}
+			line := lines[0]
+			if line["msg"] != "request" || line["level"] != tt.wantLevel || line["status"] != tt.wantStatus {
+				t.Errorf("got %v, want a %s request line with the status %v", line, tt.wantLevel, tt.wantStatus)
+			}
+			if got, _ := line["aborted"].(bool); got != tt.wantAborted {
+				t.Errorf("got aborted %v, want %v", line["aborted"], tt.wantAborted)
+			}
+			id := rec.Header().Get(requestIDHeader)
+			if want := tt.header.Get(requestIDHeader); want != "" && id != want {
+				t.Errorf("got request ID %q, want %q", id, want)
+			}
+			if line["request_id"] != id || id == "" {
+				t.Errorf("got the logged request ID %v, want %q", line["request_id"], id)
+			}
+		})
+	}
+}
+
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestLoggingDoer(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger, err := NewLogger(buf, LogConfig{Format: "json", Level: "info"})
+	if err != nil {
+		t.Fatalf("NewLogger: %v", err)
+	}
+	var forwarded string
+	doer := NewLoggingDoer(doerFunc(func(r *http.Request) (*http.Response, error) {
+		forwarded = r.Header.Get(requestIDHeader)
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}), logger, true)
+
+	// the request ID of the incoming request goes along with the SlashDB calls made for it
+	handler := requestLogging(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, _ := http.NewRequestWithContext(r.Context(), http.MethodGet, "http://sdb/db/x/user.json", nil)
+		if _, err := doer.Do(req); err != nil {
+			t.Errorf("Do: %v", err)
+		}
+	}))
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/me", nil)
+	r.Header.Set(requestIDHeader, "abc-123")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	if forwarded != "abc-123" {
+		t.Errorf("got the forwarded request ID %q, want abc-123", forwarded)
+	}
+	lines := logLines(t, buf)
+	if len(lines) != 2 || lines[0]["msg"] != "slashdb request" || lines[0]["request_id"] != "abc-123" {
+		t.Errorf("got log lines %v, want the SlashDB request logged with the request ID", lines)
+	}
+}
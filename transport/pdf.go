@@ -0,0 +1,113 @@
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/boromil/timesheet/pdf"
+)
+
+// the PDF layouts in the assets, see pdf.Render for the elements
+const (
+	defaultPDFTemplate     = "templates/timesheet-pdf.xml"
+	defaultInvoiceTemplate = "templates/invoice-pdf.xml"
+)
+
+// pdfFuncs are the helpers available to the PDF layout templates
+var pdfFuncs = template.FuncMap{
+	"date": func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format("2006-01-02")
+	},
+	"hours":   func(h float64) string { return strconv.FormatFloat(h, 'f', 2, 64) },
+	"money":   func(a float64) string { return strconv.FormatFloat(a, 'f', 2, 64) },
+	"percent": func(p float64) string { return strconv.FormatFloat(p, 'f', -1, 64) },
+}
+
+// newPDFTemplate returns the function parsing a PDF layout template, from the file at path or, when it's empty,
+// from the assetPath in the assets. In the dev mode it parses the template on every call, so it can be edited on the fly
+func newPDFTemplate(fsys fs.FS, assetPath, path string, devMode bool) (func() (*template.Template, error), error) {
+	parse := func() (*template.Template, error) {
+		if path != "" {
+			tmpl, err := template.New(filepath.Base(path)).Funcs(pdfFuncs).ParseFiles(path)
+			if err != nil {
+				return nil, fmt.Errorf("template.ParseFiles: %w", err)
+			}
+			return tmpl, nil
+		}
+		tmpl, err := template.New(filepath.Base(assetPath)).Funcs(pdfFuncs).ParseFS(fsys, assetPath)
+		if err != nil {
+			return nil, fmt.Errorf("template.ParseFS: %w", err)
+		}
+		return tmpl, nil
+	}
+	// parsed up front either way, so a broken template is reported on startup
+	tmpl, err := parse()
+	if err != nil {
+		return nil, err
+	}
+	if devMode {
+		return parse, nil
+	}
+	return func() (*template.Template, error) { return tmpl, nil }, nil
+}
+
+// timesheetPDF renders the user timesheet (all the projects or, with the project_id, one) over the from - to period
+func (h *apiHandler) timesheetPDF(w http.ResponseWriter, r *http.Request, userID int) {
+	q := newQueryParams(r)
+	f := q.entryFilter()
+	if !q.valid(w) {
+		return
+	}
+	ts, err := h.svc.Timesheet(r.Context(), userID, f)
+	if err != nil {
+		writeDomainError(w, r, err)
+		return
+	}
+
+	name := "timesheet"
+	if ts.Project != nil {
+		name += "-" + strconv.Itoa(ts.Project.ID)
+	}
+	if !ts.From.IsZero() {
+		name += "-" + ts.From.Format("20060102") + "-" + ts.Through.Format("20060102")
+	}
+	writePDF(w, r, h.pdfTemplate, ts, name+".pdf")
+}
+
+// writePDF renders the data with the layout template and writes the PDF as an attachment
+func writePDF(w http.ResponseWriter, r *http.Request, parse func() (*template.Template, error), data any, name string) {
+	tmpl, err := parse()
+	if err != nil {
+		logAndWrite(r, err, "error parsing the PDF template", w)
+		return
+	}
+	layout := &bytes.Buffer{}
+	if err := tmpl.Execute(layout, data); err != nil {
+		logAndWrite(r, err, "error executing the PDF template", w)
+		return
+	}
+	// the PDF is rendered in full before anything is sent, so a broken layout still gets the error envelope
+	out := &bytes.Buffer{}
+	if err := pdf.Render(out, layout); err != nil {
+		logAndWrite(r, err, "error rendering the PDF", w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	w.Header().Set("Content-Length", strconv.Itoa(out.Len()))
+	w.Header().Set("Cache-Control", "no-store")
+	if _, err := out.WriteTo(w); err != nil {
+		loggerFrom(r.Context()).Warn("error writing the PDF", slog.String("error", err.Error()))
+	}
+}
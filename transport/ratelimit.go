@@ -0,0 +1,246 @@
+package transport
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit - a token bucket limit, allowing up to Requests per Period (that's also the burst size)
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseRateLimit parses limits like "10/s", "100/m" or "1000/h", "0" or "" turns the limit off
+func ParseRateLimit(s string) (RateLimit, error) {
+	if s == "" || s == "0" {
+		return RateLimit{}, nil
+	}
+
+	tmp := strings.Split(s, "/")
+	if len(tmp) != 2 {
+		return RateLimit{}, fmt.Errorf("expected <requests>/<s|m|h>, got: %s", s)
+	}
+	requests, err := strconv.Atoi(tmp[0])
+	if err != nil || requests < 0 {
+		return RateLimit{}, fmt.Errorf("invalid request count in %q", s)
+	}
+
+	periods := map[string]time.Duration{"s": time.Second, "m": time.Minute, "h": time.Hour}
+	period, ok := periods[tmp[1]]
+	if !ok {
+		return RateLimit{}, fmt.Errorf("invalid period in %q, expected s, m or h", s)
+	}
+
+	return RateLimit{Requests: requests, Period: period}, nil
+}
+
+// Enabled reports if the limit is set
+func (rl RateLimit) Enabled() bool {
+	return rl.Requests > 0 && rl.Period > 0
+}
+
+// RateLimitConfig - container for the rate limiting settings
+type RateLimitConfig struct {
+	// Auth limits the login and registration routes, per client IP
+	Auth RateLimit
+	// API limits the authenticated routes, per user ID
+	API RateLimit
+	// TrustedProxies lists the IPs or CIDRs of the proxies whose X-Forwarded-For header is trusted
+	TrustedProxies []string
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// limiter keeps a token bucket per key
+type limiter struct {
+	limit RateLimit
+	rate  float64 // tokens per second
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newLimiter(limit RateLimit) *limiter {
+	return &limiter{
+		limit:   limit,
+		rate:    float64(limit.Requests) / limit.Period.Seconds(),
+		buckets: map[string]*bucket{},
+	}
+}
+
+// allow takes a token for the key, it returns the tokens left and the time until the bucket is full again,
+// or until the next token is available if the request is not allowed
+func (l *limiter) allow(key string, now time.Time) (ok bool, remaining int, wait time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	capacity := float64(l.limit.Requests)
+	b, found := l.buckets[key]
+	if !found {
+		b = &bucket{tokens: capacity, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		return false, 0, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, int(b.tokens), time.Duration((capacity - b.tokens) / l.rate * float64(time.Second))
+}
+
+// sweep drops the buckets which would be full by now, at most once per period
+func (l *limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.limit.Period {
+		return
+	}
+	l.lastSweep = now
+	for k, b := range l.buckets {
+		if now.Sub(b.last) >= l.limit.Period {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// rateLimit limits the requests per the key returned by keyFn, requests without a key are let through
+func rateLimit(limit RateLimit, keyFn func(*http.Request) string) middleware {
+	if !limit.Enabled() {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	l := newLimiter(limit)
+	policy := fmt.Sprintf("%d;w=%d", limit.Requests, int(limit.Period.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := keyFn(r)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ok, remaining, wait := l.allow(key, time.Now())
+			h := w.Header()
+			h.Set("RateLimit-Policy", policy)
+			h.Set("RateLimit-Limit", strconv.Itoa(limit.Requests))
+			h.Set("RateLimit-Remaining", strconv.Itoa(remaining))
+			h.Set("RateLimit-Reset", seconds(wait))
+			if !ok {
+				h.Set("Retry-After", seconds(wait))
+				writeError(w, r, http.StatusTooManyRequests, "rate_limited", "too many requests, slow down")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// userKey keys the rate limit by the user ID set by the auth middleware
+func userKey(r *http.Request) string {
+	if ri := requestInfoFrom(r.Context()); ri != nil && ri.userID != "" {
+		return "user:" + ri.userID
+	}
+	return ""
+}
+
+// trustedProxies knows which peers can be trusted to set the X-Forwarded-For header
+type trustedProxies []*net.IPNet
+
+func parseTrustedProxies(items []string) (trustedProxies, error) {
+	tp := trustedProxies{}
+	for _, item := range items {
+		if !strings.Contains(item, "/") {
+			if strings.Contains(item, ":") {
+				item += "/128"
+			} else {
+				item += "/32"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", item, err)
+		}
+		tp = append(tp, ipNet)
+	}
+	return tp, nil
+}
+
+func (tp trustedProxies) trusted(ip net.IP) bool {
+	for _, n := range tp {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the client IP, X-Forwarded-For is walked from the right
+// and the first address not belonging to a trusted proxy is the client one
+func (tp trustedProxies) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !tp.trusted(ip) {
+		return host
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			break
+		}
+		host = hop.String()
+		if !tp.trusted(hop) {
+			break
+		}
+	}
+	return host
+}
+
+func (tp trustedProxies) ipKey(r *http.Request) string {
+	return "ip:" + tp.clientIP(r)
+}
+
+// forwardedWarning warns (once) about the X-Forwarded-For header coming in with no trusted proxies,
+// the app is behind a proxy (i.e. the Heroku router) then, and the IP limits would share its address
+func forwardedWarning(tp trustedProxies) middleware {
+	if len(tp) > 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	var once sync.Once
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Forwarded-For") != "" {
+				once.Do(func() {
+					loggerFrom(r.Context()).Warn(
+						"X-Forwarded-For received with no trusted proxies, the IP rate limits apply to the proxy " +
+							"address, set -trusted-proxies",
+					)
+				})
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
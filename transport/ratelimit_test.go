@@ -0,0 +1,241 @@
+package transport
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    RateLimit
+		wantErr bool
+	}{
+		{in: "", want: RateLimit{}},
+		{in: "0", want: RateLimit{}},
+		{in: "10/s", want: RateLimit{Requests: 10, Period: time.Second}},
+		{in: "100/m", want: RateLimit{Requests: 100, Period: time.Minute}},
+		{in: "1000/h", want: RateLimit{Requests: 1000, Period: time.Hour}},
+		{in: "10", wantErr: true},
+		{in: "-1/s", wantErr: true},
+		{in: "x/s", wantErr: true},
+		{in: "10/d", wantErr: true},
+		{in: "10/s/s", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRateLimit(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterAllow(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	type step struct {
+		key string
+		// at is the time since start
+		at            time.Duration
+		wantOK        bool
+		wantRemaining int
+		wantWait      time.Duration
+	}
+	tests := []struct {
+		name  string
+		limit RateLimit
+		steps []step
+	}{
+		{
+			name:  "burst then blocked",
+			limit: RateLimit{Requests: 2, Period: time.Second},
+			steps: []step{
+				{key: "a", wantOK: true, wantRemaining: 1, wantWait: 500 * time.Millisecond},
+				{key: "a", wantOK: true, wantRemaining: 0, wantWait: time.Second},
+				{key: "a", wantOK: false, wantWait: 500 * time.Millisecond},
+			},
+		},
+		{
+			name:  "refill over time",
+			limit: RateLimit{Requests: 2, Period: time.Second},
+			steps: []step{
+				{key: "a", wantOK: true, wantRemaining: 1, wantWait: 500 * time.Millisecond},
+				{key: "a", wantOK: true, wantRemaining: 0, wantWait: time.Second},
+				{key: "a", at: 250 * time.Millisecond, wantOK: false, wantWait: 250 * time.Millisecond},
+				{key: "a", at: 500 * time.Millisecond, wantOK: true, wantRemaining: 0, wantWait: time.Second},
+			},
+		},
+		{
+			name:  "keys are independent",
+			limit: RateLimit{Requests: 1, Period: time.Minute},
+			steps: []step{
+				{key: "a", wantOK: true, wantRemaining: 0, wantWait: time.Minute},
+				{key: "b", wantOK: true, wantRemaining: 0, wantWait: time.Minute},
+				{key: "a", at: time.Second, wantOK: false, wantWait: 59 * time.Second},
+			},
+		},
+		{
+			name:  "never above the capacity",
+			limit: RateLimit{Requests: 2, Period: time.Second},
+			steps: []step{
+				{key: "a", wantOK: true, wantRemaining: 1, wantWait: 500 * time.Millisecond},
+				{key: "a", at: time.Hour, wantOK: true, wantRemaining: 1, wantWait: 500 * time.Millisecond},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newLimiter(tt.limit)
+			for i, s := range tt.steps {
+				ok, remaining, wait := l.allow(s.key, start.Add(s.at))
+				if ok != s.wantOK || remaining != s.wantRemaining || wait.Round(time.Millisecond) != s.wantWait {
+					t.Fatalf("step %d: got (%v, %d, %v), want (%v, %d, %v)",
+						i, ok, remaining, wait, s.wantOK, s.wantRemaining, s.wantWait)
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterSweep(t *testing.T) {
+	l := newLimiter(RateLimit{Requests: 1, Period: time.Second})
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.allow("a", start)
+	l.allow("b", start.Add(1500*time.Millisecond))
+	if _, ok := l.buckets["a"]; ok {
+		t.Errorf("the full bucket of a wasn't swept")
+	}
+	if _, ok := l.buckets["b"]; !ok {
+		t.Errorf("the bucket of b is missing")
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	key := func(r *http.Request) string { return r.Header.Get("X-Key") }
+	h := rateLimit(RateLimit{Requests: 1, Period: time.Minute}, key)(next)
+
+	tests := []struct {
+		name, key  string
+		wantStatus int
+		wantRetry  string
+	}{
+		{name: "first", key: "a", wantStatus: http.StatusNoContent},
+		{name: "limited", key: "a", wantStatus: http.StatusTooManyRequests, wantRetry: "60"},
+		{name: "another key", key: "b", wantStatus: http.StatusNoContent},
+		{name: "no key", wantStatus: http.StatusNoContent},
+		{name: "no key again", wantStatus: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("X-Key", tt.key)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Retry-After"); got != tt.wantRetry {
+				t.Errorf("got Retry-After %q, want %q", got, tt.wantRetry)
+			}
+			if tt.key != "" && rec.Header().Get("RateLimit-Policy") != "1;w=60" {
+				t.Errorf("got RateLimit-Policy %q, want 1;w=60", rec.Header().Get("RateLimit-Policy"))
+			}
+		})
+	}
+
+	off := rateLimit(RateLimit{}, key)(next)
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Key", "a")
+		rec := httptest.NewRecorder()
+		off.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNoContent || rec.Header().Get("RateLimit-Policy") != "" {
+			t.Fatalf("the disabled limit applied: %d %v", rec.Code, rec.Header())
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tp, err := parseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"})
+	if err != nil {
+		t.Fatalf("parseTrustedProxies: %v", err)
+	}
+	tests := []struct {
+		name, remote, xff, want string
+	}{
+		{name: "direct", remote: "203.0.113.5:1234", want: "203.0.113.5"},
+		{name: "untrusted peer ignores the header", remote: "203.0.113.5:1234", xff: "198.51.100.1", want: "203.0.113.5"},
+		{name: "trusted peer", remote: "192.0.2.1:1234", xff: "198.51.100.1", want: "198.51.100.1"},
+		{name: "trusted hops skipped", remote: "192.0.2.1:1234", xff: "198.51.100.1, 10.1.1.1", want: "198.51.100.1"},
+		{name: "spoofed left part ignored", remote: "192.0.2.1:1234", xff: "1.1.1.1, 198.51.100.1", want: "198.51.100.1"},
+		{name: "garbage hop", remote: "192.0.2.1:1234", xff: "nope", want: "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remote
+			if tt.xff != "" {
+				req.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			if got := tp.clientIP(req); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if _, err := parseTrustedProxies([]string{"not an ip"}); err == nil {
+		t.Error("no error for an invalid proxy")
+	}
+}
+
+func TestForwardedWarning(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies []string
+		xff     []string
+		// wantWarnings is the number of the warnings logged
+		wantWarnings int
+	}{
+		{name: "not forwarded", xff: []string{"", ""}},
+		{name: "forwarded, warned once", xff: []string{"", "198.51.100.1", "198.51.100.2"}, wantWarnings: 1},
+		{name: "trusted proxies", proxies: []string{"10.0.0.0/8"}, xff: []string{"198.51.100.1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger, err := NewLogger(buf, LogConfig{Format: "json", Level: "info"})
+			if err != nil {
+				t.Fatalf("NewLogger: %v", err)
+			}
+			tp, err := parseTrustedProxies(tt.proxies)
+			if err != nil {
+				t.Fatalf("parseTrustedProxies: %v", err)
+			}
+			h := chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), requestLogging(logger), forwardedWarning(tp))
+			for _, xff := range tt.xff {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				if xff != "" {
+					req.Header.Set("X-Forwarded-For", xff)
+				}
+				h.ServeHTTP(httptest.NewRecorder(), req)
+			}
+			warnings := 0
+			for _, l := range logLines(t, buf) {
+				if l["level"] == "WARN" && strings.Contains(l["msg"].(string), "-trusted-proxies") {
+					warnings++
+				}
+			}
+			if warnings != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned (wrapped in a *CircuitOpenError) while the breaker fails fast
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError - returned instead of calling the upstream when the breaker is open
+type CircuitOpenError struct {
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%v, retry after %s", ErrCircuitOpen, e.RetryAfter)
+}
+
+// Unwrap makes errors.Is(err, ErrCircuitOpen) work
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// breaker states
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half-open"
+)
+
+// Breaker - a consecutive failures circuit breaker, once open it lets a single probe
+// request through after the cooldown, closing again if the probe succeeds
+type Breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewBreaker returns a closed breaker, which opens after threshold consecutive failures
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{threshold: threshold, cooldown: cooldown, state: BreakerClosed}
+}
+
+// Allow reports if a request may go through, if not, it also returns when to try again
+func (b *Breaker) Allow() (bool, time.Duration) {
+	if b == nil || b.threshold <= 0 {
+		return true, 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if wait := b.cooldown - time.Since(b.openedAt); wait > 0 {
+			return false, wait
+		}
+		b.state = BreakerHalfOpen
+		b.probing = true
+		return true, 0
+	case BreakerHalfOpen:
+		if b.probing {
+			return false, b.cooldown
+		}
+		b.probing = true
+		return true, 0
+	}
+	return true, 0
+}
+
+// Success records a successful call
+func (b *Breaker) Success() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = BreakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+// Failure records a failed call
+func (b *Breaker) Failure() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == BreakerHalfOpen || (b.threshold > 0 && b.failures >= b.threshold) {
+		b.state = BreakerOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// Release gives back a probe slot which ended up neither succeeding nor failing
+func (b *Breaker) Release() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// State returns the current breaker state
+func (b *Breaker) State() string {
+	if b == nil {
+		return BreakerClosed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.cooldown {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// ResilienceConfig - container for the upstream retry and timeout settings
+type ResilienceConfig struct {
+	// MaxRetries is the number of retries of the idempotent requests, on top of the first attempt
+	MaxRetries  int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// AttemptTimeout bounds how long a single attempt may wait for the response headers
+	AttemptTimeout time.Duration
+}
+
+type resilientTransport struct {
+	next    http.RoundTripper
+	breaker *Breaker
+	cfg     ResilienceConfig
+}
+
+// NewResilientTransport wraps rt with the per attempt timeouts, retries of the idempotent requests
+// (exponential backoff with full jitter, within the request deadline) and the circuit breaker,
+// it can be used both as the reverse proxy transport and as the SlashDB client one, breaker may be nil
+func NewResilientTransport(rt http.RoundTripper, breaker *Breaker, cfg ResilienceConfig) http.RoundTripper {
+	return &resilientTransport{next: rt, breaker: breaker, cfg: cfg}
+}
+
+func retryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	}
+	return false
+}
+
+// callerFault reports if the request failed because of the caller, it either gave up
+// or sent too big a body, that's not the upstream fault
+func callerFault(req *http.Request, err error) bool {
+	return req.Context().Err() != nil || (err != nil && bodyLimitExceeded(req, err))
+}
+
+// upstreamFailed reports if the call counts as a breaker failure, that's any server error
+// but the 501, which is about the request rather than the upstream health
+func upstreamFailed(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented
+}
+
+// transient reports if the call is worth retrying, a 500 would most likely be the same the next time
+func transient(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func (t *resilientTransport) backoff(attempt int) time.Duration {
+	d := t.cfg.BaseBackoff << attempt
+	if d <= 0 || (t.cfg.MaxBackoff > 0 && d > t.cfg.MaxBackoff) {
+		d = t.cfg.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d)))
+}
+
+// cancelOnClose releases the attempt context once the body is consumed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+func (t *resilientTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.cfg.AttemptTimeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	timer := time.AfterFunc(t.cfg.AttemptTimeout, cancel)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if !timer.Stop() && err != nil && req.Context().Err() == nil {
+		err = fmt.Errorf("no response within %s: %w", t.cfg.AttemptTimeout, err)
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if ok, wait := t.breaker.Allow(); !ok {
+			return nil, &CircuitOpenError{RetryAfter: wait}
+		}
+
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("req.GetBody: %w", err)
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		resp, err := t.attempt(req)
+		if callerFault(req, err) {
+			t.breaker.Release()
+			return resp, err
+		}
+		if !upstreamFailed(resp, err) {
+			t.breaker.Success()
+			return resp, nil
+		}
+		t.breaker.Failure()
+
+		if !transient(resp, err) || !retryable(req) || attempt >= t.cfg.MaxRetries {
+			return resp, err
+		}
+		wait := t.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package transport
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold int
+		cooldown  time.Duration
+		// calls are the outcomes recorded after each allowed request: s(uccess), f(ailure) or r(elease)
+		calls     string
+		wantState string
+		wantAllow bool
+	}{
+		{name: "closed", threshold: 2, cooldown: time.Hour, calls: "", wantState: BreakerClosed, wantAllow: true},
+		{name: "below the threshold", threshold: 2, cooldown: time.Hour, calls: "f", wantState: BreakerClosed, wantAllow: true},
+		{name: "success resets", threshold: 2, cooldown: time.Hour, calls: "fsf", wantState: BreakerClosed, wantAllow: true},
+		{name: "opens", threshold: 2, cooldown: time.Hour, calls: "ff", wantState: BreakerOpen, wantAllow: false},
+		{name: "half-open after the cooldown", threshold: 1, cooldown: 0, calls: "f", wantState: BreakerHalfOpen, wantAllow: true},
+		{name: "probe succeeds", threshold: 1, cooldown: 0, calls: "fs", wantState: BreakerClosed, wantAllow: true},
+		{name: "probe released", threshold: 1, cooldown: 0, calls: "fr", wantState: BreakerHalfOpen, wantAllow: true},
+		{name: "disabled", threshold: 0, cooldown: time.Hour, calls: "ffff", wantState: BreakerClosed, wantAllow: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBreaker(tt.threshold, tt.cooldown)
+			for i, c := range tt.calls {
+				if ok, _ := b.Allow(); !ok {
+					t.Fatalf("call %d not allowed", i)
+				}
+				switch c {
+				case 's':
+					b.Success()
+				case 'f':
+					b.Failure()
+				case 'r':
+					b.Release()
+				}
+			}
+			if got := b.State(); got != tt.wantState {
+				t.Errorf("got state %s, want %s", got, tt.wantState)
+			}
+			if ok, _ := b.Allow(); ok != tt.wantAllow {
+				t.Errorf("got allow %v, want %v", ok, tt.wantAllow)
+			}
+		})
+	}
+}
+
+func TestBreakerSingleProbe(t *testing.T) {
+	b := NewBreaker(1, 0)
+	b.Allow()
+	b.Failure()
+	if ok, _ := b.Allow(); !ok {
+		t.Fatal("the probe wasn't allowed")
+	}
+	if ok, _ := b.Allow(); ok {
+		t.Fatal("a second request was allowed while probing")
+	}
+	b.Failure()
+	if b.state != BreakerOpen {
+		t.Errorf("got state %s after the failed probe, want %s", b.state, BreakerOpen)
+	}
+}
+
+func TestUpstreamFailed(t *testing.T) {
+	tests := []struct {
+		status        int
+		err           error
+		wantFailed    bool
+		wantTransient bool
+	}{
+		{err: errors.New("connection refused"), wantFailed: true, wantTransient: true},
+		{status: http.StatusOK},
+		{status: http.StatusNotFound},
+		{status: http.StatusConflict},
+		{status: http.StatusInternalServerError, wantFailed: true},
+		{status: http.StatusNotImplemented},
+		{status: http.StatusBadGateway, wantFailed: true, wantTransient: true},
+		{status: http.StatusServiceUnavailable, wantFailed: true, wantTransient: true},
+		{status: http.StatusGatewayTimeout, wantFailed: true, wantTransient: true},
+		{status: http.StatusHTTPVersionNotSupported, wantFailed: true},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status}
+			}
+			if got := upstreamFailed(resp, tt.err); got != tt.wantFailed {
+				t.Errorf("got failed %v, want %v", got, tt.wantFailed)
+			}
+			if got := transient(resp, tt.err); got != tt.wantTransient {
+				t.Errorf("got transient %v, want %v", got, tt.wantTransient)
+			}
+		})
+	}
+}
+
+// roundTripFunc - a http.RoundTripper out of a function
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestResilientTransport(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		statuses []int
+		// threshold of the breaker, it's off when 0
+		threshold    int
+		wantStatus   int
+		wantAttempts int
+		wantState    string
+	}{
+		{name: "ok", method: http.MethodGet, statuses: []int{200}, threshold: 2, wantStatus: 200, wantAttempts: 1, wantState: BreakerClosed},
+		{name: "retried", method: http.MethodGet, statuses: []int{503, 502, 200}, wantStatus: 200, wantAttempts: 3, wantState: BreakerClosed},
+		{name: "retries exhausted", method: http.MethodGet, statuses: []int{503, 503, 503, 503}, wantStatus: 503, wantAttempts: 3, wantState: BreakerClosed},
+		{name: "not idempotent", method: http.MethodPost, statuses: []int{503, 200}, wantStatus: 503, wantAttempts: 1, wantState: BreakerClosed},
+		{name: "500 not retried", method: http.MethodGet, statuses: []int{500, 200}, wantStatus: 500, wantAttempts: 1, wantState: BreakerClosed},
+		{name: "500 counts as a failure", method: http.MethodGet, statuses: []int{500}, threshold: 1, wantStatus: 500, wantAttempts: 1, wantState: BreakerOpen},
+		{name: "501 doesn't count", method: http.MethodGet, statuses: []int{501}, threshold: 1, wantStatus: 501, wantAttempts: 1, wantState: BreakerClosed},
+		{name: "breaker stops the retries", method: http.MethodGet, statuses: []int{503, 503, 200}, threshold: 2, wantStatus: 0, wantAttempts: 2, wantState: BreakerOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			next := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				status := tt.statuses[min(attempts, len(tt.statuses)-1)]
+				attempts++
+				return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("")), Request: r}, nil
+			})
+			breaker := NewBreaker(tt.threshold, time.Hour)
+			rt := NewResilientTransport(next, breaker, ResilienceConfig{MaxRetries: 2, BaseBackoff: time.Millisecond})
+
+			resp, err := rt.RoundTrip(httptest.NewRequest(tt.method, "http://sdb/db/x.json", nil))
+			status := 0
+			if err == nil {
+				status = resp.StatusCode
+				resp.Body.Close()
+			} else if !errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("RoundTrip: %v", err)
+			}
+			if status != tt.wantStatus {
+				t.Errorf("got status %d, want %d", status, tt.wantStatus)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", attempts, tt.wantAttempts)
+			}
+			if got := breaker.State(); got != tt.wantState {
+				t.Errorf("got breaker %s, want %s", got, tt.wantState)
+			}
+		})
+	}
+}
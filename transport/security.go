@@ -0,0 +1,166 @@
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// cspReportPath is where the browsers send the CSP violation reports to
+const cspReportPath = "/app/csp-report"
+
+// DefaultCSP - the default Content-Security-Policy, Vue 2 compiles the in-DOM and the string templates
+// at runtime, hence the 'unsafe-eval'
+const DefaultCSP = "default-src 'self'; script-src 'self' 'nonce-{nonce}' 'unsafe-eval'; style-src 'self'; " +
+	"img-src 'self' data:; connect-src 'self'; object-src 'none'; base-uri 'self'; form-action 'self'"
+
+// SecurityConfig - container for the security headers settings, empty values are not sent
+type SecurityConfig struct {
+	// CSP is the Content-Security-Policy, "{nonce}" is replaced with a per request nonce,
+	// which the index template puts on its scripts
+	CSP string
+	// CSPReportOnly sends the policy as Content-Security-Policy-Report-Only, the violations
+	// are only reported (to /app/csp-report), not blocked
+	CSPReportOnly bool
+	// FrameAncestors is added to the CSP as the frame-ancestors directive, 'none' also sets X-Frame-Options
+	FrameAncestors    string
+	ReferrerPolicy    string
+	PermissionsPolicy string
+}
+
+// securityHeaderNames are set by the securityHeaders middleware, on every response
+var securityHeaderNames = []string{
+	"Content-Security-Policy",
+	"Content-Security-Policy-Report-Only",
+	"X-Content-Type-Options",
+	"X-Frame-Options",
+	"Referrer-Policy",
+	"Permissions-Policy",
+}
+
+type cspNonceKey struct{}
+
+// cspNonceFrom returns the request CSP nonce, if there's one
+func cspNonceFrom(ctx context.Context) string {
+	nonce, _ := ctx.Value(cspNonceKey{}).(string)
+	return nonce
+}
+
+func newCSPNonce() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// policy returns the full CSP, with the frame-ancestors and the report-uri directives
+func (c SecurityConfig) policy() string {
+	directives := []string{}
+	for _, d := range strings.Split(c.CSP, ";") {
+		if d = strings.TrimSpace(d); d != "" {
+			directives = append(directives, d)
+		}
+	}
+	if c.FrameAncestors != "" {
+		directives = append(directives, "frame-ancestors "+c.FrameAncestors)
+	}
+	if len(directives) == 0 {
+		return ""
+	}
+	return strings.Join(append(directives, "report-uri "+cspReportPath), "; ")
+}
+
+// securityHeaders sets the CSP (with a fresh nonce for every request) and the other security headers
+func securityHeaders(cfg SecurityConfig) middleware {
+	policy := cfg.policy()
+	withNonce := strings.Contains(policy, "{nonce}")
+	cspHeader := "Content-Security-Policy"
+	if cfg.CSPReportOnly {
+		cspHeader = "Content-Security-Policy-Report-Only"
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h := w.Header()
+			h.Set("X-Content-Type-Options", "nosniff")
+			if cfg.ReferrerPolicy != "" {
+				h.Set("Referrer-Policy", cfg.ReferrerPolicy)
+			}
+			if cfg.PermissionsPolicy != "" {
+				h.Set("Permissions-Policy", cfg.PermissionsPolicy)
+			}
+			if cfg.FrameAncestors == "'none'" {
+				h.Set("X-Frame-Options", "DENY")
+			}
+
+			if policy != "" {
+				p := policy
+				if withNonce {
+					nonce := newCSPNonce()
+					p = strings.ReplaceAll(policy, "{nonce}", nonce)
+					r = r.WithContext(context.WithValue(r.Context(), cspNonceKey{}, nonce))
+				}
+				h.Set(cspHeader, p)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// cspReportHandler logs the CSP violation reports, both the legacy (application/csp-report)
+// and the Reporting API (application/reports+json) ones
+func cspReportHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		if bodyLimitExceeded(r, err) {
+			writeTooLarge(w, r, 0)
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, codeBadRequest, "unreadable CSP report")
+		return
+	}
+
+	reports := []map[string]any{}
+	legacy := struct {
+		Report map[string]any `json:"csp-report"`
+	}{}
+	if err := json.Unmarshal(body, &legacy); err == nil && legacy.Report != nil {
+		reports = append(reports, legacy.Report)
+	} else {
+		batch := []struct {
+			Type string         `json:"type"`
+			Body map[string]any `json:"body"`
+		}{}
+		if err := json.Unmarshal(body, &batch); err != nil {
+			writeError(w, r, http.StatusBadRequest, codeBadRequest, "malformed CSP report")
+			return
+		}
+		for _, item := range batch {
+			if item.Type == "csp-violation" && item.Body != nil {
+				reports = append(reports, item.Body)
+			}
+		}
+	}
+
+	for _, report := range reports {
+		attrs := []any{}
+		for _, key := range []string{
+			"document-uri", "documentURL",
+			"violated-directive", "effectiveDirective",
+			"blocked-uri", "blockedURL",
+			"source-file", "sourceFile",
+			"line-number", "lineNumber",
+			"disposition",
+		} {
+			if v, ok := report[key]; ok {
+				attrs = append(attrs, slog.Any(key, v))
+			}
+		}
+		loggerFrom(r.Context()).Warn("CSP violation", attrs...)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,153 @@
+package transport
+
+import (
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  SecurityConfig
+		// want lists the headers expected, "" for the ones which must not be set
+		want map[string]string
+		// wantNonce is set when the CSP has to carry the request nonce
+		wantNonce bool
+	}{
+		{
+			name: "defaults",
+			cfg: SecurityConfig{
+				CSP: DefaultCSP, FrameAncestors: "'none'", ReferrerPolicy: "same-origin", PermissionsPolicy: "camera=()",
+			},
+			want: map[string]string{
+				"X-Content-Type-Options":              "nosniff",
+				"X-Frame-Options":                     "DENY",
+				"Referrer-Policy":                     "same-origin",
+				"Permissions-Policy":                  "camera=()",
+				"Content-Security-Policy-Report-Only": "",
+			},
+			wantNonce: true,
+		},
+		{
+			name: "report only",
+			cfg:  SecurityConfig{CSP: "default-src 'self'", CSPReportOnly: true, FrameAncestors: "'self'"},
+			want: map[string]string{
+				"Content-Security-Policy-Report-Only": "default-src 'self'; frame-ancestors 'self'; report-uri " + cspReportPath,
+				"Content-Security-Policy":             "",
+				"X-Frame-Options":                     "",
+			},
+		},
+		{
+			name: "disabled",
+			cfg:  SecurityConfig{},
+			want: map[string]string{
+				"X-Content-Type-Options":  "nosniff",
+				"Content-Security-Policy": "",
+				"Referrer-Policy":         "",
+				"Permissions-Policy":      "",
+			},
+		},
+	}
+	nonceRe := regexp.MustCompile(`'nonce-([^']+)'`)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nonces := []string{}
+			h := securityHeaders(tt.cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nonces = append(nonces, cspNonceFrom(r.Context()))
+			}))
+			policies := []string{}
+			for i := 0; i < 2; i++ {
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/app/", nil))
+				for k, want := range tt.want {
+					if got := rec.Header().Get(k); got != want {
+						t.Errorf("got %s %q, want %q", k, got, want)
+					}
+				}
+				policies = append(policies, rec.Header().Get("Content-Security-Policy"))
+			}
+			if !tt.wantNonce {
+				if nonces[0] != "" {
+					t.Errorf("got nonce %q, want none", nonces[0])
+				}
+				return
+			}
+			for i, p := range policies {
+				m := nonceRe.FindStringSubmatch(p)
+				if m == nil || m[1] != nonces[i] {
+					t.Errorf("got policy %q, want the nonce %q in it", p, nonces[i])
+				}
+				if !strings.Contains(p, "frame-ancestors 'none'; report-uri "+cspReportPath) {
+					t.Errorf("got policy %q, want the frame-ancestors and report-uri directives", p)
+				}
+			}
+			if nonces[0] == nonces[1] {
+				t.Errorf("the nonce %q is reused", nonces[0])
+			}
+		})
+	}
+}
+
+func TestIndexNonce(t *testing.T) {
+	h, _ := newTestServer(t, Config{Security: SecurityConfig{CSP: DefaultCSP}})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/app/", nil))
+	m := regexp.MustCompile(`'nonce-([^']+)'`).FindStringSubmatch(rec.Header().Get("Content-Security-Policy"))
+	if m == nil {
+		t.Fatalf("no nonce in the policy %q", rec.Header().Get("Content-Security-Policy"))
+	}
+	// the template escapes the attribute, the browsers unescape it
+	if !strings.Contains(html.UnescapeString(rec.Body.String()), `nonce="`+m[1]+`"`) {
+		t.Errorf("the index scripts don't carry the nonce %s", m[1])
+	}
+}
+
+func TestCSPReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantStatus  int
+		wantLogged  int
+	}{
+		{
+			name:        "legacy",
+			contentType: "application/csp-report",
+			body:        `{"csp-report":{"document-uri":"https://app.example/app/","violated-directive":"script-src","blocked-uri":"inline"}}`,
+			wantStatus:  http.StatusNoContent,
+			wantLogged:  1,
+		},
+		{
+			name:        "reporting API",
+			contentType: "application/reports+json",
+			body: `[{"type":"csp-violation","body":{"documentURL":"https://app.example/app/","effectiveDirective":"img-src"}},` +
+				`{"type":"deprecation","body":{"id":"x"}}]`,
+			wantStatus: http.StatusNoContent,
+			wantLogged: 1,
+		},
+		{name: "malformed", contentType: "application/csp-report", body: "{", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &strings.Builder{}
+			logger, err := NewLogger(buf, LogConfig{Format: "logfmt", Level: "info"})
+			if err != nil {
+				t.Fatalf("NewLogger: %v", err)
+			}
+			r := httptest.NewRequest(http.MethodPost, cspReportPath, strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			rec := httptest.NewRecorder()
+			requestLogging(logger)(http.HandlerFunc(cspReportHandler)).ServeHTTP(rec, r)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := strings.Count(buf.String(), `msg="CSP violation"`); got != tt.wantLogged {
+				t.Errorf("got %d violations logged, want %d: %s", got, tt.wantLogged, buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package transport
+
+import (
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/http"
+
+	"github.com/boromil/timesheet/domain"
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+// Config - container for the HTTP server settings
+type Config struct {
+	SdbDBName,
+	SdbInstanceAddr,
+	SdbAPIKey,
+	SdbAPIValue string
+	CORS      CORSConfig
+	RateLimit RateLimitConfig
+	Cache     CacheConfig
+	// DevMode serves the assets as they are on the disk, with no caching, and reloads the page on their changes
+	DevMode bool
+	// Proxy passes the requests outside of the app routes on to SlashDB, the /api/v1 endpoints replace it
+	Proxy    bool
+	Security SecurityConfig
+	Auth     AuthConfig
+	Limits   LimitsConfig
+	// PDFTemplate is the path of a custom timesheet PDF layout template, the assets one is used without it
+	PDFTemplate string
+	// InvoiceTemplate is the path of a custom invoice PDF layout template, the assets one is used without it
+	InvoiceTemplate string
+	// Domain configures the /api/v1 service, its DBName defaults to SdbDBName
+	Domain domain.Config
+	// Compression applies to all the responses, the static assets precompressed at build time are served as they are
+	Compression CompressionConfig
+}
+
+// Deps - container for the HTTP server dependencies
+type Deps struct {
+	SdbService slashdb.CRUDer
+	// Assets holds the assets/ (static) and templates/ (page index and PDF layout) directories
+	Assets fs.FS
+	Logger *slog.Logger
+	Health *Health
+	// Cache is optional, the proxy responses are not cached without it
+	Cache Cache
+	// UpstreamTransport is used by the reverse proxy, it defaults to a traced defaultTransport
+	UpstreamTransport http.RoundTripper
+}
+
+// middleware wraps a handler with some additional behavior
+type middleware func(http.Handler) http.Handler
+
+// chain wraps h with the given middlewares, the first one being the outermost
+func chain(h http.Handler, mws ...middleware) http.Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
+// methodNotAllowed answers the methods a route doesn't handle with a 405, allow lists the ones it does
+func methodNotAllowed(allow string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		writeError(w, r, http.StatusMethodNotAllowed, "", "")
+	})
+}
+
+// readOnly answers the methods other than GET and HEAD with a 405
+func readOnly(h http.Handler) http.Handler {
+	allow := http.MethodGet + ", " + http.MethodHead
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			methodNotAllowed(allow).ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// NewServer builds the app router, with all the routes and their middlewares
+func NewServer(cfg Config, deps Deps) (http.Handler, error) {
+	if deps.Logger == nil {
+		deps.Logger = slog.Default()
+	}
+	if deps.Health == nil {
+		deps.Health = NewHealth()
+	}
+	if err := cfg.CORS.Validate(); err != nil {
+		return nil, fmt.Errorf("CORS: %w", err)
+	}
+
+	var manifest *assetManifest
+	if !cfg.DevMode {
+		var err error
+		if manifest, err = newAssetManifest(deps.Assets); err != nil {
+			return nil, fmt.Errorf("newAssetManifest: %w", err)
+		}
+	}
+
+	indexHandler, err := newIndexHandler(cfg.SdbDBName, deps.Assets, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("newIndexHandler: %w", err)
+	}
+
+	if deps.UpstreamTransport == nil {
+		deps.UpstreamTransport = NewTracingRoundTripper(defaultTransport)
+	}
+
+	proxy, err := newReverseProxy(cfg.SdbInstanceAddr, cfg.SdbAPIKey, cfg.SdbAPIValue, deps.UpstreamTransport)
+	if err != nil {
+		return nil, fmt.Errorf("newReverseProxy: %w", err)
+	}
+
+	proxies, err := parseTrustedProxies(cfg.RateLimit.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("parseTrustedProxies: %w", err)
+	}
+	authLimit := rateLimit(cfg.RateLimit.Auth, proxies.ipKey)
+	authBody := maxBodySize(cfg.Limits.AuthBodySize)
+	apiLimit := rateLimit(cfg.RateLimit.API, userKey)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /app/", indexHandler)
+	// only the assets are static, the templates are not to be served as they are
+	mux.Handle("GET /app/static/"+assetsDir+"/", http.StripPrefix("/app/static/", staticHandler(deps.Assets, manifest)))
+	mux.Handle("GET /app/static/", http.NotFoundHandler())
+	if cfg.DevMode {
+		mux.HandleFunc("GET /app/dev/reload", devReloadHandler(deps.Assets))
+	}
+	mux.HandleFunc("GET /app/healthz", deps.Health.livenessHandler)
+	mux.HandleFunc("GET /app/readyz", deps.Health.readinessHandler)
+	// the reports are not authenticated, hence limited per IP
+	reportLimit := rateLimit(cfg.RateLimit.API, proxies.ipKey)
+	mux.Handle("POST "+cspReportPath, chain(http.HandlerFunc(cspReportHandler), reportLimit, maxBodySize(64<<10)))
+	sameSite := originCheck(cfg.CORS)
+	mux.Handle("POST /app/reg", chain(regHandler(deps.SdbService), sameSite, authLimit, authBody))
+	mux.Handle("POST /app/login", chain(loginHandler(deps.SdbService, cfg.Auth), sameSite, authLimit, authBody))
+	mux.Handle("POST /app/logout", chain(logoutHandler(cfg.Auth), sameSite))
+	// the GETs would fall through to the index page and the other methods to the proxy
+	for _, path := range []string{cspReportPath, "/app/reg", "/app/login", "/app/logout"} {
+		for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+			mux.Handle(method+" "+path, methodNotAllowed(http.MethodPost))
+		}
+	}
+	if cfg.Domain.DBName == "" {
+		cfg.Domain.DBName = cfg.SdbDBName
+	}
+	pdfTemplate, err := newPDFTemplate(deps.Assets, defaultPDFTemplate, cfg.PDFTemplate, cfg.DevMode)
+	if err != nil {
+		return nil, fmt.Errorf("newPDFTemplate: %w", err)
+	}
+	invoiceTemplate, err := newPDFTemplate(deps.Assets, defaultInvoiceTemplate, cfg.InvoiceTemplate, cfg.DevMode)
+	if err != nil {
+		return nil, fmt.Errorf("newPDFTemplate: %w", err)
+	}
+	api := &apiHandler{
+		svc:             domain.NewService(deps.SdbService, cfg.Domain),
+		raw:             proxy,
+		pdfTemplate:     pdfTemplate,
+		invoiceTemplate: invoiceTemplate,
+	}
+	// the calendar apps can't log in, the feed tokens authenticate them
+	feedLimit := rateLimit(cfg.RateLimit.API, proxies.ipKey)
+	mux.Handle("GET "+calendarFeedPath+"{token}", chain(http.HandlerFunc(api.calendarFeed), feedLimit))
+	api.routes(mux, authorizationMiddleware("", nil, cfg.Auth), apiLimit, maxBodySize(cfg.Limits.APIBodySize))
+	if cfg.Proxy {
+		// everything else is passed on to SlashDB, read only: the entries are changed through the API,
+		// which keeps the invoiced ones locked
+		mux.Handle("/", chain(
+			readOnly(proxy),
+			authorizationMiddleware(cfg.SdbDBName, nil, cfg.Auth),
+			apiLimit,
+			maxBodySize(cfg.Limits.APIBodySize),
+			responseCache(deps.Cache, cfg.Cache),
+		))
+	}
+
+	return chain(
+		mux,
+		tracing,
+		requestLogging(deps.Logger),
+		forwardedWarning(proxies),
+		recovery,
+		cors(cfg.CORS),
+		securityHeaders(cfg.Security),
+		compression(cfg.Compression),
+	), nil
+}
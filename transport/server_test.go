@@ -0,0 +1,237 @@
+package transport
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/boromil/timesheet/domain"
+	"github.com/boromil/timesheet/internal/sdbtest"
+	"gitlab.com/boromil/goslashdb/slashdb"
+)
+
+const testDB = "timesheet"
+
+// newTestServer builds the app server on top of a fake SlashDB, with the assets of the repo
+func newTestServer(t *testing.T, cfg Config) (http.Handler, *sdbtest.Server) {
+	t.Helper()
+	return newTestServerWith(t, cfg, Deps{})
+}
+
+// newTestServerWith is newTestServer with some of the dependencies given
+func newTestServerWith(t *testing.T, cfg Config, deps Deps) (http.Handler, *sdbtest.Server) {
+	t.Helper()
+	fake := sdbtest.New(t, testDB)
+	svc, err := slashdb.NewService(fake.URL, "apikey", "key", "", false, domain.NewStatusDoer(fake.Client()))
+	if err != nil {
+		t.Fatalf("slashdb.NewService: %v", err)
+	}
+	cfg.SdbDBName, cfg.SdbInstanceAddr = testDB, fake.URL
+	cfg.SdbAPIKey, cfg.SdbAPIValue = "apikey", "key"
+	if cfg.Compression.MinSize == 0 {
+		cfg.Compression.MinSize = -1
+	}
+	deps.SdbService = svc
+	deps.Assets = SubDirs(os.DirFS(".."), "assets", "templates")
+	if deps.Logger == nil {
+		deps.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	deps.UpstreamTransport = fake.Client().Transport
+	h, err := NewServer(cfg, deps)
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	return h, fake
+}
+
+// testToken returns a bearer token of the user
+func testToken(t *testing.T, userID int) string {
+	t.Helper()
+	token, err := genJWTToken("user", userID, nil)
+	if err != nil {
+		t.Fatalf("genJWTToken: %v", err)
+	}
+	return token
+}
+
+func TestPostOnlyRoutes(t *testing.T) {
+	h, _ := newTestServer(t, Config{})
+	tests := []struct {
+		method, path string
+		want         int
+	}{
+		{http.MethodGet, "/app/login", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/app/reg", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/app/logout", http.StatusMethodNotAllowed},
+		{http.MethodGet, cspReportPath, http.StatusMethodNotAllowed},
+		{http.MethodPut, "/app/login", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/app/logout", http.StatusNoContent},
+		// the login form is validated, the route is there
+		{http.MethodPost, "/app/login", http.StatusBadRequest},
+		{http.MethodGet, "/app/", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader("")))
+			if rec.Code != tt.want {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+			if tt.want == http.StatusMethodNotAllowed {
+				if allow := rec.Header().Get("Allow"); allow != http.MethodPost {
+					t.Errorf("got Allow %q, want POST", allow)
+				}
+				if !strings.Contains(rec.Body.String(), codeMethodNotAllowed) {
+					t.Errorf("got body %s, want the %s envelope", rec.Body, codeMethodNotAllowed)
+				}
+			}
+		})
+	}
+}
+
+func TestChainOrder(t *testing.T) {
+	order := []string{}
+	mw := func(name string) middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				order = append(order, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+	h := chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { order = append(order, "handler") }), mw("outer"), mw("inner"))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if got := strings.Join(order, ","); got != "outer,inner,handler" {
+		t.Fatalf("got order %s, want outer,inner,handler", got)
+	}
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	h, fake := newTestServer(t, Config{
+		Proxy: true,
+		CORS: CORSConfig{
+			AllowedOrigins: []string{"https://app.example"},
+			AllowedMethods: []string{http.MethodGet, http.MethodPost},
+			AllowedHeaders: []string{"Authorization"},
+		},
+		Security: SecurityConfig{CSP: DefaultCSP},
+	})
+	fake.Insert("user", sdbtest.Row{"id": 1, "username": "user", "passwd": "x", "email": "user@example.com"})
+	token := testToken(t, 1)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		header     http.Header
+		wantStatus int
+		wantHeader map[string]string
+		// wantCode is the error envelope code, if any
+		wantCode string
+	}{
+		{
+			name:       "preflight skips the auth",
+			method:     http.MethodOptions,
+			path:       apiPrefix + "/me",
+			header:     http.Header{"Origin": {"https://app.example"}, "Access-Control-Request-Method": {"GET"}},
+			wantStatus: http.StatusNoContent,
+			wantHeader: map[string]string{"Access-Control-Allow-Origin": "https://app.example"},
+		},
+		{
+			name:       "no token",
+			method:     http.MethodGet,
+			path:       apiPrefix + "/me",
+			wantStatus: http.StatusUnauthorized,
+			wantHeader: map[string]string{"X-Content-Type-Options": "nosniff"},
+			wantCode:   codeUnauthorized,
+		},
+		{
+			name:       "authenticated",
+			method:     http.MethodGet,
+			path:       apiPrefix + "/me",
+			header:     http.Header{"Authorization": {"Bearer " + token}, "Origin": {"https://app.example"}},
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{
+				"Access-Control-Allow-Origin":   "https://app.example",
+				"Access-Control-Expose-Headers": corsExposedHeaders,
+			},
+		},
+		{
+			name:       "request ID propagated",
+			method:     http.MethodGet,
+			path:       apiPrefix + "/me",
+			header:     http.Header{"Authorization": {"Bearer " + token}, requestIDHeader: {"abc-123"}},
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{requestIDHeader: "abc-123"},
+		},
+		{
+			name:       "unknown API endpoint",
+			method:     http.MethodGet,
+			path:       apiPrefix + "/nope",
+			wantStatus: http.StatusNotFound,
+			wantCode:   codeNotFound,
+		},
+		{
+			name:       "proxy restricted to the token user",
+			method:     http.MethodGet,
+			path:       "/db/" + testDB + "/timesheet/user_id/2.json",
+			header:     http.Header{"Authorization": {"Bearer " + token}},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   codeUnauthorized,
+		},
+		{
+			name:       "proxy read only",
+			method:     http.MethodPut,
+			path:       "/db/" + testDB + "/timesheet/user_id/1.json",
+			header:     http.Header{"Authorization": {"Bearer " + token}},
+			wantStatus: http.StatusMethodNotAllowed,
+			wantHeader: map[string]string{"Allow": "GET, HEAD"},
+			wantCode:   codeMethodNotAllowed,
+		},
+		{
+			name:       "index page",
+			method:     http.MethodGet,
+			path:       "/app/",
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{"X-Content-Type-Options": "nosniff"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			for k, vs := range tt.header {
+				req.Header.Set(k, vs[0])
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Header().Get(requestIDHeader) == "" {
+				t.Errorf("no %s header", requestIDHeader)
+			}
+			// the preflights are answered before the security headers are set
+			if tt.method != http.MethodOptions && rec.Header().Get("Content-Security-Policy") == "" {
+				t.Errorf("no Content-Security-Policy header")
+			}
+			for k, want := range tt.wantHeader {
+				if got := rec.Header().Get(k); got != want {
+					t.Errorf("got %s %q, want %q", k, got, want)
+				}
+			}
+			if tt.wantCode != "" {
+				body := rec.Body.String()
+				if !strings.Contains(body, `"code":"`+tt.wantCode+`"`) {
+					t.Errorf("got body %s, want the %s envelope", body, tt.wantCode)
+				}
+				if !strings.Contains(body, `"request_id":"`+rec.Header().Get(requestIDHeader)+`"`) {
+					t.Errorf("the envelope lacks the request ID: %s", body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package transport
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/request"
+)
+
+// session and CSRF cookie names, and the header the CSRF token is submitted with
+const (
+	sessionCookieName = "timesheet_session"
+	csrfCookieName    = "timesheet_csrf"
+	csrfHeader        = "X-CSRF-Token"
+)
+
+// AuthConfig - container for the authentication settings
+type AuthConfig struct {
+	// CookieMode makes the login set the JWT as an HttpOnly cookie, instead of returning it
+	// (the bearer header is accepted either way), the cookie authenticated state changing requests
+	// have to carry the CSRF cookie value in the X-CSRF-Token header (double submit)
+	CookieMode bool
+	// CookieInsecure drops the Secure attribute, for the plain HTTP development setups only
+	CookieInsecure bool
+	// SameSite is the SameSite attribute of both cookies, it defaults to Lax
+	SameSite http.SameSite
+}
+
+// sessionCookieExtractor extracts the JWT from the session cookie
+type sessionCookieExtractor struct{}
+
+func (sessionCookieExtractor) ExtractToken(r *http.Request) (string, error) {
+	c, err := r.Cookie(sessionCookieName)
+	if err != nil || c.Value == "" {
+		return "", request.ErrNoTokenInRequest
+	}
+	return c.Value, nil
+}
+
+func (cfg AuthConfig) cookie(name, value string, maxAge time.Duration, httpOnly bool) *http.Cookie {
+	sameSite := cfg.SameSite
+	if sameSite == 0 {
+		sameSite = http.SameSiteLaxMode
+	}
+	c := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		HttpOnly: httpOnly,
+		Secure:   !cfg.CookieInsecure,
+		SameSite: sameSite,
+	}
+	if maxAge > 0 {
+		c.MaxAge = int(maxAge.Seconds())
+		c.Expires = time.Now().Add(maxAge)
+	} else {
+		c.MaxAge = -1
+	}
+	return c
+}
+
+func newCSRFToken() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// setSessionCookies sets the session (HttpOnly) and the CSRF (readable by the app) cookies,
+// it returns the CSRF token
+func (cfg AuthConfig) setSessionCookies(w http.ResponseWriter, token string) string {
+	csrfToken := newCSRFToken()
+	http.SetCookie(w, cfg.cookie(sessionCookieName, token, tokenTTL, true))
+	http.SetCookie(w, cfg.cookie(csrfCookieName, csrfToken, tokenTTL, false))
+	return csrfToken
+}
+
+// clearSessionCookies expires both cookies
+func (cfg AuthConfig) clearSessionCookies(w http.ResponseWriter) {
+	http.SetCookie(w, cfg.cookie(sessionCookieName, "", 0, true))
+	http.SetCookie(w, cfg.cookie(csrfCookieName, "", 0, false))
+}
+
+// csrfSafe reports if a cookie authenticated request passes the double submit check,
+// the safe methods don't need to
+func csrfSafe(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	c, err := r.Cookie(csrfCookieName)
+	if err != nil || c.Value == "" {
+		return false
+	}
+	submitted := r.Header.Get(csrfHeader)
+	return submitted != "" && subtle.ConstantTimeCompare([]byte(submitted), []byte(c.Value)) == 1
+}
+
+// sameOrigin reports if the request was sent by a page of the app itself, or of one of the origins
+// allowed by the CORS policy, the requests telling neither the Origin nor the Referer are let through,
+// the browsers send at least one of them with the cross-site POSTs
+func sameOrigin(r *http.Request, cfg CORSConfig) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		if r.Referer() == "" {
+			return true
+		}
+		ref, err := url.Parse(r.Referer())
+		if err != nil {
+			return false
+		}
+		origin = ref.Scheme + "://" + ref.Host
+	}
+	if allowed, wildcard := cfg.originAllowed(origin); allowed && !wildcard {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Host != "" && strings.EqualFold(u.Host, r.Host)
+}
+
+// originCheck guards the routes the double submit check can't, the login would otherwise let
+// another site log the browser in as someone else (login CSRF)
+func originCheck(cfg CORSConfig) middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !sameOrigin(r, cfg) {
+				writeError(w, r, http.StatusForbidden, codeForbidden, "cross-site request")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// logoutHandler expires the session cookies, the bearer tokens can't be revoked, the app just drops them
+func logoutHandler(cfg AuthConfig) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cfg.clearSessionCookies(w)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
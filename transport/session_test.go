@@ -0,0 +1,152 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/boromil/timesheet/internal/sdbtest"
+)
+
+func TestCSRFSafe(t *testing.T) {
+	tests := []struct {
+		name, method, cookie, header string
+		want                         bool
+	}{
+		{name: "GET", method: http.MethodGet, want: true},
+		{name: "HEAD", method: http.MethodHead, want: true},
+		{name: "OPTIONS", method: http.MethodOptions, want: true},
+		{name: "matching", method: http.MethodPost, cookie: "token", header: "token", want: true},
+		{name: "matching PUT", method: http.MethodPut, cookie: "token", header: "token", want: true},
+		{name: "no header", method: http.MethodPost, cookie: "token"},
+		{name: "no cookie", method: http.MethodPost, header: "token"},
+		{name: "neither", method: http.MethodDelete},
+		{name: "different", method: http.MethodPost, cookie: "token", header: "other"},
+		{name: "prefix", method: http.MethodPost, cookie: "token", header: "tok"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/", nil)
+			if tt.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: csrfCookieName, Value: tt.cookie})
+			}
+			if tt.header != "" {
+				r.Header.Set(csrfHeader, tt.header)
+			}
+			if got := csrfSafe(r); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameOrigin(t *testing.T) {
+	cors := CORSConfig{AllowedOrigins: []string{"https://app.example"}}
+	tests := []struct {
+		name, origin, referer string
+		cors                  CORSConfig
+		want                  bool
+	}{
+		{name: "no headers", want: true},
+		{name: "same", origin: "https://timesheet.example", want: true},
+		{name: "same, other scheme", origin: "http://timesheet.example", want: true},
+		{name: "cross-site", origin: "https://evil.example"},
+		{name: "null", origin: "null"},
+		{name: "allowed by CORS", origin: "https://app.example", cors: cors, want: true},
+		{name: "not allowed by CORS", origin: "https://evil.example", cors: cors},
+		{name: "the wildcard doesn't count", origin: "https://evil.example", cors: CORSConfig{AllowedOrigins: []string{"*"}}},
+		{name: "same referer", referer: "https://timesheet.example/app/", want: true},
+		{name: "cross-site referer", referer: "https://evil.example/login.html"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "https://timesheet.example/app/login", nil)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			if tt.referer != "" {
+				r.Header.Set("Referer", tt.referer)
+			}
+			if got := sameOrigin(r, tt.cors); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoginCSRF(t *testing.T) {
+	h, fake := newTestServer(t, Config{Auth: AuthConfig{CookieMode: true}})
+	fake.Insert("user", sdbtest.Row{"id": 1, "username": "user", "passwd": genPassword("user"+"secret", nil), "email": ""})
+	form := url.Values{"username": {"user"}, "password": {"secret"}}.Encode()
+
+	tests := []struct {
+		name, path, origin string
+		want               int
+	}{
+		{name: "login", path: "/app/login", origin: "http://example.com", want: http.StatusOK},
+		{name: "login without an origin", path: "/app/login", want: http.StatusOK},
+		{name: "cross-site login", path: "/app/login", origin: "https://evil.example", want: http.StatusForbidden},
+		{name: "cross-site logout", path: "/app/logout", origin: "https://evil.example", want: http.StatusForbidden},
+		{name: "cross-site registration", path: "/app/reg", origin: "https://evil.example", want: http.StatusForbidden},
+		{name: "logout", path: "/app/logout", origin: "http://example.com", want: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(form))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, r)
+			if rec.Code != tt.want {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+			if tt.want == http.StatusForbidden && len(rec.Result().Cookies()) > 0 {
+				t.Errorf("the rejected request set cookies: %v", rec.Result().Cookies())
+			}
+		})
+	}
+}
+
+func TestProxyStripsCredentials(t *testing.T) {
+	h, fake := newTestServer(t, Config{Proxy: true, Auth: AuthConfig{CookieMode: true}})
+	fake.Insert("timesheet", sdbtest.Row{"user_id": 1, "project_id": 1, "date": "2026-01-01"})
+	token := testToken(t, 1)
+
+	tests := []struct {
+		name string
+		auth func(r *http.Request)
+	}{
+		{name: "cookie", auth: func(r *http.Request) {
+			r.AddCookie(&http.Cookie{Name: sessionCookieName, Value: token})
+			r.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "csrf"})
+		}},
+		{name: "bearer", auth: func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(fake.Requests())
+			r := httptest.NewRequest(http.MethodGet, "/db/"+testDB+"/timesheet/user_id/1.json", nil)
+			tt.auth(r)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, r)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %d, want 200: %s", rec.Code, rec.Body)
+			}
+			reqs := fake.Requests()[before:]
+			if len(reqs) != 1 {
+				t.Fatalf("got %d SlashDB requests, want 1", len(reqs))
+			}
+			got := reqs[0].Header
+			if got.Get("Cookie") != "" || got.Get("Authorization") != "" {
+				t.Errorf("the credentials were passed on: %v", got)
+			}
+			if got.Get("apikey") != "key" {
+				t.Errorf("got API key %q, want key", got.Get("apikey"))
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+	"gitlab.com/boromil/goslashdb/types"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/boromil/timesheet/transport"
+
+// the global provider delegates to the one installed by SetupTracing, even if it's set up later on
+var tracer = otel.Tracer(tracerName)
+
+// TracingConfig - container for the OpenTelemetry settings
+type TracingConfig struct {
+	// Exporter is one of "none", "otlp" or "stdout"
+	Exporter string
+	// Endpoint is the OTLP/HTTP collector address, i.e. "localhost:4318",
+	// when empty the OTEL_EXPORTER_OTLP_* environment variables are used
+	Endpoint    string
+	Insecure    bool
+	ServiceName string
+	SampleRatio float64
+}
+
+// SetupTracing installs the global tracer provider and the W3C trace context propagator,
+// the returned func flushes and stops the exporter
+func SetupTracing(ctx context.Context, cfg TracingConfig, stdout io.Writer) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		opts := []otlptracehttp.Option{}
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q, expected none, otlp or stdout", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the %s trace exporter: %w", cfg.Exporter, err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("resource.Merge: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// requestTarget - the URL and the request URI of a request
+type requestTarget struct {
+	url        *url.URL
+	requestURI string
+}
+
+type requestTargetKey struct{}
+
+// tracing starts a server span for every incoming request,
+// it also picks up the trace context sent by the caller. The span gets the request with
+// the secret path segments masked, the handlers the request as it came
+func tracing(next http.Handler) http.Handler {
+	traced := otelhttp.NewHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if t, ok := r.Context().Value(requestTargetKey{}).(requestTarget); ok {
+				r = r.WithContext(r.Context())
+				r.URL, r.RequestURI = t.url, t.requestURI
+			}
+			next.ServeHTTP(w, r)
+		}),
+		"timesheet",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if path := redactPath(r.URL.Path); path != r.URL.Path {
+			t := requestTarget{url: r.URL, requestURI: r.RequestURI}
+			r = r.WithContext(context.WithValue(r.Context(), requestTargetKey{}, t))
+			masked := *t.url
+			masked.Path, masked.RawPath = path, ""
+			r.URL, r.RequestURI = &masked, masked.RequestURI()
+		}
+		traced.ServeHTTP(w, r)
+	})
+}
+
+// NewTracingRoundTripper wraps rt so that every upstream call gets a client span
+// and carries the trace context headers to SlashDB, but the ones with secrets in their URLs
+func NewTracingRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(rt,
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "SlashDB " + r.Method
+		}),
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return redactPath(r.URL.Path) == r.URL.Path
+		}),
+	)
+}
+
+// tracedService adds a span around every SlashDB CRUD call
+type tracedService struct {
+	next slashdb.CRUDer
+}
+
+// NewTracedService wraps a SlashDB service, so that each of its CRUD calls is traced
+func NewTracedService(next slashdb.CRUDer) slashdb.CRUDer {
+	return &tracedService{next: next}
+}
+
+func startSlashDBSpan(ctx context.Context, op string, sdbReq fmt.Stringer) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "slashdb."+op,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attribute.String("slashdb.request", redactPath(sdbReq.String()))),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (s *tracedService) Get(ctx context.Context, sdbReq fmt.Stringer, container interface{}) error {
+	ctx, span := startSlashDBSpan(ctx, "Get", sdbReq)
+	err := s.next.Get(ctx, sdbReq, container)
+	endSpan(span, err)
+	return err
+}
+
+func (s *tracedService) Create(
+	ctx context.Context,
+	sdbReq fmt.Stringer,
+	payload interface{},
+) (types.CreateResponse, error) {
+	ctx, span := startSlashDBSpan(ctx, "Create", sdbReq)
+	resp, err := s.next.Create(ctx, sdbReq, payload)
+	endSpan(span, err)
+	return resp, err
+}
+
+func (s *tracedService) Update(ctx context.Context, sdbReq fmt.Stringer, payload interface{}) error {
+	ctx, span := startSlashDBSpan(ctx, "Update", sdbReq)
+	err := s.next.Update(ctx, sdbReq, payload)
+	endSpan(span, err)
+	return err
+}
+
+func (s *tracedService) Delete(ctx context.Context, sdbReq fmt.Stringer) error {
+	ctx, span := startSlashDBSpan(ctx, "Delete", sdbReq)
+	err := s.next.Delete(ctx, sdbReq)
+	endSpan(span, err)
+	return err
+}
@@ -0,0 +1,105 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"gitlab.com/boromil/goslashdb/slashdb"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	spanRecorderOnce sync.Once
+	spanRecorder     *tracetest.SpanRecorder
+)
+
+// recordSpans installs the global tracer provider recording the spans and the W3C propagator, once for all
+// of the tests, the tracers handed out by the global provider stick to the first one installed
+func recordSpans() *tracetest.SpanRecorder {
+	spanRecorderOnce.Do(func() {
+		spanRecorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	})
+	return spanRecorder
+}
+
+func TestTracingPropagation(t *testing.T) {
+	spans := recordSpans()
+	var upstreamHeader http.Header
+	upstream := NewTracingRoundTripper(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		upstreamHeader = r.Header.Clone()
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Request: r}, nil
+	}))
+	svc, err := slashdb.NewService("http://sdb", "apikey", "key", "", false, &http.Client{Transport: upstream})
+	if err != nil {
+		t.Fatalf("slashdb.NewService: %v", err)
+	}
+	traced := NewTracedService(svc)
+
+	// the incoming request carries the caller trace, the SlashDB call made for it joins it
+	const callerTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	h := tracing(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := slashdb.NewDataRequest("")
+		req.AddParts(slashdb.Part{Name: "x"}, slashdb.Part{Name: "timesheet"})
+		if err := traced.Delete(r.Context(), req); err != nil {
+			t.Errorf("Delete: %v", err)
+		}
+	}))
+	before := len(spans.Ended())
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/entries/1", nil)
+	r.Header.Set("traceparent", "00-"+callerTraceID+"-00f067aa0ba902b7-01")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	names := map[string]trace.SpanContext{}
+	for _, s := range spans.Ended()[before:] {
+		names[s.Name()] = s.SpanContext()
+		if got := s.SpanContext().TraceID().String(); got != callerTraceID {
+			t.Errorf("span %s: got trace %s, want %s", s.Name(), got, callerTraceID)
+		}
+	}
+	for _, name := range []string{"DELETE /api/v1/entries/1", "slashdb.Delete", "SlashDB DELETE"} {
+		if _, ok := names[name]; !ok {
+			t.Errorf("no %s span, got %v", name, names)
+		}
+	}
+	parent := upstreamHeader.Get("traceparent")
+	if want := names["SlashDB DELETE"].SpanID().String(); !strings.Contains(parent, callerTraceID) || !strings.Contains(parent, want) {
+		t.Errorf("got the upstream traceparent %q, want the trace %s and the span %s", parent, callerTraceID, want)
+	}
+}
+
+func TestSetupTracing(t *testing.T) {
+	recordSpans()
+	// the exporters that would replace the global tracer provider aren't set up here
+	tests := []struct {
+		exporter string
+		wantErr  bool
+	}{
+		{exporter: ""},
+		{exporter: "none"},
+		{exporter: "zipkin", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.exporter, func(t *testing.T) {
+			shutdown, err := SetupTracing(context.Background(), TracingConfig{Exporter: tt.exporter}, io.Discard)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if err := shutdown(context.Background()); err != nil {
+					t.Errorf("shutdown: %v", err)
+				}
+			}
+		})
+	}
+}
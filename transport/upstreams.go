@@ -0,0 +1,271 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// upstream roles
+const (
+	RolePrimary = "primary"
+	RoleReplica = "replica"
+)
+
+// Upstream - a single SlashDB instance, writes only ever go to the primaries
+type Upstream struct {
+	URL  *url.URL
+	Role string
+}
+
+// ParseUpstreams parses lists like "https://db1.example.com=primary,https://db2.example.com/sdb=replica",
+// an upstream without a role is a primary one, at least one primary is required
+func ParseUpstreams(s string) ([]Upstream, error) {
+	upstreams := []Upstream{}
+	hasPrimary := false
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		addr, role := item, RolePrimary
+		if i := strings.LastIndex(item, "="); i >= 0 {
+			addr, role = item[:i], item[i+1:]
+		}
+		if role != RolePrimary && role != RoleReplica {
+			return nil, fmt.Errorf("invalid role %q of %q, expected %s or %s", role, addr, RolePrimary, RoleReplica)
+		}
+		u, err := url.Parse(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upstream address %q: %w", addr, err)
+		}
+		if u.Scheme == "" || u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+			return nil, fmt.Errorf("invalid upstream address %q, expected scheme://host[:port][/path]", addr)
+		}
+		// the requests are relative to the path, with or without the trailing slash
+		u.Path, u.RawPath = strings.TrimRight(u.Path, "/"), strings.TrimRight(u.RawPath, "/")
+
+		hasPrimary = hasPrimary || role == RolePrimary
+		upstreams = append(upstreams, Upstream{URL: u, Role: role})
+	}
+	if !hasPrimary {
+		return nil, fmt.Errorf("no %s upstream in %q", RolePrimary, s)
+	}
+	return upstreams, nil
+}
+
+// PoolConfig - container for the upstream pool settings
+type PoolConfig struct {
+	// BreakerThreshold and BreakerCooldown configure the per upstream circuit breakers
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// ProbePath is requested on every upstream each ProbeInterval (0 disables probing), upstreams
+	// answering with an error (or a 5xx) are ejected until they pass a probe again
+	ProbePath     string
+	ProbeInterval time.Duration
+	ProbeTimeout  time.Duration
+	// APIKey and APIValue are the SlashDB API key header sent with the probes, as with all the other requests
+	APIKey, APIValue string
+}
+
+type poolMember struct {
+	Upstream
+	breaker *Breaker
+	healthy atomic.Bool
+}
+
+// state returns "down" for the upstreams ejected by the probes, the breaker state otherwise
+func (m *poolMember) state() string {
+	if !m.healthy.Load() {
+		return "down"
+	}
+	return m.breaker.State()
+}
+
+// UpstreamPool - a http.RoundTripper spreading the requests over the SlashDB upstreams,
+// the reads are balanced across the healthy replicas (falling back to the primaries),
+// the writes go to the first healthy primary
+type UpstreamPool struct {
+	next      http.RoundTripper
+	cfg       PoolConfig
+	logger    *slog.Logger
+	primaries []*poolMember
+	replicas  []*poolMember
+	counter   atomic.Uint64
+}
+
+// NewUpstreamPool returns a pool sending the requests through rt, the requests are expected to be made
+// to the first primary, their scheme, host and path prefix are swapped for the chosen upstream ones
+func NewUpstreamPool(upstreams []Upstream, rt http.RoundTripper, cfg PoolConfig, logger *slog.Logger) *UpstreamPool {
+	p := &UpstreamPool{next: rt, cfg: cfg, logger: logger}
+	for _, u := range upstreams {
+		m := &poolMember{Upstream: u, breaker: NewBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown)}
+		m.healthy.Store(true)
+		if u.Role == RoleReplica {
+			p.replicas = append(p.replicas, m)
+		} else {
+			p.primaries = append(p.primaries, m)
+		}
+	}
+	return p
+}
+
+// Primary returns the address of the first primary upstream
+func (p *UpstreamPool) Primary() string {
+	return p.primaries[0].URL.String()
+}
+
+// States returns the upstream states keyed by their address, for the readiness endpoint
+func (p *UpstreamPool) States() map[string]func() string {
+	states := map[string]func() string{}
+	for _, m := range append(append([]*poolMember{}, p.primaries...), p.replicas...) {
+		states[m.Role+" "+m.URL.Host] = m.state
+	}
+	return states
+}
+
+// candidates returns the members to try, in order
+func (p *UpstreamPool) candidates(method string) []*poolMember {
+	if method != http.MethodGet && method != http.MethodHead && method != http.MethodOptions {
+		return p.primaries
+	}
+
+	members := make([]*poolMember, 0, len(p.replicas)+len(p.primaries))
+	if n := len(p.replicas); n > 0 {
+		start := int(p.counter.Add(1) % uint64(n))
+		members = append(members, p.replicas[start:]...)
+		members = append(members, p.replicas[:start]...)
+	}
+	return append(members, p.primaries...)
+}
+
+// RoundTrip sends the request to the first available candidate, there's no failover within
+// a single round trip, the retries (if any) are left to the wrapping transport
+func (p *UpstreamPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	var wait time.Duration
+	for _, m := range p.candidates(req.Method) {
+		if !m.healthy.Load() {
+			continue
+		}
+		ok, w := m.breaker.Allow()
+		if !ok {
+			if wait == 0 || w < wait {
+				wait = w
+			}
+			continue
+		}
+
+		out := req.Clone(req.Context())
+		out.URL.Scheme, out.URL.Host = m.URL.Scheme, m.URL.Host
+		out.Host = m.URL.Host
+		p.rebase(out.URL, m.URL)
+
+		resp, err := p.next.RoundTrip(out)
+		switch {
+		case callerFault(req, err):
+			m.breaker.Release()
+		case upstreamFailed(resp, err):
+			m.breaker.Failure()
+		default:
+			m.breaker.Success()
+		}
+		return resp, err
+	}
+
+	if wait == 0 {
+		// all the candidates are down, they'll be probed again within the interval
+		wait = p.cfg.ProbeInterval
+	}
+	return nil, &CircuitOpenError{RetryAfter: wait}
+}
+
+// rebase swaps the first primary path prefix of u for the one of the upstream
+func (p *UpstreamPool) rebase(u, upstream *url.URL) {
+	base := p.primaries[0].URL.EscapedPath()
+	if base == upstream.EscapedPath() {
+		return
+	}
+	escaped := upstream.EscapedPath() + strings.TrimPrefix(u.EscapedPath(), base)
+	if path, err := url.PathUnescape(escaped); err == nil {
+		u.Path, u.RawPath = path, escaped
+	}
+}
+
+// Run probes the upstreams until ctx is done
+func (p *UpstreamPool) Run(ctx context.Context) {
+	if p.cfg.ProbeInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.cfg.ProbeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		wg := sync.WaitGroup{}
+		for _, m := range append(append([]*poolMember{}, p.primaries...), p.replicas...) {
+			wg.Add(1)
+			go func(m *poolMember) {
+				defer wg.Done()
+				p.probe(ctx, m)
+			}(m)
+		}
+		wg.Wait()
+	}
+}
+
+func (p *UpstreamPool) probe(parent context.Context, m *poolMember) {
+	ctx := parent
+	if p.cfg.ProbeTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(parent, p.cfg.ProbeTimeout)
+		defer cancel()
+	}
+
+	err := func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.URL.JoinPath(p.cfg.ProbePath).String(), nil)
+		if err != nil {
+			return fmt.Errorf("http.NewRequest: %w", err)
+		}
+		if p.cfg.APIKey != "" {
+			req.Header.Set(p.cfg.APIKey, p.cfg.APIValue)
+		}
+		resp, err := p.next.RoundTrip(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("unexpected status: %s", resp.Status)
+		}
+		return nil
+	}()
+	if parent.Err() != nil {
+		// shutting down, that says nothing about the upstream
+		return
+	}
+
+	healthy := err == nil
+	if m.healthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		p.logger.Info("SlashDB upstream is back", slog.String("upstream", m.URL.Host), slog.String("role", m.Role))
+	} else {
+		p.logger.Warn(
+			"SlashDB upstream ejected", slog.String("upstream", m.URL.Host), slog.String("role", m.Role),
+			slog.String("error", err.Error()),
+		)
+	}
+}